@@ -0,0 +1,50 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultAliasTag is the tag resolved when a name is given without a tag.
+const DefaultAliasTag = "latest"
+
+// aliasTagPattern matches valid alias tags, with the same rules as docker image tags.
+var aliasTagPattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9._-]{0,127}$`)
+
+// ValidateAliasTag checks that a tag can be used as an alias tag.
+func ValidateAliasTag(tag string) error {
+	if !aliasTagPattern.MatchString(tag) {
+		return fmt.Errorf("invalid alias tag %q: must be up to 128 letters, digits, '_', '.' or '-', not starting with '.' or '-'", tag)
+	}
+
+	return nil
+}
+
+// IsNameReference reports whether a record reference is a "name:tag" reference rather than a CID.
+func IsNameReference(ref string) bool {
+	return strings.Contains(ref, ":")
+}
+
+// ParseNameReference splits a "name:tag" reference into the record name and tag.
+// The tag is the part after the last ':' and defaults to DefaultAliasTag if ref has no tag.
+func ParseNameReference(ref string) (string, string, error) {
+	name, tag := ref, DefaultAliasTag
+
+	if i := strings.LastIndex(ref, ":"); i >= 0 && !strings.Contains(ref[i+1:], "/") {
+		name, tag = ref[:i], ref[i+1:]
+	}
+
+	if name == "" {
+		return "", "", fmt.Errorf("invalid name reference %q: name is required", ref)
+	}
+
+	if tag == "" {
+		return "", "", fmt.Errorf("invalid name reference %q: tag is required after ':'", ref)
+	}
+
+	return name, tag, nil
+}
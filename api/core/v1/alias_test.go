@@ -0,0 +1,54 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1_test
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAliasTag(t *testing.T) {
+	for _, tag := range []string{"latest", "stable", "v1.0.0", "_canary", "release-2025.01"} {
+		assert.NoError(t, corev1.ValidateAliasTag(tag), tag)
+	}
+
+	for _, tag := range []string{"", ".hidden", "-dash", "with space", "a/b", "a:b", strings.Repeat("a", 129)} {
+		assert.Error(t, corev1.ValidateAliasTag(tag), tag)
+	}
+}
+
+func TestParseNameReference(t *testing.T) {
+	tests := []struct {
+		ref  string
+		name string
+		tag  string
+	}{
+		{"directory.agntcy.org/example/agent:stable", "directory.agntcy.org/example/agent", "stable"},
+		{"directory.agntcy.org/example/agent:v1.0.0", "directory.agntcy.org/example/agent", "v1.0.0"},
+		{"directory.agntcy.org/example/agent", "directory.agntcy.org/example/agent", corev1.DefaultAliasTag},
+		{"localhost:8888/agent", "localhost:8888/agent", corev1.DefaultAliasTag},
+		{"localhost:8888/agent:stable", "localhost:8888/agent", "stable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			name, tag, err := corev1.ParseNameReference(tt.ref)
+			require.NoError(t, err)
+			assert.Equal(t, tt.name, name)
+			assert.Equal(t, tt.tag, tag)
+		})
+	}
+
+	for _, ref := range []string{"", ":stable", "agent:"} {
+		_, _, err := corev1.ParseNameReference(ref)
+		assert.Error(t, err, ref)
+	}
+
+	assert.True(t, corev1.IsNameReference("agent:stable"))
+	assert.False(t, corev1.IsNameReference("baeareihdr6t7s6sr2q4zo456sza66eewqc7huzatyfgvoupaqyjw23ilvi"))
+}
@@ -0,0 +1,47 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import "fmt"
+
+// CanonicalizeRecord converts record JSON into the canonical form the server
+// stores and computes CIDs from.
+//
+// The following normalization rules are applied:
+//   - object keys are sorted alphabetically at every level
+//   - insignificant whitespace is removed
+//   - numbers are encoded as IEEE 754 doubles in their shortest form
+//   - the characters <, > and & in strings are escaped as \u003c, \u003e and \u0026
+//
+// The record must decode as a known OASF record, but it is not validated.
+func CanonicalizeRecord(data []byte) ([]byte, error) {
+	record, err := UnmarshalRecord(data)
+	if err != nil {
+		return nil, err
+	}
+
+	canonicalBytes, err := record.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return canonicalBytes, nil
+}
+
+// ComputeCID computes the CID of the record JSON locally, without contacting a server.
+// The returned CID is the same as the one the server assigns when the record is pushed,
+// regardless of the field ordering and formatting of the input.
+func ComputeCID(data []byte) (string, error) {
+	record, err := UnmarshalRecord(data)
+	if err != nil {
+		return "", err
+	}
+
+	cid, err := record.calculateCID()
+	if err != nil {
+		return "", fmt.Errorf("failed to calculate CID: %w", err)
+	}
+
+	return cid, nil
+}
@@ -0,0 +1,54 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1_test
+
+import (
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalizeRecord(t *testing.T) {
+	data := []byte(`{
+		"schema_version": "v0.3.1",
+		"name": "test-agent",
+		"description": "A <test> agent"
+	}`)
+
+	canonical, err := corev1.CanonicalizeRecord(data)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(canonical))
+	assert.Equal(t, `{"description":"A \u003ctest\u003e agent","name":"test-agent","schema_version":"v0.3.1"}`, string(canonical))
+
+	_, err = corev1.CanonicalizeRecord([]byte(`{invalid`))
+	require.Error(t, err)
+}
+
+func TestComputeCID(t *testing.T) {
+	data := []byte(`{"name":"test-agent","schema_version":"v0.3.1","description":"A test agent"}`)
+	reordered := []byte(`{
+		"description": "A test agent",
+		"schema_version": "v0.3.1",
+		"name": "test-agent"
+	}`)
+
+	cid, err := corev1.ComputeCID(data)
+	require.NoError(t, err)
+	assert.NotEmpty(t, cid)
+
+	// Field ordering and formatting do not change the CID
+	reorderedCID, err := corev1.ComputeCID(reordered)
+	require.NoError(t, err)
+	assert.Equal(t, cid, reorderedCID)
+
+	// The CID matches the one computed for the record the server receives
+	record, err := corev1.UnmarshalRecord(reordered)
+	require.NoError(t, err)
+	assert.Equal(t, record.GetCid(), cid)
+
+	_, err = corev1.ComputeCID([]byte(`{invalid`))
+	require.Error(t, err)
+}
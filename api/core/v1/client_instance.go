@@ -0,0 +1,38 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ClientInstanceIDMetadataKey is the gRPC metadata key carrying the optional client instance ID.
+// Clients may send a locally generated ID to let servers distinguish automation sources
+// that share an identity, or have no identity at all when authentication is disabled.
+// The ID is not authenticated and must not be used for authorization.
+const ClientInstanceIDMetadataKey = "x-dir-client-instance-id"
+
+// MaxClientInstanceIDLength is the maximum length of a client instance ID.
+const MaxClientInstanceIDLength = 64
+
+// ValidateClientInstanceID checks that a client instance ID is non-empty, at most
+// MaxClientInstanceIDLength characters long, and only contains ASCII letters, digits, '-', '_' and '.'.
+func ValidateClientInstanceID(id string) error {
+	if id == "" {
+		return errors.New("client instance ID is empty")
+	}
+
+	if len(id) > MaxClientInstanceIDLength {
+		return fmt.Errorf("client instance ID is longer than %d characters", MaxClientInstanceIDLength)
+	}
+
+	for _, c := range id {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '-' && c != '_' && c != '.' {
+			return fmt.Errorf("client instance ID contains invalid character %q", c)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1_test
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateClientInstanceID(t *testing.T) {
+	for _, id := range []string{
+		"0b4f2c4e-8f7d-4d8e-9c55-2f7b8f3a1d2e",
+		"ci-runner_01.build",
+		strings.Repeat("a", corev1.MaxClientInstanceIDLength),
+	} {
+		assert.NoError(t, corev1.ValidateClientInstanceID(id), id)
+	}
+
+	for _, id := range []string{
+		"",
+		strings.Repeat("a", corev1.MaxClientInstanceIDLength+1),
+		"with space",
+		"new\nline",
+		"ünïcode",
+	} {
+		assert.Error(t, corev1.ValidateClientInstanceID(id), id)
+	}
+}
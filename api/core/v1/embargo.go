@@ -0,0 +1,24 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"fmt"
+	"time"
+)
+
+// EmbargoUntilMetadataKey is the gRPC metadata key carrying the optional embargo time of pushed records.
+// Records pushed with an embargo are hidden from the searches of other identities, and are not
+// announced to the network, until the embargo lifts. The time is formatted as RFC 3339.
+const EmbargoUntilMetadataKey = "x-dir-embargo-until"
+
+// ParseEmbargoUntil parses an embargo time sent as EmbargoUntilMetadataKey metadata.
+func ParseEmbargoUntil(value string) (time.Time, error) {
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid embargo time %q: must be formatted as RFC 3339", value)
+	}
+
+	return until, nil
+}
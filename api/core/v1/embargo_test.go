@@ -0,0 +1,24 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEmbargoUntil(t *testing.T) {
+	until, err := corev1.ParseEmbargoUntil("2030-01-02T15:04:05Z")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC), until)
+
+	for _, value := range []string{"", "2030-01-02", "tomorrow"} {
+		_, err := corev1.ParseEmbargoUntil(value)
+		assert.Error(t, err, value)
+	}
+}
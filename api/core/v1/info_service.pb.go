@@ -0,0 +1,982 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: agntcy/dir/core/v1/info_service.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ApiDescriptorFormat is the format of an API descriptor.
+type ApiDescriptorFormat int32
+
+const (
+	// Unspecified format, defaults to OpenAPI.
+	ApiDescriptorFormat_API_DESCRIPTOR_FORMAT_UNSPECIFIED ApiDescriptorFormat = 0
+	// OpenAPI 3.0 document describing each RPC as a POST operation on its gRPC method path,
+	// with the protobuf JSON mapping of its request and response messages.
+	ApiDescriptorFormat_API_DESCRIPTOR_FORMAT_OPENAPI ApiDescriptorFormat = 1
+	// JSON Schema (draft 2020-12) of the protobuf JSON mapping of messages.
+	ApiDescriptorFormat_API_DESCRIPTOR_FORMAT_JSON_SCHEMA ApiDescriptorFormat = 2
+)
+
+// Enum value maps for ApiDescriptorFormat.
+var (
+	ApiDescriptorFormat_name = map[int32]string{
+		0: "API_DESCRIPTOR_FORMAT_UNSPECIFIED",
+		1: "API_DESCRIPTOR_FORMAT_OPENAPI",
+		2: "API_DESCRIPTOR_FORMAT_JSON_SCHEMA",
+	}
+	ApiDescriptorFormat_value = map[string]int32{
+		"API_DESCRIPTOR_FORMAT_UNSPECIFIED": 0,
+		"API_DESCRIPTOR_FORMAT_OPENAPI":     1,
+		"API_DESCRIPTOR_FORMAT_JSON_SCHEMA": 2,
+	}
+)
+
+func (x ApiDescriptorFormat) Enum() *ApiDescriptorFormat {
+	p := new(ApiDescriptorFormat)
+	*p = x
+	return p
+}
+
+func (x ApiDescriptorFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApiDescriptorFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_agntcy_dir_core_v1_info_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ApiDescriptorFormat) Type() protoreflect.EnumType {
+	return &file_agntcy_dir_core_v1_info_service_proto_enumTypes[0]
+}
+
+func (x ApiDescriptorFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApiDescriptorFormat.Descriptor instead.
+func (ApiDescriptorFormat) EnumDescriptor() ([]byte, []int) {
+	return file_agntcy_dir_core_v1_info_service_proto_rawDescGZIP(), []int{0}
+}
+
+// GetServerInfoRequest is the request of GetServerInfo.
+type GetServerInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerInfoRequest) Reset() {
+	*x = GetServerInfoRequest{}
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerInfoRequest) ProtoMessage() {}
+
+func (x *GetServerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetServerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_core_v1_info_service_proto_rawDescGZIP(), []int{0}
+}
+
+// GetServerInfoResponse describes the capabilities of the server.
+type GetServerInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Record schema versions accepted by the server on push.
+	// For example: "0.7.0", "0.8.0"
+	AcceptedSchemaVersions []string `protobuf:"bytes,1,rep,name=accepted_schema_versions,json=acceptedSchemaVersions,proto3" json:"accepted_schema_versions,omitempty"`
+	// Build information of the server.
+	BuildInfo *BuildInfo `protobuf:"bytes,2,opt,name=build_info,json=buildInfo,proto3" json:"build_info,omitempty"`
+	// Directory API versions served by the server.
+	// For example: "v1"
+	ApiVersions []string `protobuf:"bytes,3,rep,name=api_versions,json=apiVersions,proto3" json:"api_versions,omitempty"`
+	// Record schema versions the server can decode.
+	SupportedSchemaVersions []string `protobuf:"bytes,4,rep,name=supported_schema_versions,json=supportedSchemaVersions,proto3" json:"supported_schema_versions,omitempty"`
+	// Features enabled on the server.
+	Features *ServerFeatures `protobuf:"bytes,5,opt,name=features,proto3" json:"features,omitempty"`
+	// Limits enforced by the server.
+	Limits *ServerLimits `protobuf:"bytes,6,opt,name=limits,proto3" json:"limits,omitempty"`
+	// Capabilities of the store provider measured at startup, if probed.
+	StoreProbe    *StoreProbe `protobuf:"bytes,7,opt,name=store_probe,json=storeProbe,proto3" json:"store_probe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerInfoResponse) Reset() {
+	*x = GetServerInfoResponse{}
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerInfoResponse) ProtoMessage() {}
+
+func (x *GetServerInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetServerInfoResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_core_v1_info_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetServerInfoResponse) GetAcceptedSchemaVersions() []string {
+	if x != nil {
+		return x.AcceptedSchemaVersions
+	}
+	return nil
+}
+
+func (x *GetServerInfoResponse) GetBuildInfo() *BuildInfo {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return nil
+}
+
+func (x *GetServerInfoResponse) GetApiVersions() []string {
+	if x != nil {
+		return x.ApiVersions
+	}
+	return nil
+}
+
+func (x *GetServerInfoResponse) GetSupportedSchemaVersions() []string {
+	if x != nil {
+		return x.SupportedSchemaVersions
+	}
+	return nil
+}
+
+func (x *GetServerInfoResponse) GetFeatures() *ServerFeatures {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *GetServerInfoResponse) GetLimits() *ServerLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *GetServerInfoResponse) GetStoreProbe() *StoreProbe {
+	if x != nil {
+		return x.StoreProbe
+	}
+	return nil
+}
+
+// BuildInfo describes the build of the server.
+type BuildInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of the server, e.g. "v0.5.1".
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Commit hash the server was built from.
+	CommitHash string `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// Go version the server was built with.
+	GoVersion     string `protobuf:"bytes,3,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildInfo) Reset() {
+	*x = BuildInfo{}
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfo) ProtoMessage() {}
+
+func (x *BuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
+func (*BuildInfo) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_core_v1_info_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BuildInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+// ServerFeatures lists the optional features enabled on the server.
+type ServerFeatures struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether callers are authenticated.
+	Authn bool `protobuf:"varint,1,opt,name=authn,proto3" json:"authn,omitempty"`
+	// Whether authorization policies are enforced.
+	Authz bool `protobuf:"varint,2,opt,name=authz,proto3" json:"authz,omitempty"`
+	// Whether events are persisted and can be replayed.
+	// Events are only delivered to subscribers connected when they are emitted otherwise.
+	EventsPersistence bool `protobuf:"varint,3,opt,name=events_persistence,json=eventsPersistence,proto3" json:"events_persistence,omitempty"`
+	// Whether callers are isolated in the namespace of their trust domain,
+	// e.g. when subscribing to events.
+	Namespaces bool `protobuf:"varint,4,opt,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Whether requests are rate limited.
+	RateLimit bool `protobuf:"varint,5,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// Whether the server does not accept writes.
+	ReadOnly      bool `protobuf:"varint,6,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerFeatures) Reset() {
+	*x = ServerFeatures{}
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerFeatures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerFeatures) ProtoMessage() {}
+
+func (x *ServerFeatures) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerFeatures.ProtoReflect.Descriptor instead.
+func (*ServerFeatures) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_core_v1_info_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ServerFeatures) GetAuthn() bool {
+	if x != nil {
+		return x.Authn
+	}
+	return false
+}
+
+func (x *ServerFeatures) GetAuthz() bool {
+	if x != nil {
+		return x.Authz
+	}
+	return false
+}
+
+func (x *ServerFeatures) GetEventsPersistence() bool {
+	if x != nil {
+		return x.EventsPersistence
+	}
+	return false
+}
+
+func (x *ServerFeatures) GetNamespaces() bool {
+	if x != nil {
+		return x.Namespaces
+	}
+	return false
+}
+
+func (x *ServerFeatures) GetRateLimit() bool {
+	if x != nil {
+		return x.RateLimit
+	}
+	return false
+}
+
+func (x *ServerFeatures) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+// ServerLimits lists the limits enforced by the server.
+type ServerLimits struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum size in bytes of a message received by the server.
+	MaxRecvMsgSize uint64 `protobuf:"varint,1,opt,name=max_recv_msg_size,json=maxRecvMsgSize,proto3" json:"max_recv_msg_size,omitempty"`
+	// Maximum size in bytes of a message sent by the server.
+	MaxSendMsgSize uint64 `protobuf:"varint,2,opt,name=max_send_msg_size,json=maxSendMsgSize,proto3" json:"max_send_msg_size,omitempty"`
+	// Maximum number of concurrent streams per connection.
+	MaxConcurrentStreams uint32 `protobuf:"varint,3,opt,name=max_concurrent_streams,json=maxConcurrentStreams,proto3" json:"max_concurrent_streams,omitempty"`
+	// Requests per second allowed for each client, if rate limiting is enabled.
+	PerClientRps float64 `protobuf:"fixed64,4,opt,name=per_client_rps,json=perClientRps,proto3" json:"per_client_rps,omitempty"`
+	// Burst of requests allowed for each client, if rate limiting is enabled.
+	PerClientBurst uint32 `protobuf:"varint,5,opt,name=per_client_burst,json=perClientBurst,proto3" json:"per_client_burst,omitempty"`
+	// Requests per second allowed across all clients, if rate limiting is enabled.
+	GlobalRps float64 `protobuf:"fixed64,6,opt,name=global_rps,json=globalRps,proto3" json:"global_rps,omitempty"`
+	// Burst of requests allowed across all clients, if rate limiting is enabled.
+	GlobalBurst   uint32 `protobuf:"varint,7,opt,name=global_burst,json=globalBurst,proto3" json:"global_burst,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerLimits) Reset() {
+	*x = ServerLimits{}
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerLimits) ProtoMessage() {}
+
+func (x *ServerLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerLimits.ProtoReflect.Descriptor instead.
+func (*ServerLimits) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_core_v1_info_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ServerLimits) GetMaxRecvMsgSize() uint64 {
+	if x != nil {
+		return x.MaxRecvMsgSize
+	}
+	return 0
+}
+
+func (x *ServerLimits) GetMaxSendMsgSize() uint64 {
+	if x != nil {
+		return x.MaxSendMsgSize
+	}
+	return 0
+}
+
+func (x *ServerLimits) GetMaxConcurrentStreams() uint32 {
+	if x != nil {
+		return x.MaxConcurrentStreams
+	}
+	return 0
+}
+
+func (x *ServerLimits) GetPerClientRps() float64 {
+	if x != nil {
+		return x.PerClientRps
+	}
+	return 0
+}
+
+func (x *ServerLimits) GetPerClientBurst() uint32 {
+	if x != nil {
+		return x.PerClientBurst
+	}
+	return 0
+}
+
+func (x *ServerLimits) GetGlobalRps() float64 {
+	if x != nil {
+		return x.GlobalRps
+	}
+	return 0
+}
+
+func (x *ServerLimits) GetGlobalBurst() uint32 {
+	if x != nil {
+		return x.GlobalBurst
+	}
+	return 0
+}
+
+// StoreProbe describes the capabilities of the store provider measured by
+// writing, reading and deleting probe records when the server starts.
+type StoreProbe struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Store provider, e.g. "oci".
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Latency of writing a small record.
+	WriteLatency *durationpb.Duration `protobuf:"bytes,2,opt,name=write_latency,json=writeLatency,proto3" json:"write_latency,omitempty"`
+	// Latency of reading a small record.
+	ReadLatency *durationpb.Duration `protobuf:"bytes,3,opt,name=read_latency,json=readLatency,proto3" json:"read_latency,omitempty"`
+	// Latency of deleting a small record.
+	DeleteLatency *durationpb.Duration `protobuf:"bytes,4,opt,name=delete_latency,json=deleteLatency,proto3" json:"delete_latency,omitempty"`
+	// Whether the store supports referrers, e.g. record signatures.
+	Referrers bool `protobuf:"varint,5,opt,name=referrers,proto3" json:"referrers,omitempty"`
+	// Size in bytes of the largest probe record accepted by the store.
+	// Probe records are at most as large as the maximum received message size.
+	MaxRecordSize uint64 `protobuf:"varint,6,opt,name=max_record_size,json=maxRecordSize,proto3" json:"max_record_size,omitempty"`
+	// Error of the probe if the store could not be probed.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// Mismatches between the server configuration and the store capabilities.
+	Warnings      []string `protobuf:"bytes,8,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreProbe) Reset() {
+	*x = StoreProbe{}
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreProbe) ProtoMessage() {}
+
+func (x *StoreProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreProbe.ProtoReflect.Descriptor instead.
+func (*StoreProbe) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_core_v1_info_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StoreProbe) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *StoreProbe) GetWriteLatency() *durationpb.Duration {
+	if x != nil {
+		return x.WriteLatency
+	}
+	return nil
+}
+
+func (x *StoreProbe) GetReadLatency() *durationpb.Duration {
+	if x != nil {
+		return x.ReadLatency
+	}
+	return nil
+}
+
+func (x *StoreProbe) GetDeleteLatency() *durationpb.Duration {
+	if x != nil {
+		return x.DeleteLatency
+	}
+	return nil
+}
+
+func (x *StoreProbe) GetReferrers() bool {
+	if x != nil {
+		return x.Referrers
+	}
+	return false
+}
+
+func (x *StoreProbe) GetMaxRecordSize() uint64 {
+	if x != nil {
+		return x.MaxRecordSize
+	}
+	return 0
+}
+
+func (x *StoreProbe) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StoreProbe) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// UnsupportedSchemaVersion is attached as a detail to the FAILED_PRECONDITION
+// error returned when a record with a schema version not accepted by the
+// server is pushed.
+type UnsupportedSchemaVersion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Schema version of the rejected record.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// Record schema versions accepted by the server on push.
+	AcceptedSchemaVersions []string `protobuf:"bytes,2,rep,name=accepted_schema_versions,json=acceptedSchemaVersions,proto3" json:"accepted_schema_versions,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UnsupportedSchemaVersion) Reset() {
+	*x = UnsupportedSchemaVersion{}
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsupportedSchemaVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsupportedSchemaVersion) ProtoMessage() {}
+
+func (x *UnsupportedSchemaVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsupportedSchemaVersion.ProtoReflect.Descriptor instead.
+func (*UnsupportedSchemaVersion) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_core_v1_info_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnsupportedSchemaVersion) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *UnsupportedSchemaVersion) GetAcceptedSchemaVersions() []string {
+	if x != nil {
+		return x.AcceptedSchemaVersions
+	}
+	return nil
+}
+
+// GetApiDescriptorRequest is the request of GetApiDescriptor.
+type GetApiDescriptorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format of the descriptor.
+	Format ApiDescriptorFormat `protobuf:"varint,1,opt,name=format,proto3,enum=agntcy.dir.core.v1.ApiDescriptorFormat" json:"format,omitempty"`
+	// Full name of the message to describe with a JSON schema, e.g. "agntcy.dir.core.v1.RecordRef".
+	// All messages of the public APIs are described if empty. Ignored for OpenAPI.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApiDescriptorRequest) Reset() {
+	*x = GetApiDescriptorRequest{}
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApiDescriptorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiDescriptorRequest) ProtoMessage() {}
+
+func (x *GetApiDescriptorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiDescriptorRequest.ProtoReflect.Descriptor instead.
+func (*GetApiDescriptorRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_core_v1_info_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetApiDescriptorRequest) GetFormat() ApiDescriptorFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ApiDescriptorFormat_API_DESCRIPTOR_FORMAT_UNSPECIFIED
+}
+
+func (x *GetApiDescriptorRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetApiDescriptorResponse is the response of GetApiDescriptor.
+type GetApiDescriptorResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Media type of the descriptor, e.g. "application/json".
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Descriptor document.
+	Content       []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApiDescriptorResponse) Reset() {
+	*x = GetApiDescriptorResponse{}
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApiDescriptorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiDescriptorResponse) ProtoMessage() {}
+
+func (x *GetApiDescriptorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_core_v1_info_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiDescriptorResponse.ProtoReflect.Descriptor instead.
+func (*GetApiDescriptorResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_core_v1_info_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetApiDescriptorResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetApiDescriptorResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+var File_agntcy_dir_core_v1_info_service_proto protoreflect.FileDescriptor
+
+var file_agntcy_dir_core_v1_info_service_proto_rawDesc = string([]byte{
+	0x0a, 0x25, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e,
+	0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x16, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xa9, 0x03, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x18, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x16, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67,
+	0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x69,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e,
+	0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69,
+	0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x3f,
+	0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x22,
+	0x65, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc7, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x61, 0x75, 0x74, 0x68, 0x7a, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0xac, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x6d, 0x73,
+	0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x63, 0x76, 0x4d, 0x73, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x29, 0x0a, 0x11,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x6e, 0x64,
+	0x4d, 0x73, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x63,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x0a,
+	0x0e, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x65, 0x72, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70,
+	0x65, 0x72, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x72, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x72, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x42, 0x75, 0x72, 0x73, 0x74, 0x22,
+	0xe0, 0x02, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0d, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x61,
+	0x64, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x7b, 0x0a, 0x18, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x74, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x61, 0x67, 0x6e,
+	0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x70, 0x69, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2a, 0x86,
+	0x01, 0x0a, 0x13, 0x41, 0x70, 0x69, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x50, 0x49, 0x5f, 0x44, 0x45,
+	0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x4f, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a,
+	0x1d, 0x41, 0x50, 0x49, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x4f, 0x52, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x10, 0x01,
+	0x12, 0x25, 0x0a, 0x21, 0x41, 0x50, 0x49, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54,
+	0x4f, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x4d, 0x41, 0x10, 0x02, 0x32, 0xe2, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x66, 0x6f,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x64, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63,
+	0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x12, 0x2b, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xb8, 0x01, 0x0a,
+	0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64,
+	0x69, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x41, 0x44, 0x43, 0xaa, 0x02, 0x12, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x44, 0x69,
+	0x72, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x41, 0x67, 0x6e, 0x74,
+	0x63, 0x79, 0x5c, 0x44, 0x69, 0x72, 0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1e, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x5c, 0x44, 0x69, 0x72, 0x5c, 0x43, 0x6f, 0x72, 0x65,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x15, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x3a, 0x3a, 0x44, 0x69, 0x72, 0x3a, 0x3a, 0x43,
+	0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_agntcy_dir_core_v1_info_service_proto_rawDescOnce sync.Once
+	file_agntcy_dir_core_v1_info_service_proto_rawDescData []byte
+)
+
+func file_agntcy_dir_core_v1_info_service_proto_rawDescGZIP() []byte {
+	file_agntcy_dir_core_v1_info_service_proto_rawDescOnce.Do(func() {
+		file_agntcy_dir_core_v1_info_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agntcy_dir_core_v1_info_service_proto_rawDesc), len(file_agntcy_dir_core_v1_info_service_proto_rawDesc)))
+	})
+	return file_agntcy_dir_core_v1_info_service_proto_rawDescData
+}
+
+var file_agntcy_dir_core_v1_info_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_agntcy_dir_core_v1_info_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_agntcy_dir_core_v1_info_service_proto_goTypes = []any{
+	(ApiDescriptorFormat)(0),         // 0: agntcy.dir.core.v1.ApiDescriptorFormat
+	(*GetServerInfoRequest)(nil),     // 1: agntcy.dir.core.v1.GetServerInfoRequest
+	(*GetServerInfoResponse)(nil),    // 2: agntcy.dir.core.v1.GetServerInfoResponse
+	(*BuildInfo)(nil),                // 3: agntcy.dir.core.v1.BuildInfo
+	(*ServerFeatures)(nil),           // 4: agntcy.dir.core.v1.ServerFeatures
+	(*ServerLimits)(nil),             // 5: agntcy.dir.core.v1.ServerLimits
+	(*StoreProbe)(nil),               // 6: agntcy.dir.core.v1.StoreProbe
+	(*UnsupportedSchemaVersion)(nil), // 7: agntcy.dir.core.v1.UnsupportedSchemaVersion
+	(*GetApiDescriptorRequest)(nil),  // 8: agntcy.dir.core.v1.GetApiDescriptorRequest
+	(*GetApiDescriptorResponse)(nil), // 9: agntcy.dir.core.v1.GetApiDescriptorResponse
+	(*durationpb.Duration)(nil),      // 10: google.protobuf.Duration
+}
+var file_agntcy_dir_core_v1_info_service_proto_depIdxs = []int32{
+	3,  // 0: agntcy.dir.core.v1.GetServerInfoResponse.build_info:type_name -> agntcy.dir.core.v1.BuildInfo
+	4,  // 1: agntcy.dir.core.v1.GetServerInfoResponse.features:type_name -> agntcy.dir.core.v1.ServerFeatures
+	5,  // 2: agntcy.dir.core.v1.GetServerInfoResponse.limits:type_name -> agntcy.dir.core.v1.ServerLimits
+	6,  // 3: agntcy.dir.core.v1.GetServerInfoResponse.store_probe:type_name -> agntcy.dir.core.v1.StoreProbe
+	10, // 4: agntcy.dir.core.v1.StoreProbe.write_latency:type_name -> google.protobuf.Duration
+	10, // 5: agntcy.dir.core.v1.StoreProbe.read_latency:type_name -> google.protobuf.Duration
+	10, // 6: agntcy.dir.core.v1.StoreProbe.delete_latency:type_name -> google.protobuf.Duration
+	0,  // 7: agntcy.dir.core.v1.GetApiDescriptorRequest.format:type_name -> agntcy.dir.core.v1.ApiDescriptorFormat
+	1,  // 8: agntcy.dir.core.v1.InfoService.GetServerInfo:input_type -> agntcy.dir.core.v1.GetServerInfoRequest
+	8,  // 9: agntcy.dir.core.v1.InfoService.GetApiDescriptor:input_type -> agntcy.dir.core.v1.GetApiDescriptorRequest
+	2,  // 10: agntcy.dir.core.v1.InfoService.GetServerInfo:output_type -> agntcy.dir.core.v1.GetServerInfoResponse
+	9,  // 11: agntcy.dir.core.v1.InfoService.GetApiDescriptor:output_type -> agntcy.dir.core.v1.GetApiDescriptorResponse
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_agntcy_dir_core_v1_info_service_proto_init() }
+func file_agntcy_dir_core_v1_info_service_proto_init() {
+	if File_agntcy_dir_core_v1_info_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_dir_core_v1_info_service_proto_rawDesc), len(file_agntcy_dir_core_v1_info_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_agntcy_dir_core_v1_info_service_proto_goTypes,
+		DependencyIndexes: file_agntcy_dir_core_v1_info_service_proto_depIdxs,
+		EnumInfos:         file_agntcy_dir_core_v1_info_service_proto_enumTypes,
+		MessageInfos:      file_agntcy_dir_core_v1_info_service_proto_msgTypes,
+	}.Build()
+	File_agntcy_dir_core_v1_info_service_proto = out.File
+	file_agntcy_dir_core_v1_info_service_proto_goTypes = nil
+	file_agntcy_dir_core_v1_info_service_proto_depIdxs = nil
+}
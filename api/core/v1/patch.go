@@ -0,0 +1,64 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	jsonpatch "gopkg.in/evanphx/json-patch.v4"
+)
+
+// previousRecordCIDField is the OASF field linking a record to the record it supersedes.
+const previousRecordCIDField = "previous_record_cid"
+
+// ApplyPatch applies an RFC 6902 JSON patch to the record and returns the patched record.
+// The record itself is left unchanged.
+//
+// The patched record is linked to the record it was created from by setting
+// its previous_record_cid field, for schema versions that support lineage.
+// The patched record is not validated.
+func (r *Record) ApplyPatch(patch []byte) (*Record, error) {
+	previousCID := r.GetCid()
+	if previousCID == "" {
+		return nil, errors.New("failed to compute record CID")
+	}
+
+	operations, err := jsonpatch.DecodePatch(patch)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON patch: %w", err)
+	}
+
+	if len(operations) == 0 {
+		return nil, errors.New("JSON patch has no operations")
+	}
+
+	data, err := r.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	patchedData, err := operations.Apply(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply JSON patch: %w", err)
+	}
+
+	patched, err := UnmarshalRecord(patchedData)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := patched.Decode()
+	if err != nil {
+		return nil, err
+	}
+
+	// v0.3.1 records have no lineage field
+	if !decoded.HasV1Alpha0() {
+		patched.Data.Fields[previousRecordCIDField] = structpb.NewStringValue(previousCID)
+	}
+
+	return patched, nil
+}
@@ -0,0 +1,59 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1_test
+
+import (
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord_ApplyPatch(t *testing.T) {
+	record, err := corev1.UnmarshalRecord([]byte(`{
+		"schema_version": "0.7.0",
+		"name": "test-agent",
+		"version": "1.0.0",
+		"description": "A test agent"
+	}`))
+	require.NoError(t, err)
+
+	t.Run("patched record links to the original record", func(t *testing.T) {
+		patched, err := record.ApplyPatch([]byte(`[
+			{"op": "replace", "path": "/version", "value": "1.1.0"},
+			{"op": "remove", "path": "/description"}
+		]`))
+		require.NoError(t, err)
+
+		fields := patched.GetData().GetFields()
+		assert.Equal(t, "1.1.0", fields["version"].GetStringValue())
+		assert.NotContains(t, fields, "description")
+		assert.Equal(t, record.GetCid(), fields["previous_record_cid"].GetStringValue())
+		assert.NotEqual(t, record.GetCid(), patched.GetCid())
+
+		// The original record is left unchanged
+		assert.Equal(t, "1.0.0", record.GetData().GetFields()["version"].GetStringValue())
+	})
+
+	t.Run("v0.3.1 records have no lineage", func(t *testing.T) {
+		legacy, err := corev1.UnmarshalRecord([]byte(`{"schema_version": "v0.3.1", "name": "test-agent", "version": "1.0.0"}`))
+		require.NoError(t, err)
+
+		patched, err := legacy.ApplyPatch([]byte(`[{"op": "replace", "path": "/version", "value": "1.1.0"}]`))
+		require.NoError(t, err)
+		assert.NotContains(t, patched.GetData().GetFields(), "previous_record_cid")
+	})
+
+	t.Run("invalid patches", func(t *testing.T) {
+		_, err := record.ApplyPatch([]byte(`{invalid`))
+		require.ErrorContains(t, err, "invalid JSON patch")
+
+		_, err = record.ApplyPatch([]byte(`[]`))
+		require.ErrorContains(t, err, "no operations")
+
+		_, err = record.ApplyPatch([]byte(`[{"op": "test", "path": "/version", "value": "2.0.0"}]`))
+		require.ErrorContains(t, err, "failed to apply JSON patch")
+	})
+}
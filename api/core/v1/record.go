@@ -33,29 +33,34 @@ func init() {
 // Uses canonical JSON marshaling to ensure consistent, cross-language compatible results.
 // Returns empty string if calculation fails.
 func (r *Record) GetCid() string {
-	if r == nil || r.GetData() == nil {
+	cid, err := r.calculateCID()
+	if err != nil {
 		return ""
 	}
 
+	return cid
+}
+
+// calculateCID calculates the CID for this record from its canonical JSON representation.
+func (r *Record) calculateCID() (string, error) {
+	if r == nil || r.GetData() == nil {
+		return "", errors.New("record has no data")
+	}
+
 	// Use canonical marshaling for CID calculation
 	canonicalBytes, err := r.Marshal()
 	if err != nil {
-		return ""
+		return "", err
 	}
 
 	// Calculate digest using local utilities
 	digest, err := CalculateDigest(canonicalBytes)
 	if err != nil {
-		return ""
+		return "", err
 	}
 
 	// Convert digest to CID using local utilities
-	cid, err := ConvertDigestToCID(digest)
-	if err != nil {
-		return ""
-	}
-
-	return cid
+	return ConvertDigestToCID(digest)
 }
 
 // Marshal marshals the Record using canonical JSON serialization.
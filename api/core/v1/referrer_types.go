@@ -10,4 +10,19 @@ const (
 
 	// SignatureReferrerType is the type for Signature referrers.
 	SignatureReferrerType = "agntcy.dir.sign.v1.Signature"
+
+	// RevocationReferrerType is the type for signature revocation referrers.
+	RevocationReferrerType = "agntcy.dir.sign.v1.Revocation"
+
+	// VerificationSnapshotReferrerType is the type for verification snapshot referrers.
+	VerificationSnapshotReferrerType = "agntcy.dir.sign.v1.VerificationSnapshot"
+
+	// AttestationReferrerType is the type for attestation referrers,
+	// such as provenance or SBOM statements about a record.
+	AttestationReferrerType = "agntcy.dir.core.v1.Attestation"
+
+	// AnnotationsReferrerType is the type for mutable annotation referrers.
+	// Records are immutable, so annotations that may change after push are
+	// stored as referrers, with the most recently created one taking precedence.
+	AnnotationsReferrerType = "agntcy.dir.core.v1.Annotations"
 )
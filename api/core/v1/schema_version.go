@@ -0,0 +1,44 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewUnsupportedSchemaVersionError returns the error returned by the server when
+// a record with a schema version it does not accept is pushed.
+// The accepted schema versions are attached as an UnsupportedSchemaVersion detail.
+func NewUnsupportedSchemaVersionError(schemaVersion string, acceptedSchemaVersions []string) error {
+	st := status.Newf(codes.FailedPrecondition, "record schema version %q is not accepted, accepted versions: %v", schemaVersion, acceptedSchemaVersions)
+
+	withDetails, err := st.WithDetails(&UnsupportedSchemaVersion{
+		SchemaVersion:          schemaVersion,
+		AcceptedSchemaVersions: acceptedSchemaVersions,
+	})
+	if err != nil {
+		return st.Err() //nolint:wrapcheck // gRPC status error for client
+	}
+
+	return withDetails.Err() //nolint:wrapcheck // gRPC status error for client
+}
+
+// GetUnsupportedSchemaVersion extracts the UnsupportedSchemaVersion detail from
+// an error returned by the server, if the error was caused by an unsupported schema version.
+func GetUnsupportedSchemaVersion(err error) (*UnsupportedSchemaVersion, bool) {
+	// FromError also finds gRPC status errors wrapped by other errors
+	st, ok := status.FromError(err)
+	if !ok || st == nil {
+		return nil, false
+	}
+
+	for _, detail := range st.Details() {
+		if unsupported, ok := detail.(*UnsupportedSchemaVersion); ok {
+			return unsupported, true
+		}
+	}
+
+	return nil, false
+}
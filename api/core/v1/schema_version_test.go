@@ -0,0 +1,33 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnsupportedSchemaVersionError(t *testing.T) {
+	err := corev1.NewUnsupportedSchemaVersionError("0.3.1", []string{"0.7.0", "0.8.0"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The detail is found on wrapped errors too
+	unsupported, ok := corev1.GetUnsupportedSchemaVersion(fmt.Errorf("failed to push: %w", err))
+	require.True(t, ok)
+	assert.Equal(t, "0.3.1", unsupported.GetSchemaVersion())
+	assert.Equal(t, []string{"0.7.0", "0.8.0"}, unsupported.GetAcceptedSchemaVersions())
+
+	_, ok = corev1.GetUnsupportedSchemaVersion(errors.New("other error"))
+	assert.False(t, ok)
+
+	_, ok = corev1.GetUnsupportedSchemaVersion(nil)
+	assert.False(t, ok)
+}
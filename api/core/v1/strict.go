@@ -0,0 +1,162 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// FieldIssueType is the type of problem found for a record field.
+type FieldIssueType string
+
+const (
+	// FieldIssueUnknown marks fields that are not part of the record schema.
+	// Unknown fields are silently dropped when the record is decoded.
+	FieldIssueUnknown FieldIssueType = "unknown"
+
+	// FieldIssueDeprecated marks fields that are deprecated in the record schema.
+	FieldIssueDeprecated FieldIssueType = "deprecated"
+)
+
+// FieldIssue reports a problematic field of a record.
+type FieldIssue struct {
+	// Path is the JSON path of the field, e.g. $.locators[0].typ
+	Path string `json:"path"`
+
+	// Type is the type of the problem
+	Type FieldIssueType `json:"type"`
+}
+
+func (i FieldIssue) String() string {
+	return fmt.Sprintf("%s field %s", i.Type, i.Path)
+}
+
+// StrictError is returned by UnmarshalRecordStrict if the record contains
+// unknown or deprecated fields.
+type StrictError struct {
+	Issues []FieldIssue
+}
+
+func (e *StrictError) Error() string {
+	issues := make([]string, 0, len(e.Issues))
+	for _, issue := range e.Issues {
+		issues = append(issues, issue.String())
+	}
+
+	return "record contains unknown or deprecated fields: " + strings.Join(issues, ", ")
+}
+
+// UnmarshalRecordStrict unmarshals record JSON like UnmarshalRecord, but fails
+// with a *StrictError if the record contains unknown or deprecated fields.
+func UnmarshalRecordStrict(data []byte) (*Record, error) {
+	record, err := UnmarshalRecord(data)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := record.CheckFields()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(issues) > 0 {
+		return nil, &StrictError{Issues: issues}
+	}
+
+	return record, nil
+}
+
+// CheckFields reports the fields of the record that are unknown to or
+// deprecated in the OASF schema version of the record.
+// Field names must match the schema exactly, and the content of free-form
+// fields such as module data is not checked.
+func (r *Record) CheckFields() ([]FieldIssue, error) {
+	decoded, err := r.Decode()
+	if err != nil {
+		return nil, err
+	}
+
+	message, ok := decoded.GetRecord().(proto.Message)
+	if !ok {
+		return nil, errors.New("unsupported record type")
+	}
+
+	var issues []FieldIssue
+
+	checkMessageFields(r.GetData().AsMap(), message.ProtoReflect().Descriptor(), "$", &issues)
+
+	return issues, nil
+}
+
+// checkMessageFields checks the JSON object against the fields of the message.
+func checkMessageFields(object map[string]any, md protoreflect.MessageDescriptor, path string, issues *[]FieldIssue) {
+	for _, key := range slices.Sorted(maps.Keys(object)) {
+		fieldPath := path + "." + key
+
+		fd := md.Fields().ByName(protoreflect.Name(key))
+		if fd == nil {
+			*issues = append(*issues, FieldIssue{Path: fieldPath, Type: FieldIssueUnknown})
+
+			continue
+		}
+
+		if options, ok := fd.Options().(*descriptorpb.FieldOptions); ok && options.GetDeprecated() {
+			*issues = append(*issues, FieldIssue{Path: fieldPath, Type: FieldIssueDeprecated})
+		}
+
+		checkFieldValue(object[key], fd, fieldPath, issues)
+	}
+}
+
+// checkFieldValue checks the nested messages of a field value.
+func checkFieldValue(value any, fd protoreflect.FieldDescriptor, path string, issues *[]FieldIssue) {
+	switch {
+	case fd.IsMap():
+		object, ok := value.(map[string]any)
+		if !ok || fd.MapValue().Message() == nil {
+			return
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(object)) {
+			checkMessageValue(object[key], fd.MapValue().Message(), path+"["+strconv.Quote(key)+"]", issues)
+		}
+
+	case fd.IsList():
+		list, ok := value.([]any)
+		if !ok || fd.Message() == nil {
+			return
+		}
+
+		for i, entry := range list {
+			checkMessageValue(entry, fd.Message(), fmt.Sprintf("%s[%d]", path, i), issues)
+		}
+
+	case fd.Message() != nil:
+		checkMessageValue(value, fd.Message(), path, issues)
+	}
+}
+
+// checkMessageValue checks a JSON value holding a message.
+func checkMessageValue(value any, md protoreflect.MessageDescriptor, path string, issues *[]FieldIssue) {
+	// Well-known types such as google.protobuf.Struct hold free-form data
+	if md.ParentFile().Package() == "google.protobuf" {
+		return
+	}
+
+	object, ok := value.(map[string]any)
+	if !ok {
+		return
+	}
+
+	checkMessageFields(object, md, path, issues)
+}
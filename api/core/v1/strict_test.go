@@ -0,0 +1,62 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1_test
+
+import (
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalRecordStrict(t *testing.T) {
+	t.Run("clean record", func(t *testing.T) {
+		data := []byte(`{
+			"schema_version": "0.7.0",
+			"name": "test-agent",
+			"locators": [{"type": "helm_chart", "url": "https://example.com/helm-chart.tgz"}],
+			"modules": [{"name": "test-extension", "data": {"anything": "goes"}}]
+		}`)
+
+		record, err := corev1.UnmarshalRecordStrict(data)
+		require.NoError(t, err)
+		assert.NotNil(t, record)
+	})
+
+	t.Run("unknown fields", func(t *testing.T) {
+		data := []byte(`{
+			"schema_version": "0.7.0",
+			"name": "test-agent",
+			"summary": "not a record field",
+			"locators": [
+				{"type": "helm_chart", "url": "https://example.com/helm-chart.tgz"},
+				{"typ": "docker_image", "url": "https://example.com/image"}
+			]
+		}`)
+
+		// Unknown fields are tolerated by the default unmarshaling
+		record, err := corev1.UnmarshalRecord(data)
+		require.NoError(t, err)
+
+		issues, err := record.CheckFields()
+		require.NoError(t, err)
+		assert.Equal(t, []corev1.FieldIssue{
+			{Path: "$.locators[1].typ", Type: corev1.FieldIssueUnknown},
+			{Path: "$.summary", Type: corev1.FieldIssueUnknown},
+		}, issues)
+
+		_, err = corev1.UnmarshalRecordStrict(data)
+
+		var strictErr *corev1.StrictError
+		require.ErrorAs(t, err, &strictErr)
+		assert.Equal(t, issues, strictErr.Issues)
+		assert.Contains(t, err.Error(), "unknown field $.summary")
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := corev1.UnmarshalRecordStrict([]byte(`{invalid`))
+		require.Error(t, err)
+	})
+}
@@ -22,7 +22,11 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	EventService_Listen_FullMethodName = "/agntcy.dir.events.v1.EventService/Listen"
+	EventService_Listen_FullMethodName                = "/agntcy.dir.events.v1.EventService/Listen"
+	EventService_WatchName_FullMethodName             = "/agntcy.dir.events.v1.EventService/WatchName"
+	EventService_RegisterRecordWebhook_FullMethodName = "/agntcy.dir.events.v1.EventService/RegisterRecordWebhook"
+	EventService_ListRecordWebhooks_FullMethodName    = "/agntcy.dir.events.v1.EventService/ListRecordWebhooks"
+	EventService_DeleteRecordWebhook_FullMethodName   = "/agntcy.dir.events.v1.EventService/DeleteRecordWebhook"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -35,8 +39,31 @@ const (
 type EventServiceClient interface {
 	// Listen establishes a streaming connection to receive events.
 	// Events are only delivered while the stream is active.
-	// On disconnect, missed events are not recoverable.
+	// Recent events missed during a disconnect can be replayed using the
+	// since field of the request, within the server's replay buffer.
 	Listen(ctx context.Context, in *ListenRequest, opts ...grpc.CallOption) (EventService_ListenClient, error)
+	// WatchName streams the latest record of each name matching the request.
+	// The current latest record of every matching name is sent first, followed
+	// by a message whenever the latest record of a name changes, e.g. when a
+	// newer version is pushed or the latest version is deleted.
+	//
+	// The latest record of a name is the one with the highest semantic version.
+	// Records without a valid semantic version rank below versioned ones and
+	// are ordered by the time they were stored.
+	WatchName(ctx context.Context, in *WatchNameRequest, opts ...grpc.CallOption) (EventService_WatchNameClient, error)
+	// RegisterRecordWebhook registers a URL notified of the events affecting a record.
+	// Each matching event is sent to the URL in an HTTP POST request with the
+	// JSON encoded Event as body.
+	//
+	// Webhooks are owned by the identity registering them. When authentication
+	// is enabled, callers can only list and delete their own webhooks, and only
+	// the owner of a record, the identity that first pushed it, can register
+	// webhooks for it. Loopback and private network URLs are rejected.
+	RegisterRecordWebhook(ctx context.Context, in *RegisterRecordWebhookRequest, opts ...grpc.CallOption) (*RegisterRecordWebhookResponse, error)
+	// ListRecordWebhooks lists the record webhooks owned by the caller.
+	ListRecordWebhooks(ctx context.Context, in *ListRecordWebhooksRequest, opts ...grpc.CallOption) (*ListRecordWebhooksResponse, error)
+	// DeleteRecordWebhook deletes a record webhook owned by the caller.
+	DeleteRecordWebhook(ctx context.Context, in *DeleteRecordWebhookRequest, opts ...grpc.CallOption) (*DeleteRecordWebhookResponse, error)
 }
 
 type eventServiceClient struct {
@@ -80,6 +107,69 @@ func (x *eventServiceListenClient) Recv() (*ListenResponse, error) {
 	return m, nil
 }
 
+func (c *eventServiceClient) WatchName(ctx context.Context, in *WatchNameRequest, opts ...grpc.CallOption) (EventService_WatchNameClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EventService_ServiceDesc.Streams[1], EventService_WatchName_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventServiceWatchNameClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EventService_WatchNameClient interface {
+	Recv() (*WatchNameResponse, error)
+	grpc.ClientStream
+}
+
+type eventServiceWatchNameClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventServiceWatchNameClient) Recv() (*WatchNameResponse, error) {
+	m := new(WatchNameResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *eventServiceClient) RegisterRecordWebhook(ctx context.Context, in *RegisterRecordWebhookRequest, opts ...grpc.CallOption) (*RegisterRecordWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterRecordWebhookResponse)
+	err := c.cc.Invoke(ctx, EventService_RegisterRecordWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListRecordWebhooks(ctx context.Context, in *ListRecordWebhooksRequest, opts ...grpc.CallOption) (*ListRecordWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecordWebhooksResponse)
+	err := c.cc.Invoke(ctx, EventService_ListRecordWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteRecordWebhook(ctx context.Context, in *DeleteRecordWebhookRequest, opts ...grpc.CallOption) (*DeleteRecordWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRecordWebhookResponse)
+	err := c.cc.Invoke(ctx, EventService_DeleteRecordWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventServiceServer is the server API for EventService service.
 // All implementations should embed UnimplementedEventServiceServer
 // for forward compatibility.
@@ -90,8 +180,31 @@ func (x *eventServiceListenClient) Recv() (*ListenResponse, error) {
 type EventServiceServer interface {
 	// Listen establishes a streaming connection to receive events.
 	// Events are only delivered while the stream is active.
-	// On disconnect, missed events are not recoverable.
+	// Recent events missed during a disconnect can be replayed using the
+	// since field of the request, within the server's replay buffer.
 	Listen(*ListenRequest, EventService_ListenServer) error
+	// WatchName streams the latest record of each name matching the request.
+	// The current latest record of every matching name is sent first, followed
+	// by a message whenever the latest record of a name changes, e.g. when a
+	// newer version is pushed or the latest version is deleted.
+	//
+	// The latest record of a name is the one with the highest semantic version.
+	// Records without a valid semantic version rank below versioned ones and
+	// are ordered by the time they were stored.
+	WatchName(*WatchNameRequest, EventService_WatchNameServer) error
+	// RegisterRecordWebhook registers a URL notified of the events affecting a record.
+	// Each matching event is sent to the URL in an HTTP POST request with the
+	// JSON encoded Event as body.
+	//
+	// Webhooks are owned by the identity registering them. When authentication
+	// is enabled, callers can only list and delete their own webhooks, and only
+	// the owner of a record, the identity that first pushed it, can register
+	// webhooks for it. Loopback and private network URLs are rejected.
+	RegisterRecordWebhook(context.Context, *RegisterRecordWebhookRequest) (*RegisterRecordWebhookResponse, error)
+	// ListRecordWebhooks lists the record webhooks owned by the caller.
+	ListRecordWebhooks(context.Context, *ListRecordWebhooksRequest) (*ListRecordWebhooksResponse, error)
+	// DeleteRecordWebhook deletes a record webhook owned by the caller.
+	DeleteRecordWebhook(context.Context, *DeleteRecordWebhookRequest) (*DeleteRecordWebhookResponse, error)
 }
 
 // UnimplementedEventServiceServer should be embedded to have
@@ -104,6 +217,18 @@ type UnimplementedEventServiceServer struct{}
 func (UnimplementedEventServiceServer) Listen(*ListenRequest, EventService_ListenServer) error {
 	return status.Errorf(codes.Unimplemented, "method Listen not implemented")
 }
+func (UnimplementedEventServiceServer) WatchName(*WatchNameRequest, EventService_WatchNameServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchName not implemented")
+}
+func (UnimplementedEventServiceServer) RegisterRecordWebhook(context.Context, *RegisterRecordWebhookRequest) (*RegisterRecordWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterRecordWebhook not implemented")
+}
+func (UnimplementedEventServiceServer) ListRecordWebhooks(context.Context, *ListRecordWebhooksRequest) (*ListRecordWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecordWebhooks not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteRecordWebhook(context.Context, *DeleteRecordWebhookRequest) (*DeleteRecordWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecordWebhook not implemented")
+}
 func (UnimplementedEventServiceServer) testEmbeddedByValue() {}
 
 // UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -145,19 +270,112 @@ func (x *eventServiceListenServer) Send(m *ListenResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _EventService_WatchName_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServiceServer).WatchName(m, &eventServiceWatchNameServer{ServerStream: stream})
+}
+
+type EventService_WatchNameServer interface {
+	Send(*WatchNameResponse) error
+	grpc.ServerStream
+}
+
+type eventServiceWatchNameServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventServiceWatchNameServer) Send(m *WatchNameResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EventService_RegisterRecordWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRecordWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RegisterRecordWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RegisterRecordWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RegisterRecordWebhook(ctx, req.(*RegisterRecordWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListRecordWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecordWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListRecordWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListRecordWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListRecordWebhooks(ctx, req.(*ListRecordWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteRecordWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecordWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteRecordWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteRecordWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteRecordWebhook(ctx, req.(*DeleteRecordWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var EventService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "agntcy.dir.events.v1.EventService",
 	HandlerType: (*EventServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterRecordWebhook",
+			Handler:    _EventService_RegisterRecordWebhook_Handler,
+		},
+		{
+			MethodName: "ListRecordWebhooks",
+			Handler:    _EventService_ListRecordWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteRecordWebhook",
+			Handler:    _EventService_DeleteRecordWebhook_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "Listen",
 			Handler:       _EventService_Listen_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchName",
+			Handler:       _EventService_WatchName_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "agntcy/dir/events/v1/event_service.proto",
 }
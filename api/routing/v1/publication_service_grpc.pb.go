@@ -22,9 +22,11 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	PublicationService_CreatePublication_FullMethodName = "/agntcy.dir.routing.v1.PublicationService/CreatePublication"
-	PublicationService_ListPublications_FullMethodName  = "/agntcy.dir.routing.v1.PublicationService/ListPublications"
-	PublicationService_GetPublication_FullMethodName    = "/agntcy.dir.routing.v1.PublicationService/GetPublication"
+	PublicationService_CreatePublication_FullMethodName     = "/agntcy.dir.routing.v1.PublicationService/CreatePublication"
+	PublicationService_ListPublications_FullMethodName      = "/agntcy.dir.routing.v1.PublicationService/ListPublications"
+	PublicationService_GetPublication_FullMethodName        = "/agntcy.dir.routing.v1.PublicationService/GetPublication"
+	PublicationService_ConfirmPublication_FullMethodName    = "/agntcy.dir.routing.v1.PublicationService/ConfirmPublication"
+	PublicationService_GetPublicationSummary_FullMethodName = "/agntcy.dir.routing.v1.PublicationService/GetPublicationSummary"
 )
 
 // PublicationServiceClient is the client API for PublicationService service.
@@ -49,6 +51,13 @@ type PublicationServiceClient interface {
 	// GetPublication retrieves details of a specific publication request by its identifier.
 	// This includes the current status and any associated metadata.
 	GetPublication(ctx context.Context, in *GetPublicationRequest, opts ...grpc.CallOption) (*GetPublicationResponse, error)
+	// ConfirmPublication ends the settle delay of a pending publication,
+	// so that its records are announced when the publication is next processed.
+	ConfirmPublication(ctx context.Context, in *ConfirmPublicationRequest, opts ...grpc.CallOption) (*ConfirmPublicationResponse, error)
+	// GetPublicationSummary returns the number of queued, in-flight and failed publications,
+	// aggregated by namespace and by label, so that the publication backlog can be seen at a glance.
+	// Completed publications are not counted.
+	GetPublicationSummary(ctx context.Context, in *GetPublicationSummaryRequest, opts ...grpc.CallOption) (*GetPublicationSummaryResponse, error)
 }
 
 type publicationServiceClient struct {
@@ -112,6 +121,26 @@ func (c *publicationServiceClient) GetPublication(ctx context.Context, in *GetPu
 	return out, nil
 }
 
+func (c *publicationServiceClient) ConfirmPublication(ctx context.Context, in *ConfirmPublicationRequest, opts ...grpc.CallOption) (*ConfirmPublicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmPublicationResponse)
+	err := c.cc.Invoke(ctx, PublicationService_ConfirmPublication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicationServiceClient) GetPublicationSummary(ctx context.Context, in *GetPublicationSummaryRequest, opts ...grpc.CallOption) (*GetPublicationSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicationSummaryResponse)
+	err := c.cc.Invoke(ctx, PublicationService_GetPublicationSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PublicationServiceServer is the server API for PublicationService service.
 // All implementations should embed UnimplementedPublicationServiceServer
 // for forward compatibility.
@@ -134,6 +163,13 @@ type PublicationServiceServer interface {
 	// GetPublication retrieves details of a specific publication request by its identifier.
 	// This includes the current status and any associated metadata.
 	GetPublication(context.Context, *GetPublicationRequest) (*GetPublicationResponse, error)
+	// ConfirmPublication ends the settle delay of a pending publication,
+	// so that its records are announced when the publication is next processed.
+	ConfirmPublication(context.Context, *ConfirmPublicationRequest) (*ConfirmPublicationResponse, error)
+	// GetPublicationSummary returns the number of queued, in-flight and failed publications,
+	// aggregated by namespace and by label, so that the publication backlog can be seen at a glance.
+	// Completed publications are not counted.
+	GetPublicationSummary(context.Context, *GetPublicationSummaryRequest) (*GetPublicationSummaryResponse, error)
 }
 
 // UnimplementedPublicationServiceServer should be embedded to have
@@ -152,6 +188,12 @@ func (UnimplementedPublicationServiceServer) ListPublications(*ListPublicationsR
 func (UnimplementedPublicationServiceServer) GetPublication(context.Context, *GetPublicationRequest) (*GetPublicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPublication not implemented")
 }
+func (UnimplementedPublicationServiceServer) ConfirmPublication(context.Context, *ConfirmPublicationRequest) (*ConfirmPublicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPublication not implemented")
+}
+func (UnimplementedPublicationServiceServer) GetPublicationSummary(context.Context, *GetPublicationSummaryRequest) (*GetPublicationSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicationSummary not implemented")
+}
 func (UnimplementedPublicationServiceServer) testEmbeddedByValue() {}
 
 // UnsafePublicationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -229,6 +271,42 @@ func _PublicationService_GetPublication_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicationService_ConfirmPublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPublicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicationServiceServer).ConfirmPublication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublicationService_ConfirmPublication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicationServiceServer).ConfirmPublication(ctx, req.(*ConfirmPublicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicationService_GetPublicationSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicationSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicationServiceServer).GetPublicationSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublicationService_GetPublicationSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicationServiceServer).GetPublicationSummary(ctx, req.(*GetPublicationSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PublicationService_ServiceDesc is the grpc.ServiceDesc for PublicationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -244,6 +322,14 @@ var PublicationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPublication",
 			Handler:    _PublicationService_GetPublication_Handler,
 		},
+		{
+			MethodName: "ConfirmPublication",
+			Handler:    _PublicationService_ConfirmPublication_Handler,
+		},
+		{
+			MethodName: "GetPublicationSummary",
+			Handler:    _PublicationService_GetPublicationSummary_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
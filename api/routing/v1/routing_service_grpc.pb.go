@@ -27,6 +27,7 @@ const (
 	RoutingService_Unpublish_FullMethodName = "/agntcy.dir.routing.v1.RoutingService/Unpublish"
 	RoutingService_Search_FullMethodName    = "/agntcy.dir.routing.v1.RoutingService/Search"
 	RoutingService_List_FullMethodName      = "/agntcy.dir.routing.v1.RoutingService/List"
+	RoutingService_ListPeers_FullMethodName = "/agntcy.dir.routing.v1.RoutingService/ListPeers"
 )
 
 // RoutingServiceClient is the client API for RoutingService service.
@@ -49,7 +50,11 @@ type RoutingServiceClient interface {
 	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Stop serving this record to the network. If other peers try
 	// to retrieve this record, the peer will refuse the request.
-	Unpublish(ctx context.Context, in *UnpublishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	//
+	// Records can be selected by reference or by queries, in which case
+	// all published records matching the queries are unpublished.
+	// With dry_run set, the selected records are returned without being unpublished.
+	Unpublish(ctx context.Context, in *UnpublishRequest, opts ...grpc.CallOption) (*UnpublishResponse, error)
 	// Search records based on the request across the network.
 	// This will search the network for the record with the given parameters.
 	//
@@ -63,6 +68,9 @@ type RoutingServiceClient interface {
 	// that match the given parameters.
 	// This operation does not interact with the network.
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (RoutingService_ListClient, error)
+	// List peers known to this peer along with the capabilities they advertised.
+	// This operation does not interact with the network.
+	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (RoutingService_ListPeersClient, error)
 }
 
 type routingServiceClient struct {
@@ -83,9 +91,9 @@ func (c *routingServiceClient) Publish(ctx context.Context, in *PublishRequest,
 	return out, nil
 }
 
-func (c *routingServiceClient) Unpublish(ctx context.Context, in *UnpublishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *routingServiceClient) Unpublish(ctx context.Context, in *UnpublishRequest, opts ...grpc.CallOption) (*UnpublishResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(UnpublishResponse)
 	err := c.cc.Invoke(ctx, RoutingService_Unpublish_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -159,6 +167,39 @@ func (x *routingServiceListClient) Recv() (*ListResponse, error) {
 	return m, nil
 }
 
+func (c *routingServiceClient) ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (RoutingService_ListPeersClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RoutingService_ServiceDesc.Streams[2], RoutingService_ListPeers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routingServiceListPeersClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RoutingService_ListPeersClient interface {
+	Recv() (*ListPeersResponse, error)
+	grpc.ClientStream
+}
+
+type routingServiceListPeersClient struct {
+	grpc.ClientStream
+}
+
+func (x *routingServiceListPeersClient) Recv() (*ListPeersResponse, error) {
+	m := new(ListPeersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RoutingServiceServer is the server API for RoutingService service.
 // All implementations should embed UnimplementedRoutingServiceServer
 // for forward compatibility.
@@ -179,7 +220,11 @@ type RoutingServiceServer interface {
 	Publish(context.Context, *PublishRequest) (*emptypb.Empty, error)
 	// Stop serving this record to the network. If other peers try
 	// to retrieve this record, the peer will refuse the request.
-	Unpublish(context.Context, *UnpublishRequest) (*emptypb.Empty, error)
+	//
+	// Records can be selected by reference or by queries, in which case
+	// all published records matching the queries are unpublished.
+	// With dry_run set, the selected records are returned without being unpublished.
+	Unpublish(context.Context, *UnpublishRequest) (*UnpublishResponse, error)
 	// Search records based on the request across the network.
 	// This will search the network for the record with the given parameters.
 	//
@@ -193,6 +238,9 @@ type RoutingServiceServer interface {
 	// that match the given parameters.
 	// This operation does not interact with the network.
 	List(*ListRequest, RoutingService_ListServer) error
+	// List peers known to this peer along with the capabilities they advertised.
+	// This operation does not interact with the network.
+	ListPeers(*ListPeersRequest, RoutingService_ListPeersServer) error
 }
 
 // UnimplementedRoutingServiceServer should be embedded to have
@@ -205,7 +253,7 @@ type UnimplementedRoutingServiceServer struct{}
 func (UnimplementedRoutingServiceServer) Publish(context.Context, *PublishRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
 }
-func (UnimplementedRoutingServiceServer) Unpublish(context.Context, *UnpublishRequest) (*emptypb.Empty, error) {
+func (UnimplementedRoutingServiceServer) Unpublish(context.Context, *UnpublishRequest) (*UnpublishResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unpublish not implemented")
 }
 func (UnimplementedRoutingServiceServer) Search(*SearchRequest, RoutingService_SearchServer) error {
@@ -214,6 +262,9 @@ func (UnimplementedRoutingServiceServer) Search(*SearchRequest, RoutingService_S
 func (UnimplementedRoutingServiceServer) List(*ListRequest, RoutingService_ListServer) error {
 	return status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedRoutingServiceServer) ListPeers(*ListPeersRequest, RoutingService_ListPeersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+}
 func (UnimplementedRoutingServiceServer) testEmbeddedByValue() {}
 
 // UnsafeRoutingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -312,6 +363,27 @@ func (x *routingServiceListServer) Send(m *ListResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _RoutingService_ListPeers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPeersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RoutingServiceServer).ListPeers(m, &routingServiceListPeersServer{ServerStream: stream})
+}
+
+type RoutingService_ListPeersServer interface {
+	Send(*ListPeersResponse) error
+	grpc.ServerStream
+}
+
+type routingServiceListPeersServer struct {
+	grpc.ServerStream
+}
+
+func (x *routingServiceListPeersServer) Send(m *ListPeersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // RoutingService_ServiceDesc is the grpc.ServiceDesc for RoutingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -339,6 +411,11 @@ var RoutingService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _RoutingService_List_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListPeers",
+			Handler:       _RoutingService_ListPeers_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "agntcy/dir/routing/v1/routing_service.proto",
 }
@@ -54,20 +54,37 @@ const (
 	// Query for a domain name.
 	// Supports wildcard patterns: "*education*", "healthcare/*", "*technology"
 	RecordQueryType_RECORD_QUERY_TYPE_DOMAIN_NAME RecordQueryType = 8
+	// Query for an annotation, given as "key=value".
+	// Only annotation keys declared as indexed in the server configuration can be queried.
+	// Values are case-sensitive and support wildcard patterns: "team=platform", "environment=prod*"
+	RecordQueryType_RECORD_QUERY_TYPE_ANNOTATION RecordQueryType = 9
+	// Query for the SPDX identifier of the license declared by the license module of records.
+	// Several license queries are combined with OR semantics.
+	// Supports wildcard patterns: "Apache-2.0", "GPL-*", "BSD-?-Clause"
+	RecordQueryType_RECORD_QUERY_TYPE_LICENSE RecordQueryType = 10
+	// Query for the identity of a signer of records, extracted from their verified signatures:
+	// the SPIFFE ID or OIDC subject (email or URI) of the signing certificate,
+	// or the fingerprint "sha256:<hex>" of the signing public key.
+	// Revoked signatures are not matched. Several signer queries are combined with OR semantics.
+	// Supports wildcard patterns: "spiffe://acme.org/ci", "*@acme.org", "sha256:3f2a*"
+	RecordQueryType_RECORD_QUERY_TYPE_SIGNER RecordQueryType = 11
 )
 
 // Enum value maps for RecordQueryType.
 var (
 	RecordQueryType_name = map[int32]string{
-		0: "RECORD_QUERY_TYPE_UNSPECIFIED",
-		1: "RECORD_QUERY_TYPE_NAME",
-		2: "RECORD_QUERY_TYPE_VERSION",
-		3: "RECORD_QUERY_TYPE_SKILL_ID",
-		4: "RECORD_QUERY_TYPE_SKILL_NAME",
-		5: "RECORD_QUERY_TYPE_LOCATOR",
-		6: "RECORD_QUERY_TYPE_MODULE",
-		7: "RECORD_QUERY_TYPE_DOMAIN_ID",
-		8: "RECORD_QUERY_TYPE_DOMAIN_NAME",
+		0:  "RECORD_QUERY_TYPE_UNSPECIFIED",
+		1:  "RECORD_QUERY_TYPE_NAME",
+		2:  "RECORD_QUERY_TYPE_VERSION",
+		3:  "RECORD_QUERY_TYPE_SKILL_ID",
+		4:  "RECORD_QUERY_TYPE_SKILL_NAME",
+		5:  "RECORD_QUERY_TYPE_LOCATOR",
+		6:  "RECORD_QUERY_TYPE_MODULE",
+		7:  "RECORD_QUERY_TYPE_DOMAIN_ID",
+		8:  "RECORD_QUERY_TYPE_DOMAIN_NAME",
+		9:  "RECORD_QUERY_TYPE_ANNOTATION",
+		10: "RECORD_QUERY_TYPE_LICENSE",
+		11: "RECORD_QUERY_TYPE_SIGNER",
 	}
 	RecordQueryType_value = map[string]int32{
 		"RECORD_QUERY_TYPE_UNSPECIFIED": 0,
@@ -79,6 +96,9 @@ var (
 		"RECORD_QUERY_TYPE_MODULE":      6,
 		"RECORD_QUERY_TYPE_DOMAIN_ID":   7,
 		"RECORD_QUERY_TYPE_DOMAIN_NAME": 8,
+		"RECORD_QUERY_TYPE_ANNOTATION":  9,
+		"RECORD_QUERY_TYPE_LICENSE":     10,
+		"RECORD_QUERY_TYPE_SIGNER":      11,
 	}
 )
 
@@ -118,6 +138,9 @@ func (RecordQueryType) EnumDescriptor() ([]byte, []int) {
 //	Question mark:    { type: RECORD_QUERY_TYPE_VERSION, value: "v1.0.?" }
 //	List wildcards:   { type: RECORD_QUERY_TYPE_NAME, value: "agent-[0-9]" }
 //	Complex match:    { type: RECORD_QUERY_TYPE_LOCATOR, value: "docker-image:https://*.example.com/*" }
+//	Annotation match: { type: RECORD_QUERY_TYPE_ANNOTATION, value: "team=platform" }
+//	License match:    { type: RECORD_QUERY_TYPE_LICENSE, value: "Apache-2.0" }
+//	Signer match:     { type: RECORD_QUERY_TYPE_SIGNER, value: "spiffe://acme.org/ci/*" }
 type RecordQuery struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The type of the query to match against.
@@ -190,7 +213,7 @@ var file_agntcy_dir_search_v1_record_query_proto_rawDesc = string([]byte{
 	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
 	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a,
-	0xb2, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x91, 0x03, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
 	0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x51, 0x55,
 	0x45, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
 	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44,
@@ -209,20 +232,26 @@ var file_agntcy_dir_search_v1_record_query_proto_rawDesc = string([]byte{
 	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x10,
 	0x07, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x51, 0x55, 0x45, 0x52,
 	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x4e, 0x41,
-	0x4d, 0x45, 0x10, 0x08, 0x42, 0xc4, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x67, 0x6e,
-	0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76,
-	0x31, 0x42, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x44, 0x53,
-	0xaa, 0x02, 0x14, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x44, 0x69, 0x72, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x14, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79,
-	0x5c, 0x44, 0x69, 0x72, 0x5c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x20, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x5c, 0x44, 0x69, 0x72, 0x5c, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x17, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x3a, 0x3a, 0x44, 0x69, 0x72, 0x3a,
-	0x3a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x4d, 0x45, 0x10, 0x08, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x51,
+	0x55, 0x45, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x09, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44,
+	0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x43, 0x45,
+	0x4e, 0x53, 0x45, 0x10, 0x0a, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f,
+	0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45,
+	0x52, 0x10, 0x0b, 0x42, 0xc4, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x67, 0x6e, 0x74,
+	0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x42, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x44, 0x53, 0xaa,
+	0x02, 0x14, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x44, 0x69, 0x72, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x14, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x5c,
+	0x44, 0x69, 0x72, 0x5c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20,
+	0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x5c, 0x44, 0x69, 0x72, 0x5c, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x17, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x3a, 0x3a, 0x44, 0x69, 0x72, 0x3a, 0x3a,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 })
 
 var (
@@ -31,6 +31,9 @@ const (
 type SearchServiceClient interface {
 	// List records that this peer is currently providing that match the given parameters.
 	// This operation does not interact with the network.
+	//
+	// Results are read from a single consistent snapshot of the search index, taken
+	// before the first result is sent. Every response carries the snapshot time.
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (SearchService_SearchClient, error)
 }
 
@@ -81,6 +84,9 @@ func (x *searchServiceSearchClient) Recv() (*SearchResponse, error) {
 type SearchServiceServer interface {
 	// List records that this peer is currently providing that match the given parameters.
 	// This operation does not interact with the network.
+	//
+	// Results are read from a single consistent snapshot of the search index, taken
+	// before the first result is sent. Every response carries the snapshot time.
 	Search(*SearchRequest, SearchService_SearchServer) error
 }
 
@@ -0,0 +1,105 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	ocidigest "github.com/opencontainers/go-digest"
+)
+
+// Payload is the simple signing payload signed for records.
+// It references the digest of the canonical form of a record, as returned by
+// corev1.CanonicalizeRecord, so that signatures remain valid regardless of JSON key
+// ordering or whitespace when records are re-serialized, e.g. across sync boundaries.
+type Payload struct {
+	Critical PayloadCritical `json:"critical"`
+}
+
+// PayloadCritical holds the signed claims of a Payload.
+type PayloadCritical struct {
+	Image PayloadImage `json:"image"`
+}
+
+// PayloadImage identifies the signed record by the digest of its canonical form.
+type PayloadImage struct {
+	DockerManifestDigest string `json:"docker-manifest-digest"`
+}
+
+// RecordPayload returns the payload to sign, or to verify signatures against, for a record.
+// The record is canonicalized before its digest is computed.
+func RecordPayload(record *corev1.Record) ([]byte, error) {
+	if record == nil || record.GetData() == nil {
+		return nil, errors.New("record has no data")
+	}
+
+	canonicalBytes, err := record.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to canonicalize record: %w", err)
+	}
+
+	digest, err := corev1.CalculateDigest(canonicalBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate record digest: %w", err)
+	}
+
+	return marshalPayload(digest)
+}
+
+// RecordDataPayload returns the payload for record JSON in any key ordering or formatting.
+func RecordDataPayload(data []byte) ([]byte, error) {
+	record, err := corev1.UnmarshalRecord(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal record: %w", err)
+	}
+
+	return RecordPayload(record)
+}
+
+// CIDPayload returns the payload for the record with the given CID.
+// Since CIDs are computed from the canonical form of records, it is the same
+// as the payload returned by RecordPayload for the record.
+func CIDPayload(cid string) ([]byte, error) {
+	digest, err := corev1.ConvertCIDToDigest(cid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert CID to digest: %w", err)
+	}
+
+	return marshalPayload(digest)
+}
+
+// PayloadCID returns the CID of the record referenced by a payload.
+func PayloadCID(payload []byte) (string, error) {
+	var parsed Payload
+	if err := json.Unmarshal(payload, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse signature payload: %w", err)
+	}
+
+	cid, err := corev1.ConvertDigestToCID(ocidigest.Digest(parsed.Critical.Image.DockerManifestDigest))
+	if err != nil {
+		return "", fmt.Errorf("invalid signature payload digest: %w", err)
+	}
+
+	return cid, nil
+}
+
+func marshalPayload(digest ocidigest.Digest) ([]byte, error) {
+	payload := &Payload{
+		Critical: PayloadCritical{
+			Image: PayloadImage{
+				DockerManifestDigest: digest.String(),
+			},
+		},
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	return payloadBytes, nil
+}
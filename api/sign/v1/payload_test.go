@@ -0,0 +1,149 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const testRecordJSON = `{
+	"name": "test-agent",
+	"version": "v1.0.0",
+	"schema_version": "v0.3.1",
+	"description": "A <test> agent",
+	"skills": [
+		{"category_name": "Natural Language Processing", "class_name": "Text Completion", "class_uid": 10201}
+	],
+	"extensions": [
+		{"name": "schema.oasf.agntcy.org/features/runtime/model", "version": "v1.0.0", "data": {"models": [{"provider": "openai", "model": "gpt-4"}], "temperature": 0.7}}
+	]
+}`
+
+// roundTrips re-serializes a record the ways it can be re-serialized across sync
+// boundaries, and returns the resulting records.
+func roundTrips(t *testing.T, record *corev1.Record) map[string]*corev1.Record {
+	t.Helper()
+
+	records := map[string]*corev1.Record{}
+
+	// Transferred over gRPC between Directories
+	wire, err := proto.Marshal(record)
+	require.NoError(t, err)
+
+	transferred := &corev1.Record{}
+	require.NoError(t, proto.Unmarshal(wire, transferred))
+
+	records["protobuf"] = transferred
+
+	// Exported and re-imported as indented protojson
+	exported, err := protojson.MarshalOptions{Multiline: true, Indent: "    "}.Marshal(record.GetData())
+	require.NoError(t, err)
+
+	imported, err := corev1.UnmarshalRecord(exported)
+	require.NoError(t, err)
+
+	records["protojson"] = imported
+
+	// Re-encoded by a generic JSON tool, which reorders keys and changes whitespace
+	var generic map[string]any
+	require.NoError(t, json.Unmarshal([]byte(testRecordJSON), &generic))
+
+	reencoded, err := json.MarshalIndent(generic, "", "\t")
+	require.NoError(t, err)
+
+	decoded, err := corev1.UnmarshalRecord(reencoded)
+	require.NoError(t, err)
+
+	records["reencoded json"] = decoded
+
+	return records
+}
+
+func TestRecordPayload(t *testing.T) {
+	record, err := corev1.UnmarshalRecord([]byte(testRecordJSON))
+	require.NoError(t, err)
+
+	payload, err := signv1.RecordPayload(record)
+	require.NoError(t, err)
+
+	// The payload references the record CID
+	cid, err := signv1.PayloadCID(payload)
+	require.NoError(t, err)
+	assert.Equal(t, record.GetCid(), cid)
+
+	cidPayload, err := signv1.CIDPayload(record.GetCid())
+	require.NoError(t, err)
+	assert.Equal(t, payload, cidPayload)
+
+	dataPayload, err := signv1.RecordDataPayload([]byte(testRecordJSON))
+	require.NoError(t, err)
+	assert.Equal(t, payload, dataPayload)
+
+	for name, roundTripped := range roundTrips(t, record) {
+		t.Run(name, func(t *testing.T) {
+			roundTrippedPayload, err := signv1.RecordPayload(roundTripped)
+			require.NoError(t, err)
+			assert.Equal(t, payload, roundTrippedPayload)
+			assert.Equal(t, record.GetCid(), roundTripped.GetCid())
+		})
+	}
+
+	_, err = signv1.RecordPayload(&corev1.Record{})
+	require.Error(t, err)
+
+	_, err = signv1.RecordDataPayload([]byte(`{invalid`))
+	require.Error(t, err)
+}
+
+func TestSignatureSurvivesSync(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	record, err := corev1.UnmarshalRecord([]byte(testRecordJSON))
+	require.NoError(t, err)
+
+	// Sign the record on the source Directory
+	payload, err := signv1.RecordPayload(record)
+	require.NoError(t, err)
+
+	digest := sha256.Sum256(payload)
+
+	signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+
+	// Verify the signature against the synced copies of the record
+	for name, synced := range roundTrips(t, record) {
+		t.Run(name, func(t *testing.T) {
+			syncedPayload, err := signv1.RecordPayload(synced)
+			require.NoError(t, err)
+
+			syncedDigest := sha256.Sum256(syncedPayload)
+			assert.True(t, ecdsa.VerifyASN1(&key.PublicKey, syncedDigest[:], signature))
+		})
+	}
+}
+
+func TestPayloadCID(t *testing.T) {
+	_, err := signv1.PayloadCID([]byte(`{invalid`))
+	require.Error(t, err)
+
+	_, err = signv1.PayloadCID([]byte(`{"critical":{"image":{"docker-manifest-digest":"not-a-digest"}}}`))
+	require.Error(t, err)
+
+	cid, err := signv1.PayloadCID([]byte(`{"critical":{"image":{"docker-manifest-digest":"sha256:0000000000000000000000000000000000000000000000000000000000000000"}}}`))
+	require.NoError(t, err)
+	assert.NotEmpty(t, cid)
+}
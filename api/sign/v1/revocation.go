@@ -0,0 +1,56 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/oasf-sdk/pkg/decoder"
+)
+
+// ReferrerType returns the type for Revocation.
+func (r *Revocation) ReferrerType() string {
+	return string((&Revocation{}).ProtoReflect().Descriptor().FullName())
+}
+
+// MarshalReferrer exports the Revocation into a RecordReferrer.
+func (r *Revocation) MarshalReferrer() (*corev1.RecordReferrer, error) {
+	if r == nil {
+		return nil, errors.New("revocation is nil")
+	}
+
+	// Use decoder to convert proto message to structpb
+	data, err := decoder.StructToProto(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert revocation to struct: %w", err)
+	}
+
+	return &corev1.RecordReferrer{
+		Type: r.ReferrerType(),
+		Data: data,
+	}, nil
+}
+
+// UnmarshalReferrer loads the Revocation from a RecordReferrer.
+func (r *Revocation) UnmarshalReferrer(ref *corev1.RecordReferrer) error {
+	if ref == nil || ref.GetData() == nil {
+		return errors.New("referrer or data is nil")
+	}
+
+	// Use decoder to convert structpb to proto message
+	decoded, err := decoder.ProtoToStruct[Revocation](ref.GetData())
+	if err != nil {
+		return fmt.Errorf("failed to decode revocation from referrer: %w", err)
+	}
+
+	// Copy fields individually to avoid copying the lock
+	r.Signature = decoded.GetSignature()
+	r.Reason = decoded.GetReason()
+	r.RevokedAt = decoded.GetRevokedAt()
+	r.RevokedBy = decoded.GetRevokedBy()
+
+	return nil
+}
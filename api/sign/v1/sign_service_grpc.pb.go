@@ -22,8 +22,11 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	SignService_Sign_FullMethodName   = "/agntcy.dir.sign.v1.SignService/Sign"
-	SignService_Verify_FullMethodName = "/agntcy.dir.sign.v1.SignService/Verify"
+	SignService_Sign_FullMethodName                       = "/agntcy.dir.sign.v1.SignService/Sign"
+	SignService_Verify_FullMethodName                     = "/agntcy.dir.sign.v1.SignService/Verify"
+	SignService_Revoke_FullMethodName                     = "/agntcy.dir.sign.v1.SignService/Revoke"
+	SignService_CreateVerificationSnapshot_FullMethodName = "/agntcy.dir.sign.v1.SignService/CreateVerificationSnapshot"
+	SignService_Resign_FullMethodName                     = "/agntcy.dir.sign.v1.SignService/Resign"
 )
 
 // SignServiceClient is the client API for SignService service.
@@ -36,6 +39,21 @@ type SignServiceClient interface {
 	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
 	// Verify signed record using keyless OIDC based provider or using PEM-encoded formatted PEM public key encrypted
 	Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error)
+	// Revoke previously valid signatures of a record.
+	// Revocations are stored as record referrers and checked during verification.
+	Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*RevokeResponse, error)
+	// Verify the record and store the result as an immutable snapshot attached to the record.
+	// Snapshots can be retrieved using StoreService PullReferrer.
+	CreateVerificationSnapshot(ctx context.Context, in *CreateVerificationSnapshotRequest, opts ...grpc.CallOption) (*CreateVerificationSnapshotResponse, error)
+	// Resign signs all records matching search queries with a new key or OIDC identity,
+	// for example after rotating a signing key. A RECORD_SIGNED event is emitted for every
+	// record signed, and existing signatures are kept until revoked.
+	//
+	// Records are re-signed in the background: the response returns immediately with the ID
+	// of the operation, which can be tracked and cancelled with the OperationService.
+	// The ResignResponse is the response of the operation once it succeeded.
+	// When authentication is enabled, only the admins of the server can re-sign records.
+	Resign(ctx context.Context, in *ResignRequest, opts ...grpc.CallOption) (*ResignResponse, error)
 }
 
 type signServiceClient struct {
@@ -66,6 +84,36 @@ func (c *signServiceClient) Verify(ctx context.Context, in *VerifyRequest, opts
 	return out, nil
 }
 
+func (c *signServiceClient) Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*RevokeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeResponse)
+	err := c.cc.Invoke(ctx, SignService_Revoke_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) CreateVerificationSnapshot(ctx context.Context, in *CreateVerificationSnapshotRequest, opts ...grpc.CallOption) (*CreateVerificationSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVerificationSnapshotResponse)
+	err := c.cc.Invoke(ctx, SignService_CreateVerificationSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) Resign(ctx context.Context, in *ResignRequest, opts ...grpc.CallOption) (*ResignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResignResponse)
+	err := c.cc.Invoke(ctx, SignService_Resign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SignServiceServer is the server API for SignService service.
 // All implementations should embed UnimplementedSignServiceServer
 // for forward compatibility.
@@ -76,6 +124,21 @@ type SignServiceServer interface {
 	Sign(context.Context, *SignRequest) (*SignResponse, error)
 	// Verify signed record using keyless OIDC based provider or using PEM-encoded formatted PEM public key encrypted
 	Verify(context.Context, *VerifyRequest) (*VerifyResponse, error)
+	// Revoke previously valid signatures of a record.
+	// Revocations are stored as record referrers and checked during verification.
+	Revoke(context.Context, *RevokeRequest) (*RevokeResponse, error)
+	// Verify the record and store the result as an immutable snapshot attached to the record.
+	// Snapshots can be retrieved using StoreService PullReferrer.
+	CreateVerificationSnapshot(context.Context, *CreateVerificationSnapshotRequest) (*CreateVerificationSnapshotResponse, error)
+	// Resign signs all records matching search queries with a new key or OIDC identity,
+	// for example after rotating a signing key. A RECORD_SIGNED event is emitted for every
+	// record signed, and existing signatures are kept until revoked.
+	//
+	// Records are re-signed in the background: the response returns immediately with the ID
+	// of the operation, which can be tracked and cancelled with the OperationService.
+	// The ResignResponse is the response of the operation once it succeeded.
+	// When authentication is enabled, only the admins of the server can re-sign records.
+	Resign(context.Context, *ResignRequest) (*ResignResponse, error)
 }
 
 // UnimplementedSignServiceServer should be embedded to have
@@ -91,6 +154,15 @@ func (UnimplementedSignServiceServer) Sign(context.Context, *SignRequest) (*Sign
 func (UnimplementedSignServiceServer) Verify(context.Context, *VerifyRequest) (*VerifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Verify not implemented")
 }
+func (UnimplementedSignServiceServer) Revoke(context.Context, *RevokeRequest) (*RevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
+}
+func (UnimplementedSignServiceServer) CreateVerificationSnapshot(context.Context, *CreateVerificationSnapshotRequest) (*CreateVerificationSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVerificationSnapshot not implemented")
+}
+func (UnimplementedSignServiceServer) Resign(context.Context, *ResignRequest) (*ResignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resign not implemented")
+}
 func (UnimplementedSignServiceServer) testEmbeddedByValue() {}
 
 // UnsafeSignServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -147,6 +219,60 @@ func _SignService_Verify_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SignService_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_Revoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).Revoke(ctx, req.(*RevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_CreateVerificationSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVerificationSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).CreateVerificationSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_CreateVerificationSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).CreateVerificationSnapshot(ctx, req.(*CreateVerificationSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_Resign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).Resign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_Resign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).Resign(ctx, req.(*ResignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SignService_ServiceDesc is the grpc.ServiceDesc for SignService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -162,6 +288,18 @@ var SignService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Verify",
 			Handler:    _SignService_Verify_Handler,
 		},
+		{
+			MethodName: "Revoke",
+			Handler:    _SignService_Revoke_Handler,
+		},
+		{
+			MethodName: "CreateVerificationSnapshot",
+			Handler:    _SignService_CreateVerificationSnapshot_Handler,
+		},
+		{
+			MethodName: "Resign",
+			Handler:    _SignService_Resign_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "agntcy/dir/sign/v1/sign_service.proto",
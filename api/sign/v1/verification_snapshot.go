@@ -0,0 +1,63 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/oasf-sdk/pkg/decoder"
+)
+
+// ReferrerType returns the type for VerificationSnapshot.
+func (v *VerificationSnapshot) ReferrerType() string {
+	return string((&VerificationSnapshot{}).ProtoReflect().Descriptor().FullName())
+}
+
+// MarshalReferrer exports the VerificationSnapshot into a RecordReferrer.
+func (v *VerificationSnapshot) MarshalReferrer() (*corev1.RecordReferrer, error) {
+	if v == nil {
+		return nil, errors.New("verification snapshot is nil")
+	}
+
+	// Use decoder to convert proto message to structpb
+	data, err := decoder.StructToProto(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert verification snapshot to struct: %w", err)
+	}
+
+	return &corev1.RecordReferrer{
+		Type: v.ReferrerType(),
+		Data: data,
+	}, nil
+}
+
+// UnmarshalReferrer loads the VerificationSnapshot from a RecordReferrer.
+func (v *VerificationSnapshot) UnmarshalReferrer(ref *corev1.RecordReferrer) error {
+	if ref == nil || ref.GetData() == nil {
+		return errors.New("referrer or data is nil")
+	}
+
+	// Use decoder to convert structpb to proto message
+	decoded, err := decoder.ProtoToStruct[VerificationSnapshot](ref.GetData())
+	if err != nil {
+		return fmt.Errorf("failed to decode verification snapshot from referrer: %w", err)
+	}
+
+	// Copy fields individually to avoid copying the lock
+	v.VerifiedAt = decoded.GetVerifiedAt()
+	v.CreatedBy = decoded.GetCreatedBy()
+	v.SignatureVerified = decoded.GetSignatureVerified()
+	v.SignatureError = decoded.SignatureError
+	v.SignatureCount = decoded.GetSignatureCount()
+	v.RevokedCount = decoded.GetRevokedCount()
+	v.SignatureBundles = decoded.GetSignatureBundles()
+	v.SchemaValid = decoded.GetSchemaValid()
+	v.SchemaErrors = decoded.GetSchemaErrors()
+	v.SchemaVersion = decoded.GetSchemaVersion()
+	v.RulesVersion = decoded.GetRulesVersion()
+
+	return nil
+}
@@ -0,0 +1,9 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+// RehydratedMetadataKey is the gRPC trailer metadata key listing the CIDs of records
+// that were restored from the archive storage class to serve a Pull request.
+// Pulling archived records is slower than pulling records from the primary storage.
+const RehydratedMetadataKey = "x-dir-rehydrated"
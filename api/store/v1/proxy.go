@@ -0,0 +1,18 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+// ProxiedFromMetadataKey is the gRPC trailer metadata key listing the upstream Directories
+// from which records were fetched by the pull-through proxy to serve a Pull request.
+const ProxiedFromMetadataKey = "x-dir-proxied-from"
+
+// Annotations added to the metadata returned by Lookup for records that were
+// fetched from an upstream Directory by the pull-through proxy.
+const (
+	// ProxiedFromAnnotation is the address of the upstream Directory the record was fetched from.
+	ProxiedFromAnnotation = "org.agntcy.dir/proxied-from"
+
+	// ProxiedAtAnnotation is the RFC 3339 time at which the record was fetched.
+	ProxiedAtAnnotation = "org.agntcy.dir/proxied-at"
+)
@@ -26,6 +26,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ConsistencyDiscrepancyType is the kind of a consistency discrepancy.
+type ConsistencyDiscrepancyType int32
+
+const (
+	// Unknown discrepancy.
+	ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_UNSPECIFIED ConsistencyDiscrepancyType = 0
+	// Record is in the search database but missing from the content store.
+	ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_INDEXED_MISSING_BLOB ConsistencyDiscrepancyType = 1
+	// Record is in the content store but missing from the search database.
+	ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_STORED_UNINDEXED ConsistencyDiscrepancyType = 2
+	// Record is published to the routing datastore but missing from the content store.
+	ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_PUBLISHED_MISSING ConsistencyDiscrepancyType = 3
+)
+
+// Enum value maps for ConsistencyDiscrepancyType.
+var (
+	ConsistencyDiscrepancyType_name = map[int32]string{
+		0: "CONSISTENCY_DISCREPANCY_TYPE_UNSPECIFIED",
+		1: "CONSISTENCY_DISCREPANCY_TYPE_INDEXED_MISSING_BLOB",
+		2: "CONSISTENCY_DISCREPANCY_TYPE_STORED_UNINDEXED",
+		3: "CONSISTENCY_DISCREPANCY_TYPE_PUBLISHED_MISSING",
+	}
+	ConsistencyDiscrepancyType_value = map[string]int32{
+		"CONSISTENCY_DISCREPANCY_TYPE_UNSPECIFIED":          0,
+		"CONSISTENCY_DISCREPANCY_TYPE_INDEXED_MISSING_BLOB": 1,
+		"CONSISTENCY_DISCREPANCY_TYPE_STORED_UNINDEXED":     2,
+		"CONSISTENCY_DISCREPANCY_TYPE_PUBLISHED_MISSING":    3,
+	}
+)
+
+func (x ConsistencyDiscrepancyType) Enum() *ConsistencyDiscrepancyType {
+	p := new(ConsistencyDiscrepancyType)
+	*p = x
+	return p
+}
+
+func (x ConsistencyDiscrepancyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsistencyDiscrepancyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_agntcy_dir_store_v1_store_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ConsistencyDiscrepancyType) Type() protoreflect.EnumType {
+	return &file_agntcy_dir_store_v1_store_service_proto_enumTypes[0]
+}
+
+func (x ConsistencyDiscrepancyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsistencyDiscrepancyType.Descriptor instead.
+func (ConsistencyDiscrepancyType) EnumDescriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{0}
+}
+
 // PushReferrerRequest represents a record with optional OCI artifacts for push operations.
 type PushReferrerRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -136,6 +193,79 @@ func (x *PushReferrerResponse) GetErrorMessage() string {
 	return ""
 }
 
+// PushManyResponse is the result of pushing a single record with PushMany.
+type PushManyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the record in the request stream, starting at 0
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Reference of the stored record, set if the push succeeded
+	RecordRef *v1.RecordRef `protobuf:"bytes,2,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	// Error message if the record could not be validated or stored
+	ErrorMessage *string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	// gRPC status code of the error, set together with error_message
+	ErrorCode     uint32 `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushManyResponse) Reset() {
+	*x = PushManyResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushManyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushManyResponse) ProtoMessage() {}
+
+func (x *PushManyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushManyResponse.ProtoReflect.Descriptor instead.
+func (*PushManyResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PushManyResponse) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PushManyResponse) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+func (x *PushManyResponse) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *PushManyResponse) GetErrorCode() uint32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
 // PullReferrerRequest represents a record with optional OCI artifacts for pull operations.
 type PullReferrerRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -150,7 +280,7 @@ type PullReferrerRequest struct {
 
 func (x *PullReferrerRequest) Reset() {
 	*x = PullReferrerRequest{}
-	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[2]
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -162,7 +292,7 @@ func (x *PullReferrerRequest) String() string {
 func (*PullReferrerRequest) ProtoMessage() {}
 
 func (x *PullReferrerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[2]
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,7 +305,7 @@ func (x *PullReferrerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullReferrerRequest.ProtoReflect.Descriptor instead.
 func (*PullReferrerRequest) Descriptor() ([]byte, []int) {
-	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{2}
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PullReferrerRequest) GetRecordRef() *v1.RecordRef {
@@ -203,7 +333,7 @@ type PullReferrerResponse struct {
 
 func (x *PullReferrerResponse) Reset() {
 	*x = PullReferrerResponse{}
-	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[3]
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -215,7 +345,7 @@ func (x *PullReferrerResponse) String() string {
 func (*PullReferrerResponse) ProtoMessage() {}
 
 func (x *PullReferrerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[3]
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +358,7 @@ func (x *PullReferrerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullReferrerResponse.ProtoReflect.Descriptor instead.
 func (*PullReferrerResponse) Descriptor() ([]byte, []int) {
-	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{3}
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PullReferrerResponse) GetReferrer() *v1.RecordReferrer {
@@ -238,162 +368,3052 @@ func (x *PullReferrerResponse) GetReferrer() *v1.RecordReferrer {
 	return nil
 }
 
-var File_agntcy_dir_store_v1_store_service_proto protoreflect.FileDescriptor
+// PushBundleRequest contains a record together with its signature and attestations.
+type PushBundleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Record to be stored
+	Record *v1.Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	// Signature of the record.
+	// Must be of type agntcy.dir.sign.v1.Signature.
+	Signature *v1.RecordReferrer `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Public key used to verify the signature, if the record was signed with a key.
+	// Must be of type agntcy.dir.sign.v1.PublicKey.
+	PublicKey *v1.RecordReferrer `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Attestations about the record, such as provenance or SBOM statements.
+	// Each must be of type agntcy.dir.core.v1.Attestation.
+	Attestations  []*v1.RecordReferrer `protobuf:"bytes,4,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var file_agntcy_dir_store_v1_store_service_proto_rawDesc = string([]byte{
-	0x0a, 0x27, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x2f, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x61, 0x67, 0x6e, 0x74, 0x63,
-	0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f,
-	0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
-	0x76, 0x31, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x01, 0x0a,
-	0x13, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72,
-	0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63,
-	0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
-	0x65, 0x66, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69,
-	0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
-	0x65, 0x72, 0x22, 0x6c, 0x0a, 0x14, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10,
-	0x0a, 0x0e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x8f, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
-	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x09, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
-	0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0x56, 0x0a, 0x14, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61,
-	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72,
-	0x52, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x32, 0xfe, 0x03, 0x0a, 0x0c, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x50,
-	0x75, 0x73, 0x68, 0x12, 0x1a, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x1a,
-	0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x28, 0x01,
-	0x30, 0x01, 0x12, 0x45, 0x0a, 0x04, 0x50, 0x75, 0x6c, 0x6c, 0x12, 0x1d, 0x2e, 0x61, 0x67, 0x6e,
-	0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x1a, 0x1a, 0x2e, 0x61, 0x67, 0x6e, 0x74,
-	0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x06, 0x4c, 0x6f, 0x6f,
-	0x6b, 0x75, 0x70, 0x12, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
-	0x65, 0x66, 0x1a, 0x1e, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d, 0x65,
-	0x74, 0x61, 0x28, 0x01, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x12, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x67, 0x0a, 0x0c, 0x50, 0x75, 0x73,
-	0x68, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x61, 0x67, 0x6e, 0x74,
-	0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01,
-	0x30, 0x01, 0x12, 0x67, 0x0a, 0x0c, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72,
-	0x65, 0x72, 0x12, 0x28, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61,
-	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x42, 0xbf, 0x01, 0x0a, 0x17,
-	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x22, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f,
-	0x64, 0x69, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x41, 0x44, 0x53, 0xaa, 0x02, 0x13, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e,
-	0x44, 0x69, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x41,
-	0x67, 0x6e, 0x74, 0x63, 0x79, 0x5c, 0x44, 0x69, 0x72, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x1f, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x5c, 0x44, 0x69, 0x72, 0x5c,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x3a, 0x3a, 0x44,
-	0x69, 0x72, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
-})
+func (x *PushBundleRequest) Reset() {
+	*x = PushBundleRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_agntcy_dir_store_v1_store_service_proto_rawDescOnce sync.Once
-	file_agntcy_dir_store_v1_store_service_proto_rawDescData []byte
-)
+func (x *PushBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP() []byte {
-	file_agntcy_dir_store_v1_store_service_proto_rawDescOnce.Do(func() {
-		file_agntcy_dir_store_v1_store_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agntcy_dir_store_v1_store_service_proto_rawDesc), len(file_agntcy_dir_store_v1_store_service_proto_rawDesc)))
-	})
-	return file_agntcy_dir_store_v1_store_service_proto_rawDescData
+func (*PushBundleRequest) ProtoMessage() {}
+
+func (x *PushBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_agntcy_dir_store_v1_store_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_agntcy_dir_store_v1_store_service_proto_goTypes = []any{
-	(*PushReferrerRequest)(nil),  // 0: agntcy.dir.store.v1.PushReferrerRequest
-	(*PushReferrerResponse)(nil), // 1: agntcy.dir.store.v1.PushReferrerResponse
-	(*PullReferrerRequest)(nil),  // 2: agntcy.dir.store.v1.PullReferrerRequest
-	(*PullReferrerResponse)(nil), // 3: agntcy.dir.store.v1.PullReferrerResponse
-	(*v1.RecordRef)(nil),         // 4: agntcy.dir.core.v1.RecordRef
-	(*v1.RecordReferrer)(nil),    // 5: agntcy.dir.core.v1.RecordReferrer
-	(*v1.Record)(nil),            // 6: agntcy.dir.core.v1.Record
-	(*v1.RecordMeta)(nil),        // 7: agntcy.dir.core.v1.RecordMeta
-	(*emptypb.Empty)(nil),        // 8: google.protobuf.Empty
+// Deprecated: Use PushBundleRequest.ProtoReflect.Descriptor instead.
+func (*PushBundleRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{5}
 }
-var file_agntcy_dir_store_v1_store_service_proto_depIdxs = []int32{
-	4,  // 0: agntcy.dir.store.v1.PushReferrerRequest.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
-	5,  // 1: agntcy.dir.store.v1.PushReferrerRequest.referrer:type_name -> agntcy.dir.core.v1.RecordReferrer
-	4,  // 2: agntcy.dir.store.v1.PullReferrerRequest.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
-	5,  // 3: agntcy.dir.store.v1.PullReferrerResponse.referrer:type_name -> agntcy.dir.core.v1.RecordReferrer
-	6,  // 4: agntcy.dir.store.v1.StoreService.Push:input_type -> agntcy.dir.core.v1.Record
-	4,  // 5: agntcy.dir.store.v1.StoreService.Pull:input_type -> agntcy.dir.core.v1.RecordRef
-	4,  // 6: agntcy.dir.store.v1.StoreService.Lookup:input_type -> agntcy.dir.core.v1.RecordRef
-	4,  // 7: agntcy.dir.store.v1.StoreService.Delete:input_type -> agntcy.dir.core.v1.RecordRef
-	0,  // 8: agntcy.dir.store.v1.StoreService.PushReferrer:input_type -> agntcy.dir.store.v1.PushReferrerRequest
-	2,  // 9: agntcy.dir.store.v1.StoreService.PullReferrer:input_type -> agntcy.dir.store.v1.PullReferrerRequest
-	4,  // 10: agntcy.dir.store.v1.StoreService.Push:output_type -> agntcy.dir.core.v1.RecordRef
-	6,  // 11: agntcy.dir.store.v1.StoreService.Pull:output_type -> agntcy.dir.core.v1.Record
-	7,  // 12: agntcy.dir.store.v1.StoreService.Lookup:output_type -> agntcy.dir.core.v1.RecordMeta
-	8,  // 13: agntcy.dir.store.v1.StoreService.Delete:output_type -> google.protobuf.Empty
-	1,  // 14: agntcy.dir.store.v1.StoreService.PushReferrer:output_type -> agntcy.dir.store.v1.PushReferrerResponse
-	3,  // 15: agntcy.dir.store.v1.StoreService.PullReferrer:output_type -> agntcy.dir.store.v1.PullReferrerResponse
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+
+func (x *PushBundleRequest) GetRecord() *v1.Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
 }
 
-func init() { file_agntcy_dir_store_v1_store_service_proto_init() }
-func file_agntcy_dir_store_v1_store_service_proto_init() {
-	if File_agntcy_dir_store_v1_store_service_proto != nil {
-		return
+func (x *PushBundleRequest) GetSignature() *v1.RecordReferrer {
+	if x != nil {
+		return x.Signature
 	}
-	file_agntcy_dir_store_v1_store_service_proto_msgTypes[1].OneofWrappers = []any{}
-	file_agntcy_dir_store_v1_store_service_proto_msgTypes[2].OneofWrappers = []any{}
+	return nil
+}
+
+func (x *PushBundleRequest) GetPublicKey() *v1.RecordReferrer {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *PushBundleRequest) GetAttestations() []*v1.RecordReferrer {
+	if x != nil {
+		return x.Attestations
+	}
+	return nil
+}
+
+// PushBundleResponse is returned after the complete bundle has been stored.
+type PushBundleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Reference of the stored record
+	RecordRef     *v1.RecordRef `protobuf:"bytes,1,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushBundleResponse) Reset() {
+	*x = PushBundleResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushBundleResponse) ProtoMessage() {}
+
+func (x *PushBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushBundleResponse.ProtoReflect.Descriptor instead.
+func (*PushBundleResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PushBundleResponse) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+// ApplyTransactionRequest contains the operations to apply atomically.
+type ApplyTransactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Operations to apply.
+	// A record may only be referenced by a single operation.
+	Operations    []*TransactionOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyTransactionRequest) Reset() {
+	*x = ApplyTransactionRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyTransactionRequest) ProtoMessage() {}
+
+func (x *ApplyTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ApplyTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApplyTransactionRequest) GetOperations() []*TransactionOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+// TransactionOperation is a single operation within a transaction.
+type TransactionOperation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*TransactionOperation_Push
+	//	*TransactionOperation_Delete
+	Operation     isTransactionOperation_Operation `protobuf_oneof:"operation"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionOperation) Reset() {
+	*x = TransactionOperation{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionOperation) ProtoMessage() {}
+
+func (x *TransactionOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionOperation.ProtoReflect.Descriptor instead.
+func (*TransactionOperation) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TransactionOperation) GetOperation() isTransactionOperation_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *TransactionOperation) GetPush() *v1.Record {
+	if x != nil {
+		if x, ok := x.Operation.(*TransactionOperation_Push); ok {
+			return x.Push
+		}
+	}
+	return nil
+}
+
+func (x *TransactionOperation) GetDelete() *v1.RecordRef {
+	if x != nil {
+		if x, ok := x.Operation.(*TransactionOperation_Delete); ok {
+			return x.Delete
+		}
+	}
+	return nil
+}
+
+type isTransactionOperation_Operation interface {
+	isTransactionOperation_Operation()
+}
+
+type TransactionOperation_Push struct {
+	// Record to be stored
+	Push *v1.Record `protobuf:"bytes,1,opt,name=push,proto3,oneof"`
+}
+
+type TransactionOperation_Delete struct {
+	// Reference of the record to be deleted
+	Delete *v1.RecordRef `protobuf:"bytes,2,opt,name=delete,proto3,oneof"`
+}
+
+func (*TransactionOperation_Push) isTransactionOperation_Operation() {}
+
+func (*TransactionOperation_Delete) isTransactionOperation_Operation() {}
+
+// ApplyTransactionResponse is returned after all operations have been applied.
+type ApplyTransactionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// References of the pushed records, in the order of the push operations
+	PushedRefs []*v1.RecordRef `protobuf:"bytes,1,rep,name=pushed_refs,json=pushedRefs,proto3" json:"pushed_refs,omitempty"`
+	// References of the deleted records, in the order of the delete operations
+	DeletedRefs   []*v1.RecordRef `protobuf:"bytes,2,rep,name=deleted_refs,json=deletedRefs,proto3" json:"deleted_refs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyTransactionResponse) Reset() {
+	*x = ApplyTransactionResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyTransactionResponse) ProtoMessage() {}
+
+func (x *ApplyTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyTransactionResponse.ProtoReflect.Descriptor instead.
+func (*ApplyTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ApplyTransactionResponse) GetPushedRefs() []*v1.RecordRef {
+	if x != nil {
+		return x.PushedRefs
+	}
+	return nil
+}
+
+func (x *ApplyTransactionResponse) GetDeletedRefs() []*v1.RecordRef {
+	if x != nil {
+		return x.DeletedRefs
+	}
+	return nil
+}
+
+// GetDependenciesRequest specifies the record to resolve dependencies for.
+type GetDependenciesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Record reference
+	RecordRef *v1.RecordRef `protobuf:"bytes,1,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	// Whether to resolve dependencies transitively.
+	// If false, only direct dependencies are returned.
+	Recursive     bool `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDependenciesRequest) Reset() {
+	*x = GetDependenciesRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDependenciesRequest) ProtoMessage() {}
+
+func (x *GetDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*GetDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetDependenciesRequest) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+func (x *GetDependenciesRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+// GetDependenciesResponse contains the dependency graph of a record.
+type GetDependenciesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// References reachable from the record, in traversal order
+	References []*RecordReference `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
+	// Reference cycles found while traversing the graph
+	Cycles        []*RecordReferenceCycle `protobuf:"bytes,2,rep,name=cycles,proto3" json:"cycles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDependenciesResponse) Reset() {
+	*x = GetDependenciesResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDependenciesResponse) ProtoMessage() {}
+
+func (x *GetDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*GetDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetDependenciesResponse) GetReferences() []*RecordReference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *GetDependenciesResponse) GetCycles() []*RecordReferenceCycle {
+	if x != nil {
+		return x.Cycles
+	}
+	return nil
+}
+
+// GetDependentsRequest specifies the record to resolve dependents for.
+type GetDependentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Record reference
+	RecordRef *v1.RecordRef `protobuf:"bytes,1,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	// Whether to resolve dependents transitively.
+	// If false, only direct dependents are returned.
+	Recursive     bool `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDependentsRequest) Reset() {
+	*x = GetDependentsRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDependentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDependentsRequest) ProtoMessage() {}
+
+func (x *GetDependentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDependentsRequest.ProtoReflect.Descriptor instead.
+func (*GetDependentsRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetDependentsRequest) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+func (x *GetDependentsRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+// GetDependentsResponse contains the reverse dependency graph of a record.
+type GetDependentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// References leading to the record, in traversal order
+	References []*RecordReference `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
+	// Reference cycles found while traversing the graph
+	Cycles        []*RecordReferenceCycle `protobuf:"bytes,2,rep,name=cycles,proto3" json:"cycles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDependentsResponse) Reset() {
+	*x = GetDependentsResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDependentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDependentsResponse) ProtoMessage() {}
+
+func (x *GetDependentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDependentsResponse.ProtoReflect.Descriptor instead.
+func (*GetDependentsResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetDependentsResponse) GetReferences() []*RecordReference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *GetDependentsResponse) GetCycles() []*RecordReferenceCycle {
+	if x != nil {
+		return x.Cycles
+	}
+	return nil
+}
+
+// RecordReference is an edge of the record reference graph.
+type RecordReference struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CID of the referencing record
+	RecordCid string `protobuf:"bytes,1,opt,name=record_cid,json=recordCid,proto3" json:"record_cid,omitempty"`
+	// CID of the referenced record
+	ReferencedCid string `protobuf:"bytes,2,opt,name=referenced_cid,json=referencedCid,proto3" json:"referenced_cid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordReference) Reset() {
+	*x = RecordReference{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordReference) ProtoMessage() {}
+
+func (x *RecordReference) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordReference.ProtoReflect.Descriptor instead.
+func (*RecordReference) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RecordReference) GetRecordCid() string {
+	if x != nil {
+		return x.RecordCid
+	}
+	return ""
+}
+
+func (x *RecordReference) GetReferencedCid() string {
+	if x != nil {
+		return x.ReferencedCid
+	}
+	return ""
+}
+
+// RecordReferenceCycle is a cycle in the record reference graph.
+type RecordReferenceCycle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CIDs of the records forming the cycle, where each record
+	// references the next one and the last one references the first one
+	Cids          []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordReferenceCycle) Reset() {
+	*x = RecordReferenceCycle{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordReferenceCycle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordReferenceCycle) ProtoMessage() {}
+
+func (x *RecordReferenceCycle) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordReferenceCycle.ProtoReflect.Descriptor instead.
+func (*RecordReferenceCycle) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RecordReferenceCycle) GetCids() []string {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+// ResolveLocatorRequest specifies the record to resolve a locator for.
+type ResolveLocatorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Record reference
+	RecordRef *v1.RecordRef `protobuf:"bytes,1,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	// Only consider locators of this type, e.g. "docker_image".
+	// All locators are considered if empty.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Regions preferred by the caller, most preferred first, e.g. "eu-west-1".
+	// Regions are matched case-insensitively against the "region" annotation of locators.
+	// Defaults to the region of the server, if configured.
+	PreferredRegions []string `protobuf:"bytes,3,rep,name=preferred_regions,json=preferredRegions,proto3" json:"preferred_regions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ResolveLocatorRequest) Reset() {
+	*x = ResolveLocatorRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveLocatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveLocatorRequest) ProtoMessage() {}
+
+func (x *ResolveLocatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveLocatorRequest.ProtoReflect.Descriptor instead.
+func (*ResolveLocatorRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ResolveLocatorRequest) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+func (x *ResolveLocatorRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ResolveLocatorRequest) GetPreferredRegions() []string {
+	if x != nil {
+		return x.PreferredRegions
+	}
+	return nil
+}
+
+// ResolveLocatorResponse is the locator selected for the caller.
+// Locators in the most preferred region are selected first, then locators
+// without region hint, and finally locators in other regions.
+// Ties are broken by the order of the locators in the record.
+type ResolveLocatorResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Locator type
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Locator URL
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Region hint of the locator, empty if the locator has none
+	Region string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	// Whether the locator is in one of the preferred regions
+	Preferred     bool `protobuf:"varint,4,opt,name=preferred,proto3" json:"preferred,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveLocatorResponse) Reset() {
+	*x = ResolveLocatorResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveLocatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveLocatorResponse) ProtoMessage() {}
+
+func (x *ResolveLocatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveLocatorResponse.ProtoReflect.Descriptor instead.
+func (*ResolveLocatorResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResolveLocatorResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ResolveLocatorResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ResolveLocatorResponse) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ResolveLocatorResponse) GetPreferred() bool {
+	if x != nil {
+		return x.Preferred
+	}
+	return false
+}
+
+// RecordInfoRequest specifies the record to describe.
+type RecordInfoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Record reference
+	RecordRef     *v1.RecordRef `protobuf:"bytes,1,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordInfoRequest) Reset() {
+	*x = RecordInfoRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordInfoRequest) ProtoMessage() {}
+
+func (x *RecordInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordInfoRequest.ProtoReflect.Descriptor instead.
+func (*RecordInfoRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RecordInfoRequest) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+// RecordInfoResponse is a consolidated view of the record state on the server.
+type RecordInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Record reference
+	RecordRef *v1.RecordRef `protobuf:"bytes,1,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	// Record metadata resolved from the store.
+	// Not set if the record is not present in the store.
+	Meta *v1.RecordMeta `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Whether the record is present in the store.
+	Stored bool `protobuf:"varint,3,opt,name=stored,proto3" json:"stored,omitempty"`
+	// Whether the record is indexed in the search database.
+	Indexed bool `protobuf:"varint,4,opt,name=indexed,proto3" json:"indexed,omitempty"`
+	// Syncs that included the record, if it was synced from a remote directory.
+	SyncOrigins []*RecordSyncOrigin `protobuf:"bytes,5,rep,name=sync_origins,json=syncOrigins,proto3" json:"sync_origins,omitempty"`
+	// Whether the record is published to the routing network.
+	Published bool `protobuf:"varint,6,opt,name=published,proto3" json:"published,omitempty"`
+	// Labels announced for the record on the routing network.
+	Labels []string `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty"`
+	// Signature and verification state of the record.
+	Signature *RecordSignatureInfo `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Number of times the record has been pulled from this server.
+	PullCount     uint64 `protobuf:"varint,9,opt,name=pull_count,json=pullCount,proto3" json:"pull_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordInfoResponse) Reset() {
+	*x = RecordInfoResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordInfoResponse) ProtoMessage() {}
+
+func (x *RecordInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordInfoResponse.ProtoReflect.Descriptor instead.
+func (*RecordInfoResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RecordInfoResponse) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+func (x *RecordInfoResponse) GetMeta() *v1.RecordMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RecordInfoResponse) GetStored() bool {
+	if x != nil {
+		return x.Stored
+	}
+	return false
+}
+
+func (x *RecordInfoResponse) GetIndexed() bool {
+	if x != nil {
+		return x.Indexed
+	}
+	return false
+}
+
+func (x *RecordInfoResponse) GetSyncOrigins() []*RecordSyncOrigin {
+	if x != nil {
+		return x.SyncOrigins
+	}
+	return nil
+}
+
+func (x *RecordInfoResponse) GetPublished() bool {
+	if x != nil {
+		return x.Published
+	}
+	return false
+}
+
+func (x *RecordInfoResponse) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *RecordInfoResponse) GetSignature() *RecordSignatureInfo {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *RecordInfoResponse) GetPullCount() uint64 {
+	if x != nil {
+		return x.PullCount
+	}
+	return 0
+}
+
+// RecordSyncOrigin describes a sync operation that included the record.
+type RecordSyncOrigin struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sync ID
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// Remote directory URL the record was synced from
+	RemoteDirectoryUrl string `protobuf:"bytes,2,opt,name=remote_directory_url,json=remoteDirectoryUrl,proto3" json:"remote_directory_url,omitempty"`
+	// Status of the sync operation
+	Status        SyncStatus `protobuf:"varint,3,opt,name=status,proto3,enum=agntcy.dir.store.v1.SyncStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordSyncOrigin) Reset() {
+	*x = RecordSyncOrigin{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSyncOrigin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSyncOrigin) ProtoMessage() {}
+
+func (x *RecordSyncOrigin) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSyncOrigin.ProtoReflect.Descriptor instead.
+func (*RecordSyncOrigin) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RecordSyncOrigin) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *RecordSyncOrigin) GetRemoteDirectoryUrl() string {
+	if x != nil {
+		return x.RemoteDirectoryUrl
+	}
+	return ""
+}
+
+func (x *RecordSyncOrigin) GetStatus() SyncStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SyncStatus_SYNC_STATUS_UNSPECIFIED
+}
+
+// RecordSignatureInfo describes the signatures attached to the record.
+type RecordSignatureInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of signatures attached to the record
+	SignatureCount uint32 `protobuf:"varint,1,opt,name=signature_count,json=signatureCount,proto3" json:"signature_count,omitempty"`
+	// Whether the record signature was verified by the server
+	Verified bool `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Optional error message if verification could not be performed
+	VerificationError *string `protobuf:"bytes,3,opt,name=verification_error,json=verificationError,proto3,oneof" json:"verification_error,omitempty"`
+	// Number of signatures attached to the record that have been revoked
+	RevokedCount  uint32 `protobuf:"varint,4,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordSignatureInfo) Reset() {
+	*x = RecordSignatureInfo{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSignatureInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSignatureInfo) ProtoMessage() {}
+
+func (x *RecordSignatureInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSignatureInfo.ProtoReflect.Descriptor instead.
+func (*RecordSignatureInfo) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RecordSignatureInfo) GetSignatureCount() uint32 {
+	if x != nil {
+		return x.SignatureCount
+	}
+	return 0
+}
+
+func (x *RecordSignatureInfo) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *RecordSignatureInfo) GetVerificationError() string {
+	if x != nil && x.VerificationError != nil {
+		return *x.VerificationError
+	}
+	return ""
+}
+
+func (x *RecordSignatureInfo) GetRevokedCount() uint32 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+// ValidateStoredRequest selects the stored records to re-validate.
+type ValidateStoredRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// References of the records to re-validate.
+	// If empty, all stored records are re-validated.
+	RecordRefs []*v1.RecordRef `protobuf:"bytes,1,rep,name=record_refs,json=recordRefs,proto3" json:"record_refs,omitempty"`
+	// Only stream results for records that no longer validate.
+	OnlyInvalid   bool `protobuf:"varint,2,opt,name=only_invalid,json=onlyInvalid,proto3" json:"only_invalid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateStoredRequest) Reset() {
+	*x = ValidateStoredRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateStoredRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateStoredRequest) ProtoMessage() {}
+
+func (x *ValidateStoredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateStoredRequest.ProtoReflect.Descriptor instead.
+func (*ValidateStoredRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ValidateStoredRequest) GetRecordRefs() []*v1.RecordRef {
+	if x != nil {
+		return x.RecordRefs
+	}
+	return nil
+}
+
+func (x *ValidateStoredRequest) GetOnlyInvalid() bool {
+	if x != nil {
+		return x.OnlyInvalid
+	}
+	return false
+}
+
+// ValidateStoredResponse is the validation result of a single stored record.
+type ValidateStoredResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Record reference
+	RecordRef *v1.RecordRef `protobuf:"bytes,1,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	// Whether the record validates against the current rules
+	Valid bool `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Validation errors if the record does not validate
+	Errors []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Schema version of the record
+	SchemaVersion string `protobuf:"bytes,4,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// Whether the record was valid before and no longer validates
+	Drift bool `protobuf:"varint,5,opt,name=drift,proto3" json:"drift,omitempty"`
+	// Version of the validation rules the record was validated with
+	RulesVersion  string `protobuf:"bytes,6,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateStoredResponse) Reset() {
+	*x = ValidateStoredResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateStoredResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateStoredResponse) ProtoMessage() {}
+
+func (x *ValidateStoredResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateStoredResponse.ProtoReflect.Descriptor instead.
+func (*ValidateStoredResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ValidateStoredResponse) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+func (x *ValidateStoredResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateStoredResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ValidateStoredResponse) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *ValidateStoredResponse) GetDrift() bool {
+	if x != nil {
+		return x.Drift
+	}
+	return false
+}
+
+func (x *ValidateStoredResponse) GetRulesVersion() string {
+	if x != nil {
+		return x.RulesVersion
+	}
+	return ""
+}
+
+// CheckConsistencyRequest configures a consistency check.
+type CheckConsistencyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Repair the discrepancies between the search database and the content store.
+	// Stored records missing from the search database are indexed, and indexed
+	// records missing from the content store are removed from the search database.
+	Repair bool `protobuf:"varint,1,opt,name=repair,proto3" json:"repair,omitempty"`
+	// If set, check the consistency in the background and return immediately
+	// with the ID of the operation, which can be tracked with the OperationService.
+	// The CheckConsistencyResponse is the response of the operation once it succeeded.
+	Async         bool `protobuf:"varint,2,opt,name=async,proto3" json:"async,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckConsistencyRequest) Reset() {
+	*x = CheckConsistencyRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConsistencyRequest) ProtoMessage() {}
+
+func (x *CheckConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*CheckConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CheckConsistencyRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+func (x *CheckConsistencyRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+// CheckConsistencyResponse is the report of a consistency check.
+type CheckConsistencyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of records in the search database
+	IndexedRecords uint64 `protobuf:"varint,1,opt,name=indexed_records,json=indexedRecords,proto3" json:"indexed_records,omitempty"`
+	// Number of records in the content store
+	StoredRecords uint64 `protobuf:"varint,2,opt,name=stored_records,json=storedRecords,proto3" json:"stored_records,omitempty"`
+	// Number of records published to the routing datastore
+	PublishedRecords uint64 `protobuf:"varint,3,opt,name=published_records,json=publishedRecords,proto3" json:"published_records,omitempty"`
+	// Discrepancies found between the search database, content store and routing datastore
+	Discrepancies []*ConsistencyDiscrepancy `protobuf:"bytes,4,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	// ID of the operation checking the consistency, set if async was set.
+	OperationId   string `protobuf:"bytes,5,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckConsistencyResponse) Reset() {
+	*x = CheckConsistencyResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckConsistencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConsistencyResponse) ProtoMessage() {}
+
+func (x *CheckConsistencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConsistencyResponse.ProtoReflect.Descriptor instead.
+func (*CheckConsistencyResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CheckConsistencyResponse) GetIndexedRecords() uint64 {
+	if x != nil {
+		return x.IndexedRecords
+	}
+	return 0
+}
+
+func (x *CheckConsistencyResponse) GetStoredRecords() uint64 {
+	if x != nil {
+		return x.StoredRecords
+	}
+	return 0
+}
+
+func (x *CheckConsistencyResponse) GetPublishedRecords() uint64 {
+	if x != nil {
+		return x.PublishedRecords
+	}
+	return 0
+}
+
+func (x *CheckConsistencyResponse) GetDiscrepancies() []*ConsistencyDiscrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+func (x *CheckConsistencyResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// GarbageCollectRequest configures a garbage collection of the content store.
+type GarbageCollectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// If set, collect the garbage in the background and return immediately
+	// with the ID of the operation, which can be tracked with the OperationService.
+	// The GarbageCollectResponse is the response of the operation once it succeeded.
+	Async         bool `protobuf:"varint,1,opt,name=async,proto3" json:"async,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GarbageCollectRequest) Reset() {
+	*x = GarbageCollectRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GarbageCollectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GarbageCollectRequest) ProtoMessage() {}
+
+func (x *GarbageCollectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GarbageCollectRequest.ProtoReflect.Descriptor instead.
+func (*GarbageCollectRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GarbageCollectRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+// GarbageCollectResponse is the report of a garbage collection.
+type GarbageCollectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of blobs removed from the content store
+	RemovedBlobs uint64 `protobuf:"varint,1,opt,name=removed_blobs,json=removedBlobs,proto3" json:"removed_blobs,omitempty"`
+	// Number of bytes reclaimed by the removed blobs
+	ReclaimedBytes uint64 `protobuf:"varint,2,opt,name=reclaimed_bytes,json=reclaimedBytes,proto3" json:"reclaimed_bytes,omitempty"`
+	// ID of the operation collecting the garbage, set if async was set.
+	OperationId   string `protobuf:"bytes,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GarbageCollectResponse) Reset() {
+	*x = GarbageCollectResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GarbageCollectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GarbageCollectResponse) ProtoMessage() {}
+
+func (x *GarbageCollectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GarbageCollectResponse.ProtoReflect.Descriptor instead.
+func (*GarbageCollectResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GarbageCollectResponse) GetRemovedBlobs() uint64 {
+	if x != nil {
+		return x.RemovedBlobs
+	}
+	return 0
+}
+
+func (x *GarbageCollectResponse) GetReclaimedBytes() uint64 {
+	if x != nil {
+		return x.ReclaimedBytes
+	}
+	return 0
+}
+
+func (x *GarbageCollectResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// ConsistencyDiscrepancy is a record missing from one of the record sources.
+type ConsistencyDiscrepancy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Record reference
+	RecordRef *v1.RecordRef `protobuf:"bytes,1,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	// Kind of the discrepancy
+	Type ConsistencyDiscrepancyType `protobuf:"varint,2,opt,name=type,proto3,enum=agntcy.dir.store.v1.ConsistencyDiscrepancyType" json:"type,omitempty"`
+	// Whether the discrepancy was repaired
+	Repaired bool `protobuf:"varint,3,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	// Error message if the discrepancy could not be repaired
+	RepairError   *string `protobuf:"bytes,4,opt,name=repair_error,json=repairError,proto3,oneof" json:"repair_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsistencyDiscrepancy) Reset() {
+	*x = ConsistencyDiscrepancy{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsistencyDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyDiscrepancy) ProtoMessage() {}
+
+func (x *ConsistencyDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyDiscrepancy.ProtoReflect.Descriptor instead.
+func (*ConsistencyDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ConsistencyDiscrepancy) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+func (x *ConsistencyDiscrepancy) GetType() ConsistencyDiscrepancyType {
+	if x != nil {
+		return x.Type
+	}
+	return ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_UNSPECIFIED
+}
+
+func (x *ConsistencyDiscrepancy) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+func (x *ConsistencyDiscrepancy) GetRepairError() string {
+	if x != nil && x.RepairError != nil {
+		return *x.RepairError
+	}
+	return ""
+}
+
+// UpdateRecordRequest specifies the record to patch and the patch to apply.
+type UpdateRecordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Reference of the record to patch
+	RecordRef *v1.RecordRef `protobuf:"bytes,1,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	// RFC 6902 JSON patch document applied to the record JSON
+	Patch         []byte `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecordRequest) Reset() {
+	*x = UpdateRecordRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecordRequest) ProtoMessage() {}
+
+func (x *UpdateRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecordRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecordRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateRecordRequest) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+func (x *UpdateRecordRequest) GetPatch() []byte {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+// UpdateRecordResponse describes the record created by applying the patch.
+type UpdateRecordResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Reference of the new record
+	RecordRef *v1.RecordRef `protobuf:"bytes,1,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	// Reference of the patched record
+	PreviousRecordRef *v1.RecordRef `protobuf:"bytes,2,opt,name=previous_record_ref,json=previousRecordRef,proto3" json:"previous_record_ref,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateRecordResponse) Reset() {
+	*x = UpdateRecordResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecordResponse) ProtoMessage() {}
+
+func (x *UpdateRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecordResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRecordResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateRecordResponse) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+func (x *UpdateRecordResponse) GetPreviousRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.PreviousRecordRef
+	}
+	return nil
+}
+
+// Alias is a mutable name:tag pointer to a record.
+type Alias struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the aliased record
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Tag of the alias, such as "stable" or "latest"
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Reference of the record the alias points to
+	RecordRef *v1.RecordRef `protobuf:"bytes,3,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	// Time the alias was last changed, formatted as RFC 3339
+	UpdatedTime string `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
+	// Identity that last changed the alias, empty without authentication
+	UpdatedBy     string `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alias) Reset() {
+	*x = Alias{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alias) ProtoMessage() {}
+
+func (x *Alias) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alias.ProtoReflect.Descriptor instead.
+func (*Alias) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Alias) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Alias) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *Alias) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+func (x *Alias) GetUpdatedTime() string {
+	if x != nil {
+		return x.UpdatedTime
+	}
+	return ""
+}
+
+func (x *Alias) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+// AliasChange is an entry of the alias history.
+type AliasChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the aliased record
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Tag of the alias
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// CID the alias pointed to before the change, empty if it was created
+	PreviousCid string `protobuf:"bytes,3,opt,name=previous_cid,json=previousCid,proto3" json:"previous_cid,omitempty"`
+	// CID the alias points to after the change, empty if it was deleted
+	Cid string `protobuf:"bytes,4,opt,name=cid,proto3" json:"cid,omitempty"`
+	// Time of the change, formatted as RFC 3339
+	ChangedTime string `protobuf:"bytes,5,opt,name=changed_time,json=changedTime,proto3" json:"changed_time,omitempty"`
+	// Identity that changed the alias, empty without authentication
+	ChangedBy     string `protobuf:"bytes,6,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AliasChange) Reset() {
+	*x = AliasChange{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AliasChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AliasChange) ProtoMessage() {}
+
+func (x *AliasChange) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AliasChange.ProtoReflect.Descriptor instead.
+func (*AliasChange) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AliasChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AliasChange) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *AliasChange) GetPreviousCid() string {
+	if x != nil {
+		return x.PreviousCid
+	}
+	return ""
+}
+
+func (x *AliasChange) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *AliasChange) GetChangedTime() string {
+	if x != nil {
+		return x.ChangedTime
+	}
+	return ""
+}
+
+func (x *AliasChange) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+// SetAliasRequest specifies the alias to set and the record it points to.
+type SetAliasRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the aliased record, must match the name of the record
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Tag of the alias, as a docker tag
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Reference of the record to point the alias to
+	RecordRef *v1.RecordRef `protobuf:"bytes,3,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	// CID the alias is expected to point to, empty if it is expected not to exist.
+	// The alias is changed unconditionally if not set.
+	ExpectedCid   *string `protobuf:"bytes,4,opt,name=expected_cid,json=expectedCid,proto3,oneof" json:"expected_cid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAliasRequest) Reset() {
+	*x = SetAliasRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAliasRequest) ProtoMessage() {}
+
+func (x *SetAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAliasRequest.ProtoReflect.Descriptor instead.
+func (*SetAliasRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetAliasRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetAliasRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SetAliasRequest) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+func (x *SetAliasRequest) GetExpectedCid() string {
+	if x != nil && x.ExpectedCid != nil {
+		return *x.ExpectedCid
+	}
+	return ""
+}
+
+// SetAliasResponse describes the alias after the change.
+type SetAliasResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The alias after the change
+	Alias *Alias `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// CID the alias pointed to before the change, empty if it was created
+	PreviousCid   string `protobuf:"bytes,2,opt,name=previous_cid,json=previousCid,proto3" json:"previous_cid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAliasResponse) Reset() {
+	*x = SetAliasResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAliasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAliasResponse) ProtoMessage() {}
+
+func (x *SetAliasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAliasResponse.ProtoReflect.Descriptor instead.
+func (*SetAliasResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetAliasResponse) GetAlias() *Alias {
+	if x != nil {
+		return x.Alias
+	}
+	return nil
+}
+
+func (x *SetAliasResponse) GetPreviousCid() string {
+	if x != nil {
+		return x.PreviousCid
+	}
+	return ""
+}
+
+// DeleteAliasRequest specifies the alias to delete.
+type DeleteAliasRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the aliased record
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Tag of the alias
+	Tag           string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAliasRequest) Reset() {
+	*x = DeleteAliasRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAliasRequest) ProtoMessage() {}
+
+func (x *DeleteAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAliasRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAliasRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteAliasRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteAliasRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// ListAliasesRequest specifies the aliases to list.
+type ListAliasesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the aliased record, all aliases are listed if empty
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAliasesRequest) Reset() {
+	*x = ListAliasesRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAliasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAliasesRequest) ProtoMessage() {}
+
+func (x *ListAliasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAliasesRequest.ProtoReflect.Descriptor instead.
+func (*ListAliasesRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListAliasesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ListAliasesResponse contains the matching aliases.
+type ListAliasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Aliases       []*Alias               `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAliasesResponse) Reset() {
+	*x = ListAliasesResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAliasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAliasesResponse) ProtoMessage() {}
+
+func (x *ListAliasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAliasesResponse.ProtoReflect.Descriptor instead.
+func (*ListAliasesResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListAliasesResponse) GetAliases() []*Alias {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+// GetAliasHistoryRequest specifies the aliases to return the history of.
+type GetAliasHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the aliased record
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Tag of the alias, the history of all tags of the name is returned if empty
+	Tag           string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAliasHistoryRequest) Reset() {
+	*x = GetAliasHistoryRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAliasHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAliasHistoryRequest) ProtoMessage() {}
+
+func (x *GetAliasHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAliasHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetAliasHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetAliasHistoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetAliasHistoryRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// GetAliasHistoryResponse contains the changes of the aliases, oldest first.
+type GetAliasHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*AliasChange         `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAliasHistoryResponse) Reset() {
+	*x = GetAliasHistoryResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAliasHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAliasHistoryResponse) ProtoMessage() {}
+
+func (x *GetAliasHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAliasHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetAliasHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetAliasHistoryResponse) GetChanges() []*AliasChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// ResolveNameRequest specifies the record name and tag to resolve.
+type ResolveNameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the record
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Alias tag or version of the record, "latest" if empty
+	Tag           string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveNameRequest) Reset() {
+	*x = ResolveNameRequest{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveNameRequest) ProtoMessage() {}
+
+func (x *ResolveNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveNameRequest.ProtoReflect.Descriptor instead.
+func (*ResolveNameRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ResolveNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResolveNameRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// ResolveNameResponse contains the resolved record reference.
+type ResolveNameResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Reference of the resolved record
+	RecordRef *v1.RecordRef `protobuf:"bytes,1,opt,name=record_ref,json=recordRef,proto3" json:"record_ref,omitempty"`
+	// Whether the tag was resolved as an alias rather than a version
+	Alias         bool `protobuf:"varint,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveNameResponse) Reset() {
+	*x = ResolveNameResponse{}
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveNameResponse) ProtoMessage() {}
+
+func (x *ResolveNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_store_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveNameResponse.ProtoReflect.Descriptor instead.
+func (*ResolveNameResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ResolveNameResponse) GetRecordRef() *v1.RecordRef {
+	if x != nil {
+		return x.RecordRef
+	}
+	return nil
+}
+
+func (x *ResolveNameResponse) GetAlias() bool {
+	if x != nil {
+		return x.Alias
+	}
+	return false
+}
+
+var File_agntcy_dir_store_v1_store_service_proto protoreflect.FileDescriptor
+
+var file_agntcy_dir_store_v1_store_service_proto_rawDesc = string([]byte{
+	0x0a, 0x27, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x2f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x61, 0x67, 0x6e, 0x74, 0x63,
+	0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f,
+	0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x26, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x2f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0a,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52,
+	0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72,
+	0x52, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x22, 0x6c, 0x0a, 0x14, 0x50, 0x75,
+	0x73, 0x68, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0d,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x73,
+	0x68, 0x4d, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65,
+	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79,
+	0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x66, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8f, 0x01, 0x0a,
+	0x13, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63,
+	0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x66, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x56,
+	0x0a, 0x14, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63,
+	0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x08, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x22, 0x94, 0x02, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x40, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e,
+	0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x67,
+	0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52,
+	0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x52, 0x0a,
+	0x12, 0x50, 0x75, 0x73, 0x68, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65,
+	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79,
+	0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x66, 0x22, 0x64, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0a,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x04, 0x70, 0x75, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x00, 0x52, 0x04, 0x70, 0x75,
+	0x73, 0x68, 0x12, 0x37, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x66, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x18, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e,
+	0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x65,
+	0x64, 0x52, 0x65, 0x66, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67,
+	0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x66, 0x73, 0x22, 0x74, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64,
+	0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x66, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x22, 0xa2, 0x01,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x41, 0x0a, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x06, 0x63, 0x79, 0x63, 0x6c,
+	0x65, 0x73, 0x22, 0x72, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x09, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75,
+	0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63,
+	0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x44, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69,
+	0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e,
+	0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x79, 0x63, 0x6c,
+	0x65, 0x52, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x0f, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x43,
+	0x69, 0x64, 0x22, 0x2a, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x96,
+	0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x09, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x74, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x22, 0x51, 0x0a,
+	0x11, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e,
+	0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66,
+	0x22, 0x9f, 0x03, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67,
+	0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x66, 0x12, 0x32, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d,
+	0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0c, 0x73,
+	0x79, 0x6e, 0x63, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x79,
+	0x6e, 0x63, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x46, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x79, 0x6e,
+	0x63, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55,
+	0x72, 0x6c, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x13,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x12, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x7a, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e,
+	0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x66, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x69, 0x66, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x64, 0x72, 0x69, 0x66, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x47, 0x0a,
+	0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x8d, 0x02, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x51, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79,
+	0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70,
+	0x61, 0x6e, 0x63, 0x79, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x15, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x61, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x89, 0x01, 0x0a, 0x16, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x42, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0xf0, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x3c, 0x0a, 0x0a,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52,
+	0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x12, 0x43, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63,
+	0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65,
+	0x70, 0x61, 0x6e, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x72,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x09,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0xa3, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x09, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x12, 0x4d, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x66, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x66, 0x22, 0xad, 0x01, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74,
+	0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0xaa, 0x01, 0x0a, 0x0b, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x69, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x42, 0x79, 0x22, 0xae, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x3c, 0x0a, 0x0a,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52,
+	0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x12, 0x26, 0x0a, 0x0c, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x69, 0x64, 0x88,
+	0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x63, 0x69, 0x64, 0x22, 0x67, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e,
+	0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x69, 0x64, 0x22, 0x3a, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x28, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x6e,
+	0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x22,
+	0x3e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22,
+	0x55, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x67,
+	0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
+	0x61, 0x67, 0x22, 0x69, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x52, 0x09, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x2a, 0xe8, 0x01,
+	0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x73,
+	0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x28,
+	0x43, 0x4f, 0x4e, 0x53, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x43,
+	0x52, 0x45, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x35, 0x0a, 0x31, 0x43, 0x4f,
+	0x4e, 0x53, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x52, 0x45,
+	0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58,
+	0x45, 0x44, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x4c, 0x4f, 0x42, 0x10,
+	0x01, 0x12, 0x31, 0x0a, 0x2d, 0x43, 0x4f, 0x4e, 0x53, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x43, 0x59,
+	0x5f, 0x44, 0x49, 0x53, 0x43, 0x52, 0x45, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x5f, 0x55, 0x4e, 0x49, 0x4e, 0x44, 0x45, 0x58,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x32, 0x0a, 0x2e, 0x43, 0x4f, 0x4e, 0x53, 0x49, 0x53, 0x54, 0x45,
+	0x4e, 0x43, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x52, 0x45, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x5f, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x32, 0xca, 0x10, 0x0a, 0x0c, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x50, 0x75, 0x73,
+	0x68, 0x12, 0x1a, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x1a, 0x1d, 0x2e,
+	0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x28, 0x01, 0x30, 0x01,
+	0x12, 0x51, 0x0a, 0x08, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x1a, 0x2e, 0x61,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x1a, 0x25, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63,
+	0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x75, 0x73, 0x68, 0x4d, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x04, 0x50, 0x75, 0x6c, 0x6c, 0x12, 0x1d, 0x2e, 0x61, 0x67,
+	0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66, 0x1a, 0x1a, 0x2e, 0x61, 0x67, 0x6e,
+	0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x06, 0x4c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x12, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69,
+	0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x66, 0x1a, 0x1e, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d,
+	0x65, 0x74, 0x61, 0x28, 0x01, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x66,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x67, 0x0a, 0x0c, 0x50, 0x75,
+	0x73, 0x68, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x61, 0x67, 0x6e,
+	0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69,
+	0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x67, 0x0a, 0x0c, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5d, 0x0a, 0x0a,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x61, 0x67, 0x6e,
+	0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x50,
+	0x75, 0x73, 0x68, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x67, 0x6e, 0x74,
+	0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x75, 0x73, 0x68, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x10, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2b,
+	0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x67,
+	0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x67, 0x6e,
+	0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64,
+	0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6b, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x12, 0x2a, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x69,
+	0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x2a, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x10, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2c, 0x2e,
+	0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x67,
+	0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e, 0x47, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x2e, 0x61,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63,
+	0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64,
+	0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x08, 0x53, 0x65,
+	0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e,
+	0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x60, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x65, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x67,
+	0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2b, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63,
+	0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64,
+	0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x27, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x67,
+	0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xbf, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x67,
+	0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x42, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x44, 0x53,
+	0xaa, 0x02, 0x13, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x44, 0x69, 0x72, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x5c,
+	0x44, 0x69, 0x72, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x41,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x5c, 0x44, 0x69, 0x72, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x16, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x3a, 0x3a, 0x44, 0x69, 0x72, 0x3a, 0x3a, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_agntcy_dir_store_v1_store_service_proto_rawDescOnce sync.Once
+	file_agntcy_dir_store_v1_store_service_proto_rawDescData []byte
+)
+
+func file_agntcy_dir_store_v1_store_service_proto_rawDescGZIP() []byte {
+	file_agntcy_dir_store_v1_store_service_proto_rawDescOnce.Do(func() {
+		file_agntcy_dir_store_v1_store_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agntcy_dir_store_v1_store_service_proto_rawDesc), len(file_agntcy_dir_store_v1_store_service_proto_rawDesc)))
+	})
+	return file_agntcy_dir_store_v1_store_service_proto_rawDescData
+}
+
+var file_agntcy_dir_store_v1_store_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_agntcy_dir_store_v1_store_service_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_agntcy_dir_store_v1_store_service_proto_goTypes = []any{
+	(ConsistencyDiscrepancyType)(0),  // 0: agntcy.dir.store.v1.ConsistencyDiscrepancyType
+	(*PushReferrerRequest)(nil),      // 1: agntcy.dir.store.v1.PushReferrerRequest
+	(*PushReferrerResponse)(nil),     // 2: agntcy.dir.store.v1.PushReferrerResponse
+	(*PushManyResponse)(nil),         // 3: agntcy.dir.store.v1.PushManyResponse
+	(*PullReferrerRequest)(nil),      // 4: agntcy.dir.store.v1.PullReferrerRequest
+	(*PullReferrerResponse)(nil),     // 5: agntcy.dir.store.v1.PullReferrerResponse
+	(*PushBundleRequest)(nil),        // 6: agntcy.dir.store.v1.PushBundleRequest
+	(*PushBundleResponse)(nil),       // 7: agntcy.dir.store.v1.PushBundleResponse
+	(*ApplyTransactionRequest)(nil),  // 8: agntcy.dir.store.v1.ApplyTransactionRequest
+	(*TransactionOperation)(nil),     // 9: agntcy.dir.store.v1.TransactionOperation
+	(*ApplyTransactionResponse)(nil), // 10: agntcy.dir.store.v1.ApplyTransactionResponse
+	(*GetDependenciesRequest)(nil),   // 11: agntcy.dir.store.v1.GetDependenciesRequest
+	(*GetDependenciesResponse)(nil),  // 12: agntcy.dir.store.v1.GetDependenciesResponse
+	(*GetDependentsRequest)(nil),     // 13: agntcy.dir.store.v1.GetDependentsRequest
+	(*GetDependentsResponse)(nil),    // 14: agntcy.dir.store.v1.GetDependentsResponse
+	(*RecordReference)(nil),          // 15: agntcy.dir.store.v1.RecordReference
+	(*RecordReferenceCycle)(nil),     // 16: agntcy.dir.store.v1.RecordReferenceCycle
+	(*ResolveLocatorRequest)(nil),    // 17: agntcy.dir.store.v1.ResolveLocatorRequest
+	(*ResolveLocatorResponse)(nil),   // 18: agntcy.dir.store.v1.ResolveLocatorResponse
+	(*RecordInfoRequest)(nil),        // 19: agntcy.dir.store.v1.RecordInfoRequest
+	(*RecordInfoResponse)(nil),       // 20: agntcy.dir.store.v1.RecordInfoResponse
+	(*RecordSyncOrigin)(nil),         // 21: agntcy.dir.store.v1.RecordSyncOrigin
+	(*RecordSignatureInfo)(nil),      // 22: agntcy.dir.store.v1.RecordSignatureInfo
+	(*ValidateStoredRequest)(nil),    // 23: agntcy.dir.store.v1.ValidateStoredRequest
+	(*ValidateStoredResponse)(nil),   // 24: agntcy.dir.store.v1.ValidateStoredResponse
+	(*CheckConsistencyRequest)(nil),  // 25: agntcy.dir.store.v1.CheckConsistencyRequest
+	(*CheckConsistencyResponse)(nil), // 26: agntcy.dir.store.v1.CheckConsistencyResponse
+	(*GarbageCollectRequest)(nil),    // 27: agntcy.dir.store.v1.GarbageCollectRequest
+	(*GarbageCollectResponse)(nil),   // 28: agntcy.dir.store.v1.GarbageCollectResponse
+	(*ConsistencyDiscrepancy)(nil),   // 29: agntcy.dir.store.v1.ConsistencyDiscrepancy
+	(*UpdateRecordRequest)(nil),      // 30: agntcy.dir.store.v1.UpdateRecordRequest
+	(*UpdateRecordResponse)(nil),     // 31: agntcy.dir.store.v1.UpdateRecordResponse
+	(*Alias)(nil),                    // 32: agntcy.dir.store.v1.Alias
+	(*AliasChange)(nil),              // 33: agntcy.dir.store.v1.AliasChange
+	(*SetAliasRequest)(nil),          // 34: agntcy.dir.store.v1.SetAliasRequest
+	(*SetAliasResponse)(nil),         // 35: agntcy.dir.store.v1.SetAliasResponse
+	(*DeleteAliasRequest)(nil),       // 36: agntcy.dir.store.v1.DeleteAliasRequest
+	(*ListAliasesRequest)(nil),       // 37: agntcy.dir.store.v1.ListAliasesRequest
+	(*ListAliasesResponse)(nil),      // 38: agntcy.dir.store.v1.ListAliasesResponse
+	(*GetAliasHistoryRequest)(nil),   // 39: agntcy.dir.store.v1.GetAliasHistoryRequest
+	(*GetAliasHistoryResponse)(nil),  // 40: agntcy.dir.store.v1.GetAliasHistoryResponse
+	(*ResolveNameRequest)(nil),       // 41: agntcy.dir.store.v1.ResolveNameRequest
+	(*ResolveNameResponse)(nil),      // 42: agntcy.dir.store.v1.ResolveNameResponse
+	(*v1.RecordRef)(nil),             // 43: agntcy.dir.core.v1.RecordRef
+	(*v1.RecordReferrer)(nil),        // 44: agntcy.dir.core.v1.RecordReferrer
+	(*v1.Record)(nil),                // 45: agntcy.dir.core.v1.Record
+	(*v1.RecordMeta)(nil),            // 46: agntcy.dir.core.v1.RecordMeta
+	(SyncStatus)(0),                  // 47: agntcy.dir.store.v1.SyncStatus
+	(*emptypb.Empty)(nil),            // 48: google.protobuf.Empty
+}
+var file_agntcy_dir_store_v1_store_service_proto_depIdxs = []int32{
+	43, // 0: agntcy.dir.store.v1.PushReferrerRequest.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	44, // 1: agntcy.dir.store.v1.PushReferrerRequest.referrer:type_name -> agntcy.dir.core.v1.RecordReferrer
+	43, // 2: agntcy.dir.store.v1.PushManyResponse.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	43, // 3: agntcy.dir.store.v1.PullReferrerRequest.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	44, // 4: agntcy.dir.store.v1.PullReferrerResponse.referrer:type_name -> agntcy.dir.core.v1.RecordReferrer
+	45, // 5: agntcy.dir.store.v1.PushBundleRequest.record:type_name -> agntcy.dir.core.v1.Record
+	44, // 6: agntcy.dir.store.v1.PushBundleRequest.signature:type_name -> agntcy.dir.core.v1.RecordReferrer
+	44, // 7: agntcy.dir.store.v1.PushBundleRequest.public_key:type_name -> agntcy.dir.core.v1.RecordReferrer
+	44, // 8: agntcy.dir.store.v1.PushBundleRequest.attestations:type_name -> agntcy.dir.core.v1.RecordReferrer
+	43, // 9: agntcy.dir.store.v1.PushBundleResponse.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	9,  // 10: agntcy.dir.store.v1.ApplyTransactionRequest.operations:type_name -> agntcy.dir.store.v1.TransactionOperation
+	45, // 11: agntcy.dir.store.v1.TransactionOperation.push:type_name -> agntcy.dir.core.v1.Record
+	43, // 12: agntcy.dir.store.v1.TransactionOperation.delete:type_name -> agntcy.dir.core.v1.RecordRef
+	43, // 13: agntcy.dir.store.v1.ApplyTransactionResponse.pushed_refs:type_name -> agntcy.dir.core.v1.RecordRef
+	43, // 14: agntcy.dir.store.v1.ApplyTransactionResponse.deleted_refs:type_name -> agntcy.dir.core.v1.RecordRef
+	43, // 15: agntcy.dir.store.v1.GetDependenciesRequest.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	15, // 16: agntcy.dir.store.v1.GetDependenciesResponse.references:type_name -> agntcy.dir.store.v1.RecordReference
+	16, // 17: agntcy.dir.store.v1.GetDependenciesResponse.cycles:type_name -> agntcy.dir.store.v1.RecordReferenceCycle
+	43, // 18: agntcy.dir.store.v1.GetDependentsRequest.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	15, // 19: agntcy.dir.store.v1.GetDependentsResponse.references:type_name -> agntcy.dir.store.v1.RecordReference
+	16, // 20: agntcy.dir.store.v1.GetDependentsResponse.cycles:type_name -> agntcy.dir.store.v1.RecordReferenceCycle
+	43, // 21: agntcy.dir.store.v1.ResolveLocatorRequest.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	43, // 22: agntcy.dir.store.v1.RecordInfoRequest.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	43, // 23: agntcy.dir.store.v1.RecordInfoResponse.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	46, // 24: agntcy.dir.store.v1.RecordInfoResponse.meta:type_name -> agntcy.dir.core.v1.RecordMeta
+	21, // 25: agntcy.dir.store.v1.RecordInfoResponse.sync_origins:type_name -> agntcy.dir.store.v1.RecordSyncOrigin
+	22, // 26: agntcy.dir.store.v1.RecordInfoResponse.signature:type_name -> agntcy.dir.store.v1.RecordSignatureInfo
+	47, // 27: agntcy.dir.store.v1.RecordSyncOrigin.status:type_name -> agntcy.dir.store.v1.SyncStatus
+	43, // 28: agntcy.dir.store.v1.ValidateStoredRequest.record_refs:type_name -> agntcy.dir.core.v1.RecordRef
+	43, // 29: agntcy.dir.store.v1.ValidateStoredResponse.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	29, // 30: agntcy.dir.store.v1.CheckConsistencyResponse.discrepancies:type_name -> agntcy.dir.store.v1.ConsistencyDiscrepancy
+	43, // 31: agntcy.dir.store.v1.ConsistencyDiscrepancy.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	0,  // 32: agntcy.dir.store.v1.ConsistencyDiscrepancy.type:type_name -> agntcy.dir.store.v1.ConsistencyDiscrepancyType
+	43, // 33: agntcy.dir.store.v1.UpdateRecordRequest.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	43, // 34: agntcy.dir.store.v1.UpdateRecordResponse.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	43, // 35: agntcy.dir.store.v1.UpdateRecordResponse.previous_record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	43, // 36: agntcy.dir.store.v1.Alias.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	43, // 37: agntcy.dir.store.v1.SetAliasRequest.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	32, // 38: agntcy.dir.store.v1.SetAliasResponse.alias:type_name -> agntcy.dir.store.v1.Alias
+	32, // 39: agntcy.dir.store.v1.ListAliasesResponse.aliases:type_name -> agntcy.dir.store.v1.Alias
+	33, // 40: agntcy.dir.store.v1.GetAliasHistoryResponse.changes:type_name -> agntcy.dir.store.v1.AliasChange
+	43, // 41: agntcy.dir.store.v1.ResolveNameResponse.record_ref:type_name -> agntcy.dir.core.v1.RecordRef
+	45, // 42: agntcy.dir.store.v1.StoreService.Push:input_type -> agntcy.dir.core.v1.Record
+	45, // 43: agntcy.dir.store.v1.StoreService.PushMany:input_type -> agntcy.dir.core.v1.Record
+	43, // 44: agntcy.dir.store.v1.StoreService.Pull:input_type -> agntcy.dir.core.v1.RecordRef
+	43, // 45: agntcy.dir.store.v1.StoreService.Lookup:input_type -> agntcy.dir.core.v1.RecordRef
+	43, // 46: agntcy.dir.store.v1.StoreService.Delete:input_type -> agntcy.dir.core.v1.RecordRef
+	1,  // 47: agntcy.dir.store.v1.StoreService.PushReferrer:input_type -> agntcy.dir.store.v1.PushReferrerRequest
+	4,  // 48: agntcy.dir.store.v1.StoreService.PullReferrer:input_type -> agntcy.dir.store.v1.PullReferrerRequest
+	19, // 49: agntcy.dir.store.v1.StoreService.RecordInfo:input_type -> agntcy.dir.store.v1.RecordInfoRequest
+	6,  // 50: agntcy.dir.store.v1.StoreService.PushBundle:input_type -> agntcy.dir.store.v1.PushBundleRequest
+	8,  // 51: agntcy.dir.store.v1.StoreService.ApplyTransaction:input_type -> agntcy.dir.store.v1.ApplyTransactionRequest
+	11, // 52: agntcy.dir.store.v1.StoreService.GetDependencies:input_type -> agntcy.dir.store.v1.GetDependenciesRequest
+	13, // 53: agntcy.dir.store.v1.StoreService.GetDependents:input_type -> agntcy.dir.store.v1.GetDependentsRequest
+	23, // 54: agntcy.dir.store.v1.StoreService.ValidateStored:input_type -> agntcy.dir.store.v1.ValidateStoredRequest
+	17, // 55: agntcy.dir.store.v1.StoreService.ResolveLocator:input_type -> agntcy.dir.store.v1.ResolveLocatorRequest
+	25, // 56: agntcy.dir.store.v1.StoreService.CheckConsistency:input_type -> agntcy.dir.store.v1.CheckConsistencyRequest
+	27, // 57: agntcy.dir.store.v1.StoreService.GarbageCollect:input_type -> agntcy.dir.store.v1.GarbageCollectRequest
+	30, // 58: agntcy.dir.store.v1.StoreService.UpdateRecord:input_type -> agntcy.dir.store.v1.UpdateRecordRequest
+	34, // 59: agntcy.dir.store.v1.StoreService.SetAlias:input_type -> agntcy.dir.store.v1.SetAliasRequest
+	36, // 60: agntcy.dir.store.v1.StoreService.DeleteAlias:input_type -> agntcy.dir.store.v1.DeleteAliasRequest
+	37, // 61: agntcy.dir.store.v1.StoreService.ListAliases:input_type -> agntcy.dir.store.v1.ListAliasesRequest
+	39, // 62: agntcy.dir.store.v1.StoreService.GetAliasHistory:input_type -> agntcy.dir.store.v1.GetAliasHistoryRequest
+	41, // 63: agntcy.dir.store.v1.StoreService.ResolveName:input_type -> agntcy.dir.store.v1.ResolveNameRequest
+	43, // 64: agntcy.dir.store.v1.StoreService.Push:output_type -> agntcy.dir.core.v1.RecordRef
+	3,  // 65: agntcy.dir.store.v1.StoreService.PushMany:output_type -> agntcy.dir.store.v1.PushManyResponse
+	45, // 66: agntcy.dir.store.v1.StoreService.Pull:output_type -> agntcy.dir.core.v1.Record
+	46, // 67: agntcy.dir.store.v1.StoreService.Lookup:output_type -> agntcy.dir.core.v1.RecordMeta
+	48, // 68: agntcy.dir.store.v1.StoreService.Delete:output_type -> google.protobuf.Empty
+	2,  // 69: agntcy.dir.store.v1.StoreService.PushReferrer:output_type -> agntcy.dir.store.v1.PushReferrerResponse
+	5,  // 70: agntcy.dir.store.v1.StoreService.PullReferrer:output_type -> agntcy.dir.store.v1.PullReferrerResponse
+	20, // 71: agntcy.dir.store.v1.StoreService.RecordInfo:output_type -> agntcy.dir.store.v1.RecordInfoResponse
+	7,  // 72: agntcy.dir.store.v1.StoreService.PushBundle:output_type -> agntcy.dir.store.v1.PushBundleResponse
+	10, // 73: agntcy.dir.store.v1.StoreService.ApplyTransaction:output_type -> agntcy.dir.store.v1.ApplyTransactionResponse
+	12, // 74: agntcy.dir.store.v1.StoreService.GetDependencies:output_type -> agntcy.dir.store.v1.GetDependenciesResponse
+	14, // 75: agntcy.dir.store.v1.StoreService.GetDependents:output_type -> agntcy.dir.store.v1.GetDependentsResponse
+	24, // 76: agntcy.dir.store.v1.StoreService.ValidateStored:output_type -> agntcy.dir.store.v1.ValidateStoredResponse
+	18, // 77: agntcy.dir.store.v1.StoreService.ResolveLocator:output_type -> agntcy.dir.store.v1.ResolveLocatorResponse
+	26, // 78: agntcy.dir.store.v1.StoreService.CheckConsistency:output_type -> agntcy.dir.store.v1.CheckConsistencyResponse
+	28, // 79: agntcy.dir.store.v1.StoreService.GarbageCollect:output_type -> agntcy.dir.store.v1.GarbageCollectResponse
+	31, // 80: agntcy.dir.store.v1.StoreService.UpdateRecord:output_type -> agntcy.dir.store.v1.UpdateRecordResponse
+	35, // 81: agntcy.dir.store.v1.StoreService.SetAlias:output_type -> agntcy.dir.store.v1.SetAliasResponse
+	48, // 82: agntcy.dir.store.v1.StoreService.DeleteAlias:output_type -> google.protobuf.Empty
+	38, // 83: agntcy.dir.store.v1.StoreService.ListAliases:output_type -> agntcy.dir.store.v1.ListAliasesResponse
+	40, // 84: agntcy.dir.store.v1.StoreService.GetAliasHistory:output_type -> agntcy.dir.store.v1.GetAliasHistoryResponse
+	42, // 85: agntcy.dir.store.v1.StoreService.ResolveName:output_type -> agntcy.dir.store.v1.ResolveNameResponse
+	64, // [64:86] is the sub-list for method output_type
+	42, // [42:64] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_agntcy_dir_store_v1_store_service_proto_init() }
+func file_agntcy_dir_store_v1_store_service_proto_init() {
+	if File_agntcy_dir_store_v1_store_service_proto != nil {
+		return
+	}
+	file_agntcy_dir_store_v1_sync_service_proto_init()
+	file_agntcy_dir_store_v1_store_service_proto_msgTypes[1].OneofWrappers = []any{}
+	file_agntcy_dir_store_v1_store_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_agntcy_dir_store_v1_store_service_proto_msgTypes[3].OneofWrappers = []any{}
+	file_agntcy_dir_store_v1_store_service_proto_msgTypes[8].OneofWrappers = []any{
+		(*TransactionOperation_Push)(nil),
+		(*TransactionOperation_Delete)(nil),
+	}
+	file_agntcy_dir_store_v1_store_service_proto_msgTypes[21].OneofWrappers = []any{}
+	file_agntcy_dir_store_v1_store_service_proto_msgTypes[28].OneofWrappers = []any{}
+	file_agntcy_dir_store_v1_store_service_proto_msgTypes[33].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_dir_store_v1_store_service_proto_rawDesc), len(file_agntcy_dir_store_v1_store_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_agntcy_dir_store_v1_store_service_proto_goTypes,
 		DependencyIndexes: file_agntcy_dir_store_v1_store_service_proto_depIdxs,
+		EnumInfos:         file_agntcy_dir_store_v1_store_service_proto_enumTypes,
 		MessageInfos:      file_agntcy_dir_store_v1_store_service_proto_msgTypes,
 	}.Build()
 	File_agntcy_dir_store_v1_store_service_proto = out.File
@@ -24,12 +24,28 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	StoreService_Push_FullMethodName         = "/agntcy.dir.store.v1.StoreService/Push"
-	StoreService_Pull_FullMethodName         = "/agntcy.dir.store.v1.StoreService/Pull"
-	StoreService_Lookup_FullMethodName       = "/agntcy.dir.store.v1.StoreService/Lookup"
-	StoreService_Delete_FullMethodName       = "/agntcy.dir.store.v1.StoreService/Delete"
-	StoreService_PushReferrer_FullMethodName = "/agntcy.dir.store.v1.StoreService/PushReferrer"
-	StoreService_PullReferrer_FullMethodName = "/agntcy.dir.store.v1.StoreService/PullReferrer"
+	StoreService_Push_FullMethodName             = "/agntcy.dir.store.v1.StoreService/Push"
+	StoreService_PushMany_FullMethodName         = "/agntcy.dir.store.v1.StoreService/PushMany"
+	StoreService_Pull_FullMethodName             = "/agntcy.dir.store.v1.StoreService/Pull"
+	StoreService_Lookup_FullMethodName           = "/agntcy.dir.store.v1.StoreService/Lookup"
+	StoreService_Delete_FullMethodName           = "/agntcy.dir.store.v1.StoreService/Delete"
+	StoreService_PushReferrer_FullMethodName     = "/agntcy.dir.store.v1.StoreService/PushReferrer"
+	StoreService_PullReferrer_FullMethodName     = "/agntcy.dir.store.v1.StoreService/PullReferrer"
+	StoreService_RecordInfo_FullMethodName       = "/agntcy.dir.store.v1.StoreService/RecordInfo"
+	StoreService_PushBundle_FullMethodName       = "/agntcy.dir.store.v1.StoreService/PushBundle"
+	StoreService_ApplyTransaction_FullMethodName = "/agntcy.dir.store.v1.StoreService/ApplyTransaction"
+	StoreService_GetDependencies_FullMethodName  = "/agntcy.dir.store.v1.StoreService/GetDependencies"
+	StoreService_GetDependents_FullMethodName    = "/agntcy.dir.store.v1.StoreService/GetDependents"
+	StoreService_ValidateStored_FullMethodName   = "/agntcy.dir.store.v1.StoreService/ValidateStored"
+	StoreService_ResolveLocator_FullMethodName   = "/agntcy.dir.store.v1.StoreService/ResolveLocator"
+	StoreService_CheckConsistency_FullMethodName = "/agntcy.dir.store.v1.StoreService/CheckConsistency"
+	StoreService_GarbageCollect_FullMethodName   = "/agntcy.dir.store.v1.StoreService/GarbageCollect"
+	StoreService_UpdateRecord_FullMethodName     = "/agntcy.dir.store.v1.StoreService/UpdateRecord"
+	StoreService_SetAlias_FullMethodName         = "/agntcy.dir.store.v1.StoreService/SetAlias"
+	StoreService_DeleteAlias_FullMethodName      = "/agntcy.dir.store.v1.StoreService/DeleteAlias"
+	StoreService_ListAliases_FullMethodName      = "/agntcy.dir.store.v1.StoreService/ListAliases"
+	StoreService_GetAliasHistory_FullMethodName  = "/agntcy.dir.store.v1.StoreService/GetAliasHistory"
+	StoreService_ResolveName_FullMethodName      = "/agntcy.dir.store.v1.StoreService/ResolveName"
 )
 
 // StoreServiceClient is the client API for StoreService service.
@@ -54,6 +70,13 @@ const (
 type StoreServiceClient interface {
 	// Push performs write operation for given records.
 	Push(ctx context.Context, opts ...grpc.CallOption) (StoreService_PushClient, error)
+	// PushMany performs write operation for many records over a single stream.
+	//
+	// Unlike Push, a record failing validation or storage does not cancel the
+	// stream. A result is returned for each received record, in the order the
+	// records were sent, so large imports can be performed without reconnecting.
+	// The server validates the next records while previous ones are being stored.
+	PushMany(ctx context.Context, opts ...grpc.CallOption) (StoreService_PushManyClient, error)
 	// Pull performs read operation for given records.
 	Pull(ctx context.Context, opts ...grpc.CallOption) (StoreService_PullClient, error)
 	// Lookup resolves basic metadata for the records.
@@ -64,6 +87,78 @@ type StoreServiceClient interface {
 	PushReferrer(ctx context.Context, opts ...grpc.CallOption) (StoreService_PushReferrerClient, error)
 	// PullReferrer performs read operation for record referrers.
 	PullReferrer(ctx context.Context, opts ...grpc.CallOption) (StoreService_PullReferrerClient, error)
+	// RecordInfo returns a consolidated view of the record state on this server,
+	// including storage, sync, publication, and signature details.
+	RecordInfo(ctx context.Context, in *RecordInfoRequest, opts ...grpc.CallOption) (*RecordInfoResponse, error)
+	// PushBundle performs write operation for a record together with
+	// its signature and attestations.
+	//
+	// All parts of the bundle are validated before any write takes place.
+	// If storing any part fails, the record is removed again, and the record
+	// is only added to the search index once the complete bundle is stored,
+	// so partially signed records never become visible.
+	PushBundle(ctx context.Context, in *PushBundleRequest, opts ...grpc.CallOption) (*PushBundleResponse, error)
+	// ApplyTransaction performs a group of push and delete operations atomically.
+	//
+	// All operations are validated before any write takes place. If applying
+	// any operation fails, the operations already applied are reverted, and the
+	// search index is only updated once all operations succeeded, so either all
+	// changes become visible or none.
+	ApplyTransaction(ctx context.Context, in *ApplyTransactionRequest, opts ...grpc.CallOption) (*ApplyTransactionResponse, error)
+	// GetDependencies returns the records referenced by the given record,
+	// as indexed when the records were pushed.
+	GetDependencies(ctx context.Context, in *GetDependenciesRequest, opts ...grpc.CallOption) (*GetDependenciesResponse, error)
+	// GetDependents returns the records referencing the given record,
+	// as indexed when the records were pushed.
+	GetDependents(ctx context.Context, in *GetDependentsRequest, opts ...grpc.CallOption) (*GetDependentsResponse, error)
+	// ValidateStored re-validates already stored records against the current
+	// OASF schemas and validation rules, streaming one result per record.
+	//
+	// Records that were valid before and no longer validate are flagged as
+	// drifted, and a RECORD_VALIDATION_DRIFT event is emitted for them.
+	ValidateStored(ctx context.Context, in *ValidateStoredRequest, opts ...grpc.CallOption) (StoreService_ValidateStoredClient, error)
+	// ResolveLocator selects the locator of a record nearest to the caller,
+	// based on the region hints annotated on the locators of the record.
+	ResolveLocator(ctx context.Context, in *ResolveLocatorRequest, opts ...grpc.CallOption) (*ResolveLocatorResponse, error)
+	// CheckConsistency compares the records held by the search database, the
+	// content store and the routing datastore, and reports the discrepancies.
+	//
+	// Discrepancies between the search database and the content store are
+	// repaired if requested. Published records missing locally are only reported.
+	CheckConsistency(ctx context.Context, in *CheckConsistencyRequest, opts ...grpc.CallOption) (*CheckConsistencyResponse, error)
+	// GarbageCollect removes the content of the content store that is no longer
+	// referenced by any record, such as the blobs left behind by deleted records
+	// and interrupted pushes. Records and the referrers of stored records are kept.
+	//
+	// Only local content stores are collected. Remote registries collect their
+	// own garbage, and requests fail with FAILED_PRECONDITION.
+	GarbageCollect(ctx context.Context, in *GarbageCollectRequest, opts ...grpc.CallOption) (*GarbageCollectResponse, error)
+	// UpdateRecord applies an RFC 6902 JSON patch to a stored record and
+	// pushes the result as a new record.
+	//
+	// The new record is linked to the patched record through its
+	// previous_record_cid field, for schema versions that support lineage.
+	// The patched record is left unchanged, and its signatures do not apply
+	// to the new record, which must be signed again if needed.
+	UpdateRecord(ctx context.Context, in *UpdateRecordRequest, opts ...grpc.CallOption) (*UpdateRecordResponse, error)
+	// SetAlias points a mutable name:tag alias, such as "stable" or "latest",
+	// to a stored record, creating the alias or atomically retargeting it.
+	//
+	// If expected_cid is set, the alias is only changed if it currently points
+	// to that record, or does not exist when it is empty.
+	// Every change is appended to the alias history.
+	SetAlias(ctx context.Context, in *SetAliasRequest, opts ...grpc.CallOption) (*SetAliasResponse, error)
+	// DeleteAlias removes a name:tag alias. The deletion is appended to the alias history.
+	DeleteAlias(ctx context.Context, in *DeleteAliasRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListAliases lists the aliases of a record name, or of all records.
+	ListAliases(ctx context.Context, in *ListAliasesRequest, opts ...grpc.CallOption) (*ListAliasesResponse, error)
+	// GetAliasHistory returns the changes of the aliases of a record name, oldest first.
+	GetAliasHistory(ctx context.Context, in *GetAliasHistoryRequest, opts ...grpc.CallOption) (*GetAliasHistoryResponse, error)
+	// ResolveName resolves a record name and tag to a record reference.
+	//
+	// The tag is resolved as an alias first, then as the version of a record
+	// with that name. Pull also resolves "name:tag" references in place of CIDs.
+	ResolveName(ctx context.Context, in *ResolveNameRequest, opts ...grpc.CallOption) (*ResolveNameResponse, error)
 }
 
 type storeServiceClient struct {
@@ -106,9 +201,41 @@ func (x *storeServicePushClient) Recv() (*v1.RecordRef, error) {
 	return m, nil
 }
 
+func (c *storeServiceClient) PushMany(ctx context.Context, opts ...grpc.CallOption) (StoreService_PushManyClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[1], StoreService_PushMany_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storeServicePushManyClient{ClientStream: stream}
+	return x, nil
+}
+
+type StoreService_PushManyClient interface {
+	Send(*v1.Record) error
+	Recv() (*PushManyResponse, error)
+	grpc.ClientStream
+}
+
+type storeServicePushManyClient struct {
+	grpc.ClientStream
+}
+
+func (x *storeServicePushManyClient) Send(m *v1.Record) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *storeServicePushManyClient) Recv() (*PushManyResponse, error) {
+	m := new(PushManyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *storeServiceClient) Pull(ctx context.Context, opts ...grpc.CallOption) (StoreService_PullClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[1], StoreService_Pull_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[2], StoreService_Pull_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +267,7 @@ func (x *storeServicePullClient) Recv() (*v1.Record, error) {
 
 func (c *storeServiceClient) Lookup(ctx context.Context, opts ...grpc.CallOption) (StoreService_LookupClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[2], StoreService_Lookup_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[3], StoreService_Lookup_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +299,7 @@ func (x *storeServiceLookupClient) Recv() (*v1.RecordMeta, error) {
 
 func (c *storeServiceClient) Delete(ctx context.Context, opts ...grpc.CallOption) (StoreService_DeleteClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[3], StoreService_Delete_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[4], StoreService_Delete_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -207,7 +334,7 @@ func (x *storeServiceDeleteClient) CloseAndRecv() (*emptypb.Empty, error) {
 
 func (c *storeServiceClient) PushReferrer(ctx context.Context, opts ...grpc.CallOption) (StoreService_PushReferrerClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[4], StoreService_PushReferrer_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[5], StoreService_PushReferrer_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -239,7 +366,7 @@ func (x *storeServicePushReferrerClient) Recv() (*PushReferrerResponse, error) {
 
 func (c *storeServiceClient) PullReferrer(ctx context.Context, opts ...grpc.CallOption) (StoreService_PullReferrerClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[5], StoreService_PullReferrer_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[6], StoreService_PullReferrer_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -269,6 +396,179 @@ func (x *storeServicePullReferrerClient) Recv() (*PullReferrerResponse, error) {
 	return m, nil
 }
 
+func (c *storeServiceClient) RecordInfo(ctx context.Context, in *RecordInfoRequest, opts ...grpc.CallOption) (*RecordInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordInfoResponse)
+	err := c.cc.Invoke(ctx, StoreService_RecordInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) PushBundle(ctx context.Context, in *PushBundleRequest, opts ...grpc.CallOption) (*PushBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushBundleResponse)
+	err := c.cc.Invoke(ctx, StoreService_PushBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) ApplyTransaction(ctx context.Context, in *ApplyTransactionRequest, opts ...grpc.CallOption) (*ApplyTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyTransactionResponse)
+	err := c.cc.Invoke(ctx, StoreService_ApplyTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) GetDependencies(ctx context.Context, in *GetDependenciesRequest, opts ...grpc.CallOption) (*GetDependenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDependenciesResponse)
+	err := c.cc.Invoke(ctx, StoreService_GetDependencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) GetDependents(ctx context.Context, in *GetDependentsRequest, opts ...grpc.CallOption) (*GetDependentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDependentsResponse)
+	err := c.cc.Invoke(ctx, StoreService_GetDependents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) ValidateStored(ctx context.Context, in *ValidateStoredRequest, opts ...grpc.CallOption) (StoreService_ValidateStoredClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[7], StoreService_ValidateStored_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storeServiceValidateStoredClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StoreService_ValidateStoredClient interface {
+	Recv() (*ValidateStoredResponse, error)
+	grpc.ClientStream
+}
+
+type storeServiceValidateStoredClient struct {
+	grpc.ClientStream
+}
+
+func (x *storeServiceValidateStoredClient) Recv() (*ValidateStoredResponse, error) {
+	m := new(ValidateStoredResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storeServiceClient) ResolveLocator(ctx context.Context, in *ResolveLocatorRequest, opts ...grpc.CallOption) (*ResolveLocatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveLocatorResponse)
+	err := c.cc.Invoke(ctx, StoreService_ResolveLocator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) CheckConsistency(ctx context.Context, in *CheckConsistencyRequest, opts ...grpc.CallOption) (*CheckConsistencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckConsistencyResponse)
+	err := c.cc.Invoke(ctx, StoreService_CheckConsistency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) GarbageCollect(ctx context.Context, in *GarbageCollectRequest, opts ...grpc.CallOption) (*GarbageCollectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GarbageCollectResponse)
+	err := c.cc.Invoke(ctx, StoreService_GarbageCollect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) UpdateRecord(ctx context.Context, in *UpdateRecordRequest, opts ...grpc.CallOption) (*UpdateRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRecordResponse)
+	err := c.cc.Invoke(ctx, StoreService_UpdateRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) SetAlias(ctx context.Context, in *SetAliasRequest, opts ...grpc.CallOption) (*SetAliasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAliasResponse)
+	err := c.cc.Invoke(ctx, StoreService_SetAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) DeleteAlias(ctx context.Context, in *DeleteAliasRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StoreService_DeleteAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) ListAliases(ctx context.Context, in *ListAliasesRequest, opts ...grpc.CallOption) (*ListAliasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAliasesResponse)
+	err := c.cc.Invoke(ctx, StoreService_ListAliases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) GetAliasHistory(ctx context.Context, in *GetAliasHistoryRequest, opts ...grpc.CallOption) (*GetAliasHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAliasHistoryResponse)
+	err := c.cc.Invoke(ctx, StoreService_GetAliasHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) ResolveName(ctx context.Context, in *ResolveNameRequest, opts ...grpc.CallOption) (*ResolveNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveNameResponse)
+	err := c.cc.Invoke(ctx, StoreService_ResolveName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StoreServiceServer is the server API for StoreService service.
 // All implementations should embed UnimplementedStoreServiceServer
 // for forward compatibility.
@@ -291,6 +591,13 @@ func (x *storeServicePullReferrerClient) Recv() (*PullReferrerResponse, error) {
 type StoreServiceServer interface {
 	// Push performs write operation for given records.
 	Push(StoreService_PushServer) error
+	// PushMany performs write operation for many records over a single stream.
+	//
+	// Unlike Push, a record failing validation or storage does not cancel the
+	// stream. A result is returned for each received record, in the order the
+	// records were sent, so large imports can be performed without reconnecting.
+	// The server validates the next records while previous ones are being stored.
+	PushMany(StoreService_PushManyServer) error
 	// Pull performs read operation for given records.
 	Pull(StoreService_PullServer) error
 	// Lookup resolves basic metadata for the records.
@@ -301,6 +608,78 @@ type StoreServiceServer interface {
 	PushReferrer(StoreService_PushReferrerServer) error
 	// PullReferrer performs read operation for record referrers.
 	PullReferrer(StoreService_PullReferrerServer) error
+	// RecordInfo returns a consolidated view of the record state on this server,
+	// including storage, sync, publication, and signature details.
+	RecordInfo(context.Context, *RecordInfoRequest) (*RecordInfoResponse, error)
+	// PushBundle performs write operation for a record together with
+	// its signature and attestations.
+	//
+	// All parts of the bundle are validated before any write takes place.
+	// If storing any part fails, the record is removed again, and the record
+	// is only added to the search index once the complete bundle is stored,
+	// so partially signed records never become visible.
+	PushBundle(context.Context, *PushBundleRequest) (*PushBundleResponse, error)
+	// ApplyTransaction performs a group of push and delete operations atomically.
+	//
+	// All operations are validated before any write takes place. If applying
+	// any operation fails, the operations already applied are reverted, and the
+	// search index is only updated once all operations succeeded, so either all
+	// changes become visible or none.
+	ApplyTransaction(context.Context, *ApplyTransactionRequest) (*ApplyTransactionResponse, error)
+	// GetDependencies returns the records referenced by the given record,
+	// as indexed when the records were pushed.
+	GetDependencies(context.Context, *GetDependenciesRequest) (*GetDependenciesResponse, error)
+	// GetDependents returns the records referencing the given record,
+	// as indexed when the records were pushed.
+	GetDependents(context.Context, *GetDependentsRequest) (*GetDependentsResponse, error)
+	// ValidateStored re-validates already stored records against the current
+	// OASF schemas and validation rules, streaming one result per record.
+	//
+	// Records that were valid before and no longer validate are flagged as
+	// drifted, and a RECORD_VALIDATION_DRIFT event is emitted for them.
+	ValidateStored(*ValidateStoredRequest, StoreService_ValidateStoredServer) error
+	// ResolveLocator selects the locator of a record nearest to the caller,
+	// based on the region hints annotated on the locators of the record.
+	ResolveLocator(context.Context, *ResolveLocatorRequest) (*ResolveLocatorResponse, error)
+	// CheckConsistency compares the records held by the search database, the
+	// content store and the routing datastore, and reports the discrepancies.
+	//
+	// Discrepancies between the search database and the content store are
+	// repaired if requested. Published records missing locally are only reported.
+	CheckConsistency(context.Context, *CheckConsistencyRequest) (*CheckConsistencyResponse, error)
+	// GarbageCollect removes the content of the content store that is no longer
+	// referenced by any record, such as the blobs left behind by deleted records
+	// and interrupted pushes. Records and the referrers of stored records are kept.
+	//
+	// Only local content stores are collected. Remote registries collect their
+	// own garbage, and requests fail with FAILED_PRECONDITION.
+	GarbageCollect(context.Context, *GarbageCollectRequest) (*GarbageCollectResponse, error)
+	// UpdateRecord applies an RFC 6902 JSON patch to a stored record and
+	// pushes the result as a new record.
+	//
+	// The new record is linked to the patched record through its
+	// previous_record_cid field, for schema versions that support lineage.
+	// The patched record is left unchanged, and its signatures do not apply
+	// to the new record, which must be signed again if needed.
+	UpdateRecord(context.Context, *UpdateRecordRequest) (*UpdateRecordResponse, error)
+	// SetAlias points a mutable name:tag alias, such as "stable" or "latest",
+	// to a stored record, creating the alias or atomically retargeting it.
+	//
+	// If expected_cid is set, the alias is only changed if it currently points
+	// to that record, or does not exist when it is empty.
+	// Every change is appended to the alias history.
+	SetAlias(context.Context, *SetAliasRequest) (*SetAliasResponse, error)
+	// DeleteAlias removes a name:tag alias. The deletion is appended to the alias history.
+	DeleteAlias(context.Context, *DeleteAliasRequest) (*emptypb.Empty, error)
+	// ListAliases lists the aliases of a record name, or of all records.
+	ListAliases(context.Context, *ListAliasesRequest) (*ListAliasesResponse, error)
+	// GetAliasHistory returns the changes of the aliases of a record name, oldest first.
+	GetAliasHistory(context.Context, *GetAliasHistoryRequest) (*GetAliasHistoryResponse, error)
+	// ResolveName resolves a record name and tag to a record reference.
+	//
+	// The tag is resolved as an alias first, then as the version of a record
+	// with that name. Pull also resolves "name:tag" references in place of CIDs.
+	ResolveName(context.Context, *ResolveNameRequest) (*ResolveNameResponse, error)
 }
 
 // UnimplementedStoreServiceServer should be embedded to have
@@ -313,6 +692,9 @@ type UnimplementedStoreServiceServer struct{}
 func (UnimplementedStoreServiceServer) Push(StoreService_PushServer) error {
 	return status.Errorf(codes.Unimplemented, "method Push not implemented")
 }
+func (UnimplementedStoreServiceServer) PushMany(StoreService_PushManyServer) error {
+	return status.Errorf(codes.Unimplemented, "method PushMany not implemented")
+}
 func (UnimplementedStoreServiceServer) Pull(StoreService_PullServer) error {
 	return status.Errorf(codes.Unimplemented, "method Pull not implemented")
 }
@@ -328,6 +710,51 @@ func (UnimplementedStoreServiceServer) PushReferrer(StoreService_PushReferrerSer
 func (UnimplementedStoreServiceServer) PullReferrer(StoreService_PullReferrerServer) error {
 	return status.Errorf(codes.Unimplemented, "method PullReferrer not implemented")
 }
+func (UnimplementedStoreServiceServer) RecordInfo(context.Context, *RecordInfoRequest) (*RecordInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordInfo not implemented")
+}
+func (UnimplementedStoreServiceServer) PushBundle(context.Context, *PushBundleRequest) (*PushBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushBundle not implemented")
+}
+func (UnimplementedStoreServiceServer) ApplyTransaction(context.Context, *ApplyTransactionRequest) (*ApplyTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyTransaction not implemented")
+}
+func (UnimplementedStoreServiceServer) GetDependencies(context.Context, *GetDependenciesRequest) (*GetDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDependencies not implemented")
+}
+func (UnimplementedStoreServiceServer) GetDependents(context.Context, *GetDependentsRequest) (*GetDependentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDependents not implemented")
+}
+func (UnimplementedStoreServiceServer) ValidateStored(*ValidateStoredRequest, StoreService_ValidateStoredServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateStored not implemented")
+}
+func (UnimplementedStoreServiceServer) ResolveLocator(context.Context, *ResolveLocatorRequest) (*ResolveLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveLocator not implemented")
+}
+func (UnimplementedStoreServiceServer) CheckConsistency(context.Context, *CheckConsistencyRequest) (*CheckConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConsistency not implemented")
+}
+func (UnimplementedStoreServiceServer) GarbageCollect(context.Context, *GarbageCollectRequest) (*GarbageCollectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GarbageCollect not implemented")
+}
+func (UnimplementedStoreServiceServer) UpdateRecord(context.Context, *UpdateRecordRequest) (*UpdateRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecord not implemented")
+}
+func (UnimplementedStoreServiceServer) SetAlias(context.Context, *SetAliasRequest) (*SetAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAlias not implemented")
+}
+func (UnimplementedStoreServiceServer) DeleteAlias(context.Context, *DeleteAliasRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlias not implemented")
+}
+func (UnimplementedStoreServiceServer) ListAliases(context.Context, *ListAliasesRequest) (*ListAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAliases not implemented")
+}
+func (UnimplementedStoreServiceServer) GetAliasHistory(context.Context, *GetAliasHistoryRequest) (*GetAliasHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAliasHistory not implemented")
+}
+func (UnimplementedStoreServiceServer) ResolveName(context.Context, *ResolveNameRequest) (*ResolveNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveName not implemented")
+}
 func (UnimplementedStoreServiceServer) testEmbeddedByValue() {}
 
 // UnsafeStoreServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -374,6 +801,32 @@ func (x *storeServicePushServer) Recv() (*v1.Record, error) {
 	return m, nil
 }
 
+func _StoreService_PushMany_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StoreServiceServer).PushMany(&storeServicePushManyServer{ServerStream: stream})
+}
+
+type StoreService_PushManyServer interface {
+	Send(*PushManyResponse) error
+	Recv() (*v1.Record, error)
+	grpc.ServerStream
+}
+
+type storeServicePushManyServer struct {
+	grpc.ServerStream
+}
+
+func (x *storeServicePushManyServer) Send(m *PushManyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *storeServicePushManyServer) Recv() (*v1.Record, error) {
+	m := new(v1.Record)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _StoreService_Pull_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(StoreServiceServer).Pull(&storeServicePullServer{ServerStream: stream})
 }
@@ -504,13 +957,343 @@ func (x *storeServicePullReferrerServer) Recv() (*PullReferrerRequest, error) {
 	return m, nil
 }
 
+func _StoreService_RecordInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).RecordInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_RecordInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).RecordInfo(ctx, req.(*RecordInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_PushBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).PushBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_PushBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).PushBundle(ctx, req.(*PushBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_ApplyTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).ApplyTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_ApplyTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).ApplyTransaction(ctx, req.(*ApplyTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_GetDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).GetDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_GetDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).GetDependencies(ctx, req.(*GetDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_GetDependents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDependentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).GetDependents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_GetDependents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).GetDependents(ctx, req.(*GetDependentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_ValidateStored_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidateStoredRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StoreServiceServer).ValidateStored(m, &storeServiceValidateStoredServer{ServerStream: stream})
+}
+
+type StoreService_ValidateStoredServer interface {
+	Send(*ValidateStoredResponse) error
+	grpc.ServerStream
+}
+
+type storeServiceValidateStoredServer struct {
+	grpc.ServerStream
+}
+
+func (x *storeServiceValidateStoredServer) Send(m *ValidateStoredResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StoreService_ResolveLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).ResolveLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_ResolveLocator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).ResolveLocator(ctx, req.(*ResolveLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_CheckConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).CheckConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_CheckConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).CheckConsistency(ctx, req.(*CheckConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_GarbageCollect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GarbageCollectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).GarbageCollect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_GarbageCollect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).GarbageCollect(ctx, req.(*GarbageCollectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_UpdateRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).UpdateRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_UpdateRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).UpdateRecord(ctx, req.(*UpdateRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_SetAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).SetAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_SetAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).SetAlias(ctx, req.(*SetAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_DeleteAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).DeleteAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_DeleteAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).DeleteAlias(ctx, req.(*DeleteAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_ListAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).ListAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_ListAliases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).ListAliases(ctx, req.(*ListAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_GetAliasHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAliasHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).GetAliasHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_GetAliasHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).GetAliasHistory(ctx, req.(*GetAliasHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_ResolveName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).ResolveName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_ResolveName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).ResolveName(ctx, req.(*ResolveNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StoreService_ServiceDesc is the grpc.ServiceDesc for StoreService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var StoreService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "agntcy.dir.store.v1.StoreService",
 	HandlerType: (*StoreServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecordInfo",
+			Handler:    _StoreService_RecordInfo_Handler,
+		},
+		{
+			MethodName: "PushBundle",
+			Handler:    _StoreService_PushBundle_Handler,
+		},
+		{
+			MethodName: "ApplyTransaction",
+			Handler:    _StoreService_ApplyTransaction_Handler,
+		},
+		{
+			MethodName: "GetDependencies",
+			Handler:    _StoreService_GetDependencies_Handler,
+		},
+		{
+			MethodName: "GetDependents",
+			Handler:    _StoreService_GetDependents_Handler,
+		},
+		{
+			MethodName: "ResolveLocator",
+			Handler:    _StoreService_ResolveLocator_Handler,
+		},
+		{
+			MethodName: "CheckConsistency",
+			Handler:    _StoreService_CheckConsistency_Handler,
+		},
+		{
+			MethodName: "GarbageCollect",
+			Handler:    _StoreService_GarbageCollect_Handler,
+		},
+		{
+			MethodName: "UpdateRecord",
+			Handler:    _StoreService_UpdateRecord_Handler,
+		},
+		{
+			MethodName: "SetAlias",
+			Handler:    _StoreService_SetAlias_Handler,
+		},
+		{
+			MethodName: "DeleteAlias",
+			Handler:    _StoreService_DeleteAlias_Handler,
+		},
+		{
+			MethodName: "ListAliases",
+			Handler:    _StoreService_ListAliases_Handler,
+		},
+		{
+			MethodName: "GetAliasHistory",
+			Handler:    _StoreService_GetAliasHistory_Handler,
+		},
+		{
+			MethodName: "ResolveName",
+			Handler:    _StoreService_ResolveName_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "Push",
@@ -518,6 +1301,12 @@ var StoreService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "PushMany",
+			Handler:       _StoreService_PushMany_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "Pull",
 			Handler:       _StoreService_Pull_Handler,
@@ -547,6 +1336,11 @@ var StoreService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ValidateStored",
+			Handler:       _StoreService_ValidateStored_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "agntcy/dir/store/v1/store_service.proto",
 }
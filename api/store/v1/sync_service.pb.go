@@ -10,8 +10,10 @@
 package v1
 
 import (
+	v1 "github.com/agntcy/dir/api/search/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -105,7 +107,14 @@ type CreateSyncRequest struct {
 	RemoteDirectoryUrl string `protobuf:"bytes,1,opt,name=remote_directory_url,json=remoteDirectoryUrl,proto3" json:"remote_directory_url,omitempty"`
 	// List of CIDs to synchronize from the remote Directory.
 	// If empty, all objects will be synchronized.
-	Cids          []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+	Cids []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+	// Mappings of remote record names into local namespaces, first match wins.
+	// Mirrored records with a matching name are renamed, so that they cannot
+	// collide with or impersonate local records.
+	NamespaceMappings []*NamespaceMapping `protobuf:"bytes,3,rep,name=namespace_mappings,json=namespaceMappings,proto3" json:"namespace_mappings,omitempty"`
+	// Trust policy verifying the signatures of the imported records.
+	// If unset, records are imported without signature verification.
+	TrustPolicy   *SyncTrustPolicy `protobuf:"bytes,4,opt,name=trust_policy,json=trustPolicy,proto3" json:"trust_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -154,6 +163,131 @@ func (x *CreateSyncRequest) GetCids() []string {
 	return nil
 }
 
+func (x *CreateSyncRequest) GetNamespaceMappings() []*NamespaceMapping {
+	if x != nil {
+		return x.NamespaceMappings
+	}
+	return nil
+}
+
+func (x *CreateSyncRequest) GetTrustPolicy() *SyncTrustPolicy {
+	if x != nil {
+		return x.TrustPolicy
+	}
+	return nil
+}
+
+// SyncTrustPolicy requires records imported by a synchronization to be signed by trust roots.
+//
+// Records without a valid signature from one of the trust roots are quarantined:
+// they are stored but not indexed, and hidden from search until released with ReleaseQuarantinedRecord.
+// Signatures are verified on the mirrored records, before they are renamed by namespace mappings.
+type SyncTrustPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PEM-encoded public keys of the trust roots.
+	// If empty, records are imported without signature verification.
+	TrustedPublicKeys []string `protobuf:"bytes,1,rep,name=trusted_public_keys,json=trustedPublicKeys,proto3" json:"trusted_public_keys,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SyncTrustPolicy) Reset() {
+	*x = SyncTrustPolicy{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncTrustPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncTrustPolicy) ProtoMessage() {}
+
+func (x *SyncTrustPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncTrustPolicy.ProtoReflect.Descriptor instead.
+func (*SyncTrustPolicy) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SyncTrustPolicy) GetTrustedPublicKeys() []string {
+	if x != nil {
+		return x.TrustedPublicKeys
+	}
+	return nil
+}
+
+// NamespaceMapping maps remote record names into a local namespace.
+//
+// Patterns ending with "*" match name prefixes, e.g. "acme/*" to "mirrors/acme/*"
+// renames "acme/agent" to "mirrors/acme/agent". Other patterns match exact names.
+// Renamed records are new records linked to the mirrored ones through their
+// previous_record_cid field, and are not covered by the signatures of the mirrored records.
+type NamespaceMapping struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pattern of the remote record names.
+	Remote string `protobuf:"bytes,1,opt,name=remote,proto3" json:"remote,omitempty"`
+	// Pattern of the local record names, ending with "*" if the remote pattern does.
+	Local         string `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespaceMapping) Reset() {
+	*x = NamespaceMapping{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceMapping) ProtoMessage() {}
+
+func (x *NamespaceMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceMapping.ProtoReflect.Descriptor instead.
+func (*NamespaceMapping) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NamespaceMapping) GetRemote() string {
+	if x != nil {
+		return x.Remote
+	}
+	return ""
+}
+
+func (x *NamespaceMapping) GetLocal() string {
+	if x != nil {
+		return x.Local
+	}
+	return ""
+}
+
 // CreateSyncResponse contains the result of creating a new synchronization operation.
 type CreateSyncResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -166,7 +300,7 @@ type CreateSyncResponse struct {
 
 func (x *CreateSyncResponse) Reset() {
 	*x = CreateSyncResponse{}
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[1]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -178,7 +312,7 @@ func (x *CreateSyncResponse) String() string {
 func (*CreateSyncResponse) ProtoMessage() {}
 
 func (x *CreateSyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[1]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,7 +325,7 @@ func (x *CreateSyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSyncResponse.ProtoReflect.Descriptor instead.
 func (*CreateSyncResponse) Descriptor() ([]byte, []int) {
-	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{1}
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateSyncResponse) GetSyncId() string {
@@ -214,7 +348,7 @@ type ListSyncsRequest struct {
 
 func (x *ListSyncsRequest) Reset() {
 	*x = ListSyncsRequest{}
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[2]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -226,7 +360,7 @@ func (x *ListSyncsRequest) String() string {
 func (*ListSyncsRequest) ProtoMessage() {}
 
 func (x *ListSyncsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[2]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,7 +373,7 @@ func (x *ListSyncsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSyncsRequest.ProtoReflect.Descriptor instead.
 func (*ListSyncsRequest) Descriptor() ([]byte, []int) {
-	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{2}
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListSyncsRequest) GetLimit() uint32 {
@@ -271,7 +405,7 @@ type ListSyncsItem struct {
 
 func (x *ListSyncsItem) Reset() {
 	*x = ListSyncsItem{}
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[3]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -283,7 +417,7 @@ func (x *ListSyncsItem) String() string {
 func (*ListSyncsItem) ProtoMessage() {}
 
 func (x *ListSyncsItem) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[3]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +430,7 @@ func (x *ListSyncsItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSyncsItem.ProtoReflect.Descriptor instead.
 func (*ListSyncsItem) Descriptor() ([]byte, []int) {
-	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{3}
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListSyncsItem) GetSyncId() string {
@@ -331,7 +465,7 @@ type GetSyncRequest struct {
 
 func (x *GetSyncRequest) Reset() {
 	*x = GetSyncRequest{}
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[4]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +477,7 @@ func (x *GetSyncRequest) String() string {
 func (*GetSyncRequest) ProtoMessage() {}
 
 func (x *GetSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[4]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +490,7 @@ func (x *GetSyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSyncRequest.ProtoReflect.Descriptor instead.
 func (*GetSyncRequest) Descriptor() ([]byte, []int) {
-	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{4}
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetSyncRequest) GetSyncId() string {
@@ -380,13 +514,23 @@ type GetSyncResponse struct {
 	CreatedTime string `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
 	// Timestamp of the most recent status update for this synchronization in the RFC3339 format.
 	LastUpdateTime string `protobuf:"bytes,5,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Highest number of records fetched concurrently for this synchronization.
+	Parallelism uint32 `protobuf:"varint,6,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	// Number of records fetched and indexed for this synchronization.
+	SyncedRecords uint64 `protobuf:"varint,7,opt,name=synced_records,json=syncedRecords,proto3" json:"synced_records,omitempty"`
+	// Average throughput of record fetching in records per second.
+	Throughput float64 `protobuf:"fixed64,8,opt,name=throughput,proto3" json:"throughput,omitempty"`
+	// Mappings of remote record names into local namespaces.
+	NamespaceMappings []*NamespaceMapping `protobuf:"bytes,9,rep,name=namespace_mappings,json=namespaceMappings,proto3" json:"namespace_mappings,omitempty"`
+	// Trust policy verifying the signatures of the imported records.
+	TrustPolicy   *SyncTrustPolicy `protobuf:"bytes,10,opt,name=trust_policy,json=trustPolicy,proto3" json:"trust_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSyncResponse) Reset() {
 	*x = GetSyncResponse{}
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[5]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +542,7 @@ func (x *GetSyncResponse) String() string {
 func (*GetSyncResponse) ProtoMessage() {}
 
 func (x *GetSyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[5]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +555,7 @@ func (x *GetSyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSyncResponse.ProtoReflect.Descriptor instead.
 func (*GetSyncResponse) Descriptor() ([]byte, []int) {
-	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{5}
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetSyncResponse) GetSyncId() string {
@@ -449,6 +593,41 @@ func (x *GetSyncResponse) GetLastUpdateTime() string {
 	return ""
 }
 
+func (x *GetSyncResponse) GetParallelism() uint32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *GetSyncResponse) GetSyncedRecords() uint64 {
+	if x != nil {
+		return x.SyncedRecords
+	}
+	return 0
+}
+
+func (x *GetSyncResponse) GetThroughput() float64 {
+	if x != nil {
+		return x.Throughput
+	}
+	return 0
+}
+
+func (x *GetSyncResponse) GetNamespaceMappings() []*NamespaceMapping {
+	if x != nil {
+		return x.NamespaceMappings
+	}
+	return nil
+}
+
+func (x *GetSyncResponse) GetTrustPolicy() *SyncTrustPolicy {
+	if x != nil {
+		return x.TrustPolicy
+	}
+	return nil
+}
+
 // DeleteSyncRequest specifies which synchronization to delete.
 type DeleteSyncRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -460,7 +639,7 @@ type DeleteSyncRequest struct {
 
 func (x *DeleteSyncRequest) Reset() {
 	*x = DeleteSyncRequest{}
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[6]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -472,7 +651,7 @@ func (x *DeleteSyncRequest) String() string {
 func (*DeleteSyncRequest) ProtoMessage() {}
 
 func (x *DeleteSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[6]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +664,7 @@ func (x *DeleteSyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSyncRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSyncRequest) Descriptor() ([]byte, []int) {
-	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{6}
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteSyncRequest) GetSyncId() string {
@@ -504,7 +683,7 @@ type DeleteSyncResponse struct {
 
 func (x *DeleteSyncResponse) Reset() {
 	*x = DeleteSyncResponse{}
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[7]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -516,7 +695,7 @@ func (x *DeleteSyncResponse) String() string {
 func (*DeleteSyncResponse) ProtoMessage() {}
 
 func (x *DeleteSyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[7]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +708,7 @@ func (x *DeleteSyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSyncResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSyncResponse) Descriptor() ([]byte, []int) {
-	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{7}
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{9}
 }
 
 type RequestRegistryCredentialsRequest struct {
@@ -537,13 +716,16 @@ type RequestRegistryCredentialsRequest struct {
 	// Identity of the requesting node
 	// For example: spiffe://example.org/service/foo
 	RequestingNodeId string `protobuf:"bytes,1,opt,name=requesting_node_id,json=requestingNodeId,proto3" json:"requesting_node_id,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Invitation token issued by this node with CreateSyncInvitation, if any.
+	// Credentials are refused if the token is invalid or expired.
+	InvitationToken string `protobuf:"bytes,2,opt,name=invitation_token,json=invitationToken,proto3" json:"invitation_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *RequestRegistryCredentialsRequest) Reset() {
 	*x = RequestRegistryCredentialsRequest{}
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[8]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +737,7 @@ func (x *RequestRegistryCredentialsRequest) String() string {
 func (*RequestRegistryCredentialsRequest) ProtoMessage() {}
 
 func (x *RequestRegistryCredentialsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[8]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +750,7 @@ func (x *RequestRegistryCredentialsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RequestRegistryCredentialsRequest.ProtoReflect.Descriptor instead.
 func (*RequestRegistryCredentialsRequest) Descriptor() ([]byte, []int) {
-	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{8}
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RequestRegistryCredentialsRequest) GetRequestingNodeId() string {
@@ -578,6 +760,13 @@ func (x *RequestRegistryCredentialsRequest) GetRequestingNodeId() string {
 	return ""
 }
 
+func (x *RequestRegistryCredentialsRequest) GetInvitationToken() string {
+	if x != nil {
+		return x.InvitationToken
+	}
+	return ""
+}
+
 type RequestRegistryCredentialsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Success status of the credential negotiation
@@ -598,7 +787,7 @@ type RequestRegistryCredentialsResponse struct {
 
 func (x *RequestRegistryCredentialsResponse) Reset() {
 	*x = RequestRegistryCredentialsResponse{}
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[9]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -610,7 +799,7 @@ func (x *RequestRegistryCredentialsResponse) String() string {
 func (*RequestRegistryCredentialsResponse) ProtoMessage() {}
 
 func (x *RequestRegistryCredentialsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[9]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +812,7 @@ func (x *RequestRegistryCredentialsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RequestRegistryCredentialsResponse.ProtoReflect.Descriptor instead.
 func (*RequestRegistryCredentialsResponse) Descriptor() ([]byte, []int) {
-	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{9}
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RequestRegistryCredentialsResponse) GetSuccess() bool {
@@ -685,7 +874,7 @@ type BasicAuthCredentials struct {
 
 func (x *BasicAuthCredentials) Reset() {
 	*x = BasicAuthCredentials{}
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[10]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -697,7 +886,7 @@ func (x *BasicAuthCredentials) String() string {
 func (*BasicAuthCredentials) ProtoMessage() {}
 
 func (x *BasicAuthCredentials) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[10]
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +899,7 @@ func (x *BasicAuthCredentials) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BasicAuthCredentials.ProtoReflect.Descriptor instead.
 func (*BasicAuthCredentials) Descriptor() ([]byte, []int) {
-	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{10}
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *BasicAuthCredentials) GetUsername() string {
@@ -727,140 +916,1153 @@ func (x *BasicAuthCredentials) GetPassword() string {
 	return ""
 }
 
-var File_agntcy_dir_store_v1_sync_service_proto protoreflect.FileDescriptor
+// WarmCacheRequest specifies the source and the records to prefetch.
+type WarmCacheRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of an existing synchronization whose remote Directory is used as the source.
+	// Exactly one of sync_id or remote_directory_url must be set.
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// URL of the remote Directory to prefetch records from.
+	// Exactly one of sync_id or remote_directory_url must be set.
+	RemoteDirectoryUrl string `protobuf:"bytes,2,opt,name=remote_directory_url,json=remoteDirectoryUrl,proto3" json:"remote_directory_url,omitempty"`
+	// List of CIDs to prefetch from the remote Directory.
+	Cids []string `protobuf:"bytes,3,rep,name=cids,proto3" json:"cids,omitempty"`
+	// Search queries evaluated on the remote Directory to select records to prefetch.
+	// Records matching the queries are prefetched in addition to the listed CIDs.
+	//
+	// If neither cids nor queries are set, the CIDs tracked by the sync are used
+	// when sync_id is set, otherwise all records of the remote Directory are prefetched.
+	Queries []*v1.RecordQuery `protobuf:"bytes,4,rep,name=queries,proto3" json:"queries,omitempty"`
+	// If set, prefetch the records in the background and return immediately
+	// with the ID of the operation, which can be tracked with the OperationService.
+	// The WarmCacheResponse is the response of the operation once it succeeded.
+	Async         bool `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var file_agntcy_dir_store_v1_sync_service_proto_rawDesc = string([]byte{
-	0x0a, 0x26, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x2f, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79,
-	0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x59, 0x0a,
-	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x2d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
-	0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x79, 0x6e, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a,
-	0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79,
-	0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e,
-	0x63, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x14,
-	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x22, 0x29,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a,
-	0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e,
-	0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e,
-	0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x30, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x72,
-	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c,
-	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x51, 0x0a, 0x21, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4e,
-	0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0xee, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x4a, 0x0a, 0x0a, 0x62,
-	0x61, 0x73, 0x69, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x29, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x09, 0x62, 0x61,
-	0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x42, 0x0d, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x4e, 0x0a, 0x14, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41,
-	0x75, 0x74, 0x68, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2a, 0xb0, 0x01, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53,
-	0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52,
-	0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x59, 0x4e, 0x43,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03,
-	0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x04,
-	0x12, 0x17, 0x0a, 0x13, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x05, 0x32, 0x8b, 0x04, 0x0a, 0x0b, 0x53, 0x79,
-	0x6e, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x26, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79,
-	0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x79, 0x6e, 0x63, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64,
-	0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x79, 0x6e, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61,
-	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x49, 0x74, 0x65, 0x6d,
-	0x30, 0x01, 0x12, 0x54, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x23, 0x2e,
-	0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x26, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e,
-	0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
-	0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x36, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e,
-	0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37,
-	0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xbe, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e,
-	0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x42, 0x10, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x44,
-	0x53, 0xaa, 0x02, 0x13, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x44, 0x69, 0x72, 0x2e, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79,
-	0x5c, 0x44, 0x69, 0x72, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f,
-	0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x5c, 0x44, 0x69, 0x72, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x16, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x3a, 0x3a, 0x44, 0x69, 0x72, 0x3a, 0x3a, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *WarmCacheRequest) Reset() {
+	*x = WarmCacheRequest{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarmCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmCacheRequest) ProtoMessage() {}
+
+func (x *WarmCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarmCacheRequest.ProtoReflect.Descriptor instead.
+func (*WarmCacheRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WarmCacheRequest) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *WarmCacheRequest) GetRemoteDirectoryUrl() string {
+	if x != nil {
+		return x.RemoteDirectoryUrl
+	}
+	return ""
+}
+
+func (x *WarmCacheRequest) GetCids() []string {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+func (x *WarmCacheRequest) GetQueries() []*v1.RecordQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *WarmCacheRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+// WarmCacheResponse summarizes the result of a cache warming operation.
+type WarmCacheResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of records fetched from the remote Directory and stored locally.
+	FetchedCount uint32 `protobuf:"varint,1,opt,name=fetched_count,json=fetchedCount,proto3" json:"fetched_count,omitempty"`
+	// Number of records that were already present in the local store.
+	CachedCount uint32 `protobuf:"varint,2,opt,name=cached_count,json=cachedCount,proto3" json:"cached_count,omitempty"`
+	// CIDs of records that could not be prefetched.
+	FailedCids []string `protobuf:"bytes,3,rep,name=failed_cids,json=failedCids,proto3" json:"failed_cids,omitempty"`
+	// ID of the operation prefetching the records, set if async was set.
+	OperationId   string `protobuf:"bytes,4,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarmCacheResponse) Reset() {
+	*x = WarmCacheResponse{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarmCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmCacheResponse) ProtoMessage() {}
+
+func (x *WarmCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarmCacheResponse.ProtoReflect.Descriptor instead.
+func (*WarmCacheResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WarmCacheResponse) GetFetchedCount() uint32 {
+	if x != nil {
+		return x.FetchedCount
+	}
+	return 0
+}
+
+func (x *WarmCacheResponse) GetCachedCount() uint32 {
+	if x != nil {
+		return x.CachedCount
+	}
+	return 0
+}
+
+func (x *WarmCacheResponse) GetFailedCids() []string {
+	if x != nil {
+		return x.FailedCids
+	}
+	return nil
+}
+
+func (x *WarmCacheResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// CreateSyncInvitationRequest defines the records a remote Directory node is invited to synchronize.
+type CreateSyncInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of CIDs the remote Directory node synchronizes.
+	// If empty, all objects are synchronized.
+	Cids []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
+	// Validity period of the invitation.
+	// Defaults to the validity period configured on the server.
+	Ttl           *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3,oneof" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSyncInvitationRequest) Reset() {
+	*x = CreateSyncInvitationRequest{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSyncInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSyncInvitationRequest) ProtoMessage() {}
+
+func (x *CreateSyncInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSyncInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateSyncInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateSyncInvitationRequest) GetCids() []string {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+func (x *CreateSyncInvitationRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// CreateSyncInvitationResponse contains the issued invitation.
+type CreateSyncInvitationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Invitation token to pass to AcceptSyncInvitation on the remote Directory node.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Expiration time of the invitation, in RFC3339 format.
+	ExpiresTime   string `protobuf:"bytes,2,opt,name=expires_time,json=expiresTime,proto3" json:"expires_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSyncInvitationResponse) Reset() {
+	*x = CreateSyncInvitationResponse{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSyncInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSyncInvitationResponse) ProtoMessage() {}
+
+func (x *CreateSyncInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSyncInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CreateSyncInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateSyncInvitationResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateSyncInvitationResponse) GetExpiresTime() string {
+	if x != nil {
+		return x.ExpiresTime
+	}
+	return ""
+}
+
+// AcceptSyncInvitationRequest contains the invitation to accept.
+type AcceptSyncInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Invitation token issued by the remote Directory node.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Mappings of remote record names into local namespaces, as for CreateSync.
+	NamespaceMappings []*NamespaceMapping `protobuf:"bytes,2,rep,name=namespace_mappings,json=namespaceMappings,proto3" json:"namespace_mappings,omitempty"`
+	// Trust policy verifying the signatures of the imported records, as for CreateSync.
+	TrustPolicy   *SyncTrustPolicy `protobuf:"bytes,3,opt,name=trust_policy,json=trustPolicy,proto3" json:"trust_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptSyncInvitationRequest) Reset() {
+	*x = AcceptSyncInvitationRequest{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptSyncInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptSyncInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptSyncInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptSyncInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptSyncInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AcceptSyncInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptSyncInvitationRequest) GetNamespaceMappings() []*NamespaceMapping {
+	if x != nil {
+		return x.NamespaceMappings
+	}
+	return nil
+}
+
+func (x *AcceptSyncInvitationRequest) GetTrustPolicy() *SyncTrustPolicy {
+	if x != nil {
+		return x.TrustPolicy
+	}
+	return nil
+}
+
+// AcceptSyncInvitationResponse describes the synchronization created from the invitation.
+type AcceptSyncInvitationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the created synchronization operation.
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// URL of the remote Directory node that issued the invitation.
+	RemoteDirectoryUrl string `protobuf:"bytes,2,opt,name=remote_directory_url,json=remoteDirectoryUrl,proto3" json:"remote_directory_url,omitempty"`
+	// List of CIDs synchronized from the remote Directory node.
+	// If empty, all objects are synchronized.
+	Cids          []string `protobuf:"bytes,3,rep,name=cids,proto3" json:"cids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptSyncInvitationResponse) Reset() {
+	*x = AcceptSyncInvitationResponse{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptSyncInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptSyncInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptSyncInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptSyncInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptSyncInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AcceptSyncInvitationResponse) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *AcceptSyncInvitationResponse) GetRemoteDirectoryUrl() string {
+	if x != nil {
+		return x.RemoteDirectoryUrl
+	}
+	return ""
+}
+
+func (x *AcceptSyncInvitationResponse) GetCids() []string {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+// CIDRange is a range of the lexically sorted set of CIDs of a Directory node,
+// summarized by the number of CIDs in the range and their fingerprint.
+type CIDRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lower bound of the range, inclusive.
+	// Empty for ranges starting at the first CID.
+	Lower string `protobuf:"bytes,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	// Upper bound of the range, exclusive.
+	// Empty for ranges ending after the last CID.
+	Upper string `protobuf:"bytes,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	// Number of CIDs in the range.
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// XOR of the SHA-256 hashes of the CIDs in the range.
+	Fingerprint   []byte `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CIDRange) Reset() {
+	*x = CIDRange{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CIDRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CIDRange) ProtoMessage() {}
+
+func (x *CIDRange) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CIDRange.ProtoReflect.Descriptor instead.
+func (*CIDRange) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CIDRange) GetLower() string {
+	if x != nil {
+		return x.Lower
+	}
+	return ""
+}
+
+func (x *CIDRange) GetUpper() string {
+	if x != nil {
+		return x.Upper
+	}
+	return ""
+}
+
+func (x *CIDRange) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CIDRange) GetFingerprint() []byte {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return nil
+}
+
+// ReconcileCIDsRequest contains the ranges of the set of CIDs of the requesting node.
+type ReconcileCIDsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ranges to compare, summarized with the CIDs of the requesting node.
+	// The first round compares the whole set, as a single range with empty bounds.
+	Ranges []*CIDRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	// Maximum number of CIDs returned for a mismatching range before it is split.
+	// Defaults to a server-defined value.
+	ItemThreshold uint32 `protobuf:"varint,2,opt,name=item_threshold,json=itemThreshold,proto3" json:"item_threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileCIDsRequest) Reset() {
+	*x = ReconcileCIDsRequest{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileCIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileCIDsRequest) ProtoMessage() {}
+
+func (x *ReconcileCIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileCIDsRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileCIDsRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReconcileCIDsRequest) GetRanges() []*CIDRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+func (x *ReconcileCIDsRequest) GetItemThreshold() uint32 {
+	if x != nil {
+		return x.ItemThreshold
+	}
+	return 0
+}
+
+// ReconcileCIDsResponse contains the result of the comparison of the requested ranges.
+// Ranges with matching fingerprints are omitted.
+type ReconcileCIDsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CIDs stored on this node in small mismatching ranges.
+	// The requesting node is missing the ones it does not store.
+	Cids []string `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
+	// Subranges of large mismatching ranges, summarized with the CIDs of this node.
+	// The requesting node compares them with its own CIDs and requests the mismatching ones.
+	Ranges        []*CIDRange `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileCIDsResponse) Reset() {
+	*x = ReconcileCIDsResponse{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileCIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileCIDsResponse) ProtoMessage() {}
+
+func (x *ReconcileCIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileCIDsResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileCIDsResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReconcileCIDsResponse) GetCids() []string {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+func (x *ReconcileCIDsResponse) GetRanges() []*CIDRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+// ListQuarantinedRecordsRequest specifies parameters for listing quarantined records.
+type ListQuarantinedRecordsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional identifier of the synchronization that imported the records.
+	// If empty, the records quarantined by all synchronizations are listed.
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// Optional limit on the number of results to return.
+	Limit *uint32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// Optional offset for pagination of results.
+	Offset        *uint32 `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuarantinedRecordsRequest) Reset() {
+	*x = ListQuarantinedRecordsRequest{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuarantinedRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuarantinedRecordsRequest) ProtoMessage() {}
+
+func (x *ListQuarantinedRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuarantinedRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListQuarantinedRecordsRequest) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *ListQuarantinedRecordsRequest) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListQuarantinedRecordsRequest) GetOffset() uint32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+// QuarantinedRecord represents a record imported by a synchronization that failed its trust policy.
+type QuarantinedRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CID of the quarantined record, as mirrored from the remote Directory.
+	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	// Unique identifier of the synchronization that imported the record.
+	SyncId string `protobuf:"bytes,2,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// Reason the record failed the trust policy.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Timestamp when the record was quarantined in the RFC3339 format.
+	QuarantinedTime string `protobuf:"bytes,4,opt,name=quarantined_time,json=quarantinedTime,proto3" json:"quarantined_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuarantinedRecord) Reset() {
+	*x = QuarantinedRecord{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuarantinedRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuarantinedRecord) ProtoMessage() {}
+
+func (x *QuarantinedRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuarantinedRecord.ProtoReflect.Descriptor instead.
+func (*QuarantinedRecord) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QuarantinedRecord) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *QuarantinedRecord) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *QuarantinedRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *QuarantinedRecord) GetQuarantinedTime() string {
+	if x != nil {
+		return x.QuarantinedTime
+	}
+	return ""
+}
+
+// ReleaseQuarantinedRecordRequest specifies which quarantined record to release.
+type ReleaseQuarantinedRecordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CID of the quarantined record.
+	Cid           string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseQuarantinedRecordRequest) Reset() {
+	*x = ReleaseQuarantinedRecordRequest{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseQuarantinedRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseQuarantinedRecordRequest) ProtoMessage() {}
+
+func (x *ReleaseQuarantinedRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseQuarantinedRecordRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseQuarantinedRecordRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReleaseQuarantinedRecordRequest) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+// ReleaseQuarantinedRecordResponse contains the result of releasing a quarantined record.
+type ReleaseQuarantinedRecordResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CID of the indexed record.
+	// Differs from the CID of the quarantined record if it was renamed by a namespace mapping.
+	Cid           string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseQuarantinedRecordResponse) Reset() {
+	*x = ReleaseQuarantinedRecordResponse{}
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseQuarantinedRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseQuarantinedRecordResponse) ProtoMessage() {}
+
+func (x *ReleaseQuarantinedRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_dir_store_v1_sync_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseQuarantinedRecordResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseQuarantinedRecordResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReleaseQuarantinedRecordResponse) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+var File_agntcy_dir_store_v1_sync_service_proto protoreflect.FileDescriptor
+
+var file_agntcy_dir_store_v1_sync_service_proto_rawDesc = string([]byte{
+	0x0a, 0x26, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x2f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79,
+	0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x27, 0x61,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf8, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69,
+	0x64, 0x73, 0x12, 0x54, 0x0a, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
+	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x11, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x75, 0x73, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x22, 0x41, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x75, 0x73, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x11, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x73, 0x22, 0x40, 0x0a, 0x10, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0x2d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x79, 0x6e, 0x63, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49,
+	0x64, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x22, 0x29, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x22, 0xea, 0x03, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
+	0x6e, 0x63, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69,
+	0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68,
+	0x70, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x6f, 0x75,
+	0x67, 0x68, 0x70, 0x75, 0x74, 0x12, 0x54, 0x0a, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x11, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x73, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79,
+	0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63,
+	0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x21, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
+	0x12, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xee, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x4a, 0x0a, 0x0a,
+	0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x09, 0x62,
+	0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x42, 0x0d, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x4e, 0x0a, 0x14, 0x42, 0x61, 0x73, 0x69, 0x63,
+	0x41, 0x75, 0x74, 0x68, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xc4, 0x01, 0x0a, 0x10, 0x57, 0x61, 0x72, 0x6d,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x79, 0x6e, 0x63, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x71,
+	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e,
+	0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x9f,
+	0x01, 0x0a, 0x11, 0x57, 0x61, 0x72, 0x6d, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x66, 0x65, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x6b, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x69, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x74,
+	0x74, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x74, 0x6c, 0x22, 0x57, 0x0a,
+	0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x1b, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x54, 0x0a, 0x12,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63,
+	0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52,
+	0x11, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63,
+	0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x79, 0x6e, 0x63, 0x54, 0x72, 0x75, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x7d, 0x0a, 0x1c, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
+	0x6e, 0x63, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x6e, 0x0a, 0x08, 0x43, 0x49,
+	0x44, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x70, 0x70,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x66,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x22, 0x74, 0x0a, 0x14, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x43, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x49, 0x44, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x22, 0x62, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x43, 0x49, 0x44,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x12, 0x35, 0x0a,
+	0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x49, 0x44, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61,
+	0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x81, 0x01, 0x0a,
+	0x11, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x63, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74,
+	0x69, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x33, 0x0a, 0x1f, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61,
+	0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x20, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x2a, 0xb0, 0x01, 0x0a, 0x0a,
+	0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x59,
+	0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x59, 0x4e, 0x43, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x1b, 0x0a, 0x17, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x16, 0x0a,
+	0x12, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x05, 0x32, 0xcb,
+	0x09, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d,
+	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x26, 0x2e, 0x61,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69,
+	0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a,
+	0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x67, 0x6e,
+	0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63,
+	0x73, 0x49, 0x74, 0x65, 0x6d, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x6e, 0x63, 0x12, 0x23, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79,
+	0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a,
+	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x26, 0x2e, 0x61, 0x67,
+	0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a,
+	0x1a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x36, 0x2e, 0x61, 0x67,
+	0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x09,
+	0x57, 0x61, 0x72, 0x6d, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x25, 0x2e, 0x61, 0x67, 0x6e, 0x74,
+	0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x61, 0x72, 0x6d, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x72, 0x6d, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e,
+	0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x79, 0x6e, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e,
+	0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x31, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x79, 0x6e, 0x63,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x66, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x43,
+	0x49, 0x44, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x43, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x43, 0x49,
+	0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x32, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69,
+	0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51,
+	0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63,
+	0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x30, 0x01, 0x12, 0x87, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x51, 0x75,
+	0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x34, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x51, 0x75, 0x61,
+	0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64,
+	0x69, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xbe, 0x01, 0x0a,
+	0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e, 0x64, 0x69, 0x72, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x22, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2f,
+	0x64, 0x69, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x41, 0x44, 0x53, 0xaa, 0x02, 0x13, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x2e,
+	0x44, 0x69, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x41,
+	0x67, 0x6e, 0x74, 0x63, 0x79, 0x5c, 0x44, 0x69, 0x72, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x1f, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x5c, 0x44, 0x69, 0x72, 0x5c,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x41, 0x67, 0x6e, 0x74, 0x63, 0x79, 0x3a, 0x3a, 0x44,
+	0x69, 0x72, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -876,40 +2078,79 @@ func file_agntcy_dir_store_v1_sync_service_proto_rawDescGZIP() []byte {
 }
 
 var file_agntcy_dir_store_v1_sync_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_agntcy_dir_store_v1_sync_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_agntcy_dir_store_v1_sync_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_agntcy_dir_store_v1_sync_service_proto_goTypes = []any{
 	(SyncStatus)(0),                            // 0: agntcy.dir.store.v1.SyncStatus
 	(*CreateSyncRequest)(nil),                  // 1: agntcy.dir.store.v1.CreateSyncRequest
-	(*CreateSyncResponse)(nil),                 // 2: agntcy.dir.store.v1.CreateSyncResponse
-	(*ListSyncsRequest)(nil),                   // 3: agntcy.dir.store.v1.ListSyncsRequest
-	(*ListSyncsItem)(nil),                      // 4: agntcy.dir.store.v1.ListSyncsItem
-	(*GetSyncRequest)(nil),                     // 5: agntcy.dir.store.v1.GetSyncRequest
-	(*GetSyncResponse)(nil),                    // 6: agntcy.dir.store.v1.GetSyncResponse
-	(*DeleteSyncRequest)(nil),                  // 7: agntcy.dir.store.v1.DeleteSyncRequest
-	(*DeleteSyncResponse)(nil),                 // 8: agntcy.dir.store.v1.DeleteSyncResponse
-	(*RequestRegistryCredentialsRequest)(nil),  // 9: agntcy.dir.store.v1.RequestRegistryCredentialsRequest
-	(*RequestRegistryCredentialsResponse)(nil), // 10: agntcy.dir.store.v1.RequestRegistryCredentialsResponse
-	(*BasicAuthCredentials)(nil),               // 11: agntcy.dir.store.v1.BasicAuthCredentials
+	(*SyncTrustPolicy)(nil),                    // 2: agntcy.dir.store.v1.SyncTrustPolicy
+	(*NamespaceMapping)(nil),                   // 3: agntcy.dir.store.v1.NamespaceMapping
+	(*CreateSyncResponse)(nil),                 // 4: agntcy.dir.store.v1.CreateSyncResponse
+	(*ListSyncsRequest)(nil),                   // 5: agntcy.dir.store.v1.ListSyncsRequest
+	(*ListSyncsItem)(nil),                      // 6: agntcy.dir.store.v1.ListSyncsItem
+	(*GetSyncRequest)(nil),                     // 7: agntcy.dir.store.v1.GetSyncRequest
+	(*GetSyncResponse)(nil),                    // 8: agntcy.dir.store.v1.GetSyncResponse
+	(*DeleteSyncRequest)(nil),                  // 9: agntcy.dir.store.v1.DeleteSyncRequest
+	(*DeleteSyncResponse)(nil),                 // 10: agntcy.dir.store.v1.DeleteSyncResponse
+	(*RequestRegistryCredentialsRequest)(nil),  // 11: agntcy.dir.store.v1.RequestRegistryCredentialsRequest
+	(*RequestRegistryCredentialsResponse)(nil), // 12: agntcy.dir.store.v1.RequestRegistryCredentialsResponse
+	(*BasicAuthCredentials)(nil),               // 13: agntcy.dir.store.v1.BasicAuthCredentials
+	(*WarmCacheRequest)(nil),                   // 14: agntcy.dir.store.v1.WarmCacheRequest
+	(*WarmCacheResponse)(nil),                  // 15: agntcy.dir.store.v1.WarmCacheResponse
+	(*CreateSyncInvitationRequest)(nil),        // 16: agntcy.dir.store.v1.CreateSyncInvitationRequest
+	(*CreateSyncInvitationResponse)(nil),       // 17: agntcy.dir.store.v1.CreateSyncInvitationResponse
+	(*AcceptSyncInvitationRequest)(nil),        // 18: agntcy.dir.store.v1.AcceptSyncInvitationRequest
+	(*AcceptSyncInvitationResponse)(nil),       // 19: agntcy.dir.store.v1.AcceptSyncInvitationResponse
+	(*CIDRange)(nil),                           // 20: agntcy.dir.store.v1.CIDRange
+	(*ReconcileCIDsRequest)(nil),               // 21: agntcy.dir.store.v1.ReconcileCIDsRequest
+	(*ReconcileCIDsResponse)(nil),              // 22: agntcy.dir.store.v1.ReconcileCIDsResponse
+	(*ListQuarantinedRecordsRequest)(nil),      // 23: agntcy.dir.store.v1.ListQuarantinedRecordsRequest
+	(*QuarantinedRecord)(nil),                  // 24: agntcy.dir.store.v1.QuarantinedRecord
+	(*ReleaseQuarantinedRecordRequest)(nil),    // 25: agntcy.dir.store.v1.ReleaseQuarantinedRecordRequest
+	(*ReleaseQuarantinedRecordResponse)(nil),   // 26: agntcy.dir.store.v1.ReleaseQuarantinedRecordResponse
+	(*v1.RecordQuery)(nil),                     // 27: agntcy.dir.search.v1.RecordQuery
+	(*durationpb.Duration)(nil),                // 28: google.protobuf.Duration
 }
 var file_agntcy_dir_store_v1_sync_service_proto_depIdxs = []int32{
-	0,  // 0: agntcy.dir.store.v1.ListSyncsItem.status:type_name -> agntcy.dir.store.v1.SyncStatus
-	0,  // 1: agntcy.dir.store.v1.GetSyncResponse.status:type_name -> agntcy.dir.store.v1.SyncStatus
-	11, // 2: agntcy.dir.store.v1.RequestRegistryCredentialsResponse.basic_auth:type_name -> agntcy.dir.store.v1.BasicAuthCredentials
-	1,  // 3: agntcy.dir.store.v1.SyncService.CreateSync:input_type -> agntcy.dir.store.v1.CreateSyncRequest
-	3,  // 4: agntcy.dir.store.v1.SyncService.ListSyncs:input_type -> agntcy.dir.store.v1.ListSyncsRequest
-	5,  // 5: agntcy.dir.store.v1.SyncService.GetSync:input_type -> agntcy.dir.store.v1.GetSyncRequest
-	7,  // 6: agntcy.dir.store.v1.SyncService.DeleteSync:input_type -> agntcy.dir.store.v1.DeleteSyncRequest
-	9,  // 7: agntcy.dir.store.v1.SyncService.RequestRegistryCredentials:input_type -> agntcy.dir.store.v1.RequestRegistryCredentialsRequest
-	2,  // 8: agntcy.dir.store.v1.SyncService.CreateSync:output_type -> agntcy.dir.store.v1.CreateSyncResponse
-	4,  // 9: agntcy.dir.store.v1.SyncService.ListSyncs:output_type -> agntcy.dir.store.v1.ListSyncsItem
-	6,  // 10: agntcy.dir.store.v1.SyncService.GetSync:output_type -> agntcy.dir.store.v1.GetSyncResponse
-	8,  // 11: agntcy.dir.store.v1.SyncService.DeleteSync:output_type -> agntcy.dir.store.v1.DeleteSyncResponse
-	10, // 12: agntcy.dir.store.v1.SyncService.RequestRegistryCredentials:output_type -> agntcy.dir.store.v1.RequestRegistryCredentialsResponse
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	3,  // 0: agntcy.dir.store.v1.CreateSyncRequest.namespace_mappings:type_name -> agntcy.dir.store.v1.NamespaceMapping
+	2,  // 1: agntcy.dir.store.v1.CreateSyncRequest.trust_policy:type_name -> agntcy.dir.store.v1.SyncTrustPolicy
+	0,  // 2: agntcy.dir.store.v1.ListSyncsItem.status:type_name -> agntcy.dir.store.v1.SyncStatus
+	0,  // 3: agntcy.dir.store.v1.GetSyncResponse.status:type_name -> agntcy.dir.store.v1.SyncStatus
+	3,  // 4: agntcy.dir.store.v1.GetSyncResponse.namespace_mappings:type_name -> agntcy.dir.store.v1.NamespaceMapping
+	2,  // 5: agntcy.dir.store.v1.GetSyncResponse.trust_policy:type_name -> agntcy.dir.store.v1.SyncTrustPolicy
+	13, // 6: agntcy.dir.store.v1.RequestRegistryCredentialsResponse.basic_auth:type_name -> agntcy.dir.store.v1.BasicAuthCredentials
+	27, // 7: agntcy.dir.store.v1.WarmCacheRequest.queries:type_name -> agntcy.dir.search.v1.RecordQuery
+	28, // 8: agntcy.dir.store.v1.CreateSyncInvitationRequest.ttl:type_name -> google.protobuf.Duration
+	3,  // 9: agntcy.dir.store.v1.AcceptSyncInvitationRequest.namespace_mappings:type_name -> agntcy.dir.store.v1.NamespaceMapping
+	2,  // 10: agntcy.dir.store.v1.AcceptSyncInvitationRequest.trust_policy:type_name -> agntcy.dir.store.v1.SyncTrustPolicy
+	20, // 11: agntcy.dir.store.v1.ReconcileCIDsRequest.ranges:type_name -> agntcy.dir.store.v1.CIDRange
+	20, // 12: agntcy.dir.store.v1.ReconcileCIDsResponse.ranges:type_name -> agntcy.dir.store.v1.CIDRange
+	1,  // 13: agntcy.dir.store.v1.SyncService.CreateSync:input_type -> agntcy.dir.store.v1.CreateSyncRequest
+	5,  // 14: agntcy.dir.store.v1.SyncService.ListSyncs:input_type -> agntcy.dir.store.v1.ListSyncsRequest
+	7,  // 15: agntcy.dir.store.v1.SyncService.GetSync:input_type -> agntcy.dir.store.v1.GetSyncRequest
+	9,  // 16: agntcy.dir.store.v1.SyncService.DeleteSync:input_type -> agntcy.dir.store.v1.DeleteSyncRequest
+	11, // 17: agntcy.dir.store.v1.SyncService.RequestRegistryCredentials:input_type -> agntcy.dir.store.v1.RequestRegistryCredentialsRequest
+	14, // 18: agntcy.dir.store.v1.SyncService.WarmCache:input_type -> agntcy.dir.store.v1.WarmCacheRequest
+	16, // 19: agntcy.dir.store.v1.SyncService.CreateSyncInvitation:input_type -> agntcy.dir.store.v1.CreateSyncInvitationRequest
+	18, // 20: agntcy.dir.store.v1.SyncService.AcceptSyncInvitation:input_type -> agntcy.dir.store.v1.AcceptSyncInvitationRequest
+	21, // 21: agntcy.dir.store.v1.SyncService.ReconcileCIDs:input_type -> agntcy.dir.store.v1.ReconcileCIDsRequest
+	23, // 22: agntcy.dir.store.v1.SyncService.ListQuarantinedRecords:input_type -> agntcy.dir.store.v1.ListQuarantinedRecordsRequest
+	25, // 23: agntcy.dir.store.v1.SyncService.ReleaseQuarantinedRecord:input_type -> agntcy.dir.store.v1.ReleaseQuarantinedRecordRequest
+	4,  // 24: agntcy.dir.store.v1.SyncService.CreateSync:output_type -> agntcy.dir.store.v1.CreateSyncResponse
+	6,  // 25: agntcy.dir.store.v1.SyncService.ListSyncs:output_type -> agntcy.dir.store.v1.ListSyncsItem
+	8,  // 26: agntcy.dir.store.v1.SyncService.GetSync:output_type -> agntcy.dir.store.v1.GetSyncResponse
+	10, // 27: agntcy.dir.store.v1.SyncService.DeleteSync:output_type -> agntcy.dir.store.v1.DeleteSyncResponse
+	12, // 28: agntcy.dir.store.v1.SyncService.RequestRegistryCredentials:output_type -> agntcy.dir.store.v1.RequestRegistryCredentialsResponse
+	15, // 29: agntcy.dir.store.v1.SyncService.WarmCache:output_type -> agntcy.dir.store.v1.WarmCacheResponse
+	17, // 30: agntcy.dir.store.v1.SyncService.CreateSyncInvitation:output_type -> agntcy.dir.store.v1.CreateSyncInvitationResponse
+	19, // 31: agntcy.dir.store.v1.SyncService.AcceptSyncInvitation:output_type -> agntcy.dir.store.v1.AcceptSyncInvitationResponse
+	22, // 32: agntcy.dir.store.v1.SyncService.ReconcileCIDs:output_type -> agntcy.dir.store.v1.ReconcileCIDsResponse
+	24, // 33: agntcy.dir.store.v1.SyncService.ListQuarantinedRecords:output_type -> agntcy.dir.store.v1.QuarantinedRecord
+	26, // 34: agntcy.dir.store.v1.SyncService.ReleaseQuarantinedRecord:output_type -> agntcy.dir.store.v1.ReleaseQuarantinedRecordResponse
+	24, // [24:35] is the sub-list for method output_type
+	13, // [13:24] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_agntcy_dir_store_v1_sync_service_proto_init() }
@@ -917,17 +2158,19 @@ func file_agntcy_dir_store_v1_sync_service_proto_init() {
 	if File_agntcy_dir_store_v1_sync_service_proto != nil {
 		return
 	}
-	file_agntcy_dir_store_v1_sync_service_proto_msgTypes[2].OneofWrappers = []any{}
-	file_agntcy_dir_store_v1_sync_service_proto_msgTypes[9].OneofWrappers = []any{
+	file_agntcy_dir_store_v1_sync_service_proto_msgTypes[4].OneofWrappers = []any{}
+	file_agntcy_dir_store_v1_sync_service_proto_msgTypes[11].OneofWrappers = []any{
 		(*RequestRegistryCredentialsResponse_BasicAuth)(nil),
 	}
+	file_agntcy_dir_store_v1_sync_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_agntcy_dir_store_v1_sync_service_proto_msgTypes[22].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_dir_store_v1_sync_service_proto_rawDesc), len(file_agntcy_dir_store_v1_sync_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
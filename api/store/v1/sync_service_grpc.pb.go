@@ -27,6 +27,12 @@ const (
 	SyncService_GetSync_FullMethodName                    = "/agntcy.dir.store.v1.SyncService/GetSync"
 	SyncService_DeleteSync_FullMethodName                 = "/agntcy.dir.store.v1.SyncService/DeleteSync"
 	SyncService_RequestRegistryCredentials_FullMethodName = "/agntcy.dir.store.v1.SyncService/RequestRegistryCredentials"
+	SyncService_WarmCache_FullMethodName                  = "/agntcy.dir.store.v1.SyncService/WarmCache"
+	SyncService_CreateSyncInvitation_FullMethodName       = "/agntcy.dir.store.v1.SyncService/CreateSyncInvitation"
+	SyncService_AcceptSyncInvitation_FullMethodName       = "/agntcy.dir.store.v1.SyncService/AcceptSyncInvitation"
+	SyncService_ReconcileCIDs_FullMethodName              = "/agntcy.dir.store.v1.SyncService/ReconcileCIDs"
+	SyncService_ListQuarantinedRecords_FullMethodName     = "/agntcy.dir.store.v1.SyncService/ListQuarantinedRecords"
+	SyncService_ReleaseQuarantinedRecord_FullMethodName   = "/agntcy.dir.store.v1.SyncService/ReleaseQuarantinedRecord"
 )
 
 // SyncServiceClient is the client API for SyncService service.
@@ -57,6 +63,38 @@ type SyncServiceClient interface {
 	// This RPC allows a requesting node to authenticate with this node and obtain
 	// temporary registry credentials for secure Zot-based synchronization.
 	RequestRegistryCredentials(ctx context.Context, in *RequestRegistryCredentialsRequest, opts ...grpc.CallOption) (*RequestRegistryCredentialsResponse, error)
+	// WarmCache prefetches records from a remote Directory node into the local store.
+	//
+	// This allows edge replicas to proactively fetch records they are expected to serve,
+	// either by explicit CIDs or by search queries evaluated on the remote node.
+	// The operation is blocking and returns once all selected records have been processed,
+	// unless async is set.
+	WarmCache(ctx context.Context, in *WarmCacheRequest, opts ...grpc.CallOption) (*WarmCacheResponse, error)
+	// CreateSyncInvitation issues a signed invitation token allowing a remote Directory
+	// node to synchronize records from this node, without exchanging URLs and credentials.
+	//
+	// The token encodes the address of this node and the records to synchronize.
+	// It is accepted on the remote node with AcceptSyncInvitation, and presented back
+	// to this node when the remote node requests registry credentials.
+	CreateSyncInvitation(ctx context.Context, in *CreateSyncInvitationRequest, opts ...grpc.CallOption) (*CreateSyncInvitationResponse, error)
+	// AcceptSyncInvitation creates a synchronization from the Directory node
+	// that issued the invitation token.
+	AcceptSyncInvitation(ctx context.Context, in *AcceptSyncInvitationRequest, opts ...grpc.CallOption) (*AcceptSyncInvitationResponse, error)
+	// ReconcileCIDs compares ranges of the set of CIDs stored on a remote Directory node
+	// with the same ranges of the set of CIDs stored on this node.
+	//
+	// Remote nodes synchronizing from this node use it to find the records they are missing
+	// without enumerating the whole catalog: ranges with matching fingerprints are skipped,
+	// small mismatching ranges are answered with their CIDs, and large ones are split into
+	// subranges the remote node compares in the next round.
+	ReconcileCIDs(ctx context.Context, in *ReconcileCIDsRequest, opts ...grpc.CallOption) (*ReconcileCIDsResponse, error)
+	// ListQuarantinedRecords returns a stream of the records quarantined by the trust policies of synchronizations.
+	//
+	// Quarantined records were imported without a valid signature from the trust roots of their sync.
+	// They are stored but not indexed, and hidden from search until released.
+	ListQuarantinedRecords(ctx context.Context, in *ListQuarantinedRecordsRequest, opts ...grpc.CallOption) (SyncService_ListQuarantinedRecordsClient, error)
+	// ReleaseQuarantinedRecord indexes a quarantined record after review, making it visible in search.
+	ReleaseQuarantinedRecord(ctx context.Context, in *ReleaseQuarantinedRecordRequest, opts ...grpc.CallOption) (*ReleaseQuarantinedRecordResponse, error)
 }
 
 type syncServiceClient struct {
@@ -140,6 +178,89 @@ func (c *syncServiceClient) RequestRegistryCredentials(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *syncServiceClient) WarmCache(ctx context.Context, in *WarmCacheRequest, opts ...grpc.CallOption) (*WarmCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarmCacheResponse)
+	err := c.cc.Invoke(ctx, SyncService_WarmCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncServiceClient) CreateSyncInvitation(ctx context.Context, in *CreateSyncInvitationRequest, opts ...grpc.CallOption) (*CreateSyncInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSyncInvitationResponse)
+	err := c.cc.Invoke(ctx, SyncService_CreateSyncInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncServiceClient) AcceptSyncInvitation(ctx context.Context, in *AcceptSyncInvitationRequest, opts ...grpc.CallOption) (*AcceptSyncInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptSyncInvitationResponse)
+	err := c.cc.Invoke(ctx, SyncService_AcceptSyncInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncServiceClient) ReconcileCIDs(ctx context.Context, in *ReconcileCIDsRequest, opts ...grpc.CallOption) (*ReconcileCIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileCIDsResponse)
+	err := c.cc.Invoke(ctx, SyncService_ReconcileCIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncServiceClient) ListQuarantinedRecords(ctx context.Context, in *ListQuarantinedRecordsRequest, opts ...grpc.CallOption) (SyncService_ListQuarantinedRecordsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SyncService_ServiceDesc.Streams[1], SyncService_ListQuarantinedRecords_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &syncServiceListQuarantinedRecordsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SyncService_ListQuarantinedRecordsClient interface {
+	Recv() (*QuarantinedRecord, error)
+	grpc.ClientStream
+}
+
+type syncServiceListQuarantinedRecordsClient struct {
+	grpc.ClientStream
+}
+
+func (x *syncServiceListQuarantinedRecordsClient) Recv() (*QuarantinedRecord, error) {
+	m := new(QuarantinedRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *syncServiceClient) ReleaseQuarantinedRecord(ctx context.Context, in *ReleaseQuarantinedRecordRequest, opts ...grpc.CallOption) (*ReleaseQuarantinedRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseQuarantinedRecordResponse)
+	err := c.cc.Invoke(ctx, SyncService_ReleaseQuarantinedRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SyncServiceServer is the server API for SyncService service.
 // All implementations should embed UnimplementedSyncServiceServer
 // for forward compatibility.
@@ -168,6 +289,38 @@ type SyncServiceServer interface {
 	// This RPC allows a requesting node to authenticate with this node and obtain
 	// temporary registry credentials for secure Zot-based synchronization.
 	RequestRegistryCredentials(context.Context, *RequestRegistryCredentialsRequest) (*RequestRegistryCredentialsResponse, error)
+	// WarmCache prefetches records from a remote Directory node into the local store.
+	//
+	// This allows edge replicas to proactively fetch records they are expected to serve,
+	// either by explicit CIDs or by search queries evaluated on the remote node.
+	// The operation is blocking and returns once all selected records have been processed,
+	// unless async is set.
+	WarmCache(context.Context, *WarmCacheRequest) (*WarmCacheResponse, error)
+	// CreateSyncInvitation issues a signed invitation token allowing a remote Directory
+	// node to synchronize records from this node, without exchanging URLs and credentials.
+	//
+	// The token encodes the address of this node and the records to synchronize.
+	// It is accepted on the remote node with AcceptSyncInvitation, and presented back
+	// to this node when the remote node requests registry credentials.
+	CreateSyncInvitation(context.Context, *CreateSyncInvitationRequest) (*CreateSyncInvitationResponse, error)
+	// AcceptSyncInvitation creates a synchronization from the Directory node
+	// that issued the invitation token.
+	AcceptSyncInvitation(context.Context, *AcceptSyncInvitationRequest) (*AcceptSyncInvitationResponse, error)
+	// ReconcileCIDs compares ranges of the set of CIDs stored on a remote Directory node
+	// with the same ranges of the set of CIDs stored on this node.
+	//
+	// Remote nodes synchronizing from this node use it to find the records they are missing
+	// without enumerating the whole catalog: ranges with matching fingerprints are skipped,
+	// small mismatching ranges are answered with their CIDs, and large ones are split into
+	// subranges the remote node compares in the next round.
+	ReconcileCIDs(context.Context, *ReconcileCIDsRequest) (*ReconcileCIDsResponse, error)
+	// ListQuarantinedRecords returns a stream of the records quarantined by the trust policies of synchronizations.
+	//
+	// Quarantined records were imported without a valid signature from the trust roots of their sync.
+	// They are stored but not indexed, and hidden from search until released.
+	ListQuarantinedRecords(*ListQuarantinedRecordsRequest, SyncService_ListQuarantinedRecordsServer) error
+	// ReleaseQuarantinedRecord indexes a quarantined record after review, making it visible in search.
+	ReleaseQuarantinedRecord(context.Context, *ReleaseQuarantinedRecordRequest) (*ReleaseQuarantinedRecordResponse, error)
 }
 
 // UnimplementedSyncServiceServer should be embedded to have
@@ -192,6 +345,24 @@ func (UnimplementedSyncServiceServer) DeleteSync(context.Context, *DeleteSyncReq
 func (UnimplementedSyncServiceServer) RequestRegistryCredentials(context.Context, *RequestRegistryCredentialsRequest) (*RequestRegistryCredentialsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestRegistryCredentials not implemented")
 }
+func (UnimplementedSyncServiceServer) WarmCache(context.Context, *WarmCacheRequest) (*WarmCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarmCache not implemented")
+}
+func (UnimplementedSyncServiceServer) CreateSyncInvitation(context.Context, *CreateSyncInvitationRequest) (*CreateSyncInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSyncInvitation not implemented")
+}
+func (UnimplementedSyncServiceServer) AcceptSyncInvitation(context.Context, *AcceptSyncInvitationRequest) (*AcceptSyncInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSyncInvitation not implemented")
+}
+func (UnimplementedSyncServiceServer) ReconcileCIDs(context.Context, *ReconcileCIDsRequest) (*ReconcileCIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileCIDs not implemented")
+}
+func (UnimplementedSyncServiceServer) ListQuarantinedRecords(*ListQuarantinedRecordsRequest, SyncService_ListQuarantinedRecordsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListQuarantinedRecords not implemented")
+}
+func (UnimplementedSyncServiceServer) ReleaseQuarantinedRecord(context.Context, *ReleaseQuarantinedRecordRequest) (*ReleaseQuarantinedRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseQuarantinedRecord not implemented")
+}
 func (UnimplementedSyncServiceServer) testEmbeddedByValue() {}
 
 // UnsafeSyncServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -305,6 +476,117 @@ func _SyncService_RequestRegistryCredentials_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SyncService_WarmCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarmCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).WarmCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyncService_WarmCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).WarmCache(ctx, req.(*WarmCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyncService_CreateSyncInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSyncInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).CreateSyncInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyncService_CreateSyncInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).CreateSyncInvitation(ctx, req.(*CreateSyncInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyncService_AcceptSyncInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptSyncInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).AcceptSyncInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyncService_AcceptSyncInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).AcceptSyncInvitation(ctx, req.(*AcceptSyncInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyncService_ReconcileCIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileCIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).ReconcileCIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyncService_ReconcileCIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).ReconcileCIDs(ctx, req.(*ReconcileCIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyncService_ListQuarantinedRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListQuarantinedRecordsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SyncServiceServer).ListQuarantinedRecords(m, &syncServiceListQuarantinedRecordsServer{ServerStream: stream})
+}
+
+type SyncService_ListQuarantinedRecordsServer interface {
+	Send(*QuarantinedRecord) error
+	grpc.ServerStream
+}
+
+type syncServiceListQuarantinedRecordsServer struct {
+	grpc.ServerStream
+}
+
+func (x *syncServiceListQuarantinedRecordsServer) Send(m *QuarantinedRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SyncService_ReleaseQuarantinedRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseQuarantinedRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).ReleaseQuarantinedRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyncService_ReleaseQuarantinedRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).ReleaseQuarantinedRecord(ctx, req.(*ReleaseQuarantinedRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SyncService_ServiceDesc is the grpc.ServiceDesc for SyncService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -328,6 +610,26 @@ var SyncService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestRegistryCredentials",
 			Handler:    _SyncService_RequestRegistryCredentials_Handler,
 		},
+		{
+			MethodName: "WarmCache",
+			Handler:    _SyncService_WarmCache_Handler,
+		},
+		{
+			MethodName: "CreateSyncInvitation",
+			Handler:    _SyncService_CreateSyncInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptSyncInvitation",
+			Handler:    _SyncService_AcceptSyncInvitation_Handler,
+		},
+		{
+			MethodName: "ReconcileCIDs",
+			Handler:    _SyncService_ReconcileCIDs_Handler,
+		},
+		{
+			MethodName: "ReleaseQuarantinedRecord",
+			Handler:    _SyncService_ReleaseQuarantinedRecord_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -335,6 +637,11 @@ var SyncService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _SyncService_ListSyncs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListQuarantinedRecords",
+			Handler:       _SyncService_ListQuarantinedRecords_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "agntcy/dir/store/v1/sync_service.proto",
 }
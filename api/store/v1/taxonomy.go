@@ -0,0 +1,9 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+// TaxonomyWarningsMetadataKey is the gRPC trailer metadata key listing the skills and domains
+// of pushed records that are not in the OASF taxonomy, when the server accepts them with a warning.
+// Each value is the CID of a record followed by the description of an unknown skill or domain.
+const TaxonomyWarningsMetadataKey = "x-dir-taxonomy-warnings"
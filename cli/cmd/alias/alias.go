@@ -0,0 +1,261 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package alias
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/agntcy/dir/client"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "alias",
+	Short: "Manage human-friendly aliases of records",
+	Long: `Alias command manages mutable name:tag aliases of records, such as "stable" or "latest",
+so that consumers can pull records without tracking their CIDs.
+
+Aliases can be retargeted to other records with the same name at any time, and every
+change is kept in the alias history. Aliases are resolved by "dirctl pull <name>:<tag>",
+which falls back to the record with that version if no alias matches.`,
+}
+
+var setCmd = &cobra.Command{
+	Use:   "set <name>:<tag> <cid>",
+	Short: "Point an alias to a record",
+	Long: `Set creates an alias or retargets it to another record with the same name.
+
+Usage examples:
+
+1. Point the stable alias to a record:
+
+	dirctl alias set directory.agntcy.org/example/agent:stable <cid>
+
+2. Retarget the alias only if nobody moved it in the meantime:
+
+	dirctl alias set directory.agntcy.org/example/agent:stable <new-cid> --expect <old-cid>
+
+3. Create the alias only if it does not exist yet:
+
+	dirctl alias set directory.agntcy.org/example/agent:stable <cid> --create
+`,
+	Args: cobra.ExactArgs(2), //nolint:mnd
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSetCommand(cmd, args[0], args[1])
+	},
+}
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete <name>:<tag>",
+	Short: "Delete an alias",
+	Long: `Delete removes an alias. The records it pointed to are not deleted.
+
+Usage examples:
+
+1. Delete the stable alias:
+
+	dirctl alias delete directory.agntcy.org/example/agent:stable
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDeleteCommand(cmd, args[0])
+	},
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list [<name>]",
+	Short: "List aliases",
+	Long: `List shows the aliases of a record name, or all aliases if no name is given.
+
+Usage examples:
+
+1. List the aliases of a record name:
+
+	dirctl alias list directory.agntcy.org/example/agent
+
+2. List all aliases as JSON:
+
+	dirctl alias list --output json
+`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var name string
+		if len(args) > 0 {
+			name = args[0]
+		}
+
+		return runListCommand(cmd, name)
+	},
+}
+
+var historyCmd = &cobra.Command{
+	Use:   "history <name>",
+	Short: "Show the history of the aliases of a record name",
+	Long: `History shows the changes of the aliases of a record name, oldest first.
+
+Usage examples:
+
+1. Show the history of all aliases of a record name:
+
+	dirctl alias history directory.agntcy.org/example/agent
+
+2. Show the history of the stable alias:
+
+	dirctl alias history directory.agntcy.org/example/agent --tag stable
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runHistoryCommand(cmd, args[0])
+	},
+}
+
+var resolveCmd = &cobra.Command{
+	Use:   "resolve <name>[:<tag>]",
+	Short: "Resolve an alias or version to a CID",
+	Long: `Resolve prints the CID of the record an alias points to, or of the record with that version
+if no alias matches. The tag defaults to "latest".
+
+Usage examples:
+
+1. Resolve the stable alias:
+
+	dirctl alias resolve directory.agntcy.org/example/agent:stable
+
+2. Resolve a version for scripting:
+
+	dirctl alias resolve directory.agntcy.org/example/agent:v1.0.0 --output raw
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runResolveCommand(cmd, args[0])
+	},
+}
+
+func init() {
+	Command.AddCommand(setCmd)
+	Command.AddCommand(deleteCmd)
+	Command.AddCommand(listCmd)
+	Command.AddCommand(historyCmd)
+	Command.AddCommand(resolveCmd)
+}
+
+func runSetCommand(cmd *cobra.Command, ref, cid string) error {
+	name, tag, err := parseAliasReference(ref)
+	if err != nil {
+		return err
+	}
+
+	var expectedCID *string
+
+	switch {
+	case opts.Create:
+		expectedCID = new(string)
+	case opts.Expect != "":
+		expectedCID = &opts.Expect
+	}
+
+	c, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.SetAlias(cmd.Context(), name, tag, &corev1.RecordRef{Cid: cid}, expectedCID)
+	if err != nil {
+		return err
+	}
+
+	return presenter.PrintMessage(cmd, "alias", "Alias set", resp)
+}
+
+func runDeleteCommand(cmd *cobra.Command, ref string) error {
+	name, tag, err := parseAliasReference(ref)
+	if err != nil {
+		return err
+	}
+
+	c, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DeleteAlias(cmd.Context(), name, tag); err != nil {
+		return err
+	}
+
+	return presenter.PrintMessage(cmd, "alias", "Alias deleted", ref)
+}
+
+func runListCommand(cmd *cobra.Command, name string) error {
+	c, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	aliases, err := c.ListAliases(cmd.Context(), name)
+	if err != nil {
+		return err
+	}
+
+	return presenter.PrintMessage(cmd, "aliases", "Aliases", aliases)
+}
+
+func runHistoryCommand(cmd *cobra.Command, name string) error {
+	c, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	changes, err := c.GetAliasHistory(cmd.Context(), name, opts.Tag)
+	if err != nil {
+		return err
+	}
+
+	return presenter.PrintMessage(cmd, "changes", "Alias history", changes)
+}
+
+func runResolveCommand(cmd *cobra.Command, ref string) error {
+	c, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.ResolveName(cmd.Context(), ref)
+	if err != nil {
+		return err
+	}
+
+	return presenter.PrintMessage(cmd, "cid", "Resolved CID", resp.GetRecordRef().GetCid())
+}
+
+// parseAliasReference parses a "name:tag" reference with an explicit tag.
+func parseAliasReference(ref string) (string, string, error) {
+	name, tag, err := corev1.ParseNameReference(ref)
+	if err != nil {
+		return "", "", err
+	}
+
+	if name == ref {
+		return "", "", fmt.Errorf("invalid alias %q: must be formatted as <name>:<tag>", ref)
+	}
+
+	if err := corev1.ValidateAliasTag(tag); err != nil {
+		return "", "", err
+	}
+
+	return name, tag, nil
+}
+
+func getClient(cmd *cobra.Command) (*client.Client, error) {
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return nil, errors.New("failed to get client from context")
+	}
+
+	return c, nil
+}
@@ -0,0 +1,35 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package alias
+
+import (
+	"github.com/agntcy/dir/cli/presenter"
+)
+
+var opts = &options{}
+
+type options struct {
+	// Set command options
+	Expect string
+	Create bool
+
+	// History command options
+	Tag string
+}
+
+func init() {
+	setFlags := setCmd.Flags()
+	setFlags.StringVar(&opts.Expect, "expect", "", "Only retarget the alias if it currently points to this CID")
+	setFlags.BoolVar(&opts.Create, "create", false, "Only set the alias if it does not exist yet")
+	setCmd.MarkFlagsMutuallyExclusive("expect", "create")
+
+	historyFlags := historyCmd.Flags()
+	historyFlags.StringVar(&opts.Tag, "tag", "", "Only show the changes of this tag")
+
+	presenter.AddOutputFlags(setCmd)
+	presenter.AddOutputFlags(deleteCmd)
+	presenter.AddOutputFlags(listCmd)
+	presenter.AddOutputFlags(historyCmd)
+	presenter.AddOutputFlags(resolveCmd)
+}
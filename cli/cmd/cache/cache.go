@@ -0,0 +1,101 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package cache
+
+import (
+	"fmt"
+
+	"github.com/agntcy/dir/cli/presenter"
+	cacheUtils "github.com/agntcy/dir/cli/util/cache"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the local dirctl cache",
+	Long: `Manage the local on-disk cache of dirctl.
+
+Pulled records are cached by CID and search results are cached by query,
+which allows using "dirctl pull --offline" and "dirctl search --offline"
+without contacting the Directory server.
+
+The cache is stored in ~/.cache/dirctl by default and can be
+relocated by setting the DIRCTL_CACHE_DIR environment variable.
+
+Usage examples:
+
+1. Show cache statistics:
+
+	dirctl cache stats
+
+2. Remove all cached entries:
+
+	dirctl cache clear
+
+`,
+	Annotations: map[string]string{
+		ctxUtils.SkipClientAnnotation: "true",
+	},
+}
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show cache statistics",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		c, err := cacheUtils.Default()
+		if err != nil {
+			return err
+		}
+
+		stats, err := c.Stats()
+		if err != nil {
+			return fmt.Errorf("failed to get cache statistics: %w", err)
+		}
+
+		if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+			return presenter.PrintMessage(cmd, "stats", "Cache statistics", stats)
+		}
+
+		displayStats(cmd, stats)
+
+		return nil
+	},
+}
+
+var clearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove all cached entries",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		c, err := cacheUtils.Default()
+		if err != nil {
+			return err
+		}
+
+		if err := c.Clear(); err != nil {
+			return err
+		}
+
+		presenter.Printf(cmd, "Cache cleared: %s\n", c.Dir())
+
+		return nil
+	},
+}
+
+func init() {
+	Command.AddCommand(statsCmd, clearCmd)
+
+	presenter.AddOutputFlags(statsCmd)
+}
+
+// displayStats displays cache statistics in human-readable format.
+func displayStats(cmd *cobra.Command, stats *cacheUtils.Stats) {
+	presenter.Printf(cmd, "Cache directory: %s\n", stats.Dir)
+	presenter.Printf(cmd, "Records:         %d\n", stats.Records)
+	presenter.Printf(cmd, "Search results:  %d\n", stats.SearchResults)
+	presenter.Printf(cmd, "Size:            %d bytes\n", stats.SizeBytes)
+}
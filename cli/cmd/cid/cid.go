@@ -0,0 +1,90 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package cid
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "cid [<file>]",
+	Short: "Compute the CID of a record locally",
+	Long: `Compute the CID of a record without contacting the Directory server.
+
+The record is canonicalized the same way the server does it (sorted keys,
+no insignificant whitespace, normalized numbers), so the computed CID is the
+one the server assigns when the record is pushed. This allows pre-computing
+record references, for example in CI pipelines.
+
+Usage examples:
+
+1. Compute the CID of a record file:
+
+	dirctl cid record.json
+
+2. Compute the CID of a record from standard input:
+
+	cat record.json | dirctl cid --stdin
+
+3. Print the canonical JSON of the record:
+
+	dirctl cid record.json --canonical
+
+`,
+	Args: cobra.MaximumNArgs(1),
+	Annotations: map[string]string{
+		ctxUtils.SkipClientAnnotation: "true",
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			if !opts.FromStdin {
+				return errors.New("if no path defined --stdin flag must be set")
+			}
+
+			return runCommand(cmd, cmd.InOrStdin())
+		}
+
+		source, err := os.Open(args[0])
+		if err != nil {
+			return fmt.Errorf("could not open file %s: %w", args[0], err)
+		}
+		defer source.Close()
+
+		return runCommand(cmd, source)
+	},
+}
+
+func runCommand(cmd *cobra.Command, source io.Reader) error {
+	data, err := io.ReadAll(source)
+	if err != nil {
+		return fmt.Errorf("failed to read source data: %w", err)
+	}
+
+	if opts.Canonical {
+		canonical, err := corev1.CanonicalizeRecord(data)
+		if err != nil {
+			return fmt.Errorf("failed to canonicalize record: %w", err)
+		}
+
+		presenter.Println(cmd, string(canonical))
+
+		return nil
+	}
+
+	cid, err := corev1.ComputeCID(data)
+	if err != nil {
+		return fmt.Errorf("failed to compute CID: %w", err)
+	}
+
+	return presenter.PrintMessage(cmd, "cid", "Record CID", cid)
+}
@@ -0,0 +1,22 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package cid
+
+import "github.com/agntcy/dir/cli/presenter"
+
+var opts = &options{}
+
+type options struct {
+	FromStdin bool
+	Canonical bool
+}
+
+func init() {
+	flags := Command.Flags()
+	flags.BoolVar(&opts.FromStdin, "stdin", false, "Read the record from standard input")
+	flags.BoolVar(&opts.Canonical, "canonical", false, "Print the canonical JSON of the record instead of its CID")
+
+	// Add output format flags
+	presenter.AddOutputFlags(Command)
+}
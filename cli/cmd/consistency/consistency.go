@@ -0,0 +1,121 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package consistency
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "consistency",
+	Short: "Check that the server database, store and routing hold the same records",
+	Long: `Compare the records held by the search database, the content store and
+the routing datastore of the Directory server, and report the discrepancies:
+
+  - indexed-missing-blob: the record is indexed but missing from the store
+  - stored-unindexed: the record is stored but missing from the index
+  - published-missing: the record is published but missing from the store
+
+With --repair, stored records missing from the index are indexed, and indexed
+records missing from the store are removed from the index. Published records
+missing from the store are only reported.
+
+With --async, the check runs in the background on the server, and the ID of
+the operation is printed. Use 'dirctl ops wait' to get its report.
+
+Usage examples:
+
+1. Report discrepancies:
+
+	dirctl consistency
+
+2. Report and repair discrepancies:
+
+	dirctl consistency --repair
+
+3. Repair discrepancies in the background:
+
+	dirctl consistency --repair --async
+	dirctl ops wait <operation-id>
+
+4. Output formats:
+
+	# Get the consistency report as JSON
+	dirctl consistency --output json
+
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runCommand(cmd)
+	},
+}
+
+func runCommand(cmd *cobra.Command) error {
+	// Get the client from the context.
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	if opts.Async {
+		resp, err := c.CheckConsistencyAsync(cmd.Context(), opts.Repair)
+		if err != nil {
+			return fmt.Errorf("failed to check consistency: %w", err)
+		}
+
+		result := map[string]interface{}{
+			"operation_id": resp.GetOperationId(),
+			"status":       "running",
+		}
+
+		return presenter.PrintMessage(cmd, "Consistency", "Checking consistency with operation ID "+resp.GetOperationId(), result)
+	}
+
+	report, err := c.CheckConsistency(cmd.Context(), opts.Repair)
+	if err != nil {
+		return fmt.Errorf("failed to check consistency: %w", err)
+	}
+
+	// Output in the appropriate format
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "report", "Consistency report", report)
+	}
+
+	for _, discrepancy := range report.GetDiscrepancies() {
+		line := fmt.Sprintf("%s: %s", discrepancy.GetRecordRef().GetCid(), discrepancyName(discrepancy.GetType()))
+
+		switch {
+		case discrepancy.GetRepaired():
+			line += " (repaired)"
+		case discrepancy.RepairError != nil:
+			line += " (repair failed: " + discrepancy.GetRepairError() + ")"
+		}
+
+		presenter.Printf(cmd, "%s\n", line)
+	}
+
+	if len(report.GetDiscrepancies()) > 0 {
+		presenter.Printf(cmd, "\n")
+	}
+
+	presenter.Printf(cmd, "Indexed: %d, stored: %d, published: %d, discrepancies: %d\n",
+		report.GetIndexedRecords(), report.GetStoredRecords(), report.GetPublishedRecords(), len(report.GetDiscrepancies()))
+
+	return nil
+}
+
+// discrepancyName returns the short name of a discrepancy type, e.g. stored-unindexed.
+func discrepancyName(discrepancyType storev1.ConsistencyDiscrepancyType) string {
+	name := strings.TrimPrefix(discrepancyType.String(), "CONSISTENCY_DISCREPANCY_TYPE_")
+
+	return strings.ReplaceAll(strings.ToLower(name), "_", "-")
+}
@@ -0,0 +1,22 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package consistency
+
+import "github.com/agntcy/dir/cli/presenter"
+
+var opts = &options{}
+
+type options struct {
+	Repair bool
+	Async  bool
+}
+
+func init() {
+	flags := Command.Flags()
+	flags.BoolVar(&opts.Repair, "repair", false, "Repair discrepancies between the search database and the content store")
+	flags.BoolVar(&opts.Async, "async", false, "Check in the background on the server and print the operation ID")
+
+	// Add output format flags
+	presenter.AddOutputFlags(Command)
+}
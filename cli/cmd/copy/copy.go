@@ -0,0 +1,140 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:predeclared,wrapcheck
+package copy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/agntcy/dir/client"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "copy <cid> --to <server-addr>",
+	Short: "Copy a record with its signatures to another Directory server",
+	Long: `This command copies a record from one Directory server to another, together
+with its signatures, public keys, attestations, revocations and annotations.
+It is a lightweight alternative to configuring a sync for individual records.
+
+The record is copied unchanged, so its CID and provenance annotations are preserved.
+The CID of the pulled record is checked before it is pushed, signed records are
+stored together with their signature in a single operation, and their signatures
+are verified on the destination server once copied. The command fails if the
+signatures of a copied record do not verify, unless they have been revoked.
+
+Both servers are contacted with the authentication settings of the command.
+
+Usage examples:
+
+1. Copy a record from the configured server to another server:
+
+	dirctl copy <cid> --to dir-b.example.org:8888
+
+2. Copy a record between two other servers:
+
+	dirctl copy <cid> --from dir-a.example.org:8888 --to dir-b.example.org:8888
+
+3. Output formats:
+
+	# Get the copy result as JSON
+	dirctl copy <cid> --to dir-b.example.org:8888 --output json
+
+	# Get the CID of the copied record for scripting
+	dirctl copy <cid> --to dir-b.example.org:8888 --output raw
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCommand(cmd, args[0])
+	},
+}
+
+func runCommand(cmd *cobra.Command, cid string) error {
+	// Get the client and its configuration from the context.
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	cfg, ok := ctxUtils.GetClientConfigFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client config from context")
+	}
+
+	source := c
+	if opts.From != "" && opts.From != cfg.ServerAddress {
+		var err error
+
+		source, err = newClient(cmd.Context(), cfg, opts.From)
+		if err != nil {
+			return err
+		}
+
+		defer source.Close() //nolint:errcheck
+	}
+
+	destination, err := newClient(cmd.Context(), cfg, opts.To)
+	if err != nil {
+		return err
+	}
+
+	defer destination.Close() //nolint:errcheck
+
+	result, err := destination.CopyRecord(cmd.Context(), source, &corev1.RecordRef{Cid: cid})
+	if err != nil {
+		return fmt.Errorf("failed to copy record: %w", err)
+	}
+
+	if result.Signed && !result.Verified && !result.Revoked {
+		return fmt.Errorf("record %s was copied but its signatures do not verify on %s: %s", cid, opts.To, result.VerificationError)
+	}
+
+	switch presenter.GetOutputOptions(cmd).Format {
+	case presenter.FormatHuman:
+		displayResult(cmd, result)
+
+		return nil
+	case presenter.FormatRaw:
+		return presenter.PrintMessage(cmd, "record", "Copied record with CID", result.RecordRef.GetCid())
+	default:
+		return presenter.PrintMessage(cmd, "record", "Copied record", result)
+	}
+}
+
+// newClient creates a client for another server with the settings of the command.
+func newClient(ctx context.Context, cfg *client.Config, address string) (*client.Client, error) {
+	serverCfg := *cfg
+	serverCfg.ServerAddress = address
+
+	c, err := client.New(ctx, client.WithConfig(&serverCfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for %s: %w", address, err)
+	}
+
+	return c, nil
+}
+
+func displayResult(cmd *cobra.Command, result *client.CopyResult) {
+	presenter.Printf(cmd, "Copied record %s to %s\n", result.RecordRef.GetCid(), opts.To)
+
+	for _, referrerType := range slices.Sorted(maps.Keys(result.Referrers)) {
+		presenter.Printf(cmd, "  %s: %d\n", referrerType, result.Referrers[referrerType])
+	}
+
+	switch {
+	case !result.Signed:
+		presenter.Printf(cmd, "Signatures: none\n")
+	case result.Revoked:
+		presenter.Printf(cmd, "Signatures: revoked\n")
+	default:
+		presenter.Printf(cmd, "Signatures: verified\n")
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:predeclared
+package copy
+
+import "github.com/agntcy/dir/cli/presenter"
+
+var opts = &options{}
+
+type options struct {
+	From string
+	To   string
+}
+
+func init() {
+	flags := Command.Flags()
+	flags.StringVar(&opts.From, "from", "", "Address of the Directory server to copy the record from (defaults to --server-addr)")
+	flags.StringVar(&opts.To, "to", "", "Address of the Directory server to copy the record to")
+
+	Command.MarkFlagRequired("to") //nolint:errcheck
+
+	// Add output format flags
+	presenter.AddOutputFlags(Command)
+}
@@ -0,0 +1,162 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package deps
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "deps <cid>",
+	Short: "Show records referenced by a record",
+	Long: `Show the dependencies of a record, i.e. the records it references,
+as indexed by the Directory server when the records were pushed.
+
+Records reference other records through the "dependencies" annotation
+(comma-separated CIDs) or through the "record_cid" and "record_cids"
+fields of their modules.
+
+Usage examples:
+
+1. Show direct dependencies of a record:
+
+	dirctl deps <cid>
+
+2. Show the full dependency tree, including detected cycles:
+
+	dirctl deps <cid> --tree
+
+3. Show records depending on a record:
+
+	dirctl deps <cid> --dependents --tree
+
+4. Output formats:
+
+	# Get the dependency graph as JSON
+	dirctl deps <cid> --tree --output json
+
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCommand(cmd, args[0])
+	},
+}
+
+// referenceGraph is implemented by both dependency and dependent responses.
+type referenceGraph interface {
+	GetReferences() []*storev1.RecordReference
+	GetCycles() []*storev1.RecordReferenceCycle
+}
+
+func runCommand(cmd *cobra.Command, cid string) error {
+	// Get the client from the context.
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	recordRef := &corev1.RecordRef{Cid: cid}
+
+	var (
+		graph referenceGraph
+		err   error
+	)
+
+	if opts.Dependents {
+		graph, err = c.GetDependents(cmd.Context(), recordRef, opts.Tree)
+	} else {
+		graph, err = c.GetDependencies(cmd.Context(), recordRef, opts.Tree)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get record references: %w", err)
+	}
+
+	// Output in the appropriate format
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "references", "Record references", graph)
+	}
+
+	displayReferenceGraph(cmd, cid, graph, opts.Dependents)
+
+	return nil
+}
+
+// displayReferenceGraph displays the reference graph as a tree rooted at the given record.
+func displayReferenceGraph(cmd *cobra.Command, root string, graph referenceGraph, dependents bool) {
+	// Build adjacency in traversal direction
+	children := map[string][]string{}
+
+	for _, reference := range graph.GetReferences() {
+		from, to := reference.GetRecordCid(), reference.GetReferencedCid()
+		if dependents {
+			from, to = to, from
+		}
+
+		children[from] = append(children[from], to)
+	}
+
+	presenter.Printf(cmd, "%s\n", root)
+
+	if len(children[root]) == 0 {
+		if dependents {
+			presenter.Printf(cmd, "No dependents found\n")
+		} else {
+			presenter.Printf(cmd, "No dependencies found\n")
+		}
+	}
+
+	printed := map[string]bool{root: true}
+	path := []string{root}
+
+	var printChildren func(cid, indent string)
+
+	printChildren = func(cid, indent string) {
+		for i, child := range children[cid] {
+			branch, nextIndent := "├── ", indent+"│   "
+			if i == len(children[cid])-1 {
+				branch, nextIndent = "└── ", indent+"    "
+			}
+
+			switch {
+			case slices.Contains(path, child):
+				presenter.Printf(cmd, "%s%s%s (cycle)\n", indent, branch, child)
+			case printed[child] && len(children[child]) > 0:
+				presenter.Printf(cmd, "%s%s%s (see above)\n", indent, branch, child)
+			default:
+				presenter.Printf(cmd, "%s%s%s\n", indent, branch, child)
+
+				printed[child] = true
+				path = append(path, child)
+				printChildren(child, nextIndent)
+				path = path[:len(path)-1]
+			}
+		}
+	}
+
+	printChildren(root, "")
+
+	if len(graph.GetCycles()) > 0 {
+		presenter.Printf(cmd, "\nCycles detected:\n")
+
+		for _, cycle := range graph.GetCycles() {
+			if len(cycle.GetCids()) == 0 {
+				continue
+			}
+
+			cids := append(slices.Clone(cycle.GetCids()), cycle.GetCids()[0])
+			presenter.Printf(cmd, "  %s\n", strings.Join(cids, " -> "))
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package deps
+
+import (
+	"bytes"
+	"testing"
+
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDisplayReferenceGraph tests human-readable tree output of the reference graph.
+func TestDisplayReferenceGraph(t *testing.T) {
+	tests := []struct {
+		name       string
+		graph      referenceGraph
+		dependents bool
+		expected   string
+	}{
+		{
+			name:     "no references",
+			graph:    &storev1.GetDependenciesResponse{},
+			expected: "a\nNo dependencies found\n",
+		},
+		{
+			name: "dependency tree with cycle",
+			graph: &storev1.GetDependenciesResponse{
+				References: []*storev1.RecordReference{
+					{RecordCid: "a", ReferencedCid: "b"},
+					{RecordCid: "b", ReferencedCid: "d"},
+					{RecordCid: "a", ReferencedCid: "c"},
+					{RecordCid: "c", ReferencedCid: "d"},
+					{RecordCid: "c", ReferencedCid: "a"},
+				},
+				Cycles: []*storev1.RecordReferenceCycle{{Cids: []string{"a", "c"}}},
+			},
+			expected: "a\n" +
+				"├── b\n" +
+				"│   └── d\n" +
+				"└── c\n" +
+				"    ├── d\n" +
+				"    └── a (cycle)\n" +
+				"\nCycles detected:\n" +
+				"  a -> c -> a\n",
+		},
+		{
+			name: "dependents tree",
+			graph: &storev1.GetDependentsResponse{
+				References: []*storev1.RecordReference{
+					{RecordCid: "b", ReferencedCid: "a"},
+					{RecordCid: "c", ReferencedCid: "b"},
+				},
+			},
+			dependents: true,
+			expected: "a\n" +
+				"└── b\n" +
+				"    └── c\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+
+			cmd := &cobra.Command{}
+			cmd.SetOut(&out)
+
+			displayReferenceGraph(cmd, "a", tt.graph, tt.dependents)
+
+			assert.Equal(t, tt.expected, out.String())
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package deps
+
+import "github.com/agntcy/dir/cli/presenter"
+
+var opts = &options{}
+
+type options struct {
+	Tree       bool
+	Dependents bool
+}
+
+func init() {
+	flags := Command.Flags()
+	flags.BoolVar(&opts.Tree, "tree", false, "Resolve references transitively and display them as a tree")
+	flags.BoolVar(&opts.Dependents, "dependents", false, "Show records referencing the record instead of its dependencies")
+
+	// Add output format flags
+	presenter.AddOutputFlags(Command)
+}
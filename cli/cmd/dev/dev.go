@@ -0,0 +1,33 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dev
+
+import (
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "dev",
+	Short: "Run a local Directory for development",
+	Long: `Run a local Directory for development and demos.
+
+This command group provides access to development tooling:
+
+- up: Run an all-in-one Directory node in-process
+
+Examples:
+
+1. Start a development node:
+   dirctl dev up
+`,
+	Annotations: map[string]string{
+		ctxUtils.SkipClientAnnotation: "true",
+	},
+}
+
+func init() {
+	// Add dev subcommands
+	Command.AddCommand(upCmd)
+}
@@ -0,0 +1,15 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dev
+
+var opts = &options{}
+
+type options struct {
+	ListenAddress string
+}
+
+func init() {
+	flags := upCmd.Flags()
+	flags.StringVar(&opts.ListenAddress, "listen-address", DefaultListenAddress, "Address the development node listens on")
+}
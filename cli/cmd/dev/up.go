@@ -0,0 +1,109 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dev
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/agntcy/dir/cli/presenter"
+	"github.com/agntcy/dir/server"
+	"github.com/agntcy/dir/server/config"
+	"github.com/agntcy/dir/server/database"
+	routingconfig "github.com/agntcy/dir/server/routing/config"
+	"github.com/agntcy/dir/server/store"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// DefaultListenAddress is the default address of the development node.
+	DefaultListenAddress = "127.0.0.1:8888"
+
+	// memoryDBPath is the SQLite path of an in-memory database shared by all connections.
+	memoryDBPath = "file::memory:?cache=shared"
+)
+
+var upCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Run an all-in-one Directory node",
+	Long: `Run an all-in-one Directory node in-process for development and demos.
+
+The node keeps its database in memory, stores records in a temporary
+directory, and has routing across the network disabled, so that it needs no
+registry, Kubernetes cluster or Taskfile setup. All data is discarded on exit.
+
+Once started, the address to use with other dirctl commands is printed as
+a DIRECTORY_CLIENT_SERVER_ADDRESS environment variable. The node runs until
+interrupted.
+
+Examples:
+
+1. Start a development node and use it from another shell:
+   dirctl dev up
+   export DIRECTORY_CLIENT_SERVER_ADDRESS=127.0.0.1:8888
+   dirctl push record.json
+
+2. Start a development node on another port:
+   dirctl dev up --listen-address 127.0.0.1:9999
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runUp(cmd)
+	},
+}
+
+func runUp(cmd *cobra.Command) error {
+	dataDir, err := os.MkdirTemp("", "dirctl-dev-")
+	if err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	defer os.RemoveAll(dataDir)
+
+	cfg, err := newConfig(opts.ListenAddress, dataDir)
+	if err != nil {
+		return err
+	}
+
+	srv, err := server.New(cmd.Context(), cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create development node: %w", err)
+	}
+
+	presenter.Printf(cmd, "Directory development node listening on %s\n", cfg.ListenAddress)
+	presenter.Printf(cmd, "Use it from other shells with:\n\n")
+	presenter.Printf(cmd, "  export DIRECTORY_CLIENT_SERVER_ADDRESS=%s\n\n", cfg.ListenAddress)
+	presenter.Printf(cmd, "Press Ctrl+C to stop.\n")
+
+	if err := srv.Run(cmd.Context()); err != nil {
+		return fmt.Errorf("failed to run development node: %w", err)
+	}
+
+	return nil
+}
+
+// newConfig returns the server config of a development node, which overrides the
+// defaults with an in-memory database, a local store in dataDir and routing disabled.
+func newConfig(listenAddress, dataDir string) (*config.Config, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server config: %w", err)
+	}
+
+	cfg.ListenAddress = listenAddress
+
+	cfg.Store.Provider = string(store.OCI)
+	cfg.Store.OCI.LocalDir = dataDir
+
+	cfg.Database.DBType = string(database.SQLite)
+	cfg.Database.SQLite.DBPath = memoryDBPath
+
+	cfg.Routing.Mode = routingconfig.ModeLocal
+
+	cfg.Authn.Enabled = false
+	cfg.Authz.Enabled = false
+	cfg.Metrics.Enabled = false
+
+	return cfg, nil
+}
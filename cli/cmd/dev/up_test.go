@@ -0,0 +1,28 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dev
+
+import (
+	"testing"
+
+	routingconfig "github.com/agntcy/dir/server/routing/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConfig(t *testing.T) {
+	dataDir := t.TempDir()
+
+	cfg, err := newConfig("127.0.0.1:9999", dataDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "127.0.0.1:9999", cfg.ListenAddress)
+	assert.Equal(t, "oci", cfg.Store.Provider)
+	assert.Equal(t, dataDir, cfg.Store.OCI.LocalDir)
+	assert.Equal(t, "sqlite", cfg.Database.DBType)
+	assert.Equal(t, memoryDBPath, cfg.Database.SQLite.DBPath)
+	assert.Equal(t, routingconfig.ModeLocal, cfg.Routing.Mode)
+	assert.False(t, cfg.Authn.Enabled)
+	assert.False(t, cfg.Authz.Enabled)
+}
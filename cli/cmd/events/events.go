@@ -32,15 +32,29 @@ Examples:
    dirctl events listen --output json     # Pretty-printed JSON
    dirctl events listen --output raw      # Resource IDs only
 
-Events are delivered from subscription time forward (no history).
-The stream remains active until interrupted (Ctrl+C).
+5. Replay recent events and reuse saved filters:
+   dirctl events listen --follow-from 15m --save-filter recent
+   dirctl events listen --filter recent
+
+6. Follow the latest version of an agent:
+   dirctl events watch-name directory.agntcy.org/example/agent
+
+7. Notify a URL of the events affecting a record:
+   dirctl events webhook add <cid> https://example.org/hooks/dir
+
+Events are delivered from subscription time forward, or from the
+--follow-from time for events still retained by the server.
+The stream reconnects automatically and remains active until interrupted (Ctrl+C).
 `,
 }
 
 func init() {
 	// Add subcommands
 	Command.AddCommand(listenCmd)
+	Command.AddCommand(watchNameCmd)
+	Command.AddCommand(webhookCmd)
 
 	// Add output format flags
 	presenter.AddOutputFlags(listenCmd)
+	presenter.AddOutputFlags(watchNameCmd)
 }
@@ -0,0 +1,143 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// configDirEnv overrides the default dirctl configuration directory.
+	configDirEnv = "DIRCTL_CONFIG_DIR"
+
+	filtersFile = "event-filters.json"
+
+	configDirPerm  = 0o700
+	configFilePerm = 0o600
+)
+
+// savedFilter is a named set of listen filters persisted with --save-filter.
+type savedFilter struct {
+	Types      []string `json:"types,omitempty"`
+	Labels     []string `json:"labels,omitempty"`
+	CIDs       []string `json:"cids,omitempty"`
+	Actors     []string `json:"actors,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+	Expression string   `json:"expression,omitempty"`
+}
+
+// merge returns the filter with the fields that are empty in f taken from base.
+func (f savedFilter) merge(base savedFilter) savedFilter {
+	pick := func(value, fallback []string) []string {
+		if len(value) > 0 {
+			return value
+		}
+
+		return fallback
+	}
+
+	expression := f.Expression
+	if expression == "" {
+		expression = base.Expression
+	}
+
+	return savedFilter{
+		Types:      pick(f.Types, base.Types),
+		Labels:     pick(f.Labels, base.Labels),
+		CIDs:       pick(f.CIDs, base.CIDs),
+		Actors:     pick(f.Actors, base.Actors),
+		Namespaces: pick(f.Namespaces, base.Namespaces),
+		Expression: expression,
+	}
+}
+
+// filtersPath returns the path of the saved filters file.
+// It is located in DIRCTL_CONFIG_DIR if set, otherwise in the
+// dirctl directory of the user configuration directory (e.g. ~/.config/dirctl).
+func filtersPath() (string, error) {
+	if dir := os.Getenv(configDirEnv); dir != "" {
+		return filepath.Join(dir, filtersFile), nil
+	}
+
+	userConfigDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user config directory: %w", err)
+	}
+
+	return filepath.Join(userConfigDir, "dirctl", filtersFile), nil
+}
+
+// loadSavedFilters returns all saved filters by name.
+func loadSavedFilters() (map[string]savedFilter, error) {
+	path, err := filtersPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]savedFilter{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read saved filters: %w", err)
+	}
+
+	filters := map[string]savedFilter{}
+	if err := json.Unmarshal(data, &filters); err != nil {
+		return nil, fmt.Errorf("failed to parse saved filters %s: %w", path, err)
+	}
+
+	return filters, nil
+}
+
+// loadSavedFilter returns the saved filter with the given name.
+func loadSavedFilter(name string) (savedFilter, error) {
+	filters, err := loadSavedFilters()
+	if err != nil {
+		return savedFilter{}, err
+	}
+
+	filter, ok := filters[name]
+	if !ok {
+		return savedFilter{}, fmt.Errorf("saved filter %q not found", name)
+	}
+
+	return filter, nil
+}
+
+// saveFilter stores the filter under the given name, replacing any filter with the same name.
+func saveFilter(name string, filter savedFilter) error {
+	filters, err := loadSavedFilters()
+	if err != nil {
+		return err
+	}
+
+	filters[name] = filter
+
+	data, err := json.MarshalIndent(filters, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal saved filters: %w", err)
+	}
+
+	path, err := filtersPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), configDirPerm); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, configFilePerm); err != nil {
+		return fmt.Errorf("failed to write saved filters: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedFilters(t *testing.T) {
+	t.Setenv(configDirEnv, t.TempDir())
+
+	_, err := loadSavedFilter("ai-pushes")
+	require.Error(t, err)
+
+	filter := savedFilter{
+		Types:  []string{"RECORD_PUSHED"},
+		Labels: []string{"/skills/AI"},
+	}
+	require.NoError(t, saveFilter("ai-pushes", filter))
+	require.NoError(t, saveFilter("other", savedFilter{CIDs: []string{"bafytest"}}))
+
+	loaded, err := loadSavedFilter("ai-pushes")
+	require.NoError(t, err)
+	assert.Equal(t, filter, loaded)
+
+	// Filters given on the command line take precedence over saved ones
+	merged := savedFilter{Labels: []string{"/domains/research"}}.merge(loaded)
+	assert.Equal(t, savedFilter{
+		Types:  []string{"RECORD_PUSHED"},
+		Labels: []string{"/domains/research"},
+	}, merged)
+}
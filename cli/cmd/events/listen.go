@@ -4,15 +4,21 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	eventsv1 "github.com/agntcy/dir/api/events/v1"
 	"github.com/agntcy/dir/cli/presenter"
 	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/agntcy/dir/client"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var listenCmd = &cobra.Command{
@@ -21,8 +27,13 @@ var listenCmd = &cobra.Command{
 	Long: `Listen to real-time system events with optional filtering.
 
 Events are streamed from the Directory server in real-time.
-Only events occurring after subscription are delivered (no history).
-The stream remains active until interrupted (Ctrl+C).
+Only events occurring after subscription are delivered, unless --follow-from
+is used to replay the recent events retained by the server.
+
+When the stream is interrupted, it is re-established automatically with
+exponential backoff and the events missed in between are replayed.
+Status lines are written to stderr. The stream remains active until
+interrupted (Ctrl+C).
 
 Examples:
 
@@ -38,25 +49,63 @@ Examples:
 4. Filter by CID:
    dirctl events listen --cids bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi
 
-5. Combine filters:
+5. Filter by actor (SPIFFE ID that caused the event):
+   dirctl events listen --actors spiffe://example.org/agent
+
+6. Filter by namespace (trust domain of the actor):
+   dirctl events listen --namespaces example.org
+
+7. Combine filters:
    dirctl events listen --types RECORD_PUSHED --labels /skills/AI --output jsonl
 
+8. Replay events of the last hour, then follow live events:
+   dirctl events listen --follow-from 1h
+   dirctl events listen --follow-from 2025-01-02T15:00:00Z
+
+9. Filter with a CEL expression evaluated on the server:
+   dirctl events listen --expr "event.type == 'RECORD_PUSHED' && 'AI' in event.labels && event.metadata['team'] == 'ml'"
+
+10. Save filters and reuse them later:
+   dirctl events listen --types RECORD_PUSHED --labels /skills/AI --save-filter ai-pushes
+   dirctl events listen --filter ai-pushes
+
+When authorization is enabled, callers outside of the server's trust domain
+only receive events from their own namespace.
+
 Available event types:
 - Store: RECORD_PUSHED, RECORD_PULLED, RECORD_DELETED
 - Routing: RECORD_PUBLISHED, RECORD_UNPUBLISHED
 - Sync: SYNC_CREATED, SYNC_COMPLETED, SYNC_FAILED
 - Sign: RECORD_SIGNED
+- Validation: RECORD_VALIDATION_DRIFT
+- Scanning: RECORD_FLAGGED
+- Workers: WORKER_STALLED
 `,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		return runListenCommand(cmd)
 	},
 }
 
+const (
+	// minReconnectDelay is the delay before the first reconnection attempt.
+	minReconnectDelay = time.Second
+
+	// maxReconnectDelay caps the exponential backoff between reconnection attempts.
+	maxReconnectDelay = 30 * time.Second
+)
+
 // Listen command options.
 var listenOpts struct {
 	EventTypes   []string
 	LabelFilters []string
 	CIDFilters   []string
+	Actors       []string
+	Namespaces   []string
+	Expression   string
+	FollowFrom   string
+	Filter       string
+	SaveFilter   string
+	NoReconnect  bool
 }
 
 func init() {
@@ -66,6 +115,51 @@ func init() {
 		"Label filters (e.g., --labels /skills/AI --labels /domains/research)")
 	listenCmd.Flags().StringArrayVar(&listenOpts.CIDFilters, "cids", nil,
 		"CID filters (e.g., --cids bafyxxx)")
+	listenCmd.Flags().StringArrayVar(&listenOpts.Actors, "actors", nil,
+		"Actor filters (e.g., --actors spiffe://example.org/agent)")
+	listenCmd.Flags().StringArrayVar(&listenOpts.Namespaces, "namespaces", nil,
+		"Namespace filters (e.g., --namespaces example.org)")
+	listenCmd.Flags().StringVar(&listenOpts.Expression, "expr", "",
+		"CEL filter expression evaluated on the server (e.g., --expr \"event.metadata['team'] == 'ml'\")")
+	listenCmd.Flags().StringVar(&listenOpts.FollowFrom, "follow-from", "",
+		"Replay retained events since an RFC 3339 timestamp or a duration ago (e.g., --follow-from 15m)")
+	listenCmd.Flags().StringVar(&listenOpts.Filter, "filter", "",
+		"Use the filters saved under this name (flags given explicitly take precedence)")
+	listenCmd.Flags().StringVar(&listenOpts.SaveFilter, "save-filter", "",
+		"Save the filters of this command under this name for later use with --filter")
+	listenCmd.Flags().BoolVar(&listenOpts.NoReconnect, "no-reconnect", false,
+		"Exit when the event stream is interrupted instead of reconnecting")
+}
+
+// listenState tracks the progress of the event stream across reconnections.
+type listenState struct {
+	// resumeFrom is the time from which events are replayed after reconnecting.
+	resumeFrom time.Time
+
+	// seen holds the IDs of the delivered events with the resumeFrom timestamp,
+	// so that they are not displayed twice when replayed after reconnecting.
+	seen map[string]struct{}
+}
+
+// observe records the event and reports whether it was not delivered before.
+func (s *listenState) observe(event *eventsv1.Event) bool {
+	timestamp := event.GetTimestamp().AsTime()
+
+	switch {
+	case timestamp.Before(s.resumeFrom):
+		return true
+	case timestamp.After(s.resumeFrom):
+		s.resumeFrom = timestamp
+		s.seen = map[string]struct{}{}
+	}
+
+	if _, ok := s.seen[event.GetId()]; ok {
+		return false
+	}
+
+	s.seen[event.GetId()] = struct{}{}
+
+	return true
 }
 
 func runListenCommand(cmd *cobra.Command) error {
@@ -75,23 +169,58 @@ func runListenCommand(cmd *cobra.Command) error {
 		return errors.New("failed to get client from context")
 	}
 
+	filter := savedFilter{
+		Types:      listenOpts.EventTypes,
+		Labels:     listenOpts.LabelFilters,
+		CIDs:       listenOpts.CIDFilters,
+		Actors:     listenOpts.Actors,
+		Namespaces: listenOpts.Namespaces,
+		Expression: listenOpts.Expression,
+	}
+
+	if listenOpts.Filter != "" {
+		saved, err := loadSavedFilter(listenOpts.Filter)
+		if err != nil {
+			return err
+		}
+
+		filter = filter.merge(saved)
+	}
+
 	// Parse event types from strings to enums
-	eventTypes, err := parseEventTypes(listenOpts.EventTypes)
+	eventTypes, err := parseEventTypes(filter.Types)
 	if err != nil {
 		return fmt.Errorf("invalid event types: %w", err)
 	}
 
+	if listenOpts.SaveFilter != "" {
+		if err := saveFilter(listenOpts.SaveFilter, filter); err != nil {
+			return err
+		}
+
+		presenter.Errorf(cmd, "Saved filters as %q\n", listenOpts.SaveFilter)
+	}
+
 	// Build request
 	req := &eventsv1.ListenRequest{
-		EventTypes:   eventTypes,
-		LabelFilters: listenOpts.LabelFilters,
-		CidFilters:   listenOpts.CIDFilters,
+		EventTypes:       eventTypes,
+		LabelFilters:     filter.Labels,
+		CidFilters:       filter.CIDs,
+		ActorFilters:     filter.Actors,
+		NamespaceFilters: filter.Namespaces,
+		FilterExpression: filter.Expression,
 	}
 
-	// Start listening
-	result, err := c.ListenStream(cmd.Context(), req)
-	if err != nil {
-		return fmt.Errorf("failed to start event stream: %w", err)
+	state := &listenState{resumeFrom: time.Now(), seen: map[string]struct{}{}}
+
+	if listenOpts.FollowFrom != "" {
+		since, err := parseFollowFrom(listenOpts.FollowFrom, time.Now())
+		if err != nil {
+			return err
+		}
+
+		req.Since = timestamppb.New(since)
+		state.resumeFrom = since
 	}
 
 	// Show metadata only in human format (route to stderr for structured formats)
@@ -100,41 +229,141 @@ func runListenCommand(cmd *cobra.Command) error {
 		presenter.Printf(cmd, "Listening to events (press Ctrl+C to stop)...\n")
 
 		if len(eventTypes) > 0 {
-			presenter.Printf(cmd, "Event types: %v\n", listenOpts.EventTypes)
+			presenter.Printf(cmd, "Event types: %v\n", filter.Types)
+		}
+
+		if len(filter.Labels) > 0 {
+			presenter.Printf(cmd, "Label filters: %v\n", filter.Labels)
+		}
+
+		if len(filter.CIDs) > 0 {
+			presenter.Printf(cmd, "CID filters: %v\n", filter.CIDs)
 		}
 
-		if len(listenOpts.LabelFilters) > 0 {
-			presenter.Printf(cmd, "Label filters: %v\n", listenOpts.LabelFilters)
+		if len(filter.Actors) > 0 {
+			presenter.Printf(cmd, "Actor filters: %v\n", filter.Actors)
 		}
 
-		if len(listenOpts.CIDFilters) > 0 {
-			presenter.Printf(cmd, "CID filters: %v\n", listenOpts.CIDFilters)
+		if len(filter.Namespaces) > 0 {
+			presenter.Printf(cmd, "Namespace filters: %v\n", filter.Namespaces)
+		}
+
+		if filter.Expression != "" {
+			presenter.Printf(cmd, "Filter expression: %s\n", filter.Expression)
+		}
+
+		if req.GetSince() != nil {
+			presenter.Printf(cmd, "Replaying events since: %s\n", req.GetSince().AsTime().Format(time.RFC3339))
 		}
 
 		presenter.Printf(cmd, "\n")
 	}
 
+	delay := minReconnectDelay
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			presenter.Errorf(cmd, "Reconnecting to event stream (attempt %d)...\n", attempt)
+		}
+
+		received, err := listen(cmd, c, req, state)
+
+		// Return unwrapped context error so callers can check for context.Canceled
+		if cmd.Context().Err() != nil {
+			//nolint:wrapcheck
+			return cmd.Context().Err()
+		}
+
+		if listenOpts.NoReconnect || !isRetryable(err) {
+			return err
+		}
+
+		if received {
+			delay = minReconnectDelay
+		}
+
+		if err != nil {
+			presenter.Errorf(cmd, "Event stream interrupted: %v\n", err)
+		} else {
+			presenter.Errorf(cmd, "Event stream closed by server\n")
+		}
+
+		presenter.Errorf(cmd, "Retrying in %s, resuming from %s\n", delay, state.resumeFrom.Format(time.RFC3339))
+
+		select {
+		case <-time.After(delay):
+		case <-cmd.Context().Done():
+			//nolint:wrapcheck
+			return cmd.Context().Err()
+		}
+
+		delay = min(delay*2, maxReconnectDelay) //nolint:mnd
+
+		// Replay the events missed while disconnected
+		req.Since = timestamppb.New(state.resumeFrom)
+	}
+}
+
+// listen streams events of a single connection until it ends.
+// It reports whether any events were received.
+func listen(cmd *cobra.Command, c *client.Client, req *eventsv1.ListenRequest, state *listenState) (bool, error) {
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	result, err := c.ListenStream(ctx, req)
+	if err != nil {
+		return false, fmt.Errorf("failed to start event stream: %w", err)
+	}
+
+	var received bool
+
 	// Stream events using StreamResult pattern
 	for {
 		select {
 		case resp := <-result.ResCh():
+			received = true
+
 			event := resp.GetEvent()
-			if event != nil {
+			if event != nil && state.observe(event) {
 				displayEvent(cmd, event)
 			}
 		case err := <-result.ErrCh():
-			return fmt.Errorf("error receiving event: %w", err)
+			return received, fmt.Errorf("error receiving event: %w", err)
 		case <-result.DoneCh():
 			// Stream ended normally
-			return nil
-		case <-cmd.Context().Done():
-			// Return unwrapped context error so callers can check for context.Canceled
-			//nolint:wrapcheck
-			return cmd.Context().Err()
+			return received, nil
+		case <-ctx.Done():
+			return received, nil
 		}
 	}
 }
 
+// isRetryable reports whether reconnecting may resolve the stream error.
+// Rejected requests, such as invalid filters or denied namespaces, are not retried.
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.PermissionDenied, codes.Unauthenticated, codes.Unimplemented:
+		return false
+	default:
+		return true
+	}
+}
+
+// parseFollowFrom parses the --follow-from value, either an RFC 3339 timestamp
+// or a duration that is subtracted from now.
+func parseFollowFrom(value string, now time.Time) (time.Time, error) {
+	if since, err := time.Parse(time.RFC3339, value); err == nil {
+		return since, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return time.Time{}, fmt.Errorf("invalid --follow-from value %q: expected an RFC 3339 timestamp or a duration such as 15m", value)
+	}
+
+	return now.Add(-duration), nil
+}
+
 // displayEvent formats and displays an event.
 func displayEvent(cmd *cobra.Command, event *eventsv1.Event) {
 	// Get output options
@@ -180,6 +409,10 @@ func displayEvent(cmd *cobra.Command, event *eventsv1.Event) {
 			presenter.Printf(cmd, " (labels: %s)", strings.Join(event.GetLabels(), ", "))
 		}
 
+		if event.GetActor() != "" {
+			presenter.Printf(cmd, " (actor: %s)", event.GetActor())
+		}
+
 		if len(event.GetMetadata()) > 0 {
 			presenter.Printf(cmd, " %v", event.GetMetadata())
 		}
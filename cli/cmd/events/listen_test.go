@@ -5,13 +5,17 @@ package events
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	eventsv1 "github.com/agntcy/dir/api/events/v1"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -330,6 +334,20 @@ func TestDisplayEvent_FormatHuman(t *testing.T) {
 				"key:value",
 			},
 		},
+		{
+			name: "event with actor",
+			event: func() *eventsv1.Event {
+				event := createTestEvent("test-cid", eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, nil, nil)
+				event.Actor = "spiffe://example.org/agent"
+
+				return event
+			}(),
+			contains: []string{
+				"RECORD_PUSHED",
+				"test-cid",
+				"actor: spiffe://example.org/agent",
+			},
+		},
 		{
 			name:  "different event type",
 			event: createTestEvent("sync-id", eventsv1.EventType_EVENT_TYPE_SYNC_COMPLETED, nil, nil),
@@ -471,6 +489,12 @@ func TestListenCmd_Initialization(t *testing.T) {
 
 	cidsFlag := listenCmd.Flags().Lookup("cids")
 	assert.NotNil(t, cidsFlag)
+
+	actorsFlag := listenCmd.Flags().Lookup("actors")
+	assert.NotNil(t, actorsFlag)
+
+	namespacesFlag := listenCmd.Flags().Lookup("namespaces")
+	assert.NotNil(t, namespacesFlag)
 }
 
 // TestListenOpts_Structure tests the listenOpts structure.
@@ -584,3 +608,52 @@ func TestDisplayEvent_AllEventTypes(t *testing.T) {
 		})
 	}
 }
+
+// TestParseFollowFrom tests parsing timestamps and relative durations.
+func TestParseFollowFrom(t *testing.T) {
+	now := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	since, err := parseFollowFrom("2025-01-02T15:00:00Z", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 2, 15, 0, 0, 0, time.UTC), since)
+
+	since, err = parseFollowFrom("15m", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-15*time.Minute), since)
+
+	_, err = parseFollowFrom("yesterday", now)
+	require.Error(t, err)
+
+	_, err = parseFollowFrom("-5m", now)
+	require.Error(t, err)
+}
+
+// TestListenStateObserve tests that events replayed after reconnecting are not displayed twice.
+func TestListenStateObserve(t *testing.T) {
+	start := time.Date(2025, 1, 2, 15, 0, 0, 0, time.UTC)
+	state := &listenState{resumeFrom: start, seen: map[string]struct{}{}}
+
+	event := func(id string, timestamp time.Time) *eventsv1.Event {
+		return &eventsv1.Event{Id: id, Timestamp: timestamppb.New(timestamp)}
+	}
+
+	assert.True(t, state.observe(event("a", start.Add(time.Second))))
+	assert.True(t, state.observe(event("b", start.Add(time.Second))))
+	assert.Equal(t, start.Add(time.Second), state.resumeFrom)
+
+	// Replayed events with the resume timestamp are skipped
+	assert.False(t, state.observe(event("a", start.Add(time.Second))))
+	assert.False(t, state.observe(event("b", start.Add(time.Second))))
+
+	// New events are displayed and advance the resume timestamp
+	assert.True(t, state.observe(event("c", start.Add(2*time.Second))))
+	assert.Equal(t, start.Add(2*time.Second), state.resumeFrom)
+}
+
+// TestIsRetryable tests that rejected requests are not retried.
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, isRetryable(nil))
+	assert.True(t, isRetryable(fmt.Errorf("error receiving event: %w", status.Error(codes.Unavailable, "connection lost"))))
+	assert.False(t, isRetryable(fmt.Errorf("error receiving event: %w", status.Error(codes.InvalidArgument, "invalid filter expression"))))
+	assert.False(t, isRetryable(status.Error(codes.PermissionDenied, "not allowed")))
+}
@@ -0,0 +1,230 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/agntcy/dir/client"
+	"github.com/spf13/cobra"
+)
+
+var watchNameCmd = &cobra.Command{
+	Use:   "watch-name <name>",
+	Short: "Watch the latest version of records by name",
+	Long: `Watch the latest version of records by name.
+
+The latest record of each name matching the given name or pattern is
+printed first, followed by a line whenever the latest record of a name
+changes, e.g. when a newer version is pushed or the latest version is
+deleted. This makes it easy for deployment systems to upgrade to new
+agent versions automatically.
+
+The latest record of a name is the one with the highest semantic version.
+Records without a valid semantic version rank below versioned ones and
+are ordered by the time they were stored.
+
+When the stream is interrupted, it is re-established automatically with
+exponential backoff. Names whose latest record did not change while
+disconnected are not printed again.
+
+Examples:
+
+1. Watch a single record name:
+   dirctl events watch-name directory.agntcy.org/example/agent
+
+2. Watch all names matching a pattern:
+   dirctl events watch-name "directory.agntcy.org/example/*"
+
+3. Only react to changes made by identities of a namespace:
+   dirctl events watch-name "directory.agntcy.org/example/*" --namespace example.org
+
+4. Print only the latest CIDs for scripting:
+   dirctl events watch-name directory.agntcy.org/example/agent --output raw
+
+When authorization is enabled, callers outside of the server's trust domain
+only receive changes made from their own namespace.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runWatchNameCommand(cmd, args[0])
+	},
+}
+
+// Watch name command options.
+var watchNameOpts struct {
+	Namespace   string
+	NoReconnect bool
+}
+
+func init() {
+	watchNameCmd.Flags().StringVar(&watchNameOpts.Namespace, "namespace", "",
+		"Only react to changes made by identities of this namespace (e.g., --namespace example.org)")
+	watchNameCmd.Flags().BoolVar(&watchNameOpts.NoReconnect, "no-reconnect", false,
+		"Exit when the stream is interrupted instead of reconnecting")
+}
+
+// watchNameState tracks the latest CID of each name across reconnections.
+type watchNameState struct {
+	latest map[string]string
+}
+
+// observe records the latest CID of a name and reports whether it changed.
+func (s *watchNameState) observe(resp *eventsv1.WatchNameResponse) bool {
+	previous, ok := s.latest[resp.GetName()]
+	if ok && previous == resp.GetCid() {
+		return false
+	}
+
+	s.latest[resp.GetName()] = resp.GetCid()
+
+	return true
+}
+
+func runWatchNameCommand(cmd *cobra.Command, name string) error {
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	req := &eventsv1.WatchNameRequest{
+		Name:      name,
+		Namespace: watchNameOpts.Namespace,
+	}
+
+	state := &watchNameState{latest: map[string]string{}}
+
+	if presenter.GetOutputOptions(cmd).Format == presenter.FormatHuman {
+		presenter.Printf(cmd, "Watching latest records of %s (press Ctrl+C to stop)...\n\n", name)
+	}
+
+	delay := minReconnectDelay
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			presenter.Errorf(cmd, "Reconnecting to name watch (attempt %d)...\n", attempt)
+		}
+
+		received, err := watchName(cmd, c, req, state)
+
+		// Return unwrapped context error so callers can check for context.Canceled
+		if cmd.Context().Err() != nil {
+			//nolint:wrapcheck
+			return cmd.Context().Err()
+		}
+
+		if watchNameOpts.NoReconnect || !isRetryable(err) {
+			return err
+		}
+
+		if received {
+			delay = minReconnectDelay
+		}
+
+		if err != nil {
+			presenter.Errorf(cmd, "Name watch interrupted: %v\n", err)
+		} else {
+			presenter.Errorf(cmd, "Name watch closed by server\n")
+		}
+
+		presenter.Errorf(cmd, "Retrying in %s\n", delay)
+
+		select {
+		case <-time.After(delay):
+		case <-cmd.Context().Done():
+			//nolint:wrapcheck
+			return cmd.Context().Err()
+		}
+
+		delay = min(delay*2, maxReconnectDelay) //nolint:mnd
+	}
+}
+
+// watchName streams latest record changes of a single connection until it ends.
+// It reports whether any responses were received.
+func watchName(cmd *cobra.Command, c *client.Client, req *eventsv1.WatchNameRequest, state *watchNameState) (bool, error) {
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	result, err := c.WatchNameStream(ctx, req)
+	if err != nil {
+		return false, fmt.Errorf("failed to start name watch: %w", err)
+	}
+
+	var received bool
+
+	for {
+		select {
+		case resp := <-result.ResCh():
+			received = true
+
+			if state.observe(resp) {
+				displayLatest(cmd, resp)
+			}
+		case err := <-result.ErrCh():
+			return received, fmt.Errorf("error receiving latest record: %w", err)
+		case <-result.DoneCh():
+			return received, nil
+		case <-ctx.Done():
+			return received, nil
+		}
+	}
+}
+
+// displayLatest formats and displays a latest record change.
+func displayLatest(cmd *cobra.Command, resp *eventsv1.WatchNameResponse) {
+	opts := presenter.GetOutputOptions(cmd)
+
+	switch opts.Format {
+	case presenter.FormatJSON, presenter.FormatJSONL:
+		var (
+			data []byte
+			err  error
+		)
+
+		if opts.Format == presenter.FormatJSON {
+			data, err = json.MarshalIndent(resp, "", "  ")
+		} else {
+			data, err = json.Marshal(resp)
+		}
+
+		if err != nil {
+			presenter.Errorf(cmd, "Error marshaling latest record: %v\n", err)
+
+			return
+		}
+
+		presenter.Printf(cmd, "%s\n", string(data))
+
+	case presenter.FormatRaw:
+		// Deleted names have no latest CID
+		if resp.GetCid() != "" {
+			presenter.Printf(cmd, "%s\n", resp.GetCid())
+		}
+
+	case presenter.FormatHuman:
+		timestamp := resp.GetTimestamp().AsTime().Format("15:04:05")
+
+		if resp.GetCid() == "" {
+			presenter.Printf(cmd, "[%s] %s: all records deleted (previous: %s)\n", timestamp, resp.GetName(), resp.GetPreviousCid())
+
+			return
+		}
+
+		presenter.Printf(cmd, "[%s] %s: %s %s", timestamp, resp.GetName(), resp.GetVersion(), resp.GetCid())
+
+		if resp.GetPreviousCid() != "" {
+			presenter.Printf(cmd, " (previous: %s)", resp.GetPreviousCid())
+		}
+
+		presenter.Printf(cmd, "\n")
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"bytes"
+	"testing"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestWatchNameStateObserve tests that unchanged latest records resent after reconnecting are not displayed twice.
+func TestWatchNameStateObserve(t *testing.T) {
+	state := &watchNameState{latest: map[string]string{}}
+
+	assert.True(t, state.observe(&eventsv1.WatchNameResponse{Name: "agent", Cid: "cid-1"}))
+	assert.True(t, state.observe(&eventsv1.WatchNameResponse{Name: "other", Cid: "cid-2"}))
+
+	// Initial state resent after reconnecting is skipped
+	assert.False(t, state.observe(&eventsv1.WatchNameResponse{Name: "agent", Cid: "cid-1"}))
+
+	// Changes are displayed, including deletion of all records
+	assert.True(t, state.observe(&eventsv1.WatchNameResponse{Name: "agent", Cid: "cid-3", PreviousCid: "cid-1"}))
+	assert.True(t, state.observe(&eventsv1.WatchNameResponse{Name: "agent", PreviousCid: "cid-3"}))
+}
+
+// TestDisplayLatest tests the output formats of latest record changes.
+func TestDisplayLatest(t *testing.T) {
+	resp := &eventsv1.WatchNameResponse{
+		Name:        "example.org/agent",
+		Cid:         "cid-2",
+		Version:     "v2.0.0",
+		PreviousCid: "cid-1",
+		Timestamp:   timestamppb.Now(),
+	}
+
+	tests := []struct {
+		format   string
+		resp     *eventsv1.WatchNameResponse
+		contains []string
+		expected string
+	}{
+		{format: "human", resp: resp, contains: []string{"example.org/agent: v2.0.0 cid-2", "(previous: cid-1)"}},
+		{format: "jsonl", resp: resp, contains: []string{`"cid":"cid-2"`, `"previous_cid":"cid-1"`}},
+		{format: "raw", resp: resp, expected: "cid-2\n"},
+		{format: "raw", resp: &eventsv1.WatchNameResponse{Name: "example.org/agent", PreviousCid: "cid-2"}, expected: ""},
+		{format: "human", resp: &eventsv1.WatchNameResponse{Name: "example.org/agent", PreviousCid: "cid-2"}, contains: []string{"all records deleted"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			presenter.AddOutputFlags(cmd)
+			require.NoError(t, cmd.Flags().Set("output", tt.format))
+
+			var stdout bytes.Buffer
+			cmd.SetOut(&stdout)
+
+			displayLatest(cmd, tt.resp)
+
+			if tt.contains == nil {
+				assert.Equal(t, tt.expected, stdout.String())
+			}
+
+			for _, s := range tt.contains {
+				assert.Contains(t, stdout.String(), s)
+			}
+		})
+	}
+}
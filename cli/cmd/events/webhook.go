@@ -0,0 +1,185 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var webhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Manage record webhooks",
+	Long: `Manage record webhooks.
+
+A record webhook is a URL notified of the events affecting a record,
+e.g. when it is pulled, published or its validation drifts. The event
+is sent as a JSON POST request. When the webhook has a secret, the
+X-Dir-Signature header holds the hex encoded HMAC-SHA256 of the body.
+
+Only the owner of a record, the identity that first pushed it, can
+register webhooks for it. URLs of loopback or private network addresses
+are rejected.
+
+Webhooks are owned by the identity that registered them. Callers can
+only list and delete their own webhooks.
+
+Examples:
+
+1. Notify a URL of all events affecting a record:
+   dirctl events webhook add <cid> https://example.org/hooks/dir
+
+2. Notify a URL of pulls only, with signed requests:
+   dirctl events webhook add <cid> https://example.org/hooks/dir --types RECORD_PULLED --secret s3cr3t
+
+3. List your webhooks, optionally for a single record:
+   dirctl events webhook list
+   dirctl events webhook list --cid <cid>
+
+4. Delete a webhook:
+   dirctl events webhook delete <webhook-id>
+`,
+}
+
+var webhookAddCmd = &cobra.Command{
+	Use:   "add <cid> <url>",
+	Short: "Register a webhook for a record",
+	Args:  cobra.ExactArgs(2), //nolint:mnd
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runWebhookAddCommand(cmd, args[0], args[1])
+	},
+}
+
+var webhookListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List your record webhooks",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runWebhookListCommand(cmd)
+	},
+}
+
+var webhookDeleteCmd = &cobra.Command{
+	Use:   "delete <webhook-id>",
+	Short: "Delete a record webhook",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runWebhookDeleteCommand(cmd, args[0])
+	},
+}
+
+// Webhook command options.
+var webhookOpts struct {
+	Types  []string
+	Secret string
+	CID    string
+}
+
+func init() {
+	webhookAddCmd.Flags().StringSliceVar(&webhookOpts.Types, "types", nil,
+		"Record event types to notify (e.g., --types RECORD_PULLED,RECORD_VALIDATION_DRIFT). Defaults to all record events")
+	webhookAddCmd.Flags().StringVar(&webhookOpts.Secret, "secret", "",
+		"Secret used to sign the notifications with HMAC-SHA256")
+	webhookListCmd.Flags().StringVar(&webhookOpts.CID, "cid", "",
+		"Only list the webhooks of this record")
+
+	webhookCmd.AddCommand(webhookAddCmd)
+	webhookCmd.AddCommand(webhookListCmd)
+	webhookCmd.AddCommand(webhookDeleteCmd)
+
+	presenter.AddOutputFlags(webhookAddCmd)
+	presenter.AddOutputFlags(webhookListCmd)
+}
+
+func runWebhookAddCommand(cmd *cobra.Command, cid, url string) error {
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	eventTypes, err := parseEventTypes(webhookOpts.Types)
+	if err != nil {
+		return err
+	}
+
+	webhook, err := c.RegisterRecordWebhook(cmd.Context(), &eventsv1.RegisterRecordWebhookRequest{
+		Cid:        cid,
+		Url:        url,
+		EventTypes: eventTypes,
+		Secret:     webhookOpts.Secret,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register webhook: %w", err)
+	}
+
+	if presenter.GetOutputOptions(cmd).Format == presenter.FormatRaw {
+		return presenter.PrintMessage(cmd, "webhook", "Webhook ID", webhook.GetWebhookId())
+	}
+
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "webhook", "Registered webhook", webhook)
+	}
+
+	presenter.Printf(cmd, "Registered webhook %s for record %s\n", webhook.GetWebhookId(), webhook.GetCid())
+
+	return nil
+}
+
+func runWebhookListCommand(cmd *cobra.Command) error {
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	webhooks, err := c.ListRecordWebhooks(cmd.Context(), webhookOpts.CID)
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman || len(webhooks) == 0 {
+		return presenter.PrintMessage(cmd, "webhooks", "Webhooks", webhooks)
+	}
+
+	for _, webhook := range webhooks {
+		presenter.Printf(cmd, "%s  %s  %s  %s\n",
+			webhook.GetWebhookId(), webhook.GetCid(), webhook.GetUrl(), webhookEventTypes(webhook))
+	}
+
+	return nil
+}
+
+func runWebhookDeleteCommand(cmd *cobra.Command, webhookID string) error {
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	if err := c.DeleteRecordWebhook(cmd.Context(), webhookID); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	presenter.Printf(cmd, "Deleted webhook %s\n", webhookID)
+
+	return nil
+}
+
+// webhookEventTypes returns the short names of the event types notified to a webhook.
+func webhookEventTypes(webhook *eventsv1.RecordWebhook) string {
+	if len(webhook.GetEventTypes()) == 0 {
+		return "all record events"
+	}
+
+	names := make([]string, 0, len(webhook.GetEventTypes()))
+	for _, eventType := range webhook.GetEventTypes() {
+		names = append(names, strings.TrimPrefix(eventType.String(), "EVENT_TYPE_"))
+	}
+
+	return strings.Join(names, ",")
+}
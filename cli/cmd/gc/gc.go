@@ -0,0 +1,86 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package gc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "gc",
+	Short: "Remove unreferenced content from the server store",
+	Long: `Remove the content of the content store of the Directory server that is no
+longer referenced by any record, such as the blobs left behind by deleted records
+and interrupted pushes. Records and the referrers of stored records are kept.
+
+Only local content stores are collected. Remote registries collect their own
+garbage.
+
+With --async, the collection runs in the background on the server, and the ID
+of the operation is printed. Use 'dirctl ops wait' to get its report.
+
+Usage examples:
+
+1. Collect garbage:
+
+	dirctl gc
+
+2. Collect garbage in the background:
+
+	dirctl gc --async
+	dirctl ops wait <operation-id>
+
+3. Output formats:
+
+	# Get the garbage collection report as JSON
+	dirctl gc --output json
+
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runCommand(cmd)
+	},
+}
+
+func runCommand(cmd *cobra.Command) error {
+	// Get the client from the context.
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	if opts.Async {
+		resp, err := c.GarbageCollectAsync(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("failed to collect garbage: %w", err)
+		}
+
+		result := map[string]interface{}{
+			"operation_id": resp.GetOperationId(),
+			"status":       "running",
+		}
+
+		return presenter.PrintMessage(cmd, "Garbage collection", "Collecting garbage with operation ID "+resp.GetOperationId(), result)
+	}
+
+	report, err := c.GarbageCollect(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("failed to collect garbage: %w", err)
+	}
+
+	// Output in the appropriate format
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "report", "Garbage collection report", report)
+	}
+
+	presenter.Printf(cmd, "Removed blobs: %d, reclaimed bytes: %d\n", report.GetRemovedBlobs(), report.GetReclaimedBytes())
+
+	return nil
+}
@@ -0,0 +1,20 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gc
+
+import "github.com/agntcy/dir/cli/presenter"
+
+var opts = &options{}
+
+type options struct {
+	Async bool
+}
+
+func init() {
+	flags := Command.Flags()
+	flags.BoolVar(&opts.Async, "async", false, "Collect in the background on the server and print the operation ID")
+
+	// Add output format flags
+	presenter.AddOutputFlags(Command)
+}
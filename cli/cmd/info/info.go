@@ -7,8 +7,12 @@ package info
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
 	"github.com/agntcy/dir/cli/presenter"
 	ctxUtils "github.com/agntcy/dir/cli/util/context"
 	"github.com/spf13/cobra"
@@ -22,7 +26,15 @@ func init() {
 var Command = &cobra.Command{
 	Use:   "info",
 	Short: "Check info about an object in Directory store",
-	Long: `Lookup and get basic metadata about an object pushed to the Directory store.
+	Long: `Get a consolidated view of an object pushed to the Directory store.
+
+The view is assembled on the server in a single call and includes:
+- Record metadata and storage presence
+- Search index presence
+- Sync origin (syncs that explicitly included the record)
+- Publication status and announced labels
+- Signature and verification status
+- Pull count
 
 Usage examples:
 
@@ -55,14 +67,83 @@ func runCommand(cmd *cobra.Command, cid string) error {
 		return errors.New("failed to get client from context")
 	}
 
-	// Fetch info from store
-	info, err := c.Lookup(cmd.Context(), &corev1.RecordRef{
+	// Fetch consolidated info from server
+	info, err := c.RecordInfo(cmd.Context(), &corev1.RecordRef{
 		Cid: cid,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to pull data: %w", err)
+		return fmt.Errorf("failed to get record info: %w", err)
 	}
 
 	// Output in the appropriate format
-	return presenter.PrintMessage(cmd, "info", "Record information", info)
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "info", "Record information", info)
+	}
+
+	displayRecordInfo(cmd, info)
+
+	return nil
+}
+
+// displayRecordInfo displays the record info in human-readable format.
+func displayRecordInfo(cmd *cobra.Command, info *storev1.RecordInfoResponse) {
+	meta := info.GetMeta()
+
+	presenter.Printf(cmd, "Record: %s\n\n", info.GetRecordRef().GetCid())
+
+	presenter.Printf(cmd, "Metadata:\n")
+	presenter.Printf(cmd, "  Schema Version: %s\n", meta.GetSchemaVersion())
+	presenter.Printf(cmd, "  Created At: %s\n", valueOrNone(meta.GetCreatedAt()))
+
+	for _, key := range slices.Sorted(maps.Keys(meta.GetAnnotations())) {
+		presenter.Printf(cmd, "  %s: %s\n", key, meta.GetAnnotations()[key])
+	}
+
+	presenter.Printf(cmd, "\nState:\n")
+	presenter.Printf(cmd, "  Stored: %t\n", info.GetStored())
+	presenter.Printf(cmd, "  Indexed: %t\n", info.GetIndexed())
+	presenter.Printf(cmd, "  Pull Count: %d\n", info.GetPullCount())
+
+	presenter.Printf(cmd, "\nSync Origin:\n")
+
+	if len(info.GetSyncOrigins()) == 0 {
+		presenter.Printf(cmd, "  none\n")
+	}
+
+	for _, origin := range info.GetSyncOrigins() {
+		presenter.Printf(cmd, "  %s (sync %s, %s)\n", origin.GetRemoteDirectoryUrl(), origin.GetSyncId(), origin.GetStatus())
+	}
+
+	presenter.Printf(cmd, "\nPublication:\n")
+	presenter.Printf(cmd, "  Published: %t\n", info.GetPublished())
+
+	if len(info.GetLabels()) > 0 {
+		presenter.Printf(cmd, "  Labels: %s\n", strings.Join(info.GetLabels(), ", "))
+	}
+
+	signature := info.GetSignature()
+
+	presenter.Printf(cmd, "\nSignature:\n")
+	presenter.Printf(cmd, "  Signatures: %d\n", signature.GetSignatureCount())
+
+	if signature.GetSignatureCount() > 0 {
+		presenter.Printf(cmd, "  Verified: %t\n", signature.GetVerified())
+	}
+
+	if signature.GetRevokedCount() > 0 {
+		presenter.Printf(cmd, "  Revoked: %d\n", signature.GetRevokedCount())
+	}
+
+	if signature.GetVerificationError() != "" {
+		presenter.Printf(cmd, "  Verification Error: %s\n", signature.GetVerificationError())
+	}
+}
+
+// valueOrNone returns the value or "none" if empty.
+func valueOrNone(value string) string {
+	if value == "" {
+		return "none"
+	}
+
+	return value
 }
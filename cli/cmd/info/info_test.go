@@ -0,0 +1,98 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package info
+
+import (
+	"bytes"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDisplayRecordInfo tests human-readable output of the consolidated record info.
+func TestDisplayRecordInfo(t *testing.T) {
+	errMsg := "verification not available in this store configuration"
+
+	tests := []struct {
+		name     string
+		info     *storev1.RecordInfoResponse
+		contains []string
+		excludes []string
+	}{
+		{
+			name: "minimal record",
+			info: &storev1.RecordInfoResponse{
+				RecordRef: &corev1.RecordRef{Cid: "bafytest"},
+				Meta:      &corev1.RecordMeta{Cid: "bafytest", SchemaVersion: "0.7.0"},
+				Stored:    true,
+				Signature: &storev1.RecordSignatureInfo{},
+			},
+			contains: []string{
+				"Record: bafytest",
+				"Schema Version: 0.7.0",
+				"Created At: none",
+				"Stored: true",
+				"Indexed: false",
+				"Published: false",
+				"Signatures: 0",
+			},
+			excludes: []string{"Labels:", "Verified:", "Revoked:"},
+		},
+		{
+			name: "synced, published and signed record",
+			info: &storev1.RecordInfoResponse{
+				RecordRef: &corev1.RecordRef{Cid: "bafytest"},
+				Meta: &corev1.RecordMeta{
+					Cid:         "bafytest",
+					CreatedAt:   "2025-01-01T00:00:00Z",
+					Annotations: map[string]string{"name": "test-agent"},
+				},
+				Stored:  true,
+				Indexed: true,
+				SyncOrigins: []*storev1.RecordSyncOrigin{
+					{SyncId: "sync-1", RemoteDirectoryUrl: "remote:8888", Status: storev1.SyncStatus_SYNC_STATUS_IN_PROGRESS},
+				},
+				Published: true,
+				Labels:    []string{"/skills/AI", "/locators/docker-image"},
+				Signature: &storev1.RecordSignatureInfo{SignatureCount: 2, RevokedCount: 1, VerificationError: &errMsg},
+				PullCount: 5,
+			},
+			contains: []string{
+				"Created At: 2025-01-01T00:00:00Z",
+				"name: test-agent",
+				"Indexed: true",
+				"Pull Count: 5",
+				"remote:8888 (sync sync-1, SYNC_STATUS_IN_PROGRESS)",
+				"Published: true",
+				"Labels: /skills/AI, /locators/docker-image",
+				"Signatures: 2",
+				"Verified: false",
+				"Revoked: 1",
+				"Verification Error: " + errMsg,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+
+			displayRecordInfo(cmd, tt.info)
+
+			for _, s := range tt.contains {
+				assert.Contains(t, out.String(), s)
+			}
+
+			for _, s := range tt.excludes {
+				assert.NotContains(t, out.String(), s)
+			}
+		})
+	}
+}
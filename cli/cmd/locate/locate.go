@@ -0,0 +1,77 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package locate
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "locate <cid>",
+	Short: "Resolve the nearest locator of a record",
+	Long: `Resolve the locator of a record nearest to the given regions.
+
+Locators declare the region they are served from with a "region" annotation.
+Locators in the most preferred region are selected first, then locators
+without region hint, and finally locators in other regions.
+Without --region, the region of the server is preferred, if configured.
+
+Usage examples:
+
+1. Resolve the nearest locator of a record:
+
+	dirctl locate <cid>
+
+2. Resolve the nearest container image, preferring eu-west-1 then eu-central-1:
+
+	dirctl locate <cid> --type docker_image --region eu-west-1 --region eu-central-1
+
+3. Output formats:
+
+	# Get the URL only
+	dirctl locate <cid> --output raw
+
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCommand(cmd, args[0])
+	},
+}
+
+func runCommand(cmd *cobra.Command, cid string) error {
+	// Get the client from the context.
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	locator, err := c.ResolveLocator(cmd.Context(), &corev1.RecordRef{Cid: cid}, opts.Type, opts.Regions...)
+	if err != nil {
+		return fmt.Errorf("failed to resolve locator: %w", err)
+	}
+
+	switch presenter.GetOutputOptions(cmd).Format {
+	case presenter.FormatHuman:
+		region := locator.GetRegion()
+		if region == "" {
+			region = "unknown region"
+		}
+
+		presenter.Printf(cmd, "%s %s (%s)\n", locator.GetType(), locator.GetUrl(), region)
+
+		return nil
+	case presenter.FormatRaw:
+		return presenter.PrintMessage(cmd, "locator", "Locator", locator.GetUrl())
+	case presenter.FormatJSON, presenter.FormatJSONL:
+	}
+
+	return presenter.PrintMessage(cmd, "locator", "Locator", locator)
+}
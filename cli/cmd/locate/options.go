@@ -0,0 +1,22 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package locate
+
+import "github.com/agntcy/dir/cli/presenter"
+
+var opts = &options{}
+
+type options struct {
+	Type    string
+	Regions []string
+}
+
+func init() {
+	flags := Command.Flags()
+	flags.StringVar(&opts.Type, "type", "", "Only consider locators of this type (e.g., docker_image)")
+	flags.StringArrayVar(&opts.Regions, "region", nil, "Preferred region, most preferred first (can be repeated, default: region of the server)")
+
+	// Add output format flags
+	presenter.AddOutputFlags(Command)
+}
@@ -0,0 +1,286 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package ops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/agntcy/dir/client"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// progressPollTimeout is the wait timeout used to poll operations when reporting progress events.
+const progressPollTimeout = 2 * time.Second
+
+var Command = &cobra.Command{
+	Use:   "ops",
+	Short: "Track long-running operations",
+	Long: `Track long-running operations running in the background on the server.
+
+Expensive requests started with --async, such as filter-based unpublishing,
+cache warming or consistency checks, return an operation ID immediately instead
+of holding the connection open until they complete. Operations keep running
+after dirctl exits, and can be tracked again at any time with their ID.
+
+Operations are kept in memory by the server: they are lost when it restarts,
+and finished operations are forgotten after an hour. Operations can only be
+tracked and cancelled by the identity that started them.
+
+Usage examples:
+
+1. List running operations:
+   dirctl ops list --running
+
+2. Wait for an operation to complete:
+   dirctl ops wait <operation-id>
+
+3. Cancel an operation:
+   dirctl ops cancel <operation-id>
+`,
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List long-running operations",
+	Long: `List the long-running operations known to the server, most recently created first.
+
+Usage examples:
+
+1. List all operations:
+   dirctl ops list
+
+2. List running cache warming operations:
+   dirctl ops list --type warm_cache --running
+
+3. Output formats:
+   dirctl ops list --output json
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runListCommand(cmd)
+	},
+}
+
+var waitCmd = &cobra.Command{
+	Use:   "wait <operation-id>",
+	Short: "Wait for a long-running operation to complete",
+	Long: `Wait until a long-running operation is done and print its final state.
+
+The command fails if the operation failed or was cancelled. Stopping the
+command, or reaching --timeout, does not affect the operation.
+
+Usage examples:
+
+1. Wait for an operation:
+   dirctl ops wait <operation-id>
+
+2. Wait at most 10 minutes:
+   dirctl ops wait <operation-id> --timeout 10m
+
+3. Emit machine-readable progress events on stderr:
+   dirctl ops wait <operation-id> --progress jsonl
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runWaitCommand(cmd, args[0])
+	},
+}
+
+var cancelCmd = &cobra.Command{
+	Use:   "cancel <operation-id>",
+	Short: "Cancel a long-running operation",
+	Long: `Request the cancellation of a running operation.
+
+Cancellation is best effort: work done before the cancellation is not rolled back.
+Use 'dirctl ops wait' to wait for the operation to stop.
+
+Usage examples:
+
+1. Cancel an operation:
+   dirctl ops cancel <operation-id>
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCancelCommand(cmd, args[0])
+	},
+}
+
+var opts struct {
+	Type    string
+	Running bool
+	Timeout time.Duration
+}
+
+func init() {
+	listCmd.Flags().StringVar(&opts.Type, "type", "", "Only list operations of this type (e.g., unpublish, warm_cache, check_consistency, garbage_collect)")
+	listCmd.Flags().BoolVar(&opts.Running, "running", false, "Only list operations that are still running")
+
+	waitCmd.Flags().DurationVar(&opts.Timeout, "timeout", 0, "Maximum time to wait for the operation (default: no limit)")
+
+	Command.AddCommand(listCmd)
+	Command.AddCommand(waitCmd)
+	Command.AddCommand(cancelCmd)
+
+	presenter.AddOutputFlags(listCmd)
+	presenter.AddOutputFlags(waitCmd)
+	presenter.AddOutputFlags(cancelCmd)
+
+	presenter.AddProgressFlags(waitCmd)
+}
+
+func runListCommand(cmd *cobra.Command) error {
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	opCh, err := c.ListOperations(cmd.Context(), &corev1.ListOperationsRequest{
+		Type:        opts.Type,
+		RunningOnly: opts.Running,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list operations: %w", err)
+	}
+
+	var results []*corev1.Operation
+	for op := range opCh {
+		results = append(results, op)
+	}
+
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman || len(results) == 0 {
+		return presenter.PrintMessage(cmd, "operations", "Operations", results)
+	}
+
+	for _, op := range results {
+		presenter.Printf(cmd, "%s  %-12s  %-10s  %s  created %s\n",
+			op.GetId(), op.GetType(), stateName(op), progressText(op), op.GetCreatedAt().AsTime().Local().Format(time.DateTime))
+	}
+
+	return nil
+}
+
+func runWaitCommand(cmd *cobra.Command, id string) error {
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	progress, err := presenter.NewProgress(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	op, err := Wait(ctx, c, id, progress)
+	if err != nil {
+		return fmt.Errorf("failed to wait for operation %s: %w", id, err)
+	}
+
+	if err := printOperation(cmd, "Operation done", op); err != nil {
+		return err
+	}
+
+	if op.GetState() != corev1.OperationState_OPERATION_STATE_SUCCEEDED {
+		return fmt.Errorf("operation %s %s: %s", id, stateName(op), op.GetErrorMessage())
+	}
+
+	return nil
+}
+
+func runCancelCommand(cmd *cobra.Command, id string) error {
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	op, err := c.CancelOperation(cmd.Context(), id)
+	if err != nil {
+		return fmt.Errorf("failed to cancel operation %s: %w", id, err)
+	}
+
+	return printOperation(cmd, "Operation cancellation requested", op)
+}
+
+// Wait waits until a long-running operation is done and returns its final state.
+// Without progress reporting, it relies on the server default wait timeout.
+// Otherwise the operation is polled frequently, and a progress event is reported
+// after each poll with the state of the operation as phase.
+func Wait(ctx context.Context, c *client.Client, id string, progress *presenter.Progress) (*corev1.Operation, error) {
+	if progress == nil {
+		return c.WaitOperation(ctx, id, 0)
+	}
+
+	req := &corev1.WaitOperationRequest{
+		Id:      id,
+		Timeout: durationpb.New(progressPollTimeout),
+	}
+
+	for {
+		op, err := c.OperationServiceClient.WaitOperation(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		phase := stateName(op)
+		if op.GetDone() {
+			phase = "done"
+		}
+
+		progress.Reportf(phase, int(op.GetProcessed()), int(op.GetTotal()), 0, "operation %s is %s", op.GetId(), stateName(op))
+
+		if op.GetDone() {
+			return op, nil
+		}
+	}
+}
+
+// printOperation prints the state of an operation in the output format of the command.
+func printOperation(cmd *cobra.Command, title string, op *corev1.Operation) error {
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "operation", title, op)
+	}
+
+	presenter.Printf(cmd, "%s\n", title)
+	presenter.Printf(cmd, "  ID: %s\n", op.GetId())
+	presenter.Printf(cmd, "  Type: %s\n", op.GetType())
+	presenter.Printf(cmd, "  State: %s\n", stateName(op))
+	presenter.Printf(cmd, "  Progress: %s\n", progressText(op))
+
+	if op.GetErrorMessage() != "" {
+		presenter.Printf(cmd, "  Error: %s\n", op.GetErrorMessage())
+	}
+
+	return nil
+}
+
+// stateName returns the lowercase name of the state of an operation, e.g. "running".
+func stateName(op *corev1.Operation) string {
+	return strings.ToLower(strings.TrimPrefix(op.GetState().String(), "OPERATION_STATE_"))
+}
+
+// progressText returns the progress of an operation, e.g. "3/10".
+func progressText(op *corev1.Operation) string {
+	if op.GetTotal() == 0 {
+		return fmt.Sprintf("%d/?", op.GetProcessed())
+	}
+
+	return fmt.Sprintf("%d/%d", op.GetProcessed(), op.GetTotal())
+}
@@ -4,11 +4,29 @@
 package cmd
 
 import (
+	"os"
+	"strconv"
+	"time"
+
 	"github.com/agntcy/dir/client"
 )
 
+const (
+	// sendInstanceIDEnv enables sending the persistent client instance ID.
+	sendInstanceIDEnv = "DIRCTL_SEND_INSTANCE_ID"
+
+	// timeoutEnv sets the deadline of commands.
+	timeoutEnv = "DIRCTL_TIMEOUT"
+)
+
 var clientConfig = &client.DefaultConfig
 
+// sendInstanceID enables sending the persistent, locally generated client instance ID.
+var sendInstanceID bool
+
+// timeout is the deadline of commands, including the connection to the server. No deadline if zero.
+var timeout time.Duration
+
 func init() {
 	// load config
 	if cfg, err := client.LoadConfig(); err == nil {
@@ -18,14 +36,23 @@ func init() {
 	// set flags
 	flags := RootCmd.PersistentFlags()
 	flags.StringVar(&clientConfig.ServerAddress, "server-addr", clientConfig.ServerAddress, "Directory Server API address")
-	flags.StringVar(&clientConfig.AuthMode, "auth-mode", clientConfig.AuthMode, "Authentication mode: none, x509, jwt, token, tls")
+	flags.StringVar(&clientConfig.AuthMode, "auth-mode", clientConfig.AuthMode, "Authentication mode: none, x509, jwt, token, tls, api-token")
 	flags.StringVar(&clientConfig.SpiffeSocketPath, "spiffe-socket-path", clientConfig.SpiffeSocketPath, "Path to SPIFFE Workload API socket (for x509 or JWT authentication)")
 	flags.StringVar(&clientConfig.SpiffeToken, "spiffe-token", clientConfig.SpiffeToken, "Path to file containing SPIFFE X509 SVID token (for token authentication)")
 	flags.StringVar(&clientConfig.JWTAudience, "jwt-audience", clientConfig.JWTAudience, "JWT audience (for JWT authentication mode)")
+	flags.StringVar(&clientConfig.APIToken, "api-token", clientConfig.APIToken, "API token (for api-token authentication mode, env DIRECTORY_CLIENT_API_TOKEN)")
 	flags.BoolVar(&clientConfig.TlsSkipVerify, "tls-skip-verify", clientConfig.TlsSkipVerify, "Skip TLS verification (for TLS authentication mode)")
 	flags.StringVar(&clientConfig.TlsCAFile, "tls-ca-file", clientConfig.TlsCAFile, "Path to TLS CA file (for TLS authentication mode)")
 	flags.StringVar(&clientConfig.TlsCertFile, "tls-cert-file", clientConfig.TlsCertFile, "Path to TLS certificate file (for TLS authentication mode)")
 	flags.StringVar(&clientConfig.TlsKeyFile, "tls-key-file", clientConfig.TlsKeyFile, "Path to TLS key file (for TLS authentication mode)")
+	flags.StringVar(&clientConfig.ClientInstanceID, "client-instance-id", clientConfig.ClientInstanceID, "Client instance ID sent to the server to identify this automation source")
+
+	sendInstanceID, _ = strconv.ParseBool(os.Getenv(sendInstanceIDEnv))
+	flags.BoolVar(&sendInstanceID, "send-instance-id", sendInstanceID, "Send a persistent locally generated client instance ID (env "+sendInstanceIDEnv+")")
+
+	timeout, _ = time.ParseDuration(os.Getenv(timeoutEnv))
+	flags.DurationVar(&timeout, "timeout", timeout, "Deadline of the command, e.g. 30s, 0 for none (env "+timeoutEnv+")")
+	flags.IntVar(&clientConfig.MaxRetries, "retries", clientConfig.MaxRetries, "Maximum number of retries of calls failing while the server is unavailable (env DIRECTORY_CLIENT_MAX_RETRIES)")
 
 	// mark required flags
 	RootCmd.MarkFlagRequired("server-addr") //nolint:errcheck
@@ -0,0 +1,37 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package patch
+
+import (
+	signcmd "github.com/agntcy/dir/cli/cmd/sign"
+	"github.com/agntcy/dir/cli/presenter"
+)
+
+var opts = &options{}
+
+type options struct {
+	PatchFile string
+	Push      bool
+	Sign      bool
+}
+
+func init() {
+	flags := Command.Flags()
+	flags.StringVar(&opts.PatchFile, "patch-file", "",
+		"Path of the RFC 6902 JSON patch file to apply to the record.",
+	)
+	flags.BoolVar(&opts.Push, "push", false,
+		"Push the patched record to the server. Otherwise the patched record is only printed.",
+	)
+	flags.BoolVar(&opts.Sign, "sign", false,
+		"Sign the pushed record with the specified signing options. Requires --push.",
+	)
+
+	_ = Command.MarkFlagRequired("patch-file")
+
+	signcmd.AddSigningFlags(flags)
+
+	// Add output format flags
+	presenter.AddOutputFlags(Command)
+}
@@ -0,0 +1,111 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package patch
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signcmd "github.com/agntcy/dir/cli/cmd/sign"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "patch <cid>",
+	Short: "Update a record with a JSON patch",
+	Long: `Apply an RFC 6902 JSON patch to a record.
+
+Records are content-addressed, so patching a record creates a new record
+with a new CID. The new record is linked to the patched record through its
+previous_record_cid field, for schema versions that support lineage. The
+patched record is left unchanged.
+
+Without --push, the patch is applied locally and the patched record is
+printed. With --push, the patch is applied by the server, which validates
+and stores the new record. Signatures of the patched record do not apply
+to the new record, use --sign to sign it again.
+
+Usage examples:
+
+1. Preview the patched record:
+
+	dirctl patch <cid> --patch-file patch.json
+
+2. Push the patched record:
+
+	dirctl patch <cid> --patch-file patch.json --push
+
+3. Push and sign the patched record:
+
+	dirctl patch <cid> --patch-file patch.json --push --sign
+
+4. Get the CID of the new record for scripting:
+
+	CID=$(dirctl patch <cid> --patch-file patch.json --push --output raw)
+
+Example patch file:
+
+	[
+	  {"op": "replace", "path": "/version", "value": "1.1.0"},
+	  {"op": "add", "path": "/skills/-", "value": {"name": "text_completion", "id": 10201}}
+	]
+
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if opts.Sign && !opts.Push {
+			return errors.New("--sign requires --push")
+		}
+
+		return runCommand(cmd, args[0])
+	},
+}
+
+func runCommand(cmd *cobra.Command, cid string) error {
+	// Get the client from the context.
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	patch, err := os.ReadFile(filepath.Clean(opts.PatchFile))
+	if err != nil {
+		return fmt.Errorf("could not read patch file %s: %w", opts.PatchFile, err)
+	}
+
+	recordRef := &corev1.RecordRef{Cid: cid}
+
+	if !opts.Push {
+		record, err := c.Pull(cmd.Context(), recordRef)
+		if err != nil {
+			return fmt.Errorf("failed to pull record: %w", err)
+		}
+
+		patched, err := record.ApplyPatch(patch)
+		if err != nil {
+			return fmt.Errorf("failed to patch record: %w", err)
+		}
+
+		return presenter.PrintMessage(cmd, "record", "Patched record data", patched.GetData())
+	}
+
+	newRef, err := c.UpdateRecord(cmd.Context(), recordRef, patch)
+	if err != nil {
+		return fmt.Errorf("failed to update record: %w", err)
+	}
+
+	if opts.Sign {
+		if err := signcmd.Sign(cmd.Context(), c, newRef.GetCid()); err != nil {
+			return fmt.Errorf("failed to sign record: %w", err)
+		}
+	}
+
+	return presenter.PrintMessage(cmd, "record", "Pushed patched record with CID", newRef.GetCid())
+}
@@ -10,12 +10,14 @@ var opts = &options{}
 type options struct {
 	PublicKey bool
 	Signature bool
+	Offline   bool
 }
 
 func init() {
 	flags := Command.Flags()
 	flags.BoolVar(&opts.PublicKey, "public-key", false, "Pull the public key for the record.")
 	flags.BoolVar(&opts.Signature, "signature", false, "Pull the signature for the record.")
+	flags.BoolVar(&opts.Offline, "offline", false, "Load the record from the local cache without contacting the server.")
 
 	// Add output format flags
 	presenter.AddOutputFlags(Command)
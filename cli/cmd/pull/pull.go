@@ -12,6 +12,7 @@ import (
 	signv1 "github.com/agntcy/dir/api/sign/v1"
 	storev1 "github.com/agntcy/dir/api/store/v1"
 	"github.com/agntcy/dir/cli/presenter"
+	cacheUtils "github.com/agntcy/dir/cli/util/cache"
 	ctxUtils "github.com/agntcy/dir/cli/util/context"
 	"github.com/spf13/cobra"
 )
@@ -36,7 +37,16 @@ Usage examples:
 
 	dirctl pull <cid> --signature
 
-4. Output formats:
+4. Pull a previously pulled record from the local cache without contacting the server
+
+	dirctl pull <cid> --offline
+
+5. Pull by alias or version of a record name (see 'dirctl alias')
+
+	dirctl pull directory.agntcy.org/example/agent:stable
+	dirctl pull directory.agntcy.org/example/agent:v1.0.0
+
+6. Output formats:
 
 	# Get record as JSON
 	dirctl pull <cid> --output json
@@ -49,7 +59,7 @@ Usage examples:
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) != 1 {
-			return errors.New("cid is a required argument")
+			return errors.New("cid or name:tag is a required argument")
 		}
 
 		return runCommand(cmd, args[0])
@@ -58,12 +68,26 @@ Usage examples:
 
 //nolint:cyclop,gocognit
 func runCommand(cmd *cobra.Command, cid string) error {
+	if opts.Offline {
+		return runOfflineCommand(cmd, cid)
+	}
+
 	// Get the client from the context.
 	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
 	if !ok {
 		return errors.New("failed to get client from context")
 	}
 
+	// Resolve name:tag references, so that referrers are pulled by CID
+	if corev1.IsNameReference(cid) {
+		resolved, err := c.ResolveName(cmd.Context(), cid)
+		if err != nil {
+			return err
+		}
+
+		cid = resolved.GetRecordRef().GetCid()
+	}
+
 	// Fetch record from store
 	record, err := c.Pull(cmd.Context(), &corev1.RecordRef{
 		Cid: cid,
@@ -72,6 +96,11 @@ func runCommand(cmd *cobra.Command, cid string) error {
 		return fmt.Errorf("failed to pull data: %w", err)
 	}
 
+	// Cache the record for offline use, caching is best-effort
+	if recordCache, err := cacheUtils.Default(); err == nil {
+		_ = recordCache.PutRecord(record)
+	}
+
 	if !opts.PublicKey && !opts.Signature {
 		// Handle different output formats
 		return presenter.PrintMessage(cmd, "record", "Record data", record.GetData())
@@ -165,3 +194,26 @@ func runCommand(cmd *cobra.Command, cid string) error {
 	// Output the structured data
 	return presenter.PrintMessage(cmd, "record", "Record data with keys and signatures", structuredData)
 }
+
+// runOfflineCommand outputs the record from the local cache without contacting the server.
+func runOfflineCommand(cmd *cobra.Command, cid string) error {
+	if opts.PublicKey || opts.Signature {
+		return errors.New("--public-key and --signature are not available with --offline")
+	}
+
+	if corev1.IsNameReference(cid) {
+		return errors.New("name:tag references cannot be resolved with --offline")
+	}
+
+	recordCache, err := cacheUtils.Default()
+	if err != nil {
+		return err
+	}
+
+	record, err := recordCache.GetRecord(cid)
+	if err != nil {
+		return fmt.Errorf("failed to load record from cache: %w", err)
+	}
+
+	return presenter.PrintMessage(cmd, "record", "Record data", record.GetData())
+}
@@ -0,0 +1,94 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package push
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// bundleFile is the on-disk format of a record bundle.
+//
+// Example:
+//
+//	{
+//	  "record": { ...OASF record... },
+//	  "signature": { "signature": "...", "annotations": { "payload": "..." } },
+//	  "public_key": "-----BEGIN PUBLIC KEY-----...",
+//	  "attestations": [ { ...attestation statement... } ]
+//	}
+type bundleFile struct {
+	Record       json.RawMessage   `json:"record"`
+	Signature    json.RawMessage   `json:"signature"`
+	PublicKey    string            `json:"public_key,omitempty"`
+	Attestations []json.RawMessage `json:"attestations,omitempty"`
+}
+
+// loadBundle converts the bundle file data into a PushBundle request.
+func loadBundle(data []byte) (*storev1.PushBundleRequest, error) {
+	var bundle bundleFile
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, fmt.Errorf("failed to parse bundle: %w", err)
+	}
+
+	if len(bundle.Record) == 0 {
+		return nil, errors.New("bundle record is required")
+	}
+
+	if len(bundle.Signature) == 0 {
+		return nil, errors.New("bundle signature is required")
+	}
+
+	// Load OASF data into a Record
+	record, err := corev1.UnmarshalRecord(bundle.Record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bundle record: %w", err)
+	}
+
+	// Load signature
+	signature := &signv1.Signature{}
+	if err := protojson.Unmarshal(bundle.Signature, signature); err != nil {
+		return nil, fmt.Errorf("failed to load bundle signature: %w", err)
+	}
+
+	signatureReferrer, err := signature.MarshalReferrer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signature to referrer: %w", err)
+	}
+
+	req := &storev1.PushBundleRequest{
+		Record:    record,
+		Signature: signatureReferrer,
+	}
+
+	// Load public key
+	if bundle.PublicKey != "" {
+		req.PublicKey, err = (&signv1.PublicKey{Key: bundle.PublicKey}).MarshalReferrer()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode public key to referrer: %w", err)
+		}
+	}
+
+	// Load attestations
+	for i, raw := range bundle.Attestations {
+		data := &structpb.Struct{}
+		if err := protojson.Unmarshal(raw, data); err != nil {
+			return nil, fmt.Errorf("failed to load bundle attestation %d: %w", i, err)
+		}
+
+		req.Attestations = append(req.Attestations, &corev1.RecordReferrer{
+			Type: corev1.AttestationReferrerType,
+			Data: data,
+		})
+	}
+
+	return req, nil
+}
@@ -0,0 +1,75 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package push
+
+import (
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBundleRecord = `{
+	"name": "test-agent",
+	"version": "1.0.0",
+	"schema_version": "v0.3.1",
+	"authors": ["test"],
+	"created_at": "2023-01-01T00:00:00Z"
+}`
+
+func TestLoadBundle(t *testing.T) {
+	t.Run("complete bundle", func(t *testing.T) {
+		data := `{
+			"record": ` + testBundleRecord + `,
+			"signature": {"signature": "c2lnbmF0dXJl", "annotations": {"payload": "{}"}},
+			"public_key": "public-key",
+			"attestations": [{"predicate_type": "https://slsa.dev/provenance/v1"}]
+		}`
+
+		req, err := loadBundle([]byte(data))
+		require.NoError(t, err)
+
+		assert.NotEmpty(t, req.GetRecord().GetCid())
+
+		signature := &signv1.Signature{}
+		require.NoError(t, signature.UnmarshalReferrer(req.GetSignature()))
+		assert.Equal(t, "c2lnbmF0dXJl", signature.GetSignature())
+		assert.Equal(t, "{}", signature.GetAnnotations()["payload"])
+
+		publicKey := &signv1.PublicKey{}
+		require.NoError(t, publicKey.UnmarshalReferrer(req.GetPublicKey()))
+		assert.Equal(t, "public-key", publicKey.GetKey())
+
+		require.Len(t, req.GetAttestations(), 1)
+		assert.Equal(t, corev1.AttestationReferrerType, req.GetAttestations()[0].GetType())
+		assert.Equal(t, "https://slsa.dev/provenance/v1", req.GetAttestations()[0].GetData().GetFields()["predicate_type"].GetStringValue())
+	})
+
+	t.Run("bundle without optional parts", func(t *testing.T) {
+		data := `{"record": ` + testBundleRecord + `, "signature": {"signature": "c2lnbmF0dXJl"}}`
+
+		req, err := loadBundle([]byte(data))
+		require.NoError(t, err)
+
+		assert.Nil(t, req.GetPublicKey())
+		assert.Empty(t, req.GetAttestations())
+	})
+
+	t.Run("missing signature", func(t *testing.T) {
+		_, err := loadBundle([]byte(`{"record": ` + testBundleRecord + `}`))
+		require.ErrorContains(t, err, "bundle signature is required")
+	})
+
+	t.Run("missing record", func(t *testing.T) {
+		_, err := loadBundle([]byte(`{"signature": {"signature": "c2lnbmF0dXJl"}}`))
+		require.ErrorContains(t, err, "bundle record is required")
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := loadBundle([]byte(`{`))
+		require.ErrorContains(t, err, "failed to parse bundle")
+	})
+}
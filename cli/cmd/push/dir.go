@@ -0,0 +1,203 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signcmd "github.com/agntcy/dir/cli/cmd/sign"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/agntcy/dir/client/batch"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+)
+
+// runDirCommand pushes all record files of the directory over a single PushMany stream.
+// With --fail-fast, the remaining files are skipped after the first failure.
+func runDirCommand(cmd *cobra.Command, dir string) error {
+	// Get the client from the context.
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	files, err := listRecordFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	if len(files) == 0 {
+		return fmt.Errorf("no record files found in %s", dir)
+	}
+
+	progress, err := presenter.NewProgress(cmd)
+	if err != nil {
+		return err
+	}
+
+	failFast := presenter.GetBatchOptions(cmd).FailFast
+	results := batch.NewNamedResult(files)
+
+	progress.Report("push", 0, len(files), 0)
+
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	// Files are only sent if they can be loaded, so keep track of
+	// which file each record sent over the stream belongs to.
+	var (
+		mu   sync.Mutex
+		sent []int
+	)
+
+	fail := func(i int, code codes.Code, message string) {
+		mu.Lock()
+		results.FailWithCode(i, code, message)
+		progress.Report("push", results.Succeeded()+results.Failed(), len(files), results.Failed())
+		mu.Unlock()
+
+		if failFast {
+			cancel()
+		}
+	}
+
+	recordsCh := make(chan *corev1.Record)
+
+	go func() {
+		defer close(recordsCh)
+
+		for i, file := range files {
+			record, err := loadRecordFile(file)
+			if err != nil {
+				fail(i, codes.InvalidArgument, err.Error())
+
+				if failFast {
+					return
+				}
+
+				continue
+			}
+
+			mu.Lock()
+			sent = append(sent, i)
+			mu.Unlock()
+
+			select {
+			case recordsCh <- record:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	result, err := c.PushManyStream(ctx, recordsCh)
+	if err != nil {
+		return fmt.Errorf("failed to push records: %w", err)
+	}
+
+	var errs error
+
+	for done := false; !done; {
+		select {
+		case err := <-result.ErrCh():
+			errs = errors.Join(errs, err)
+		case resp := <-result.ResCh():
+			mu.Lock()
+			i := sent[resp.GetIndex()]
+			mu.Unlock()
+
+			if resp.ErrorMessage != nil {
+				fail(i, codes.Code(resp.GetErrorCode()), resp.GetErrorMessage())
+			} else {
+				mu.Lock()
+				results.Succeed(i, resp.GetRecordRef().GetCid())
+				progress.Report("push", results.Succeeded()+results.Failed(), len(files), results.Failed())
+				mu.Unlock()
+			}
+		case <-result.DoneCh():
+			done = true
+		}
+	}
+
+	// Stream errors caused by stopping at the first failure are expected
+	if errs != nil && !(failFast && ctx.Err() != nil) {
+		return fmt.Errorf("failed to push records: %w", errs)
+	}
+
+	if opts.Sign {
+		toSign := results.Succeeded()
+		signed, signErrors := 0, 0
+
+		progress.Report("sign", 0, toSign, 0)
+
+		for _, item := range results.Items {
+			if item.Status != batch.StatusSucceeded {
+				continue
+			}
+
+			signed++
+
+			if err := signcmd.Sign(cmd.Context(), c, item.CID); err != nil {
+				results.Fail(item.Index, fmt.Errorf("failed to sign record %s: %w", item.CID, err))
+				signErrors++
+			}
+
+			progress.Report("sign", signed, toSign, signErrors)
+
+			if signErrors > 0 && failFast {
+				break
+			}
+		}
+	}
+
+	progress.Report("done", results.Succeeded()+results.Failed(), len(files), results.Failed())
+
+	return presenter.PrintBatchResult(cmd, "Pushed records", results)
+}
+
+// listRecordFiles returns the JSON files found in the directory and its subdirectories.
+func listRecordFiles(dir string) ([]string, error) {
+	var files []string
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.IsDir() && strings.EqualFold(filepath.Ext(path), ".json") {
+			files = append(files, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read directory %s: %w", dir, err)
+	}
+
+	return files, nil
+}
+
+// loadRecordFile loads the OASF record stored in the file.
+func loadRecordFile(path string) (*corev1.Record, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read file: %w", err)
+	}
+
+	record, err := unmarshalRecord(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OASF: %w", err)
+	}
+
+	return record, nil
+}
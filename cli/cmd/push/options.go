@@ -14,6 +14,13 @@ var opts = &options{}
 type options struct {
 	FromStdin bool
 	Sign      bool
+	Bundle    string
+	Dir       string
+	Strict    bool
+
+	Prevalidate bool
+
+	EmbargoUntil string
 
 	// Signing options
 	client.SignOpts
@@ -29,8 +36,38 @@ func init() {
 		"Sign the record with the specified signing options.",
 	)
 
+	flags.StringVar(&opts.Bundle, "bundle", "",
+		"Push a bundle file containing the record together with its signature and attestations. "+
+			"Nothing is stored unless the complete bundle is valid.",
+	)
+
+	flags.StringVar(&opts.Dir, "dir", "",
+		"Push all JSON record files found in the directory over a single stream. "+
+			"Records failing to push do not stop the remaining ones unless --fail-fast is set.",
+	)
+
+	flags.BoolVar(&opts.Strict, "strict", false,
+		"Reject records containing fields that are unknown to or deprecated in their OASF schema version.",
+	)
+
+	flags.BoolVar(&opts.Prevalidate, "prevalidate", false,
+		"Check the record size, schema version and schema validity locally before pushing it, "+
+			"so that records the server would reject fail without sending a request.",
+	)
+
+	flags.StringVar(&opts.EmbargoUntil, "embargo-until", "",
+		"Place the pushed records under embargo until the given RFC 3339 time. "+
+			"Until then, the records are hidden from the searches of other identities and are not announced to the network.",
+	)
+
 	signcmd.AddSigningFlags(flags)
 
 	// Add output format flags
 	presenter.AddOutputFlags(Command)
+
+	// Add failure handling flags for --dir
+	presenter.AddBatchFlags(Command)
+
+	// Add progress events for --dir
+	presenter.AddProgressFlags(Command)
 }
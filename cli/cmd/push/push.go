@@ -14,6 +14,7 @@ import (
 	signcmd "github.com/agntcy/dir/cli/cmd/sign"
 	"github.com/agntcy/dir/cli/presenter"
 	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/agntcy/dir/client"
 	"github.com/spf13/cobra"
 )
 
@@ -37,7 +38,35 @@ Usage examples:
 
 	dirctl push model.json --sign
 
-4. Output formats:
+4. Push a pre-signed record bundle (record, signature and attestations) atomically:
+
+	dirctl push --bundle bundle.json
+
+5. Push all record files of a directory over a single stream:
+
+	dirctl push --dir ./records
+
+   Stop at the first record failing to push:
+
+	dirctl push --dir ./records --fail-fast
+
+   Emit machine-readable progress events on stderr:
+
+	dirctl push --dir ./records --progress jsonl
+
+6. Reject records containing unknown or deprecated fields:
+
+	dirctl push model.json --strict
+
+7. Check the record locally before sending it to the server:
+
+	dirctl push model.json --prevalidate
+
+8. Hide the record until a coordinated launch time:
+
+	dirctl push model.json --embargo-until 2030-01-01T09:00:00Z
+
+9. Output formats:
 
 	# Get CID as JSON
 	dirctl push model.json --output json
@@ -50,6 +79,31 @@ Usage examples:
 
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if opts.Bundle != "" {
+			if len(args) > 0 || opts.FromStdin || opts.Sign || opts.EmbargoUntil != "" {
+				return errors.New("--bundle cannot be combined with a file path, --stdin, --sign or --embargo-until")
+			}
+
+			return runBundleCommand(cmd, opts.Bundle)
+		}
+
+		if opts.EmbargoUntil != "" {
+			until, err := corev1.ParseEmbargoUntil(opts.EmbargoUntil)
+			if err != nil {
+				return err //nolint:wrapcheck
+			}
+
+			cmd.SetContext(client.WithEmbargoUntil(cmd.Context(), until))
+		}
+
+		if opts.Dir != "" {
+			if len(args) > 0 || opts.FromStdin {
+				return errors.New("--dir cannot be combined with a file path or --stdin")
+			}
+
+			return runDirCommand(cmd, opts.Dir)
+		}
+
 		var path string
 		if len(args) > 1 {
 			return errors.New("only one file path is allowed")
@@ -92,11 +146,17 @@ func runCommand(cmd *cobra.Command, source io.Reader) error {
 	}
 
 	// Load OASF data into a Record
-	record, err := corev1.UnmarshalRecord(sourceData)
+	record, err := unmarshalRecord(sourceData)
 	if err != nil {
 		return fmt.Errorf("failed to load OASF: %w", err)
 	}
 
+	if opts.Prevalidate {
+		if _, err := c.PrevalidatePush(cmd.Context(), record); err != nil {
+			return fmt.Errorf("record failed pre-validation: %w", err)
+		}
+	}
+
 	var recordRef *corev1.RecordRef
 
 	// Use the client's Push method to send the record
@@ -115,3 +175,38 @@ func runCommand(cmd *cobra.Command, source io.Reader) error {
 	// Output in the appropriate format
 	return presenter.PrintMessage(cmd, "record", "Pushed record with CID", recordRef.GetCid())
 }
+
+func runBundleCommand(cmd *cobra.Command, path string) error {
+	// Get the client from the context.
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	bundleData, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read bundle file %s: %w", path, err)
+	}
+
+	req, err := loadBundle(bundleData)
+	if err != nil {
+		return fmt.Errorf("failed to load bundle: %w", err)
+	}
+
+	recordRef, err := c.PushBundle(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to push bundle: %w", err)
+	}
+
+	// Output in the appropriate format
+	return presenter.PrintMessage(cmd, "record", "Pushed record bundle with CID", recordRef.GetCid())
+}
+
+// unmarshalRecord loads the record, rejecting unknown or deprecated fields in strict mode.
+func unmarshalRecord(data []byte) (*corev1.Record, error) {
+	if opts.Strict {
+		return corev1.UnmarshalRecordStrict(data)
+	}
+
+	return corev1.UnmarshalRecord(data)
+}
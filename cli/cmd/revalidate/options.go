@@ -0,0 +1,23 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package revalidate
+
+import "github.com/agntcy/dir/cli/presenter"
+
+var opts = &options{}
+
+type options struct {
+	OnlyInvalid bool
+}
+
+func init() {
+	flags := Command.Flags()
+	flags.BoolVar(&opts.OnlyInvalid, "only-invalid", false, "Only show records that no longer validate")
+
+	// Add output format flags
+	presenter.AddOutputFlags(Command)
+
+	// Add progress events
+	presenter.AddProgressFlags(Command)
+}
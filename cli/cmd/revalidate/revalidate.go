@@ -0,0 +1,151 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package revalidate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "revalidate [<cid>...]",
+	Short: "Re-validate stored records against the current validation rules",
+	Long: `Re-validate records already stored on the Directory server against the
+OASF schemas and validation rules currently used by the server.
+
+Records that were valid and no longer validate are flagged as drifted,
+and a RECORD_VALIDATION_DRIFT event is emitted for them.
+
+Usage examples:
+
+1. Re-validate all stored records:
+
+	dirctl revalidate
+
+2. Re-validate specific records:
+
+	dirctl revalidate <cid1> <cid2>
+
+3. Only show records that no longer validate:
+
+	dirctl revalidate --only-invalid
+
+4. Emit machine-readable progress events on stderr:
+
+	dirctl revalidate --progress jsonl
+
+5. Output formats:
+
+	# Get validation results as JSON
+	dirctl revalidate --output json
+
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCommand(cmd, args)
+	},
+}
+
+func runCommand(cmd *cobra.Command, cids []string) error {
+	// Get the client from the context.
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	req := &storev1.ValidateStoredRequest{
+		OnlyInvalid: opts.OnlyInvalid,
+	}
+
+	for _, cid := range cids {
+		req.RecordRefs = append(req.RecordRefs, &corev1.RecordRef{Cid: cid})
+	}
+
+	progress, err := presenter.NewProgress(cmd)
+	if err != nil {
+		return err
+	}
+
+	result, err := c.ValidateStoredStream(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to re-validate records: %w", err)
+	}
+
+	var (
+		results []*storev1.ValidateStoredResponse
+		invalid int
+	)
+
+	// The total is unknown when re-validating all stored records
+	progress.Report("validate", 0, len(cids), 0)
+
+	for {
+		select {
+		case resp := <-result.ResCh():
+			results = append(results, resp)
+
+			if !resp.GetValid() {
+				invalid++
+			}
+
+			progress.Report("validate", len(results), len(cids), invalid)
+		case err := <-result.ErrCh():
+			return fmt.Errorf("failed to re-validate records: %w", err)
+		case <-result.DoneCh():
+			progress.Report("done", len(results), len(cids), invalid)
+
+			return printResults(cmd, results)
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		}
+	}
+}
+
+func printResults(cmd *cobra.Command, results []*storev1.ValidateStoredResponse) error {
+	// Output in the appropriate format
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "results", "Validation results", results)
+	}
+
+	if len(results) == 0 {
+		presenter.Printf(cmd, "No records to report\n")
+
+		return nil
+	}
+
+	var invalid, drifted int
+
+	for _, result := range results {
+		switch {
+		case result.GetValid():
+			presenter.Printf(cmd, "%s: valid\n", result.GetRecordRef().GetCid())
+
+			continue
+		case result.GetDrift():
+			drifted++
+
+			presenter.Printf(cmd, "%s: invalid (drift)\n", result.GetRecordRef().GetCid())
+		default:
+			presenter.Printf(cmd, "%s: invalid\n", result.GetRecordRef().GetCid())
+		}
+
+		invalid++
+
+		if len(result.GetErrors()) > 0 {
+			presenter.Printf(cmd, "  %s\n", strings.Join(result.GetErrors(), "\n  "))
+		}
+	}
+
+	presenter.Printf(cmd, "\nValidation rules %s: %d invalid, %d drifted\n",
+		results[0].GetRulesVersion(), invalid, drifted)
+
+	return nil
+}
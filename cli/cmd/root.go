@@ -7,22 +7,36 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/agntcy/dir/cli/cmd/alias"
+	"github.com/agntcy/dir/cli/cmd/cache"
+	"github.com/agntcy/dir/cli/cmd/cid"
+	"github.com/agntcy/dir/cli/cmd/consistency"
+	"github.com/agntcy/dir/cli/cmd/copy"
 	"github.com/agntcy/dir/cli/cmd/delete"
+	"github.com/agntcy/dir/cli/cmd/deps"
 	"github.com/agntcy/dir/cli/cmd/events"
+	"github.com/agntcy/dir/cli/cmd/gc"
 	hubCmd "github.com/agntcy/dir/cli/cmd/hub"
 	importcmd "github.com/agntcy/dir/cli/cmd/import"
 	"github.com/agntcy/dir/cli/cmd/info"
+	"github.com/agntcy/dir/cli/cmd/locate"
 	"github.com/agntcy/dir/cli/cmd/mcp"
 	"github.com/agntcy/dir/cli/cmd/network"
+	"github.com/agntcy/dir/cli/cmd/ops"
+	"github.com/agntcy/dir/cli/cmd/patch"
 	"github.com/agntcy/dir/cli/cmd/pull"
 	"github.com/agntcy/dir/cli/cmd/push"
+	"github.com/agntcy/dir/cli/cmd/revalidate"
 	"github.com/agntcy/dir/cli/cmd/routing"
+	"github.com/agntcy/dir/cli/cmd/schema"
 	"github.com/agntcy/dir/cli/cmd/search"
 	"github.com/agntcy/dir/cli/cmd/sign"
 	"github.com/agntcy/dir/cli/cmd/sync"
+	"github.com/agntcy/dir/cli/cmd/token"
 	"github.com/agntcy/dir/cli/cmd/verify"
 	"github.com/agntcy/dir/cli/cmd/version"
 	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/agntcy/dir/cli/util/instance"
 	"github.com/agntcy/dir/client"
 	"github.com/agntcy/dir/hub"
 	"github.com/spf13/cobra"
@@ -34,6 +48,31 @@ var RootCmd = &cobra.Command{
 	Long:         ``,
 	SilenceUsage: true,
 	PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+		// Bound the command by its deadline if set, so that scripts do not hang on unreachable servers
+		if timeout > 0 {
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			cmd.SetContext(ctx)
+			cobra.OnFinalize(cancel)
+		}
+
+		// Skip client creation for commands working on local state only
+		if !requiresClient(cmd) {
+			return nil
+		}
+
+		// Send the persistent client instance ID if enabled and no explicit ID is set
+		if sendInstanceID && clientConfig.ClientInstanceID == "" {
+			path, err := instance.DefaultPath()
+			if err != nil {
+				return fmt.Errorf("failed to get client instance ID path: %w", err)
+			}
+
+			clientConfig.ClientInstanceID, err = instance.LoadOrCreate(path)
+			if err != nil {
+				return fmt.Errorf("failed to load client instance ID: %w", err)
+			}
+		}
+
 		// Set client via context for all requests
 		// TODO: make client config configurable via CLI args
 		c, err := client.New(cmd.Context(), client.WithConfig(clientConfig))
@@ -42,6 +81,7 @@ var RootCmd = &cobra.Command{
 		}
 
 		ctx := ctxUtils.SetClientForContext(cmd.Context(), c)
+		ctx = ctxUtils.SetClientConfigForContext(ctx, clientConfig)
 		cmd.SetContext(ctx)
 
 		cobra.OnFinalize(func() {
@@ -63,11 +103,22 @@ func init() {
 		// initialize.Command, // REMOVED: Initialize functionality
 		sign.Command,
 		verify.Command,
+		cache.Command, // Contains: stats, clear
+		cid.Command,
+		schema.Command, // Contains: describe
 		// storage commands
 		info.Command,
 		pull.Command,
 		push.Command,
 		delete.Command,
+		copy.Command,
+		deps.Command,
+		locate.Command,
+		revalidate.Command,
+		consistency.Command,
+		gc.Command,
+		patch.Command,
+		alias.Command, // Contains: set, delete, list, history, resolve
 		// import commands
 		importcmd.Command,
 		// routing commands (all under routing subcommand)
@@ -80,11 +131,33 @@ func init() {
 		sync.Command,
 		// events commands
 		events.Command, // Contains: listen
+		// operations commands
+		ops.Command, // Contains: list, wait, cancel
+		// token commands
+		token.Command, // Contains: create, exchange
 		// mcp commands
 		mcp.Command, // Contains: serve
+		// development commands are registered in root_dev.go
 	)
 }
 
+// requiresClient reports whether the command needs a client in its context.
+// Commands annotated with SkipClientAnnotation (or whose parent is) and
+// commands run with --offline work on local state only.
+func requiresClient(cmd *cobra.Command) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		if _, ok := c.Annotations[ctxUtils.SkipClientAnnotation]; ok {
+			return false
+		}
+	}
+
+	if offline, err := cmd.Flags().GetBool("offline"); err == nil && offline {
+		return false
+	}
+
+	return true
+}
+
 func Run(ctx context.Context) error {
 	if err := RootCmd.ExecuteContext(ctx); err != nil {
 		return fmt.Errorf("failed to execute command: %w", err)
@@ -0,0 +1,16 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !dir_minimal
+
+package cmd
+
+import "github.com/agntcy/dir/cli/cmd/dev"
+
+// The development commands embed the server, and with it libp2p, GORM and the OCI registry
+// clients, so they are left out of binaries built with the dir_minimal build tag.
+func init() {
+	RootCmd.AddCommand(
+		dev.Command, // Contains: up
+	)
+}
@@ -0,0 +1,57 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package routing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var confirmCmd = &cobra.Command{
+	Use:   "confirm <publication-id>",
+	Short: "End the settle delay of a pending publication",
+	Long: `End the settle delay of a pending publication.
+
+When a settle delay is configured on the server, newly published records are
+only announced to the network once the delay has elapsed, giving publishers a
+window to catch mistakes before global propagation. Confirming a publication
+ends its delay, so that its records are announced when the publication is next
+processed.
+
+Usage examples:
+
+1. List pending publications:
+   dirctl routing publications
+
+2. Confirm a publication:
+   dirctl routing confirm <publication-id>
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runConfirmCommand(cmd, args[0])
+	},
+}
+
+func runConfirmCommand(cmd *cobra.Command, publicationID string) error {
+	// Get the client from the context
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	if err := c.ConfirmPublication(cmd.Context(), publicationID); err != nil {
+		return fmt.Errorf("failed to confirm publication: %w", err)
+	}
+
+	result := map[string]interface{}{
+		"publication_id": publicationID,
+		"status":         "Publication confirmed",
+	}
+
+	return presenter.PrintMessage(cmd, "Confirm", "Publication confirmed", result) //nolint:wrapcheck
+}
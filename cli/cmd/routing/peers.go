@@ -0,0 +1,142 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package routing
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var peersCmd = &cobra.Command{
+	Use:   "peers",
+	Short: "List peers known to the routing network",
+	Long: `List peers known to the routing network.
+
+This command lists the peers this node is connected to or has received
+capability announcements from. Capabilities are advertised by each peer
+and describe the API and record schema versions it supports, the maximum
+message size it accepts and whether it is read-only.
+
+Peers with incompatible capabilities are skipped by remote search and sync.
+
+Usage examples:
+
+1. List known peers:
+   dirctl routing peers
+
+2. List known peers with their capabilities:
+   dirctl routing peers --details
+
+3. Output formats:
+   # Get peers and capabilities as JSON
+   dirctl routing peers --output json
+`,
+	//nolint:gocritic // Lambda required due to signature mismatch - runPeersCommand doesn't use args
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runPeersCommand(cmd)
+	},
+}
+
+// Peers command options.
+var peersOpts struct {
+	Details bool
+}
+
+func init() {
+	peersCmd.Flags().BoolVar(&peersOpts.Details, "details", false, "Show capabilities advertised by each peer")
+
+	// Add output format flags
+	presenter.AddOutputFlags(peersCmd)
+}
+
+func runPeersCommand(cmd *cobra.Command) error {
+	// Get the client from the context
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	result, err := c.ListPeersStream(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("failed to list peers: %w", err)
+	}
+
+	var peers []*routingv1.Peer
+
+	for {
+		select {
+		case resp := <-result.ResCh():
+			peers = append(peers, resp.GetPeer())
+		case err := <-result.ErrCh():
+			return fmt.Errorf("failed to list peers: %w", err)
+		case <-result.DoneCh():
+			return printPeers(cmd, peers)
+		case <-cmd.Context().Done():
+			return cmd.Context().Err() //nolint:wrapcheck
+		}
+	}
+}
+
+func printPeers(cmd *cobra.Command, peers []*routingv1.Peer) error {
+	// Output in the appropriate format
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "peers", "Known peers", peers) //nolint:wrapcheck
+	}
+
+	if len(peers) == 0 {
+		presenter.Printf(cmd, "No peers found\n")
+
+		return nil
+	}
+
+	for _, peer := range peers {
+		connection := "not connected"
+		if peer.GetConnection() == routingv1.PeerConnectionType_PEER_CONNECTION_TYPE_CONNECTED {
+			connection = "connected"
+		}
+
+		presenter.Printf(cmd, "%s (%s)\n", peer.GetId(), connection)
+
+		if addrs := nonEmpty(peer.GetAddrs()); len(addrs) > 0 {
+			presenter.Printf(cmd, "  Address: %s\n", strings.Join(addrs, ", "))
+		}
+
+		if !peersOpts.Details {
+			continue
+		}
+
+		capabilities := peer.GetCapabilities()
+		if capabilities == nil {
+			presenter.Printf(cmd, "  Capabilities: not advertised\n")
+
+			continue
+		}
+
+		presenter.Printf(cmd, "  API versions: %s\n", strings.Join(capabilities.GetApiVersions(), ", "))
+		presenter.Printf(cmd, "  Schema versions: %s\n", strings.Join(capabilities.GetSchemaVersions(), ", "))
+		presenter.Printf(cmd, "  Max message size: %d bytes\n", capabilities.GetMaxMessageSize())
+		presenter.Printf(cmd, "  Read-only: %t\n", capabilities.GetReadOnly())
+		presenter.Printf(cmd, "  Updated at: %s\n", capabilities.GetUpdatedAt())
+	}
+
+	return nil
+}
+
+func nonEmpty(values []string) []string {
+	var result []string
+
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
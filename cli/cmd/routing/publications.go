@@ -0,0 +1,186 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package routing
+
+import (
+	"errors"
+	"fmt"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var publicationsCmd = &cobra.Command{
+	Use:   "publications",
+	Short: "List publication requests with their status and schedule",
+	Long: `List publication requests with their status and schedule.
+
+Publication requests are created by 'dirctl routing publish' and processed
+in the background by the publication service of the server.
+
+Pending publications may be held back by the settle delay configured on the
+server. Use 'dirctl routing confirm <publication-id>' to end the delay early.
+
+With --summary, the number of queued, settling, in-progress and failed
+publications is shown instead, in total and by namespace and label, to see
+the publication backlog at a glance. Completed publications are not counted.
+
+Usage examples:
+
+1. List publication requests:
+   dirctl routing publications
+
+2. List the first 10 publication requests:
+   dirctl routing publications --limit 10
+
+3. Summarize the publication backlog by namespace and label:
+   dirctl routing publications --summary
+
+4. Output formats:
+   # Get publication requests as JSON
+   dirctl routing publications --output json
+
+   # Get the publication summary as JSON
+   dirctl routing publications --summary --output json
+`,
+	//nolint:gocritic // Lambda required due to signature mismatch - runPublicationsCommand doesn't use args
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runPublicationsCommand(cmd)
+	},
+}
+
+// Publications command options.
+var publicationsOpts struct {
+	Limit   uint32
+	Offset  uint32
+	Summary bool
+}
+
+func init() {
+	publicationsCmd.Flags().Uint32Var(&publicationsOpts.Limit, "limit", 0, "Maximum number of publication requests to list (0 for all)")
+	publicationsCmd.Flags().Uint32Var(&publicationsOpts.Offset, "offset", 0, "Number of publication requests to skip")
+	publicationsCmd.Flags().BoolVar(&publicationsOpts.Summary, "summary", false, "Show the publication counts by namespace and label instead of the publication requests")
+
+	// Add output format flags
+	presenter.AddOutputFlags(publicationsCmd)
+}
+
+func runPublicationsCommand(cmd *cobra.Command) error {
+	// Get the client from the context
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	if publicationsOpts.Summary {
+		summary, err := c.GetPublicationSummary(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("failed to get publication summary: %w", err)
+		}
+
+		return printPublicationSummary(cmd, summary)
+	}
+
+	req := &routingv1.ListPublicationsRequest{}
+	if publicationsOpts.Limit > 0 {
+		req.Limit = &publicationsOpts.Limit
+	}
+
+	if publicationsOpts.Offset > 0 {
+		req.Offset = &publicationsOpts.Offset
+	}
+
+	result, err := c.ListPublications(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to list publications: %w", err)
+	}
+
+	var publications []*routingv1.ListPublicationsItem
+
+	for {
+		select {
+		case resp := <-result.ResCh():
+			publications = append(publications, resp)
+		case err := <-result.ErrCh():
+			return fmt.Errorf("failed to list publications: %w", err)
+		case <-result.DoneCh():
+			return printPublications(cmd, publications)
+		case <-cmd.Context().Done():
+			return cmd.Context().Err() //nolint:wrapcheck
+		}
+	}
+}
+
+func printPublications(cmd *cobra.Command, publications []*routingv1.ListPublicationsItem) error {
+	// Output in the appropriate format
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "publications", "Publication requests", publications) //nolint:wrapcheck
+	}
+
+	if len(publications) == 0 {
+		presenter.Printf(cmd, "No publications found\n")
+
+		return nil
+	}
+
+	for _, publication := range publications {
+		presenter.Printf(cmd, "%s (%s)\n", publication.GetPublicationId(), publication.GetStatus())
+		presenter.Printf(cmd, "  Created: %s\n", publication.GetCreatedTime())
+
+		if settleUntil := publication.GetSettleUntil(); settleUntil != "" {
+			presenter.Printf(cmd, "  Settling until: %s\n", settleUntil)
+		}
+
+		schedule := publication.GetSchedule()
+		if schedule == nil {
+			continue
+		}
+
+		presenter.Printf(cmd, "  Announced: %d/%d (%d failed)\n", schedule.GetAnnouncedRecords(), schedule.GetTotalRecords(), schedule.GetFailedRecords())
+
+		if next := schedule.GetNextAnnounceTime(); next != "" {
+			presenter.Printf(cmd, "  Next announcement: %s\n", next)
+		}
+	}
+
+	return nil
+}
+
+func printPublicationSummary(cmd *cobra.Command, summary *routingv1.GetPublicationSummaryResponse) error {
+	// Output in the appropriate format
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "summary", "Publication summary", summary) //nolint:wrapcheck
+	}
+
+	presenter.Printf(cmd, "Total: %s\n", formatPublicationCounts(summary.GetTotal()))
+
+	printPublicationGroups(cmd, "Namespaces", summary.GetNamespaces())
+	printPublicationGroups(cmd, "Labels", summary.GetLabels())
+
+	return nil
+}
+
+func printPublicationGroups(cmd *cobra.Command, title string, groups []*routingv1.PublicationSummaryGroup) {
+	if len(groups) == 0 {
+		return
+	}
+
+	presenter.Printf(cmd, "%s:\n", title)
+
+	for _, group := range groups {
+		name := group.GetName()
+		if name == "" {
+			name = "(none)"
+		}
+
+		presenter.Printf(cmd, "  %s: %s\n", name, formatPublicationCounts(group.GetCounts()))
+	}
+}
+
+func formatPublicationCounts(counts *routingv1.PublicationCounts) string {
+	return fmt.Sprintf("%d queued, %d settling, %d in progress, %d failed",
+		counts.GetQueued(), counts.GetSettling(), counts.GetInProgress(), counts.GetFailed())
+}
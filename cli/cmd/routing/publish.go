@@ -37,7 +37,10 @@ Usage examples:
 1. Publish a record to the network:
    dirctl routing publish <cid>
 
-2. Output formats:
+2. Publish a record immediately, skipping the settle delay configured on the server:
+   dirctl routing publish <cid> --confirm
+
+3. Output formats:
    # Publish with JSON confirmation
    dirctl routing publish <cid> --output json
    
@@ -45,6 +48,10 @@ Usage examples:
    dirctl routing publish <cid> --output raw
 
 Note: The record must already be pushed to storage before publishing.
+When a settle delay is configured on the server, records are only announced once
+the delay has elapsed, giving a window to catch mistakes before global propagation.
+Use 'dirctl routing publications' to list pending publications and
+'dirctl routing confirm <publication-id>' to end the delay early.
 `,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -52,6 +59,15 @@ Note: The record must already be pushed to storage before publishing.
 	},
 }
 
+// Publish command options.
+var publishOpts struct {
+	Confirm bool
+}
+
+func init() {
+	publishCmd.Flags().BoolVar(&publishOpts.Confirm, "confirm", false, "Skip the settle delay configured on the server")
+}
+
 func runPublishCommand(cmd *cobra.Command, cid string) error {
 	// Get the client from the context
 	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
@@ -77,6 +93,7 @@ func runPublishCommand(cmd *cobra.Command, cid string) error {
 				Refs: []*corev1.RecordRef{recordRef},
 			},
 		},
+		Confirmed: publishOpts.Confirm,
 	}); err != nil {
 		if strings.Contains(err.Error(), "failed to announce object") {
 			return errors.New("failed to announce object, it will be retried in the background on the API server")
@@ -20,6 +20,9 @@ This command group provides access to all routing-specific operations:
 - list: Query local records with filtering
 - search: Discover remote records from other peers
 - info: Show routing statistics and summary information
+- peers: List known peers and their advertised capabilities
+- publications: List publication requests with their status and schedule
+- confirm: End the settle delay of a pending publication
 
 Examples:
 
@@ -46,8 +49,12 @@ func init() {
 	Command.AddCommand(listCmd)
 	Command.AddCommand(searchCmd)
 	Command.AddCommand(infoCmd)
+	Command.AddCommand(peersCmd)
+	Command.AddCommand(publicationsCmd)
+	Command.AddCommand(confirmCmd)
 
 	// Add output format flags to routing subcommands
 	presenter.AddOutputFlags(publishCmd)
 	presenter.AddOutputFlags(unpublishCmd)
+	presenter.AddOutputFlags(confirmCmd)
 }
@@ -5,12 +5,15 @@
 package routing
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
 	"github.com/agntcy/dir/cli/presenter"
 	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/agntcy/dir/client"
 	"github.com/spf13/cobra"
 )
 
@@ -26,7 +29,9 @@ Key Features:
 - Remote-only: Only returns records from other peers
 - OR logic: Records returned if they match ≥ minScore queries
 - Match scoring: Shows how well records match your criteria
+- Popularity ranking: Records with equal scores are ordered by network-wide pull count
 - Peer information: Shows which peer provides each record
+- Verification: Optionally pulls each record from its peer to verify its CID and signature
 
 Usage examples:
 
@@ -39,7 +44,15 @@ Usage examples:
 3. Search with result limiting:
    dirctl routing search --skill "web-development" --limit 5
 
-4. Output formats:
+4. Verify results before syncing them:
+   dirctl routing search --skill "AI" --verify --output json | dirctl sync create --stdin
+
+   Each result is pulled from the Directory API of the peer that announced it,
+   using the authentication settings of the command. Results whose content does
+   not match their CID, or without a valid signature, are marked unverified,
+   and are skipped by "dirctl sync create --stdin".
+
+5. Output formats:
    # Get results as JSON
    dirctl routing search --skill "AI" --output json
    
@@ -64,6 +77,7 @@ var searchOpts struct {
 	Modules  []string
 	Limit    uint32
 	MinScore uint32
+	Verify   bool
 }
 
 const (
@@ -80,6 +94,7 @@ func init() {
 	searchCmd.Flags().StringArrayVar(&searchOpts.Modules, "module", nil, "Search for records with specific module (can be repeated)")
 	searchCmd.Flags().Uint32Var(&searchOpts.Limit, "limit", defaultSearchLimit, "Maximum number of results to return")
 	searchCmd.Flags().Uint32Var(&searchOpts.MinScore, "min-score", defaultMinScore, "Minimum match score (number of queries that must match)")
+	searchCmd.Flags().BoolVar(&searchOpts.Verify, "verify", false, "Pull each result from its peer and verify its CID and signature")
 
 	// Add examples in flag help
 	searchCmd.Flags().Lookup("skill").Usage = "Search for records with specific skill (e.g., --skill 'AI' --skill 'ML')"
@@ -165,9 +180,99 @@ func runSearchCommand(cmd *cobra.Command) error {
 
 	// Collect results
 	results := make([]interface{}, 0, searchOpts.Limit)
+
+	if !searchOpts.Verify {
+		for result := range resultCh {
+			results = append(results, result)
+		}
+
+		return presenter.PrintMessage(cmd, "remote records", "Remote records found", results)
+	}
+
+	cfg, ok := ctxUtils.GetClientConfigFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client config from context")
+	}
+
+	verifier := newPeerVerifier(cfg)
+	defer verifier.Close()
+
 	for result := range resultCh {
-		results = append(results, result)
+		results = append(results, &verifiedSearchResult{
+			SearchResponse: result,
+			Verification:   verifier.Verify(cmd.Context(), result),
+		})
 	}
 
 	return presenter.PrintMessage(cmd, "remote records", "Remote records found", results)
 }
+
+// verifiedSearchResult is a search result with the verification of its record.
+// It is serialized as the search result with an additional verification field.
+type verifiedSearchResult struct {
+	*routingv1.SearchResponse
+
+	Verification *client.RecordVerification `json:"verification"`
+}
+
+func (r *verifiedSearchResult) String() string {
+	if r.Verification.Verified() {
+		return r.SearchResponse.String() + " [verified]"
+	}
+
+	return fmt.Sprintf("%s [unverified: %s]", r.SearchResponse.String(), r.Verification.Error)
+}
+
+// peerVerifier verifies search results against the Directory API of the peers that announced them.
+type peerVerifier struct {
+	cfg     *client.Config
+	clients map[string]*client.Client
+}
+
+func newPeerVerifier(cfg *client.Config) *peerVerifier {
+	return &peerVerifier{
+		cfg:     cfg,
+		clients: make(map[string]*client.Client),
+	}
+}
+
+// Verify pulls the record of a search result from its peer and verifies it.
+func (v *peerVerifier) Verify(ctx context.Context, result *routingv1.SearchResponse) *client.RecordVerification {
+	addrs := result.GetPeer().GetAddrs()
+	if len(addrs) == 0 || addrs[0] == "" {
+		return &client.RecordVerification{Error: "peer has no Directory API address"}
+	}
+
+	c, err := v.client(ctx, addrs[0])
+	if err != nil {
+		return &client.RecordVerification{Error: err.Error()}
+	}
+
+	return c.VerifyRecord(ctx, &corev1.RecordRef{Cid: result.GetRecordRef().GetCid()})
+}
+
+// client returns the client of a peer, created once per address with the settings of the command.
+func (v *peerVerifier) client(ctx context.Context, address string) (*client.Client, error) {
+	if c, ok := v.clients[address]; ok {
+		return c, nil
+	}
+
+	peerCfg := *v.cfg
+	peerCfg.ServerAddress = address
+
+	c, err := client.New(ctx, client.WithConfig(&peerCfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for %s: %w", address, err)
+	}
+
+	v.clients[address] = c
+
+	return c, nil
+}
+
+// Close closes the clients of the peers.
+func (v *peerVerifier) Close() {
+	for _, c := range v.clients {
+		_ = c.Close()
+	}
+}
@@ -10,15 +10,16 @@ import (
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
 	"github.com/agntcy/dir/cli/presenter"
 	ctxUtils "github.com/agntcy/dir/cli/util/context"
 	"github.com/spf13/cobra"
 )
 
 var unpublishCmd = &cobra.Command{
-	Use:   "unpublish <cid>",
-	Short: "Unpublish record from the network",
-	Long: `Unpublish a record from the network to stop content discovery by other peers.
+	Use:   "unpublish [<cid>]",
+	Short: "Unpublish records from the network",
+	Long: `Unpublish records from the network to stop content discovery by other peers.
 
 This command removes a record's network announcements, making it no longer
 discoverable by other peers through the DHT. The record remains in local storage.
@@ -28,27 +29,96 @@ Key Features:
 - Local cleanup: Removes record from local routing index
 - DHT cleanup: Removes record and label announcements from network
 - Immediate effect: Record becomes undiscoverable by other peers
+- Filter-based unpublish: Retract all published records matching skills, domains,
+  names or other filters in a single server-side operation
+- Dry run: Show the records matching the filters without unpublishing them
+- Async: Unpublish many records in the background and track progress with 'dirctl ops'
 
 Usage examples:
 
 1. Unpublish a record from the network:
    dirctl routing unpublish <cid>
 
-2. Output formats:
+2. Show the published records that match the filters:
+   dirctl routing unpublish --skill "AI" --dry-run
+
+3. Unpublish all published records matching the filters:
+   dirctl routing unpublish --skill "AI" --locator "docker-image"
+   dirctl routing unpublish --name "my-org/*"
+
+4. Unpublish many records in the background:
+   dirctl routing unpublish --name "my-org/*" --async
+   dirctl ops wait <operation-id>
+
+5. Output formats:
    # Unpublish with JSON confirmation
    dirctl routing unpublish <cid> --output json
    
    # Unpublish with raw output for scripting
    dirctl routing unpublish <cid> --output raw
 
+Multiple filters are combined with AND logic. Filter-based unpublish only
+matches records published by this node.
+
 Note: This only removes network announcements. Use 'dirctl delete' to remove the record entirely.
 `,
-	Args: cobra.ExactArgs(1),
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return runUnpublishCommand(cmd, args[0])
+		queries := buildUnpublishQueries()
+
+		if len(args) == 1 {
+			if len(queries) > 0 || unpublishOpts.DryRun || unpublishOpts.Async {
+				return errors.New("filter flags, --dry-run and --async cannot be used with a CID")
+			}
+
+			return runUnpublishCommand(cmd, args[0])
+		}
+
+		if len(queries) == 0 {
+			return errors.New("either a CID or at least one filter flag is required")
+		}
+
+		if unpublishOpts.DryRun && unpublishOpts.Async {
+			return errors.New("--dry-run and --async cannot be used together")
+		}
+
+		return runUnpublishMatchingCommand(cmd, queries)
 	},
 }
 
+// Unpublish command options.
+var unpublishOpts struct {
+	DryRun      bool
+	Async       bool
+	Names       []string
+	Versions    []string
+	SkillIDs    []string
+	SkillNames  []string
+	Locators    []string
+	Modules     []string
+	DomainIDs   []string
+	DomainNames []string
+	Annotations []string
+}
+
+func init() {
+	flags := unpublishCmd.Flags()
+
+	flags.BoolVar(&unpublishOpts.DryRun, "dry-run", false, "Show the records matching the filters without unpublishing them")
+	flags.BoolVar(&unpublishOpts.Async, "async", false, "Unpublish the matching records in the background on the server and print the operation ID")
+
+	// Direct field flags (consistent with search)
+	flags.StringArrayVar(&unpublishOpts.Names, "name", nil, "Unpublish records with specific name (e.g., --name 'my-agent' --name 'my-org/*')")
+	flags.StringArrayVar(&unpublishOpts.Versions, "version", nil, "Unpublish records with specific version (e.g., --version 'v1.0.0' --version 'v1.*')")
+	flags.StringArrayVar(&unpublishOpts.SkillIDs, "skill-id", nil, "Unpublish records with specific skill ID (e.g., --skill-id '10201')")
+	flags.StringArrayVar(&unpublishOpts.SkillNames, "skill", nil, "Unpublish records with specific skill name (e.g., --skill 'natural_language_processing')")
+	flags.StringArrayVar(&unpublishOpts.Locators, "locator", nil, "Unpublish records with specific locator type (e.g., --locator 'docker-image')")
+	flags.StringArrayVar(&unpublishOpts.Modules, "module", nil, "Unpublish records with specific module (e.g., --module 'runtime/language')")
+	flags.StringArrayVar(&unpublishOpts.DomainIDs, "domain-id", nil, "Unpublish records with specific domain ID (e.g., --domain-id '604')")
+	flags.StringArrayVar(&unpublishOpts.DomainNames, "domain", nil, "Unpublish records with specific domain name (e.g., --domain 'healthcare/*')")
+	flags.StringArrayVar(&unpublishOpts.Annotations, "annotation", nil, "Unpublish records with specific indexed annotation (e.g., --annotation 'team=platform')")
+}
+
 func runUnpublishCommand(cmd *cobra.Command, cid string) error {
 	// Get the client from the context
 	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
@@ -87,3 +157,78 @@ func runUnpublishCommand(cmd *cobra.Command, cid string) error {
 
 	return presenter.PrintMessage(cmd, "Unpublish", "Successfully unpublished record", result)
 }
+
+func runUnpublishMatchingCommand(cmd *cobra.Command, queries []*searchv1.RecordQuery) error {
+	// Get the client from the context
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	if unpublishOpts.Async {
+		resp, err := c.UnpublishMatchingAsync(cmd.Context(), queries)
+		if err != nil {
+			return fmt.Errorf("failed to unpublish: %w", err)
+		}
+
+		result := map[string]interface{}{
+			"operation_id": resp.GetOperationId(),
+			"count":        resp.GetCount(),
+			"status":       "running",
+		}
+
+		return presenter.PrintMessage(cmd, "Unpublish", fmt.Sprintf("Unpublishing %d record(s) with operation ID %s", resp.GetCount(), resp.GetOperationId()), result)
+	}
+
+	resp, err := c.UnpublishMatching(cmd.Context(), queries, unpublishOpts.DryRun)
+	if err != nil {
+		return fmt.Errorf("failed to unpublish: %w", err)
+	}
+
+	cids := make([]string, 0, len(resp.GetRecordRefs()))
+	for _, ref := range resp.GetRecordRefs() {
+		cids = append(cids, ref.GetCid())
+	}
+
+	status := "unpublished"
+	title := fmt.Sprintf("Successfully unpublished %d record(s)", resp.GetCount())
+
+	if unpublishOpts.DryRun {
+		status = "dry-run"
+		title = fmt.Sprintf("%d record(s) would be unpublished", resp.GetCount())
+	}
+
+	// Output in the appropriate format
+	result := map[string]interface{}{
+		"cids":   cids,
+		"count":  resp.GetCount(),
+		"status": status,
+	}
+
+	return presenter.PrintMessage(cmd, "Unpublish", title, result)
+}
+
+func buildUnpublishQueries() []*searchv1.RecordQuery {
+	var queries []*searchv1.RecordQuery
+
+	add := func(queryType searchv1.RecordQueryType, values []string) {
+		for _, value := range values {
+			queries = append(queries, &searchv1.RecordQuery{
+				Type:  queryType,
+				Value: value,
+			})
+		}
+	}
+
+	add(searchv1.RecordQueryType_RECORD_QUERY_TYPE_NAME, unpublishOpts.Names)
+	add(searchv1.RecordQueryType_RECORD_QUERY_TYPE_VERSION, unpublishOpts.Versions)
+	add(searchv1.RecordQueryType_RECORD_QUERY_TYPE_SKILL_ID, unpublishOpts.SkillIDs)
+	add(searchv1.RecordQueryType_RECORD_QUERY_TYPE_SKILL_NAME, unpublishOpts.SkillNames)
+	add(searchv1.RecordQueryType_RECORD_QUERY_TYPE_LOCATOR, unpublishOpts.Locators)
+	add(searchv1.RecordQueryType_RECORD_QUERY_TYPE_MODULE, unpublishOpts.Modules)
+	add(searchv1.RecordQueryType_RECORD_QUERY_TYPE_DOMAIN_ID, unpublishOpts.DomainIDs)
+	add(searchv1.RecordQueryType_RECORD_QUERY_TYPE_DOMAIN_NAME, unpublishOpts.DomainNames)
+	add(searchv1.RecordQueryType_RECORD_QUERY_TYPE_ANNOTATION, unpublishOpts.Annotations)
+
+	return queries
+}
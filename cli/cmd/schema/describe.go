@@ -0,0 +1,337 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/agntcy/oasf-sdk/pkg/validator"
+)
+
+// Field describes a record field of a schema.
+type Field struct {
+	Path     string         `json:"path"`
+	Title    string         `json:"title,omitempty"`
+	Type     string         `json:"type"`
+	Required bool           `json:"required"`
+	Const    any            `json:"const,omitempty"`
+	Enum     []string       `json:"enum,omitempty"`
+	Fields   []FieldSummary `json:"fields,omitempty"`
+	Classes  []Class        `json:"classes,omitempty"`
+}
+
+// FieldSummary summarizes a nested field of an object.
+type FieldSummary struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+}
+
+// Class is an allowed class of a skills, domains or modules field.
+type Class struct {
+	Name  string `json:"name"`
+	ID    int    `json:"id,omitempty"`
+	Title string `json:"title,omitempty"`
+}
+
+// schemaNode is a JSON Schema node.
+type schemaNode = map[string]any
+
+// Describe describes the field at the dot-separated path of the given schema version.
+// An empty path describes the record itself.
+func Describe(version, path string) (*Field, error) {
+	root, err := loadSchema(version)
+	if err != nil {
+		return nil, err
+	}
+
+	node := root.resolve(root.node)
+	required := true
+
+	var walked []string
+
+	for _, segment := range splitPath(path) {
+		node = root.element(node)
+
+		next, isRequired, err := root.child(node, segment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe %q: %w", strings.Join(append(walked, segment), "."), err)
+		}
+
+		node, required = next, isRequired
+		walked = append(walked, segment)
+	}
+
+	field := &Field{
+		Path:     strings.Join(walked, "."),
+		Title:    stringValue(node["title"]),
+		Type:     root.typeOf(node),
+		Required: required,
+		Const:    node["const"],
+	}
+
+	element := root.element(node)
+
+	for _, value := range sliceValue(element["enum"]) {
+		field.Enum = append(field.Enum, fmt.Sprint(value))
+	}
+
+	field.Fields = root.fields(element)
+	field.Classes = root.classes(element)
+
+	return field, nil
+}
+
+// schema is a parsed schema with its definitions for resolving references.
+type schema struct {
+	node schemaNode
+}
+
+// loadSchema loads the embedded schema of the given version.
+// Versions are accepted with or without the "v" prefix.
+func loadSchema(version string) (*schema, error) {
+	versions, err := validator.GetAvailableSchemaVersions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema versions: %w", err)
+	}
+
+	if !slices.Contains(versions, version) {
+		trimmed := strings.TrimPrefix(version, "v")
+		if !slices.Contains(versions, trimmed) {
+			return nil, fmt.Errorf("unknown schema version %q, available versions: %s", version, strings.Join(versions, ", "))
+		}
+
+		version = trimmed
+	}
+
+	data, err := validator.GetSchemaContent(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+
+	var node schemaNode
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+
+	return &schema{node: node}, nil
+}
+
+// resolve follows local references of a node.
+func (s *schema) resolve(node schemaNode) schemaNode {
+	for {
+		ref, ok := node["$ref"].(string)
+		if !ok || !strings.HasPrefix(ref, "#/") {
+			return node
+		}
+
+		target := s.node
+
+		for _, key := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+			next, ok := target[key].(schemaNode)
+			if !ok {
+				return node
+			}
+
+			target = next
+		}
+
+		// Keep the title of the referencing node, which describes the field
+		resolved := make(schemaNode, len(target))
+		for k, v := range target {
+			resolved[k] = v
+		}
+
+		if title, ok := node["title"]; ok {
+			resolved["title"] = title
+		}
+
+		node = resolved
+	}
+}
+
+// element returns the element schema of arrays and the node itself otherwise.
+func (s *schema) element(node schemaNode) schemaNode {
+	if items, ok := node["items"].(schemaNode); ok && node["type"] == "array" {
+		return s.resolve(items)
+	}
+
+	return node
+}
+
+// alternatives returns the resolved oneOf/anyOf alternatives of a node with their schema keys.
+func (s *schema) alternatives(node schemaNode) ([]string, []schemaNode) {
+	var (
+		keys  []string
+		nodes []schemaNode
+	)
+
+	for _, combinator := range []string{"oneOf", "anyOf"} {
+		for _, alt := range sliceValue(node[combinator]) {
+			altNode, ok := alt.(schemaNode)
+			if !ok {
+				continue
+			}
+
+			ref, _ := altNode["$ref"].(string)
+			keys = append(keys, ref[strings.LastIndex(ref, "/")+1:])
+			nodes = append(nodes, s.resolve(altNode))
+		}
+	}
+
+	return keys, nodes
+}
+
+// child returns the node of a property or class of a node and whether it is required.
+func (s *schema) child(node schemaNode, name string) (schemaNode, bool, error) {
+	if props, ok := node["properties"].(schemaNode); ok {
+		if prop, ok := props[name].(schemaNode); ok {
+			required := slices.Contains(sliceValue(node["required"]), any(name))
+
+			return s.resolve(prop), required, nil
+		}
+	}
+
+	keys, alts := s.alternatives(node)
+	for i, alt := range alts {
+		if keys[i] == name || classOf(alt).Name == name {
+			return alt, false, nil
+		}
+	}
+
+	if names := s.propertyNames(node); len(names) > 0 {
+		return nil, false, fmt.Errorf("no such field, available fields: %s", strings.Join(names, ", "))
+	}
+
+	if len(alts) > 0 {
+		return nil, false, fmt.Errorf("no such class, %d classes are available", len(alts))
+	}
+
+	return nil, false, fmt.Errorf("field has no nested fields")
+}
+
+// propertyNames returns the sorted property names of a node.
+func (s *schema) propertyNames(node schemaNode) []string {
+	props, _ := node["properties"].(schemaNode)
+
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// typeOf returns a readable type of a node.
+func (s *schema) typeOf(node schemaNode) string {
+	typ, _ := node["type"].(string)
+
+	switch {
+	case typ == "array":
+		if items, ok := node["items"].(schemaNode); ok {
+			return "[]" + s.typeOf(s.resolve(items))
+		}
+
+		return "[]any"
+	case typ == "object":
+		if values, ok := node["additionalProperties"].(schemaNode); ok {
+			return "map[string]" + s.typeOf(s.resolve(values))
+		}
+
+		return "object"
+	case typ != "":
+		return typ
+	}
+
+	if _, alts := s.alternatives(node); len(alts) > 0 {
+		return "object"
+	}
+
+	return "any"
+}
+
+// fields summarizes the properties of an object node.
+func (s *schema) fields(node schemaNode) []FieldSummary {
+	props, _ := node["properties"].(schemaNode)
+	required := sliceValue(node["required"])
+
+	var fields []FieldSummary
+
+	for _, name := range s.propertyNames(node) {
+		prop, _ := props[name].(schemaNode)
+
+		fields = append(fields, FieldSummary{
+			Name:     name,
+			Type:     s.typeOf(s.resolve(prop)),
+			Required: slices.Contains(required, any(name)),
+		})
+	}
+
+	return fields
+}
+
+// classes lists the classes allowed by the alternatives of a node, sorted by name.
+func (s *schema) classes(node schemaNode) []Class {
+	_, alts := s.alternatives(node)
+
+	var classes []Class
+
+	for _, alt := range alts {
+		if class := classOf(alt); class.Name != "" {
+			classes = append(classes, class)
+		}
+	}
+
+	sort.Slice(classes, func(i, j int) bool {
+		return classes[i].Name < classes[j].Name
+	})
+
+	return classes
+}
+
+// classOf extracts the class name, ID and title from a class definition.
+func classOf(node schemaNode) Class {
+	class := Class{Title: stringValue(node["title"])}
+
+	props, _ := node["properties"].(schemaNode)
+
+	if name, ok := props["name"].(schemaNode); ok {
+		class.Name = stringValue(name["const"])
+	}
+
+	if id, ok := props["id"].(schemaNode); ok {
+		if value, ok := id["const"].(float64); ok {
+			class.ID = int(value)
+		}
+	}
+
+	return class
+}
+
+// splitPath splits a dot-separated field path.
+func splitPath(path string) []string {
+	if path == "" {
+		return nil
+	}
+
+	return strings.Split(path, ".")
+}
+
+func stringValue(value any) string {
+	s, _ := value.(string)
+
+	return s
+}
+
+func sliceValue(value any) []any {
+	s, _ := value.([]any)
+
+	return s
+}
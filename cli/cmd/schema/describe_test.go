@@ -0,0 +1,63 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribe(t *testing.T) {
+	t.Run("record fields", func(t *testing.T) {
+		field, err := Describe("0.8.0", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, "object", field.Type)
+		assert.Contains(t, field.Fields, FieldSummary{Name: "name", Type: "string", Required: true})
+		assert.Contains(t, field.Fields, FieldSummary{Name: "locators", Type: "[]object"})
+	})
+
+	t.Run("skills classes", func(t *testing.T) {
+		field, err := Describe("v0.8.0", "skills")
+		require.NoError(t, err)
+
+		assert.Equal(t, "skills", field.Path)
+		assert.Equal(t, "[]object", field.Type)
+		assert.True(t, field.Required)
+		assert.Contains(t, field.Classes, Class{
+			Name:  "natural_language_processing/creative_content/storytelling",
+			ID:    10401,
+			Title: "Storytelling",
+		})
+	})
+
+	t.Run("enum of array elements", func(t *testing.T) {
+		field, err := Describe("0.8.0", "locators.type")
+		require.NoError(t, err)
+
+		assert.Equal(t, "string", field.Type)
+		assert.True(t, field.Required)
+		assert.Contains(t, field.Enum, "docker_image")
+	})
+
+	t.Run("field of a class", func(t *testing.T) {
+		field, err := Describe("0.8.0", "modules.integration/a2a")
+		require.NoError(t, err)
+
+		assert.Equal(t, "object", field.Type)
+		assert.Contains(t, field.Fields, FieldSummary{Name: "data", Type: "object", Required: true})
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := Describe("0.8.0", "locators.unknown")
+		require.ErrorContains(t, err, "available fields: annotations, digest, size, type, url")
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := Describe("9.9.9", "")
+		require.ErrorContains(t, err, "unknown schema version")
+	})
+}
@@ -0,0 +1,15 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+var opts = &options{}
+
+type options struct {
+	Path string
+}
+
+func init() {
+	flags := describeCmd.Flags()
+	flags.StringVar(&opts.Path, "path", "", "Dot-separated path of the field to describe (e.g. skills, locators.type)")
+}
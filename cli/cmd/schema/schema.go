@@ -0,0 +1,136 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package schema
+
+import (
+	"strings"
+
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "schema",
+	Short: "Explore the OASF record schemas",
+	Long: `Explore the OASF record schemas embedded in dirctl.
+
+Usage examples:
+
+1. Describe the fields of a record:
+
+	dirctl schema describe 0.8.0
+
+2. Describe a specific field:
+
+	dirctl schema describe 0.8.0 --path skills
+	dirctl schema describe 0.8.0 --path locators.type
+
+`,
+	Annotations: map[string]string{
+		ctxUtils.SkipClientAnnotation: "true",
+	},
+}
+
+var describeCmd = &cobra.Command{
+	Use:   "describe <version>",
+	Short: "Describe record fields of a schema version",
+	Long: `Describe record fields of a schema version.
+
+Shows the type, title, and whether the field is required, as well as the
+fields of objects, the allowed values of enums, and the allowed classes of
+skills, domains and modules.
+
+The --path flag selects a field with a dot-separated path. Array fields
+are described by their elements, and a class of skills, domains or modules
+is selected by its name or schema key, for example "modules.integration/a2a.data".
+
+Usage examples:
+
+1. Describe the top-level record fields:
+
+	dirctl schema describe 0.8.0
+
+2. List the skills that can be set on a record:
+
+	dirctl schema describe 0.8.0 --path skills
+
+3. Show the allowed locator types as JSON:
+
+	dirctl schema describe 0.8.0 --path locators.type --output json
+
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		field, err := Describe(args[0], opts.Path)
+		if err != nil {
+			return err
+		}
+
+		if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+			return presenter.PrintMessage(cmd, "field", "Schema field", field)
+		}
+
+		displayField(cmd, field)
+
+		return nil
+	},
+}
+
+func init() {
+	Command.AddCommand(describeCmd)
+
+	presenter.AddOutputFlags(describeCmd)
+}
+
+// displayField displays a field description in human-readable format.
+func displayField(cmd *cobra.Command, field *Field) {
+	path := field.Path
+	if path == "" {
+		path = "(record)"
+	}
+
+	presenter.Printf(cmd, "Field: %s\n", path)
+
+	if field.Title != "" {
+		presenter.Printf(cmd, "Title: %s\n", field.Title)
+	}
+
+	presenter.Printf(cmd, "Type: %s\n", field.Type)
+	presenter.Printf(cmd, "Required: %t\n", field.Required)
+
+	if field.Const != nil {
+		presenter.Printf(cmd, "Value: %v\n", field.Const)
+	}
+
+	if len(field.Enum) > 0 {
+		presenter.Printf(cmd, "Allowed values: %s\n", strings.Join(field.Enum, ", "))
+	}
+
+	if len(field.Fields) > 0 {
+		presenter.Printf(cmd, "Fields:\n")
+
+		for _, f := range field.Fields {
+			required := ""
+			if f.Required {
+				required = " (required)"
+			}
+
+			presenter.Printf(cmd, "  %s: %s%s\n", f.Name, f.Type, required)
+		}
+	}
+
+	if len(field.Classes) > 0 {
+		presenter.Printf(cmd, "Classes (%d):\n", len(field.Classes))
+
+		for _, c := range field.Classes {
+			if c.ID != 0 {
+				presenter.Printf(cmd, "  %s [%d] %s\n", c.Name, c.ID, c.Title)
+			} else {
+				presenter.Printf(cmd, "  %s %s\n", c.Name, c.Title)
+			}
+		}
+	}
+}
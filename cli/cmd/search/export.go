@@ -0,0 +1,229 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package search
+
+import (
+	"archive/tar"
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// exportManifestName is the name of the manifest file in export archives.
+	exportManifestName = "manifest.json"
+
+	// exportRecordsDir is the directory of the record files in export archives.
+	exportRecordsDir = "records"
+
+	// exportFileMode is the mode of the files in export archives.
+	exportFileMode = 0o644
+)
+
+// exportManifest describes the content of an export archive.
+type exportManifest struct {
+	CreatedAt string          `json:"created_at"`
+	Queries   []exportQuery   `json:"queries,omitempty"`
+	Records   []exportedEntry `json:"records"`
+}
+
+// exportQuery is a search query that selected the exported records.
+type exportQuery struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// exportedEntry describes an exported record and the file it was written to.
+type exportedEntry struct {
+	CID     string `json:"cid"`
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+	Path    string `json:"path"`
+}
+
+// runExportCommand pulls the records matching the search and writes them with a manifest to a tar archive.
+// The number of exported records is bounded by --export-max, and the export must be confirmed unless --yes is set.
+func runExportCommand(cmd *cobra.Command, req *searchv1.SearchRequest) error {
+	if opts.ExportMax == 0 {
+		return errors.New("--export-max must be positive")
+	}
+
+	// Search one more record than allowed to detect searches matching too many records
+	if !cmd.Flags().Changed("limit") {
+		limit := opts.ExportMax + 1
+		req.Limit = &limit
+	}
+
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	ch, err := c.Search(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to search: %w", err)
+	}
+
+	refs := make([]*corev1.RecordRef, 0)
+
+	for recordCid := range ch {
+		if recordCid != "" {
+			refs = append(refs, &corev1.RecordRef{Cid: recordCid})
+		}
+	}
+
+	if len(refs) == 0 {
+		return errors.New("no records match the search, nothing to export")
+	}
+
+	if len(refs) > int(opts.ExportMax) {
+		return fmt.Errorf("more than %d records match the search: refine the search or raise --export-max", opts.ExportMax)
+	}
+
+	if !opts.Yes {
+		confirmed, err := confirmExport(cmd, len(refs), opts.Export)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			return errors.New("export aborted")
+		}
+	}
+
+	records, err := c.PullBatch(cmd.Context(), refs)
+	if err != nil {
+		return fmt.Errorf("failed to pull records: %w", err)
+	}
+
+	manifest, err := writeExportArchive(opts.Export, req.GetQueries(), records, time.Now())
+	if err != nil {
+		return err
+	}
+
+	result := map[string]interface{}{
+		"path":    opts.Export,
+		"records": len(manifest.Records),
+	}
+
+	return presenter.PrintMessage(cmd, "export", "Exported records", result)
+}
+
+// confirmExport asks the user to confirm the export on the standard input.
+func confirmExport(cmd *cobra.Command, count int, path string) (bool, error) {
+	presenter.Errorf(cmd, "Export %d records to %s? [y/N] ", count, path)
+
+	answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("failed to read confirmation: %w", err)
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+
+	return answer == "y" || answer == "yes", nil
+}
+
+// writeExportArchive writes the records and their manifest to a tar archive at path.
+// The archive is written to a temporary file first, so that failed exports leave no partial archive.
+// Record files contain the canonical JSON of the records and can be pushed again with `dirctl push --dir`.
+func writeExportArchive(path string, queries []*searchv1.RecordQuery, records []*corev1.Record, now time.Time) (*exportManifest, error) {
+	manifest := &exportManifest{
+		CreatedAt: now.UTC().Format(time.RFC3339),
+		Records:   make([]exportedEntry, 0, len(records)),
+	}
+
+	for _, query := range queries {
+		manifest.Queries = append(manifest.Queries, exportQuery{Type: query.GetType().String(), Value: query.GetValue()})
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".dirctl-export-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create archive: %w", err)
+	}
+
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	archive := tar.NewWriter(tmp)
+
+	for _, record := range records {
+		data, err := record.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal record %s: %w", record.GetCid(), err)
+		}
+
+		entry := exportedEntry{
+			CID:     record.GetCid(),
+			Name:    record.GetData().GetFields()["name"].GetStringValue(),
+			Version: record.GetData().GetFields()["version"].GetStringValue(),
+			Path:    exportRecordsDir + "/" + record.GetCid() + ".json",
+		}
+
+		if err := writeArchiveFile(archive, entry.Path, data, now); err != nil {
+			return nil, err
+		}
+
+		manifest.Records = append(manifest.Records, entry)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	if err := writeArchiveFile(archive, exportManifestName, data, now); err != nil {
+		return nil, err
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	if err := tmp.Chmod(exportFileMode); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// writeArchiveFile adds a file to a tar archive.
+func writeArchiveFile(archive *tar.Writer, name string, data []byte, modTime time.Time) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    exportFileMode,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+
+	if err := archive.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write %s to archive: %w", name, err)
+	}
+
+	if _, err := archive.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s to archive: %w", name, err)
+	}
+
+	return nil
+}
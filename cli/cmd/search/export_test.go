@@ -0,0 +1,87 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteExportArchive(t *testing.T) {
+	record, err := corev1.UnmarshalRecord([]byte(`{
+		"name": "directory.agntcy.org/example/agent",
+		"version": "v1.0.0",
+		"schema_version": "0.7.0",
+		"description": "Example agent",
+		"authors": ["AGNTCY"],
+		"created_at": "2025-01-01T00:00:00Z",
+		"skills": [{"id": 10201, "name": "natural_language_processing/natural_language_generation/text_completion"}],
+		"locators": [{"type": "docker_image", "url": "https://ghcr.io/agntcy/example"}]
+	}`))
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "results.tar")
+	queries := []*searchv1.RecordQuery{{Type: searchv1.RecordQueryType_RECORD_QUERY_TYPE_NAME, Value: "*example*"}}
+
+	manifest, err := writeExportArchive(path, queries, []*corev1.Record{record}, time.Now())
+	require.NoError(t, err)
+	require.Len(t, manifest.Records, 1)
+	assert.Equal(t, record.GetCid(), manifest.Records[0].CID)
+	assert.Equal(t, "directory.agntcy.org/example/agent", manifest.Records[0].Name)
+	assert.Equal(t, "v1.0.0", manifest.Records[0].Version)
+
+	files := readArchive(t, path)
+	require.Len(t, files, 2)
+
+	// Exported records keep their CID when loaded again
+	exported, err := corev1.UnmarshalRecord(files[manifest.Records[0].Path])
+	require.NoError(t, err)
+	assert.Equal(t, record.GetCid(), exported.GetCid())
+
+	var readManifest exportManifest
+	require.NoError(t, json.Unmarshal(files[exportManifestName], &readManifest))
+	assert.Equal(t, *manifest, readManifest)
+	assert.Equal(t, []exportQuery{{Type: "RECORD_QUERY_TYPE_NAME", Value: "*example*"}}, readManifest.Queries)
+
+	// No temporary files are left behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+// readArchive returns the content of the files of a tar archive by name.
+func readArchive(t *testing.T, path string) map[string][]byte {
+	t.Helper()
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	files := make(map[string][]byte)
+	archive := tar.NewReader(file)
+
+	for {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+
+		require.NoError(t, err)
+
+		files[header.Name], err = io.ReadAll(archive)
+		require.NoError(t, err)
+	}
+}
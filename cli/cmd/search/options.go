@@ -11,6 +11,13 @@ type options struct {
 	Limit  uint32
 	Offset uint32
 
+	// PageSize and PageToken read the results page by page
+	PageSize  uint32
+	PageToken string
+
+	// Offline returns cached results without contacting the server
+	Offline bool
+
 	// Direct field flags (consistent with routing search)
 	Names       []string
 	Versions    []string
@@ -20,6 +27,27 @@ type options struct {
 	Modules     []string
 	DomainIDs   []string
 	DomainNames []string
+	Annotations []string
+	Licenses    []string
+	Signers     []string
+
+	// PreferredRegions ranks records with locators in these regions first
+	PreferredRegions []string
+
+	// UpdatedSince and SinceWatermark only return records changed since a previous poll
+	UpdatedSince   string
+	SinceWatermark string
+
+	// Fields returns these record fields with the CIDs found
+	Fields []string
+
+	// ExpandProvenance returns a result for every provenance of a record instead of merging them
+	ExpandProvenance bool
+
+	// Export pulls the matching records into a tar archive, up to ExportMax records
+	Export    string
+	ExportMax uint32
+	Yes       bool
 }
 
 func init() {
@@ -27,6 +55,24 @@ func init() {
 
 	flags.Uint32Var(&opts.Limit, "limit", 100, "Maximum number of results to return (default: 100)") //nolint:mnd
 	flags.Uint32Var(&opts.Offset, "offset", 0, "Pagination offset (default: 0)")
+	flags.Uint32Var(&opts.PageSize, "page-size", 0, "Read a page of at most this many results ordered by CID, printing the next page token on stderr")
+	flags.StringVar(&opts.PageToken, "page-token", "", "Read the page after the search that printed this next page token")
+	flags.BoolVar(&opts.Offline, "offline", false, "Return the cached result of the same search without contacting the server")
+	flags.StringArrayVar(&opts.PreferredRegions, "prefer-region", nil,
+		"Return records with a locator in this region first, most preferred first (can be repeated, default: region of the server)")
+	flags.StringVar(&opts.UpdatedSince, "updated-since", "",
+		"Only return records added or updated since an RFC 3339 time or a duration ago (e.g., --updated-since 1h)")
+	flags.StringVar(&opts.SinceWatermark, "since-watermark", "",
+		"Only return records added or updated since the search that returned this watermark")
+	flags.StringSliceVar(&opts.Fields, "fields", nil,
+		"Return these record fields with the CIDs found: name, version, created_at, skills, locators, modules, domains, provenance (e.g., --fields name,version)")
+	flags.BoolVar(&opts.ExpandProvenance, "expand-provenance", false,
+		"Return a result for every push and sync a record comes from, instead of a single result with merged provenance")
+	flags.StringVar(&opts.Export, "export", "",
+		"Pull the matching records and write them with a manifest to a tar archive at this path")
+	flags.Uint32Var(&opts.ExportMax, "export-max", 1000, //nolint:mnd
+		"Maximum number of records to export, searches matching more records fail (default: 1000)")
+	flags.BoolVar(&opts.Yes, "yes", false, "Export without asking for confirmation")
 
 	// Direct field flags
 	flags.StringArrayVar(&opts.Names, "name", nil, "Search for records with specific name (can be repeated)")
@@ -37,6 +83,9 @@ func init() {
 	flags.StringArrayVar(&opts.Modules, "module", nil, "Search for records with specific module (can be repeated)")
 	flags.StringArrayVar(&opts.DomainIDs, "domain-id", nil, "Search for records with specific domain ID (can be repeated)")
 	flags.StringArrayVar(&opts.DomainNames, "domain", nil, "Search for records with specific domain name (can be repeated)")
+	flags.StringArrayVar(&opts.Annotations, "annotation", nil, "Search for records with specific annotation (can be repeated)")
+	flags.StringArrayVar(&opts.Licenses, "license", nil, "Search for records with specific license (can be repeated)")
+	flags.StringArrayVar(&opts.Signers, "signer", nil, "Search for records signed by specific signer identity (can be repeated)")
 
 	// Add examples in flag help
 	flags.Lookup("name").Usage = "Search for records with specific name (e.g., --name 'my-agent' --name 'web-*')"
@@ -47,6 +96,9 @@ func init() {
 	flags.Lookup("module").Usage = "Search for records with specific module (e.g., --module 'runtime/language')"
 	flags.Lookup("domain-id").Usage = "Search for records with specific domain ID (e.g., --domain-id '604')"
 	flags.Lookup("domain").Usage = "Search for records with specific domain name (e.g., --domain '*education*' --domain 'healthcare/*')"
+	flags.Lookup("annotation").Usage = "Search for records with specific indexed annotation (e.g., --annotation 'team=platform' --annotation 'environment=prod*')"
+	flags.Lookup("license").Usage = "Search for records with specific SPDX license (e.g., --license 'Apache-2.0' --license 'BSD-*')"
+	flags.Lookup("signer").Usage = "Search for records signed by specific signer identity (e.g., --signer 'spiffe://acme/ci' --signer 'sha256:<key-fingerprint>')"
 
 	// Add output format flags
 	presenter.AddOutputFlags(Command)
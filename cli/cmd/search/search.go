@@ -7,11 +7,16 @@ package search
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	searchv1 "github.com/agntcy/dir/api/search/v1"
 	"github.com/agntcy/dir/cli/presenter"
+	cacheUtils "github.com/agntcy/dir/cli/util/cache"
 	ctxUtils "github.com/agntcy/dir/cli/util/context"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var Command = &cobra.Command{
@@ -106,6 +111,48 @@ Usage examples:
 	# Get raw CIDs only for piping to other commands
 	dirctl search --name "web*" --output raw | xargs -I {} dirctl pull {}
 
+7. Offline usage:
+
+	# Return the cached result of the last identical search without contacting the server
+	dirctl search --name "web*" --offline
+
+8. Incremental polling:
+
+	# Return the records added or updated in the last hour
+	dirctl search --updated-since 1h --output raw
+
+	# Return the records added or updated since a previous search,
+	# using the watermark it printed on stderr
+	dirctl search --since-watermark <watermark> --output raw
+
+9. Record fields:
+
+	# Return the name and version of the records found with their CIDs
+	dirctl search --skill "AI" --fields name,version --output json
+
+	# Return the pushes and syncs each record comes from, merged into a single result per record
+	dirctl search --skill "AI" --fields provenance --output json
+
+	# Return a result for every push and sync a record comes from
+	dirctl search --skill "AI" --expand-provenance --output json
+
+10. Pagination:
+
+	# Read the first 500 records ordered by CID, printing the next page token on stderr
+	dirctl search --skill "AI" --page-size 500 --output raw
+
+	# Read the next page, until no next page token is printed
+	dirctl search --skill "AI" --page-size 500 --page-token <token> --output raw
+
+11. Export:
+
+	# Pull the matching records and write them with a manifest to an archive,
+	# for offline analysis or to seed another environment with "dirctl push --dir"
+	dirctl search --skill "*machine*learning*" --export results.tar
+
+	# Export without confirmation, failing if more than 500 records match
+	dirctl search --name "web*" --export results.tar --export-max 500 --yes
+
 `,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		return runCommand(cmd)
@@ -113,37 +160,286 @@ Usage examples:
 }
 
 func runCommand(cmd *cobra.Command) error {
+	// Build queries from direct field flags
+	req := &searchv1.SearchRequest{
+		Limit:            &opts.Limit,
+		Offset:           &opts.Offset,
+		Queries:          buildQueriesFromFlags(),
+		PreferredRegions: opts.PreferredRegions,
+	}
+
+	if opts.PageSize > 0 || opts.PageToken != "" {
+		if opts.Offline || opts.Export != "" || opts.UpdatedSince != "" || opts.SinceWatermark != "" ||
+			cmd.Flags().Changed("limit") || cmd.Flags().Changed("offset") || len(opts.PreferredRegions) > 0 {
+			return errors.New("--page-size and --page-token cannot be used with --limit, --offset, --prefer-region, --offline, --export, --updated-since or --since-watermark")
+		}
+
+		return runPageCommand(cmd, req)
+	}
+
+	if len(opts.Fields) > 0 || opts.ExpandProvenance {
+		if opts.Offline || opts.Export != "" || opts.UpdatedSince != "" || opts.SinceWatermark != "" {
+			return errors.New("--fields and --expand-provenance cannot be used with --offline, --export, --updated-since or --since-watermark")
+		}
+
+		return runFieldsCommand(cmd, req)
+	}
+
+	if opts.Export != "" {
+		if opts.Offline || opts.UpdatedSince != "" || opts.SinceWatermark != "" {
+			return errors.New("--export cannot be used with --offline, --updated-since or --since-watermark")
+		}
+
+		return runExportCommand(cmd, req)
+	}
+
+	if opts.UpdatedSince != "" || opts.SinceWatermark != "" {
+		if opts.Offline {
+			return errors.New("--offline cannot be used with --updated-since or --since-watermark")
+		}
+
+		return runIncrementalCommand(cmd, req)
+	}
+
+	if opts.Offline {
+		return runOfflineCommand(cmd, req)
+	}
+
 	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
 	if !ok {
 		return errors.New("failed to get client from context")
 	}
 
-	// Build queries from direct field flags
-	queries := buildQueriesFromFlags()
-
-	ch, err := c.Search(cmd.Context(), &searchv1.SearchRequest{
-		Limit:   &opts.Limit,
-		Offset:  &opts.Offset,
-		Queries: queries,
-	})
+	ch, err := c.Search(cmd.Context(), req)
 	if err != nil {
 		return fmt.Errorf("failed to search: %w", err)
 	}
 
-	// Collect results and convert to interface{} slice
-	results := make([]interface{}, 0, opts.Limit)
+	// Collect results
+	cids := make([]string, 0, opts.Limit)
 
 	for recordCid := range ch {
 		if recordCid == "" {
 			continue
 		}
 
-		results = append(results, recordCid)
+		cids = append(cids, recordCid)
+	}
+
+	// Cache the results for offline use, caching is best-effort
+	if searchCache, err := cacheUtils.Default(); err == nil {
+		_ = searchCache.PutSearch(searchCacheKey(req), cids)
+	}
+
+	return printResults(cmd, cids)
+}
+
+// runOfflineCommand outputs the cached result of the search without contacting the server.
+func runOfflineCommand(cmd *cobra.Command, req *searchv1.SearchRequest) error {
+	searchCache, err := cacheUtils.Default()
+	if err != nil {
+		return err
+	}
+
+	entry, err := searchCache.GetSearch(searchCacheKey(req))
+	if err != nil {
+		return fmt.Errorf("failed to load search results from cache: %w", err)
+	}
+
+	return printResults(cmd, entry.CIDs)
+}
+
+// runIncrementalCommand outputs the records changed since the requested time or watermark.
+// The watermark to pass in the next poll is printed on stderr, so that it does not mix with results.
+// Incremental results are not cached, as they depend on the time of the search.
+func runIncrementalCommand(cmd *cobra.Command, req *searchv1.SearchRequest) error {
+	if opts.UpdatedSince != "" {
+		since, err := parseUpdatedSince(opts.UpdatedSince, time.Now())
+		if err != nil {
+			return err
+		}
+
+		req.UpdatedSince = timestamppb.New(since)
+	}
+
+	req.SinceWatermark = opts.SinceWatermark
+
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	result, err := c.SearchStream(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to search: %w", err)
+	}
+
+	var (
+		cids      []string
+		watermark string
+	)
+
+	for {
+		select {
+		case resp := <-result.ResCh():
+			cids = append(cids, resp.GetRecordCid())
+			watermark = resp.GetWatermark()
+		case err := <-result.ErrCh():
+			return fmt.Errorf("failed to search: %w", err)
+		case <-result.DoneCh():
+			return printIncrementalResults(cmd, cids, watermark)
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		}
+	}
+}
+
+// runFieldsCommand outputs the records found with the requested fields.
+// Only the requested fields are read and returned by the server, with the provenance of each result if expanded.
+func runFieldsCommand(cmd *cobra.Command, req *searchv1.SearchRequest) error {
+	req.ReadMask = &fieldmaskpb.FieldMask{Paths: append([]string{"record_cid"}, opts.Fields...)}
+	req.ExpandProvenance = opts.ExpandProvenance
+
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	result, err := c.SearchStream(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to search: %w", err)
+	}
+
+	var results []interface{}
+
+	for {
+		select {
+		case resp := <-result.ResCh():
+			results = append(results, resp)
+		case err := <-result.ErrCh():
+			return fmt.Errorf("failed to search: %w", err)
+		case <-result.DoneCh():
+			return presenter.PrintMessage(cmd, "records", "Records found", results)
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		}
+	}
+}
+
+// runPageCommand outputs a page of the records found, with the requested fields and expanded provenance if any.
+// The token of the next page is printed on stderr, so that it does not mix with results.
+// Pages are not cached, as they depend on the snapshot time of the first page.
+func runPageCommand(cmd *cobra.Command, req *searchv1.SearchRequest) error {
+	req.Limit = nil
+	req.Offset = nil
+	req.PageSize = &opts.PageSize
+	req.PageToken = opts.PageToken
+
+	if len(opts.Fields) > 0 {
+		req.ReadMask = &fieldmaskpb.FieldMask{Paths: append([]string{"record_cid"}, opts.Fields...)}
+	}
+
+	req.ExpandProvenance = opts.ExpandProvenance
+
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	page, err := c.SearchPage(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to search: %w", err)
+	}
+
+	if len(opts.Fields) > 0 || opts.ExpandProvenance {
+		results := make([]interface{}, 0, len(page.Results))
+		for _, resp := range page.Results {
+			results = append(results, resp)
+		}
+
+		err = presenter.PrintMessage(cmd, "records", "Records found", results)
+	} else {
+		cids := make([]string, 0, len(page.Results))
+		for _, resp := range page.Results {
+			cids = append(cids, resp.GetRecordCid())
+		}
+
+		err = printResults(cmd, cids)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if page.NextPageToken != "" {
+		presenter.Errorf(cmd, "Next page token: %s\n", page.NextPageToken)
+	}
+
+	return nil
+}
+
+// printIncrementalResults outputs the record CIDs found, and the watermark on stderr.
+// Without results, no watermark is returned and the previous one remains valid.
+func printIncrementalResults(cmd *cobra.Command, cids []string, watermark string) error {
+	if err := printResults(cmd, cids); err != nil {
+		return err
+	}
+
+	if watermark == "" {
+		watermark = opts.SinceWatermark
+	}
+
+	if watermark != "" {
+		presenter.Errorf(cmd, "Watermark: %s\n", watermark)
+	}
+
+	return nil
+}
+
+// parseUpdatedSince parses an RFC 3339 time, or a duration before now.
+func parseUpdatedSince(value string, now time.Time) (time.Time, error) {
+	if since, err := time.Parse(time.RFC3339, value); err == nil {
+		return since, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return time.Time{}, fmt.Errorf("invalid --updated-since value %q: expected an RFC 3339 timestamp or a duration such as 1h", value)
+	}
+
+	return now.Add(-duration), nil
+}
+
+// printResults outputs the record CIDs found.
+func printResults(cmd *cobra.Command, cids []string) error {
+	// Convert to interface{} slice
+	results := make([]interface{}, 0, len(cids))
+	for _, cid := range cids {
+		results = append(results, cid)
 	}
 
 	return presenter.PrintMessage(cmd, "record CIDs", "Record CIDs found", results)
 }
 
+// searchCacheKey returns the key identifying the search request in the cache.
+func searchCacheKey(req *searchv1.SearchRequest) string {
+	parts := []string{
+		fmt.Sprintf("limit=%d", req.GetLimit()),
+		fmt.Sprintf("offset=%d", req.GetOffset()),
+	}
+
+	for _, query := range req.GetQueries() {
+		parts = append(parts, fmt.Sprintf("%s=%s", query.GetType(), query.GetValue()))
+	}
+
+	// Region preferences change the order, and thus the pages, of results
+	for _, region := range req.GetPreferredRegions() {
+		parts = append(parts, "region="+region)
+	}
+
+	return strings.Join(parts, "\n")
+}
+
 // buildQueriesFromFlags builds API queries.
 func buildQueriesFromFlags() []*searchv1.RecordQuery {
 	queries := make([]*searchv1.RecordQuery, 0,
@@ -215,5 +511,29 @@ func buildQueriesFromFlags() []*searchv1.RecordQuery {
 		})
 	}
 
+	// Add annotation queries
+	for _, annotation := range opts.Annotations {
+		queries = append(queries, &searchv1.RecordQuery{
+			Type:  searchv1.RecordQueryType_RECORD_QUERY_TYPE_ANNOTATION,
+			Value: annotation,
+		})
+	}
+
+	// Add license queries
+	for _, license := range opts.Licenses {
+		queries = append(queries, &searchv1.RecordQuery{
+			Type:  searchv1.RecordQueryType_RECORD_QUERY_TYPE_LICENSE,
+			Value: license,
+		})
+	}
+
+	// Add signer queries
+	for _, signer := range opts.Signers {
+		queries = append(queries, &searchv1.RecordQuery{
+			Type:  searchv1.RecordQueryType_RECORD_QUERY_TYPE_SIGNER,
+			Value: signer,
+		})
+	}
+
 	return queries
 }
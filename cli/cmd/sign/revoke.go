@@ -0,0 +1,142 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package sign
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/agntcy/dir/utils/cosign"
+	"github.com/spf13/cobra"
+)
+
+var revokeCmd = &cobra.Command{
+	Use:   "revoke <record-cid>",
+	Short: "Revoke signatures of a record",
+	Long: `This command revokes previously valid signatures of a record.
+
+Revocations are stored alongside the record and synced with it,
+so that verification reports the record as revoked once all its
+signatures have been revoked.
+
+A signer can revoke the signatures created with its own key using --key.
+Otherwise, the given signature or all signatures of the record are revoked,
+which requires access to the server revocation API.
+
+Usage examples:
+
+1. Revoke the signatures created with a key:
+
+	dirctl sign revoke <record-cid> --key <key-file> --reason "key compromised"
+
+2. Revoke a specific signature:
+
+	dirctl sign revoke <record-cid> --signature <base64-signature>
+
+3. Revoke all signatures of a record:
+
+	dirctl sign revoke <record-cid>
+
+4. Output formats:
+
+	# Get revocations as JSON
+	dirctl sign revoke <record-cid> --output json
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRevokeCommand(cmd, args[0])
+	},
+}
+
+// Revoke command options.
+var revokeOpts struct {
+	Key       string
+	Signature string
+	Reason    string
+}
+
+func init() {
+	flags := revokeCmd.Flags()
+	flags.StringVar(&revokeOpts.Key, "key", "",
+		"Path to the private key file used for signing. Only the signatures created with this key are revoked.")
+	flags.StringVar(&revokeOpts.Signature, "signature", "",
+		"Base64-encoded signature to revoke")
+	flags.StringVar(&revokeOpts.Reason, "reason", "",
+		"Reason for the revocation")
+
+	revokeCmd.MarkFlagsMutuallyExclusive("key", "signature")
+
+	// Add output format flags
+	presenter.AddOutputFlags(revokeCmd)
+
+	Command.AddCommand(revokeCmd)
+}
+
+func runRevokeCommand(cmd *cobra.Command, recordCID string) error {
+	// Get the client from the context
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	var (
+		resp *signv1.RevokeResponse
+		err  error
+	)
+
+	if revokeOpts.Key != "" {
+		// Load the key from file
+		rawKey, err := os.ReadFile(filepath.Clean(revokeOpts.Key))
+		if err != nil {
+			return fmt.Errorf("failed to read key file: %w", err)
+		}
+
+		// Read password from environment variable
+		pw, err := cosign.ReadPrivateKeyPassword()()
+		if err != nil {
+			return fmt.Errorf("failed to read password: %w", err)
+		}
+
+		resp, err = c.RevokeWithKey(cmd.Context(), recordCID, rawKey, pw, revokeOpts.Reason)
+		if err != nil {
+			return fmt.Errorf("failed to revoke signatures: %w", err)
+		}
+	} else {
+		req := &signv1.RevokeRequest{
+			RecordRef: &corev1.RecordRef{Cid: recordCID},
+			Reason:    revokeOpts.Reason,
+		}
+
+		if revokeOpts.Signature != "" {
+			req.Signature = &revokeOpts.Signature
+		}
+
+		resp, err = c.Revoke(cmd.Context(), req)
+		if err != nil {
+			return fmt.Errorf("failed to revoke signatures: %w", err)
+		}
+	}
+
+	// Output in the appropriate format
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "revocations", "Revoked signatures", resp.GetRevocations())
+	}
+
+	if len(resp.GetRevocations()) == 0 {
+		presenter.Printf(cmd, "No signatures revoked, all matching signatures were already revoked\n")
+
+		return nil
+	}
+
+	presenter.Printf(cmd, "Revoked %d signature(s) of record %s\n", len(resp.GetRevocations()), recordCID)
+
+	return nil
+}
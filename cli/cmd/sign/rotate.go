@@ -0,0 +1,176 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package sign
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/agntcy/dir/cli/cmd/ops"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/agntcy/dir/client"
+	"github.com/spf13/cobra"
+)
+
+var rotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Re-sign the records of a signer with a new key or identity",
+	Long: `This command re-signs all records signed by the given signer identities
+with a new key or OIDC identity, for example after rotating a signing key.
+Only the admins of the server can re-sign records.
+
+Records are re-signed by the server as a long-running operation, and a
+RECORD_SIGNED event is emitted for every record signed. The command waits
+for the operation to finish, unless --async is set; track it with
+"dirctl ops wait" and stop it with "dirctl ops cancel". Records already
+signed with the new key are skipped, so an interrupted rotation can be
+resumed by running the command again.
+
+The previous signatures are kept. Once the records are re-signed,
+revoke them with "dirctl sign revoke --key <old-key-file>".
+
+Usage examples:
+
+1. Re-sign the records signed by a rotated key with the new key:
+
+	dirctl sign rotate --signer sha256:<old-key-fingerprint> --key <new-key-file>
+
+2. Re-sign the records of an identity with OIDC:
+
+	dirctl sign rotate --signer spiffe://acme/ci --oidc-token <token>
+
+3. Stop at the first failure and emit progress events on stderr:
+
+	dirctl sign rotate --signer <identity> --key <new-key-file> --fail-fast --progress jsonl
+
+4. Start the rotation in the background and wait for it later:
+
+	dirctl sign rotate --signer <identity> --key <new-key-file> --async
+	dirctl ops wait <operation-id>
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runRotateCommand(cmd)
+	},
+}
+
+// Rotate command options.
+var rotateOpts struct {
+	Signers  []string
+	FailFast bool
+	Async    bool
+}
+
+func init() {
+	flags := rotateCmd.Flags()
+	flags.StringArrayVar(&rotateOpts.Signers, "signer", nil,
+		"Signer identity whose records are re-signed, e.g. 'spiffe://acme/ci' or 'sha256:<key-fingerprint>' (can be repeated)")
+	flags.BoolVar(&rotateOpts.FailFast, "fail-fast", false, "Stop at the first record that fails to be re-signed")
+	flags.BoolVar(&rotateOpts.Async, "async", false, "Run in the background on the server and print the operation ID (see 'dirctl ops')")
+
+	_ = rotateCmd.MarkFlagRequired("signer")
+
+	AddSigningFlags(flags)
+
+	// Add output format flags
+	presenter.AddOutputFlags(rotateCmd)
+
+	// Add progress events
+	presenter.AddProgressFlags(rotateCmd)
+
+	Command.AddCommand(rotateCmd)
+}
+
+func runRotateCommand(cmd *cobra.Command) error {
+	// Get the client from the context
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	provider, err := signingProvider()
+	if err != nil {
+		return err
+	}
+
+	progress, err := presenter.NewProgress(cmd)
+	if err != nil {
+		return err
+	}
+
+	req := &signv1.ResignRequest{
+		Provider: provider,
+		FailFast: rotateOpts.FailFast,
+	}
+
+	for _, signer := range rotateOpts.Signers {
+		req.Queries = append(req.Queries, &searchv1.RecordQuery{
+			Type:  searchv1.RecordQueryType_RECORD_QUERY_TYPE_SIGNER,
+			Value: signer,
+		})
+	}
+
+	resp, err := c.Resign(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to re-sign records: %w", err)
+	}
+
+	if rotateOpts.Async {
+		return presenter.PrintMessage(cmd, "operation", "Re-signing started with operation ID", resp.GetOperationId())
+	}
+
+	resp, err = waitResign(cmd, c, resp.GetOperationId(), progress)
+	if err != nil {
+		return err
+	}
+
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		if err := presenter.PrintMessage(cmd, "records", "Records re-signed", resp); err != nil {
+			return err
+		}
+	} else {
+		displayResignResult(cmd, resp)
+	}
+
+	if len(resp.GetFailedCids()) > 0 {
+		return fmt.Errorf("failed to re-sign %d records", len(resp.GetFailedCids()))
+	}
+
+	return nil
+}
+
+// waitResign waits for a re-signing operation while reporting its progress, and returns its result.
+func waitResign(cmd *cobra.Command, c *client.Client, id string, progress *presenter.Progress) (*signv1.ResignResponse, error) {
+	op, err := ops.Wait(cmd.Context(), c, id, progress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for re-signing operation %s: %w", id, err)
+	}
+
+	if op.GetState() != corev1.OperationState_OPERATION_STATE_SUCCEEDED {
+		return nil, fmt.Errorf("failed to re-sign records: %s", op.GetErrorMessage())
+	}
+
+	resp := &signv1.ResignResponse{}
+	if err := op.GetResponse().UnmarshalTo(resp); err != nil {
+		return nil, fmt.Errorf("failed to decode re-signing result: %w", err)
+	}
+
+	return resp, nil
+}
+
+// displayResignResult displays the re-signing result in human-readable format.
+func displayResignResult(cmd *cobra.Command, resp *signv1.ResignResponse) {
+	presenter.Printf(cmd, "Signed: %d\n", resp.GetSignedCount())
+	presenter.Printf(cmd, "Already signed with the key: %d\n", resp.GetSkippedCount())
+	presenter.Printf(cmd, "Failed: %d\n", len(resp.GetFailedCids()))
+
+	for _, cid := range resp.GetFailedCids() {
+		presenter.Printf(cmd, "  %s\n", cid)
+	}
+}
@@ -83,90 +83,72 @@ func runCommand(cmd *cobra.Command, recordCID string) error {
 }
 
 func Sign(ctx context.Context, c *client.Client, recordCID string) error {
-	switch {
-	case opts.Key != "":
+	provider, err := signingProvider()
+	if err != nil {
+		return err
+	}
+
+	req := &signv1.SignRequest{
+		RecordRef: &corev1.RecordRef{Cid: recordCID},
+		Provider:  provider,
+	}
+
+	// Sign the record using the key or the OIDC provider
+	if _, err := c.Sign(ctx, req); err != nil {
+		return fmt.Errorf("failed to sign record: %w", err)
+	}
+
+	return nil
+}
+
+// signingProvider returns the signature provider selected by the signing flags.
+// Without a key or OIDC token, the token is retrieved from the OIDC provider interactively.
+func signingProvider() (*signv1.SignRequestProvider, error) {
+	if opts.Key != "" {
 		// Load the key from file
 		rawKey, err := os.ReadFile(filepath.Clean(opts.Key))
 		if err != nil {
-			return fmt.Errorf("failed to read key file: %w", err)
+			return nil, fmt.Errorf("failed to read key file: %w", err)
 		}
 
 		// Read password from environment variable
 		pw, err := cosign.ReadPrivateKeyPassword()()
 		if err != nil {
-			return fmt.Errorf("failed to read password: %w", err)
-		}
-
-		req := &signv1.SignRequest{
-			RecordRef: &corev1.RecordRef{Cid: recordCID},
-			Provider: &signv1.SignRequestProvider{
-				Request: &signv1.SignRequestProvider_Key{
-					Key: &signv1.SignWithKey{
-						PrivateKey: rawKey,
-						Password:   pw,
-					},
-				},
-			},
+			return nil, fmt.Errorf("failed to read password: %w", err)
 		}
 
-		// Sign the record using the provided key
-		_, err = c.SignWithKey(ctx, req)
-		if err != nil {
-			return fmt.Errorf("failed to sign record with key: %w", err)
-		}
-	case opts.OIDCToken != "":
-		req := &signv1.SignRequest{
-			RecordRef: &corev1.RecordRef{Cid: recordCID},
-			Provider: &signv1.SignRequestProvider{
-				Request: &signv1.SignRequestProvider_Oidc{
-					Oidc: &signv1.SignWithOIDC{
-						IdToken: opts.OIDCToken,
-						Options: &signv1.SignWithOIDC_SignOpts{
-							FulcioUrl:       &opts.FulcioURL,
-							RekorUrl:        &opts.RekorURL,
-							TimestampUrl:    &opts.TimestampURL,
-							OidcProviderUrl: &opts.OIDCProviderURL,
-						},
-					},
+		return &signv1.SignRequestProvider{
+			Request: &signv1.SignRequestProvider_Key{
+				Key: &signv1.SignWithKey{
+					PrivateKey: rawKey,
+					Password:   pw,
 				},
 			},
-		}
+		}, nil
+	}
 
-		// Sign the record using the OIDC provider
-		_, err := c.SignWithOIDC(ctx, req)
-		if err != nil {
-			return fmt.Errorf("failed to sign record: %w", err)
-		}
-	default:
+	idToken := opts.OIDCToken
+	if idToken == "" {
 		// Retrieve the token from the OIDC provider
 		token, err := oauthflow.OIDConnect(opts.OIDCProviderURL, opts.OIDCClientID, "", "", oauthflow.DefaultIDTokenGetter)
 		if err != nil {
-			return fmt.Errorf("failed to get OIDC token: %w", err)
+			return nil, fmt.Errorf("failed to get OIDC token: %w", err)
 		}
 
-		req := &signv1.SignRequest{
-			RecordRef: &corev1.RecordRef{Cid: recordCID},
-			Provider: &signv1.SignRequestProvider{
-				Request: &signv1.SignRequestProvider_Oidc{
-					Oidc: &signv1.SignWithOIDC{
-						IdToken: token.RawString,
-						Options: &signv1.SignWithOIDC_SignOpts{
-							FulcioUrl:       &opts.FulcioURL,
-							RekorUrl:        &opts.RekorURL,
-							TimestampUrl:    &opts.TimestampURL,
-							OidcProviderUrl: &opts.OIDCProviderURL,
-						},
-					},
-				},
-			},
-		}
-
-		// Sign the record using the OIDC provider
-		_, err = c.SignWithOIDC(ctx, req)
-		if err != nil {
-			return fmt.Errorf("failed to sign record: %w", err)
-		}
+		idToken = token.RawString
 	}
 
-	return nil
+	return &signv1.SignRequestProvider{
+		Request: &signv1.SignRequestProvider_Oidc{
+			Oidc: &signv1.SignWithOIDC{
+				IdToken: idToken,
+				Options: &signv1.SignWithOIDC_SignOpts{
+					FulcioUrl:       &opts.FulcioURL,
+					RekorUrl:        &opts.RekorURL,
+					TimestampUrl:    &opts.TimestampURL,
+					OidcProviderUrl: &opts.OIDCProviderURL,
+				},
+			},
+		},
+	}, nil
 }
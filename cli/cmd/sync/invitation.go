@@ -0,0 +1,122 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package sync
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+// Invite subcommand.
+var inviteCmd = &cobra.Command{
+	Use:   "invite",
+	Short: "Invite a remote Directory to sync from this Directory",
+	Long: `Invite issues a signed invitation token that allows a remote Directory node
+to synchronize records from this Directory node.
+
+The token encodes the address of this node and the records to synchronize.
+Share it with the operator of the remote node, who accepts it with
+'dirctl sync accept <token>'. The remote node presents the token back when
+it requests registry credentials, so no URLs or passwords need to be exchanged.
+
+Invitations must be enabled on the server (DIRECTORY_SERVER_SYNC_INVITATIONS_SECRET
+and DIRECTORY_SERVER_SYNC_INVITATIONS_DIRECTORY_URL).
+
+Usage examples:
+
+1. Invite a remote node to sync all records:
+  dirctl sync invite
+
+2. Invite a remote node to sync specific records, valid for one hour:
+  dirctl sync invite --cids cid1,cid2 --ttl 1h
+
+3. Get the raw token for scripting:
+  TOKEN=$(dirctl sync invite --output raw)`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runInvite(cmd)
+	},
+}
+
+// Accept subcommand.
+var acceptCmd = &cobra.Command{
+	Use:   "accept <token>",
+	Short: "Accept an invitation to sync from a remote Directory",
+	Long: `Accept creates a synchronization from the remote Directory node that issued
+the invitation token, for the records encoded in the token.
+
+Usage examples:
+
+1. Accept an invitation:
+  dirctl sync accept <token>
+
+2. Accept an invitation, mirroring records under "mirrors/acme/":
+  dirctl sync accept <token> --map '*=mirrors/acme/*'
+
+3. Output formats:
+  # Get the created sync as JSON
+  dirctl sync accept <token> --output json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runAccept(cmd, args[0])
+	},
+}
+
+func runInvite(cmd *cobra.Command) error {
+	client, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	resp, err := client.CreateSyncInvitation(cmd.Context(), opts.CIDs, opts.TTL)
+	if err != nil {
+		return fmt.Errorf("failed to create sync invitation: %w", err)
+	}
+
+	switch presenter.GetOutputOptions(cmd).Format {
+	case presenter.FormatRaw:
+		return presenter.PrintMessage(cmd, "invitation", "Invitation token", resp.GetToken())
+	case presenter.FormatHuman:
+		presenter.Printf(cmd, "Invitation token (expires %s):\n%s\n", resp.GetExpiresTime(), resp.GetToken())
+
+		return nil
+	default:
+		return presenter.PrintMessage(cmd, "invitation", "Invitation", resp)
+	}
+}
+
+func runAccept(cmd *cobra.Command, token string) error {
+	client, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	mappings, err := parseNamespaceMappings(opts.Mappings)
+	if err != nil {
+		return err
+	}
+
+	trustedKeys, err := readTrustedKeys(opts.TrustedKeys)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.AcceptTrustedSyncInvitation(cmd.Context(), token, trustedKeys, mappings...)
+	if err != nil {
+		return fmt.Errorf("failed to accept sync invitation: %w", err)
+	}
+
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "sync", "Sync created with ID", resp.GetSyncId())
+	}
+
+	presenter.Printf(cmd, "Sync created with ID %s from %s\n", resp.GetSyncId(), resp.GetRemoteDirectoryUrl())
+
+	return nil
+}
@@ -3,7 +3,11 @@
 
 package sync
 
-import "github.com/agntcy/dir/cli/presenter"
+import (
+	"time"
+
+	"github.com/agntcy/dir/cli/presenter"
+)
 
 var opts = &options{}
 
@@ -12,6 +16,23 @@ type options struct {
 	Offset uint32
 	CIDs   []string
 	Stdin  bool
+
+	// Create and accept command options
+	Mappings    []string
+	TrustedKeys []string
+
+	// Warm command options
+	SyncID      string
+	Async       bool
+	Names       []string
+	Versions    []string
+	SkillNames  []string
+	Locators    []string
+	Modules     []string
+	DomainNames []string
+
+	// Invite command options
+	TTL time.Duration
 }
 
 //nolint:mnd
@@ -25,10 +46,47 @@ func init() {
 	createFlags := createCmd.Flags()
 	createFlags.StringSliceVar(&opts.CIDs, "cids", []string{}, "List of CIDs to synchronize from the remote Directory. If empty, all objects will be synchronized.")
 	createFlags.BoolVar(&opts.Stdin, "stdin", false, "Parse routing search output from stdin to create sync operations for each provider")
+	createFlags.StringArrayVar(&opts.Mappings, "map", nil, "Rename mirrored records matching a remote name pattern into a local namespace, as <remote>=<local> (e.g. 'acme/*=mirrors/acme/*', can be repeated, first match wins)")
+	createFlags.StringArrayVar(&opts.TrustedKeys, "trusted-key", nil, "Path to a PEM public key of a trust root; mirrored records not signed by a trusted key are quarantined (can be repeated)")
+
+	// Add flags for warm command
+	warmFlags := warmCmd.Flags()
+	warmFlags.StringVar(&opts.SyncID, "sync-id", "", "ID of an existing sync whose remote Directory is used as the source")
+	warmFlags.BoolVar(&opts.Async, "async", false, "Run in the background on the server and print the operation ID (see 'dirctl ops')")
+	warmFlags.StringSliceVar(&opts.CIDs, "cids", []string{}, "List of CIDs to prefetch from the remote Directory")
+	warmFlags.StringArrayVar(&opts.Names, "name", nil, "Prefetch records with specific name (can be repeated)")
+	warmFlags.StringArrayVar(&opts.Versions, "version", nil, "Prefetch records with specific version (can be repeated)")
+	warmFlags.StringArrayVar(&opts.SkillNames, "skill", nil, "Prefetch records with specific skill name (can be repeated)")
+	warmFlags.StringArrayVar(&opts.Locators, "locator", nil, "Prefetch records with specific locator type (can be repeated)")
+	warmFlags.StringArrayVar(&opts.Modules, "module", nil, "Prefetch records with specific module (can be repeated)")
+	warmFlags.StringArrayVar(&opts.DomainNames, "domain", nil, "Prefetch records with specific domain name (can be repeated)")
+
+	// Add flags for invite command
+	inviteFlags := inviteCmd.Flags()
+	inviteFlags.StringSliceVar(&opts.CIDs, "cids", []string{}, "List of CIDs the remote Directory is invited to synchronize. If empty, all objects will be synchronized.")
+	inviteFlags.DurationVar(&opts.TTL, "ttl", 0, "Validity period of the invitation (default: server configured validity)")
+
+	// Add flags for accept command
+	acceptFlags := acceptCmd.Flags()
+	acceptFlags.StringArrayVar(&opts.Mappings, "map", nil, "Rename mirrored records matching a remote name pattern into a local namespace, as <remote>=<local> (can be repeated, first match wins)")
+	acceptFlags.StringArrayVar(&opts.TrustedKeys, "trusted-key", nil, "Path to a PEM public key of a trust root; mirrored records not signed by a trusted key are quarantined (can be repeated)")
+
+	// Add flags for quarantine list command
+	quarantineListFlags := quarantineListCmd.Flags()
+	quarantineListFlags.StringVar(&opts.SyncID, "sync-id", "", "Only list the records quarantined by this sync")
+	quarantineListFlags.Uint32Var(&opts.Limit, "limit", 100, "Maximum number of quarantined records to return (default: 100)")
+	quarantineListFlags.Uint32Var(&opts.Offset, "offset", 0, "Number of quarantined records to skip (for pagination)")
 
 	// Add output format flags to all sync subcommands
 	presenter.AddOutputFlags(createCmd)
 	presenter.AddOutputFlags(listCmd)
 	presenter.AddOutputFlags(statusCmd)
 	presenter.AddOutputFlags(deleteCmd)
+	presenter.AddOutputFlags(warmCmd)
+	presenter.AddOutputFlags(inviteCmd)
+	presenter.AddOutputFlags(acceptCmd)
+	presenter.AddOutputFlags(quarantineListCmd)
+	presenter.AddOutputFlags(quarantineReleaseCmd)
+
+	presenter.AddProgressFlags(warmCmd)
 }
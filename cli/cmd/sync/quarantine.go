@@ -0,0 +1,135 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+// Quarantine subcommand.
+var quarantineCmd = &cobra.Command{
+	Use:   "quarantine",
+	Short: "Review records quarantined by sync trust policies",
+	Long: `Quarantine manages the records imported by syncs created with --trusted-key
+that are not signed by any of the trusted keys.
+
+Quarantined records are stored but not indexed, so they are hidden from search
+until they are reviewed and released.`,
+}
+
+// Quarantine list subcommand.
+var quarantineListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List quarantined records",
+	Long: `List displays the records quarantined by sync trust policies that are not released,
+with the sync that imported them and the reason they failed verification.
+
+Usage examples:
+
+1. List all quarantined records:
+  dirctl sync quarantine list
+
+2. List the records quarantined by a sync:
+  dirctl sync quarantine list --sync-id <sync-id>
+
+3. Output formats:
+  # Get quarantined records as JSON
+  dirctl sync quarantine list --output json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runListQuarantine(cmd)
+	},
+}
+
+// Quarantine release subcommand.
+var quarantineReleaseCmd = &cobra.Command{
+	Use:   "release <cid>",
+	Short: "Release a quarantined record",
+	Long: `Release indexes a quarantined record after review, making it visible in search.
+The record is renamed with the namespace mappings of its sync, if any.
+
+Usage examples:
+
+1. Release a quarantined record:
+  dirctl sync quarantine release <cid>`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runReleaseQuarantine(cmd, args[0])
+	},
+}
+
+func init() {
+	quarantineCmd.AddCommand(quarantineListCmd)
+	quarantineCmd.AddCommand(quarantineReleaseCmd)
+}
+
+func runListQuarantine(cmd *cobra.Command) error {
+	client, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	itemCh, err := client.ListQuarantinedRecords(cmd.Context(), &storev1.ListQuarantinedRecordsRequest{
+		SyncId: opts.SyncID,
+		Limit:  &opts.Limit,
+		Offset: &opts.Offset,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list quarantined records: %w", err)
+	}
+
+	var results []interface{}
+
+	for {
+		select {
+		case record, ok := <-itemCh:
+			if !ok {
+				return presenter.PrintMessage(cmd, "quarantined records", "Quarantined records", results)
+			}
+
+			results = append(results, record)
+		case <-cmd.Context().Done():
+			return fmt.Errorf("context cancelled while listing quarantined records: %w", cmd.Context().Err())
+		}
+	}
+}
+
+func runReleaseQuarantine(cmd *cobra.Command, cid string) error {
+	client, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	indexedCID, err := client.ReleaseQuarantinedRecord(cmd.Context(), cid)
+	if err != nil {
+		return fmt.Errorf("failed to release quarantined record: %w", err)
+	}
+
+	return presenter.PrintMessage(cmd, "record", "Record released with CID", indexedCID)
+}
+
+// readTrustedKeys reads the PEM public keys of the trust roots of a sync from files.
+func readTrustedKeys(paths []string) ([]string, error) {
+	keys := make([]string, 0, len(paths))
+
+	for _, path := range paths {
+		key, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read trusted key: %w", err)
+		}
+
+		keys = append(keys, string(key))
+	}
+
+	return keys, nil
+}
@@ -9,11 +9,16 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
 	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/cli/cmd/ops"
 	"github.com/agntcy/dir/cli/presenter"
 	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/agntcy/dir/client"
 	"github.com/spf13/cobra"
 )
 
@@ -21,7 +26,8 @@ var Command = &cobra.Command{
 	Use:   "sync",
 	Short: "Manage synchronization operations with remote Directory nodes",
 	Long: `Sync command allows you to manage synchronization operations between Directory nodes.
-It provides subcommands to create, list, monitor, and delete sync operations.`,
+It provides subcommands to create, list, monitor, and delete sync operations,
+and to invite remote Directory nodes to sync from this node.`,
 }
 
 // Create sync subcommand.
@@ -43,7 +49,13 @@ Usage examples:
   dir sync create http://localhost:8080 --cids cid1,cid2,cid3
 
 3. Create sync from routing search output:
-  dirctl routing search --skill "AI" --output json | dirctl sync create --stdin`,
+  dirctl routing search --skill "AI" --output json | dirctl sync create --stdin
+
+4. Mirror records of the "acme/" namespace under "mirrors/acme/":
+  dirctl sync create https://directory.example.com --map 'acme/*=mirrors/acme/*'
+
+5. Quarantine mirrored records that are not signed by a trusted key:
+  dirctl sync create https://directory.example.com --trusted-key acme.pub`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if opts.Stdin {
 			return cobra.MaximumNArgs(0)(cmd, args)
@@ -137,12 +149,63 @@ Usage examples:
 	},
 }
 
+// Warm cache subcommand.
+var warmCmd = &cobra.Command{
+	Use:   "warm [<remote-directory-url>]",
+	Short: "Prefetch records from a remote Directory into the local store",
+	Long: `Warm prefetches records from a remote Directory node into the local store,
+so that edge replicas can serve them without fetching on demand.
+
+The source is either given as a remote Directory URL or as the ID of an existing
+sync via --sync-id. Records are selected by CIDs and/or by search filters that are
+evaluated on the remote Directory. If no selection is given, the CIDs tracked by
+the sync are used, or all records of the remote Directory otherwise.
+
+Usage examples:
+
+1. Prefetch specific records:
+  dirctl sync warm http://localhost:8080 --cids cid1,cid2,cid3
+
+2. Prefetch records matching a filter from an existing sync source:
+  dirctl sync warm --sync-id <sync-id> --skill "Natural Language Processing"
+
+3. Prefetch in the background and wait for completion later:
+  dirctl sync warm --sync-id <sync-id> --async
+  dirctl ops wait <operation-id>
+
+4. Emit machine-readable progress events on stderr:
+  dirctl sync warm --sync-id <sync-id> --progress jsonl
+
+5. Output formats:
+  # Get warm results as JSON
+  dirctl sync warm --sync-id <sync-id> --output json`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if opts.SyncID != "" {
+			return cobra.NoArgs(cmd, args)
+		}
+
+		return cobra.ExactArgs(1)(cmd, args)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var remoteURL string
+		if len(args) > 0 {
+			remoteURL = args[0]
+		}
+
+		return runWarmCache(cmd, remoteURL)
+	},
+}
+
 func init() {
 	// Add subcommands
 	Command.AddCommand(createCmd)
 	Command.AddCommand(listCmd)
 	Command.AddCommand(statusCmd)
 	Command.AddCommand(deleteCmd)
+	Command.AddCommand(warmCmd)
+	Command.AddCommand(inviteCmd)
+	Command.AddCommand(acceptCmd)
+	Command.AddCommand(quarantineCmd)
 }
 
 func runCreateSync(cmd *cobra.Command, remoteURL string, cids []string) error {
@@ -156,7 +219,17 @@ func runCreateSync(cmd *cobra.Command, remoteURL string, cids []string) error {
 		return errors.New("failed to get client from context")
 	}
 
-	syncID, err := client.CreateSync(cmd.Context(), remoteURL, cids)
+	mappings, err := parseNamespaceMappings(opts.Mappings)
+	if err != nil {
+		return err
+	}
+
+	trustedKeys, err := readTrustedKeys(opts.TrustedKeys)
+	if err != nil {
+		return err
+	}
+
+	syncID, err := client.CreateTrustedSync(cmd.Context(), remoteURL, cids, trustedKeys, mappings...)
 	if err != nil {
 		return fmt.Errorf("failed to create sync: %w", err)
 	}
@@ -165,6 +238,22 @@ func runCreateSync(cmd *cobra.Command, remoteURL string, cids []string) error {
 	return presenter.PrintMessage(cmd, "sync", "Sync created with ID", syncID)
 }
 
+// parseNamespaceMappings parses namespace mappings given as remote=local patterns.
+func parseNamespaceMappings(values []string) ([]*storev1.NamespaceMapping, error) {
+	mappings := make([]*storev1.NamespaceMapping, 0, len(values))
+
+	for _, value := range values {
+		remote, local, ok := strings.Cut(value, "=")
+		if !ok || remote == "" || local == "" {
+			return nil, fmt.Errorf("invalid namespace mapping %q: must be formatted as <remote>=<local>", value)
+		}
+
+		mappings = append(mappings, &storev1.NamespaceMapping{Remote: remote, Local: local})
+	}
+
+	return mappings, nil
+}
+
 func runListSyncs(cmd *cobra.Command) error {
 	client, ok := ctxUtils.GetClientFromContext(cmd.Context())
 	if !ok {
@@ -239,6 +328,107 @@ func runDeleteSync(cmd *cobra.Command, syncID string) error {
 	return presenter.PrintMessage(cmd, "sync", "Sync deleted with ID", syncID)
 }
 
+func runWarmCache(cmd *cobra.Command, remoteURL string) error {
+	client, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	progress, err := presenter.NewProgress(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Progress is only known to the server, so track it through an operation
+	resp, err := client.WarmCache(cmd.Context(), &storev1.WarmCacheRequest{
+		SyncId:             opts.SyncID,
+		RemoteDirectoryUrl: remoteURL,
+		Cids:               opts.CIDs,
+		Queries:            buildWarmQueriesFromFlags(),
+		Async:              opts.Async || progress != nil,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to warm cache: %w", err)
+	}
+
+	if opts.Async {
+		return presenter.PrintMessage(cmd, "operation", "Cache warming started with operation ID", resp.GetOperationId())
+	}
+
+	if progress != nil {
+		resp, err = waitWarmCache(cmd, client, resp.GetOperationId(), progress)
+		if err != nil {
+			return err
+		}
+	}
+
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "cache", "Cache warmed", resp)
+	}
+
+	displayWarmCacheResult(cmd, resp)
+
+	return nil
+}
+
+// waitWarmCache waits for a cache warming operation while reporting its progress, and returns its result.
+func waitWarmCache(cmd *cobra.Command, c *client.Client, id string, progress *presenter.Progress) (*storev1.WarmCacheResponse, error) {
+	op, err := ops.Wait(cmd.Context(), c, id, progress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for cache warming operation %s: %w", id, err)
+	}
+
+	if op.GetState() != corev1.OperationState_OPERATION_STATE_SUCCEEDED {
+		return nil, fmt.Errorf("failed to warm cache: %s", op.GetErrorMessage())
+	}
+
+	resp := &storev1.WarmCacheResponse{}
+	if err := op.GetResponse().UnmarshalTo(resp); err != nil {
+		return nil, fmt.Errorf("failed to decode cache warming result: %w", err)
+	}
+
+	return resp, nil
+}
+
+// displayWarmCacheResult displays the cache warming result in human-readable format.
+func displayWarmCacheResult(cmd *cobra.Command, resp *storev1.WarmCacheResponse) {
+	presenter.Printf(cmd, "Fetched: %d\n", resp.GetFetchedCount())
+	presenter.Printf(cmd, "Already cached: %d\n", resp.GetCachedCount())
+	presenter.Printf(cmd, "Failed: %d\n", len(resp.GetFailedCids()))
+
+	for _, cid := range resp.GetFailedCids() {
+		presenter.Printf(cmd, "  %s\n", cid)
+	}
+}
+
+// buildWarmQueriesFromFlags builds search queries used to select records to prefetch.
+func buildWarmQueriesFromFlags() []*searchv1.RecordQuery {
+	filters := []struct {
+		queryType searchv1.RecordQueryType
+		values    []string
+	}{
+		{searchv1.RecordQueryType_RECORD_QUERY_TYPE_NAME, opts.Names},
+		{searchv1.RecordQueryType_RECORD_QUERY_TYPE_VERSION, opts.Versions},
+		{searchv1.RecordQueryType_RECORD_QUERY_TYPE_SKILL_NAME, opts.SkillNames},
+		{searchv1.RecordQueryType_RECORD_QUERY_TYPE_LOCATOR, opts.Locators},
+		{searchv1.RecordQueryType_RECORD_QUERY_TYPE_MODULE, opts.Modules},
+		{searchv1.RecordQueryType_RECORD_QUERY_TYPE_DOMAIN_NAME, opts.DomainNames},
+	}
+
+	var queries []*searchv1.RecordQuery
+
+	for _, filter := range filters {
+		for _, value := range filter.values {
+			queries = append(queries, &searchv1.RecordQuery{
+				Type:  filter.queryType,
+				Value: value,
+			})
+		}
+	}
+
+	return queries
+}
+
 func runCreateSyncFromStdin(cmd *cobra.Command) error {
 	// Parse the search output from stdin
 	results, err := parseSearchOutput(cmd.InOrStdin())
@@ -266,13 +456,29 @@ func parseSearchOutput(input io.Reader) ([]*routingv1.SearchResponse, error) {
 		return nil, fmt.Errorf("error reading input: %w", err)
 	}
 
-	var searchResponses []*routingv1.SearchResponse
+	// Results of "routing search --verify" carry the verification of their record
+	var searchResults []struct {
+		*routingv1.SearchResponse
 
-	err = json.Unmarshal(inputBytes, &searchResponses)
+		Verification *client.RecordVerification `json:"verification"`
+	}
+
+	err = json.Unmarshal(inputBytes, &searchResults)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse JSON: %w", err)
 	}
 
+	searchResponses := make([]*routingv1.SearchResponse, 0, len(searchResults))
+
+	for _, result := range searchResults {
+		// Skip results whose record could not be verified
+		if result.Verification != nil && !result.Verification.Verified() {
+			continue
+		}
+
+		searchResponses = append(searchResponses, result.SearchResponse)
+	}
+
 	return searchResponses, nil
 }
 
@@ -319,6 +525,16 @@ func createSyncOperations(cmd *cobra.Command, peerResults map[string]PeerSyncInf
 		return errors.New("failed to get client from context")
 	}
 
+	mappings, err := parseNamespaceMappings(opts.Mappings)
+	if err != nil {
+		return err
+	}
+
+	trustedKeys, err := readTrustedKeys(opts.TrustedKeys)
+	if err != nil {
+		return err
+	}
+
 	totalSyncs := 0
 	totalCIDs := 0
 
@@ -333,7 +549,7 @@ func createSyncOperations(cmd *cobra.Command, peerResults map[string]PeerSyncInf
 		}
 
 		// Create sync operation
-		syncID, err := client.CreateSync(cmd.Context(), syncInfo.APIAddress, syncInfo.CIDs)
+		syncID, err := client.CreateTrustedSync(cmd.Context(), syncInfo.APIAddress, syncInfo.CIDs, trustedKeys, mappings...)
 		if err != nil {
 			presenter.PrintSmartf(cmd, "ERROR: Failed to create sync for peer %s: %v\n", apiAddress, err)
 
@@ -5,11 +5,14 @@ package sync
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -139,6 +142,22 @@ func TestParseSearchOutput_ComplexJSON(t *testing.T) {
 	assert.Len(t, result[0].GetPeer().GetAddrs(), 2)
 }
 
+// TestParseSearchOutput_Verified tests that unverified results of routing search --verify are skipped.
+func TestParseSearchOutput_Verified(t *testing.T) {
+	input := `[
+		{"record_ref": {"cid": "bafyverified"}, "verification": {"cid_verified": true, "signature_verified": true}},
+		{"record_ref": {"cid": "bafyunsigned"}, "verification": {"cid_verified": true, "error": "no signature"}},
+		{"record_ref": {"cid": "bafyunchecked"}}
+	]`
+
+	result, err := parseSearchOutput(strings.NewReader(input))
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "bafyverified", result[0].GetRecordRef().GetCid())
+	assert.Equal(t, "bafyunchecked", result[1].GetRecordRef().GetCid())
+}
+
 // TestGroupResultsByAPIAddress_EmptyInput tests grouping empty results.
 func TestGroupResultsByAPIAddress_EmptyInput(t *testing.T) {
 	result := groupResultsByAPIAddress(nil)
@@ -542,6 +561,78 @@ func TestCreateCmd_ArgsValidation(t *testing.T) {
 	}
 }
 
+// TestWarmCmd_ArgsValidation tests argument validation for warm command.
+func TestWarmCmd_ArgsValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		syncID      string
+		expectError bool
+	}{
+		{
+			name:        "remote URL without sync ID",
+			args:        []string{"http://example.com"},
+			expectError: false,
+		},
+		{
+			name:        "sync ID without remote URL",
+			args:        []string{},
+			syncID:      "sync-id",
+			expectError: false,
+		},
+		{
+			name:        "neither remote URL nor sync ID",
+			args:        []string{},
+			expectError: true,
+		},
+		{
+			name:        "both remote URL and sync ID",
+			args:        []string{"http://example.com"},
+			syncID:      "sync-id",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Save and restore opts
+			oldSyncID := opts.SyncID
+
+			defer func() { opts.SyncID = oldSyncID }()
+
+			opts.SyncID = tt.syncID
+
+			cmd := &cobra.Command{}
+			err := warmCmd.Args(cmd, tt.args)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestBuildWarmQueriesFromFlags tests building warm queries from flags.
+func TestBuildWarmQueriesFromFlags(t *testing.T) {
+	oldNames, oldSkills := opts.Names, opts.SkillNames
+
+	defer func() { opts.Names, opts.SkillNames = oldNames, oldSkills }()
+
+	opts.Names = []string{"agent"}
+	opts.SkillNames = []string{"nlp", "vision"}
+
+	queries := buildWarmQueriesFromFlags()
+	require.Len(t, queries, 3)
+
+	assert.Equal(t, searchv1.RecordQueryType_RECORD_QUERY_TYPE_NAME, queries[0].GetType())
+	assert.Equal(t, "agent", queries[0].GetValue())
+	assert.Equal(t, searchv1.RecordQueryType_RECORD_QUERY_TYPE_SKILL_NAME, queries[1].GetType())
+	assert.Equal(t, "nlp", queries[1].GetValue())
+	assert.Equal(t, "vision", queries[2].GetValue())
+}
+
 // TestParseSearchOutput_ReadError tests handling of read errors.
 func TestParseSearchOutput_ReadError(t *testing.T) {
 	// Create a reader that always errors
@@ -620,3 +711,29 @@ func TestParseSearchOutput_WithBytes(t *testing.T) {
 	assert.NotNil(t, result[0].GetRecordRef())
 	assert.Equal(t, "test-cid-123", result[0].GetRecordRef().GetCid())
 }
+
+func TestParseNamespaceMappings(t *testing.T) {
+	mappings, err := parseNamespaceMappings([]string{"acme/*=mirrors/acme/*", "acme=mirrors/acme-agent"})
+	require.NoError(t, err)
+	require.Len(t, mappings, 2)
+	assert.Equal(t, "acme/*", mappings[0].GetRemote())
+	assert.Equal(t, "mirrors/acme/*", mappings[0].GetLocal())
+	assert.Equal(t, "mirrors/acme-agent", mappings[1].GetLocal())
+
+	for _, value := range []string{"acme/*", "=mirrors/*", "acme/*="} {
+		_, err := parseNamespaceMappings([]string{value})
+		assert.Error(t, err, value)
+	}
+}
+
+func TestReadTrustedKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trusted.pub")
+	require.NoError(t, os.WriteFile(path, []byte("-----BEGIN PUBLIC KEY-----\n"), 0o600))
+
+	keys, err := readTrustedKeys([]string{path})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-----BEGIN PUBLIC KEY-----\n"}, keys)
+
+	_, err = readTrustedKeys([]string{filepath.Join(t.TempDir(), "missing.pub")})
+	require.Error(t, err)
+}
@@ -0,0 +1,227 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package token
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "token",
+	Short: "Manage short-lived API tokens",
+	Long: `Mint short-lived API tokens scoped to a namespace, e.g. for CI pipelines.
+
+API tokens authenticate their bearer in a namespace (SPIFFE trust domain), and only
+grant the API methods of their scopes:
+- read: pull, lookup, search and list records and publications
+- push: push records and referrers
+- publish: publish and unpublish records
+
+Tokens are sent as bearer tokens with --auth-mode api-token, and expire after
+their TTL. The server must be configured with API tokens enabled.
+
+Usage examples:
+
+1. Mint a push token for the ml namespace, valid for one hour:
+   dirctl token create --namespace ml --scope push --ttl 1h
+
+2. Exchange the OIDC ID token of a CI job for an API token:
+   dirctl token exchange --id-token-file "$ID_TOKEN_FILE" --scope push
+
+3. Use a token in a CI pipeline:
+   export DIRECTORY_CLIENT_AUTH_MODE=api-token
+   export DIRECTORY_CLIENT_API_TOKEN=$(dirctl token exchange --id-token "$ID_TOKEN" --output raw)
+   dirctl push agent.json
+`,
+}
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Mint an API token for a namespace",
+	Long: `Mint an API token for a namespace on behalf of the caller.
+
+API tokens cannot mint other tokens: the command must be run with the SPIFFE identity
+of a token admin of the server.
+
+Usage examples:
+
+1. Mint a push token valid for the default TTL of the server:
+   dirctl token create --namespace ml --scope push
+
+2. Mint a read and push token valid for 30 minutes:
+   dirctl token create --namespace ml --scope read --scope push --ttl 30m
+
+3. Output formats:
+   dirctl token create --namespace ml --scope push --output json
+
+   # Print the token only
+   dirctl token create --namespace ml --scope push --output raw
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runCreateCommand(cmd)
+	},
+}
+
+var exchangeCmd = &cobra.Command{
+	Use:   "exchange",
+	Short: "Exchange an OIDC ID token for an API token",
+	Long: `Exchange an OIDC ID token, e.g. issued to a CI job, for an API token.
+
+The ID token must match a token exchange policy of the server, which sets the
+namespace and the scopes the ID token may be exchanged for. Exchanged tokens
+never outlive their ID token.
+
+The command does not require any other authentication.
+
+Usage examples:
+
+1. Exchange an ID token for all the scopes of its policy:
+   dirctl token exchange --id-token "$ID_TOKEN"
+
+2. Exchange an ID token read from a file for a push token:
+   dirctl token exchange --id-token-file /var/run/secrets/id-token --scope push
+
+3. Print the token only:
+   dirctl token exchange --id-token "$ID_TOKEN" --output raw
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runExchangeCommand(cmd)
+	},
+}
+
+var opts struct {
+	Namespace   string
+	Scopes      []string
+	TTL         time.Duration
+	IDToken     string
+	IDTokenFile string
+}
+
+func init() {
+	createCmd.Flags().StringVar(&opts.Namespace, "namespace", "", "Namespace (SPIFFE trust domain) of the token")
+	createCmd.Flags().StringArrayVar(&opts.Scopes, "scope", nil, "Scope of the token: read, push, publish (repeatable)")
+	createCmd.Flags().DurationVar(&opts.TTL, "ttl", 0, "Validity period of the token (default: server default)")
+	createCmd.MarkFlagRequired("namespace") //nolint:errcheck
+	createCmd.MarkFlagRequired("scope")     //nolint:errcheck
+
+	exchangeCmd.Flags().StringVar(&opts.IDToken, "id-token", "", "OIDC ID token to exchange")
+	exchangeCmd.Flags().StringVar(&opts.IDTokenFile, "id-token-file", "", "Path to file containing the OIDC ID token to exchange")
+	exchangeCmd.Flags().StringArrayVar(&opts.Scopes, "scope", nil, "Scope of the token: read, push, publish (repeatable, default: all scopes of the policy)")
+	exchangeCmd.Flags().DurationVar(&opts.TTL, "ttl", 0, "Validity period of the token (default: server default)")
+	exchangeCmd.MarkFlagsMutuallyExclusive("id-token", "id-token-file")
+	exchangeCmd.MarkFlagsOneRequired("id-token", "id-token-file")
+
+	Command.AddCommand(createCmd)
+	Command.AddCommand(exchangeCmd)
+
+	presenter.AddOutputFlags(createCmd)
+	presenter.AddOutputFlags(exchangeCmd)
+}
+
+func runCreateCommand(cmd *cobra.Command) error {
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	scopes, err := parseScopes(opts.Scopes)
+	if err != nil {
+		return err
+	}
+
+	token, err := c.CreateToken(cmd.Context(), opts.Namespace, scopes, opts.TTL)
+	if err != nil {
+		return err
+	}
+
+	return printToken(cmd, "Token created", token)
+}
+
+func runExchangeCommand(cmd *cobra.Command) error {
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	idToken := opts.IDToken
+
+	if opts.IDTokenFile != "" {
+		data, err := os.ReadFile(opts.IDTokenFile)
+		if err != nil {
+			return fmt.Errorf("failed to read ID token file: %w", err)
+		}
+
+		idToken = strings.TrimSpace(string(data))
+	}
+
+	scopes, err := parseScopes(opts.Scopes)
+	if err != nil {
+		return err
+	}
+
+	token, err := c.ExchangeToken(cmd.Context(), idToken, scopes, opts.TTL)
+	if err != nil {
+		return err
+	}
+
+	return printToken(cmd, "Token exchanged", token)
+}
+
+// parseScopes converts scope names, e.g. "push", to API scopes.
+func parseScopes(names []string) ([]corev1.TokenScope, error) {
+	scopes := make([]corev1.TokenScope, 0, len(names))
+
+	for _, name := range names {
+		scope, ok := corev1.TokenScope_value["TOKEN_SCOPE_"+strings.ToUpper(strings.TrimSpace(name))]
+		if !ok || scope == int32(corev1.TokenScope_TOKEN_SCOPE_UNSPECIFIED) {
+			return nil, fmt.Errorf("invalid token scope %q: must be one of read, push, publish", name)
+		}
+
+		scopes = append(scopes, corev1.TokenScope(scope))
+	}
+
+	return scopes, nil
+}
+
+// printToken prints a token in the output format of the command.
+// The raw output format prints the bearer token only, e.g. to export it.
+func printToken(cmd *cobra.Command, title string, token *corev1.Token) error {
+	format := presenter.GetOutputOptions(cmd).Format
+
+	if format == presenter.FormatRaw {
+		presenter.Println(cmd, token.GetToken())
+
+		return nil
+	}
+
+	if format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "token", title, token)
+	}
+
+	scopes := make([]string, 0, len(token.GetScopes()))
+	for _, scope := range token.GetScopes() {
+		scopes = append(scopes, strings.ToLower(strings.TrimPrefix(scope.String(), "TOKEN_SCOPE_")))
+	}
+
+	presenter.Printf(cmd, "%s\n", title)
+	presenter.Printf(cmd, "  ID: %s\n", token.GetTokenId())
+	presenter.Printf(cmd, "  Namespace: %s\n", token.GetNamespace())
+	presenter.Printf(cmd, "  Scopes: %s\n", strings.Join(scopes, ", "))
+	presenter.Printf(cmd, "  Expires: %s\n", token.GetExpiresTime())
+	presenter.Printf(cmd, "  Token: %s\n", token.GetToken())
+
+	return nil
+}
@@ -7,6 +7,7 @@ package verify
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
 	signv1 "github.com/agntcy/dir/api/sign/v1"
@@ -15,7 +16,21 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// Verify command options.
+var opts struct {
+	Snapshot bool
+	History  bool
+}
+
 func init() {
+	flags := Command.Flags()
+	flags.BoolVar(&opts.Snapshot, "snapshot", false,
+		"Record the verification result as an immutable snapshot attached to the record")
+	flags.BoolVar(&opts.History, "history", false,
+		"List the verification snapshots recorded for the record")
+
+	Command.MarkFlagsMutuallyExclusive("snapshot", "history")
+
 	// Add output format flags
 	presenter.AddOutputFlags(Command)
 }
@@ -33,7 +48,16 @@ Usage examples:
 
 	dirctl verify <record-cid>
 
-2. Output formats:
+2. Verify a record and record the result as a verification snapshot,
+   e.g. when the record is deployed:
+
+	dirctl verify <record-cid> --snapshot
+
+3. List the verification snapshots recorded for a record:
+
+	dirctl verify <record-cid> --history
+
+4. Output formats:
 
 	# Get verification result as JSON
 	dirctl verify <record-cid> --output json
@@ -49,7 +73,14 @@ Usage examples:
 			recordRef = args[0]
 		}
 
-		return runCommand(cmd, recordRef)
+		switch {
+		case opts.Snapshot:
+			return runSnapshotCommand(cmd, recordRef)
+		case opts.History:
+			return runHistoryCommand(cmd, recordRef)
+		default:
+			return runCommand(cmd, recordRef)
+		}
 	},
 }
 
@@ -72,9 +103,107 @@ func runCommand(cmd *cobra.Command, recordRef string) error {
 
 	// Output in the appropriate format
 	status := "trusted"
-	if !response.GetSuccess() {
+
+	switch {
+	case response.GetRevoked():
+		status = "revoked"
+	case !response.GetSuccess():
 		status = "not trusted"
 	}
 
-	return presenter.PrintMessage(cmd, "signature", "Record signature is", status)
+	if err := presenter.PrintMessage(cmd, "signature", "Record signature is", status); err != nil {
+		return err
+	}
+
+	// List the signers satisfying the server signature policies
+	if presenter.GetOutputOptions(cmd).Format == presenter.FormatHuman {
+		for _, result := range response.GetPolicyResults() {
+			satisfied := "not satisfied"
+			if result.GetSatisfied() {
+				satisfied = "satisfied"
+			}
+
+			presenter.Printf(cmd, "Policy %s: %s (%d of %d signers: %s)\n", result.GetName(), satisfied,
+				len(result.GetSigners()), result.GetThreshold(), strings.Join(result.GetSigners(), ", "))
+		}
+	}
+
+	return nil
+}
+
+func runSnapshotCommand(cmd *cobra.Command, recordRef string) error {
+	// Get the client from the context
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	snapshot, err := c.CreateVerificationSnapshot(cmd.Context(), recordRef)
+	if err != nil {
+		return fmt.Errorf("failed to create verification snapshot: %w", err)
+	}
+
+	// Output in the appropriate format
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "snapshot", "Verification snapshot", snapshot)
+	}
+
+	printSnapshot(cmd, snapshot)
+
+	return nil
+}
+
+func runHistoryCommand(cmd *cobra.Command, recordRef string) error {
+	// Get the client from the context
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	snapshots, err := c.ListVerificationSnapshots(cmd.Context(), recordRef)
+	if err != nil {
+		return fmt.Errorf("failed to list verification snapshots: %w", err)
+	}
+
+	// Output in the appropriate format
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "snapshots", "Verification snapshots", snapshots)
+	}
+
+	if len(snapshots) == 0 {
+		presenter.Printf(cmd, "No verification snapshots found\n")
+
+		return nil
+	}
+
+	for i, snapshot := range snapshots {
+		if i > 0 {
+			presenter.Printf(cmd, "\n")
+		}
+
+		printSnapshot(cmd, snapshot)
+	}
+
+	return nil
+}
+
+func printSnapshot(cmd *cobra.Command, snapshot *signv1.VerificationSnapshot) {
+	presenter.Printf(cmd, "Verified at: %s\n", snapshot.GetVerifiedAt())
+
+	if snapshot.GetCreatedBy() != "" {
+		presenter.Printf(cmd, "  Created by: %s\n", snapshot.GetCreatedBy())
+	}
+
+	presenter.Printf(cmd, "  Signatures: %d (%d revoked)\n", snapshot.GetSignatureCount(), snapshot.GetRevokedCount())
+	presenter.Printf(cmd, "  Signature verified: %t\n", snapshot.GetSignatureVerified())
+
+	if snapshot.GetSignatureError() != "" {
+		presenter.Printf(cmd, "  Signature error: %s\n", snapshot.GetSignatureError())
+	}
+
+	presenter.Printf(cmd, "  Schema valid: %t (schema %s, rules %s)\n", snapshot.GetSchemaValid(), snapshot.GetSchemaVersion(), snapshot.GetRulesVersion())
+
+	for _, schemaError := range snapshot.GetSchemaErrors() {
+		presenter.Printf(cmd, "  Schema error: %s\n", schemaError)
+	}
 }
@@ -0,0 +1,20 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package version
+
+import "github.com/agntcy/dir/cli/presenter"
+
+var opts = &options{}
+
+type options struct {
+	Server bool
+}
+
+func init() {
+	flags := Command.Flags()
+	flags.BoolVar(&opts.Server, "server", false, "Also print the version, enabled features and limits of the Directory server")
+
+	// Add output format flags
+	presenter.AddOutputFlags(Command)
+}
@@ -4,15 +4,116 @@
 package version
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	"github.com/agntcy/dir/api/version"
 	"github.com/agntcy/dir/cli/presenter"
+	ctxUtils "github.com/agntcy/dir/cli/util/context"
 	"github.com/spf13/cobra"
 )
 
 var Command = &cobra.Command{
 	Use:   "version",
 	Short: "Print the version of the application",
-	Run: func(cmd *cobra.Command, _ []string) {
-		presenter.Print(cmd, "Application Version: ", version.String())
+	Long: `Print the version of the application.
+
+Usage examples:
+
+1. Print the version of dirctl:
+
+	dirctl version
+
+2. Also print the version, enabled features, limits and store probe results of the Directory server:
+
+	dirctl version --server
+
+`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if !opts.Server {
+			presenter.Print(cmd, "Application Version: ", version.String())
+
+			return nil
+		}
+
+		return runServerCommand(cmd)
 	},
 }
+
+func runServerCommand(cmd *cobra.Command) error {
+	// Get the client from the context.
+	c, ok := ctxUtils.GetClientFromContext(cmd.Context())
+	if !ok {
+		return errors.New("failed to get client from context")
+	}
+
+	info, err := c.GetServerInfo(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("failed to get server info: %w", err)
+	}
+
+	if presenter.GetOutputOptions(cmd).Format != presenter.FormatHuman {
+		return presenter.PrintMessage(cmd, "server info", "Server info", info)
+	}
+
+	printServerInfo(cmd, info)
+
+	return nil
+}
+
+func printServerInfo(cmd *cobra.Command, info *corev1.GetServerInfoResponse) {
+	build := info.GetBuildInfo()
+	features := info.GetFeatures()
+	limits := info.GetLimits()
+
+	presenter.Printf(cmd, "Application Version: %s\n", version.String())
+	presenter.Printf(cmd, "Server Version: %s (%s)\n", build.GetVersion(), build.GetCommitHash())
+	presenter.Printf(cmd, "Server Go Version: %s\n", build.GetGoVersion())
+	presenter.Printf(cmd, "API Versions: %s\n", strings.Join(info.GetApiVersions(), ", "))
+	presenter.Printf(cmd, "Supported Schema Versions: %s\n", strings.Join(info.GetSupportedSchemaVersions(), ", "))
+	presenter.Printf(cmd, "Accepted Schema Versions: %s\n", strings.Join(info.GetAcceptedSchemaVersions(), ", "))
+
+	presenter.Println(cmd, "Features:")
+	presenter.Printf(cmd, "  Authentication: %t\n", features.GetAuthn())
+	presenter.Printf(cmd, "  Authorization: %t\n", features.GetAuthz())
+	presenter.Printf(cmd, "  Events Persistence: %t\n", features.GetEventsPersistence())
+	presenter.Printf(cmd, "  Namespaces: %t\n", features.GetNamespaces())
+	presenter.Printf(cmd, "  Rate Limiting: %t\n", features.GetRateLimit())
+	presenter.Printf(cmd, "  Read Only: %t\n", features.GetReadOnly())
+
+	presenter.Println(cmd, "Limits:")
+	presenter.Printf(cmd, "  Max Receive Message Size: %d bytes\n", limits.GetMaxRecvMsgSize())
+	presenter.Printf(cmd, "  Max Send Message Size: %d bytes\n", limits.GetMaxSendMsgSize())
+	presenter.Printf(cmd, "  Max Concurrent Streams: %d\n", limits.GetMaxConcurrentStreams())
+
+	if features.GetRateLimit() {
+		presenter.Printf(cmd, "  Per Client Rate Limit: %g rps (burst %d)\n", limits.GetPerClientRps(), limits.GetPerClientBurst())
+		presenter.Printf(cmd, "  Global Rate Limit: %g rps (burst %d)\n", limits.GetGlobalRps(), limits.GetGlobalBurst())
+	}
+
+	if probe := info.GetStoreProbe(); probe != nil {
+		printStoreProbe(cmd, probe)
+	}
+}
+
+func printStoreProbe(cmd *cobra.Command, probe *corev1.StoreProbe) {
+	presenter.Printf(cmd, "Store Probe (%s):\n", probe.GetProvider())
+
+	if probe.GetError() != "" {
+		presenter.Printf(cmd, "  Error: %s\n", probe.GetError())
+
+		return
+	}
+
+	presenter.Printf(cmd, "  Write Latency: %s\n", probe.GetWriteLatency().AsDuration())
+	presenter.Printf(cmd, "  Read Latency: %s\n", probe.GetReadLatency().AsDuration())
+	presenter.Printf(cmd, "  Delete Latency: %s\n", probe.GetDeleteLatency().AsDuration())
+	presenter.Printf(cmd, "  Referrers: %t\n", probe.GetReferrers())
+	presenter.Printf(cmd, "  Max Record Size: %d bytes\n", probe.GetMaxRecordSize())
+
+	for _, warning := range probe.GetWarnings() {
+		presenter.Printf(cmd, "  Warning: %s\n", warning)
+	}
+}
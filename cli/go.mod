@@ -8,6 +8,7 @@ replace (
 	github.com/agntcy/dir/hub => ../hub
 	github.com/agntcy/dir/importer => ../importer
 	github.com/agntcy/dir/mcp => ../mcp
+	github.com/agntcy/dir/server => ../server
 	github.com/agntcy/dir/utils => ../utils
 )
 
@@ -17,13 +18,16 @@ require (
 	github.com/agntcy/dir/hub v0.5.1
 	github.com/agntcy/dir/importer v0.5.1
 	github.com/agntcy/dir/mcp v0.5.1
+	github.com/agntcy/dir/server v0.5.1
 	github.com/agntcy/dir/utils v0.5.1
+	github.com/agntcy/oasf-sdk/pkg v0.0.11
 	github.com/libp2p/go-libp2p v0.44.0
 	github.com/sigstore/sigstore v1.9.5
 	github.com/spf13/cobra v1.10.1
 	github.com/spf13/pflag v1.0.10
 	github.com/stretchr/testify v1.11.1
 	golang.org/x/crypto v0.43.0
+	google.golang.org/grpc v1.76.0
 	google.golang.org/protobuf v1.36.10
 )
 
@@ -49,7 +53,6 @@ require (
 	github.com/Microsoft/go-winio v0.6.2 // indirect
 	github.com/PuerkitoBio/goquery v1.10.3 // indirect
 	github.com/ThalesIgnite/crypto11 v1.2.5 // indirect
-	github.com/agntcy/oasf-sdk/pkg v0.0.11 // indirect
 	github.com/alecthomas/chroma/v2 v2.20.0 // indirect
 	github.com/andybalholm/cascadia v1.3.3 // indirect
 	github.com/anthropics/anthropic-sdk-go v1.10.0 // indirect
@@ -299,7 +302,6 @@ require (
 	google.golang.org/genproto v0.0.0-20250603155806-513f23925822 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20251007200510-49b9836ed3ff // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251002232023-7c0ddcbb5797 // indirect
-	google.golang.org/grpc v1.76.0 // indirect
 	gopkg.in/evanphx/json-patch.v4 v4.12.0 // indirect
 	gopkg.in/inf.v0 v0.9.1 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
@@ -0,0 +1,79 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package presenter
+
+import (
+	"github.com/agntcy/dir/client/batch"
+	"github.com/spf13/cobra"
+)
+
+// BatchOptions holds the failure handling options of batch commands.
+type BatchOptions struct {
+	// FailFast stops processing at the first failing item.
+	// Otherwise remaining items are processed and failures are reported at the end.
+	FailFast bool
+}
+
+// AddBatchFlags adds the standard --fail-fast and --continue-on-error flags to a batch command.
+// Batch commands continue on error by default.
+func AddBatchFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("fail-fast", false, "Stop at the first failing item and skip the remaining ones")
+	cmd.Flags().Bool("continue-on-error", true, "Process all items and report failures at the end (default)")
+	cmd.MarkFlagsMutuallyExclusive("fail-fast", "continue-on-error")
+}
+
+// GetBatchOptions extracts batch failure handling options from command flags.
+func GetBatchOptions(cmd *cobra.Command) BatchOptions {
+	failFast, err := cmd.Flags().GetBool("fail-fast")
+	if err != nil {
+		return BatchOptions{}
+	}
+
+	return BatchOptions{FailFast: failFast}
+}
+
+// PrintBatchResult outputs the per-item results of a batch operation followed by a summary.
+// JSON and JSONL outputs print the items.
+// Raw output prints the CIDs of succeeded items, one per line.
+// The returned error summarizes failed and skipped items.
+func PrintBatchResult(cmd *cobra.Command, title string, result *batch.Result) error {
+	switch GetOutputOptions(cmd).Format {
+	case FormatHuman:
+		for _, item := range result.Items {
+			name := item.Name
+			if name == "" {
+				name = item.CID
+			}
+
+			switch item.Status {
+			case batch.StatusSucceeded:
+				Printf(cmd, "OK      %s %s\n", name, item.CID)
+			case batch.StatusFailed:
+				Printf(cmd, "FAILED  %s: %s (%s)\n", name, item.Error, item.Code)
+			case batch.StatusSkipped:
+				Printf(cmd, "SKIPPED %s\n", name)
+			}
+		}
+
+		Printf(cmd, "\n")
+
+	case FormatRaw:
+		for _, item := range result.Items {
+			if item.Status == batch.StatusSucceeded {
+				Printf(cmd, "%s\n", item.CID)
+			}
+		}
+
+	case FormatJSON, FormatJSONL:
+		if err := PrintMessage(cmd, "items", title, result.Items); err != nil {
+			return err
+		}
+	}
+
+	// The summary goes to stderr for structured outputs to keep stdout machine-readable
+	PrintSmartf(cmd, "%s: %d succeeded, %d failed, %d skipped\n",
+		title, result.Succeeded(), result.Failed(), result.Skipped())
+
+	return result.Err() //nolint:wrapcheck
+}
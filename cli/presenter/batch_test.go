@@ -0,0 +1,95 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package presenter
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/agntcy/dir/client/batch"
+	"github.com/spf13/cobra"
+)
+
+func newBatchTestCommand(args ...string) (*cobra.Command, *bytes.Buffer, *bytes.Buffer) {
+	cmd := &cobra.Command{Use: "test"}
+	AddOutputFlags(cmd)
+	AddBatchFlags(cmd)
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	_ = cmd.ParseFlags(args)
+
+	return cmd, stdout, stderr
+}
+
+func newTestBatchResult() *batch.Result {
+	result := batch.NewNamedResult([]string{"a.json", "b.json", "c.json"})
+	result.Succeed(0, "bafy-a")
+	result.Fail(1, errors.New("invalid record"))
+
+	return result
+}
+
+func TestGetBatchOptions(t *testing.T) {
+	cmd, _, _ := newBatchTestCommand()
+	if GetBatchOptions(cmd).FailFast {
+		t.Error("expected batch commands to continue on error by default")
+	}
+
+	cmd, _, _ = newBatchTestCommand("--fail-fast")
+	if !GetBatchOptions(cmd).FailFast {
+		t.Error("expected --fail-fast to be set")
+	}
+
+	cmd = &cobra.Command{Use: "test"}
+	if GetBatchOptions(cmd).FailFast {
+		t.Error("expected commands without batch flags to continue on error")
+	}
+}
+
+func TestPrintBatchResultHuman(t *testing.T) {
+	cmd, stdout, _ := newBatchTestCommand()
+
+	err := PrintBatchResult(cmd, "Pushed records", newTestBatchResult())
+	if err == nil || err.Error() != "1 of 3 items failed, 1 skipped" {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	for _, expected := range []string{
+		"OK      a.json bafy-a",
+		"FAILED  b.json: invalid record (Unknown)",
+		"SKIPPED c.json",
+		"Pushed records: 1 succeeded, 1 failed, 1 skipped",
+	} {
+		if !strings.Contains(stdout.String(), expected) {
+			t.Errorf("expected output to contain %q, got %q", expected, stdout.String())
+		}
+	}
+}
+
+func TestPrintBatchResultStructured(t *testing.T) {
+	cmd, stdout, stderr := newBatchTestCommand("--output", "raw")
+
+	_ = PrintBatchResult(cmd, "Pushed records", newTestBatchResult())
+
+	if stdout.String() != "bafy-a\n" {
+		t.Errorf("expected only succeeded CIDs on stdout, got %q", stdout.String())
+	}
+
+	if !strings.Contains(stderr.String(), "1 succeeded, 1 failed, 1 skipped") {
+		t.Errorf("expected summary on stderr, got %q", stderr.String())
+	}
+
+	cmd, stdout, _ = newBatchTestCommand("--output", "jsonl")
+
+	_ = PrintBatchResult(cmd, "Pushed records", newTestBatchResult())
+
+	if lines := strings.Count(stdout.String(), "\n"); lines != 3 {
+		t.Errorf("expected one JSON line per item, got %q", stdout.String())
+	}
+}
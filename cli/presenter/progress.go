@@ -0,0 +1,100 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package presenter
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// ProgressFormat represents the different progress event formats available.
+type ProgressFormat string
+
+const (
+	// ProgressNone disables progress events.
+	ProgressNone ProgressFormat = ""
+	// ProgressJSONL emits one JSON progress event per line on stderr.
+	ProgressJSONL ProgressFormat = "jsonl"
+)
+
+// ProgressEvent is a machine-readable progress event of a long-running command.
+type ProgressEvent struct {
+	// Time at which the event was emitted.
+	Time time.Time `json:"time"`
+	// Command is the path of the command, e.g. "dirctl push".
+	Command string `json:"command"`
+	// Phase is the current phase of the command, e.g. "push" or "done".
+	Phase string `json:"phase"`
+	// Processed is the number of items processed so far in the phase.
+	Processed int `json:"processed"`
+	// Total is the number of items to process in the phase, or 0 if unknown.
+	Total int `json:"total"`
+	// Errors is the number of items that failed so far in the phase.
+	Errors int `json:"errors"`
+	// Message optionally describes the event.
+	Message string `json:"message,omitempty"`
+}
+
+// Progress reports progress events of a long-running command.
+// A nil Progress reports nothing, so commands can report unconditionally.
+type Progress struct {
+	mu      sync.Mutex
+	command string
+	enc     *json.Encoder
+}
+
+// AddProgressFlags adds the standard --progress flag to a long-running command.
+func AddProgressFlags(cmd *cobra.Command) {
+	cmd.Flags().String("progress", "", "Emit machine-readable progress events on stderr: jsonl")
+}
+
+// NewProgress returns the progress reporter selected by the --progress flag of the command.
+// It returns nil if progress events are disabled or the command has no --progress flag.
+func NewProgress(cmd *cobra.Command) (*Progress, error) {
+	format, err := cmd.Flags().GetString("progress")
+	if err != nil {
+		return nil, nil //nolint:nilerr,nilnil
+	}
+
+	switch ProgressFormat(format) {
+	case ProgressNone:
+		return nil, nil //nolint:nilnil
+	case ProgressJSONL:
+		return &Progress{
+			command: cmd.CommandPath(),
+			enc:     json.NewEncoder(cmd.ErrOrStderr()),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported progress format %q, expected: jsonl", format)
+	}
+}
+
+// Report emits a progress event for the given phase.
+func (p *Progress) Report(phase string, processed, total, errors int) {
+	p.emit(ProgressEvent{Phase: phase, Processed: processed, Total: total, Errors: errors})
+}
+
+// Reportf emits a progress event for the given phase with a formatted message.
+func (p *Progress) Reportf(phase string, processed, total, errors int, format string, args ...any) {
+	p.emit(ProgressEvent{Phase: phase, Processed: processed, Total: total, Errors: errors, Message: fmt.Sprintf(format, args...)})
+}
+
+func (p *Progress) emit(event ProgressEvent) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	event.Time = time.Now().UTC()
+	event.Command = p.command
+
+	// Progress events are best effort and must never fail the command
+	_ = p.enc.Encode(event)
+}
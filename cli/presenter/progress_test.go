@@ -0,0 +1,109 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package presenter
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newProgressTestCommand(args ...string) (*cobra.Command, *bytes.Buffer, *bytes.Buffer) {
+	cmd := &cobra.Command{Use: "test"}
+	AddProgressFlags(cmd)
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	_ = cmd.ParseFlags(args)
+
+	return cmd, stdout, stderr
+}
+
+func TestNewProgressDisabled(t *testing.T) {
+	cmd, _, stderr := newProgressTestCommand()
+
+	progress, err := NewProgress(cmd)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if progress != nil {
+		t.Fatal("expected progress events to be disabled by default")
+	}
+
+	// A disabled reporter reports nothing
+	progress.Report("push", 1, 2, 0)
+
+	if stderr.Len() != 0 {
+		t.Errorf("unexpected progress output: %q", stderr.String())
+	}
+
+	progress, err = NewProgress(&cobra.Command{Use: "test"})
+	if err != nil || progress != nil {
+		t.Errorf("expected commands without progress flags to report nothing, got %v, %v", progress, err)
+	}
+}
+
+func TestNewProgressInvalidFormat(t *testing.T) {
+	cmd, _, _ := newProgressTestCommand("--progress", "xml")
+
+	if _, err := NewProgress(cmd); err == nil {
+		t.Error("expected an error for an unsupported progress format")
+	}
+}
+
+func TestProgressJSONL(t *testing.T) {
+	cmd, stdout, stderr := newProgressTestCommand("--progress", "jsonl")
+
+	progress, err := NewProgress(cmd)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	progress.Report("push", 1, 3, 0)
+	progress.Reportf("done", 3, 3, 1, "pushed %d records", 2)
+
+	if stdout.Len() != 0 {
+		t.Errorf("expected progress events to leave stdout untouched, got %q", stdout.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(stderr.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 progress events, got %d: %q", len(lines), stderr.String())
+	}
+
+	var events []ProgressEvent
+
+	for _, line := range lines {
+		var event ProgressEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			t.Fatalf("invalid progress event %q: %v", line, err)
+		}
+
+		events = append(events, event)
+	}
+
+	first := events[0]
+	if first.Command != "test" || first.Phase != "push" || first.Processed != 1 || first.Total != 3 || first.Errors != 0 {
+		t.Errorf("unexpected first event: %+v", first)
+	}
+
+	if first.Time.IsZero() {
+		t.Error("expected events to be timestamped")
+	}
+
+	if strings.Contains(lines[0], "message") {
+		t.Errorf("expected empty messages to be omitted: %q", lines[0])
+	}
+
+	last := events[1]
+	if last.Phase != "done" || last.Processed != 3 || last.Errors != 1 || last.Message != "pushed 2 records" {
+		t.Errorf("unexpected last event: %+v", last)
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cache provides a local on-disk cache for dirctl.
+//
+// Records are stored by CID and verified against their CID when read,
+// so cached records can be served without contacting the server.
+// Search results are stored by query and refreshed on every online search.
+//
+// Layout:
+//
+//	<dir>/records/<cid>.json
+//	<dir>/search/<sha256 of query>.json
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+)
+
+const (
+	// DirEnv overrides the default cache directory.
+	DirEnv = "DIRCTL_CACHE_DIR"
+
+	recordsDir = "records"
+	searchDir  = "search"
+
+	dirPerm = 0o700
+)
+
+// ErrNotFound is returned when an entry is not present in the cache.
+var ErrNotFound = errors.New("not found in cache")
+
+// Cache is a local on-disk cache rooted at a directory.
+type Cache struct {
+	dir string
+}
+
+// SearchEntry is a cached search result.
+type SearchEntry struct {
+	Query     string    `json:"query"`
+	CIDs      []string  `json:"cids"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Stats describes the cache contents.
+type Stats struct {
+	Dir           string `json:"dir"`
+	Records       int    `json:"records"`
+	SearchResults int    `json:"search_results"`
+	SizeBytes     int64  `json:"size_bytes"`
+}
+
+// New creates a cache rooted at dir.
+func New(dir string) *Cache {
+	return &Cache{dir: dir}
+}
+
+// DefaultDir returns the default cache directory.
+// It is taken from DIRCTL_CACHE_DIR if set, otherwise it is
+// the dirctl directory in the user cache directory (e.g. ~/.cache/dirctl).
+func DefaultDir() (string, error) {
+	if dir := os.Getenv(DirEnv); dir != "" {
+		return dir, nil
+	}
+
+	userCacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user cache directory: %w", err)
+	}
+
+	return filepath.Join(userCacheDir, "dirctl"), nil
+}
+
+// Default creates a cache rooted at the default cache directory.
+func Default() (*Cache, error) {
+	dir, err := DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return New(dir), nil
+}
+
+// Dir returns the cache root directory.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+// GetRecord returns the cached record with the given CID.
+// Returns ErrNotFound if the record is not cached or no longer matches its CID.
+func (c *Cache) GetRecord(cid string) (*corev1.Record, error) {
+	path, err := c.recordPath(cid)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cached record: %w", err)
+	}
+
+	record, err := corev1.UnmarshalRecord(data)
+	if err != nil || record.GetCid() != cid {
+		// Drop corrupted entries so they get refreshed on next pull
+		_ = os.Remove(path)
+
+		return nil, ErrNotFound
+	}
+
+	return record, nil
+}
+
+// PutRecord stores the record by its CID.
+func (c *Cache) PutRecord(record *corev1.Record) error {
+	cid := record.GetCid()
+	if cid == "" {
+		return errors.New("failed to calculate record CID")
+	}
+
+	path, err := c.recordPath(cid)
+	if err != nil {
+		return err
+	}
+
+	data, err := record.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal record: %w", err)
+	}
+
+	return writeFile(path, data)
+}
+
+// GetSearch returns the cached result of the given search query.
+// Returns ErrNotFound if the query has no cached result.
+func (c *Cache) GetSearch(query string) (*SearchEntry, error) {
+	data, err := os.ReadFile(c.searchPath(query))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cached search result: %w", err)
+	}
+
+	var entry SearchEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Query != query {
+		return nil, ErrNotFound
+	}
+
+	return &entry, nil
+}
+
+// PutSearch stores the result of the given search query.
+func (c *Cache) PutSearch(query string, cids []string) error {
+	data, err := json.Marshal(SearchEntry{
+		Query:     query,
+		CIDs:      cids,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal search result: %w", err)
+	}
+
+	return writeFile(c.searchPath(query), data)
+}
+
+// Stats returns statistics about the cache contents.
+func (c *Cache) Stats() (*Stats, error) {
+	stats := &Stats{Dir: c.dir}
+
+	for _, sub := range []string{recordsDir, searchDir} {
+		entries, err := os.ReadDir(filepath.Join(c.dir, sub))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cache directory: %w", err)
+		}
+
+		for _, entry := range entries {
+			// Skip in-flight temporary files
+			if strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+
+			info, err := entry.Info()
+			if err != nil || !info.Mode().IsRegular() {
+				continue
+			}
+
+			stats.SizeBytes += info.Size()
+
+			if sub == recordsDir {
+				stats.Records++
+			} else {
+				stats.SearchResults++
+			}
+		}
+	}
+
+	return stats, nil
+}
+
+// Clear removes all cached entries.
+func (c *Cache) Clear() error {
+	for _, sub := range []string{recordsDir, searchDir} {
+		if err := os.RemoveAll(filepath.Join(c.dir, sub)); err != nil {
+			return fmt.Errorf("failed to clear cache: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Cache) recordPath(cid string) (string, error) {
+	// CIDs are used as file names, reject anything that could escape the cache
+	if cid == "" || strings.ContainsAny(cid, `/\.`) {
+		return "", fmt.Errorf("invalid CID: %q", cid)
+	}
+
+	return filepath.Join(c.dir, recordsDir, cid+".json"), nil
+}
+
+func (c *Cache) searchPath(query string) string {
+	sum := sha256.Sum256([]byte(query))
+
+	return filepath.Join(c.dir, searchDir, hex.EncodeToString(sum[:])+".json")
+}
+
+// writeFile atomically writes data to path, creating parent directories.
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	return nil
+}
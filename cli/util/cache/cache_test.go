@@ -0,0 +1,118 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRecord = `{
+	"name": "test-agent",
+	"version": "1.0.0",
+	"schema_version": "v0.3.1",
+	"authors": ["test"],
+	"created_at": "2023-01-01T00:00:00Z"
+}`
+
+func TestRecordCache(t *testing.T) {
+	c := New(t.TempDir())
+
+	record, err := corev1.UnmarshalRecord([]byte(testRecord))
+	require.NoError(t, err)
+
+	cid := record.GetCid()
+
+	t.Run("missing record", func(t *testing.T) {
+		_, err := c.GetRecord(cid)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("put and get record", func(t *testing.T) {
+		require.NoError(t, c.PutRecord(record))
+
+		cached, err := c.GetRecord(cid)
+		require.NoError(t, err)
+		assert.Equal(t, cid, cached.GetCid())
+		assert.Equal(t, "test-agent", cached.GetData().GetFields()["name"].GetStringValue())
+	})
+
+	t.Run("corrupted record is dropped", func(t *testing.T) {
+		path := filepath.Join(c.Dir(), recordsDir, cid+".json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"name": "tampered"}`), 0o600))
+
+		_, err := c.GetRecord(cid)
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.NoFileExists(t, path)
+	})
+
+	t.Run("invalid CID", func(t *testing.T) {
+		_, err := c.GetRecord("../escape")
+		require.ErrorContains(t, err, "invalid CID")
+	})
+}
+
+func TestSearchCache(t *testing.T) {
+	c := New(t.TempDir())
+
+	_, err := c.GetSearch("name=test")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, c.PutSearch("name=test", []string{"cid-a", "cid-b"}))
+	require.NoError(t, c.PutSearch("name=other", []string{"cid-c"}))
+
+	entry, err := c.GetSearch("name=test")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cid-a", "cid-b"}, entry.CIDs)
+	assert.False(t, entry.CreatedAt.IsZero())
+
+	// Results are replaced on subsequent searches
+	require.NoError(t, c.PutSearch("name=test", []string{"cid-d"}))
+
+	entry, err = c.GetSearch("name=test")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cid-d"}, entry.CIDs)
+}
+
+func TestStatsAndClear(t *testing.T) {
+	c := New(filepath.Join(t.TempDir(), "dirctl"))
+
+	// Empty cache
+	stats, err := c.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, 0, stats.Records)
+	assert.Equal(t, 0, stats.SearchResults)
+
+	record, err := corev1.UnmarshalRecord([]byte(testRecord))
+	require.NoError(t, err)
+
+	require.NoError(t, c.PutRecord(record))
+	require.NoError(t, c.PutSearch("name=test", []string{record.GetCid()}))
+
+	stats, err = c.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Records)
+	assert.Equal(t, 1, stats.SearchResults)
+	assert.Positive(t, stats.SizeBytes)
+
+	require.NoError(t, c.Clear())
+
+	stats, err = c.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, 0, stats.Records)
+	assert.Equal(t, 0, stats.SearchResults)
+}
+
+func TestDefaultDir(t *testing.T) {
+	t.Setenv(DirEnv, "/tmp/dirctl-cache")
+
+	dir, err := DefaultDir()
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/dirctl-cache", dir)
+}
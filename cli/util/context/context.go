@@ -11,7 +11,14 @@ import (
 
 type ClientContextKeyType string
 
-const ClientContextKey ClientContextKeyType = "ContextDirClient"
+const (
+	ClientContextKey       ClientContextKeyType = "ContextDirClient"
+	ClientConfigContextKey ClientContextKeyType = "ContextDirClientConfig"
+)
+
+// SkipClientAnnotation marks commands (and their subcommands) that only work
+// on local state and therefore do not need a client in their context.
+const SkipClientAnnotation = "dirctl/skip-client"
 
 func SetClientForContext(ctx context.Context, c *client.Client) context.Context {
 	return context.WithValue(ctx, ClientContextKey, c)
@@ -22,3 +29,15 @@ func GetClientFromContext(ctx context.Context) (*client.Client, bool) {
 
 	return cli, ok
 }
+
+// SetClientConfigForContext stores the configuration the client was created with,
+// so that commands can create clients for other servers with the same settings.
+func SetClientConfigForContext(ctx context.Context, cfg *client.Config) context.Context {
+	return context.WithValue(ctx, ClientConfigContextKey, cfg)
+}
+
+func GetClientConfigFromContext(ctx context.Context) (*client.Config, bool) {
+	cfg, ok := ctx.Value(ClientConfigContextKey).(*client.Config)
+
+	return cfg, ok
+}
@@ -0,0 +1,80 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package instance manages the persistent client instance ID of dirctl.
+//
+// The ID is generated on first use and stored in the dirctl configuration
+// directory, so that all dirctl invocations on a machine or in a container
+// image send the same ID and can be correlated in server logs.
+package instance
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/client"
+)
+
+const (
+	// ConfigDirEnv overrides the default dirctl configuration directory.
+	ConfigDirEnv = "DIRCTL_CONFIG_DIR"
+
+	idFile = "instance-id"
+
+	dirPerm  = 0o700
+	filePerm = 0o600
+)
+
+// DefaultPath returns the path of the client instance ID file.
+// It is located in DIRCTL_CONFIG_DIR if set, otherwise in the
+// dirctl directory of the user configuration directory (e.g. ~/.config/dirctl).
+func DefaultPath() (string, error) {
+	if dir := os.Getenv(ConfigDirEnv); dir != "" {
+		return filepath.Join(dir, idFile), nil
+	}
+
+	userConfigDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user config directory: %w", err)
+	}
+
+	return filepath.Join(userConfigDir, "dirctl", idFile), nil
+}
+
+// LoadOrCreate returns the client instance ID stored at path.
+// If the file does not exist, a new ID is generated and stored.
+func LoadOrCreate(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		id := strings.TrimSpace(string(data))
+		if err := corev1.ValidateClientInstanceID(id); err != nil {
+			return "", fmt.Errorf("invalid client instance ID in %s: %w", path, err)
+		}
+
+		return id, nil
+	}
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("failed to read client instance ID: %w", err)
+	}
+
+	id, err := client.NewClientInstanceID()
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return "", fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, []byte(id+"\n"), filePerm); err != nil {
+		return "", fmt.Errorf("failed to write client instance ID: %w", err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,42 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package instance
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadOrCreate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dirctl", idFile)
+
+	// The ID is generated on first use
+	id, err := LoadOrCreate(path)
+	require.NoError(t, err)
+	assert.NotEmpty(t, id)
+
+	// and reused afterwards
+	again, err := LoadOrCreate(path)
+	require.NoError(t, err)
+	assert.Equal(t, id, again)
+
+	// Malformed IDs are rejected
+	require.NoError(t, os.WriteFile(path, []byte("not a valid id"), filePerm))
+
+	_, err = LoadOrCreate(path)
+	require.ErrorContains(t, err, "invalid client instance ID")
+}
+
+func TestDefaultPath(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(ConfigDirEnv, dir)
+
+	path, err := DefaultPath()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, idFile), path)
+}
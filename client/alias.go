@@ -0,0 +1,112 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+)
+
+// SetAlias points the name:tag alias to a record using the SetAlias RPC.
+// If expectedCID is not nil, the alias is only retargeted if it points to that record,
+// or does not exist when it is empty.
+func (c *Client) SetAlias(ctx context.Context, name, tag string, recordRef *corev1.RecordRef, expectedCID *string) (*storev1.SetAliasResponse, error) {
+	resp, err := c.StoreServiceClient.SetAlias(ctx, &storev1.SetAliasRequest{
+		Name:        name,
+		Tag:         tag,
+		RecordRef:   recordRef,
+		ExpectedCid: expectedCID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set alias: %w", err)
+	}
+
+	if c.cache != nil {
+		c.cache.EvictNames()
+	}
+
+	return resp, nil
+}
+
+// DeleteAlias deletes the name:tag alias using the DeleteAlias RPC.
+func (c *Client) DeleteAlias(ctx context.Context, name, tag string) error {
+	_, err := c.StoreServiceClient.DeleteAlias(ctx, &storev1.DeleteAliasRequest{
+		Name: name,
+		Tag:  tag,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete alias: %w", err)
+	}
+
+	if c.cache != nil {
+		c.cache.EvictNames()
+	}
+
+	return nil
+}
+
+// ListAliases lists the aliases of a record name, or all aliases if name is empty.
+func (c *Client) ListAliases(ctx context.Context, name string) ([]*storev1.Alias, error) {
+	resp, err := c.StoreServiceClient.ListAliases(ctx, &storev1.ListAliasesRequest{
+		Name: name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list aliases: %w", err)
+	}
+
+	return resp.GetAliases(), nil
+}
+
+// GetAliasHistory returns the changes of the aliases of a record name, oldest first,
+// optionally only those of the given tag.
+func (c *Client) GetAliasHistory(ctx context.Context, name, tag string) ([]*storev1.AliasChange, error) {
+	resp, err := c.StoreServiceClient.GetAliasHistory(ctx, &storev1.GetAliasHistoryRequest{
+		Name: name,
+		Tag:  tag,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alias history: %w", err)
+	}
+
+	return resp.GetChanges(), nil
+}
+
+// ResolveName resolves a "name:tag" reference to a record reference using the ResolveName RPC.
+// The tag is resolved as an alias first, then as a record version, and defaults to "latest".
+func (c *Client) ResolveName(ctx context.Context, ref string) (*storev1.ResolveNameResponse, error) {
+	name, tag, err := corev1.ParseNameReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve name: %w", err)
+	}
+
+	// Cache resolutions by the parsed reference, so that "name" and "name:latest" share an entry
+	key := name + ":" + tag
+
+	var generation uint64
+
+	if c.cache != nil {
+		if resp, ok := c.cache.GetName(key); ok {
+			return resp, nil
+		}
+
+		generation = c.cache.Generation()
+	}
+
+	resp, err := c.StoreServiceClient.ResolveName(ctx, &storev1.ResolveNameRequest{
+		Name: name,
+		Tag:  tag,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve name: %w", err)
+	}
+
+	if c.cache != nil {
+		c.cache.PutName(generation, key, resp)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,137 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package batch defines the result of batch operations that can partially fail.
+//
+// Batch operations report a result for every item instead of failing as a whole,
+// so that callers can tell which items succeeded, failed, or were skipped
+// after an earlier failure when stopping at the first error.
+package batch
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Status is the outcome of a single item of a batch operation.
+type Status string
+
+const (
+	// StatusSucceeded means the item was processed.
+	StatusSucceeded Status = "succeeded"
+	// StatusFailed means processing the item failed.
+	StatusFailed Status = "failed"
+	// StatusSkipped means the item was not processed, e.g. after an earlier failure.
+	StatusSkipped Status = "skipped"
+)
+
+// Item is the result of a single item of a batch operation.
+type Item struct {
+	// Index of the item in the batch, starting at 0.
+	Index int `json:"index"`
+
+	// Name identifies the item for the caller, e.g. the file the record was loaded from.
+	Name string `json:"name,omitempty"`
+
+	// CID of the record, set if known.
+	CID string `json:"cid,omitempty"`
+
+	// Status of the item.
+	Status Status `json:"status"`
+
+	// Code is the gRPC status code of failed items, e.g. "InvalidArgument".
+	Code string `json:"code,omitempty"`
+
+	// Error message of failed items.
+	Error string `json:"error,omitempty"`
+}
+
+// Result collects the per-item results of a batch operation.
+// Items are skipped until they are marked as succeeded or failed.
+type Result struct {
+	Items []*Item `json:"items"`
+}
+
+// NewResult creates a result for a batch of the given size.
+func NewResult(size int) *Result {
+	items := make([]*Item, size)
+	for i := range items {
+		items[i] = &Item{Index: i, Status: StatusSkipped}
+	}
+
+	return &Result{Items: items}
+}
+
+// NewNamedResult creates a result for a batch of named items.
+func NewNamedResult(names []string) *Result {
+	result := NewResult(len(names))
+	for i, name := range names {
+		result.Items[i].Name = name
+	}
+
+	return result
+}
+
+// Succeed marks the item as succeeded.
+func (r *Result) Succeed(index int, cid string) {
+	item := r.Items[index]
+	item.Status = StatusSucceeded
+	item.CID = cid
+	item.Code = ""
+	item.Error = ""
+}
+
+// Fail marks the item as failed with the gRPC status code of the error.
+// Errors without a gRPC status are reported with the Unknown code.
+func (r *Result) Fail(index int, err error) {
+	item := r.Items[index]
+	item.Status = StatusFailed
+	item.Code = status.Code(err).String()
+	item.Error = err.Error()
+}
+
+// FailWithCode marks the item as failed with the given code and message.
+// This is used for per-item errors reported by batch RPCs as messages.
+func (r *Result) FailWithCode(index int, code codes.Code, message string) {
+	item := r.Items[index]
+	item.Status = StatusFailed
+	item.Code = code.String()
+	item.Error = message
+}
+
+// Succeeded returns the number of succeeded items.
+func (r *Result) Succeeded() int { return r.count(StatusSucceeded) }
+
+// Failed returns the number of failed items.
+func (r *Result) Failed() int { return r.count(StatusFailed) }
+
+// Skipped returns the number of skipped items.
+func (r *Result) Skipped() int { return r.count(StatusSkipped) }
+
+// Err returns an error summarizing the failed and skipped items, or nil if all items succeeded.
+func (r *Result) Err() error {
+	failed, skipped := r.Failed(), r.Skipped()
+
+	switch {
+	case failed == 0 && skipped == 0:
+		return nil
+	case skipped == 0:
+		return fmt.Errorf("%d of %d items failed", failed, len(r.Items))
+	default:
+		return fmt.Errorf("%d of %d items failed, %d skipped", failed, len(r.Items), skipped)
+	}
+}
+
+func (r *Result) count(s Status) int {
+	n := 0
+
+	for _, item := range r.Items {
+		if item.Status == s {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,45 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package batch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResult(t *testing.T) {
+	result := NewNamedResult([]string{"a.json", "b.json", "c.json", "d.json"})
+
+	result.Succeed(0, "bafy-a")
+	result.Fail(1, status.Error(codes.InvalidArgument, "invalid record"))
+	result.Fail(2, errors.New("could not read file"))
+
+	assert.Equal(t, 1, result.Succeeded())
+	assert.Equal(t, 2, result.Failed())
+	assert.Equal(t, 1, result.Skipped())
+
+	assert.Equal(t, &Item{Index: 0, Name: "a.json", CID: "bafy-a", Status: StatusSucceeded}, result.Items[0])
+	assert.Equal(t, "InvalidArgument", result.Items[1].Code)
+	assert.Equal(t, "Unknown", result.Items[2].Code)
+	assert.Equal(t, StatusSkipped, result.Items[3].Status)
+
+	require.EqualError(t, result.Err(), "2 of 4 items failed, 1 skipped")
+}
+
+func TestResultErr(t *testing.T) {
+	result := NewResult(2)
+	require.Error(t, result.Err())
+
+	result.Succeed(0, "bafy-a")
+	result.FailWithCode(1, codes.AlreadyExists, "record exists")
+	require.EqualError(t, result.Err(), "1 of 2 items failed")
+
+	result.Succeed(1, "bafy-b")
+	require.NoError(t, result.Err())
+}
@@ -0,0 +1,312 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+)
+
+const (
+	// DefaultCacheSize is the default maximum number of cached records, and of cached name resolutions.
+	DefaultCacheSize = 1024
+
+	invalidationInitialBackoff = time.Second
+	invalidationMaxBackoff     = 30 * time.Second
+)
+
+// CacheConfig configures the in-memory cache of the client, see WithCache.
+type CacheConfig struct {
+	// Size is the maximum number of cached records, and of cached name resolutions.
+	// The least recently used entries are evicted first. Defaults to DefaultCacheSize.
+	Size int
+
+	// TTL is the time after which cached entries expire. Zero keeps entries until they are evicted.
+	TTL time.Duration
+
+	// Invalidate subscribes to the record events of the server and evicts the cached
+	// entries affected by records pushed or deleted, so that long-running clients stay
+	// consistent with the server without guessing a TTL:
+	//   - a deleted record is evicted, together with the names resolved to it
+	//   - a pushed record evicts all name resolutions, as it may be the new target of a name
+	//
+	// Events missed while the subscription is interrupted cannot be replayed,
+	// so the cache is cleared whenever the subscription is (re)established.
+	// Aliases retargeted by other clients emit no events, set a TTL if they change often.
+	Invalidate bool
+}
+
+// WithCache caches the records pulled with Pull and the names resolved with ResolveName in memory.
+// Records are immutable, so cached records only become stale when they are deleted from the server.
+func WithCache(cfg CacheConfig) Option {
+	return func(opts *options) error {
+		if cfg.Size < 0 || cfg.TTL < 0 {
+			return errors.New("cache size and TTL must not be negative")
+		}
+
+		if cfg.Size == 0 {
+			cfg.Size = DefaultCacheSize
+		}
+
+		opts.cache = &cfg
+
+		return nil
+	}
+}
+
+// cache holds the records and name resolutions of a client.
+type cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	records *lru[*corev1.Record]
+	names   *lru[*storev1.ResolveNameResponse]
+
+	// generation changes on every eviction, so that entries fetched
+	// while they were evicted are not added back to the cache
+	generation uint64
+}
+
+func newCache(cfg *CacheConfig) *cache {
+	return &cache{
+		ttl:     cfg.TTL,
+		records: newLRU[*corev1.Record](cfg.Size),
+		names:   newLRU[*storev1.ResolveNameResponse](cfg.Size),
+	}
+}
+
+// Generation returns the current generation of the cache, to pass to the Put methods
+// with the entries fetched afterwards.
+func (c *cache) Generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.generation
+}
+
+// GetRecord returns the cached record with the given CID.
+func (c *cache) GetRecord(cid string) (*corev1.Record, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.records.get(cid, time.Now())
+}
+
+// PutRecord caches a record fetched at the given generation, unless entries were evicted since.
+func (c *cache) PutRecord(generation uint64, cid string, record *corev1.Record) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation == c.generation {
+		c.records.put(cid, record, c.expiry())
+	}
+}
+
+// GetName returns the cached resolution of a name reference.
+func (c *cache) GetName(ref string) (*storev1.ResolveNameResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.names.get(ref, time.Now())
+}
+
+// PutName caches a name resolution fetched at the given generation, unless entries were evicted since.
+func (c *cache) PutName(generation uint64, ref string, resp *storev1.ResolveNameResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation == c.generation {
+		c.names.put(ref, resp, c.expiry())
+	}
+}
+
+// EvictRecord evicts a record, together with the names resolved to it.
+func (c *cache) EvictRecord(cid string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.records.remove(cid)
+	c.names.removeFunc(func(resp *storev1.ResolveNameResponse) bool {
+		return resp.GetRecordRef().GetCid() == cid
+	})
+}
+
+// EvictNames evicts all name resolutions.
+func (c *cache) EvictNames() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.names.clear()
+}
+
+// Clear evicts all entries.
+func (c *cache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.records.clear()
+	c.names.clear()
+}
+
+// HandleEvent evicts the entries affected by a record event.
+func (c *cache) HandleEvent(event *eventsv1.Event) {
+	switch event.GetType() {
+	case eventsv1.EventType_EVENT_TYPE_RECORD_DELETED:
+		c.EvictRecord(event.GetResourceId())
+	case eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED:
+		c.EvictNames()
+	default:
+	}
+}
+
+func (c *cache) expiry() time.Time {
+	if c.ttl <= 0 {
+		return time.Time{}
+	}
+
+	return time.Now().Add(c.ttl)
+}
+
+// invalidateCache evicts the cached entries affected by the record events of the server until ctx is done.
+// The subscription is re-established with exponential backoff when it fails.
+func (c *Client) invalidateCache(ctx context.Context) {
+	backoff := invalidationInitialBackoff
+
+	for {
+		err := c.listenInvalidations(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		c.logger().Warn("Cache invalidation subscription interrupted, retrying", "error", err, "backoff", backoff)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, invalidationMaxBackoff) //nolint:mnd
+	}
+}
+
+// listenInvalidations subscribes to the record events of the server and applies them to the cache.
+func (c *Client) listenInvalidations(ctx context.Context) error {
+	stream, err := c.EventServiceClient.Listen(ctx, &eventsv1.ListenRequest{
+		EventTypes: []eventsv1.EventType{
+			eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED,
+			eventsv1.EventType_EVENT_TYPE_RECORD_DELETED,
+		},
+	})
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	// Entries may have become stale while no subscription was active
+	c.cache.Clear()
+	c.logger().Debug("Subscribed to cache invalidation events")
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return errors.New("event stream closed by server")
+		}
+
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		c.cache.HandleEvent(resp.GetEvent())
+	}
+}
+
+// lru is a size-bounded map evicting its least recently used entries first.
+type lru[V any] struct {
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type lruEntry[V any] struct {
+	key     string
+	value   V
+	expires time.Time
+}
+
+func newLRU[V any](size int) *lru[V] {
+	return &lru[V]{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (l *lru[V]) get(key string, now time.Time) (V, bool) {
+	var zero V
+
+	elem, ok := l.entries[key]
+	if !ok {
+		return zero, false
+	}
+
+	entry := elem.Value.(*lruEntry[V]) //nolint:forcetypeassert
+	if !entry.expires.IsZero() && now.After(entry.expires) {
+		l.order.Remove(elem)
+		delete(l.entries, key)
+
+		return zero, false
+	}
+
+	l.order.MoveToFront(elem)
+
+	return entry.value, true
+}
+
+func (l *lru[V]) put(key string, value V, expires time.Time) {
+	if elem, ok := l.entries[key]; ok {
+		elem.Value = &lruEntry[V]{key: key, value: value, expires: expires}
+		l.order.MoveToFront(elem)
+
+		return
+	}
+
+	l.entries[key] = l.order.PushFront(&lruEntry[V]{key: key, value: value, expires: expires})
+
+	for l.order.Len() > l.size {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruEntry[V]).key) //nolint:forcetypeassert
+	}
+}
+
+func (l *lru[V]) remove(key string) {
+	if elem, ok := l.entries[key]; ok {
+		l.order.Remove(elem)
+		delete(l.entries, key)
+	}
+}
+
+func (l *lru[V]) removeFunc(match func(V) bool) {
+	for key, elem := range l.entries {
+		if match(elem.Value.(*lruEntry[V]).value) { //nolint:forcetypeassert
+			l.order.Remove(elem)
+			delete(l.entries, key)
+		}
+	}
+}
+
+func (l *lru[V]) clear() {
+	l.order.Init()
+	clear(l.entries)
+}
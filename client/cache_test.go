@@ -0,0 +1,186 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"google.golang.org/grpc"
+)
+
+// mockEventServiceClient serves the events sent on its channel to a single listener.
+type mockEventServiceClient struct {
+	eventsv1.EventServiceClient
+
+	events     chan *eventsv1.Event
+	subscribed chan struct{}
+}
+
+func (m *mockEventServiceClient) Listen(ctx context.Context, _ *eventsv1.ListenRequest, _ ...grpc.CallOption) (eventsv1.EventService_ListenClient, error) {
+	m.subscribed <- struct{}{}
+
+	return &mockListenStream{ctx: ctx, events: m.events}, nil
+}
+
+type mockListenStream struct {
+	grpc.ClientStream
+
+	ctx    context.Context //nolint:containedctx
+	events chan *eventsv1.Event
+}
+
+func (s *mockListenStream) Recv() (*eventsv1.ListenResponse, error) {
+	select {
+	case event := <-s.events:
+		return &eventsv1.ListenResponse{Event: event}, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func nameResolution(cid string) *storev1.ResolveNameResponse {
+	return &storev1.ResolveNameResponse{RecordRef: &corev1.RecordRef{Cid: cid}}
+}
+
+func TestCacheLRU(t *testing.T) {
+	c := newCache(&CacheConfig{Size: 2})
+
+	c.PutRecord(c.Generation(), "a", &corev1.Record{})
+	c.PutRecord(c.Generation(), "b", &corev1.Record{})
+
+	// Using a makes b the least recently used record
+	if _, ok := c.GetRecord("a"); !ok {
+		t.Fatal("Expected record a to be cached")
+	}
+
+	c.PutRecord(c.Generation(), "c", &corev1.Record{})
+
+	if _, ok := c.GetRecord("b"); ok {
+		t.Error("Expected least recently used record b to be evicted")
+	}
+
+	for _, cid := range []string{"a", "c"} {
+		if _, ok := c.GetRecord(cid); !ok {
+			t.Errorf("Expected record %s to be cached", cid)
+		}
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	c := newCache(&CacheConfig{Size: 2, TTL: time.Minute})
+
+	c.PutName(c.Generation(), "agent:latest", nameResolution("a"))
+
+	if _, ok := c.names.get("agent:latest", time.Now()); !ok {
+		t.Fatal("Expected name to be cached")
+	}
+
+	if _, ok := c.names.get("agent:latest", time.Now().Add(2*time.Minute)); ok {
+		t.Error("Expected name to expire")
+	}
+}
+
+func TestCacheHandleEvent(t *testing.T) {
+	c := newCache(&CacheConfig{Size: 10})
+
+	c.PutRecord(c.Generation(), "a", &corev1.Record{})
+	c.PutRecord(c.Generation(), "b", &corev1.Record{})
+	c.PutName(c.Generation(), "agent:latest", nameResolution("a"))
+	c.PutName(c.Generation(), "other:latest", nameResolution("b"))
+
+	// Deleting a record evicts it and the names resolved to it
+	generation := c.Generation()
+	c.HandleEvent(&eventsv1.Event{Type: eventsv1.EventType_EVENT_TYPE_RECORD_DELETED, ResourceId: "a"})
+
+	if _, ok := c.GetRecord("a"); ok {
+		t.Error("Expected deleted record to be evicted")
+	}
+
+	if _, ok := c.GetName("agent:latest"); ok {
+		t.Error("Expected name of deleted record to be evicted")
+	}
+
+	if _, ok := c.GetRecord("b"); !ok {
+		t.Error("Expected other record to stay cached")
+	}
+
+	if _, ok := c.GetName("other:latest"); !ok {
+		t.Error("Expected name of other record to stay cached")
+	}
+
+	// Records fetched before the eviction are not added back
+	c.PutRecord(generation, "a", &corev1.Record{})
+
+	if _, ok := c.GetRecord("a"); ok {
+		t.Error("Expected record fetched before its eviction not to be cached")
+	}
+
+	// Pushing a record evicts all names, as it may be the new target of any of them
+	c.HandleEvent(&eventsv1.Event{Type: eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, ResourceId: "c"})
+
+	if _, ok := c.GetName("other:latest"); ok {
+		t.Error("Expected names to be evicted when a record is pushed")
+	}
+
+	if _, ok := c.GetRecord("b"); !ok {
+		t.Error("Expected records to stay cached when a record is pushed")
+	}
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	events := &mockEventServiceClient{
+		events:     make(chan *eventsv1.Event),
+		subscribed: make(chan struct{}, 1),
+	}
+
+	client := &Client{
+		EventServiceClient: events,
+		cache:              newCache(&CacheConfig{Size: 10, Invalidate: true}),
+		log:                nopLogger{},
+	}
+
+	// Entries cached before the subscription are cleared once it is established
+	client.cache.PutRecord(client.cache.Generation(), "stale", &corev1.Record{})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	go client.invalidateCache(ctx)
+
+	<-events.subscribed
+
+	waitFor(t, func() bool {
+		_, ok := client.cache.GetRecord("stale")
+
+		return !ok
+	})
+
+	client.cache.PutRecord(client.cache.Generation(), "a", &corev1.Record{})
+
+	events.events <- &eventsv1.Event{Type: eventsv1.EventType_EVENT_TYPE_RECORD_DELETED, ResourceId: "a"}
+
+	waitFor(t, func() bool {
+		_, ok := client.cache.GetRecord("a")
+
+		return !ok
+	})
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(testContextTimeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for condition")
+		}
+
+		time.Sleep(testCleanupWait)
+	}
+}
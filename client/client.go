@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	eventsv1 "github.com/agntcy/dir/api/events/v1"
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
 	searchv1 "github.com/agntcy/dir/api/search/v1"
@@ -20,10 +22,14 @@ import (
 type Client struct {
 	storev1.StoreServiceClient
 	routingv1.RoutingServiceClient
+	routingv1.PublicationServiceClient
 	searchv1.SearchServiceClient
 	storev1.SyncServiceClient
 	signv1.SignServiceClient
 	eventsv1.EventServiceClient
+	corev1.InfoServiceClient
+	corev1.OperationServiceClient
+	corev1.TokenServiceClient
 
 	config     *Config
 	authClient *workloadapi.Client
@@ -33,6 +39,21 @@ type Client struct {
 	bundleSrc io.Closer
 	x509Src   io.Closer
 	jwtSource io.Closer
+
+	// Server info cached for capability checks
+	serverInfoMu sync.Mutex
+	serverInfo   *corev1.GetServerInfoResponse
+
+	// Check records locally before pushing them
+	prevalidatePush bool
+
+	// Logs of the client, and cancellation of the connection state logging
+	log           Logger
+	stopLogStates context.CancelFunc
+
+	// Cache of records and name resolutions, and cancellation of its invalidation
+	cache            *cache
+	stopInvalidation context.CancelFunc
 }
 
 func New(ctx context.Context, opts ...Option) (*Client, error) {
@@ -47,31 +68,106 @@ func New(ctx context.Context, opts ...Option) (*Client, error) {
 		}
 	}
 
+	dialOpts := options.authOpts
+
+	// Attach the client instance ID to all requests if set
+	clientInstanceID := options.clientInstanceID
+	if clientInstanceID == "" {
+		clientInstanceID = options.config.ClientInstanceID
+	}
+
+	if clientInstanceID != "" {
+		if err := corev1.ValidateClientInstanceID(clientInstanceID); err != nil {
+			return nil, fmt.Errorf("invalid client instance ID: %w", err)
+		}
+
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(newClientInstanceCredentials(clientInstanceID)))
+	}
+
+	// Attach the user agent and static metadata to all requests if set
+	if options.userAgent != "" {
+		dialOpts = append(dialOpts, grpc.WithUserAgent(options.userAgent))
+	}
+
+	if len(options.metadata) > 0 {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(newMetadataCredentials(options.metadata)))
+	}
+
+	logger := options.logger
+	if logger == nil {
+		logger = defaultLogger
+	}
+
+	// Retry calls failing with a transient error if enabled
+	if options.retryPolicy != nil {
+		dialOpts = append(dialOpts, options.retryPolicy.dialOptions(logger)...)
+	} else {
+		dialOpts = append(dialOpts, retryDialOptions(options.config.MaxRetries)...)
+	}
+
 	// Create gRPC client connection
-	conn, err := grpc.NewClient(options.config.ServerAddress, options.authOpts...)
+	conn, err := grpc.NewClient(options.config.ServerAddress, dialOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create gRPC client: %w", err)
 	}
 
-	return &Client{
-		StoreServiceClient:   storev1.NewStoreServiceClient(conn),
-		RoutingServiceClient: routingv1.NewRoutingServiceClient(conn),
-		SearchServiceClient:  searchv1.NewSearchServiceClient(conn),
-		SyncServiceClient:    storev1.NewSyncServiceClient(conn),
-		SignServiceClient:    signv1.NewSignServiceClient(conn),
-		EventServiceClient:   eventsv1.NewEventServiceClient(conn),
-		config:               options.config,
-		authClient:           options.authClient,
-		conn:                 conn,
-		bundleSrc:            options.bundleSrc,
-		x509Src:              options.x509Src,
-		jwtSource:            options.jwtSource,
-	}, nil
+	logger.Debug("Created client", "server", options.config.ServerAddress, "auth_mode", options.config.AuthMode,
+		"max_retries", options.config.MaxRetries)
+
+	// Log connection state transitions until the client is closed.
+	// Note: Use context.Background() because logging must last for the entire client lifetime.
+	logStatesCtx, stopLogStates := context.WithCancel(context.Background()) //nolint:contextcheck
+
+	client := &Client{
+		StoreServiceClient:       storev1.NewStoreServiceClient(conn),
+		RoutingServiceClient:     routingv1.NewRoutingServiceClient(conn),
+		PublicationServiceClient: routingv1.NewPublicationServiceClient(conn),
+		SearchServiceClient:      searchv1.NewSearchServiceClient(conn),
+		SyncServiceClient:        storev1.NewSyncServiceClient(conn),
+		SignServiceClient:        signv1.NewSignServiceClient(conn),
+		EventServiceClient:       eventsv1.NewEventServiceClient(conn),
+		InfoServiceClient:        corev1.NewInfoServiceClient(conn),
+		OperationServiceClient:   corev1.NewOperationServiceClient(conn),
+		TokenServiceClient:       corev1.NewTokenServiceClient(conn),
+		config:                   options.config,
+		authClient:               options.authClient,
+		conn:                     conn,
+		bundleSrc:                options.bundleSrc,
+		x509Src:                  options.x509Src,
+		jwtSource:                options.jwtSource,
+		prevalidatePush:          options.prevalidatePush,
+		log:                      logger,
+		stopLogStates:            stopLogStates,
+	}
+
+	go client.logConnectionStates(logStatesCtx)
+
+	if options.cache != nil {
+		client.cache = newCache(options.cache)
+
+		// Note: Use context.Background() because invalidation must last for the entire client lifetime.
+		if options.cache.Invalidate {
+			invalidationCtx, stopInvalidation := context.WithCancel(context.Background()) //nolint:contextcheck
+			client.stopInvalidation = stopInvalidation
+
+			go client.invalidateCache(invalidationCtx)
+		}
+	}
+
+	return client, nil
 }
 
 func (c *Client) Close() error {
 	var errs []error
 
+	if c.stopLogStates != nil {
+		c.stopLogStates()
+	}
+
+	if c.stopInvalidation != nil {
+		c.stopInvalidation()
+	}
+
 	// Close SPIFFE sources first (they may be using authClient)
 	if c.jwtSource != nil {
 		if err := c.jwtSource.Close(); err != nil {
@@ -106,8 +202,12 @@ func (c *Client) Close() error {
 	}
 
 	if len(errs) > 0 {
+		c.logger().Debug("Closed client with errors", "errors", errs)
+
 		return fmt.Errorf("client close errors: %v", errs)
 	}
 
+	c.logger().Debug("Closed client")
+
 	return nil
 }
@@ -16,6 +16,7 @@ const (
 
 	DefaultServerAddress = "0.0.0.0:8888"
 	DefaultTlsSkipVerify = false
+	DefaultMaxRetries    = 0
 )
 
 var DefaultConfig = Config{
@@ -32,6 +33,13 @@ type Config struct {
 	SpiffeToken      string `json:"spiffe_token,omitempty"       mapstructure:"spiffe_token"`
 	AuthMode         string `json:"auth_mode,omitempty"          mapstructure:"auth_mode"`
 	JWTAudience      string `json:"jwt_audience,omitempty"       mapstructure:"jwt_audience"`
+	ClientInstanceID string `json:"client_instance_id,omitempty" mapstructure:"client_instance_id"`
+
+	// APIToken is the API token sent as bearer token in the api-token authentication mode.
+	APIToken string `json:"api_token,omitempty" mapstructure:"api_token"`
+
+	// MaxRetries is the maximum number of times calls failing with a transient error are retried, 0 to disable retries.
+	MaxRetries int `json:"max_retries,omitempty" mapstructure:"max_retries"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -71,6 +79,15 @@ func LoadConfig() (*Config, error) {
 	_ = v.BindEnv("tls_ca_file")
 	v.SetDefault("tls_ca_file", "")
 
+	_ = v.BindEnv("client_instance_id")
+	v.SetDefault("client_instance_id", "")
+
+	_ = v.BindEnv("api_token")
+	v.SetDefault("api_token", "")
+
+	_ = v.BindEnv("max_retries")
+	v.SetDefault("max_retries", DefaultMaxRetries)
+
 	// Load configuration into struct
 	decodeHooks := mapstructure.ComposeDecodeHookFunc(
 		mapstructure.TextUnmarshallerHookFunc(),
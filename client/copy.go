@@ -0,0 +1,215 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+)
+
+// CopyResult describes a record copied from another Directory.
+type CopyResult struct {
+	// RecordRef is the reference of the copied record, the same in both Directories.
+	RecordRef *corev1.RecordRef `json:"record_ref"`
+
+	// Referrers is the number of referrers copied with the record, by referrer type.
+	Referrers map[string]int `json:"referrers,omitempty"`
+
+	// Signed reports whether the record was copied with signatures.
+	Signed bool `json:"signed"`
+
+	// Verified reports whether the signatures of the record verify on the destination.
+	Verified bool `json:"verified"`
+
+	// Revoked reports whether the signatures of the record are revoked.
+	Revoked bool `json:"revoked,omitempty"`
+
+	// VerificationError explains why the signatures of the record did not verify.
+	VerificationError string `json:"verification_error,omitempty"`
+}
+
+// CopyRecord copies a record from the source Directory to the Directory of the client,
+// together with its signatures, public keys, attestations, revocations and annotations.
+// The record is copied unchanged, so its CID and the annotations it carries are preserved.
+//
+// The CID of the pulled record is checked before anything is pushed. Signed records are
+// stored together with their first signature, public key and attestations, so that they
+// never become visible without them, and their signatures are verified once copied.
+// Verification snapshots are specific to the Directory that verified the record and are not copied.
+func (c *Client) CopyRecord(ctx context.Context, source *Client, recordRef *corev1.RecordRef) (*CopyResult, error) {
+	record, err := source.Pull(ctx, recordRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull record from source: %w", err)
+	}
+
+	// The CID is derived from the content, so a matching CID proves the record is intact
+	if cid := record.GetCid(); cid != recordRef.GetCid() {
+		return nil, fmt.Errorf("source returned record %s instead of %s", cid, recordRef.GetCid())
+	}
+
+	referrers, err := source.pullAllReferrers(ctx, recordRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull referrers from source: %w", err)
+	}
+
+	var signatures, publicKeys, attestations, others []*corev1.RecordReferrer
+
+	for _, referrer := range referrers {
+		switch referrer.GetType() {
+		case corev1.SignatureReferrerType:
+			signatures = append(signatures, referrer)
+		case corev1.PublicKeyReferrerType:
+			publicKeys = append(publicKeys, referrer)
+		case corev1.AttestationReferrerType:
+			attestations = append(attestations, referrer)
+		case corev1.VerificationSnapshotReferrerType:
+			continue
+		default:
+			others = append(others, referrer)
+		}
+	}
+
+	var (
+		pushed    *corev1.RecordRef
+		remaining []*corev1.RecordReferrer
+	)
+
+	if len(signatures) > 0 {
+		bundle := &storev1.PushBundleRequest{
+			Record:       record,
+			Signature:    signatures[0],
+			Attestations: attestations,
+		}
+
+		if len(publicKeys) > 0 {
+			bundle.PublicKey = publicKeys[0]
+			publicKeys = publicKeys[1:]
+		}
+
+		pushed, err = c.PushBundle(ctx, bundle)
+		remaining = slices.Concat(publicKeys, signatures[1:], others)
+	} else {
+		pushed, err = c.Push(ctx, record)
+		remaining = slices.Concat(attestations, others)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to push record to destination: %w", err)
+	}
+
+	if pushed.GetCid() != recordRef.GetCid() {
+		return nil, fmt.Errorf("destination stored record %s instead of %s", pushed.GetCid(), recordRef.GetCid())
+	}
+
+	if err := c.pushReferrers(ctx, pushed, remaining); err != nil {
+		return nil, err
+	}
+
+	result := &CopyResult{
+		RecordRef: pushed,
+		Referrers: make(map[string]int),
+		Signed:    len(signatures) > 0,
+	}
+
+	for _, referrer := range referrers {
+		if referrer.GetType() != corev1.VerificationSnapshotReferrerType {
+			result.Referrers[referrer.GetType()]++
+		}
+	}
+
+	if !result.Signed {
+		return result, nil
+	}
+
+	verification, err := c.Verify(ctx, &signv1.VerifyRequest{RecordRef: pushed})
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify copied record: %w", err)
+	}
+
+	result.Verified = verification.GetSuccess()
+	result.Revoked = verification.GetRevoked()
+	result.VerificationError = verification.GetErrorMessage()
+
+	return result, nil
+}
+
+// pullAllReferrers retrieves the referrers of all types of a record.
+// Unlike PullReferrer, it fails if the referrers cannot be received completely.
+func (c *Client) pullAllReferrers(ctx context.Context, recordRef *corev1.RecordRef) ([]*corev1.RecordReferrer, error) {
+	stream, err := c.StoreServiceClient.PullReferrer(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull referrer stream: %w", err)
+	}
+
+	if err := stream.Send(&storev1.PullReferrerRequest{RecordRef: recordRef}); err != nil {
+		return nil, fmt.Errorf("failed to send pull referrer request: %w", err)
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return nil, fmt.Errorf("failed to close send stream: %w", err)
+	}
+
+	var referrers []*corev1.RecordReferrer
+
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return referrers, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to receive pull referrer response: %w", err)
+		}
+
+		// Responses without referrer are sent by stores that cannot list referrers
+		if response.GetReferrer() == nil {
+			c.logger().Warn("Source did not list the referrers of the record", "cid", recordRef.GetCid())
+
+			continue
+		}
+
+		referrers = append(referrers, response.GetReferrer())
+	}
+}
+
+// pushReferrers stores referrers of a record over a single stream, in order.
+// Unlike PushReferrer, it fails if any referrer is not stored.
+func (c *Client) pushReferrers(ctx context.Context, recordRef *corev1.RecordRef, referrers []*corev1.RecordReferrer) error {
+	if len(referrers) == 0 {
+		return nil
+	}
+
+	stream, err := c.StoreServiceClient.PushReferrer(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create push referrer stream: %w", err)
+	}
+
+	for _, referrer := range referrers {
+		if err := stream.Send(&storev1.PushReferrerRequest{RecordRef: recordRef, Referrer: referrer}); err != nil {
+			return fmt.Errorf("failed to send push referrer request: %w", err)
+		}
+
+		response, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("failed to receive push referrer response: %w", err)
+		}
+
+		if !response.GetSuccess() {
+			return fmt.Errorf("failed to push %s referrer: %s", referrer.GetType(), response.GetErrorMessage())
+		}
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return fmt.Errorf("failed to close send stream: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,339 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// copyStoreService is an in-memory store of records and their referrers.
+type copyStoreService struct {
+	storev1.UnimplementedStoreServiceServer
+
+	mu        sync.Mutex
+	records   map[string]*corev1.Record
+	referrers map[string][]*corev1.RecordReferrer
+	bundles   int
+}
+
+func newCopyStoreService() *copyStoreService {
+	return &copyStoreService{
+		records:   make(map[string]*corev1.Record),
+		referrers: make(map[string][]*corev1.RecordReferrer),
+	}
+}
+
+func (s *copyStoreService) Push(stream storev1.StoreService_PushServer) error {
+	for {
+		record, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		s.mu.Lock()
+		s.records[record.GetCid()] = record
+		s.mu.Unlock()
+
+		if err := stream.Send(&corev1.RecordRef{Cid: record.GetCid()}); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+}
+
+func (s *copyStoreService) Pull(stream storev1.StoreService_PullServer) error {
+	for {
+		ref, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		s.mu.Lock()
+		record, ok := s.records[ref.GetCid()]
+		s.mu.Unlock()
+
+		if !ok {
+			return status.Errorf(codes.NotFound, "record %s not found", ref.GetCid())
+		}
+
+		if err := stream.Send(record); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+}
+
+func (s *copyStoreService) PushBundle(_ context.Context, req *storev1.PushBundleRequest) (*storev1.PushBundleResponse, error) {
+	cid := req.GetRecord().GetCid()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.bundles++
+	s.records[cid] = req.GetRecord()
+	s.referrers[cid] = append(s.referrers[cid], req.GetSignature())
+
+	if req.GetPublicKey() != nil {
+		s.referrers[cid] = append(s.referrers[cid], req.GetPublicKey())
+	}
+
+	s.referrers[cid] = append(s.referrers[cid], req.GetAttestations()...)
+
+	return &storev1.PushBundleResponse{RecordRef: &corev1.RecordRef{Cid: cid}}, nil
+}
+
+func (s *copyStoreService) PushReferrer(stream storev1.StoreService_PushReferrerServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		s.mu.Lock()
+		s.referrers[req.GetRecordRef().GetCid()] = append(s.referrers[req.GetRecordRef().GetCid()], req.GetReferrer())
+		s.mu.Unlock()
+
+		if err := stream.Send(&storev1.PushReferrerResponse{Success: true}); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+}
+
+func (s *copyStoreService) PullReferrer(stream storev1.StoreService_PullReferrerServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		s.mu.Lock()
+		referrers := s.referrers[req.GetRecordRef().GetCid()]
+		s.mu.Unlock()
+
+		for _, referrer := range referrers {
+			if err := stream.Send(&storev1.PullReferrerResponse{Referrer: referrer}); err != nil {
+				return err //nolint:wrapcheck
+			}
+		}
+	}
+}
+
+func (s *copyStoreService) referrerTypes(cid string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	types := make([]string, 0, len(s.referrers[cid]))
+	for _, referrer := range s.referrers[cid] {
+		types = append(types, referrer.GetType())
+	}
+
+	return types
+}
+
+// copySignService reports records as verified.
+type copySignService struct {
+	signv1.UnimplementedSignServiceServer
+}
+
+func (copySignService) Verify(context.Context, *signv1.VerifyRequest) (*signv1.VerifyResponse, error) {
+	return &signv1.VerifyResponse{Success: true}, nil
+}
+
+func newCopyTestClient(t *testing.T, store *copyStoreService) *Client {
+	t.Helper()
+
+	lis := bufconn.Listen(bufSize)
+	server := grpc.NewServer()
+	storev1.RegisterStoreServiceServer(server, store)
+	signv1.RegisterSignServiceServer(server, copySignService{})
+
+	go func() { _ = server.Serve(lis) }()
+
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(testServerBufnet,
+		grpc.WithContextDialer(bufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return &Client{
+		StoreServiceClient: storev1.NewStoreServiceClient(conn),
+		SignServiceClient:  signv1.NewSignServiceClient(conn),
+		log:                nopLogger{},
+	}
+}
+
+func newCopyTestRecord(t *testing.T, name string) *corev1.Record {
+	t.Helper()
+
+	data, err := structpb.NewStruct(map[string]any{
+		"name":           name,
+		"version":        "v1.0.0",
+		"schema_version": "0.8.0",
+		"annotations":    map[string]any{"origin": "https://dir.example.org"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create record data: %v", err)
+	}
+
+	return &corev1.Record{Data: data}
+}
+
+func newCopyTestReferrer(t *testing.T, referrerType, value string) *corev1.RecordReferrer {
+	t.Helper()
+
+	data, err := structpb.NewStruct(map[string]any{"value": value})
+	if err != nil {
+		t.Fatalf("Failed to create referrer data: %v", err)
+	}
+
+	return &corev1.RecordReferrer{Type: referrerType, Data: data}
+}
+
+func TestCopyRecord(t *testing.T) {
+	record := newCopyTestRecord(t, "agent")
+	cid := record.GetCid()
+
+	sourceStore := newCopyStoreService()
+	sourceStore.records[cid] = record
+	sourceStore.referrers[cid] = []*corev1.RecordReferrer{
+		newCopyTestReferrer(t, corev1.SignatureReferrerType, "signature-1"),
+		newCopyTestReferrer(t, corev1.PublicKeyReferrerType, "key-1"),
+		newCopyTestReferrer(t, corev1.AttestationReferrerType, "provenance"),
+		newCopyTestReferrer(t, corev1.SignatureReferrerType, "signature-2"),
+		newCopyTestReferrer(t, corev1.AnnotationsReferrerType, "annotations"),
+		newCopyTestReferrer(t, corev1.VerificationSnapshotReferrerType, "snapshot"),
+	}
+
+	destinationStore := newCopyStoreService()
+
+	source := newCopyTestClient(t, sourceStore)
+	destination := newCopyTestClient(t, destinationStore)
+
+	result, err := destination.CopyRecord(t.Context(), source, &corev1.RecordRef{Cid: cid})
+	if err != nil {
+		t.Fatalf("Failed to copy record: %v", err)
+	}
+
+	if result.RecordRef.GetCid() != cid || !result.Signed || !result.Verified {
+		t.Errorf("Unexpected copy result: %+v", result)
+	}
+
+	if result.Referrers[corev1.SignatureReferrerType] != 2 || result.Referrers[corev1.VerificationSnapshotReferrerType] != 0 {
+		t.Errorf("Unexpected copied referrers: %v", result.Referrers)
+	}
+
+	// The record is stored with its first signature, public key and attestations at once
+	if destinationStore.bundles != 1 {
+		t.Errorf("Expected the record to be pushed as a bundle, got %d bundles", destinationStore.bundles)
+	}
+
+	if got := destinationStore.records[cid].GetCid(); got != cid {
+		t.Errorf("Expected copied record %s, got %s", cid, got)
+	}
+
+	want := []string{
+		corev1.SignatureReferrerType,
+		corev1.PublicKeyReferrerType,
+		corev1.AttestationReferrerType,
+		corev1.SignatureReferrerType,
+		corev1.AnnotationsReferrerType,
+	}
+
+	got := destinationStore.referrerTypes(cid)
+	if len(got) != len(want) {
+		t.Fatalf("Expected referrers %v, got %v", want, got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected referrers %v, got %v", want, got)
+
+			break
+		}
+	}
+}
+
+func TestCopyRecord_Unsigned(t *testing.T) {
+	record := newCopyTestRecord(t, "agent")
+	cid := record.GetCid()
+
+	sourceStore := newCopyStoreService()
+	sourceStore.records[cid] = record
+	sourceStore.referrers[cid] = []*corev1.RecordReferrer{
+		newCopyTestReferrer(t, corev1.AttestationReferrerType, "provenance"),
+	}
+
+	destinationStore := newCopyStoreService()
+
+	result, err := newCopyTestClient(t, destinationStore).CopyRecord(t.Context(), newCopyTestClient(t, sourceStore), &corev1.RecordRef{Cid: cid})
+	if err != nil {
+		t.Fatalf("Failed to copy record: %v", err)
+	}
+
+	if result.Signed || result.Verified {
+		t.Errorf("Expected unsigned record, got %+v", result)
+	}
+
+	if destinationStore.bundles != 0 {
+		t.Errorf("Expected unsigned record to be pushed without bundle")
+	}
+
+	if got := destinationStore.referrerTypes(cid); len(got) != 1 || got[0] != corev1.AttestationReferrerType {
+		t.Errorf("Expected the attestation to be copied, got %v", got)
+	}
+}
+
+func TestCopyRecord_ContentMismatch(t *testing.T) {
+	record := newCopyTestRecord(t, "agent")
+	cid := record.GetCid()
+
+	// The source serves another record under the requested CID
+	sourceStore := newCopyStoreService()
+	sourceStore.records[cid] = newCopyTestRecord(t, "tampered")
+
+	destinationStore := newCopyStoreService()
+
+	_, err := newCopyTestClient(t, destinationStore).CopyRecord(t.Context(), newCopyTestClient(t, sourceStore), &corev1.RecordRef{Cid: cid})
+	if err == nil {
+		t.Fatal("Expected copy of a tampered record to fail")
+	}
+
+	if len(destinationStore.records) != 0 {
+		t.Errorf("Expected nothing to be pushed, got %d records", len(destinationStore.records))
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"google.golang.org/grpc/metadata"
+)
+
+// WithEmbargoUntil returns a context placing the records pushed with it under embargo until the given time.
+// Until the embargo lifts, the server hides the records from the searches of other identities
+// and holds back their publication, so that new records can be launched at a coordinated time.
+// Embargoes can only be set when records are first pushed.
+func WithEmbargoUntil(ctx context.Context, until time.Time) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, corev1.EmbargoUntilMetadataKey, until.UTC().Format(time.RFC3339))
+}
@@ -64,3 +64,81 @@ func (c *Client) ListenStream(ctx context.Context, req *eventsv1.ListenRequest)
 
 	return result, nil
 }
+
+// WatchNameStream streams the latest record of each name matching the request.
+//
+// The current latest record of every matching name is received first, followed
+// by a response whenever the latest record of a name changes. A response with an
+// empty CID means that all records with the name were deleted.
+//
+// Example - Follow new versions of an agent:
+//
+//	result, err := client.WatchNameStream(ctx, &eventsv1.WatchNameRequest{
+//	    Name: "directory.agntcy.org/example/agent",
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//
+//	for {
+//	    select {
+//	    case resp := <-result.ResCh():
+//	        fmt.Printf("Latest: %s %s (%s)\n", resp.GetName(), resp.GetVersion(), resp.GetCid())
+//	    case err := <-result.ErrCh():
+//	        return fmt.Errorf("stream error: %w", err)
+//	    case <-result.DoneCh():
+//	        return nil
+//	    case <-ctx.Done():
+//	        return ctx.Err()
+//	    }
+//	}
+func (c *Client) WatchNameStream(ctx context.Context, req *eventsv1.WatchNameRequest) (streaming.StreamResult[eventsv1.WatchNameResponse], error) {
+	stream, err := c.WatchName(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create name watch stream: %w", err)
+	}
+
+	result, err := streaming.ProcessServerStream(ctx, stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process name watch stream: %w", err)
+	}
+
+	return result, nil
+}
+
+// RegisterRecordWebhook registers a URL notified of the events affecting a stored record.
+// If no event types are given, the URL is notified of all record events. If a secret is
+// given, notifications are signed with it (see the X-Dir-Signature header).
+func (c *Client) RegisterRecordWebhook(ctx context.Context, req *eventsv1.RegisterRecordWebhookRequest) (*eventsv1.RecordWebhook, error) {
+	resp, err := c.EventServiceClient.RegisterRecordWebhook(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register record webhook: %w", err)
+	}
+
+	return resp.GetWebhook(), nil
+}
+
+// ListRecordWebhooks lists the record webhooks owned by the caller,
+// optionally only those of the record with the given CID.
+func (c *Client) ListRecordWebhooks(ctx context.Context, cid string) ([]*eventsv1.RecordWebhook, error) {
+	resp, err := c.EventServiceClient.ListRecordWebhooks(ctx, &eventsv1.ListRecordWebhooksRequest{
+		Cid: cid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list record webhooks: %w", err)
+	}
+
+	return resp.GetWebhooks(), nil
+}
+
+// DeleteRecordWebhook deletes a record webhook owned by the caller.
+func (c *Client) DeleteRecordWebhook(ctx context.Context, webhookID string) error {
+	_, err := c.EventServiceClient.DeleteRecordWebhook(ctx, &eventsv1.DeleteRecordWebhookRequest{
+		WebhookId: webhookID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete record webhook: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/connectivity"
+)
+
+// ConnectionCallbacks are called by WatchConnection on connection state transitions.
+// Callbacks are called sequentially from a single goroutine, so they should not block.
+// Unset callbacks are ignored.
+type ConnectionCallbacks struct {
+	// OnStateChange is called on every transition with the previous and the new state.
+	OnStateChange func(from, to connectivity.State)
+
+	// OnReady is called when the connection becomes ready, including after an outage.
+	OnReady func()
+
+	// OnTransientFailure is called when the connection fails.
+	// gRPC keeps reconnecting in the background with exponential backoff.
+	OnTransientFailure func()
+}
+
+// ConnectionState returns the current state of the connection to the server.
+func (c *Client) ConnectionState() connectivity.State {
+	if c.conn == nil {
+		return connectivity.Shutdown
+	}
+
+	return c.conn.GetState()
+}
+
+// WatchConnection calls the callbacks on every connection state transition, starting from the current state.
+// It returns immediately; watching stops when ctx is done or the client is closed.
+// Watching does not open the connection: a new client stays idle until its first request or AwaitReady.
+func (c *Client) WatchConnection(ctx context.Context, callbacks ConnectionCallbacks) error {
+	if c.conn == nil {
+		return errors.New("client is not connected")
+	}
+
+	go func() {
+		state := c.conn.GetState()
+
+		for state != connectivity.Shutdown {
+			if !c.conn.WaitForStateChange(ctx, state) {
+				return
+			}
+
+			next := c.conn.GetState()
+			callbacks.notify(state, next)
+			state = next
+		}
+	}()
+
+	return nil
+}
+
+// AwaitReady opens the connection if needed and waits until it is ready.
+// Transient failures are retried until ctx is done, and an error is returned if the client is closed.
+func (c *Client) AwaitReady(ctx context.Context) error {
+	if c.conn == nil {
+		return errors.New("client is not connected")
+	}
+
+	for {
+		state := c.conn.GetState()
+
+		switch state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.Shutdown:
+			return errors.New("client is closed")
+		case connectivity.Idle:
+			c.conn.Connect()
+		case connectivity.Connecting, connectivity.TransientFailure:
+		}
+
+		c.logger().Debug("Waiting for connection to be ready", "server", c.conn.Target(), "state", state.String())
+
+		if !c.conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("connection is not ready (%s): %w", state, ctx.Err())
+		}
+	}
+}
+
+// Reconnect opens the connection if it is idle and retries failed connections immediately,
+// instead of waiting for the reconnection backoff to expire.
+func (c *Client) Reconnect() {
+	if c.conn == nil {
+		return
+	}
+
+	c.logger().Debug("Reconnecting", "server", c.conn.Target(), "state", c.conn.GetState().String())
+
+	c.conn.Connect()
+	c.conn.ResetConnectBackoff()
+}
+
+func (cb ConnectionCallbacks) notify(from, to connectivity.State) {
+	if cb.OnStateChange != nil {
+		cb.OnStateChange(from, to)
+	}
+
+	switch to {
+	case connectivity.Ready:
+		if cb.OnReady != nil {
+			cb.OnReady()
+		}
+	case connectivity.TransientFailure:
+		if cb.OnTransientFailure != nil {
+			cb.OnTransientFailure()
+		}
+	case connectivity.Idle, connectivity.Connecting, connectivity.Shutdown:
+	}
+}
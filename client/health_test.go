@@ -0,0 +1,192 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newHealthTestClient creates a client connected to the bufconn listener.
+func newHealthTestClient(t *testing.T, lis *bufconn.Listener) *Client {
+	t.Helper()
+
+	// The passthrough resolver hands the target to the bufconn dialer without DNS resolution
+	conn, err := grpc.NewClient(
+		"passthrough:///"+testServerBufnet,
+		grpc.WithContextDialer(bufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
+	}
+
+	client := &Client{conn: conn}
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client
+}
+
+// waitForState waits until the state is received from the channel.
+func waitForState(t *testing.T, states <-chan connectivity.State, want connectivity.State) {
+	t.Helper()
+
+	timeout := time.After(testContextTimeout)
+
+	for {
+		select {
+		case state := <-states:
+			if state == want {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("Timed out waiting for connection state %s", want)
+		}
+	}
+}
+
+func TestAwaitReady(t *testing.T) {
+	server, lis := createTestServer(t)
+	defer server.Stop()
+
+	client := newHealthTestClient(t, lis)
+
+	if state := client.ConnectionState(); state != connectivity.Idle {
+		t.Errorf("Expected new client to be idle, got %s", state)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	if err := client.AwaitReady(ctx); err != nil {
+		t.Fatalf("Expected connection to become ready: %v", err)
+	}
+
+	if state := client.ConnectionState(); state != connectivity.Ready {
+		t.Errorf("Expected ready connection, got %s", state)
+	}
+}
+
+func TestAwaitReady_ContextDone(t *testing.T) {
+	server, lis := createTestServer(t)
+	server.Stop()
+
+	client := newHealthTestClient(t, lis)
+
+	ctx, cancel := context.WithTimeout(context.Background(), testConnectionStateCheck)
+	defer cancel()
+
+	err := client.AwaitReady(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded error, got %v", err)
+	}
+}
+
+func TestAwaitReady_Closed(t *testing.T) {
+	server, lis := createTestServer(t)
+	defer server.Stop()
+
+	client := newHealthTestClient(t, lis)
+	_ = client.Close()
+
+	if err := client.AwaitReady(context.Background()); err == nil {
+		t.Error("Expected error when awaiting a closed client")
+	}
+
+	if err := (&Client{}).AwaitReady(context.Background()); err == nil {
+		t.Error("Expected error when awaiting a client without connection")
+	}
+}
+
+func TestWatchConnection(t *testing.T) {
+	server, lis := createTestServer(t)
+
+	client := newHealthTestClient(t, lis)
+
+	states := make(chan connectivity.State, 16)
+	ready := make(chan struct{}, 16)
+	failed := make(chan struct{}, 16)
+
+	err := client.WatchConnection(context.Background(), ConnectionCallbacks{
+		OnStateChange: func(_, to connectivity.State) { states <- to },
+		OnReady:       func() { ready <- struct{}{} },
+		OnTransientFailure: func() {
+			failed <- struct{}{}
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to watch connection: %v", err)
+	}
+
+	client.Reconnect()
+	waitForState(t, states, connectivity.Ready)
+
+	select {
+	case <-ready:
+	default:
+		t.Error("Expected OnReady to be called")
+	}
+
+	// Outage: the server goes away and reconnection attempts fail
+	server.Stop()
+	_ = lis.Close()
+
+	client.Reconnect()
+	waitForState(t, states, connectivity.TransientFailure)
+
+	select {
+	case <-failed:
+	default:
+		t.Error("Expected OnTransientFailure to be called")
+	}
+
+	// Watching stops once the client is closed
+	_ = client.Close()
+	waitForState(t, states, connectivity.Shutdown)
+}
+
+func TestWatchConnection_ContextDone(t *testing.T) {
+	server, lis := createTestServer(t)
+	defer server.Stop()
+
+	client := newHealthTestClient(t, lis)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	states := make(chan connectivity.State, 16)
+
+	err := client.WatchConnection(ctx, ConnectionCallbacks{
+		OnStateChange: func(_, to connectivity.State) { states <- to },
+	})
+	if err != nil {
+		t.Fatalf("Failed to watch connection: %v", err)
+	}
+
+	client.Reconnect()
+
+	ctx, cancel = context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	if err := client.AwaitReady(ctx); err != nil {
+		t.Fatalf("Expected connection to become ready: %v", err)
+	}
+
+	time.Sleep(testConnectionStateCheck)
+
+	if len(states) != 0 {
+		t.Errorf("Expected no callbacks after the context is done, got %d", len(states))
+	}
+
+	if err := (&Client{}).WatchConnection(context.Background(), ConnectionCallbacks{}); err == nil {
+		t.Error("Expected error when watching a client without connection")
+	}
+}
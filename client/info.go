@@ -0,0 +1,132 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrFeatureNotSupported is returned when a feature is not enabled on the server.
+var ErrFeatureNotSupported = errors.New("feature not supported by server")
+
+// Feature is an optional server feature that can be checked with RequireFeature.
+type Feature string
+
+const (
+	FeatureAuthn             Feature = "authn"
+	FeatureAuthz             Feature = "authz"
+	FeatureEventsPersistence Feature = "events_persistence"
+	FeatureNamespaces        Feature = "namespaces"
+	FeatureRateLimit         Feature = "rate_limit"
+)
+
+// GetServerInfo returns information about the server, such as its version,
+// enabled features, limits and accepted record schema versions.
+func (c *Client) GetServerInfo(ctx context.Context) (*corev1.GetServerInfoResponse, error) {
+	resp, err := c.InfoServiceClient.GetServerInfo(ctx, &corev1.GetServerInfoRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server info: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetAPIDescriptor returns a machine-readable descriptor of the public APIs of the server,
+// generated from the protobuf definitions it serves. JSON schemas describe the message with
+// the given full name, or all messages if empty.
+func (c *Client) GetAPIDescriptor(ctx context.Context, format corev1.ApiDescriptorFormat, message string) ([]byte, error) {
+	resp, err := c.InfoServiceClient.GetApiDescriptor(ctx, &corev1.GetApiDescriptorRequest{
+		Format:  format,
+		Message: message,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API descriptor: %w", err)
+	}
+
+	return resp.GetContent(), nil
+}
+
+// RequireFeature returns ErrFeatureNotSupported if the feature is not enabled on the server.
+// The server info is fetched once and cached for the lifetime of the client.
+// Servers not exposing their info are assumed not to support any feature.
+func (c *Client) RequireFeature(ctx context.Context, feature Feature) error {
+	info, err := c.cachedServerInfo(ctx)
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return fmt.Errorf("%w: %s", ErrFeatureNotSupported, feature)
+		}
+
+		return err
+	}
+
+	features := info.GetFeatures()
+
+	var enabled bool
+
+	switch feature {
+	case FeatureAuthn:
+		enabled = features.GetAuthn()
+	case FeatureAuthz:
+		enabled = features.GetAuthz()
+	case FeatureEventsPersistence:
+		enabled = features.GetEventsPersistence()
+	case FeatureNamespaces:
+		enabled = features.GetNamespaces()
+	case FeatureRateLimit:
+		enabled = features.GetRateLimit()
+	}
+
+	if !enabled {
+		return fmt.Errorf("%w: %s", ErrFeatureNotSupported, feature)
+	}
+
+	return nil
+}
+
+// CheckSchemaVersion checks that the server accepts the schema version of the record before pushing it.
+// The returned error can be inspected with corev1.GetUnsupportedSchemaVersion, like the error returned by push.
+func (c *Client) CheckSchemaVersion(ctx context.Context, record *corev1.Record) error {
+	info, err := c.cachedServerInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	schemaVersion := record.GetSchemaVersion()
+	accepted := info.GetAcceptedSchemaVersions()
+
+	if slices.ContainsFunc(accepted, func(version string) bool {
+		return strings.TrimPrefix(version, "v") == strings.TrimPrefix(schemaVersion, "v")
+	}) {
+		return nil
+	}
+
+	return corev1.NewUnsupportedSchemaVersionError(schemaVersion, accepted)
+}
+
+// cachedServerInfo returns the server info, fetching it on first use.
+func (c *Client) cachedServerInfo(ctx context.Context) (*corev1.GetServerInfoResponse, error) {
+	c.serverInfoMu.Lock()
+	defer c.serverInfoMu.Unlock()
+
+	if c.serverInfo != nil {
+		return c.serverInfo, nil
+	}
+
+	info, err := c.GetServerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.serverInfo = info
+
+	return info, nil
+}
@@ -0,0 +1,92 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockInfoClient returns a fixed server info and counts the calls.
+type mockInfoClient struct {
+	corev1.InfoServiceClient
+
+	info  *corev1.GetServerInfoResponse
+	err   error
+	calls int
+}
+
+func (m *mockInfoClient) GetServerInfo(context.Context, *corev1.GetServerInfoRequest, ...grpc.CallOption) (*corev1.GetServerInfoResponse, error) {
+	m.calls++
+
+	return m.info, m.err
+}
+
+func TestRequireFeature(t *testing.T) {
+	ctx := context.Background()
+
+	mock := &mockInfoClient{
+		info: &corev1.GetServerInfoResponse{
+			Features: &corev1.ServerFeatures{Authz: true, Namespaces: true},
+		},
+	}
+	c := &Client{InfoServiceClient: mock}
+
+	if err := c.RequireFeature(ctx, FeatureAuthz); err != nil {
+		t.Errorf("RequireFeature(authz) unexpected error: %v", err)
+	}
+
+	if err := c.RequireFeature(ctx, FeatureEventsPersistence); !errors.Is(err, ErrFeatureNotSupported) {
+		t.Errorf("RequireFeature(events_persistence) error = %v, want ErrFeatureNotSupported", err)
+	}
+
+	if mock.calls != 1 {
+		t.Errorf("server info fetched %d times, want 1", mock.calls)
+	}
+}
+
+func TestRequireFeature_UnimplementedServer(t *testing.T) {
+	c := &Client{InfoServiceClient: &mockInfoClient{err: status.Error(codes.Unimplemented, "unknown service")}}
+
+	if err := c.RequireFeature(context.Background(), FeatureAuthn); !errors.Is(err, ErrFeatureNotSupported) {
+		t.Errorf("RequireFeature() error = %v, want ErrFeatureNotSupported", err)
+	}
+}
+
+func TestCheckSchemaVersion(t *testing.T) {
+	ctx := context.Background()
+
+	c := &Client{InfoServiceClient: &mockInfoClient{
+		info: &corev1.GetServerInfoResponse{AcceptedSchemaVersions: []string{"0.7.0", "0.8.0"}},
+	}}
+
+	accepted, err := corev1.UnmarshalRecord([]byte(`{"name":"agent","schema_version":"0.7.0"}`))
+	if err != nil {
+		t.Fatalf("failed to load record: %v", err)
+	}
+
+	if err := c.CheckSchemaVersion(ctx, accepted); err != nil {
+		t.Errorf("CheckSchemaVersion() unexpected error: %v", err)
+	}
+
+	rejected, err := corev1.UnmarshalRecord([]byte(`{"name":"agent","schema_version":"v0.3.1"}`))
+	if err != nil {
+		t.Fatalf("failed to load record: %v", err)
+	}
+
+	unsupported, ok := corev1.GetUnsupportedSchemaVersion(c.CheckSchemaVersion(ctx, rejected))
+	if !ok {
+		t.Fatal("CheckSchemaVersion() did not return an unsupported schema version error")
+	}
+
+	if got := unsupported.GetAcceptedSchemaVersions(); len(got) != 2 {
+		t.Errorf("accepted schema versions = %v, want 2 versions", got)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"google.golang.org/grpc/credentials"
+)
+
+// clientInstanceIDBytes is the number of random bytes of generated client instance IDs.
+const clientInstanceIDBytes = 16
+
+// WithClientInstanceID sends the given client instance ID as gRPC metadata with every request.
+// It takes precedence over the ClientInstanceID of the config.
+//
+// The ID lets servers distinguish automation sources in their logs and rate limits
+// when clients share an identity or authentication is disabled. It is opt-in:
+// no ID is sent unless set with this option or the config.
+func WithClientInstanceID(id string) Option {
+	return func(opts *options) error {
+		if err := corev1.ValidateClientInstanceID(id); err != nil {
+			return fmt.Errorf("invalid client instance ID: %w", err)
+		}
+
+		opts.clientInstanceID = id
+
+		return nil
+	}
+}
+
+// NewClientInstanceID generates a random client instance ID.
+// Callers should persist it to keep the same ID across client instances.
+func NewClientInstanceID() (string, error) {
+	b := make([]byte, clientInstanceIDBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate client instance ID: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// clientInstanceCredentials implements credentials.PerRPCCredentials to attach the client instance ID.
+type clientInstanceCredentials struct {
+	id string
+}
+
+// GetRequestMetadata attaches the client instance ID to the request metadata.
+func (c *clientInstanceCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{
+		corev1.ClientInstanceIDMetadataKey: c.id,
+	}, nil
+}
+
+// Returns false because the client instance ID is not a secret.
+func (c *clientInstanceCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// newClientInstanceCredentials creates a new PerRPCCredentials that attaches the client instance ID.
+func newClientInstanceCredentials(id string) credentials.PerRPCCredentials {
+	return &clientInstanceCredentials{id: id}
+}
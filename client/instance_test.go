@@ -0,0 +1,116 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataInfoService records the client instance IDs received with server info requests.
+type metadataInfoService struct {
+	corev1.UnimplementedInfoServiceServer
+
+	received chan []string
+}
+
+func (s *metadataInfoService) GetServerInfo(ctx context.Context, _ *corev1.GetServerInfoRequest) (*corev1.GetServerInfoResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.received <- md.Get(corev1.ClientInstanceIDMetadataKey)
+
+	return &corev1.GetServerInfoResponse{}, nil
+}
+
+func TestClientInstanceID(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	lc := net.ListenConfig{}
+
+	lis, err := lc.Listen(ctx, "tcp", testServerLocalhost)
+	if err != nil {
+		t.Fatalf("Failed to create listener: %v", err)
+	}
+
+	service := &metadataInfoService{received: make(chan []string, 1)}
+	server := grpc.NewServer()
+	corev1.RegisterInfoServiceServer(server, service)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	defer server.Stop()
+
+	getInstanceIDs := func(t *testing.T, opts ...Option) []string {
+		t.Helper()
+
+		opts = append([]Option{WithConfig(&Config{ServerAddress: lis.Addr().String()})}, opts...)
+
+		c, err := New(ctx, opts...)
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+
+		defer c.Close()
+
+		if _, err := c.InfoServiceClient.GetServerInfo(ctx, &corev1.GetServerInfoRequest{}); err != nil {
+			t.Fatalf("GetServerInfo() error: %v", err)
+		}
+
+		return <-service.received
+	}
+
+	t.Run("not sent by default", func(t *testing.T) {
+		if ids := getInstanceIDs(t); len(ids) != 0 {
+			t.Errorf("received client instance IDs %v, want none", ids)
+		}
+	})
+
+	t.Run("sent when set", func(t *testing.T) {
+		id, err := NewClientInstanceID()
+		if err != nil {
+			t.Fatalf("NewClientInstanceID() error: %v", err)
+		}
+
+		if ids := getInstanceIDs(t, WithClientInstanceID(id)); len(ids) != 1 || ids[0] != id {
+			t.Errorf("received client instance IDs %v, want [%s]", ids, id)
+		}
+	})
+
+	t.Run("invalid ID is rejected", func(t *testing.T) {
+		if _, err := New(ctx, WithConfig(&Config{ServerAddress: lis.Addr().String()}), WithClientInstanceID("not valid")); err == nil {
+			t.Error("New() with invalid client instance ID should fail")
+		}
+
+		if _, err := New(ctx, WithConfig(&Config{ServerAddress: lis.Addr().String(), ClientInstanceID: "not valid"})); err == nil {
+			t.Error("New() with invalid client instance ID in config should fail")
+		}
+	})
+}
+
+func TestNewClientInstanceID(t *testing.T) {
+	id1, err := NewClientInstanceID()
+	if err != nil {
+		t.Fatalf("NewClientInstanceID() error: %v", err)
+	}
+
+	id2, err := NewClientInstanceID()
+	if err != nil {
+		t.Fatalf("NewClientInstanceID() error: %v", err)
+	}
+
+	if id1 == id2 {
+		t.Errorf("generated client instance IDs are equal: %s", id1)
+	}
+
+	if err := corev1.ValidateClientInstanceID(id1); err != nil {
+		t.Errorf("generated client instance ID is invalid: %v", err)
+	}
+}
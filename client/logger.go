@@ -0,0 +1,80 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc/connectivity"
+)
+
+// Logger receives the structured logs of the client, as alternating key-value pairs.
+// It is satisfied by *slog.Logger.
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+// defaultLogger is the logger of clients created without WithLogger or WithoutLogging.
+var defaultLogger Logger = logging.Logger("client")
+
+// WithLogger sends the logs of the client to the given logger instead of the default slog logger.
+// Connection lifecycle, retries and stream terminations are logged at debug level.
+func WithLogger(logger Logger) Option {
+	return func(opts *options) error {
+		if logger == nil {
+			return errors.New("logger is nil")
+		}
+
+		opts.logger = logger
+
+		return nil
+	}
+}
+
+// WithoutLogging discards the logs of the client.
+func WithoutLogging() Option {
+	return func(opts *options) error {
+		opts.logger = nopLogger{}
+
+		return nil
+	}
+}
+
+// logger returns the logger of the client, or the default logger if it is not set.
+func (c *Client) logger() Logger {
+	if c.log == nil {
+		return defaultLogger
+	}
+
+	return c.log
+}
+
+// nopLogger discards all logs.
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+// logConnectionStates logs the connection state transitions until ctx is done or the connection is shut down.
+// Watching does not open the connection.
+func (c *Client) logConnectionStates(ctx context.Context) {
+	state := c.conn.GetState()
+
+	for state != connectivity.Shutdown {
+		if !c.conn.WaitForStateChange(ctx, state) {
+			return
+		}
+
+		next := c.conn.GetState()
+		c.logger().Debug("Connection state changed", "server", c.conn.Target(), "from", state.String(), "to", next.String())
+		state = next
+	}
+}
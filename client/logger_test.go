@@ -0,0 +1,107 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// recordingLogger records the messages logged at each level.
+type recordingLogger struct {
+	mu       sync.Mutex
+	messages map[string][]string
+}
+
+func newRecordingLogger() *recordingLogger {
+	return &recordingLogger{messages: make(map[string][]string)}
+}
+
+func (l *recordingLogger) record(level, msg string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.messages[level] = append(l.messages[level], msg)
+}
+
+func (l *recordingLogger) logged(level, msg string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return slices.Contains(l.messages[level], msg)
+}
+
+func (l *recordingLogger) Debug(msg string, _ ...any) { l.record("debug", msg) }
+func (l *recordingLogger) Info(msg string, _ ...any)  { l.record("info", msg) }
+func (l *recordingLogger) Warn(msg string, _ ...any)  { l.record("warn", msg) }
+func (l *recordingLogger) Error(msg string, _ ...any) { l.record("error", msg) }
+
+// searchService streams a single search result.
+type searchService struct {
+	searchv1.UnimplementedSearchServiceServer
+}
+
+func (s *searchService) Search(_ *searchv1.SearchRequest, stream searchv1.SearchService_SearchServer) error {
+	return stream.Send(&searchv1.SearchResponse{RecordCid: "bafytest"}) //nolint:wrapcheck
+}
+
+func TestWithLogger(t *testing.T) {
+	t.Run("should reject nil logger", func(t *testing.T) {
+		require.Error(t, WithLogger(nil)(&options{}))
+	})
+
+	t.Run("should discard logs without logging", func(t *testing.T) {
+		opts := &options{}
+		require.NoError(t, WithoutLogging()(opts))
+		assert.Equal(t, nopLogger{}, opts.logger)
+	})
+
+	t.Run("should default to slog logger", func(t *testing.T) {
+		assert.Equal(t, defaultLogger, (&Client{}).logger())
+	})
+}
+
+func TestClientLogs(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	lc := net.ListenConfig{}
+
+	lis, err := lc.Listen(ctx, "tcp", testServerLocalhost)
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	searchv1.RegisterSearchServiceServer(server, &searchService{})
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	defer server.Stop()
+
+	logger := newRecordingLogger()
+
+	c, err := New(ctx, WithConfig(&Config{ServerAddress: lis.Addr().String()}), WithLogger(logger))
+	require.NoError(t, err)
+
+	resultCh, err := c.Search(ctx, &searchv1.SearchRequest{})
+	require.NoError(t, err)
+
+	for range resultCh {
+	}
+
+	require.NoError(t, c.Close())
+
+	assert.True(t, logger.logged("debug", "Created client"))
+	assert.True(t, logger.logged("debug", "Stream completed"))
+	assert.True(t, logger.logged("debug", "Closed client"))
+}
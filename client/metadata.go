@@ -0,0 +1,104 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"google.golang.org/grpc/credentials"
+)
+
+// metadataKeyPattern matches the valid gRPC metadata keys.
+var metadataKeyPattern = regexp.MustCompile(`^[0-9a-z_.-]+$`)
+
+// reservedMetadataKeys are the metadata keys set by the transport or by other options.
+var reservedMetadataKeys = map[string]string{
+	"authorization":                    "authentication options",
+	"content-type":                     "the transport",
+	"te":                               "the transport",
+	"user-agent":                       "WithUserAgent",
+	corev1.ClientInstanceIDMetadataKey: "WithClientInstanceID",
+}
+
+// WithUserAgent sets the user agent sent with every request, e.g. "release-pipeline/1.2".
+// The server records it in its request logs; gRPC appends its own version to it.
+func WithUserAgent(userAgent string) Option {
+	return func(opts *options) error {
+		if userAgent == "" || !isPrintableASCII(userAgent) {
+			return fmt.Errorf("invalid user agent %q: must be non-empty printable ASCII", userAgent)
+		}
+
+		opts.userAgent = userAgent
+
+		return nil
+	}
+}
+
+// WithMetadata sends the given key and value as gRPC metadata with every request,
+// e.g. WithMetadata("x-request-id", pipelineRunID) to find the requests of a pipeline
+// run in the server logs. It can be repeated to send several keys.
+//
+// Keys are case-insensitive and sent in lowercase. Keys set by the transport
+// or by other options, such as "user-agent", cannot be set.
+func WithMetadata(key, value string) Option {
+	return func(opts *options) error {
+		key = strings.ToLower(key)
+
+		if !metadataKeyPattern.MatchString(key) || strings.HasPrefix(key, "grpc-") {
+			return fmt.Errorf("invalid metadata key %q", key)
+		}
+
+		if option, reserved := reservedMetadataKeys[key]; reserved {
+			return fmt.Errorf("metadata key %q is set by %s", key, option)
+		}
+
+		// Values of binary keys are encoded by gRPC
+		if !strings.HasSuffix(key, "-bin") && !isPrintableASCII(value) {
+			return fmt.Errorf("invalid value of metadata key %q: must be printable ASCII", key)
+		}
+
+		if opts.metadata == nil {
+			opts.metadata = make(map[string]string)
+		}
+
+		opts.metadata[key] = value
+
+		return nil
+	}
+}
+
+// isPrintableASCII reports whether the string only holds printable ASCII characters.
+func isPrintableASCII(s string) bool {
+	for i := range len(s) {
+		if s[i] < ' ' || s[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// metadataCredentials implements credentials.PerRPCCredentials to attach static metadata.
+type metadataCredentials struct {
+	metadata map[string]string
+}
+
+// GetRequestMetadata attaches the static metadata to the request metadata.
+func (c *metadataCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return c.metadata, nil
+}
+
+// Returns false because static metadata is not a secret.
+func (c *metadataCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// newMetadataCredentials creates a new PerRPCCredentials that attaches static metadata.
+func newMetadataCredentials(metadata map[string]string) credentials.PerRPCCredentials {
+	return &metadataCredentials{metadata: metadata}
+}
@@ -0,0 +1,102 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestMetadataInfoService records the metadata received with server info requests.
+type requestMetadataInfoService struct {
+	corev1.UnimplementedInfoServiceServer
+
+	received chan metadata.MD
+}
+
+func (s *requestMetadataInfoService) GetServerInfo(ctx context.Context, _ *corev1.GetServerInfoRequest) (*corev1.GetServerInfoResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.received <- md
+
+	return &corev1.GetServerInfoResponse{}, nil
+}
+
+func TestUserAgentAndMetadata(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	lc := net.ListenConfig{}
+
+	lis, err := lc.Listen(ctx, "tcp", testServerLocalhost)
+	if err != nil {
+		t.Fatalf("Failed to create listener: %v", err)
+	}
+
+	service := &requestMetadataInfoService{received: make(chan metadata.MD, 1)}
+	server := grpc.NewServer()
+	corev1.RegisterInfoServiceServer(server, service)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	defer server.Stop()
+
+	c, err := New(ctx,
+		WithConfig(&Config{ServerAddress: lis.Addr().String()}),
+		WithUserAgent("release-pipeline/1.2"),
+		WithMetadata("X-Team", "platform"),
+		WithMetadata("x-request-id", "run-42"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	defer c.Close()
+
+	if _, err := c.InfoServiceClient.GetServerInfo(ctx, &corev1.GetServerInfoRequest{}); err != nil {
+		t.Fatalf("GetServerInfo() error: %v", err)
+	}
+
+	md := <-service.received
+
+	if userAgent := md.Get("user-agent"); len(userAgent) != 1 || !strings.HasPrefix(userAgent[0], "release-pipeline/1.2") {
+		t.Errorf("received user agent %v, want release-pipeline/1.2", userAgent)
+	}
+
+	if team := md.Get("x-team"); len(team) != 1 || team[0] != "platform" {
+		t.Errorf("received x-team %v, want [platform]", team)
+	}
+
+	if requestID := md.Get("x-request-id"); len(requestID) != 1 || requestID[0] != "run-42" {
+		t.Errorf("received x-request-id %v, want [run-42]", requestID)
+	}
+}
+
+func TestWithMetadata_Invalid(t *testing.T) {
+	for _, opt := range []Option{
+		WithUserAgent(""),
+		WithUserAgent("agent\n"),
+		WithMetadata("", "value"),
+		WithMetadata("x team", "value"),
+		WithMetadata("grpc-timeout", "1s"),
+		WithMetadata("User-Agent", "agent"),
+		WithMetadata(corev1.ClientInstanceIDMetadataKey, "id"),
+		WithMetadata("x-team", "line\nbreak"),
+	} {
+		if err := opt(&options{}); err == nil {
+			t.Error("invalid user agent or metadata should be rejected")
+		}
+	}
+
+	if err := WithMetadata("x-trace-bin", "\x00\x01")(&options{}); err != nil {
+		t.Errorf("WithMetadata() with binary key error = %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// GetOperation returns the latest state of a long-running operation.
+func (c *Client) GetOperation(ctx context.Context, id string) (*corev1.Operation, error) {
+	op, err := c.OperationServiceClient.GetOperation(ctx, &corev1.GetOperationRequest{Id: id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operation: %w", err)
+	}
+
+	return op, nil
+}
+
+// ListOperations lists the long-running operations known to the server, most recently created first.
+func (c *Client) ListOperations(ctx context.Context, req *corev1.ListOperationsRequest) (<-chan *corev1.Operation, error) {
+	stream, err := c.OperationServiceClient.ListOperations(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create list operations stream: %w", err)
+	}
+
+	resultCh := make(chan *corev1.Operation)
+
+	go func() {
+		defer close(resultCh)
+
+		for {
+			op, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				c.logger().Debug("Stream completed", "stream", "operations.ListOperations")
+
+				break
+			}
+
+			if err != nil {
+				c.logger().Error("failed to receive list operations response", "error", err)
+
+				break
+			}
+
+			select {
+			case resultCh <- op:
+			case <-ctx.Done():
+				c.logger().Debug("context cancelled while receiving list operations response", "error", ctx.Err())
+
+				return
+			}
+		}
+	}()
+
+	return resultCh, nil
+}
+
+// WaitOperation waits until a long-running operation is done and returns its final state.
+// The server is polled with waits of at most pollTimeout, or the server default if it is zero,
+// so that waiting survives the server limit on the duration of a single wait.
+// Cancel ctx to stop waiting; the operation keeps running on the server.
+func (c *Client) WaitOperation(ctx context.Context, id string, pollTimeout time.Duration) (*corev1.Operation, error) {
+	req := &corev1.WaitOperationRequest{Id: id}
+	if pollTimeout > 0 {
+		req.Timeout = durationpb.New(pollTimeout)
+	}
+
+	for {
+		op, err := c.OperationServiceClient.WaitOperation(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to wait for operation: %w", err)
+		}
+
+		if op.GetDone() {
+			return op, nil
+		}
+
+		c.logger().Debug("Operation not done, waiting again", "operation_id", id)
+	}
+}
+
+// CancelOperation requests the cancellation of a long-running operation and returns its latest state.
+func (c *Client) CancelOperation(ctx context.Context, id string) (*corev1.Operation, error) {
+	op, err := c.OperationServiceClient.CancelOperation(ctx, &corev1.CancelOperationRequest{Id: id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel operation: %w", err)
+	}
+
+	return op, nil
+}
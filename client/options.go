@@ -31,6 +31,27 @@ type options struct {
 	authOpts   []grpc.DialOption
 	authClient *workloadapi.Client
 
+	// clientInstanceID overrides the client instance ID of the config
+	clientInstanceID string
+
+	// userAgent overrides the default gRPC user agent
+	userAgent string
+
+	// metadata is attached to all requests
+	metadata map[string]string
+
+	// retryPolicy overrides the retries of the config
+	retryPolicy *RetryPolicy
+
+	// prevalidatePush checks records locally before pushing them
+	prevalidatePush bool
+
+	// logger receives the logs of the client
+	logger Logger
+
+	// cache configures the in-memory cache of records and name resolutions
+	cache *CacheConfig
+
 	// SPIFFE sources for cleanup
 	bundleSrc io.Closer
 	x509Src   io.Closer
@@ -74,6 +95,8 @@ func withAuth(ctx context.Context) Option {
 			return o.setupSpiffeAuth(ctx)
 		case "tls":
 			return o.setupTlsAuth(ctx)
+		case "api-token":
+			return o.setupAPITokenAuth(ctx)
 		case "":
 			// Empty auth mode - use insecure connection (for development/testing only)
 			o.authOpts = append(o.authOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -81,7 +104,7 @@ func withAuth(ctx context.Context) Option {
 			return nil
 		default:
 			// Invalid auth mode specified - return error to prevent silent security issues
-			return fmt.Errorf("unsupported auth mode: %s (supported: 'jwt', 'x509', 'token', or empty for insecure)", o.config.AuthMode)
+			return fmt.Errorf("unsupported auth mode: %s (supported: 'jwt', 'x509', 'token', 'tls', 'api-token', or empty for insecure)", o.config.AuthMode)
 		}
 	}
 }
@@ -327,3 +350,33 @@ func (o *options) setupTlsAuth(_ context.Context) error {
 
 	return nil
 }
+
+func (o *options) setupAPITokenAuth(_ context.Context) error {
+	// Verify the server certificate with the given CA if set, or the system CAs otherwise
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: o.config.TlsSkipVerify, //nolint:gosec
+	}
+
+	if o.config.TlsCAFile != "" {
+		caData, err := os.ReadFile(o.config.TlsCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read TLS CA file: %w", err)
+		}
+
+		capool := x509.NewCertPool()
+		if !capool.AppendCertsFromPEM(caData) {
+			return errors.New("failed to append root CA certificate to CA pool")
+		}
+
+		tlsConfig.RootCAs = capool
+	}
+
+	o.authOpts = append(o.authOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+
+	// Without a token, only methods authenticated by their request can be called, e.g. to exchange an ID token
+	if o.config.APIToken != "" {
+		o.authOpts = append(o.authOpts, grpc.WithPerRPCCredentials(newAPITokenCredentials(o.config.APIToken)))
+	}
+
+	return nil
+}
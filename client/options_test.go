@@ -163,6 +163,53 @@ func TestWithAuth_ConfigValidation(t *testing.T) {
 	})
 }
 
+func TestWithAuth_APIToken(t *testing.T) {
+	t.Run("should send the API token over TLS", func(t *testing.T) {
+		opts := &options{
+			config: &Config{
+				ServerAddress: testServerAddr,
+				AuthMode:      "api-token",
+				APIToken:      "dirtok1.payload.signature",
+			},
+		}
+
+		err := withAuth(context.Background())(opts)
+
+		require.NoError(t, err)
+		assert.Len(t, opts.authOpts, 2)
+		assert.Nil(t, opts.authClient)
+	})
+
+	t.Run("should allow exchanging ID tokens without an API token", func(t *testing.T) {
+		opts := &options{
+			config: &Config{
+				ServerAddress: testServerAddr,
+				AuthMode:      "api-token",
+			},
+		}
+
+		err := withAuth(context.Background())(opts)
+
+		require.NoError(t, err)
+		assert.Len(t, opts.authOpts, 1)
+	})
+
+	t.Run("should error on missing CA file", func(t *testing.T) {
+		opts := &options{
+			config: &Config{
+				ServerAddress: testServerAddr,
+				AuthMode:      "api-token",
+				APIToken:      "dirtok1.payload.signature",
+				TlsCAFile:     "/nonexistent/ca.pem",
+			},
+		}
+
+		err := withAuth(context.Background())(opts)
+
+		require.ErrorContains(t, err, "failed to read TLS CA file")
+	})
+}
+
 func TestWithAuth_InvalidAuthMode(t *testing.T) {
 	t.Run("should error on unsupported auth mode", func(t *testing.T) {
 		// Skip this test if we can't connect to SPIFFE socket
@@ -0,0 +1,101 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// PushValidationError is returned when a record is rejected by the client-side
+// checks run before pushing it, so that no request is sent to the server.
+type PushValidationError struct {
+	// CID of the record, empty if it could not be computed.
+	CID string
+
+	// Size of the record message in bytes.
+	Size int
+
+	// Errors describing why the record would be rejected by the server.
+	Errors []string
+}
+
+func (e *PushValidationError) Error() string {
+	if e.CID == "" {
+		return "invalid record: " + strings.Join(e.Errors, "; ")
+	}
+
+	return fmt.Sprintf("invalid record %s: %s", e.CID, strings.Join(e.Errors, "; "))
+}
+
+// WithPushPrevalidation runs PrevalidatePush for every record before it is pushed
+// with Push or PushBatch. Records that would be rejected by the server fail locally,
+// without sending a request or consuming rate limit tokens.
+func WithPushPrevalidation() Option {
+	return func(opts *options) error {
+		opts.prevalidatePush = true
+
+		return nil
+	}
+}
+
+// PrevalidatePush checks a record locally before pushing it and returns its CID.
+// The record must have a computable CID, must fit in the maximum message size
+// advertised by the server, must have a schema version accepted by the server,
+// and must validate against the OASF schemas embedded in the client.
+//
+// Records failing the checks are reported with a *PushValidationError, except for
+// unaccepted schema versions, which are reported with the same error as the server
+// returns on push (see corev1.GetUnsupportedSchemaVersion). Checks against server
+// limits are skipped if the server does not expose its info.
+func (c *Client) PrevalidatePush(ctx context.Context, record *corev1.Record) (string, error) {
+	cid := record.GetCid()
+	if cid == "" {
+		return "", &PushValidationError{Errors: []string{"failed to compute the record CID: record has no data"}}
+	}
+
+	size := proto.Size(record)
+
+	info, err := c.cachedServerInfo(ctx)
+	if err != nil && status.Code(err) != codes.Unimplemented {
+		return "", err
+	}
+
+	if info != nil {
+		if maxSize := info.GetLimits().GetMaxRecvMsgSize(); maxSize > 0 && uint64(size) > maxSize { //nolint:gosec // sizes are non-negative
+			return "", &PushValidationError{
+				CID:    cid,
+				Size:   size,
+				Errors: []string{fmt.Sprintf("record size %d bytes exceeds the maximum message size of %d bytes accepted by the server", size, maxSize)},
+			}
+		}
+
+		if len(info.GetAcceptedSchemaVersions()) > 0 {
+			if err := c.CheckSchemaVersion(ctx, record); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	valid, validationErrors, err := record.Validate()
+	if err != nil {
+		return "", fmt.Errorf("failed to validate record: %w", err)
+	}
+
+	if !valid {
+		return "", &PushValidationError{
+			CID:    cid,
+			Size:   size,
+			Errors: validationErrors,
+		}
+	}
+
+	return cid, nil
+}
@@ -0,0 +1,132 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func loadTestRecord(t *testing.T, data string) *corev1.Record {
+	t.Helper()
+
+	record, err := corev1.UnmarshalRecord([]byte(data))
+	if err != nil {
+		t.Fatalf("failed to load record: %v", err)
+	}
+
+	return record
+}
+
+func TestPrevalidatePush(t *testing.T) {
+	ctx := context.Background()
+
+	record := loadTestRecord(t, `{"name":"agent","schema_version":"0.7.0"}`)
+
+	tests := []struct {
+		name          string
+		info          *corev1.GetServerInfoResponse
+		infoErr       error
+		wantValidated bool
+		wantSize      bool
+	}{
+		{
+			name: "record too large for the server",
+			info: &corev1.GetServerInfoResponse{
+				AcceptedSchemaVersions: []string{"0.7.0"},
+				Limits:                 &corev1.ServerLimits{MaxRecvMsgSize: 8},
+			},
+			wantSize: true,
+		},
+		{
+			name: "record validated against embedded schemas",
+			info: &corev1.GetServerInfoResponse{
+				AcceptedSchemaVersions: []string{"0.7.0"},
+				Limits:                 &corev1.ServerLimits{MaxRecvMsgSize: 4 * 1024 * 1024},
+			},
+			wantValidated: true,
+		},
+		{
+			name:          "server without info",
+			infoErr:       status.Error(codes.Unimplemented, "unknown service"),
+			wantValidated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{InfoServiceClient: &mockInfoClient{info: tt.info, err: tt.infoErr}}
+
+			_, err := c.PrevalidatePush(ctx, record)
+
+			var validationErr *PushValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("PrevalidatePush() error = %v, want PushValidationError", err)
+			}
+
+			if validationErr.CID != record.GetCid() {
+				t.Errorf("CID = %q, want %q", validationErr.CID, record.GetCid())
+			}
+
+			// The test record lacks required fields, so it fails schema validation
+			if tt.wantValidated && len(validationErr.Errors) == 0 {
+				t.Error("expected schema validation errors")
+			}
+
+			if tt.wantSize && len(validationErr.Errors) != 1 {
+				t.Errorf("errors = %v, want only the size error", validationErr.Errors)
+			}
+		})
+	}
+}
+
+func TestPrevalidatePush_UnsupportedSchemaVersion(t *testing.T) {
+	c := &Client{InfoServiceClient: &mockInfoClient{
+		info: &corev1.GetServerInfoResponse{AcceptedSchemaVersions: []string{"0.8.0"}},
+	}}
+
+	record := loadTestRecord(t, `{"name":"agent","schema_version":"0.7.0"}`)
+
+	if _, ok := corev1.GetUnsupportedSchemaVersion(func() error {
+		_, err := c.PrevalidatePush(context.Background(), record)
+
+		return err
+	}()); !ok {
+		t.Error("PrevalidatePush() did not return an unsupported schema version error")
+	}
+}
+
+func TestPrevalidatePush_NoData(t *testing.T) {
+	c := &Client{InfoServiceClient: &mockInfoClient{}}
+
+	_, err := c.PrevalidatePush(context.Background(), &corev1.Record{})
+
+	var validationErr *PushValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("PrevalidatePush() error = %v, want PushValidationError", err)
+	}
+}
+
+func TestPushBatch_Prevalidation(t *testing.T) {
+	mock := &mockInfoClient{info: &corev1.GetServerInfoResponse{
+		Limits: &corev1.ServerLimits{MaxRecvMsgSize: 8},
+	}}
+
+	// No store client is set, so the push fails unless it is rejected before any request
+	c := &Client{InfoServiceClient: mock, prevalidatePush: true}
+
+	_, err := c.PushBatch(context.Background(), []*corev1.Record{
+		loadTestRecord(t, `{"name":"agent","schema_version":"0.7.0"}`),
+	})
+
+	var validationErr *PushValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("PushBatch() error = %v, want PushValidationError", err)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/client/streaming"
+)
+
+// ListPublications lists the publication requests of the server along with their status and schedule.
+func (c *Client) ListPublications(ctx context.Context, req *routingv1.ListPublicationsRequest) (streaming.StreamResult[routingv1.ListPublicationsItem], error) {
+	stream, err := c.PublicationServiceClient.ListPublications(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create list publications stream: %w", err)
+	}
+
+	result, err := streaming.ProcessServerStream(ctx, stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process list publications stream: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetPublication returns the status and schedule of a publication request.
+func (c *Client) GetPublication(ctx context.Context, publicationID string) (*routingv1.GetPublicationResponse, error) {
+	resp, err := c.PublicationServiceClient.GetPublication(ctx, &routingv1.GetPublicationRequest{
+		PublicationId: publicationID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get publication: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ConfirmPublication ends the settle delay of a pending publication request,
+// so that its records are announced when the publication is next processed.
+func (c *Client) ConfirmPublication(ctx context.Context, publicationID string) error {
+	_, err := c.PublicationServiceClient.ConfirmPublication(ctx, &routingv1.ConfirmPublicationRequest{
+		PublicationId: publicationID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to confirm publication: %w", err)
+	}
+
+	return nil
+}
+
+// GetPublicationSummary returns the number of queued, in-flight and failed publication requests,
+// in total and aggregated by namespace and by label.
+func (c *Client) GetPublicationSummary(ctx context.Context) (*routingv1.GetPublicationSummaryResponse, error) {
+	resp, err := c.PublicationServiceClient.GetPublicationSummary(ctx, &routingv1.GetPublicationSummaryRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get publication summary: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,93 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"google.golang.org/grpc"
+)
+
+// mockPublicationServiceClient records the publications confirmed by the client.
+type mockPublicationServiceClient struct {
+	routingv1.PublicationServiceClient
+
+	confirmed  []string
+	confirmErr error
+}
+
+func (m *mockPublicationServiceClient) GetPublication(_ context.Context, req *routingv1.GetPublicationRequest, _ ...grpc.CallOption) (*routingv1.GetPublicationResponse, error) {
+	return &routingv1.GetPublicationResponse{
+		PublicationId: req.GetPublicationId(),
+		Status:        routingv1.PublicationStatus_PUBLICATION_STATUS_PENDING,
+		SettleUntil:   "2026-01-01T00:15:00Z",
+	}, nil
+}
+
+func (m *mockPublicationServiceClient) ConfirmPublication(_ context.Context, req *routingv1.ConfirmPublicationRequest, _ ...grpc.CallOption) (*routingv1.ConfirmPublicationResponse, error) {
+	if m.confirmErr != nil {
+		return nil, m.confirmErr
+	}
+
+	m.confirmed = append(m.confirmed, req.GetPublicationId())
+
+	return &routingv1.ConfirmPublicationResponse{}, nil
+}
+
+func (m *mockPublicationServiceClient) GetPublicationSummary(_ context.Context, _ *routingv1.GetPublicationSummaryRequest, _ ...grpc.CallOption) (*routingv1.GetPublicationSummaryResponse, error) {
+	return &routingv1.GetPublicationSummaryResponse{
+		Total: &routingv1.PublicationCounts{Queued: 2, Failed: 1},
+		Namespaces: []*routingv1.PublicationSummaryGroup{
+			{Name: "example.org", Counts: &routingv1.PublicationCounts{Queued: 2, Failed: 1}},
+		},
+	}, nil
+}
+
+func TestGetPublicationSummary(t *testing.T) {
+	client := &Client{PublicationServiceClient: &mockPublicationServiceClient{}}
+
+	resp, err := client.GetPublicationSummary(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get publication summary: %v", err)
+	}
+
+	if resp.GetTotal().GetQueued() != 2 || resp.GetTotal().GetFailed() != 1 {
+		t.Errorf("Unexpected publication counts: %v", resp.GetTotal())
+	}
+
+	if len(resp.GetNamespaces()) != 1 || resp.GetNamespaces()[0].GetName() != "example.org" {
+		t.Errorf("Unexpected namespaces: %v", resp.GetNamespaces())
+	}
+}
+
+func TestConfirmPublication(t *testing.T) {
+	mockClient := &mockPublicationServiceClient{}
+	client := &Client{PublicationServiceClient: mockClient}
+
+	resp, err := client.GetPublication(context.Background(), "pub-1")
+	if err != nil {
+		t.Fatalf("Failed to get publication: %v", err)
+	}
+
+	if resp.GetSettleUntil() == "" {
+		t.Error("Expected pending publication to be settling")
+	}
+
+	if err := client.ConfirmPublication(context.Background(), "pub-1"); err != nil {
+		t.Fatalf("Failed to confirm publication: %v", err)
+	}
+
+	if len(mockClient.confirmed) != 1 || mockClient.confirmed[0] != "pub-1" {
+		t.Errorf("Expected publication pub-1 to be confirmed, got %v", mockClient.confirmed)
+	}
+
+	mockClient.confirmErr = errors.New("publication is not pending")
+
+	if err := client.ConfirmPublication(context.Background(), "pub-2"); err == nil {
+		t.Error("Expected error when the server rejects the confirmation")
+	}
+}
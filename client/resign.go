@@ -0,0 +1,37 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+)
+
+// Resign starts signing all records matching the queries of the request with its provider,
+// for example after rotating a signing key. Only the admins of the server can re-sign records.
+//
+// Records are re-signed by the server in the background: the returned response only holds
+// the ID of the operation, which can be waited for with WaitOperation or cancelled with
+// CancelOperation. The ResignResponse of the finished operation reports the records signed,
+// those skipped because they are already signed with the key of a key provider, and those
+// that failed. The existing signatures of the records are kept; revoke them separately if needed.
+func (c *Client) Resign(ctx context.Context, req *signv1.ResignRequest) (*signv1.ResignResponse, error) {
+	if len(req.GetQueries()) == 0 {
+		return nil, errors.New("at least one query is required to select the records to re-sign")
+	}
+
+	if req.GetProvider() == nil {
+		return nil, errors.New("signature provider must be specified")
+	}
+
+	resp, err := c.SignServiceClient.Resign(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start re-signing records: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,228 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	retryInitialBackoff    = 200 * time.Millisecond
+	retryMaxBackoff        = 5 * time.Second
+	retryBackoffMultiplier = 2
+	retryJitter            = 0.2
+
+	// defaultRetryMaxAttempts is the number of attempts of calls of the default retry policy.
+	defaultRetryMaxAttempts = 4
+)
+
+// retryableStatusCodes are the status codes of transient errors, e.g. while the server is unreachable.
+var retryableStatusCodes = []codes.Code{codes.Unavailable}
+
+// retriedServices are the services whose streaming methods are retried by a retry policy.
+var retriedServices = []*grpc.ServiceDesc{
+	&storev1.StoreService_ServiceDesc,
+	&routingv1.RoutingService_ServiceDesc,
+	&routingv1.PublicationService_ServiceDesc,
+	&searchv1.SearchService_ServiceDesc,
+	&storev1.SyncService_ServiceDesc,
+	&signv1.SignService_ServiceDesc,
+	&eventsv1.EventService_ServiceDesc,
+	&corev1.InfoService_ServiceDesc,
+	&corev1.OperationService_ServiceDesc,
+	&corev1.TokenService_ServiceDesc,
+}
+
+// RetryPolicy configures the retries of calls failing with a transient error,
+// with exponential backoff between attempts.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a call, including the first one.
+	MaxAttempts int
+
+	// InitialBackoff is the backoff before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the backoff between attempts.
+	MaxBackoff time.Duration
+
+	// BackoffMultiplier is the factor the backoff grows by after every retry.
+	BackoffMultiplier float64
+
+	// Jitter randomizes the backoff of unary calls by up to this fraction, between 0 and 1,
+	// so that callers failing at the same time do not retry at the same time.
+	Jitter float64
+
+	// RetryableCodes are the status codes of the errors that are retried.
+	RetryableCodes []codes.Code
+}
+
+// DefaultRetryPolicy returns a retry policy retrying calls failing because the server
+// is unavailable or overloaded up to 3 times.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:       defaultRetryMaxAttempts,
+		InitialBackoff:    retryInitialBackoff,
+		MaxBackoff:        retryMaxBackoff,
+		BackoffMultiplier: retryBackoffMultiplier,
+		Jitter:            retryJitter,
+		RetryableCodes:    []codes.Code{codes.Unavailable, codes.ResourceExhausted},
+	}
+}
+
+// WithRetryPolicy retries the calls failing with one of the retryable codes of the policy.
+// It takes precedence over the MaxRetries of the config.
+//
+// Unary calls are retried by an interceptor. Streaming calls, such as pushing and pulling
+// records, are retried by gRPC until the first response is received, so that streams are
+// never replayed after the server started sending; gRPC randomizes their backoff itself.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(opts *options) error {
+		if err := policy.validate(); err != nil {
+			return fmt.Errorf("invalid retry policy: %w", err)
+		}
+
+		opts.retryPolicy = &policy
+
+		return nil
+	}
+}
+
+func (p RetryPolicy) validate() error {
+	if p.MaxAttempts < 1 {
+		return errors.New("max attempts must be at least 1")
+	}
+
+	if p.InitialBackoff <= 0 || p.MaxBackoff < p.InitialBackoff {
+		return errors.New("initial backoff must be positive and not greater than max backoff")
+	}
+
+	if p.BackoffMultiplier < 1 {
+		return errors.New("backoff multiplier must be at least 1")
+	}
+
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return errors.New("jitter must be between 0 and 1")
+	}
+
+	if len(p.RetryableCodes) == 0 || slices.Contains(p.RetryableCodes, codes.OK) {
+		return errors.New("at least one retryable error code is required")
+	}
+
+	return nil
+}
+
+// backoff returns the randomized backoff before the given retry, starting at 0.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	backoff := float64(p.InitialBackoff) * math.Pow(p.BackoffMultiplier, float64(retry))
+
+	// Spread the backoff evenly around its value
+	backoff *= 1 + p.Jitter*(2*rand.Float64()-1) //nolint:gosec,mnd
+
+	return time.Duration(min(backoff, float64(p.MaxBackoff)))
+}
+
+// dialOptions returns the dial options retrying the calls of the policy.
+func (p RetryPolicy) dialOptions(logger Logger) []grpc.DialOption {
+	if p.MaxAttempts <= 1 {
+		return nil
+	}
+
+	// Streaming methods are retried by gRPC, as they cannot be replayed by interceptors
+	var methods []map[string]any
+
+	for _, service := range retriedServices {
+		for _, stream := range service.Streams {
+			methods = append(methods, map[string]any{"service": service.ServiceName, "method": stream.StreamName})
+		}
+	}
+
+	return append(
+		serviceConfigRetryOptions(methods, p.MaxAttempts, p.InitialBackoff, p.MaxBackoff, p.BackoffMultiplier, p.RetryableCodes),
+		grpc.WithChainUnaryInterceptor(p.unaryInterceptor(logger)),
+	)
+}
+
+// unaryInterceptor returns an interceptor retrying the unary calls of the policy.
+func (p RetryPolicy) unaryInterceptor(logger Logger) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		for retry := 0; ; retry++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || retry+1 >= p.MaxAttempts || !slices.Contains(p.RetryableCodes, status.Code(err)) {
+				return err
+			}
+
+			backoff := p.backoff(retry)
+			logger.Debug("Retrying call", "method", method, "retry", retry+1, "backoff", backoff, "error", err)
+
+			timer := time.NewTimer(backoff)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				return err
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// retryDialOptions returns the dial options retrying the calls failing with a transient error
+// up to maxRetries times, with exponential backoff. Returns no options if maxRetries is not positive.
+//
+// Retries follow the gRPC retry policy: calls are only retried until the first response
+// is received, so that streams are never replayed after the server started sending.
+func retryDialOptions(maxRetries int) []grpc.DialOption {
+	if maxRetries <= 0 {
+		return nil
+	}
+
+	// Empty name matches all methods
+	return serviceConfigRetryOptions([]map[string]any{{}}, maxRetries+1,
+		retryInitialBackoff, retryMaxBackoff, retryBackoffMultiplier, retryableStatusCodes)
+}
+
+// serviceConfigRetryOptions returns the dial options setting the gRPC retry policy of the given methods.
+func serviceConfigRetryOptions(methods []map[string]any, maxAttempts int, initialBackoff, maxBackoff time.Duration, multiplier float64, retryableCodes []codes.Code) []grpc.DialOption {
+	serviceConfig, _ := json.Marshal(map[string]any{ //nolint:errchkjson
+		"methodConfig": []map[string]any{{
+			"name": methods,
+			"retryPolicy": map[string]any{
+				"maxAttempts":          maxAttempts,
+				"initialBackoff":       durationString(initialBackoff),
+				"maxBackoff":           durationString(maxBackoff),
+				"backoffMultiplier":    multiplier,
+				"retryableStatusCodes": retryableCodes,
+			},
+		}},
+	})
+
+	return []grpc.DialOption{
+		grpc.WithDefaultServiceConfig(string(serviceConfig)),
+		// Lift the default cap of 5 attempts per call
+		grpc.WithMaxCallAttempts(maxAttempts),
+	}
+}
+
+// durationString formats a duration as in service configs, e.g. 0.2s.
+func durationString(d time.Duration) string {
+	return fmt.Sprintf("%gs", d.Seconds())
+}
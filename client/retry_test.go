@@ -0,0 +1,217 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// flakyInfoService fails the first calls with the given code.
+type flakyInfoService struct {
+	corev1.UnimplementedInfoServiceServer
+
+	failures int32
+	code     codes.Code
+	calls    atomic.Int32
+}
+
+func (s *flakyInfoService) GetServerInfo(context.Context, *corev1.GetServerInfoRequest) (*corev1.GetServerInfoResponse, error) {
+	if s.calls.Add(1) <= s.failures {
+		return nil, status.Error(s.code, "flaky")
+	}
+
+	return &corev1.GetServerInfoResponse{}, nil
+}
+
+func TestRetries(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxRetries int
+		failures   int32
+		code       codes.Code
+		wantCode   codes.Code
+		wantCalls  int32
+	}{
+		{
+			name:       "retries disabled",
+			maxRetries: 0,
+			failures:   1,
+			code:       codes.Unavailable,
+			wantCode:   codes.Unavailable,
+			wantCalls:  1,
+		},
+		{
+			name:       "transient errors are retried",
+			maxRetries: 2,
+			failures:   2,
+			code:       codes.Unavailable,
+			wantCode:   codes.OK,
+			wantCalls:  3,
+		},
+		{
+			name:       "retries are bounded",
+			maxRetries: 1,
+			failures:   2,
+			code:       codes.Unavailable,
+			wantCode:   codes.Unavailable,
+			wantCalls:  2,
+		},
+		{
+			name:       "other errors are not retried",
+			maxRetries: 2,
+			failures:   1,
+			code:       codes.InvalidArgument,
+			wantCode:   codes.InvalidArgument,
+			wantCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), testContextTimeout)
+			defer cancel()
+
+			lc := net.ListenConfig{}
+			lis, err := lc.Listen(ctx, "tcp", testServerLocalhost)
+			require.NoError(t, err)
+
+			service := &flakyInfoService{failures: tt.failures, code: tt.code}
+
+			server := grpc.NewServer()
+			corev1.RegisterInfoServiceServer(server, service)
+
+			go func() {
+				_ = server.Serve(lis)
+			}()
+
+			defer server.Stop()
+
+			client, err := New(ctx, WithConfig(&Config{
+				ServerAddress: lis.Addr().String(),
+				AuthMode:      testServerInsecureMode,
+				MaxRetries:    tt.maxRetries,
+			}))
+			require.NoError(t, err)
+
+			defer client.Close()
+
+			_, err = client.GetServerInfo(ctx)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantCalls, service.calls.Load())
+		})
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 10 * time.Millisecond
+
+	tests := []struct {
+		name      string
+		failures  int32
+		code      codes.Code
+		wantCode  codes.Code
+		wantCalls int32
+	}{
+		{
+			name:      "transient errors are retried",
+			failures:  3,
+			code:      codes.ResourceExhausted,
+			wantCode:  codes.OK,
+			wantCalls: 4,
+		},
+		{
+			name:      "attempts are bounded",
+			failures:  4,
+			code:      codes.Unavailable,
+			wantCode:  codes.Unavailable,
+			wantCalls: 4,
+		},
+		{
+			name:      "other errors are not retried",
+			failures:  1,
+			code:      codes.InvalidArgument,
+			wantCode:  codes.InvalidArgument,
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), testContextTimeout)
+			defer cancel()
+
+			lc := net.ListenConfig{}
+			lis, err := lc.Listen(ctx, "tcp", testServerLocalhost)
+			require.NoError(t, err)
+
+			service := &flakyInfoService{failures: tt.failures, code: tt.code}
+
+			server := grpc.NewServer()
+			corev1.RegisterInfoServiceServer(server, service)
+
+			go func() {
+				_ = server.Serve(lis)
+			}()
+
+			defer server.Stop()
+
+			// The policy takes precedence over the retries of the config
+			client, err := New(ctx, WithConfig(&Config{
+				ServerAddress: lis.Addr().String(),
+				AuthMode:      testServerInsecureMode,
+				MaxRetries:    1,
+			}), WithRetryPolicy(policy))
+			require.NoError(t, err)
+
+			defer client.Close()
+
+			_, err = client.GetServerInfo(ctx)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantCalls, service.calls.Load())
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := DefaultRetryPolicy()
+
+	for retry, want := range map[int]time.Duration{
+		0:  200 * time.Millisecond,
+		1:  400 * time.Millisecond,
+		2:  800 * time.Millisecond,
+		10: policy.MaxBackoff,
+	} {
+		jitter := float64(want) * policy.Jitter
+		assert.InDelta(t, want, policy.backoff(retry), jitter, "retry %d", retry)
+	}
+}
+
+func TestWithRetryPolicy_Invalid(t *testing.T) {
+	for _, mutate := range []func(*RetryPolicy){
+		func(p *RetryPolicy) { p.MaxAttempts = 0 },
+		func(p *RetryPolicy) { p.InitialBackoff = 0 },
+		func(p *RetryPolicy) { p.MaxBackoff = p.InitialBackoff / 2 },
+		func(p *RetryPolicy) { p.BackoffMultiplier = 0.5 },
+		func(p *RetryPolicy) { p.Jitter = 1.5 },
+		func(p *RetryPolicy) { p.RetryableCodes = nil },
+	} {
+		policy := DefaultRetryPolicy()
+		mutate(&policy)
+
+		assert.Error(t, WithRetryPolicy(policy)(&options{}))
+	}
+}
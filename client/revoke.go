@@ -0,0 +1,73 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+)
+
+// Revoke revokes signatures of the record.
+// If no signature is set in the request, all signatures of the record are revoked.
+func (c *Client) Revoke(ctx context.Context, req *signv1.RevokeRequest) (*signv1.RevokeResponse, error) {
+	resp, err := c.SignServiceClient.Revoke(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke signatures: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RevokeWithKey revokes the signatures of the record created with the given private key.
+// This allows a signer to revoke its own signatures without affecting other signers.
+func (c *Client) RevokeWithKey(ctx context.Context, recordCID string, privateKey, password []byte, reason string) (*signv1.RevokeResponse, error) {
+	publicKey, err := publicKeyFromPrivate(privateKey, password)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedPayload, err := signv1.CIDPayload(recordCID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate expected payload: %w", err)
+	}
+
+	signatures, err := c.pullSignatureReferrer(ctx, recordCID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull signature referrer: %w", err)
+	}
+
+	response := &signv1.RevokeResponse{}
+	found := false
+
+	// Revoke the signatures created with this key
+	for _, signature := range signatures {
+		if !c.verifySignature(publicKey, signature, expectedPayload) {
+			continue
+		}
+
+		found = true
+		value := signature.GetSignature()
+
+		resp, err := c.Revoke(ctx, &signv1.RevokeRequest{
+			RecordRef: &corev1.RecordRef{Cid: recordCID},
+			Signature: &value,
+			Reason:    reason,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		response.Revocations = append(response.Revocations, resp.GetRevocations()...)
+	}
+
+	if !found {
+		return nil, errors.New("no signatures created with the given key found for record")
+	}
+
+	return response, nil
+}
@@ -10,11 +10,10 @@ import (
 	"io"
 
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
-	"github.com/agntcy/dir/utils/logging"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	"github.com/agntcy/dir/client/streaming"
 )
 
-var logger = logging.Logger("client")
-
 func (c *Client) Publish(ctx context.Context, req *routingv1.PublishRequest) error {
 	_, err := c.RoutingServiceClient.Publish(ctx, req)
 	if err != nil {
@@ -38,11 +37,13 @@ func (c *Client) List(ctx context.Context, req *routingv1.ListRequest) (<-chan *
 		for {
 			obj, err := stream.Recv()
 			if errors.Is(err, io.EOF) {
+				c.logger().Debug("Stream completed", "stream", "routing.List")
+
 				break
 			}
 
 			if err != nil {
-				logger.Error("error receiving object", "error", err)
+				c.logger().Error("error receiving object", "error", err)
 
 				return
 			}
@@ -52,7 +53,7 @@ func (c *Client) List(ctx context.Context, req *routingv1.ListRequest) (<-chan *
 			select {
 			case resCh <- obj:
 			case <-ctx.Done():
-				logger.Error("context cancelled while receiving list response", "error", ctx.Err())
+				c.logger().Error("context cancelled while receiving list response", "error", ctx.Err())
 
 				return
 			}
@@ -76,11 +77,13 @@ func (c *Client) SearchRouting(ctx context.Context, req *routingv1.SearchRequest
 		for {
 			obj, err := stream.Recv()
 			if errors.Is(err, io.EOF) {
+				c.logger().Debug("Stream completed", "stream", "routing.Search")
+
 				break
 			}
 
 			if err != nil {
-				logger.Error("error receiving search result", "error", err)
+				c.logger().Error("error receiving search result", "error", err)
 
 				return
 			}
@@ -90,7 +93,7 @@ func (c *Client) SearchRouting(ctx context.Context, req *routingv1.SearchRequest
 			select {
 			case resCh <- obj:
 			case <-ctx.Done():
-				logger.Error("context cancelled while receiving search response", "error", ctx.Err())
+				c.logger().Error("context cancelled while receiving search response", "error", ctx.Err())
 
 				return
 			}
@@ -108,3 +111,52 @@ func (c *Client) Unpublish(ctx context.Context, req *routingv1.UnpublishRequest)
 
 	return nil
 }
+
+// UnpublishMatching unpublishes all records published by the server that match the queries.
+// With dryRun set, the matching records are returned without being unpublished.
+func (c *Client) UnpublishMatching(ctx context.Context, queries []*searchv1.RecordQuery, dryRun bool) (*routingv1.UnpublishResponse, error) {
+	resp, err := c.RoutingServiceClient.Unpublish(ctx, &routingv1.UnpublishRequest{
+		Request: &routingv1.UnpublishRequest_Queries{
+			Queries: &routingv1.RecordQueries{Queries: queries},
+		},
+		DryRun: dryRun,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpublish matching records: %w", err)
+	}
+
+	return resp, nil
+}
+
+// UnpublishMatchingAsync starts unpublishing all records published by the server that match
+// the queries as a long-running operation, and returns the matching records along with the
+// operation ID. Use WaitOperation to wait for the records to be unpublished.
+func (c *Client) UnpublishMatchingAsync(ctx context.Context, queries []*searchv1.RecordQuery) (*routingv1.UnpublishResponse, error) {
+	resp, err := c.RoutingServiceClient.Unpublish(ctx, &routingv1.UnpublishRequest{
+		Request: &routingv1.UnpublishRequest_Queries{
+			Queries: &routingv1.RecordQueries{Queries: queries},
+		},
+		Async: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start unpublishing matching records: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListPeersStream lists the peers known to the server along with the capabilities
+// they advertised using the ListPeers RPC.
+func (c *Client) ListPeersStream(ctx context.Context) (streaming.StreamResult[routingv1.ListPeersResponse], error) {
+	stream, err := c.RoutingServiceClient.ListPeers(ctx, &routingv1.ListPeersRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create list peers stream: %w", err)
+	}
+
+	result, err := streaming.ProcessServerStream(ctx, stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process list peers stream: %w", err)
+	}
+
+	return result, nil
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 
 	searchv1 "github.com/agntcy/dir/api/search/v1"
+	"github.com/agntcy/dir/client/streaming"
 )
 
 func (c *Client) Search(ctx context.Context, req *searchv1.SearchRequest) (<-chan string, error) {
@@ -26,11 +27,13 @@ func (c *Client) Search(ctx context.Context, req *searchv1.SearchRequest) (<-cha
 		for {
 			obj, err := stream.Recv()
 			if errors.Is(err, io.EOF) {
+				c.logger().Debug("Stream completed", "stream", "search.Search")
+
 				break
 			}
 
 			if err != nil {
-				logger.Error("failed to receive search response", "error", err)
+				c.logger().Error("failed to receive search response", "error", err)
 
 				return
 			}
@@ -38,7 +41,7 @@ func (c *Client) Search(ctx context.Context, req *searchv1.SearchRequest) (<-cha
 			select {
 			case resultCh <- obj.GetRecordCid():
 			case <-ctx.Done():
-				logger.Error("context cancelled while receiving search response", "error", ctx.Err())
+				c.logger().Error("context cancelled while receiving search response", "error", ctx.Err())
 
 				return
 			}
@@ -47,3 +50,57 @@ func (c *Client) Search(ctx context.Context, req *searchv1.SearchRequest) (<-cha
 
 	return resultCh, nil
 }
+
+// SearchStream searches for records like Search, but returns the full responses,
+// which also carry the snapshot time of the search index the results were read from.
+// Pass the snapshot time in the requests for further pages to read them at the same point.
+// The responses also carry a watermark, to pass as since_watermark in a later request
+// to only receive the records added or updated in the meantime.
+func (c *Client) SearchStream(ctx context.Context, req *searchv1.SearchRequest) (streaming.StreamResult[searchv1.SearchResponse], error) {
+	stream, err := c.SearchServiceClient.Search(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create search stream: %w", err)
+	}
+
+	result, err := streaming.ProcessServerStream(ctx, stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process search stream: %w", err)
+	}
+
+	return result, nil
+}
+
+// SearchPageResult is a page of search results, see SearchPage.
+type SearchPageResult struct {
+	// Results are the responses of the page, ordered by record CID.
+	Results []*searchv1.SearchResponse
+
+	// NextPageToken is the token to pass as page_token to read the next page, empty on the last page.
+	NextPageToken string
+}
+
+// SearchPage reads a page of the results of a search, to read large result sets incrementally.
+// Set the page size of the request, and pass the NextPageToken of a page as page_token
+// in the same request to read the next page, until the token is empty.
+func (c *Client) SearchPage(ctx context.Context, req *searchv1.SearchRequest) (*SearchPageResult, error) {
+	stream, err := c.SearchServiceClient.Search(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create search stream: %w", err)
+	}
+
+	page := &SearchPageResult{}
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return page, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to receive search response: %w", err)
+		}
+
+		page.Results = append(page.Results, resp)
+		page.NextPageToken = resp.GetNextPageToken()
+	}
+}
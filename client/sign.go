@@ -11,9 +11,19 @@ import (
 	corev1 "github.com/agntcy/dir/api/core/v1"
 	signv1 "github.com/agntcy/dir/api/sign/v1"
 	storev1 "github.com/agntcy/dir/api/store/v1"
-	"github.com/agntcy/dir/utils/cosign"
 )
 
+// ErrSigningUnsupported is returned by the signing methods of clients built with the
+// dir_minimal build tag, which leaves out the sigstore dependencies used to sign records.
+var ErrSigningUnsupported = errors.New("signing is not supported by clients built with the dir_minimal tag")
+
+// signedBlob is a signature created by the signer of the client.
+type signedBlob struct {
+	Signature   string
+	PublicKey   string
+	Certificate string
+}
+
 type SignOpts struct {
 	FulcioURL       string
 	RekorURL        string
@@ -52,38 +62,19 @@ func (c *Client) SignWithOIDC(ctx context.Context, req *signv1.SignRequest) (*si
 
 	oidcSigner := req.GetProvider().GetOidc()
 
-	digest, err := corev1.ConvertCIDToDigest(req.GetRecordRef().GetCid())
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert CID to digest: %w", err)
-	}
-
-	payloadBytes, err := cosign.GeneratePayload(digest.String())
+	payloadBytes, err := signv1.CIDPayload(req.GetRecordRef().GetCid())
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate payload: %w", err)
 	}
 
-	// Prepare options for signing
-	signOpts := &cosign.SignBlobOIDCOptions{
-		Payload: payloadBytes,
-		IDToken: oidcSigner.GetIdToken(),
-	}
-
-	// Set URLs from options if provided
-	if opts := oidcSigner.GetOptions(); opts != nil {
-		signOpts.FulcioURL = opts.GetFulcioUrl()
-		signOpts.RekorURL = opts.GetRekorUrl()
-		signOpts.TimestampURL = opts.GetTimestampUrl()
-		signOpts.OIDCProviderURL = opts.GetOidcProviderUrl()
-	}
-
-	// Sign using utility function
-	result, err := cosign.SignBlobWithOIDC(ctx, signOpts)
+	result, err := signBlobWithOIDC(ctx, payloadBytes, oidcSigner)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign with OIDC: %w", err)
 	}
 
 	signatureObj := &signv1.Signature{
-		Signature: result.Signature,
+		Signature:   result.Signature,
+		Certificate: result.Certificate,
 		Annotations: map[string]string{
 			"payload": string(payloadBytes),
 		},
@@ -108,25 +99,12 @@ func (c *Client) SignWithKey(ctx context.Context, req *signv1.SignRequest) (*sig
 		password = []byte("") // Empty password is valid for cosign.
 	}
 
-	digest, err := corev1.ConvertCIDToDigest(req.GetRecordRef().GetCid())
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert CID to digest: %w", err)
-	}
-
-	payloadBytes, err := cosign.GeneratePayload(digest.String())
+	payloadBytes, err := signv1.CIDPayload(req.GetRecordRef().GetCid())
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate payload: %w", err)
 	}
 
-	// Prepare options for signing
-	signOpts := &cosign.SignBlobKeyOptions{
-		Payload:    payloadBytes,
-		PrivateKey: keySigner.GetPrivateKey(),
-		Password:   password,
-	}
-
-	// Sign using utility function
-	result, err := cosign.SignBlobWithKey(ctx, signOpts)
+	result, err := signBlobWithKey(ctx, payloadBytes, keySigner.GetPrivateKey(), password)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign with key: %w", err)
 	}
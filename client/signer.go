@@ -0,0 +1,82 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !dir_minimal
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/agntcy/dir/utils/cosign"
+)
+
+// clientSideVerification reports whether signatures can be verified by the client
+// when the server fails to verify them.
+const clientSideVerification = true
+
+// signBlobWithOIDC signs the payload with a short-lived certificate issued for the OIDC identity of the signer.
+func signBlobWithOIDC(ctx context.Context, payload []byte, signer *signv1.SignWithOIDC) (*signedBlob, error) {
+	signOpts := &cosign.SignBlobOIDCOptions{
+		Payload: payload,
+		IDToken: signer.GetIdToken(),
+	}
+
+	// Set URLs from options if provided
+	if opts := signer.GetOptions(); opts != nil {
+		signOpts.FulcioURL = opts.GetFulcioUrl()
+		signOpts.RekorURL = opts.GetRekorUrl()
+		signOpts.TimestampURL = opts.GetTimestampUrl()
+		signOpts.OIDCProviderURL = opts.GetOidcProviderUrl()
+	}
+
+	result, err := cosign.SignBlobWithOIDC(ctx, signOpts)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &signedBlob{
+		Signature:   result.Signature,
+		PublicKey:   result.PublicKey,
+		Certificate: result.Certificate,
+	}, nil
+}
+
+// signBlobWithKey signs the payload with a cosign private key.
+func signBlobWithKey(ctx context.Context, payload, privateKey, password []byte) (*signedBlob, error) {
+	result, err := cosign.SignBlobWithKey(ctx, &cosign.SignBlobKeyOptions{
+		Payload:    payload,
+		PrivateKey: privateKey,
+		Password:   password,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &signedBlob{
+		Signature: result.Signature,
+		PublicKey: result.PublicKey,
+	}, nil
+}
+
+// verifyBlob checks the signature of the payload using the PEM-encoded public key.
+func verifyBlob(publicKey []byte, signature string, payload []byte) error {
+	return cosign.VerifySignature(publicKey, signature, payload) //nolint:wrapcheck
+}
+
+// publicKeyFromPrivate returns the PEM-encoded public key of a cosign private key.
+func publicKeyFromPrivate(privateKey, password []byte) (string, error) {
+	keypair, err := cosign.LoadKeypair(privateKey, password)
+	if err != nil {
+		return "", fmt.Errorf("failed to load private key: %w", err)
+	}
+
+	publicKey, err := keypair.GetPublicKeyPem()
+	if err != nil {
+		return "", fmt.Errorf("failed to get public key: %w", err)
+	}
+
+	return publicKey, nil
+}
@@ -0,0 +1,31 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build dir_minimal
+
+package client
+
+import (
+	"context"
+
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+)
+
+// clientSideVerification is disabled, so minimal clients rely on the server to verify signatures.
+const clientSideVerification = false
+
+func signBlobWithOIDC(context.Context, []byte, *signv1.SignWithOIDC) (*signedBlob, error) {
+	return nil, ErrSigningUnsupported
+}
+
+func signBlobWithKey(context.Context, []byte, []byte, []byte) (*signedBlob, error) {
+	return nil, ErrSigningUnsupported
+}
+
+func verifyBlob([]byte, string, []byte) error {
+	return ErrSigningUnsupported
+}
+
+func publicKeyFromPrivate([]byte, []byte) (string, error) {
+	return "", ErrSigningUnsupported
+}
@@ -0,0 +1,60 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+)
+
+// CreateVerificationSnapshot verifies the record on the server and stores the result
+// as an immutable snapshot attached to the record.
+func (c *Client) CreateVerificationSnapshot(ctx context.Context, recordCID string) (*signv1.VerificationSnapshot, error) {
+	resp, err := c.SignServiceClient.CreateVerificationSnapshot(ctx, &signv1.CreateVerificationSnapshotRequest{
+		RecordRef: &corev1.RecordRef{Cid: recordCID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create verification snapshot: %w", err)
+	}
+
+	return resp.GetSnapshot(), nil
+}
+
+// ListVerificationSnapshots retrieves the verification snapshots attached to the record.
+func (c *Client) ListVerificationSnapshots(ctx context.Context, recordCID string) ([]*signv1.VerificationSnapshot, error) {
+	snapshotType := corev1.VerificationSnapshotReferrerType
+
+	resultCh, err := c.PullReferrer(ctx, &storev1.PullReferrerRequest{
+		RecordRef: &corev1.RecordRef{
+			Cid: recordCID,
+		},
+		ReferrerType: &snapshotType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull verification snapshot referrer: %w", err)
+	}
+
+	snapshots := make([]*signv1.VerificationSnapshot, 0)
+
+	// Get all snapshot responses and decode them from referrer data
+	for response := range resultCh {
+		referrer := response.GetReferrer()
+		if referrer != nil {
+			snapshot := &signv1.VerificationSnapshot{}
+			if err := snapshot.UnmarshalReferrer(referrer); err != nil {
+				c.logger().Error("Failed to decode verification snapshot from referrer", "error", err)
+
+				continue
+			}
+
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+
+	return snapshots, nil
+}
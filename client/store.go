@@ -11,7 +11,9 @@ import (
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
 	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/client/batch"
 	"github.com/agntcy/dir/client/streaming"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -46,7 +48,18 @@ func (c *Client) PullStream(ctx context.Context, refsCh <-chan *corev1.RecordRef
 
 // Pull retrieves a single record from the store using its reference.
 // This is a convenience wrapper around PullBatch for single-record operations.
+// Records are served from the cache of the client if it was created WithCache.
 func (c *Client) Pull(ctx context.Context, recordRef *corev1.RecordRef) (*corev1.Record, error) {
+	var generation uint64
+
+	if c.cache != nil {
+		if record, ok := c.cache.GetRecord(recordRef.GetCid()); ok {
+			return record, nil
+		}
+
+		generation = c.cache.Generation()
+	}
+
 	records, err := c.PullBatch(ctx, []*corev1.RecordRef{recordRef})
 	if err != nil {
 		return nil, err
@@ -56,6 +69,11 @@ func (c *Client) Pull(ctx context.Context, recordRef *corev1.RecordRef) (*corev1
 		return nil, errors.New("no data returned")
 	}
 
+	// Only cache records matching the requested CID
+	if c.cache != nil && records[0].GetCid() == recordRef.GetCid() {
+		c.cache.PutRecord(generation, recordRef.GetCid(), records[0])
+	}
+
 	return records[0], nil
 }
 
@@ -102,7 +120,16 @@ func (c *Client) PushStream(ctx context.Context, recordsCh <-chan *corev1.Record
 // PushBatch sends multiple records in a single stream for efficiency.
 // This is a convenience method that accepts a slice and returns a slice,
 // built on top of the streaming implementation for consistency.
+// Records are checked locally first if the client was created WithPushPrevalidation.
 func (c *Client) PushBatch(ctx context.Context, records []*corev1.Record) ([]*corev1.RecordRef, error) {
+	if c.prevalidatePush {
+		for i, record := range records {
+			if _, err := c.PrevalidatePush(ctx, record); err != nil {
+				return nil, fmt.Errorf("record %d failed pre-validation: %w", i, err)
+			}
+		}
+	}
+
 	// Use channel to communicate error safely (no race condition)
 	result, err := c.PushStream(ctx, streaming.SliceToChan(ctx, records))
 	if err != nil {
@@ -126,6 +153,68 @@ func (c *Client) PushBatch(ctx context.Context, records []*corev1.Record) ([]*co
 	}
 }
 
+// PushManyStream uploads records using the PushMany RPC, returning a result for each record.
+// Unlike PushStream, records failing validation or storage do not cancel the stream,
+// which makes it suitable for large imports.
+func (c *Client) PushManyStream(ctx context.Context, recordsCh <-chan *corev1.Record) (streaming.StreamResult[storev1.PushManyResponse], error) {
+	stream, err := c.StoreServiceClient.PushMany(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create push many stream: %w", err)
+	}
+
+	//nolint:wrapcheck
+	return streaming.ProcessBidiStream(ctx, stream, recordsCh)
+}
+
+// PushMany sends multiple records using the PushMany RPC and returns the per-record results
+// in the order the records were given. The returned error is only set if the stream failed.
+func (c *Client) PushMany(ctx context.Context, records []*corev1.Record) ([]*storev1.PushManyResponse, error) {
+	result, err := c.PushManyStream(ctx, streaming.SliceToChan(ctx, records))
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for results
+	var errs error
+
+	var results []*storev1.PushManyResponse
+
+	for {
+		select {
+		case err := <-result.ErrCh():
+			errs = errors.Join(errs, err)
+		case resp := <-result.ResCh():
+			results = append(results, resp)
+		case <-result.DoneCh():
+			return results, errs
+		}
+	}
+}
+
+// PushManyResult sends multiple records using the PushMany RPC and returns a batch result
+// with the status, error code and CID of each record. Records without a response are skipped.
+// The returned error is only set if the stream failed.
+func (c *Client) PushManyResult(ctx context.Context, records []*corev1.Record) (*batch.Result, error) {
+	responses, err := c.PushMany(ctx, records)
+
+	result := batch.NewResult(len(records))
+
+	for _, resp := range responses {
+		index := int(resp.GetIndex())
+		if index >= len(records) {
+			continue
+		}
+
+		if resp.ErrorMessage != nil {
+			result.FailWithCode(index, codes.Code(resp.GetErrorCode()), resp.GetErrorMessage())
+		} else {
+			result.Succeed(index, resp.GetRecordRef().GetCid())
+		}
+	}
+
+	return result, err
+}
+
 // PushReferrer stores a signature using the PushReferrer RPC.
 func (c *Client) PushReferrer(ctx context.Context, req *storev1.PushReferrerRequest) error {
 	// Create streaming client
@@ -153,6 +242,157 @@ func (c *Client) PushReferrer(ctx context.Context, req *storev1.PushReferrerRequ
 	return nil
 }
 
+// PushBundle stores a record together with its signature and attestations
+// using the PushBundle RPC. Either the complete bundle is stored or nothing is.
+func (c *Client) PushBundle(ctx context.Context, req *storev1.PushBundleRequest) (*corev1.RecordRef, error) {
+	resp, err := c.StoreServiceClient.PushBundle(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to push bundle: %w", err)
+	}
+
+	return resp.GetRecordRef(), nil
+}
+
+// ApplyTransaction applies a group of push and delete operations atomically
+// using the ApplyTransaction RPC. Either all operations are applied or none.
+func (c *Client) ApplyTransaction(ctx context.Context, operations []*storev1.TransactionOperation) (*storev1.ApplyTransactionResponse, error) {
+	resp, err := c.StoreServiceClient.ApplyTransaction(ctx, &storev1.ApplyTransactionRequest{
+		Operations: operations,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply transaction: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetDependencies retrieves the records referenced by a record using the GetDependencies RPC.
+func (c *Client) GetDependencies(ctx context.Context, recordRef *corev1.RecordRef, recursive bool) (*storev1.GetDependenciesResponse, error) {
+	resp, err := c.StoreServiceClient.GetDependencies(ctx, &storev1.GetDependenciesRequest{
+		RecordRef: recordRef,
+		Recursive: recursive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dependencies: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetDependents retrieves the records referencing a record using the GetDependents RPC.
+func (c *Client) GetDependents(ctx context.Context, recordRef *corev1.RecordRef, recursive bool) (*storev1.GetDependentsResponse, error) {
+	resp, err := c.StoreServiceClient.GetDependents(ctx, &storev1.GetDependentsRequest{
+		RecordRef: recordRef,
+		Recursive: recursive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dependents: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ResolveLocator selects the locator of a record nearest to the preferred regions, most preferred first.
+// Only locators of the given type are considered, unless it is empty.
+// The server region is preferred if no region is given.
+func (c *Client) ResolveLocator(ctx context.Context, recordRef *corev1.RecordRef, locatorType string, preferredRegions ...string) (*storev1.ResolveLocatorResponse, error) {
+	resp, err := c.StoreServiceClient.ResolveLocator(ctx, &storev1.ResolveLocatorRequest{
+		RecordRef:        recordRef,
+		Type:             locatorType,
+		PreferredRegions: preferredRegions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve locator: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CheckConsistency compares the records of the search database, content store and
+// routing datastore of the server. Discrepancies between the search database and
+// the content store are repaired if repair is set.
+func (c *Client) CheckConsistency(ctx context.Context, repair bool) (*storev1.CheckConsistencyResponse, error) {
+	resp, err := c.StoreServiceClient.CheckConsistency(ctx, &storev1.CheckConsistencyRequest{
+		Repair: repair,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check consistency: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CheckConsistencyAsync starts checking the consistency of the records of the server as a
+// long-running operation, and returns the operation ID. Use WaitOperation to wait for the
+// check to complete; its response is the consistency report.
+func (c *Client) CheckConsistencyAsync(ctx context.Context, repair bool) (*storev1.CheckConsistencyResponse, error) {
+	resp, err := c.StoreServiceClient.CheckConsistency(ctx, &storev1.CheckConsistencyRequest{
+		Repair: repair,
+		Async:  true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start consistency check: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GarbageCollect removes the content of the content store of the server that is no
+// longer referenced by any record, and reports what was removed.
+func (c *Client) GarbageCollect(ctx context.Context) (*storev1.GarbageCollectResponse, error) {
+	resp, err := c.StoreServiceClient.GarbageCollect(ctx, &storev1.GarbageCollectRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect garbage: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GarbageCollectAsync starts collecting the garbage of the content store of the server as a
+// long-running operation, and returns the operation ID. Use WaitOperation to wait for the
+// collection to complete; its response is the garbage collection report.
+func (c *Client) GarbageCollectAsync(ctx context.Context) (*storev1.GarbageCollectResponse, error) {
+	resp, err := c.StoreServiceClient.GarbageCollect(ctx, &storev1.GarbageCollectRequest{
+		Async: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start garbage collection: %w", err)
+	}
+
+	return resp, nil
+}
+
+// UpdateRecord applies an RFC 6902 JSON patch to a stored record using the UpdateRecord RPC.
+// The result is pushed as a new record linked to the patched record, whose reference is returned.
+func (c *Client) UpdateRecord(ctx context.Context, recordRef *corev1.RecordRef, patch []byte) (*corev1.RecordRef, error) {
+	resp, err := c.StoreServiceClient.UpdateRecord(ctx, &storev1.UpdateRecordRequest{
+		RecordRef: recordRef,
+		Patch:     patch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update record: %w", err)
+	}
+
+	return resp.GetRecordRef(), nil
+}
+
+// ValidateStoredStream re-validates stored records against the current validation
+// rules using the ValidateStored RPC. All stored records are re-validated if no
+// record references are given.
+func (c *Client) ValidateStoredStream(ctx context.Context, req *storev1.ValidateStoredRequest) (streaming.StreamResult[storev1.ValidateStoredResponse], error) {
+	stream, err := c.ValidateStored(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create validate stored stream: %w", err)
+	}
+
+	result, err := streaming.ProcessServerStream(ctx, stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process validate stored stream: %w", err)
+	}
+
+	return result, nil
+}
+
 // PullReferrer retrieves all referrers using the PullReferrer RPC.
 func (c *Client) PullReferrer(ctx context.Context, req *storev1.PullReferrerRequest) (<-chan *storev1.PullReferrerResponse, error) {
 	// Create streaming client
@@ -179,11 +419,13 @@ func (c *Client) PullReferrer(ctx context.Context, req *storev1.PullReferrerRequ
 		for {
 			response, err := stream.Recv()
 			if errors.Is(err, io.EOF) {
+				c.logger().Debug("Stream completed", "stream", "store.PullReferrer")
+
 				break
 			}
 
 			if err != nil {
-				logger.Error("failed to receive pull referrer response", "error", err)
+				c.logger().Error("failed to receive pull referrer response", "error", err)
 
 				return
 			}
@@ -191,7 +433,7 @@ func (c *Client) PullReferrer(ctx context.Context, req *storev1.PullReferrerRequ
 			select {
 			case resultCh <- response:
 			case <-ctx.Done():
-				logger.Error("context cancelled while receiving pull referrer response", "error", ctx.Err())
+				c.logger().Error("context cancelled while receiving pull referrer response", "error", ctx.Err())
 
 				return
 			}
@@ -256,6 +498,19 @@ func (c *Client) LookupStream(ctx context.Context, refsCh <-chan *corev1.RecordR
 	return streaming.ProcessBidiStream(ctx, stream, refsCh)
 }
 
+// RecordInfo retrieves a consolidated view of the record state on the server,
+// including storage, sync, publication, and signature details.
+func (c *Client) RecordInfo(ctx context.Context, recordRef *corev1.RecordRef) (*storev1.RecordInfoResponse, error) {
+	resp, err := c.StoreServiceClient.RecordInfo(ctx, &storev1.RecordInfoRequest{
+		RecordRef: recordRef,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get record info: %w", err)
+	}
+
+	return resp, nil
+}
+
 // Delete removes a record from the store using its reference.
 func (c *Client) Delete(ctx context.Context, recordRef *corev1.RecordRef) error {
 	return c.DeleteBatch(ctx, []*corev1.RecordRef{recordRef})
@@ -263,6 +518,13 @@ func (c *Client) Delete(ctx context.Context, recordRef *corev1.RecordRef) error
 
 // DeleteBatch removes multiple records from the store in a single stream for efficiency.
 func (c *Client) DeleteBatch(ctx context.Context, recordRefs []*corev1.RecordRef) error {
+	// Evict the records from the cache, even if deleting them fails part way
+	if c.cache != nil {
+		for _, recordRef := range recordRefs {
+			c.cache.EvictRecord(recordRef.GetCid())
+		}
+	}
+
 	// Use channel to communicate error safely (no race condition)
 	result, err := c.DeleteStream(ctx, streaming.SliceToChan(ctx, recordRefs))
 	if err != nil {
@@ -8,14 +8,27 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	storev1 "github.com/agntcy/dir/api/store/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
-func (c *Client) CreateSync(ctx context.Context, remoteURL string, cids []string) (string, error) {
+// CreateSync creates a sync from the remote Directory node.
+// Mirrored records are renamed into local namespaces with the first matching mapping, if any.
+func (c *Client) CreateSync(ctx context.Context, remoteURL string, cids []string, mappings ...*storev1.NamespaceMapping) (string, error) {
+	return c.CreateTrustedSync(ctx, remoteURL, cids, nil, mappings...)
+}
+
+// CreateTrustedSync creates a sync from the remote Directory node like CreateSync.
+// Mirrored records without a valid signature from one of the trusted PEM-encoded public keys
+// are quarantined instead of indexed. Signatures are not verified if no keys are given.
+func (c *Client) CreateTrustedSync(ctx context.Context, remoteURL string, cids []string, trustedPublicKeys []string, mappings ...*storev1.NamespaceMapping) (string, error) {
 	meta, err := c.SyncServiceClient.CreateSync(ctx, &storev1.CreateSyncRequest{
 		RemoteDirectoryUrl: remoteURL,
 		Cids:               cids,
+		NamespaceMappings:  mappings,
+		TrustPolicy:        newSyncTrustPolicy(trustedPublicKeys),
 	})
 	if err != nil {
 		return "", fmt.Errorf("failed to create sync: %w", err)
@@ -38,11 +51,13 @@ func (c *Client) ListSyncs(ctx context.Context, req *storev1.ListSyncsRequest) (
 		for {
 			item, err := stream.Recv()
 			if errors.Is(err, io.EOF) {
+				c.logger().Debug("Stream completed", "stream", "sync.ListSyncs")
+
 				break
 			}
 
 			if err != nil {
-				logger.Error("failed to receive list syncs response", "error", err)
+				c.logger().Error("failed to receive list syncs response", "error", err)
 
 				break
 			}
@@ -50,6 +65,8 @@ func (c *Client) ListSyncs(ctx context.Context, req *storev1.ListSyncsRequest) (
 			select {
 			case resultCh <- item:
 			case <-ctx.Done():
+				c.logger().Debug("context cancelled while receiving list syncs response", "error", ctx.Err())
+
 				return
 			}
 		}
@@ -79,3 +96,115 @@ func (c *Client) DeleteSync(ctx context.Context, syncID string) error {
 
 	return nil
 }
+
+func (c *Client) WarmCache(ctx context.Context, req *storev1.WarmCacheRequest) (*storev1.WarmCacheResponse, error) {
+	resp, err := c.SyncServiceClient.WarmCache(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to warm cache: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CreateSyncInvitation issues an invitation token for a remote Directory node to sync
+// the given records from the server. All records are synchronized if no CIDs are given.
+// The server default validity period is used if ttl is zero.
+func (c *Client) CreateSyncInvitation(ctx context.Context, cids []string, ttl time.Duration) (*storev1.CreateSyncInvitationResponse, error) {
+	req := &storev1.CreateSyncInvitationRequest{
+		Cids: cids,
+	}
+
+	if ttl > 0 {
+		req.Ttl = durationpb.New(ttl)
+	}
+
+	resp, err := c.SyncServiceClient.CreateSyncInvitation(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sync invitation: %w", err)
+	}
+
+	return resp, nil
+}
+
+// AcceptSyncInvitation creates a sync from the remote Directory node that issued the invitation token.
+// Mirrored records are renamed into local namespaces with the first matching mapping, if any.
+func (c *Client) AcceptSyncInvitation(ctx context.Context, token string, mappings ...*storev1.NamespaceMapping) (*storev1.AcceptSyncInvitationResponse, error) {
+	return c.AcceptTrustedSyncInvitation(ctx, token, nil, mappings...)
+}
+
+// AcceptTrustedSyncInvitation creates a sync from the remote Directory node that issued the invitation token,
+// quarantining mirrored records without a valid signature from one of the trusted public keys as for CreateTrustedSync.
+func (c *Client) AcceptTrustedSyncInvitation(ctx context.Context, token string, trustedPublicKeys []string, mappings ...*storev1.NamespaceMapping) (*storev1.AcceptSyncInvitationResponse, error) {
+	resp, err := c.SyncServiceClient.AcceptSyncInvitation(ctx, &storev1.AcceptSyncInvitationRequest{
+		Token:             token,
+		NamespaceMappings: mappings,
+		TrustPolicy:       newSyncTrustPolicy(trustedPublicKeys),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept sync invitation: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListQuarantinedRecords lists the records quarantined by the trust policies of syncs that are not released.
+func (c *Client) ListQuarantinedRecords(ctx context.Context, req *storev1.ListQuarantinedRecordsRequest) (<-chan *storev1.QuarantinedRecord, error) {
+	stream, err := c.SyncServiceClient.ListQuarantinedRecords(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create list quarantined records stream: %w", err)
+	}
+
+	resultCh := make(chan *storev1.QuarantinedRecord)
+
+	go func() {
+		defer close(resultCh)
+
+		for {
+			item, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				c.logger().Debug("Stream completed", "stream", "sync.ListQuarantinedRecords")
+
+				break
+			}
+
+			if err != nil {
+				c.logger().Error("failed to receive list quarantined records response", "error", err)
+
+				break
+			}
+
+			select {
+			case resultCh <- item:
+			case <-ctx.Done():
+				c.logger().Debug("context cancelled while receiving list quarantined records response", "error", ctx.Err())
+
+				return
+			}
+		}
+	}()
+
+	return resultCh, nil
+}
+
+// ReleaseQuarantinedRecord indexes a quarantined record after review.
+// Returns the CID of the indexed record, which differs from the given CID
+// if the record was renamed by a namespace mapping of its sync.
+func (c *Client) ReleaseQuarantinedRecord(ctx context.Context, cid string) (string, error) {
+	resp, err := c.SyncServiceClient.ReleaseQuarantinedRecord(ctx, &storev1.ReleaseQuarantinedRecordRequest{
+		Cid: cid,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to release quarantined record: %w", err)
+	}
+
+	return resp.GetCid(), nil
+}
+
+// newSyncTrustPolicy returns the trust policy of a sync, nil if no trusted public keys are given.
+func newSyncTrustPolicy(trustedPublicKeys []string) *storev1.SyncTrustPolicy {
+	if len(trustedPublicKeys) == 0 {
+		return nil
+	}
+
+	return &storev1.SyncTrustPolicy{TrustedPublicKeys: trustedPublicKeys}
+}
@@ -0,0 +1,76 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// CreateToken mints a short-lived API token for a namespace, granting the API methods of the scopes.
+// The validity period defaults to the one configured on the server if zero.
+func (c *Client) CreateToken(ctx context.Context, namespace string, scopes []corev1.TokenScope, ttl time.Duration) (*corev1.Token, error) {
+	req := &corev1.CreateTokenRequest{
+		Namespace: namespace,
+		Scopes:    scopes,
+	}
+
+	if ttl > 0 {
+		req.Ttl = durationpb.New(ttl)
+	}
+
+	token, err := c.TokenServiceClient.CreateToken(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token: %w", err)
+	}
+
+	return token, nil
+}
+
+// ExchangeToken mints a short-lived API token in exchange for an OIDC ID token, e.g. issued to a CI job.
+// The scopes default to the ones the ID token may be exchanged for if empty,
+// and the validity period to the one configured on the server if zero.
+func (c *Client) ExchangeToken(ctx context.Context, idToken string, scopes []corev1.TokenScope, ttl time.Duration) (*corev1.Token, error) {
+	req := &corev1.ExchangeTokenRequest{
+		IdToken: idToken,
+		Scopes:  scopes,
+	}
+
+	if ttl > 0 {
+		req.Ttl = durationpb.New(ttl)
+	}
+
+	token, err := c.TokenServiceClient.ExchangeToken(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange token: %w", err)
+	}
+
+	return token, nil
+}
+
+// apiTokenCredentials attaches an API token to all requests as bearer token.
+type apiTokenCredentials struct {
+	token string
+}
+
+func (c *apiTokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{
+		"authorization": "Bearer " + c.token,
+	}, nil
+}
+
+// Returns true because API tokens must not be sent in clear text.
+func (c *apiTokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// newAPITokenCredentials creates a new PerRPCCredentials that sends the API token.
+func newAPITokenCredentials(token string) credentials.PerRPCCredentials {
+	return &apiTokenCredentials{token: token}
+}
@@ -4,20 +4,19 @@
 package client
 
 import (
-	"bytes"
 	"context"
-	"crypto"
-	"encoding/base64"
 	"errors"
 	"fmt"
+	"slices"
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
 	signv1 "github.com/agntcy/dir/api/sign/v1"
 	storev1 "github.com/agntcy/dir/api/store/v1"
-	cosignutils "github.com/agntcy/dir/utils/cosign"
-	sigs "github.com/sigstore/cosign/v2/pkg/signature"
 )
 
+// errSignaturesRevoked is returned when all signatures of a record have been revoked.
+var errSignaturesRevoked = errors.New("all record signatures have been revoked")
+
 // Verify verifies the signature of the record.
 func (c *Client) Verify(ctx context.Context, req *signv1.VerifyRequest) (*signv1.VerifyResponse, error) {
 	// Server-side verification
@@ -26,12 +25,14 @@ func (c *Client) Verify(ctx context.Context, req *signv1.VerifyRequest) (*signv1
 		return nil, fmt.Errorf("server verification failed: %w", err)
 	}
 
-	if response.GetSuccess() {
+	// Revoked signatures cannot be verified client-side either,
+	// and minimal clients cannot verify signatures themselves
+	if response.GetSuccess() || response.GetRevoked() || !clientSideVerification {
 		return response, nil
 	}
 
 	// Fall back to client-side verification
-	logger.Info("Server verification failed, falling back to client-side verification")
+	c.logger().Info("Server verification failed, falling back to client-side verification")
 
 	var errMsg string
 
@@ -43,20 +44,68 @@ func (c *Client) Verify(ctx context.Context, req *signv1.VerifyRequest) (*signv1
 	return &signv1.VerifyResponse{
 		Success:      verified,
 		ErrorMessage: &errMsg,
+		Revoked:      errors.Is(err, errSignaturesRevoked),
 	}, nil
 }
 
-// verifyClientSide performs client-side signature verification using OCI referrers.
-func (c *Client) verifyClientSide(ctx context.Context, recordCID string) (bool, error) {
-	logger.Debug("Starting client-side verification", "recordCID", recordCID)
+// RecordVerification is the result of verifying a record served by a Directory.
+type RecordVerification struct {
+	// CIDVerified reports whether the content of the record matches its CID.
+	CIDVerified bool `json:"cid_verified"`
 
-	// Generate the expected payload for this record CID
-	digest, err := corev1.ConvertCIDToDigest(recordCID)
+	// SignatureVerified reports whether a signature of the record verifies.
+	SignatureVerified bool `json:"signature_verified"`
+
+	// Error explains why the record could not be verified.
+	Error string `json:"error,omitempty"`
+}
+
+// Verified reports whether both the CID and a signature of the record verify.
+func (v *RecordVerification) Verified() bool {
+	return v.CIDVerified && v.SignatureVerified
+}
+
+// VerifyRecord pulls a record and verifies that its content matches its CID
+// and that it carries a valid signature, e.g. to check a record announced by
+// a remote Directory before syncing it. Unsigned records are not verified.
+func (c *Client) VerifyRecord(ctx context.Context, recordRef *corev1.RecordRef) *RecordVerification {
+	record, err := c.Pull(ctx, recordRef)
 	if err != nil {
-		return false, fmt.Errorf("failed to convert CID to digest: %w", err)
+		return &RecordVerification{Error: fmt.Sprintf("failed to pull record: %v", err)}
 	}
 
-	expectedPayload, err := cosignutils.GeneratePayload(digest.String())
+	// The CID is derived from the content, so a matching CID proves the record is intact
+	if cid := record.GetCid(); cid != recordRef.GetCid() {
+		return &RecordVerification{Error: fmt.Sprintf("pulled record %s instead of %s", cid, recordRef.GetCid())}
+	}
+
+	verification := &RecordVerification{CIDVerified: true}
+
+	response, err := c.Verify(ctx, &signv1.VerifyRequest{RecordRef: recordRef})
+
+	switch {
+	case err != nil:
+		verification.Error = err.Error()
+	case response.GetRevoked():
+		verification.Error = errSignaturesRevoked.Error()
+	case !response.GetSuccess():
+		verification.Error = "signature verification failed"
+		if msg := response.GetErrorMessage(); msg != "" {
+			verification.Error += ": " + msg
+		}
+	default:
+		verification.SignatureVerified = true
+	}
+
+	return verification
+}
+
+// verifyClientSide performs client-side signature verification using OCI referrers.
+func (c *Client) verifyClientSide(ctx context.Context, recordCID string) (bool, error) {
+	c.logger().Debug("Starting client-side verification", "recordCID", recordCID)
+
+	// Generate the expected payload for this record CID
+	expectedPayload, err := signv1.CIDPayload(recordCID)
 	if err != nil {
 		return false, fmt.Errorf("failed to generate expected payload: %w", err)
 	}
@@ -71,6 +120,20 @@ func (c *Client) verifyClientSide(ctx context.Context, recordCID string) (bool,
 		return false, errors.New("no signature found in referrer responses")
 	}
 
+	// Skip signatures that have been revoked
+	revoked, err := c.pullRevokedSignatures(ctx, recordCID)
+	if err != nil {
+		return false, fmt.Errorf("failed to pull revocation referrer: %w", err)
+	}
+
+	signatures = slices.DeleteFunc(signatures, func(signature *signv1.Signature) bool {
+		return revoked[signature.GetSignature()]
+	})
+
+	if len(signatures) == 0 {
+		return false, errSignaturesRevoked
+	}
+
 	// Retrieve public key from OCI referrers
 	publicKeys, err := c.pullPublicKeyReferrer(ctx, recordCID)
 	if err != nil {
@@ -84,37 +147,26 @@ func (c *Client) verifyClientSide(ctx context.Context, recordCID string) (bool,
 	// Compare all public keys with all signatures
 	for _, publicKey := range publicKeys {
 		for _, signature := range signatures {
-			// Verify signature using cosign
-			verifier, err := sigs.LoadPublicKeyRaw([]byte(publicKey), crypto.SHA256)
-			if err != nil {
-				// Skip this public key if it's invalid, try the next one
-				logger.Debug("Failed to load public key, skipping", "error", err)
-
-				continue
-			}
-
-			// Decode base64 signature if needed
-			signatureBytes, err := base64.StdEncoding.DecodeString(signature.GetSignature())
-			if err != nil {
-				// If decoding fails, assume it's already raw bytes
-				signatureBytes = []byte(signature.GetSignature())
+			// If the signature is verified against this public key, return true
+			if c.verifySignature(publicKey, signature, expectedPayload) {
+				return true, nil
 			}
+		}
+	}
 
-			// Verify signature against the expected payload
-			err = verifier.VerifySignature(bytes.NewReader(signatureBytes), bytes.NewReader(expectedPayload))
-			if err != nil {
-				// Verification failed for this combination, try the next one
-				logger.Debug("Signature verification failed, trying next combination", "error", err)
+	return false, nil
+}
 
-				continue
-			}
+// verifySignature checks the signature against the expected payload using the PEM-encoded public key.
+func (c *Client) verifySignature(publicKey string, signature *signv1.Signature, expectedPayload []byte) bool {
+	if err := verifyBlob([]byte(publicKey), signature.GetSignature(), expectedPayload); err != nil {
+		// Verification failed for this combination, try the next one
+		c.logger().Debug("Signature verification failed, trying next combination", "error", err)
 
-			// If the signature is verified against this public key, return true
-			return true, nil
-		}
+		return false
 	}
 
-	return false, nil
+	return true
 }
 
 // pullSignatureReferrer retrieves the signature referrer for a record.
@@ -139,7 +191,7 @@ func (c *Client) pullSignatureReferrer(ctx context.Context, recordCID string) ([
 		if referrer != nil {
 			signature := &signv1.Signature{}
 			if err := signature.UnmarshalReferrer(referrer); err != nil {
-				logger.Error("Failed to decode signature from referrer", "error", err)
+				c.logger().Error("Failed to decode signature from referrer", "error", err)
 
 				continue
 			}
@@ -173,7 +225,7 @@ func (c *Client) pullPublicKeyReferrer(ctx context.Context, recordCID string) ([
 		if referrer != nil {
 			publicKey := &signv1.PublicKey{}
 			if err := publicKey.UnmarshalReferrer(referrer); err != nil {
-				logger.Error("Failed to decode public key from referrer", "error", err)
+				c.logger().Error("Failed to decode public key from referrer", "error", err)
 
 				continue
 			}
@@ -184,3 +236,37 @@ func (c *Client) pullPublicKeyReferrer(ctx context.Context, recordCID string) ([
 
 	return publicKeys, nil
 }
+
+// pullRevokedSignatures retrieves the signatures revoked for a record.
+func (c *Client) pullRevokedSignatures(ctx context.Context, recordCID string) (map[string]bool, error) {
+	revocationType := corev1.RevocationReferrerType
+
+	resultCh, err := c.PullReferrer(ctx, &storev1.PullReferrerRequest{
+		RecordRef: &corev1.RecordRef{
+			Cid: recordCID,
+		},
+		ReferrerType: &revocationType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull revocation referrer: %w", err)
+	}
+
+	revoked := make(map[string]bool)
+
+	// Get all revocation responses and decode them from referrer data
+	for response := range resultCh {
+		referrer := response.GetReferrer()
+		if referrer != nil {
+			revocation := &signv1.Revocation{}
+			if err := revocation.UnmarshalReferrer(referrer); err != nil {
+				c.logger().Error("Failed to decode revocation from referrer", "error", err)
+
+				continue
+			}
+
+			revoked[revocation.GetSignature()] = true
+		}
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,122 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package verify verifies records offline, without connecting to a Directory,
+// e.g. to validate the artifacts of a CI pipeline.
+//
+// A record is verified from its JSON content and a bundle of its signatures and
+// public keys, as output by "dirctl pull <cid> --signature --public-key --output json".
+// Revocations are not part of bundles, so revoked signatures still verify offline.
+package verify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/agntcy/dir/utils/cosign"
+)
+
+var (
+	// ErrCIDMismatch is returned when the content of a record does not match the expected CID.
+	ErrCIDMismatch = errors.New("record content does not match its CID")
+
+	// ErrNoValidSignature is returned when no signature of a bundle verifies with its public keys.
+	ErrNoValidSignature = errors.New("no signature of the record verifies with the public keys")
+)
+
+// Bundle holds the signatures of a record and the public keys that may verify them.
+type Bundle struct {
+	PublicKeys []*signv1.PublicKey `json:"publicKeys"`
+	Signatures []*signv1.Signature `json:"signatures"`
+}
+
+// ParseBundle parses a JSON bundle of signatures and public keys.
+func ParseBundle(data []byte) (*Bundle, error) {
+	var bundle Bundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, fmt.Errorf("failed to parse signature bundle: %w", err)
+	}
+
+	return &bundle, nil
+}
+
+// CID returns the CID of a record from its JSON content. If expectedCID is set,
+// ErrCIDMismatch is returned unless the content matches it.
+func CID(recordJSON []byte, expectedCID string) (string, error) {
+	record, err := corev1.UnmarshalRecord(recordJSON)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	cid := record.GetCid()
+	if cid == "" {
+		return "", errors.New("failed to compute record CID")
+	}
+
+	if expectedCID != "" && cid != expectedCID {
+		return cid, fmt.Errorf("%w: expected %s, got %s", ErrCIDMismatch, expectedCID, cid)
+	}
+
+	return cid, nil
+}
+
+// Signature verifies that a signature of the bundle signs the record CID
+// with one of the public keys of the bundle.
+func Signature(cid string, bundle *Bundle) error {
+	if len(bundle.GetSignatures()) == 0 {
+		return errors.New("no signature found in bundle")
+	}
+
+	if len(bundle.GetPublicKeys()) == 0 {
+		return errors.New("no public key found in bundle")
+	}
+
+	// Signatures sign the CID of the record, which is derived from its content
+	payload, err := signv1.CIDPayload(cid)
+	if err != nil {
+		return fmt.Errorf("failed to generate expected payload: %w", err)
+	}
+
+	for _, publicKey := range bundle.GetPublicKeys() {
+		for _, signature := range bundle.GetSignatures() {
+			if err := cosign.VerifySignature([]byte(publicKey.GetKey()), signature.GetSignature(), payload); err == nil {
+				return nil
+			}
+		}
+	}
+
+	return ErrNoValidSignature
+}
+
+// Record verifies a record from its JSON content: that its content matches the
+// expected CID, if set, and that it is signed by one of the public keys of the bundle.
+// It returns the CID of the record.
+func Record(recordJSON []byte, expectedCID string, bundle *Bundle) (string, error) {
+	cid, err := CID(recordJSON, expectedCID)
+	if err != nil {
+		return cid, err
+	}
+
+	return cid, Signature(cid, bundle)
+}
+
+// GetPublicKeys returns the public keys of the bundle, if any.
+func (b *Bundle) GetPublicKeys() []*signv1.PublicKey {
+	if b == nil {
+		return nil
+	}
+
+	return b.PublicKeys
+}
+
+// GetSignatures returns the signatures of the bundle, if any.
+func (b *Bundle) GetSignatures() []*signv1.Signature {
+	if b == nil {
+		return nil
+	}
+
+	return b.Signatures
+}
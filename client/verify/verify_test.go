@@ -0,0 +1,110 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package verify
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRecordJSON = []byte(`{
+	"name": "offline-agent",
+	"version": "1.0.0",
+	"schema_version": "0.7.0"
+}`)
+
+// signedBundle returns a bundle with a signature of the CID and its public key.
+func signedBundle(t *testing.T, cid string) *Bundle {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	payload, err := signv1.CIDPayload(cid)
+	require.NoError(t, err)
+
+	digest := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	return &Bundle{
+		PublicKeys: []*signv1.PublicKey{{Key: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))}},
+		Signatures: []*signv1.Signature{{Signature: base64.StdEncoding.EncodeToString(signature)}},
+	}
+}
+
+func TestRecord(t *testing.T) {
+	cid, err := CID(testRecordJSON, "")
+	require.NoError(t, err)
+
+	bundle := signedBundle(t, cid)
+
+	verifiedCID, err := Record(testRecordJSON, cid, bundle)
+	require.NoError(t, err)
+	assert.Equal(t, cid, verifiedCID)
+
+	// Records without expected CID are verified against the CID of their content
+	_, err = Record(testRecordJSON, "", bundle)
+	require.NoError(t, err)
+}
+
+func TestRecord_CIDMismatch(t *testing.T) {
+	cid, err := CID(testRecordJSON, "")
+	require.NoError(t, err)
+
+	tampered := []byte(`{"name": "offline-agent", "version": "2.0.0", "schema_version": "0.7.0"}`)
+
+	_, err = Record(tampered, cid, signedBundle(t, cid))
+	require.ErrorIs(t, err, ErrCIDMismatch)
+}
+
+func TestSignature(t *testing.T) {
+	cid, err := CID(testRecordJSON, "")
+	require.NoError(t, err)
+
+	otherCID, err := CID([]byte(`{"name": "other-agent", "version": "1.0.0", "schema_version": "0.7.0"}`), "")
+	require.NoError(t, err)
+
+	// A signature of another record does not verify
+	require.ErrorIs(t, Signature(cid, signedBundle(t, otherCID)), ErrNoValidSignature)
+
+	// A signature verifies with its own public key only
+	bundle := signedBundle(t, cid)
+	bundle.PublicKeys = signedBundle(t, cid).PublicKeys
+	require.ErrorIs(t, Signature(cid, bundle), ErrNoValidSignature)
+
+	require.Error(t, Signature(cid, &Bundle{}))
+	require.Error(t, Signature(cid, nil))
+}
+
+func TestParseBundle(t *testing.T) {
+	// Bundles are output by "dirctl pull --signature --public-key --output json"
+	bundle, err := ParseBundle([]byte(`{
+		"record": {"data": {}},
+		"publicKeys": [{"key": "-----BEGIN PUBLIC KEY-----"}],
+		"signatures": [{"signature": "c2lnbmF0dXJl"}]
+	}`))
+	require.NoError(t, err)
+
+	require.Len(t, bundle.GetPublicKeys(), 1)
+	assert.Equal(t, "-----BEGIN PUBLIC KEY-----", bundle.GetPublicKeys()[0].GetKey())
+	require.Len(t, bundle.GetSignatures(), 1)
+	assert.Equal(t, "c2lnbmF0dXJl", bundle.GetSignatures()[0].GetSignature())
+
+	_, err = ParseBundle([]byte(`not json`))
+	require.Error(t, err)
+}
@@ -9,7 +9,7 @@ import (
 	"os"
 	"path/filepath"
 
-	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
 	"github.com/agntcy/dir/cli/presenter"
 	hubClient "github.com/agntcy/dir/hub/client/hub"
 	hubOptions "github.com/agntcy/dir/hub/cmd/options"
@@ -166,12 +166,7 @@ func sign(recordCID string) (string, string, error) {
 }
 
 func signWithKey(ctx context.Context, recordCID string, privateKey []byte, password []byte) (string, string, error) {
-	digest, err := corev1.ConvertCIDToDigest(recordCID)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to convert CID to digest: %w", err)
-	}
-
-	payloadBytes, err := cosign.GeneratePayload(digest.String())
+	payloadBytes, err := signv1.CIDPayload(recordCID)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to generate payload: %w", err)
 	}
@@ -189,12 +184,7 @@ func signWithKey(ctx context.Context, recordCID string, privateKey []byte, passw
 }
 
 func signWithOIDCToken(ctx context.Context, recordCID string, token string) (string, string, error) {
-	digest, err := corev1.ConvertCIDToDigest(recordCID)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to convert CID to digest: %w", err)
-	}
-
-	payloadBytes, err := cosign.GeneratePayload(digest.String())
+	payloadBytes, err := signv1.CIDPayload(recordCID)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to generate payload: %w", err)
 	}
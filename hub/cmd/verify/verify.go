@@ -10,14 +10,13 @@ import (
 	"errors"
 	"fmt"
 
-	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
 	"github.com/agntcy/dir/cli/presenter"
 	hubClient "github.com/agntcy/dir/hub/client/hub"
 	hubOptions "github.com/agntcy/dir/hub/cmd/options"
 	"github.com/agntcy/dir/hub/service"
 	"github.com/agntcy/dir/hub/sessionstore"
 	authUtils "github.com/agntcy/dir/hub/utils/auth"
-	cosignutils "github.com/agntcy/dir/utils/cosign"
 	sigs "github.com/sigstore/cosign/v2/pkg/signature"
 	"github.com/spf13/cobra"
 )
@@ -75,12 +74,7 @@ Usage examples:
 
 func verify(ctx context.Context, hc hubClient.Client, session *sessionstore.HubSession, recordCID string) (bool, error) {
 	// Generate the expected payload for this record CID
-	digest, err := corev1.ConvertCIDToDigest(recordCID)
-	if err != nil {
-		return false, fmt.Errorf("failed to convert CID to digest: %w", err)
-	}
-
-	expectedPayload, err := cosignutils.GeneratePayload(digest.String())
+	expectedPayload, err := signv1.CIDPayload(recordCID)
 	if err != nil {
 		return false, fmt.Errorf("failed to generate expected payload: %w", err)
 	}
@@ -9,6 +9,8 @@ import (
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
 	searchv1 "github.com/agntcy/dir/api/search/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/client/streaming"
 )
 
 // RegistryType represents the type of external registry to import from.
@@ -28,7 +30,7 @@ const (
 // ClientInterface defines the interface for the DIR client used by importers.
 // This allows for easier testing and mocking.
 type ClientInterface interface {
-	Push(ctx context.Context, record *corev1.Record) (*corev1.RecordRef, error)
+	PushManyStream(ctx context.Context, recordsCh <-chan *corev1.Record) (streaming.StreamResult[storev1.PushManyResponse], error)
 	Search(ctx context.Context, req *searchv1.SearchRequest) (<-chan string, error)
 	PullBatch(ctx context.Context, recordRefs []*corev1.RecordRef) ([]*corev1.Record, error)
 }
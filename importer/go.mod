@@ -16,6 +16,7 @@ require (
 	github.com/agntcy/oasf-sdk/pkg v0.0.11
 	github.com/mark3labs/mcphost v0.31.3
 	github.com/modelcontextprotocol/registry v1.2.3
+	google.golang.org/grpc v1.75.0
 	google.golang.org/protobuf v1.36.10
 )
 
@@ -278,7 +279,6 @@ require (
 	google.golang.org/genproto v0.0.0-20250603155806-513f23925822 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20250721164621-a45f3dfb1074 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250826171959-ef028d996bc1 // indirect
-	google.golang.org/grpc v1.75.0 // indirect
 	gopkg.in/evanphx/json-patch.v4 v4.12.0 // indirect
 	gopkg.in/inf.v0 v0.9.1 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
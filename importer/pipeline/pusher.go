@@ -15,6 +15,8 @@ import (
 	searchv1 "github.com/agntcy/dir/api/search/v1"
 	"github.com/agntcy/dir/importer/config"
 	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -151,24 +153,18 @@ func (p *ClientPusher) buildExistingRecordsCache(ctx context.Context) error {
 	return nil
 }
 
-// Push sends records to DIR using the client.
+// pushedRecord is a record sent on the push stream, with its MCP source for debug output.
+type pushedRecord struct {
+	record        *corev1.Record
+	mcpSourceJSON string
+	acknowledged  bool
+}
+
+// Push sends records to DIR over a single PushMany stream.
 // If force is false, it filters out duplicates based on the cache built during initialization.
 //
-// IMPLEMENTATION NOTE:
-// This implementation pushes records sequentially (one-by-one) instead of using
-// batch/streaming push. This is a temporary workaround because the current gRPC
-// streaming implementation terminates the entire stream when a single record fails
-// validation, preventing subsequent records from being processed.
-//
-// TODO: Switch back to streaming/batch push (PushStream) once the server-side
-// implementation is updated to:
-//  1. Return per-record error responses instead of terminating the stream
-//  2. Allow the stream to continue processing remaining records after individual failures
-//  3. This will require updating the proto to support a response type that can carry
-//     either a RecordRef (success) or an error message (failure)
-//
-// The sequential approach ensures all records are attempted, even if some fail,
-// at the cost of reduced throughput and increased latency.
+// The server returns a result for every record, so records failing validation
+// are reported as errors without stopping the remaining records from being pushed.
 func (p *ClientPusher) Push(ctx context.Context, inputCh <-chan *corev1.Record) (<-chan *corev1.RecordRef, <-chan error) {
 	refCh := make(chan *corev1.RecordRef)
 	errCh := make(chan error)
@@ -180,31 +176,95 @@ func (p *ClientPusher) Push(ctx context.Context, inputCh <-chan *corev1.Record)
 		defer close(refCh)
 		defer close(errCh)
 
-		// Push records one-by-one to ensure all records are processed
-		// even if some fail validation
-		for record := range recordsCh {
-			// Extract and remove debug source before pushing
-			var mcpSourceJSON string
-
-			if record.GetData() != nil && record.Data.Fields != nil {
-				if debugField, ok := record.GetData().GetFields()["__mcp_debug_source"]; ok {
-					mcpSourceJSON = debugField.GetStringValue()
-					// Remove debug field before validation
-					delete(record.GetData().GetFields(), "__mcp_debug_source")
+		// Records are tracked in the order they are sent, matching the index of their result
+		var (
+			mu     sync.Mutex
+			pushed []*pushedRecord
+		)
+
+		sendCh := make(chan *corev1.Record)
+
+		go func() {
+			defer close(sendCh)
+
+			for record := range recordsCh {
+				// Extract and remove debug source before pushing
+				mcpSourceJSON := extractDebugSource(record)
+
+				mu.Lock()
+				pushed = append(pushed, &pushedRecord{record: record, mcpSourceJSON: mcpSourceJSON})
+				mu.Unlock()
+
+				select {
+				case sendCh <- record:
+				case <-ctx.Done():
+					return
 				}
 			}
+		}()
 
-			ref, err := p.client.Push(ctx, record)
-			if err != nil {
-				p.handlePushError(err, record, mcpSourceJSON, errCh, ctx)
-
-				continue
+		result, err := p.client.PushManyStream(ctx, sendCh)
+		if err != nil {
+			// Fail all records, as none of them can be pushed
+			for record := range sendCh {
+				p.handlePushError(err, record, "", errCh, ctx)
 			}
 
-			// Send reference (success)
+			return
+		}
+
+		var streamErr error
+
+		for {
 			select {
-			case refCh <- ref:
-			case <-ctx.Done():
+			case resp := <-result.ResCh():
+				mu.Lock()
+				index := int(resp.GetIndex())
+
+				var entry *pushedRecord
+				if index < len(pushed) {
+					entry = pushed[index]
+					entry.acknowledged = true
+				}
+				mu.Unlock()
+
+				if resp.ErrorMessage != nil {
+					pushErr := status.Error(codes.Code(resp.GetErrorCode()), resp.GetErrorMessage())
+
+					if entry != nil {
+						p.handlePushError(pushErr, entry.record, entry.mcpSourceJSON, errCh, ctx)
+					} else {
+						p.handlePushError(pushErr, nil, "", errCh, ctx)
+					}
+
+					continue
+				}
+
+				// Send reference (success)
+				select {
+				case refCh <- resp.GetRecordRef():
+				case <-ctx.Done():
+					return
+				}
+
+			case err := <-result.ErrCh():
+				streamErr = errors.Join(streamErr, err)
+
+			case <-result.DoneCh():
+				if streamErr == nil {
+					return
+				}
+
+				// Records without a result were not pushed when the stream failed
+				mu.Lock()
+				defer mu.Unlock()
+
+				for _, entry := range pushed {
+					if !entry.acknowledged {
+						p.handlePushError(streamErr, entry.record, entry.mcpSourceJSON, errCh, ctx)
+					}
+				}
+
 				return
 			}
 		}
@@ -213,6 +273,23 @@ func (p *ClientPusher) Push(ctx context.Context, inputCh <-chan *corev1.Record)
 	return refCh, errCh
 }
 
+// extractDebugSource removes the MCP source attached to a record for debugging and returns it.
+func extractDebugSource(record *corev1.Record) string {
+	if record.GetData() == nil || record.Data.Fields == nil {
+		return ""
+	}
+
+	debugField, ok := record.GetData().GetFields()["__mcp_debug_source"]
+	if !ok {
+		return ""
+	}
+
+	// Remove debug field before validation
+	delete(record.GetData().GetFields(), "__mcp_debug_source")
+
+	return debugField.GetStringValue()
+}
+
 // applyDeduplication applies deduplication filtering if not in force mode.
 func (p *ClientPusher) applyDeduplication(inputCh <-chan *corev1.Record) <-chan *corev1.Record {
 	if p.force {
@@ -0,0 +1,167 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/client/streaming"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// pushManyResult is a streaming.StreamResult for the fake PushMany stream.
+type pushManyResult struct {
+	resCh  chan *storev1.PushManyResponse
+	errCh  chan error
+	doneCh chan struct{}
+}
+
+func (r *pushManyResult) ResCh() <-chan *storev1.PushManyResponse { return r.resCh }
+func (r *pushManyResult) ErrCh() <-chan error                     { return r.errCh }
+func (r *pushManyResult) DoneCh() <-chan struct{}                 { return r.doneCh }
+
+// pushManyClient is a client whose PushMany stream rejects records named "invalid"
+// and fails after failAfter records, if set.
+type pushManyClient struct {
+	failAfter int
+}
+
+func (c *pushManyClient) PushManyStream(_ context.Context, recordsCh <-chan *corev1.Record) (streaming.StreamResult[storev1.PushManyResponse], error) {
+	result := &pushManyResult{
+		resCh:  make(chan *storev1.PushManyResponse),
+		errCh:  make(chan error),
+		doneCh: make(chan struct{}),
+	}
+
+	go func() {
+		defer close(result.doneCh)
+
+		index := 0
+
+		for record := range recordsCh {
+			if c.failAfter > 0 && index == c.failAfter {
+				result.errCh <- errors.New("stream closed")
+
+				// Drain the remaining records, as the client stream does
+				for range recordsCh {
+				}
+
+				return
+			}
+
+			resp := &storev1.PushManyResponse{Index: uint32(index)} //nolint:gosec
+
+			name := record.GetData().GetFields()["name"].GetStringValue()
+			if name == "invalid" {
+				msg := "record is invalid"
+				resp.ErrorMessage = &msg
+				resp.ErrorCode = 3
+			} else {
+				resp.RecordRef = &corev1.RecordRef{Cid: "cid-" + name}
+			}
+
+			result.resCh <- resp
+			index++
+		}
+	}()
+
+	return result, nil
+}
+
+func (c *pushManyClient) Search(context.Context, *searchv1.SearchRequest) (<-chan string, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *pushManyClient) PullBatch(context.Context, []*corev1.RecordRef) ([]*corev1.Record, error) {
+	return nil, errors.New("not implemented")
+}
+
+func namedRecords(t *testing.T, names ...string) <-chan *corev1.Record {
+	t.Helper()
+
+	recordsCh := make(chan *corev1.Record, len(names))
+
+	for _, name := range names {
+		data, err := structpb.NewStruct(map[string]any{"name": name, "__mcp_debug_source": "{}"})
+		if err != nil {
+			t.Fatalf("failed to create record: %v", err)
+		}
+
+		recordsCh <- &corev1.Record{Data: data}
+	}
+
+	close(recordsCh)
+
+	return recordsCh
+}
+
+// collectPushResults returns the CIDs and errors of a push.
+func collectPushResults(refCh <-chan *corev1.RecordRef, errCh <-chan error) ([]string, []error) {
+	var (
+		cids []string
+		errs []error
+	)
+
+	for refCh != nil || errCh != nil {
+		select {
+		case ref, ok := <-refCh:
+			if !ok {
+				refCh = nil
+
+				continue
+			}
+
+			cids = append(cids, ref.GetCid())
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+
+				continue
+			}
+
+			errs = append(errs, err)
+		}
+	}
+
+	return cids, errs
+}
+
+func TestClientPusher_Push(t *testing.T) {
+	pusher, err := NewClientPusher(t.Context(), &pushManyClient{}, true, false)
+	if err != nil {
+		t.Fatalf("NewClientPusher() error = %v", err)
+	}
+
+	cids, errs := collectPushResults(pusher.Push(t.Context(), namedRecords(t, "a", "invalid", "b")))
+
+	if len(cids) != 2 || cids[0] != "cid-a" || cids[1] != "cid-b" {
+		t.Errorf("Push() pushed %v, want [cid-a cid-b]", cids)
+	}
+
+	if len(errs) != 1 {
+		t.Errorf("Push() errors = %v, want the invalid record to fail without stopping the others", errs)
+	}
+}
+
+func TestClientPusher_Push_StreamError(t *testing.T) {
+	pusher, err := NewClientPusher(t.Context(), &pushManyClient{failAfter: 1}, true, false)
+	if err != nil {
+		t.Fatalf("NewClientPusher() error = %v", err)
+	}
+
+	cids, errs := collectPushResults(pusher.Push(t.Context(), namedRecords(t, "a", "b", "c")))
+
+	if len(cids) != 1 {
+		t.Errorf("Push() pushed %v, want only the record before the stream failed", cids)
+	}
+
+	if len(errs) != 2 {
+		t.Errorf("Push() errors = %v, want one error per record not pushed", errs)
+	}
+}
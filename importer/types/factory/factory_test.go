@@ -11,6 +11,8 @@ import (
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
 	searchv1 "github.com/agntcy/dir/api/search/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/client/streaming"
 	"github.com/agntcy/dir/importer/config"
 	"github.com/agntcy/dir/importer/types"
 )
@@ -29,8 +31,8 @@ func (m *mockImporter) Run(ctx context.Context, cfg config.Config) (*types.Impor
 // mockClient is a mock implementation for testing.
 type mockClient struct{}
 
-func (m *mockClient) Push(ctx context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
-	return &corev1.RecordRef{}, nil
+func (m *mockClient) PushManyStream(ctx context.Context, recordsCh <-chan *corev1.Record) (streaming.StreamResult[storev1.PushManyResponse], error) {
+	return nil, errors.New("not implemented")
 }
 
 func (m *mockClient) PullBatch(ctx context.Context, recordRefs []*corev1.RecordRef) ([]*corev1.Record, error) {
@@ -33,7 +33,7 @@ USER QUERY: "` + query + `"
 WORKFLOW:
 
 1. Get schema: Call 'agntcy_oasf_get_schema' to see available skills/domains
-2. Translate query to search parameters (names, versions, skill_ids, skill_names, locators, modules, domain_ids, domain_names)
+2. Translate query to search parameters (names, versions, skill_ids, skill_names, locators, modules, domain_ids, domain_names, annotations, licenses, signers)
 3. Execute: Call 'agntcy_dir_search_local' with parameters
 4. Display: Extract ALL CIDs from the 'record_cids' array in the response and list them clearly with the count
 
@@ -46,6 +46,9 @@ PARAMETERS:
 - modules: Module patterns (e.g., "integration/mcp")
 - domain_ids: Exact domain IDs (e.g., "604")
 - domain_names: Domain patterns (e.g., "*education*", "healthcare/*")
+- annotations: Indexed annotation patterns as key=value (e.g., "team=platform", "environment=prod*")
+- licenses: SPDX license patterns (e.g., "Apache-2.0", "BSD-*")
+- signers: Signer identity patterns (e.g., "spiffe://acme/*", "release@acme.com", "sha256:<key-fingerprint>")
 
 WILDCARDS: * (zero+), ? (one), [abc] (char class)
 
@@ -101,10 +101,29 @@ This tool performs comprehensive validation including:
 - Domain and skill taxonomy validation
 
 Returns detailed validation errors to help fix issues.
+Fields unknown to or deprecated in the schema version are reported with their
+JSON paths, and are treated as validation errors when strict=true.
 Use this tool to ensure a record meets all OASF requirements before pushing.
 		`),
 	}, tools.ValidateRecord)
 
+	// Add tool for generating draft OASF agent records
+	mcp.AddTool(server, &mcp.Tool{
+		Name: "agntcy_oasf_generate_record",
+		Description: strings.TrimSpace(`
+Generates a best-effort draft OASF agent record from a natural-language description.
+The draft includes:
+- name (derived from the description unless provided), version and description
+- schema_version and created_at
+- skills and domains selected from the schema taxonomy by matching the description
+
+Required fields that cannot be inferred (e.g., authors, locators) are left empty and
+listed in the returned notes. Review the selected skills with agntcy_oasf_get_schema_skills,
+complete the record, then check it with agntcy_oasf_validate_record.
+Use this tool to start authoring a record and iterate until validation passes.
+		`),
+	}, tools.GenerateRecord)
+
 	// Add tool for pushing records to Directory server
 	mcp.AddTool(server, &mcp.Tool{
 		Name: "agntcy_dir_push_record",
@@ -156,6 +175,40 @@ Use this tool to retrieve agent records by their CID for inspection or validatio
 		`),
 	}, tools.PullRecord)
 
+	// Add tool for listing syncs
+	mcp.AddTool(server, &mcp.Tool{
+		Name: "agntcy_dir_list_syncs",
+		Description: strings.TrimSpace(`
+Lists the syncs of the local Directory node with their status and progress.
+Each sync includes:
+- sync_id and remote_directory_url of the mirrored Directory
+- status (e.g., SYNC_STATUS_IN_PROGRESS, SYNC_STATUS_FAILED)
+- created_time and last_update_time
+- synced_records and throughput (records per second)
+
+Syncs can be filtered by a substring of the remote Directory URL.
+Server configuration is set via environment variables (DIRECTORY_CLIENT_SERVER_ADDRESS).
+
+Use this tool to check whether mirrors of remote Directories are healthy.
+		`),
+	}, tools.ListSyncs)
+
+	// Add tool for getting local routing statistics
+	mcp.AddTool(server, &mcp.Tool{
+		Name: "agntcy_dir_routing_info",
+		Description: strings.TrimSpace(`
+Returns statistics about the records published to the network by the local Directory node:
+- total_records: Number of locally published records
+- skills: Number of published records per skill
+- locators: Number of published records per locator type
+- other_labels: Number of published records per other label (domains, modules)
+
+Server configuration is set via environment variables (DIRECTORY_CLIENT_SERVER_ADDRESS).
+
+Use this tool to check what the local node advertises to the network.
+		`),
+	}, tools.RoutingInfo)
+
 	// Add tool for exporting OASF records to other formats
 	mcp.AddTool(server, &mcp.Tool{
 		Name: "agntcy_oasf_export_record",
@@ -0,0 +1,362 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/agntcy/oasf-sdk/pkg/validator"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	defaultGeneratedMaxSkills  = 3
+	defaultGeneratedMaxDomains = 2
+	defaultGeneratedVersion    = "v1.0.0"
+)
+
+// generateStopWords are ignored when matching a description against the schema taxonomy.
+var generateStopWords = map[string]bool{
+	"and": true, "the": true, "for": true, "with": true, "that": true, "this": true,
+	"from": true, "into": true, "can": true, "are": true, "its": true, "their": true,
+	"agent": true, "agents": true, "using": true, "uses": true, "use": true, "which": true,
+	"other": true, "about": true, "your": true, "you": true, "will": true, "has": true,
+}
+
+// GenerateRecordInput represents the input for generating a draft agent record.
+type GenerateRecordInput struct {
+	Description   string `json:"description"          jsonschema:"Natural-language description of the agent (required)"`
+	SchemaVersion string `json:"schema_version"       jsonschema:"OASF schema version of the record (e.g., 0.7.0, 0.8.0) (required)"`
+	Name          string `json:"name,omitempty"       jsonschema:"Optional record name. Derived from the description if not set"`
+	Version       string `json:"version,omitempty"    jsonschema:"Optional record version (default: v1.0.0)"`
+	MaxSkills     int    `json:"max_skills,omitempty" jsonschema:"Optional maximum number of skills to select (default: 3)"`
+}
+
+// GenerateRecordOutput represents the output after generating a draft agent record.
+type GenerateRecordOutput struct {
+	RecordJSON        string   `json:"record_json,omitempty"        jsonschema:"The draft OASF record (JSON string)"`
+	Notes             []string `json:"notes,omitempty"              jsonschema:"Fields that need to be completed or reviewed before the record is valid"`
+	ErrorMessage      string   `json:"error_message,omitempty"      jsonschema:"Error message if generation failed"`
+	AvailableVersions []string `json:"available_versions,omitempty" jsonschema:"List of available OASF schema versions"`
+}
+
+// GenerateRecord generates a best-effort draft OASF record from a natural-language description.
+// Skills and domains are selected from the schema taxonomy by matching words of the description
+// against their names and captions. The draft is meant to be refined and validated.
+func GenerateRecord(_ context.Context, _ *mcp.CallToolRequest, input GenerateRecordInput) (
+	*mcp.CallToolResult,
+	GenerateRecordOutput,
+	error,
+) {
+	if strings.TrimSpace(input.Description) == "" {
+		return nil, GenerateRecordOutput{
+			ErrorMessage: "description is required",
+		}, nil
+	}
+
+	availableVersions, err := validateVersion(input.SchemaVersion)
+	if err != nil {
+		//nolint:nilerr // MCP tools communicate errors through output, not error return
+		return nil, GenerateRecordOutput{
+			ErrorMessage:      err.Error(),
+			AvailableVersions: availableVersions,
+		}, nil
+	}
+
+	record, notes, err := generateRecord(input)
+	if err != nil {
+		//nolint:nilerr // MCP tools communicate errors through output, not error return
+		return nil, GenerateRecordOutput{
+			ErrorMessage:      err.Error(),
+			AvailableVersions: availableVersions,
+		}, nil
+	}
+
+	recordJSON, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return nil, GenerateRecordOutput{
+			ErrorMessage: fmt.Sprintf("Failed to marshal record: %v", err),
+		}, nil
+	}
+
+	return nil, GenerateRecordOutput{
+		RecordJSON: string(recordJSON),
+		Notes:      notes,
+	}, nil
+}
+
+// generateRecord builds the draft record and the notes about fields left to complete.
+func generateRecord(input GenerateRecordInput) (map[string]any, []string, error) {
+	required, err := requiredRecordFields(input.SchemaVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	skillsJSON, err := validator.GetSchemaSkills(input.SchemaVersion)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get skills from OASF %s schema: %w", input.SchemaVersion, err)
+	}
+
+	allSkills, err := parseSchemaData(skillsJSON, parseItemFromSchema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(allSkills) == 0 {
+		return nil, nil, fmt.Errorf("record generation is not supported for OASF %s schema, use a newer version", input.SchemaVersion)
+	}
+
+	keywords := descriptionKeywords(input.Description)
+
+	maxSkills := input.MaxSkills
+	if maxSkills <= 0 {
+		maxSkills = defaultGeneratedMaxSkills
+	}
+
+	var notes []string
+
+	skills := matchClasses(allSkills, keywords, maxSkills)
+	if len(skills) == 0 {
+		notes = append(notes, "No skills matched the description. Use agntcy_oasf_get_schema_skills to select at least one skill.")
+	} else {
+		notes = append(notes, "Skills were selected by keyword matching. Review them with agntcy_oasf_get_schema_skills.")
+	}
+
+	name := input.Name
+	if name == "" {
+		name = nameFromDescription(input.Description)
+		notes = append(notes, "The name was derived from the description. Replace it with the agent name.")
+	}
+
+	version := input.Version
+	if version == "" {
+		version = defaultGeneratedVersion
+	}
+
+	record := map[string]any{
+		"name":           name,
+		"version":        version,
+		"schema_version": input.SchemaVersion,
+		"description":    strings.TrimSpace(input.Description),
+		"created_at":     time.Now().UTC().Format(time.RFC3339),
+		"skills":         classEntries(skills),
+	}
+
+	// Domains are optional, so they are only added when they match
+	if domainsJSON, err := validator.GetSchemaDomains(input.SchemaVersion); err == nil {
+		allDomains, err := parseSchemaData(domainsJSON, parseItemFromSchema)
+		if err == nil {
+			if domains := matchClasses(allDomains, keywords, defaultGeneratedMaxDomains); len(domains) > 0 {
+				record["domains"] = classEntries(domains)
+			}
+		}
+	}
+
+	// Required fields that cannot be inferred are left empty for the author to fill
+	for _, field := range required {
+		if _, ok := record[field]; ok {
+			continue
+		}
+
+		record[field] = []any{}
+		notes = append(notes, fmt.Sprintf("Fill in the required %q field.", field))
+	}
+
+	notes = append(notes, "Validate the completed record with agntcy_oasf_validate_record.")
+
+	return record, notes, nil
+}
+
+// requiredRecordFields returns the required top-level fields of a schema version.
+func requiredRecordFields(version string) ([]string, error) {
+	data, err := validator.GetSchemaContent(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OASF %s schema: %w", version, err)
+	}
+
+	var schema struct {
+		Required []string `json:"required"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse OASF %s schema: %w", version, err)
+	}
+
+	return schema.Required, nil
+}
+
+// descriptionKeywords returns the distinct stemmed words of a description, without stop words.
+func descriptionKeywords(description string) []string {
+	var keywords []string
+
+	for _, word := range splitWords(description) {
+		if len(word) < 3 || generateStopWords[word] { //nolint:mnd
+			continue
+		}
+
+		if stemmed := stem(word); !slices.Contains(keywords, stemmed) {
+			keywords = append(keywords, stemmed)
+		}
+	}
+
+	return keywords
+}
+
+// matchClasses returns up to limit classes best matching the keywords.
+// Words of the leaf name segment and caption weigh more than words of parent categories,
+// and ties are broken in favor of classes whose leaf words are matched more completely.
+func matchClasses(classes []schemaClass, keywords []string, limit int) []schemaClass {
+	type scored struct {
+		class    schemaClass
+		score    int
+		coverage float64
+	}
+
+	var matches []scored
+
+	for _, class := range classes {
+		segments := strings.Split(class.Name, "/")
+		leafWords := classWords(segments[len(segments)-1] + " " + class.Caption)
+		parentWords := classWords(strings.Join(segments[:len(segments)-1], " "))
+
+		leafMatches := countMatches(leafWords, keywords)
+		score := 2*leafMatches + countMatches(parentWords, keywords) //nolint:mnd
+
+		if score > 0 {
+			matches = append(matches, scored{
+				class:    class,
+				score:    score,
+				coverage: float64(leafMatches) / float64(max(len(leafWords), 1)),
+			})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+
+		if matches[i].coverage != matches[j].coverage {
+			return matches[i].coverage > matches[j].coverage
+		}
+
+		return matches[i].class.Name < matches[j].class.Name
+	})
+
+	result := make([]schemaClass, 0, limit)
+	for i := 0; i < len(matches) && i < limit; i++ {
+		result = append(result, matches[i].class)
+	}
+
+	return result
+}
+
+// classWords returns the distinct stemmed words of a class name or caption.
+func classWords(text string) []string {
+	var words []string
+
+	for _, word := range splitWords(text) {
+		if len(word) < 3 { //nolint:mnd
+			continue
+		}
+
+		if stemmed := stem(word); !slices.Contains(words, stemmed) {
+			words = append(words, stemmed)
+		}
+	}
+
+	return words
+}
+
+// countMatches counts the words matching any keyword.
+func countMatches(words, keywords []string) int {
+	count := 0
+
+	for _, word := range words {
+		if slices.ContainsFunc(keywords, func(keyword string) bool { return wordsMatch(word, keyword) }) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// wordsMatch reports whether two stemmed words match. Words of at least four letters also
+// match when one is a prefix of the other, so that "translat" matches "translation".
+func wordsMatch(a, b string) bool {
+	const minPrefixLen = 4
+
+	if a == b {
+		return true
+	}
+
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+
+	return len(a) >= minPrefixLen && strings.HasPrefix(b, a)
+}
+
+// classEntries converts schema classes to record entries.
+func classEntries(classes []schemaClass) []map[string]any {
+	entries := make([]map[string]any, 0, len(classes))
+
+	for _, class := range classes {
+		entry := map[string]any{"name": class.Name}
+		if class.ID != 0 {
+			entry["id"] = class.ID
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// nameFromDescription derives a kebab-case record name from the first words of a description.
+func nameFromDescription(description string) string {
+	const maxNameWords = 3
+
+	var words []string
+
+	for _, word := range splitWords(description) {
+		if len(word) < 3 || generateStopWords[word] { //nolint:mnd
+			continue
+		}
+
+		if words = append(words, word); len(words) == maxNameWords {
+			break
+		}
+	}
+
+	if len(words) == 0 {
+		return "my-agent"
+	}
+
+	return strings.Join(words, "-") + "-agent"
+}
+
+// splitWords splits text into lowercase alphanumeric words.
+func splitWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// stem strips common English suffixes so that word forms match.
+func stem(word string) string {
+	for _, suffix := range []string{"ing", "es", "s"} {
+		if trimmed, ok := strings.CutSuffix(word, suffix); ok && len(trimmed) >= 4 { //nolint:mnd
+			return trimmed
+		}
+	}
+
+	return word
+}
@@ -0,0 +1,74 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateRecord(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("generates a valid draft record", func(t *testing.T) {
+		t.Parallel()
+
+		_, output, err := GenerateRecord(ctx, nil, GenerateRecordInput{
+			Description:   "Translates documents between languages and summarizes long texts",
+			SchemaVersion: "0.8.0",
+		})
+		require.NoError(t, err)
+		require.Empty(t, output.ErrorMessage)
+		assert.Contains(t, output.RecordJSON, `"schema_version": "0.8.0"`)
+		assert.Contains(t, output.RecordJSON, `"name": "translates-documents-between-agent"`)
+		assert.Contains(t, output.RecordJSON, "translation")
+		assert.NotEmpty(t, output.Notes)
+
+		_, validation, err := ValidateRecord(ctx, nil, ValidateRecordInput{RecordJSON: output.RecordJSON})
+		require.NoError(t, err)
+		assert.True(t, validation.Valid, "validation errors: %v, error: %s", validation.ValidationErrors, validation.ErrorMessage)
+	})
+
+	t.Run("uses the given name and version", func(t *testing.T) {
+		t.Parallel()
+
+		_, output, err := GenerateRecord(ctx, nil, GenerateRecordInput{
+			Description:   "Answers questions about images",
+			SchemaVersion: "0.7.0",
+			Name:          "vision-qa",
+			Version:       "v2.0.0",
+			MaxSkills:     1,
+		})
+		require.NoError(t, err)
+		require.Empty(t, output.ErrorMessage)
+		assert.Contains(t, output.RecordJSON, `"name": "vision-qa"`)
+		assert.Contains(t, output.RecordJSON, `"version": "v2.0.0"`)
+		assert.Contains(t, output.Notes, `Fill in the required "locators" field.`)
+	})
+
+	t.Run("requires a description", func(t *testing.T) {
+		t.Parallel()
+
+		_, output, err := GenerateRecord(ctx, nil, GenerateRecordInput{SchemaVersion: "0.8.0"})
+		require.NoError(t, err)
+		assert.Equal(t, "description is required", output.ErrorMessage)
+	})
+
+	t.Run("rejects unknown versions", func(t *testing.T) {
+		t.Parallel()
+
+		_, output, err := GenerateRecord(ctx, nil, GenerateRecordInput{
+			Description:   "Summarizes texts",
+			SchemaVersion: "9.9.9",
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output.ErrorMessage, "invalid version")
+		assert.NotEmpty(t, output.AvailableVersions)
+	})
+}
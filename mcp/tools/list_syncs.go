@@ -0,0 +1,113 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/client"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ListSyncsInput defines the input parameters for listing syncs.
+type ListSyncsInput struct {
+	RemoteURL string `json:"remote_url,omitempty" jsonschema:"Optional substring of the remote Directory URL to filter syncs (e.g., 'registry.example.com')"`
+}
+
+// SyncItem describes a sync and its progress.
+type SyncItem struct {
+	SyncID             string  `json:"sync_id"`
+	Status             string  `json:"status"`
+	RemoteDirectoryURL string  `json:"remote_directory_url"`
+	CreatedTime        string  `json:"created_time,omitempty"`
+	LastUpdateTime     string  `json:"last_update_time,omitempty"`
+	SyncedRecords      uint64  `json:"synced_records,omitempty"`
+	Throughput         float64 `json:"throughput,omitempty"`
+}
+
+// ListSyncsOutput defines the output of listing syncs.
+type ListSyncsOutput struct {
+	Syncs        []SyncItem `json:"syncs"                   jsonschema:"Syncs with their status and progress"`
+	Count        int        `json:"count"                   jsonschema:"Number of syncs returned"`
+	ErrorMessage string     `json:"error_message,omitempty" jsonschema:"Error message if listing failed"`
+}
+
+// ListSyncs lists the syncs of the Directory node with their status and progress.
+func ListSyncs(ctx context.Context, _ *mcp.CallToolRequest, input ListSyncsInput) (
+	*mcp.CallToolResult,
+	ListSyncsOutput,
+	error,
+) {
+	// Load client configuration
+	config, err := client.LoadConfig()
+	if err != nil {
+		return nil, ListSyncsOutput{
+			ErrorMessage: fmt.Sprintf("Failed to load client configuration: %v", err),
+		}, nil
+	}
+
+	// Create Directory client
+	c, err := client.New(ctx, client.WithConfig(config))
+	if err != nil {
+		return nil, ListSyncsOutput{
+			ErrorMessage: fmt.Sprintf("Failed to create Directory client: %v", err),
+		}, nil
+	}
+	defer c.Close()
+
+	ch, err := c.ListSyncs(ctx, &storev1.ListSyncsRequest{})
+	if err != nil {
+		return nil, ListSyncsOutput{
+			ErrorMessage: fmt.Sprintf("Failed to list syncs: %v", err),
+		}, nil
+	}
+
+	syncs := []SyncItem{}
+
+	for item := range ch {
+		if !matchesRemoteURL(item.GetRemoteDirectoryUrl(), input.RemoteURL) {
+			continue
+		}
+
+		// Add progress details, falling back to the list item if they are unavailable
+		details, err := c.GetSync(ctx, item.GetSyncId())
+		if err != nil {
+			syncs = append(syncs, toSyncItem(&storev1.GetSyncResponse{
+				SyncId:             item.GetSyncId(),
+				Status:             item.GetStatus(),
+				RemoteDirectoryUrl: item.GetRemoteDirectoryUrl(),
+			}))
+
+			continue
+		}
+
+		syncs = append(syncs, toSyncItem(details))
+	}
+
+	return nil, ListSyncsOutput{
+		Syncs: syncs,
+		Count: len(syncs),
+	}, nil
+}
+
+// matchesRemoteURL reports whether the remote URL contains the filter, ignoring case.
+func matchesRemoteURL(remoteURL, filter string) bool {
+	return filter == "" || strings.Contains(strings.ToLower(remoteURL), strings.ToLower(filter))
+}
+
+// toSyncItem converts a sync response to a sync item.
+func toSyncItem(sync *storev1.GetSyncResponse) SyncItem {
+	return SyncItem{
+		SyncID:             sync.GetSyncId(),
+		Status:             sync.GetStatus().String(),
+		RemoteDirectoryURL: sync.GetRemoteDirectoryUrl(),
+		CreatedTime:        sync.GetCreatedTime(),
+		LastUpdateTime:     sync.GetLastUpdateTime(),
+		SyncedRecords:      sync.GetSyncedRecords(),
+		Throughput:         sync.GetThroughput(),
+	}
+}
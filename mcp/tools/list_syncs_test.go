@@ -0,0 +1,35 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"testing"
+
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesRemoteURL(t *testing.T) {
+	assert.True(t, matchesRemoteURL("https://registry.example.com:8888", ""))
+	assert.True(t, matchesRemoteURL("https://registry.example.com:8888", "Registry.Example"))
+	assert.False(t, matchesRemoteURL("https://registry.example.com:8888", "other.example.com"))
+}
+
+func TestToSyncItem(t *testing.T) {
+	item := toSyncItem(&storev1.GetSyncResponse{
+		SyncId:             "sync-1",
+		Status:             storev1.SyncStatus_SYNC_STATUS_IN_PROGRESS,
+		RemoteDirectoryUrl: "remote:8888",
+		SyncedRecords:      42,
+		Throughput:         2.5,
+	})
+
+	assert.Equal(t, SyncItem{
+		SyncID:             "sync-1",
+		Status:             "SYNC_STATUS_IN_PROGRESS",
+		RemoteDirectoryURL: "remote:8888",
+		SyncedRecords:      42,
+		Throughput:         2.5,
+	}, item)
+}
@@ -0,0 +1,86 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/client"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// RoutingInfoInput defines the input parameters for getting routing statistics.
+type RoutingInfoInput struct{}
+
+// RoutingInfoOutput defines the local routing statistics.
+type RoutingInfoOutput struct {
+	TotalRecords int            `json:"total_records"           jsonschema:"Number of locally published records"`
+	Skills       map[string]int `json:"skills,omitempty"        jsonschema:"Number of published records per skill"`
+	Locators     map[string]int `json:"locators,omitempty"      jsonschema:"Number of published records per locator type"`
+	OtherLabels  map[string]int `json:"other_labels,omitempty"  jsonschema:"Number of published records per other label (domains, modules)"`
+	ErrorMessage string         `json:"error_message,omitempty" jsonschema:"Error message if the statistics could not be collected"`
+}
+
+// RoutingInfo returns statistics about the records published by the local Directory node.
+func RoutingInfo(ctx context.Context, _ *mcp.CallToolRequest, _ RoutingInfoInput) (
+	*mcp.CallToolResult,
+	RoutingInfoOutput,
+	error,
+) {
+	// Load client configuration
+	config, err := client.LoadConfig()
+	if err != nil {
+		return nil, RoutingInfoOutput{
+			ErrorMessage: fmt.Sprintf("Failed to load client configuration: %v", err),
+		}, nil
+	}
+
+	// Create Directory client
+	c, err := client.New(ctx, client.WithConfig(config))
+	if err != nil {
+		return nil, RoutingInfoOutput{
+			ErrorMessage: fmt.Sprintf("Failed to create Directory client: %v", err),
+		}, nil
+	}
+	defer c.Close()
+
+	// No queries = list all local records
+	ch, err := c.List(ctx, &routingv1.ListRequest{})
+	if err != nil {
+		return nil, RoutingInfoOutput{
+			ErrorMessage: fmt.Sprintf("Failed to list local records: %v", err),
+		}, nil
+	}
+
+	return nil, collectRoutingInfo(ch), nil
+}
+
+// collectRoutingInfo counts published records by label category.
+func collectRoutingInfo(ch <-chan *routingv1.ListResponse) RoutingInfoOutput {
+	output := RoutingInfoOutput{
+		Skills:      make(map[string]int),
+		Locators:    make(map[string]int),
+		OtherLabels: make(map[string]int),
+	}
+
+	for result := range ch {
+		output.TotalRecords++
+
+		for _, label := range result.GetLabels() {
+			switch {
+			case strings.HasPrefix(label, "/skills/"):
+				output.Skills[strings.TrimPrefix(label, "/skills/")]++
+			case strings.HasPrefix(label, "/locators/"):
+				output.Locators[strings.TrimPrefix(label, "/locators/")]++
+			default:
+				output.OtherLabels[label]++
+			}
+		}
+	}
+
+	return output
+}
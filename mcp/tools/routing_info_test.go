@@ -0,0 +1,25 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"testing"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectRoutingInfo(t *testing.T) {
+	ch := make(chan *routingv1.ListResponse, 2)
+	ch <- &routingv1.ListResponse{Labels: []string{"/skills/AI", "/locators/docker_image", "/domains/research"}}
+	ch <- &routingv1.ListResponse{Labels: []string{"/skills/AI"}}
+	close(ch)
+
+	output := collectRoutingInfo(ch)
+
+	assert.Equal(t, 2, output.TotalRecords)
+	assert.Equal(t, map[string]int{"AI": 2}, output.Skills)
+	assert.Equal(t, map[string]int{"docker_image": 1}, output.Locators)
+	assert.Equal(t, map[string]int{"/domains/research": 1}, output.OtherLabels)
+}
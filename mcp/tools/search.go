@@ -24,6 +24,9 @@ type SearchLocalInput struct {
 	Modules     []string `json:"modules,omitempty"      jsonschema:"Module patterns (supports wildcards: * ? [])"`
 	DomainIDs   []string `json:"domain_ids,omitempty"   jsonschema:"Domain ID patterns (exact match only)"`
 	DomainNames []string `json:"domain_names,omitempty" jsonschema:"Domain name patterns (supports wildcards: * ? [])"`
+	Annotations []string `json:"annotations,omitempty"  jsonschema:"Indexed annotation patterns as key=value (supports wildcards in value: * ? [])"`
+	Licenses    []string `json:"licenses,omitempty"     jsonschema:"SPDX license identifier patterns (supports wildcards: * ? [])"`
+	Signers     []string `json:"signers,omitempty"      jsonschema:"Signer identity patterns such as SPIFFE IDs, OIDC subjects or sha256 key fingerprints (supports wildcards: * ? [])"`
 }
 
 // SearchLocalOutput defines the output of local search.
@@ -207,5 +210,29 @@ func buildQueries(input SearchLocalInput) []*searchv1.RecordQuery {
 		})
 	}
 
+	// Add annotation queries
+	for _, annotation := range input.Annotations {
+		queries = append(queries, &searchv1.RecordQuery{
+			Type:  searchv1.RecordQueryType_RECORD_QUERY_TYPE_ANNOTATION,
+			Value: annotation,
+		})
+	}
+
+	// Add license queries
+	for _, license := range input.Licenses {
+		queries = append(queries, &searchv1.RecordQuery{
+			Type:  searchv1.RecordQueryType_RECORD_QUERY_TYPE_LICENSE,
+			Value: license,
+		})
+	}
+
+	// Add signer queries
+	for _, signer := range input.Signers {
+		queries = append(queries, &searchv1.RecordQuery{
+			Type:  searchv1.RecordQueryType_RECORD_QUERY_TYPE_SIGNER,
+			Value: signer,
+		})
+	}
+
 	return queries
 }
@@ -71,10 +71,13 @@ func TestBuildQueriesTypes(t *testing.T) {
 		Modules:     []string{"core"},
 		DomainIDs:   []string{"604"},
 		DomainNames: []string{"*education*"},
+		Annotations: []string{"team=platform"},
+		Licenses:    []string{"Apache-2.0"},
+		Signers:     []string{"spiffe://acme/ci"},
 	}
 
 	queries := buildQueries(input)
-	assert.Len(t, queries, 8)
+	assert.Len(t, queries, 11)
 
 	// Verify query types are correctly mapped
 	expectedTypes := []searchv1.RecordQueryType{
@@ -86,6 +89,9 @@ func TestBuildQueriesTypes(t *testing.T) {
 		searchv1.RecordQueryType_RECORD_QUERY_TYPE_MODULE,
 		searchv1.RecordQueryType_RECORD_QUERY_TYPE_DOMAIN_ID,
 		searchv1.RecordQueryType_RECORD_QUERY_TYPE_DOMAIN_NAME,
+		searchv1.RecordQueryType_RECORD_QUERY_TYPE_ANNOTATION,
+		searchv1.RecordQueryType_RECORD_QUERY_TYPE_LICENSE,
+		searchv1.RecordQueryType_RECORD_QUERY_TYPE_SIGNER,
 	}
 
 	for i, query := range queries {
@@ -13,7 +13,8 @@ import (
 
 // ValidateRecordInput represents the input for validating an agent record.
 type ValidateRecordInput struct {
-	RecordJSON string `json:"record_json" jsonschema:"JSON string of the agent record to validate against OASF schema"`
+	RecordJSON string `json:"record_json"      jsonschema:"JSON string of the agent record to validate against OASF schema"`
+	Strict     bool   `json:"strict,omitempty" jsonschema:"Treat unknown or deprecated fields as validation errors (default: false)"`
 }
 
 // ValidateRecordOutput represents the output after validating an agent record.
@@ -21,6 +22,7 @@ type ValidateRecordOutput struct {
 	Valid            bool     `json:"valid"                       jsonschema:"Whether the record is valid according to OASF schema validation"`
 	SchemaVersion    string   `json:"schema_version,omitempty"    jsonschema:"Detected OASF schema version (e.g. 0.3.1 or 0.7.0)"`
 	ValidationErrors []string `json:"validation_errors,omitempty" jsonschema:"List of validation error messages. Only present if valid=false. Use these to fix the record"`
+	FieldIssues      []string `json:"field_issues,omitempty"      jsonschema:"Unknown or deprecated fields with their JSON paths. Unknown fields are dropped when the record is stored, so remove or rename them"`
 	ErrorMessage     string   `json:"error_message,omitempty"     jsonschema:"General error message if validation process failed"`
 }
 
@@ -53,10 +55,32 @@ func ValidateRecord(_ context.Context, _ *mcp.CallToolRequest, input ValidateRec
 		}, nil
 	}
 
+	// Report unknown and deprecated fields
+	issues, err := record.CheckFields()
+	if err != nil {
+		return nil, ValidateRecordOutput{
+			Valid:         false,
+			SchemaVersion: schemaVersion,
+			ErrorMessage:  fmt.Sprintf("Field check error: %v", err),
+		}, nil
+	}
+
+	fieldIssues := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		fieldIssues = append(fieldIssues, issue.String())
+	}
+
+	// In strict mode, unknown or deprecated fields make the record invalid
+	if input.Strict && len(fieldIssues) > 0 {
+		valid = false
+		validationErrors = append(validationErrors, fieldIssues...)
+	}
+
 	// Return validation results
 	return nil, ValidateRecordOutput{
 		Valid:            valid,
 		SchemaVersion:    schemaVersion,
 		ValidationErrors: validationErrors,
+		FieldIssues:      fieldIssues,
 	}, nil
 }
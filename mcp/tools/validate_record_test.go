@@ -5,6 +5,7 @@ package tools
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -75,6 +76,24 @@ func TestValidateRecord(t *testing.T) {
 		assert.NotEmpty(t, output.ValidationErrors)
 	})
 
+	t.Run("should report unknown fields", func(t *testing.T) {
+		ctx := context.Background()
+		recordWithUnknownField := strings.Replace(validRecord, `"name": "test-agent",`, `"name": "test-agent", "summary": "unknown",`, 1)
+
+		_, output, err := ValidateRecord(ctx, nil, ValidateRecordInput{RecordJSON: recordWithUnknownField})
+
+		require.NoError(t, err)
+		assert.True(t, output.Valid)
+		assert.Equal(t, []string{"unknown field $.summary"}, output.FieldIssues)
+		assert.Empty(t, output.ValidationErrors)
+
+		_, output, err = ValidateRecord(ctx, nil, ValidateRecordInput{RecordJSON: recordWithUnknownField, Strict: true})
+
+		require.NoError(t, err)
+		assert.False(t, output.Valid)
+		assert.Contains(t, output.ValidationErrors, "unknown field $.summary")
+	})
+
 	t.Run("should reject empty input", func(t *testing.T) {
 		ctx := context.Background()
 		input := ValidateRecordInput{RecordJSON: ""}
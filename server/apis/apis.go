@@ -0,0 +1,172 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package apis describes the public APIs of the server with OpenAPI and JSON Schema documents.
+// Descriptors are generated from the protobuf definitions compiled into the server,
+// so that SDK generators and validation tools stay in sync with the APIs it serves.
+package apis
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	_ "github.com/agntcy/dir/api/core/v1"    // register public APIs
+	_ "github.com/agntcy/dir/api/events/v1"  // register public APIs
+	_ "github.com/agntcy/dir/api/routing/v1" // register public APIs
+	_ "github.com/agntcy/dir/api/search/v1"  // register public APIs
+	_ "github.com/agntcy/dir/api/sign/v1"    // register public APIs
+	_ "github.com/agntcy/dir/api/store/v1"   // register public APIs
+	"github.com/agntcy/dir/api/version"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	// ContentType is the media type of the descriptors.
+	ContentType = "application/json"
+
+	// packagePrefix selects the protobuf packages of the public APIs.
+	packagePrefix = "agntcy.dir."
+
+	jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+	openAPIVersion    = "3.0.3"
+)
+
+// ErrUnknownMessage is returned when a JSON schema is requested for a message not part of the public APIs.
+var ErrUnknownMessage = errors.New("unknown message")
+
+// OpenAPI returns an OpenAPI document of the public APIs.
+// RPCs are described as POST operations on their gRPC method path, taking and returning
+// the protobuf JSON mapping of their messages. Streaming RPCs are marked with the
+// x-client-streaming and x-server-streaming extensions.
+func OpenAPI() ([]byte, error) {
+	builder := newSchemaBuilder("#/components/schemas/")
+	paths := map[string]any{}
+
+	for _, file := range files() {
+		services := file.Services()
+		for i := range services.Len() {
+			service := services.Get(i)
+
+			methods := service.Methods()
+			for j := range methods.Len() {
+				method := methods.Get(j)
+
+				operation := map[string]any{
+					"operationId": fmt.Sprintf("%s_%s", service.Name(), method.Name()),
+					"tags":        []string{string(service.FullName())},
+					"requestBody": map[string]any{
+						"required": true,
+						"content":  map[string]any{ContentType: map[string]any{"schema": builder.ref(method.Input())}},
+					},
+					"responses": map[string]any{
+						"200": map[string]any{
+							"description": "OK",
+							"content":     map[string]any{ContentType: map[string]any{"schema": builder.ref(method.Output())}},
+						},
+					},
+				}
+
+				if method.IsStreamingClient() {
+					operation["x-client-streaming"] = true
+				}
+
+				if method.IsStreamingServer() {
+					operation["x-server-streaming"] = true
+				}
+
+				paths[fmt.Sprintf("/%s/%s", service.FullName(), method.Name())] = map[string]any{"post": operation}
+			}
+		}
+	}
+
+	return marshal(map[string]any{
+		"openapi": openAPIVersion,
+		"info": map[string]any{
+			"title":   "Directory API",
+			"version": version.Version,
+		},
+		"paths":      paths,
+		"components": map[string]any{"schemas": builder.defs},
+	})
+}
+
+// JSONSchema returns a JSON schema of the message with the given full name,
+// e.g. "agntcy.dir.core.v1.RecordRef", defining the messages and enums it references.
+// All messages of the public APIs are defined if the name is empty.
+func JSONSchema(message string) ([]byte, error) {
+	builder := newSchemaBuilder("#/$defs/")
+	document := map[string]any{"$schema": jsonSchemaDialect}
+
+	if message == "" {
+		for _, file := range files() {
+			messages := file.Messages()
+			for i := range messages.Len() {
+				builder.ref(messages.Get(i))
+			}
+		}
+	} else {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(message))
+		if err != nil || !strings.HasPrefix(message, packagePrefix) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownMessage, message)
+		}
+
+		md, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownMessage, message)
+		}
+
+		document["$ref"] = builder.ref(md)["$ref"]
+	}
+
+	document["$defs"] = builder.defs
+
+	return marshal(document)
+}
+
+// Messages returns the full names of the messages of the public APIs, sorted.
+func Messages() []string {
+	var names []string
+
+	for _, file := range files() {
+		messages := file.Messages()
+		for i := range messages.Len() {
+			names = append(names, string(messages.Get(i).FullName()))
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// files returns the protobuf files of the public APIs, sorted by path.
+func files() []protoreflect.FileDescriptor {
+	var result []protoreflect.FileDescriptor
+
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		if strings.HasPrefix(string(file.Package()), packagePrefix) {
+			result = append(result, file)
+		}
+
+		return true
+	})
+
+	slices.SortFunc(result, func(a, b protoreflect.FileDescriptor) int {
+		return strings.Compare(a.Path(), b.Path())
+	})
+
+	return result
+}
+
+func marshal(document map[string]any) ([]byte, error) {
+	data, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal API descriptor: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,85 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package apis
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPI(t *testing.T) {
+	data, err := OpenAPI()
+	require.NoError(t, err)
+
+	var document struct {
+		Paths      map[string]map[string]map[string]any `json:"paths"`
+		Components struct {
+			Schemas map[string]map[string]any `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(data, &document))
+
+	// RPCs are described on their gRPC method path
+	operation := document.Paths[corev1.InfoService_GetServerInfo_FullMethodName]["post"]
+	require.NotNil(t, operation)
+	assert.Equal(t, "InfoService_GetServerInfo", operation["operationId"])
+
+	// Streaming RPCs are marked
+	assert.Equal(t, true, document.Paths["/agntcy.dir.store.v1.StoreService/Push"]["post"]["x-client-streaming"])
+
+	// Messages are described with their JSON field names
+	schema := document.Components.Schemas["agntcy.dir.core.v1.GetServerInfoResponse"]
+	require.NotNil(t, schema)
+	assert.Contains(t, schema["properties"], "acceptedSchemaVersions")
+}
+
+func TestJSONSchema(t *testing.T) {
+	data, err := JSONSchema("agntcy.dir.core.v1.GetApiDescriptorRequest")
+	require.NoError(t, err)
+
+	var document struct {
+		Ref  string                    `json:"$ref"`
+		Defs map[string]map[string]any `json:"$defs"`
+	}
+	require.NoError(t, json.Unmarshal(data, &document))
+
+	assert.Equal(t, "#/$defs/agntcy.dir.core.v1.GetApiDescriptorRequest", document.Ref)
+
+	// Referenced enums are defined by the names of their values
+	enum := document.Defs["agntcy.dir.core.v1.ApiDescriptorFormat"]
+	require.NotNil(t, enum)
+	assert.Contains(t, enum["enum"], "API_DESCRIPTOR_FORMAT_JSON_SCHEMA")
+
+	// Only messages of the public APIs are described
+	_, err = JSONSchema("google.protobuf.Timestamp")
+	require.ErrorIs(t, err, ErrUnknownMessage)
+
+	_, err = JSONSchema("agntcy.dir.core.v1.Unknown")
+	require.ErrorIs(t, err, ErrUnknownMessage)
+}
+
+func TestHandler(t *testing.T) {
+	server := httptest.NewServer(Handler())
+	defer server.Close()
+
+	for path, code := range map[string]int{
+		Path:                   http.StatusOK,
+		Path + "/openapi.json": http.StatusOK,
+		Path + "/schema.json":  http.StatusOK,
+		schemasPath + "agntcy.dir.core.v1.RecordRef.json": http.StatusOK,
+		schemasPath + "agntcy.dir.core.v1.Unknown.json":   http.StatusNotFound,
+	} {
+		resp, err := http.Get(server.URL + path) //nolint:noctx
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		assert.Equal(t, code, resp.StatusCode, path)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package apis
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+const (
+	// Path is the HTTP path the descriptors are served under:
+	//   - /apis lists the descriptors and the messages of the public APIs
+	//   - /apis/openapi.json serves the OpenAPI document
+	//   - /apis/schema.json serves the JSON schema of all messages
+	//   - /apis/schemas/<message>.json serves the JSON schema of a message
+	Path = "/apis"
+
+	schemasPath = Path + "/schemas/"
+)
+
+// Handler returns the HTTP handler serving the descriptors under Path.
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET "+Path, func(w http.ResponseWriter, _ *http.Request) {
+		write(w, marshal(map[string]any{
+			"openapi":     Path + "/openapi.json",
+			"json_schema": Path + "/schema.json",
+			"messages":    Messages(),
+		}))
+	})
+
+	mux.HandleFunc("GET "+Path+"/openapi.json", func(w http.ResponseWriter, _ *http.Request) {
+		write(w, OpenAPI())
+	})
+
+	mux.HandleFunc("GET "+Path+"/schema.json", func(w http.ResponseWriter, _ *http.Request) {
+		write(w, JSONSchema(""))
+	})
+
+	mux.HandleFunc("GET "+schemasPath, func(w http.ResponseWriter, r *http.Request) {
+		message, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, schemasPath), ".json")
+		if !ok || message == "" {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		write(w, JSONSchema(message))
+	})
+
+	return mux
+}
+
+// write writes a descriptor, or the error it failed with.
+func write(w http.ResponseWriter, data []byte, err error) {
+	if errors.Is(err, ErrUnknownMessage) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,150 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package apis
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// schemaNode is a JSON Schema node.
+type schemaNode = map[string]any
+
+// wellKnownSchemas are the schemas of the protobuf JSON mapping of well-known types,
+// which are not encoded as objects of their fields.
+var wellKnownSchemas = map[protoreflect.FullName]schemaNode{
+	"google.protobuf.Timestamp": {"type": "string", "format": "date-time"},
+	"google.protobuf.Duration":  {"type": "string", "pattern": `^-?[0-9]+(\.[0-9]+)?s$`},
+	"google.protobuf.FieldMask": {"type": "string"},
+	"google.protobuf.Struct":    {"type": "object"},
+	"google.protobuf.Value":     {},
+	"google.protobuf.ListValue": {"type": "array"},
+	"google.protobuf.Empty":     {"type": "object"},
+	"google.protobuf.Any": {
+		"type":       "object",
+		"properties": schemaNode{"@type": schemaNode{"type": "string"}},
+		"required":   []string{"@type"},
+	},
+	"google.protobuf.BoolValue":   {"type": "boolean"},
+	"google.protobuf.StringValue": {"type": "string"},
+	"google.protobuf.BytesValue":  {"type": "string", "format": "byte"},
+	"google.protobuf.Int32Value":  {"type": "integer", "format": "int32"},
+	"google.protobuf.UInt32Value": {"type": "integer", "format": "int64", "minimum": 0},
+	"google.protobuf.Int64Value":  {"type": "string", "format": "int64"},
+	"google.protobuf.UInt64Value": {"type": "string", "format": "uint64"},
+	"google.protobuf.FloatValue":  {"type": "number", "format": "float"},
+	"google.protobuf.DoubleValue": {"type": "number", "format": "double"},
+}
+
+// schemaBuilder builds the schemas of messages and the enums and messages they reference,
+// following the protobuf JSON mapping.
+type schemaBuilder struct {
+	// refPrefix is prepended to the full names of referenced definitions,
+	// e.g. "#/$defs/" for JSON Schema or "#/components/schemas/" for OpenAPI.
+	refPrefix string
+
+	// defs are the definitions of the referenced messages and enums by full name.
+	defs map[string]schemaNode
+}
+
+func newSchemaBuilder(refPrefix string) *schemaBuilder {
+	return &schemaBuilder{
+		refPrefix: refPrefix,
+		defs:      map[string]schemaNode{},
+	}
+}
+
+// ref returns a reference to the definition of a message, adding it and the definitions it references.
+func (b *schemaBuilder) ref(md protoreflect.MessageDescriptor) schemaNode {
+	if schema, ok := wellKnownSchemas[md.FullName()]; ok {
+		return schema
+	}
+
+	name := string(md.FullName())
+
+	if _, ok := b.defs[name]; !ok {
+		// Added before its fields, so that recursive messages terminate
+		def := schemaNode{"type": "object", "title": name}
+		b.defs[name] = def
+
+		properties := schemaNode{}
+
+		fields := md.Fields()
+		for i := range fields.Len() {
+			fd := fields.Get(i)
+			properties[fd.JSONName()] = b.field(fd)
+		}
+
+		if len(properties) > 0 {
+			def["properties"] = properties
+		}
+	}
+
+	return schemaNode{"$ref": b.refPrefix + name}
+}
+
+// field returns the schema of a message field.
+func (b *schemaBuilder) field(fd protoreflect.FieldDescriptor) schemaNode {
+	switch {
+	case fd.IsMap():
+		return schemaNode{"type": "object", "additionalProperties": b.singular(fd.MapValue())}
+	case fd.IsList():
+		return schemaNode{"type": "array", "items": b.singular(fd)}
+	default:
+		return b.singular(fd)
+	}
+}
+
+// singular returns the schema of a single value of a field.
+// 64-bit integers are encoded as strings by the protobuf JSON mapping.
+func (b *schemaBuilder) singular(fd protoreflect.FieldDescriptor) schemaNode {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return schemaNode{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return schemaNode{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return schemaNode{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return schemaNode{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return schemaNode{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return schemaNode{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return schemaNode{"type": "number", "format": "double"}
+	case protoreflect.StringKind:
+		return schemaNode{"type": "string"}
+	case protoreflect.BytesKind:
+		return schemaNode{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		return b.enum(fd.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.ref(fd.Message())
+	default:
+		return schemaNode{}
+	}
+}
+
+// enum returns a reference to the definition of an enum, adding it.
+// Enums are encoded as the names of their values by the protobuf JSON mapping.
+func (b *schemaBuilder) enum(ed protoreflect.EnumDescriptor) schemaNode {
+	if ed.FullName() == "google.protobuf.NullValue" {
+		return schemaNode{"type": "null"}
+	}
+
+	name := string(ed.FullName())
+
+	if _, ok := b.defs[name]; !ok {
+		values := ed.Values()
+		names := make([]string, 0, values.Len())
+
+		for i := range values.Len() {
+			names = append(names, string(values.Get(i).Name()))
+		}
+
+		b.defs[name] = schemaNode{"type": "string", "title": name, "enum": names}
+	}
+
+	return schemaNode{"$ref": b.refPrefix + name}
+}
@@ -6,6 +6,8 @@ package config
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 )
 
 // AuthMode specifies the authentication mode (jwt or x509).
@@ -16,6 +18,11 @@ const (
 	AuthModeX509 AuthMode = "x509"
 )
 
+const (
+	DefaultTokensTTL    = 1 * time.Hour
+	DefaultTokensMaxTTL = 24 * time.Hour
+)
+
 // Config contains configuration for authentication services.
 type Config struct {
 	// Indicates if authentication is enabled
@@ -29,6 +36,90 @@ type Config struct {
 
 	// Expected audiences for JWT validation (only used in JWT mode)
 	Audiences []string `json:"audiences,omitempty" mapstructure:"audiences"`
+
+	// Admins are the SPIFFE IDs allowed to run administrative operations, such as re-signing records.
+	// A trailing * matches all SPIFFE IDs starting with the given prefix. No caller is an admin if empty.
+	Admins []string `json:"admins,omitempty" mapstructure:"admins"`
+
+	// API tokens configuration (only used in JWT mode)
+	Tokens TokensConfig `json:"tokens,omitempty" mapstructure:"tokens"`
+}
+
+// IsAdmin reports whether the SPIFFE ID is allowed to run administrative operations.
+func (c Config) IsAdmin(spiffeID string) bool {
+	return spiffeID != "" && matchesPattern(c.Admins, spiffeID)
+}
+
+// TokensConfig represents the configuration of the short-lived API tokens scoped to a namespace.
+// API tokens are disabled if no secret is set.
+type TokensConfig struct {
+	// Secret used to sign and verify API tokens.
+	Secret string `json:"secret,omitempty" mapstructure:"secret"`
+
+	// Default validity period of API tokens.
+	TTL time.Duration `json:"ttl,omitempty" mapstructure:"ttl"`
+
+	// Maximum validity period of API tokens.
+	MaxTTL time.Duration `json:"max_ttl,omitempty" mapstructure:"max_ttl"`
+
+	// Admins are the SPIFFE IDs allowed to mint API tokens for any namespace, e.g. spiffe://example.org/admin.
+	// A trailing * matches all SPIFFE IDs starting with the given prefix. No caller can mint tokens if empty.
+	Admins []string `json:"admins,omitempty" mapstructure:"admins"`
+
+	// Exchanges allow OIDC ID tokens to be exchanged for API tokens, e.g. by CI jobs.
+	// ID tokens matching no policy cannot be exchanged.
+	Exchanges []TokenExchangePolicy `json:"exchanges,omitempty" mapstructure:"exchanges"`
+}
+
+// Enabled reports whether API tokens are enabled.
+func (c TokensConfig) Enabled() bool {
+	return c.Secret != ""
+}
+
+// IsAdmin reports whether the SPIFFE ID is allowed to mint API tokens.
+func (c TokensConfig) IsAdmin(spiffeID string) bool {
+	return spiffeID != "" && matchesPattern(c.Admins, spiffeID)
+}
+
+// TokenExchangePolicy allows the OIDC ID tokens of a set of subjects to be exchanged for API tokens.
+// If several policies match an ID token, the first one applies.
+type TokenExchangePolicy struct {
+	// Issuer of the ID tokens, e.g. https://token.actions.githubusercontent.com.
+	Issuer string `json:"issuer,omitempty" mapstructure:"issuer"`
+
+	// Audience the ID tokens must be issued for.
+	Audience string `json:"audience,omitempty" mapstructure:"audience"`
+
+	// Subjects of the ID tokens, e.g. repo:my-org/my-repo:ref:refs/heads/main.
+	// A trailing * matches all subjects starting with the given prefix.
+	Subjects []string `json:"subjects,omitempty" mapstructure:"subjects"`
+
+	// Namespace the API tokens are minted for.
+	Namespace string `json:"namespace,omitempty" mapstructure:"namespace"`
+
+	// Scopes the ID tokens may be exchanged for, e.g. push.
+	Scopes []string `json:"scopes,omitempty" mapstructure:"scopes"`
+}
+
+// Matches reports whether the policy applies to the subject of an ID token.
+func (p TokenExchangePolicy) Matches(subject string) bool {
+	return matchesPattern(p.Subjects, subject)
+}
+
+// matchesPattern reports whether the value matches one of the patterns.
+// A trailing * matches all values starting with the given prefix.
+func matchesPattern(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(value, prefix) {
+				return true
+			}
+		} else if value == pattern {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (c *Config) Validate() error {
@@ -51,5 +142,28 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid auth mode: %s (must be 'jwt' or 'x509')", c.Mode)
 	}
 
+	if !c.Tokens.Enabled() {
+		return nil
+	}
+
+	// API tokens are bearer tokens, which callers can only present in JWT mode
+	if c.Mode != AuthModeJWT {
+		return errors.New("API tokens require JWT mode")
+	}
+
+	if c.Tokens.TTL <= 0 || c.Tokens.MaxTTL < c.Tokens.TTL {
+		return errors.New("API tokens require a positive ttl not exceeding their max ttl")
+	}
+
+	for _, policy := range c.Tokens.Exchanges {
+		if policy.Issuer == "" || policy.Audience == "" || policy.Namespace == "" {
+			return errors.New("token exchange policy requires an issuer, an audience and a namespace")
+		}
+
+		if len(policy.Subjects) == 0 || len(policy.Scopes) == 0 {
+			return errors.New("token exchange policy requires at least one subject and one scope")
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,171 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/authn/config"
+	apitoken "github.com/agntcy/dir/server/authn/token"
+	"github.com/coreos/go-oidc/v3/oidc"
+)
+
+// publicMethods are authenticated by their request instead of the identity of the caller.
+var publicMethods = []string{
+	corev1.TokenService_ExchangeToken_FullMethodName, // tokens: exchange (authenticated by the OIDC ID token)
+}
+
+// IsPublicMethod returns true if the given method is authenticated by its request
+// instead of the identity of the caller, and is thus skipped by authentication and authorization.
+func IsPublicMethod(method string) bool {
+	return slices.Contains(publicMethods, method)
+}
+
+// ErrNoExchangePolicy is returned when an ID token matches no token exchange policy.
+var ErrNoExchangePolicy = errors.New("no token exchange policy matches the ID token")
+
+// Exchange is the result of the exchange of an OIDC ID token.
+type Exchange struct {
+	// Policy is the exchange policy matching the ID token.
+	Policy config.TokenExchangePolicy
+
+	// Scopes the ID token may be exchanged for.
+	Scopes []apitoken.Scope
+
+	// IDToken is the verified ID token.
+	IDToken *oidc.IDToken
+}
+
+// Exchanger verifies the OIDC ID tokens exchanged for API tokens against the exchange policies.
+type Exchanger struct {
+	policies []config.TokenExchangePolicy
+	scopes   [][]apitoken.Scope
+
+	// Providers are discovered on first use, and cached by issuer
+	mu        sync.Mutex
+	providers map[string]*oidc.Provider
+}
+
+// NewExchanger creates an exchanger for the exchange policies.
+func NewExchanger(policies []config.TokenExchangePolicy) (*Exchanger, error) {
+	scopes := make([][]apitoken.Scope, 0, len(policies))
+
+	for _, policy := range policies {
+		policyScopes := make([]apitoken.Scope, 0, len(policy.Scopes))
+
+		for _, name := range policy.Scopes {
+			scope, err := apitoken.ParseScope(name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid token exchange policy: %w", err)
+			}
+
+			policyScopes = append(policyScopes, scope)
+		}
+
+		scopes = append(scopes, policyScopes)
+	}
+
+	return &Exchanger{
+		policies:  policies,
+		scopes:    scopes,
+		providers: make(map[string]*oidc.Provider),
+	}, nil
+}
+
+// Verify verifies an ID token against the exchange policies of its issuer,
+// and returns the first policy matching its audience and subject.
+func (e *Exchanger) Verify(ctx context.Context, rawIDToken string) (*Exchange, error) {
+	issuer, err := unverifiedIssuer(rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+
+	for i, policy := range e.policies {
+		if policy.Issuer != issuer {
+			continue
+		}
+
+		provider, err := e.provider(ctx, issuer)
+		if err != nil {
+			return nil, err
+		}
+
+		idToken, err := provider.Verifier(&oidc.Config{ClientID: policy.Audience}).Verify(ctx, rawIDToken)
+		if err != nil {
+			lastErr = err
+
+			continue
+		}
+
+		if !policy.Matches(idToken.Subject) {
+			continue
+		}
+
+		return &Exchange{
+			Policy:  policy,
+			Scopes:  e.scopes[i],
+			IDToken: idToken,
+		}, nil
+	}
+
+	if lastErr != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNoExchangePolicy, lastErr)
+	}
+
+	return nil, ErrNoExchangePolicy
+}
+
+// provider returns the OIDC provider of an issuer, discovering it on first use.
+func (e *Exchanger) provider(ctx context.Context, issuer string) (*oidc.Provider, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if provider, ok := e.providers[issuer]; ok {
+		return provider, nil
+	}
+
+	// The provider refreshes the keys of the issuer with the discovery context,
+	// which must thus outlive the request
+	provider, err := oidc.NewProvider(context.WithoutCancel(ctx), issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover OIDC issuer %s: %w", issuer, err)
+	}
+
+	e.providers[issuer] = provider
+
+	return provider, nil
+}
+
+// unverifiedIssuer returns the issuer claim of a JWT, without verifying it.
+func unverifiedIssuer(rawIDToken string) (string, error) {
+	parts := strings.Split(rawIDToken, ".")
+	if len(parts) != 3 { //nolint:mnd
+		return "", errors.New("malformed ID token")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", errors.New("malformed ID token")
+	}
+
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Issuer == "" {
+		return "", errors.New("malformed ID token")
+	}
+
+	return claims.Issuer, nil
+}
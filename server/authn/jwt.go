@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	apitoken "github.com/agntcy/dir/server/authn/token"
 	"github.com/agntcy/dir/server/healthcheck"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/go-spiffe/v2/svid/jwtsvid"
@@ -25,13 +27,17 @@ type contextKey string
 const (
 	// SpiffeIDContextKey is the context key for the authenticated SPIFFE ID.
 	SpiffeIDContextKey contextKey = "spiffe-id"
+
+	// TokenContextKey is the context key for the API token the caller authenticated with.
+	TokenContextKey contextKey = "api-token"
 )
 
 // JWTInterceptorFn is a function that performs JWT authentication.
 type JWTInterceptorFn func(ctx context.Context) (context.Context, error)
 
 // NewJWTInterceptor returns an interceptor function that validates JWT tokens.
-func NewJWTInterceptor(jwtSource *workloadapi.JWTSource, audiences []string) JWTInterceptorFn {
+// API tokens signed with the token secret are accepted as well, if set.
+func NewJWTInterceptor(jwtSource *workloadapi.JWTSource, audiences []string, tokenSecret string) JWTInterceptorFn {
 	return func(ctx context.Context) (context.Context, error) {
 		// Extract JWT from metadata
 		token, err := extractToken(ctx)
@@ -39,6 +45,10 @@ func NewJWTInterceptor(jwtSource *workloadapi.JWTSource, audiences []string) JWT
 			return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("failed to extract token: %v", err))
 		}
 
+		if tokenSecret != "" && apitoken.IsToken(token) {
+			return authenticateAPIToken(ctx, tokenSecret, token)
+		}
+
 		// Validate JWT for each audience until one succeeds
 		var (
 			svid    *jwtsvid.SVID
@@ -76,6 +86,33 @@ func NewJWTInterceptor(jwtSource *workloadapi.JWTSource, audiences []string) JWT
 	}
 }
 
+// authenticateAPIToken validates an API token, and stores the identity it authenticates
+// in its namespace and the token itself in the context for downstream handlers.
+func authenticateAPIToken(ctx context.Context, secret, bearer string) (context.Context, error) {
+	token, err := apitoken.Verify(secret, bearer, time.Now())
+	if err != nil {
+		logger.Warn("API token validation failed", "error", err)
+
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
+	}
+
+	spiffeID, err := token.SpiffeID()
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	logger.Debug("API token authenticated",
+		"spiffe_id", spiffeID.String(),
+		"token_id", token.ID,
+		"scopes", token.Scopes,
+	)
+
+	ctx = context.WithValue(ctx, SpiffeIDContextKey, spiffeID)
+	ctx = context.WithValue(ctx, TokenContextKey, token)
+
+	return ctx, nil
+}
+
 // extractToken extracts the JWT token from gRPC metadata.
 func extractToken(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -107,11 +144,19 @@ func SpiffeIDFromContext(ctx context.Context) (spiffeid.ID, bool) {
 	return id, ok
 }
 
+// TokenFromContext extracts the API token the caller authenticated with from the context.
+// Returns false if the caller did not authenticate with an API token.
+func TokenFromContext(ctx context.Context) (*apitoken.Token, bool) {
+	token, ok := ctx.Value(TokenContextKey).(*apitoken.Token)
+
+	return token, ok
+}
+
 // jwtUnaryInterceptorFor wraps the JWT interceptor function for unary RPCs.
 func jwtUnaryInterceptorFor(fn JWTInterceptorFn) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		// Skip authentication for health check endpoints
-		if healthcheck.IsHealthCheckEndpoint(info.FullMethod) {
+		// Skip authentication for health check endpoints and methods authenticated by their request
+		if healthcheck.IsHealthCheckEndpoint(info.FullMethod) || IsPublicMethod(info.FullMethod) {
 			return handler(ctx, req)
 		}
 
@@ -127,8 +172,8 @@ func jwtUnaryInterceptorFor(fn JWTInterceptorFn) grpc.UnaryServerInterceptor {
 // jwtStreamInterceptorFor wraps the JWT interceptor function for stream RPCs.
 func jwtStreamInterceptorFor(fn JWTInterceptorFn) grpc.StreamServerInterceptor {
 	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		// Skip authentication for health check endpoints
-		if healthcheck.IsHealthCheckEndpoint(info.FullMethod) {
+		// Skip authentication for health check endpoints and methods authenticated by their request
+		if healthcheck.IsHealthCheckEndpoint(info.FullMethod) || IsPublicMethod(info.FullMethod) {
 			return handler(srv, ss)
 		}
 
@@ -148,13 +193,13 @@ func jwtStreamInterceptorFor(fn JWTInterceptorFn) grpc.StreamServerInterceptor {
 }
 
 // JWTUnaryInterceptor is a convenience wrapper for JWT unary authentication.
-func JWTUnaryInterceptor(jwtSource *workloadapi.JWTSource, audiences []string) grpc.UnaryServerInterceptor {
-	return jwtUnaryInterceptorFor(NewJWTInterceptor(jwtSource, audiences))
+func JWTUnaryInterceptor(jwtSource *workloadapi.JWTSource, audiences []string, tokenSecret string) grpc.UnaryServerInterceptor {
+	return jwtUnaryInterceptorFor(NewJWTInterceptor(jwtSource, audiences, tokenSecret))
 }
 
 // JWTStreamInterceptor is a convenience wrapper for JWT stream authentication.
-func JWTStreamInterceptor(jwtSource *workloadapi.JWTSource, audiences []string) grpc.StreamServerInterceptor {
-	return jwtStreamInterceptorFor(NewJWTInterceptor(jwtSource, audiences))
+func JWTStreamInterceptor(jwtSource *workloadapi.JWTSource, audiences []string, tokenSecret string) grpc.StreamServerInterceptor {
+	return jwtStreamInterceptorFor(NewJWTInterceptor(jwtSource, audiences, tokenSecret))
 }
 
 // wrappedServerStream wraps a grpc.ServerStream to override the context.
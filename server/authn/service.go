@@ -13,6 +13,7 @@ import (
 	"github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
 	"github.com/spiffe/go-spiffe/v2/workloadapi"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 var logger = logging.Logger("authn")
@@ -25,6 +26,10 @@ type Service struct {
 	jwtSource *workloadapi.JWTSource
 	x509Src   *workloadapi.X509Source
 	bundleSrc *workloadapi.BundleSource
+
+	// API tokens, only accepted in JWT mode
+	tokens    config.TokensConfig
+	exchanger *Exchanger
 }
 
 // New creates a new authentication service (JWT or X.509 based on config).
@@ -54,7 +59,13 @@ func New(ctx context.Context, cfg config.Config) (*Service, error) {
 			return nil, err
 		}
 
-		logger.Info("JWT authentication service initialized", "audiences", cfg.Audiences)
+		if err := service.initTokens(cfg.Tokens); err != nil {
+			_ = service.Stop()
+
+			return nil, err
+		}
+
+		logger.Info("JWT authentication service initialized", "audiences", cfg.Audiences, "api_tokens", cfg.Tokens.Enabled())
 
 	case config.AuthModeX509:
 		if err := service.initX509(ctx); err != nil {
@@ -109,6 +120,29 @@ func (s *Service) initJWT(ctx context.Context, cfg config.Config) error {
 	return nil
 }
 
+// initTokens initializes the API tokens, if enabled.
+func (s *Service) initTokens(cfg config.TokensConfig) error {
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	exchanger, err := NewExchanger(cfg.Exchanges)
+	if err != nil {
+		return err
+	}
+
+	s.tokens = cfg
+	s.exchanger = exchanger
+
+	return nil
+}
+
+// Tokens returns the configuration of the API tokens, and the exchanger of OIDC ID tokens for API tokens.
+// API tokens are disabled if the secret of the configuration is empty, in which case the exchanger is nil.
+func (s *Service) Tokens() (config.TokensConfig, *Exchanger) {
+	return s.tokens, s.exchanger
+}
+
 // initX509 initializes X.509 authentication components.
 func (s *Service) initX509(ctx context.Context) error {
 	// Create a new X509 source which periodically refetches X509-SVIDs and X.509 bundles
@@ -141,8 +175,8 @@ func (s *Service) GetServerOptions() []grpc.ServerOption {
 			grpc.Creds(
 				grpccredentials.TLSServerCredentials(s.x509Src),
 			),
-			grpc.ChainUnaryInterceptor(JWTUnaryInterceptor(s.jwtSource, s.audiences)),
-			grpc.ChainStreamInterceptor(JWTStreamInterceptor(s.jwtSource, s.audiences)),
+			grpc.ChainUnaryInterceptor(JWTUnaryInterceptor(s.jwtSource, s.audiences, s.tokens.Secret)),
+			grpc.ChainStreamInterceptor(JWTStreamInterceptor(s.jwtSource, s.audiences, s.tokens.Secret)),
 		}
 
 	case config.AuthModeX509:
@@ -161,6 +195,28 @@ func (s *Service) GetServerOptions() []grpc.ServerOption {
 	}
 }
 
+// ClientCredentials returns the transport credentials of in-process clients of the server, e.g. the web UI.
+// The server is authenticated with its own SPIFFE ID, and clients present their X.509-SVID in X.509 mode.
+func (s *Service) ClientCredentials() (credentials.TransportCredentials, error) {
+	svid, err := s.x509Src.GetX509SVID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get X509-SVID: %w", err)
+	}
+
+	authorizer := tlsconfig.AuthorizeID(svid.ID)
+
+	switch s.mode {
+	case config.AuthModeJWT:
+		return grpccredentials.TLSClientCredentials(s.bundleSrc, authorizer), nil
+
+	case config.AuthModeX509:
+		return grpccredentials.MTLSClientCredentials(s.x509Src, s.bundleSrc, authorizer), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported auth mode: %s", s.mode)
+	}
+}
+
 // Stop closes the workload API client and all sources.
 //
 //nolint:wrapcheck
@@ -0,0 +1,96 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package token
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueAndVerify(t *testing.T) {
+	now := time.Now()
+
+	token, err := New("ml", []Scope{ScopePush}, "spiffe://dir.example.com/admin", now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, token.ID, 32)
+
+	bearer, err := Issue("secret", token)
+	require.NoError(t, err)
+	assert.True(t, IsToken(bearer))
+
+	verified, err := Verify("secret", bearer, now)
+	require.NoError(t, err)
+	assert.Equal(t, token, verified)
+	assert.True(t, verified.HasScope(ScopePush))
+	assert.False(t, verified.HasScope(ScopeRead))
+
+	// Bearers are authenticated in the trust domain of the namespace
+	spiffeID, err := verified.SpiffeID()
+	require.NoError(t, err)
+	assert.Equal(t, "spiffe://ml/token/"+token.ID, spiffeID.String())
+
+	_, err = Verify("other-secret", bearer, now)
+	require.ErrorContains(t, err, "invalid token signature")
+
+	_, err = Verify("secret", bearer, now.Add(2*time.Hour))
+	require.ErrorContains(t, err, "token expired")
+}
+
+func TestVerifyTampered(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+
+	token, err := New("ml", []Scope{ScopeRead}, "", expiresAt)
+	require.NoError(t, err)
+
+	bearer, err := Issue("secret", token)
+	require.NoError(t, err)
+
+	// Widen the scopes while keeping the signature
+	token.Scopes = Scopes
+
+	widened, err := Issue("other-secret", token)
+	require.NoError(t, err)
+
+	parts := strings.Split(bearer, ".")
+	widenedParts := strings.Split(widened, ".")
+
+	_, err = Verify("secret", strings.Join([]string{parts[0], widenedParts[1], parts[2]}, "."), time.Now())
+	require.ErrorContains(t, err, "invalid token signature")
+}
+
+func TestNewInvalid(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+
+	_, err := New("Not A Namespace", []Scope{ScopeRead}, "", expiresAt)
+	require.Error(t, err)
+
+	_, err = New("ml", nil, "", expiresAt)
+	require.Error(t, err)
+
+	_, err = New("ml", []Scope{"admin"}, "", expiresAt)
+	require.Error(t, err)
+}
+
+func TestParseScope(t *testing.T) {
+	scope, err := ParseScope(" Push ")
+	require.NoError(t, err)
+	assert.Equal(t, ScopePush, scope)
+
+	_, err = ParseScope("admin")
+	require.Error(t, err)
+}
+
+func TestVerifyMalformed(t *testing.T) {
+	for _, bearer := range []string{"", "dirtok1", "other.e30.sig", "dirtok1.!!!.sig", "eyJhbGciOi.e30.sig"} {
+		_, err := Verify("secret", bearer, time.Now())
+		assert.Error(t, err, bearer)
+	}
+
+	// JWTs are not API tokens
+	assert.False(t, IsToken("eyJhbGciOi.e30.sig"))
+}
@@ -6,8 +6,15 @@ package authz
 import (
 	_ "embed"
 	"fmt"
+	"slices"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
 	storev1 "github.com/agntcy/dir/api/store/v1"
+	apitoken "github.com/agntcy/dir/server/authn/token"
 	"github.com/agntcy/dir/server/authz/config"
 	"github.com/casbin/casbin/v2"
 	"github.com/casbin/casbin/v2/model"
@@ -24,11 +31,67 @@ var allowedExternalAPIMethods = []string{
 	storev1.StoreService_Pull_FullMethodName,                      // store: pull
 	storev1.StoreService_PullReferrer_FullMethodName,              // store: pull referrer
 	storev1.StoreService_Lookup_FullMethodName,                    // store: lookup
+	storev1.StoreService_ResolveName_FullMethodName,               // store: resolve name
 	storev1.SyncService_RequestRegistryCredentials_FullMethodName, // sync: negotiate
+	storev1.SyncService_ReconcileCIDs_FullMethodName,              // sync: reconcile
+	eventsv1.EventService_Listen_FullMethodName,                   // events: listen (own namespace only)
+	eventsv1.EventService_WatchName_FullMethodName,                // events: watch name (own namespace only)
+	corev1.InfoService_GetServerInfo_FullMethodName,               // info: server info
+	corev1.InfoService_GetApiDescriptor_FullMethodName,            // info: API descriptor
 }
 
+// Defines the API methods granted by each scope of API tokens.
+// Callers authenticated with a token are only granted the methods of its scopes.
+var tokenScopeAPIMethods = map[apitoken.Scope][]string{
+	apitoken.ScopeRead: {
+		storev1.StoreService_Pull_FullMethodName,                          // store: pull
+		storev1.StoreService_PullReferrer_FullMethodName,                  // store: pull referrer
+		storev1.StoreService_Lookup_FullMethodName,                        // store: lookup
+		storev1.StoreService_RecordInfo_FullMethodName,                    // store: record info
+		storev1.StoreService_GetDependencies_FullMethodName,               // store: dependencies
+		storev1.StoreService_GetDependents_FullMethodName,                 // store: dependents
+		storev1.StoreService_ResolveLocator_FullMethodName,                // store: resolve locator
+		storev1.StoreService_ResolveName_FullMethodName,                   // store: resolve name
+		storev1.StoreService_ListAliases_FullMethodName,                   // store: list aliases
+		storev1.StoreService_GetAliasHistory_FullMethodName,               // store: alias history
+		searchv1.SearchService_Search_FullMethodName,                      // search: search
+		routingv1.RoutingService_Search_FullMethodName,                    // routing: search
+		routingv1.RoutingService_List_FullMethodName,                      // routing: list
+		routingv1.PublicationService_ListPublications_FullMethodName,      // publication: list
+		routingv1.PublicationService_GetPublication_FullMethodName,        // publication: get
+		routingv1.PublicationService_GetPublicationSummary_FullMethodName, // publication: summary
+		signv1.SignService_Verify_FullMethodName,                          // sign: verify
+		eventsv1.EventService_Listen_FullMethodName,                       // events: listen
+		eventsv1.EventService_WatchName_FullMethodName,                    // events: watch name
+		corev1.InfoService_GetServerInfo_FullMethodName,                   // info: server info
+		corev1.InfoService_GetApiDescriptor_FullMethodName,                // info: API descriptor
+	},
+	apitoken.ScopePush: {
+		storev1.StoreService_Push_FullMethodName,           // store: push
+		storev1.StoreService_PushMany_FullMethodName,       // store: push many
+		storev1.StoreService_PushBundle_FullMethodName,     // store: push bundle
+		storev1.StoreService_PushReferrer_FullMethodName,   // store: push referrer (e.g. signatures)
+		corev1.InfoService_GetServerInfo_FullMethodName,    // info: server info (checked before pushing)
+		corev1.InfoService_GetApiDescriptor_FullMethodName, // info: API descriptor
+	},
+	apitoken.ScopePublish: {
+		routingv1.RoutingService_Publish_FullMethodName,                // routing: publish
+		routingv1.RoutingService_Unpublish_FullMethodName,              // routing: unpublish
+		routingv1.PublicationService_CreatePublication_FullMethodName,  // publication: create
+		routingv1.PublicationService_ConfirmPublication_FullMethodName, // publication: confirm
+		corev1.InfoService_GetServerInfo_FullMethodName,                // info: server info
+		corev1.InfoService_GetApiDescriptor_FullMethodName,             // info: API descriptor
+	},
+}
+
+// ListenAllNamespacesPermission is checked by the events service to decide
+// whether a caller may subscribe to events from other namespaces.
+// It is only granted to users within our trust domain.
+const ListenAllNamespacesPermission = "events:listen-all-namespaces"
+
 type Authorizer struct {
-	enforcer *casbin.Enforcer
+	enforcer           *casbin.Enforcer
+	eventSubscriptions []config.EventSubscriptionPolicy
 }
 
 // New creates a new Casbin-based Authorizer.
@@ -50,7 +113,10 @@ func NewAuthorizer(cfg config.Config) (*Authorizer, error) {
 		return nil, fmt.Errorf("failed to add policies: %w", err)
 	}
 
-	return &Authorizer{enforcer: enforcer}, nil
+	return &Authorizer{
+		enforcer:           enforcer,
+		eventSubscriptions: cfg.EventSubscriptions,
+	}, nil
 }
 
 // Authorize checks if the user in trust domain can perform a given API method.
@@ -60,6 +126,17 @@ func (a *Authorizer) Authorize(trustDomain, apiMethod string) (bool, error) {
 	return a.enforcer.Enforce(trustDomain, apiMethod)
 }
 
+// AuthorizeToken checks if an API token grants a given API method through one of its scopes.
+func (a *Authorizer) AuthorizeToken(token *apitoken.Token, apiMethod string) bool {
+	for _, scope := range token.Scopes {
+		if slices.Contains(tokenScopeAPIMethods[scope], apiMethod) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // getPolicies returns a list of authorization in the following form:
 //   - All API methods are allowed for users within our trust domain
 //   - Only specific API methods are allowed for users outside of the trust domain
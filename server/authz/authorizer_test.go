@@ -6,8 +6,11 @@ package authz
 import (
 	"testing"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
 	storev1 "github.com/agntcy/dir/api/store/v1"
+	apitoken "github.com/agntcy/dir/server/authn/token"
 	"github.com/agntcy/dir/server/authz/config"
 )
 
@@ -28,11 +31,17 @@ func TestAuthorizer(t *testing.T) {
 		{"dir.com", storev1.StoreService_Delete_FullMethodName, true},
 		{"dir.com", storev1.StoreService_Push_FullMethodName, true},
 		{"dir.com", routingv1.RoutingService_Publish_FullMethodName, true},
+		{"dir.com", ListenAllNamespacesPermission, true},
 
-		// anyone else: only pull/lookup/sync
+		// anyone else: only pull/lookup/sync/listen to own namespace
 		{"other.com", storev1.StoreService_Pull_FullMethodName, true},
 		{"other.com", storev1.StoreService_Lookup_FullMethodName, true},
 		{"other.com", storev1.SyncService_RequestRegistryCredentials_FullMethodName, true},
+		{"other.com", storev1.SyncService_ReconcileCIDs_FullMethodName, true},
+		{"other.com", eventsv1.EventService_Listen_FullMethodName, true},
+		{"other.com", eventsv1.EventService_WatchName_FullMethodName, true},
+		{"other.com", corev1.InfoService_GetServerInfo_FullMethodName, true},
+		{"other.com", ListenAllNamespacesPermission, false},
 		{"other.com", storev1.StoreService_Push_FullMethodName, false},
 		{"other.com", routingv1.RoutingService_Publish_FullMethodName, false},
 	}
@@ -48,3 +57,96 @@ func TestAuthorizer(t *testing.T) {
 		}
 	}
 }
+
+func TestAuthorizeToken(t *testing.T) {
+	authz, err := NewAuthorizer(config.Config{
+		TrustDomain: "dir.com",
+	})
+	if err != nil {
+		t.Fatalf("failed to create Casbin authorizer: %v", err)
+	}
+
+	tests := []struct {
+		scopes    []apitoken.Scope
+		apiMethod string
+		allow     bool
+	}{
+		// read-only tokens
+		{[]apitoken.Scope{apitoken.ScopeRead}, storev1.StoreService_Pull_FullMethodName, true},
+		{[]apitoken.Scope{apitoken.ScopeRead}, eventsv1.EventService_Listen_FullMethodName, true},
+		{[]apitoken.Scope{apitoken.ScopeRead}, storev1.StoreService_Push_FullMethodName, false},
+		{[]apitoken.Scope{apitoken.ScopeRead}, storev1.StoreService_Delete_FullMethodName, false},
+
+		// push-only tokens
+		{[]apitoken.Scope{apitoken.ScopePush}, storev1.StoreService_Push_FullMethodName, true},
+		{[]apitoken.Scope{apitoken.ScopePush}, storev1.StoreService_PushReferrer_FullMethodName, true},
+		{[]apitoken.Scope{apitoken.ScopePush}, storev1.StoreService_Pull_FullMethodName, false},
+		{[]apitoken.Scope{apitoken.ScopePush}, routingv1.RoutingService_Publish_FullMethodName, false},
+
+		// tokens never mint tokens
+		{apitoken.Scopes, corev1.TokenService_CreateToken_FullMethodName, false},
+
+		// scopes are combined
+		{[]apitoken.Scope{apitoken.ScopePush, apitoken.ScopePublish}, routingv1.RoutingService_Publish_FullMethodName, true},
+	}
+
+	for _, tt := range tests {
+		if allowed := authz.AuthorizeToken(&apitoken.Token{Scopes: tt.scopes}, tt.apiMethod); allowed != tt.allow {
+			t.Errorf("AuthorizeToken(%v, %q) = %v, want %v", tt.scopes, tt.apiMethod, allowed, tt.allow)
+		}
+	}
+}
+
+func TestRestrictEventSubscription(t *testing.T) {
+	authz, err := NewAuthorizer(config.Config{
+		TrustDomain: "dir.com",
+		EventSubscriptions: []config.EventSubscriptionPolicy{
+			{
+				Identities: []string{"spiffe://dir.com/ci"},
+				EventTypes: []string{"RECORD_PUSHED"},
+			},
+			{
+				Identities:    []string{"spiffe://dir.com/ci", "spiffe://dir.com/teams/*"},
+				EventTypes:    []string{"EVENT_TYPE_RECORD_PUBLISHED"},
+				LabelPrefixes: []string{"/skills/"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create Casbin authorizer: %v", err)
+	}
+
+	if restriction := authz.RestrictEventSubscription("spiffe://dir.com/admin"); restriction != nil {
+		t.Errorf("RestrictEventSubscription() = %+v for unrestricted identity, want nil", restriction)
+	}
+
+	// Matching policies are merged, an empty label restriction allows all labels
+	restriction := authz.RestrictEventSubscription("spiffe://dir.com/ci")
+	if restriction == nil {
+		t.Fatal("RestrictEventSubscription() = nil for restricted identity")
+	}
+
+	if !restriction.AllowsEventType(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED) ||
+		!restriction.AllowsEventType(eventsv1.EventType_EVENT_TYPE_RECORD_PUBLISHED) ||
+		restriction.AllowsEventType(eventsv1.EventType_EVENT_TYPE_SYNC_CREATED) {
+		t.Errorf("unexpected allowed event types %v", restriction.EventTypes)
+	}
+
+	if len(restriction.LabelPrefixes) != 0 {
+		t.Errorf("unexpected label prefixes %v", restriction.LabelPrefixes)
+	}
+
+	// Trailing wildcards match identity prefixes
+	restriction = authz.RestrictEventSubscription("spiffe://dir.com/teams/ml")
+	if restriction == nil {
+		t.Fatal("RestrictEventSubscription() = nil for restricted identity")
+	}
+
+	if restriction.AllowsEventType(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED) {
+		t.Errorf("unexpected allowed event types %v", restriction.EventTypes)
+	}
+
+	if len(restriction.LabelPrefixes) != 1 || restriction.LabelPrefixes[0] != "/skills/" {
+		t.Errorf("unexpected label prefixes %v", restriction.LabelPrefixes)
+	}
+}
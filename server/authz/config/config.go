@@ -3,7 +3,13 @@
 
 package config
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+)
 
 // Config contains configuration for authorization (AuthZ) services.
 // Authorization is separate from authentication (AuthN) - it receives
@@ -15,6 +21,62 @@ type Config struct {
 	// Trust domain for this Directory server
 	// Used to distinguish internal vs external requests
 	TrustDomain string `json:"trust_domain,omitempty" mapstructure:"trust_domain"`
+
+	// EventSubscriptions restrict the events that specific identities may subscribe to.
+	// Identities without a matching policy are not restricted.
+	EventSubscriptions []EventSubscriptionPolicy `json:"event_subscriptions,omitempty" mapstructure:"event_subscriptions"`
+}
+
+// EventSubscriptionPolicy restricts the event subscriptions of a set of identities.
+// If several policies match an identity, it may subscribe to the events allowed by any of them.
+type EventSubscriptionPolicy struct {
+	// Identities the policy applies to, as SPIFFE IDs, e.g. spiffe://example.org/ci.
+	// A trailing * matches all SPIFFE IDs starting with the given prefix.
+	Identities []string `json:"identities,omitempty" mapstructure:"identities"`
+
+	// EventTypes the identities may subscribe to, e.g. RECORD_PUSHED.
+	// Event types are case-insensitive and the EVENT_TYPE_ prefix is optional.
+	// All event types are allowed if empty.
+	EventTypes []string `json:"event_types,omitempty" mapstructure:"event_types"`
+
+	// LabelPrefixes restrict the identities to the events of records with a label
+	// starting with one of the prefixes, e.g. /skills/. All labels are allowed if empty.
+	LabelPrefixes []string `json:"label_prefixes,omitempty" mapstructure:"label_prefixes"`
+}
+
+// Matches reports whether the policy applies to the SPIFFE ID.
+func (p EventSubscriptionPolicy) Matches(spiffeID string) bool {
+	for _, identity := range p.Identities {
+		if prefix, ok := strings.CutSuffix(identity, "*"); ok {
+			if strings.HasPrefix(spiffeID, prefix) {
+				return true
+			}
+		} else if spiffeID == identity {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Types returns the event types the identities may subscribe to, or nil for all event types.
+func (p EventSubscriptionPolicy) Types() ([]eventsv1.EventType, error) {
+	if len(p.EventTypes) == 0 {
+		return nil, nil
+	}
+
+	types := make([]eventsv1.EventType, 0, len(p.EventTypes))
+
+	for _, name := range p.EventTypes {
+		eventType, ok := eventsv1.EventType_value["EVENT_TYPE_"+strings.TrimPrefix(strings.ToUpper(name), "EVENT_TYPE_")]
+		if !ok || eventType == int32(eventsv1.EventType_EVENT_TYPE_UNSPECIFIED) {
+			return nil, fmt.Errorf("unknown event type %q in event subscription policy", name)
+		}
+
+		types = append(types, eventsv1.EventType(eventType))
+	}
+
+	return types, nil
 }
 
 func (c *Config) Validate() error {
@@ -26,5 +88,22 @@ func (c *Config) Validate() error {
 		return errors.New("trust domain is required for authorization")
 	}
 
+	for _, policy := range c.EventSubscriptions {
+		if len(policy.Identities) == 0 {
+			return errors.New("event subscription policy requires at least one identity")
+		}
+
+		if _, err := policy.Types(); err != nil {
+			return err
+		}
+
+		// Label prefixes are enforced with filter expressions, which they must not escape
+		for _, prefix := range policy.LabelPrefixes {
+			if prefix == "" || strings.ContainsAny(prefix, `'\`) {
+				return fmt.Errorf("invalid label prefix %q in event subscription policy", prefix)
+			}
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,78 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"slices"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+)
+
+// EventSubscriptionRestriction restricts the events an identity may subscribe to.
+type EventSubscriptionRestriction struct {
+	// EventTypes the identity may subscribe to. All event types are allowed if empty.
+	EventTypes []eventsv1.EventType
+
+	// LabelPrefixes restrict the identity to the events of records with a label
+	// starting with one of the prefixes. All labels are allowed if empty.
+	LabelPrefixes []string
+}
+
+// AllowsEventType reports whether the identity may subscribe to the event type.
+func (r *EventSubscriptionRestriction) AllowsEventType(eventType eventsv1.EventType) bool {
+	return len(r.EventTypes) == 0 || slices.Contains(r.EventTypes, eventType)
+}
+
+// RestrictEventSubscription returns the restriction of the event subscriptions of the
+// identity, merging the event subscription policies matching it.
+// Returns nil if the identity is not restricted.
+func (a *Authorizer) RestrictEventSubscription(spiffeID string) *EventSubscriptionRestriction {
+	var (
+		restriction *EventSubscriptionRestriction
+		allTypes    bool
+		allLabels   bool
+	)
+
+	for _, policy := range a.eventSubscriptions {
+		if !policy.Matches(spiffeID) {
+			continue
+		}
+
+		if restriction == nil {
+			restriction = &EventSubscriptionRestriction{}
+		}
+
+		// Policies are validated with the authorization configuration
+		types, _ := policy.Types()
+		if len(types) == 0 {
+			allTypes = true
+		}
+
+		if len(policy.LabelPrefixes) == 0 {
+			allLabels = true
+		}
+
+		for _, eventType := range types {
+			if !slices.Contains(restriction.EventTypes, eventType) {
+				restriction.EventTypes = append(restriction.EventTypes, eventType)
+			}
+		}
+
+		restriction.LabelPrefixes = append(restriction.LabelPrefixes, policy.LabelPrefixes...)
+	}
+
+	if restriction == nil {
+		return nil
+	}
+
+	if allTypes {
+		restriction.EventTypes = nil
+	}
+
+	if allLabels {
+		restriction.LabelPrefixes = nil
+	}
+
+	return restriction
+}
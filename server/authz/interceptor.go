@@ -32,6 +32,30 @@ func NewInterceptor(authorizer *Authorizer) InterceptorFn {
 
 		trustDomain := sid.TrustDomain().String()
 
+		// API tokens are authorized by their scopes rather than by the trust domain policies,
+		// as their namespace is their trust domain. Namespace-restricted methods, such as
+		// listening to events, are limited to the namespace of the token by their services.
+		if token, ok := authn.TokenFromContext(ctx); ok {
+			if token.Namespace != trustDomain || !authorizer.AuthorizeToken(token, apiMethod) {
+				logger.Warn("Authorization denied by token scopes",
+					"method", apiMethod,
+					"token_id", token.ID,
+					"namespace", token.Namespace,
+					"scopes", token.Scopes,
+				)
+
+				return status.Error(codes.PermissionDenied, "token does not grant access to "+apiMethod)
+			}
+
+			logger.Debug("Authorization successful",
+				"method", apiMethod,
+				"token_id", token.ID,
+				"namespace", token.Namespace,
+			)
+
+			return nil
+		}
+
 		// Perform authorization check
 		allowed, err := authorizer.Authorize(trustDomain, apiMethod)
 		if err != nil {
@@ -67,8 +91,8 @@ func NewInterceptor(authorizer *Authorizer) InterceptorFn {
 
 func UnaryInterceptorFor(fn InterceptorFn) func(context.Context, any, *grpc.UnaryServerInfo, grpc.UnaryHandler) (any, error) {
 	return func(ctx context.Context, req any, sInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		// Skip authorization for health check endpoints
-		if healthcheck.IsHealthCheckEndpoint(sInfo.FullMethod) {
+		// Skip authorization for health check endpoints and methods authenticated by their request
+		if healthcheck.IsHealthCheckEndpoint(sInfo.FullMethod) || authn.IsPublicMethod(sInfo.FullMethod) {
 			return handler(ctx, req)
 		}
 
@@ -82,8 +106,8 @@ func UnaryInterceptorFor(fn InterceptorFn) func(context.Context, any, *grpc.Unar
 
 func StreamInterceptorFor(fn InterceptorFn) func(any, grpc.ServerStream, *grpc.StreamServerInfo, grpc.StreamHandler) error {
 	return func(srv any, ss grpc.ServerStream, sInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		// Skip authorization for health check endpoints
-		if healthcheck.IsHealthCheckEndpoint(sInfo.FullMethod) {
+		// Skip authorization for health check endpoints and methods authenticated by their request
+		if healthcheck.IsHealthCheckEndpoint(sInfo.FullMethod) || authn.IsPublicMethod(sInfo.FullMethod) {
 			return handler(srv, ss)
 		}
 
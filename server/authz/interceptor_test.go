@@ -0,0 +1,101 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/authn"
+	apitoken "github.com/agntcy/dir/server/authn/token"
+	"github.com/agntcy/dir/server/authz/config"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// tokenContext returns the context of a caller authenticated with an API token, as set by the authn interceptor.
+func tokenContext(t *testing.T, namespace string, scopes ...apitoken.Scope) context.Context {
+	t.Helper()
+
+	token, err := apitoken.New(namespace, scopes, "spiffe://dir.com/admin", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+
+	sid, err := token.SpiffeID()
+	if err != nil {
+		t.Fatalf("failed to get token SPIFFE ID: %v", err)
+	}
+
+	ctx := context.WithValue(t.Context(), authn.SpiffeIDContextKey, sid)
+
+	return context.WithValue(ctx, authn.TokenContextKey, token)
+}
+
+func TestInterceptor_Token(t *testing.T) {
+	authz, err := NewAuthorizer(config.Config{
+		TrustDomain: "dir.com",
+	})
+	if err != nil {
+		t.Fatalf("failed to create Casbin authorizer: %v", err)
+	}
+
+	interceptor := NewInterceptor(authz)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		apiMethod string
+		code      codes.Code
+	}{
+		{
+			name:      "push token of another namespace pushes",
+			ctx:       tokenContext(t, "ml", apitoken.ScopePush),
+			apiMethod: storev1.StoreService_Push_FullMethodName,
+			code:      codes.OK,
+		},
+		{
+			name:      "publish token of another namespace publishes",
+			ctx:       tokenContext(t, "ml", apitoken.ScopePublish),
+			apiMethod: routingv1.RoutingService_Publish_FullMethodName,
+			code:      codes.OK,
+		},
+		{
+			name:      "push token cannot delete",
+			ctx:       tokenContext(t, "ml", apitoken.ScopePush),
+			apiMethod: storev1.StoreService_Delete_FullMethodName,
+			code:      codes.PermissionDenied,
+		},
+		{
+			name:      "token of the trust domain is limited to its scopes",
+			ctx:       tokenContext(t, "dir.com", apitoken.ScopeRead),
+			apiMethod: storev1.StoreService_Push_FullMethodName,
+			code:      codes.PermissionDenied,
+		},
+		{
+			name:      "token is limited to its namespace",
+			ctx:       context.WithValue(tokenContext(t, "ml", apitoken.ScopePush), authn.SpiffeIDContextKey, spiffeid.RequireFromString("spiffe://other.com/token/id")),
+			apiMethod: storev1.StoreService_Push_FullMethodName,
+			code:      codes.PermissionDenied,
+		},
+		{
+			name:      "callers of other trust domains without token cannot push",
+			ctx:       context.WithValue(t.Context(), authn.SpiffeIDContextKey, spiffeid.RequireFromString("spiffe://ml/ci")),
+			apiMethod: storev1.StoreService_Push_FullMethodName,
+			code:      codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := status.Code(interceptor(tt.ctx, tt.apiMethod)); code != tt.code {
+				t.Errorf("interceptor(%q) = %v, want %v", tt.apiMethod, code, tt.code)
+			}
+		})
+	}
+}
@@ -41,6 +41,11 @@ func New(_ context.Context, cfg config.Config) (*Service, error) {
 	}, nil
 }
 
+// Authorizer returns the authorizer used to enforce policies.
+func (s *Service) Authorizer() *Authorizer {
+	return s.authorizer
+}
+
 // GetServerOptions returns gRPC server options for authorization.
 func (s *Service) GetServerOptions() []grpc.ServerOption {
 	return []grpc.ServerOption{
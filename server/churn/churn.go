@@ -0,0 +1,223 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package churn protects against flapping records, e.g. repeatedly pushed and deleted or
+// published and unpublished by misbehaving automation. The effects of their changes, such as
+// network announcements and events, are held back while they flap and coalesced into the effect
+// of their final state once they stop changing, instead of being applied for every change.
+package churn
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/agntcy/dir/server/churn/config"
+	"github.com/agntcy/dir/utils/logging"
+)
+
+var logger = logging.Logger("churn")
+
+// Damper applies the effects of the state changes of keys, coalescing those of flapping keys.
+// A key is flapping once it changed more than the threshold within the window. The effect of
+// its latest state is then applied after the quiet period without changes, unless it is the
+// state whose effect was applied last.
+//
+// A nil Damper applies all effects immediately.
+type Damper struct {
+	config config.Config
+	name   string
+
+	mu        sync.Mutex
+	keys      map[string]*key
+	lastSweep time.Time
+	stopped   bool
+}
+
+// key is the change history of a key.
+type key struct {
+	changes []time.Time
+
+	// applied is the state whose effect was applied last.
+	applied string
+
+	// Set while the key is flapping: the latest state and its held back effect,
+	// applied when the timer fires after the quiet period.
+	pendingState  string
+	pendingEffect Effect
+	pendingCtx    context.Context //nolint:containedctx
+	timer         *time.Timer
+}
+
+// Effect is the effect of a state change, e.g. announcing a record to the network.
+// The context is the one of the change if the effect is applied immediately,
+// and is detached from its cancellation if the effect was held back.
+type Effect func(ctx context.Context) error
+
+// New creates a damper. The name identifies the damped effects in logs, e.g. "events".
+// Returns nil if churn protection is disabled.
+func New(cfg config.Config, name string) *Damper {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	return &Damper{
+		config: cfg,
+		name:   name,
+		keys:   make(map[string]*key),
+	}
+}
+
+// Change records the change of a key to a state, and applies its effect unless the key is flapping.
+// Returns true and the error of the effect if it was applied immediately.
+// Errors of held back effects are logged when they are applied.
+func (d *Damper) Change(ctx context.Context, k, state string, effect Effect) (bool, error) {
+	if d == nil {
+		return true, effect(ctx)
+	}
+
+	d.mu.Lock()
+
+	if d.stopped {
+		d.mu.Unlock()
+
+		return true, effect(ctx)
+	}
+
+	now := time.Now()
+	d.sweep(now)
+
+	entry, ok := d.keys[k]
+	if !ok {
+		entry = &key{}
+		d.keys[k] = entry
+	}
+
+	entry.changes = append(recent(entry.changes, now.Add(-d.config.Window)), now)
+
+	// Keys keep flapping until they have been quiet for the quiet period
+	if entry.timer == nil && len(entry.changes) <= d.config.Threshold {
+		entry.applied = state
+		d.mu.Unlock()
+
+		return true, effect(ctx)
+	}
+
+	if entry.timer == nil {
+		logger.Info("Record is flapping, coalescing its changes", "damper", d.name, "key", k,
+			"changes", len(entry.changes), "window", d.config.Window)
+
+		entry.timer = time.AfterFunc(d.config.QuietPeriod, func() { d.settle(k) })
+	} else {
+		entry.timer.Reset(d.config.QuietPeriod)
+	}
+
+	entry.pendingState = state
+	entry.pendingEffect = effect
+	entry.pendingCtx = context.WithoutCancel(ctx)
+	d.mu.Unlock()
+
+	return false, nil
+}
+
+// settle applies the effect of the final state of a key that stopped flapping.
+func (d *Damper) settle(k string) {
+	d.mu.Lock()
+
+	entry, ok := d.keys[k]
+	if d.stopped || !ok || entry.timer == nil {
+		d.mu.Unlock()
+
+		return
+	}
+
+	// Changes since the timer fired reset it, so the key may still be flapping
+	if quiet := time.Since(entry.changes[len(entry.changes)-1]); quiet < d.config.QuietPeriod {
+		entry.timer.Reset(d.config.QuietPeriod - quiet)
+		d.mu.Unlock()
+
+		return
+	}
+
+	state, effect, ctx := entry.pendingState, entry.pendingEffect, entry.pendingCtx
+	skip := state == entry.applied
+
+	entry.applied = state
+	entry.changes = nil
+	entry.pendingEffect = nil
+	entry.pendingCtx = nil
+	entry.timer = nil
+	d.mu.Unlock()
+
+	logger.Info("Record stopped flapping", "damper", d.name, "key", k, "state", state, "unchanged", skip)
+
+	if skip {
+		return
+	}
+
+	if err := effect(ctx); err != nil {
+		logger.Warn("Failed to apply the final state of a flapping record", "damper", d.name, "key", k, "state", state, "error", err)
+	}
+}
+
+// Flapping reports whether the effects of a key are currently held back.
+func (d *Damper) Flapping(k string) bool {
+	if d == nil {
+		return false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entry, ok := d.keys[k]
+
+	return ok && entry.timer != nil
+}
+
+// Stop discards the held back effects. The effects of changes recorded afterwards are applied immediately.
+func (d *Damper) Stop() {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.stopped = true
+
+	for _, entry := range d.keys {
+		if entry.timer != nil {
+			entry.timer.Stop()
+		}
+	}
+
+	clear(d.keys)
+}
+
+// sweep forgets the keys that did not change within the window, at most once per window.
+// Must be called with the lock held.
+func (d *Damper) sweep(now time.Time) {
+	if now.Sub(d.lastSweep) < d.config.Window {
+		return
+	}
+
+	d.lastSweep = now
+	since := now.Add(-d.config.Window)
+
+	for k, entry := range d.keys {
+		if entry.timer == nil && len(recent(entry.changes, since)) == 0 {
+			delete(d.keys, k)
+		}
+	}
+}
+
+// recent returns the changes after since.
+func recent(changes []time.Time, since time.Time) []time.Time {
+	for i, change := range changes {
+		if change.After(since) {
+			return changes[i:]
+		}
+	}
+
+	return changes[:0]
+}
@@ -0,0 +1,196 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package churn
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/agntcy/dir/server/churn/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder records the applied effects.
+type recorder struct {
+	mu      sync.Mutex
+	applied []string
+}
+
+func (r *recorder) effect(state string) Effect {
+	return func(context.Context) error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.applied = append(r.applied, state)
+
+		return nil
+	}
+}
+
+// change records a change and returns whether its effect was applied immediately.
+func change(t *testing.T, d *Damper, k, state string, effect Effect) bool {
+	t.Helper()
+
+	applied, err := d.Change(t.Context(), k, state, effect)
+	assert.NoError(t, err)
+
+	return applied
+}
+
+func (r *recorder) states() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.applied...)
+}
+
+func testConfig() config.Config {
+	return config.Config{
+		Enabled:     true,
+		Threshold:   2,
+		Window:      time.Minute,
+		QuietPeriod: 50 * time.Millisecond,
+	}
+}
+
+func TestChange(t *testing.T) {
+	t.Run("applies changes below the threshold", func(t *testing.T) {
+		d := New(testConfig(), "test")
+		defer d.Stop()
+
+		r := &recorder{}
+
+		assert.True(t, change(t, d, "cid", "published", r.effect("published")))
+		assert.True(t, change(t, d, "cid", "unpublished", r.effect("unpublished")))
+		assert.True(t, change(t, d, "other", "published", r.effect("other")))
+
+		assert.Equal(t, []string{"published", "unpublished", "other"}, r.states())
+		assert.False(t, d.Flapping("cid"))
+	})
+
+	t.Run("coalesces changes of flapping keys into their final state", func(t *testing.T) {
+		d := New(testConfig(), "test")
+		defer d.Stop()
+
+		r := &recorder{}
+
+		change(t, d, "cid", "published", r.effect("published"))
+		change(t, d, "cid", "unpublished", r.effect("unpublished"))
+		assert.False(t, change(t, d, "cid", "published", r.effect("published")))
+		assert.False(t, change(t, d, "cid", "unpublished", r.effect("unpublished")))
+		assert.False(t, change(t, d, "cid", "published", r.effect("published")))
+		assert.True(t, d.Flapping("cid"))
+
+		assert.Eventually(t, func() bool { return !d.Flapping("cid") }, time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{"published", "unpublished", "published"}, r.states())
+
+		// Keys are damped again only once they exceed the threshold again
+		assert.True(t, change(t, d, "cid", "unpublished", r.effect("unpublished")))
+	})
+
+	t.Run("skips final states whose effect was applied last", func(t *testing.T) {
+		d := New(testConfig(), "test")
+		defer d.Stop()
+
+		r := &recorder{}
+
+		change(t, d, "cid", "published", r.effect("published"))
+		change(t, d, "cid", "unpublished", r.effect("unpublished"))
+		change(t, d, "cid", "published", r.effect("published"))
+		change(t, d, "cid", "unpublished", r.effect("unpublished"))
+
+		assert.Eventually(t, func() bool { return !d.Flapping("cid") }, time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{"published", "unpublished"}, r.states())
+	})
+
+	t.Run("holds back effects until the key is quiet", func(t *testing.T) {
+		d := New(testConfig(), "test")
+		defer d.Stop()
+
+		r := &recorder{}
+
+		for range 10 {
+			change(t, d, "cid", "pushed", r.effect("pushed"))
+			change(t, d, "cid", "deleted", r.effect("deleted"))
+			time.Sleep(20 * time.Millisecond)
+		}
+
+		assert.True(t, d.Flapping("cid"))
+		assert.Equal(t, []string{"pushed", "deleted"}, r.states())
+
+		assert.Eventually(t, func() bool { return !d.Flapping("cid") }, time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{"pushed", "deleted"}, r.states())
+	})
+
+	t.Run("discards held back effects when stopped", func(t *testing.T) {
+		d := New(testConfig(), "test")
+		r := &recorder{}
+
+		change(t, d, "cid", "published", r.effect("published"))
+		change(t, d, "cid", "unpublished", r.effect("unpublished"))
+		change(t, d, "cid", "published", r.effect("published"))
+		d.Stop()
+
+		time.Sleep(100 * time.Millisecond)
+		assert.Equal(t, []string{"published", "unpublished"}, r.states())
+
+		// Effects are no longer damped once stopped
+		assert.True(t, change(t, d, "cid", "unpublished", r.effect("unpublished")))
+	})
+
+	t.Run("returns the errors of immediate effects", func(t *testing.T) {
+		d := New(testConfig(), "test")
+		defer d.Stop()
+
+		applied, err := d.Change(t.Context(), "cid", "published", func(context.Context) error {
+			return errors.New("announcement failed")
+		})
+		assert.True(t, applied)
+		assert.EqualError(t, err, "announcement failed")
+	})
+
+	t.Run("detaches held back effects from the cancellation of their change", func(t *testing.T) {
+		d := New(testConfig(), "test")
+		defer d.Stop()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		done := make(chan error, 1)
+
+		change(t, d, "cid", "published", func(context.Context) error { return nil })
+		change(t, d, "cid", "unpublished", func(context.Context) error { return nil })
+		change(t, d, "cid", "published", func(context.Context) error { return nil })
+
+		_, err := d.Change(ctx, "cid", "pushed", func(ctx context.Context) error {
+			done <- ctx.Err()
+
+			return nil
+		})
+		assert.NoError(t, err)
+		cancel()
+
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("held back effect was not applied")
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		d := New(config.Config{}, "test")
+		assert.Nil(t, d)
+
+		r := &recorder{}
+
+		for range 5 {
+			assert.True(t, change(t, d, "cid", "published", r.effect("published")))
+		}
+
+		assert.Len(t, r.states(), 5)
+		d.Stop()
+	})
+}
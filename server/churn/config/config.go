@@ -0,0 +1,53 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	DefaultEnabled     = true
+	DefaultThreshold   = 5
+	DefaultWindow      = 1 * time.Minute
+	DefaultQuietPeriod = 30 * time.Second
+)
+
+// Config is the configuration of the churn protection of records.
+// Records repeatedly pushed and deleted, or published and unpublished, are flapping:
+// their network announcements and events are held back and coalesced into their
+// final state once they stop changing, instead of being sent for every change.
+type Config struct {
+	// Enabled turns on churn protection.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// Threshold is the number of changes of a record within the window
+	// after which further changes are coalesced.
+	Threshold int `json:"threshold,omitempty" mapstructure:"threshold"`
+
+	// Window is the period over which the changes of a record are counted.
+	Window time.Duration `json:"window,omitempty" mapstructure:"window"`
+
+	// QuietPeriod is the period without changes after which the final state
+	// of a flapping record is announced.
+	QuietPeriod time.Duration `json:"quiet_period,omitempty" mapstructure:"quiet_period"`
+}
+
+// Validate checks the churn protection configuration.
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Threshold <= 0 {
+		return errors.New("churn threshold must be positive")
+	}
+
+	if c.Window <= 0 || c.QuietPeriod <= 0 {
+		return errors.New("churn window and quiet period must be positive")
+	}
+
+	return nil
+}
@@ -11,16 +11,33 @@ import (
 
 	authn "github.com/agntcy/dir/server/authn/config"
 	authz "github.com/agntcy/dir/server/authz/config"
+	churn "github.com/agntcy/dir/server/churn/config"
+	consistency "github.com/agntcy/dir/server/consistency/config"
 	database "github.com/agntcy/dir/server/database/config"
+	postgresconfig "github.com/agntcy/dir/server/database/postgres/config"
 	sqliteconfig "github.com/agntcy/dir/server/database/sqlite/config"
 	events "github.com/agntcy/dir/server/events/config"
+	listener "github.com/agntcy/dir/server/listener/config"
+	metrics "github.com/agntcy/dir/server/metrics/config"
+	priorityconfig "github.com/agntcy/dir/server/middleware/priority/config"
 	ratelimitconfig "github.com/agntcy/dir/server/middleware/ratelimit/config"
+	plugins "github.com/agntcy/dir/server/plugins/config"
+	proxy "github.com/agntcy/dir/server/proxy/config"
 	publication "github.com/agntcy/dir/server/publication/config"
 	routing "github.com/agntcy/dir/server/routing/config"
+	scanning "github.com/agntcy/dir/server/scanning/config"
+	storearchive "github.com/agntcy/dir/server/store/archive/config"
+	storecache "github.com/agntcy/dir/server/store/cache/config"
 	store "github.com/agntcy/dir/server/store/config"
 	oci "github.com/agntcy/dir/server/store/oci/config"
+	storeprobe "github.com/agntcy/dir/server/store/probe/config"
+	stores3 "github.com/agntcy/dir/server/store/s3/config"
 	sync "github.com/agntcy/dir/server/sync/config"
 	syncmonitor "github.com/agntcy/dir/server/sync/monitor/config"
+	ui "github.com/agntcy/dir/server/ui/config"
+	validation "github.com/agntcy/dir/server/validation/config"
+	watchdog "github.com/agntcy/dir/server/watchdog/config"
+	webhooks "github.com/agntcy/dir/server/webhooks/config"
 	"github.com/agntcy/dir/utils/logging"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
@@ -103,6 +120,13 @@ type Config struct {
 	// API configuration
 	ListenAddress string `json:"listen_address,omitempty" mapstructure:"listen_address"`
 
+	// Listener configuration for socket activation and listen address handoff
+	Listener listener.Config `json:"listener,omitempty" mapstructure:"listener"`
+
+	// Region of the server, e.g. eu-west-1.
+	// Used as default region preference when resolving record locators and ranking search results.
+	Region string `json:"region,omitempty" mapstructure:"region"`
+
 	// Logging configuration
 	Logging LoggingConfig `json:"logging,omitempty" mapstructure:"logging"`
 
@@ -112,6 +136,9 @@ type Config struct {
 	// Rate limiting configuration
 	RateLimit ratelimitconfig.Config `json:"ratelimit,omitempty" mapstructure:"ratelimit"`
 
+	// Request priority and load shedding configuration
+	Priority priorityconfig.Config `json:"priority,omitempty" mapstructure:"priority"`
+
 	// Authn configuration (JWT or X.509 authentication)
 	Authn authn.Config `json:"authn,omitempty" mapstructure:"authn"`
 
@@ -133,8 +160,38 @@ type Config struct {
 	// Publication configuration
 	Publication publication.Config `json:"publication,omitempty" mapstructure:"publication"`
 
+	// Sync and publication worker watchdog configuration
+	Watchdog watchdog.Config `json:"watchdog,omitempty" mapstructure:"watchdog"`
+
+	// Churn protection configuration of flapping records
+	Churn churn.Config `json:"churn,omitempty" mapstructure:"churn"`
+
 	// Events configuration
 	Events events.Config `json:"events,omitempty" mapstructure:"events"`
+
+	// Stored record validation configuration
+	Validation validation.Config `json:"validation,omitempty" mapstructure:"validation"`
+
+	// Record content scanning configuration
+	Scanning scanning.Config `json:"scanning,omitempty" mapstructure:"scanning"`
+
+	// Consistency check configuration
+	Consistency consistency.Config `json:"consistency,omitempty" mapstructure:"consistency"`
+
+	// Record webhooks configuration
+	Webhooks webhooks.Config `json:"webhooks,omitempty" mapstructure:"webhooks"`
+
+	// Prometheus metrics endpoint configuration
+	Metrics metrics.Config `json:"metrics,omitempty" mapstructure:"metrics"`
+
+	// Embedded web UI configuration
+	UI ui.Config `json:"ui,omitempty" mapstructure:"ui"`
+
+	// Server plugins configuration
+	Plugins plugins.Config `json:"plugins,omitempty" mapstructure:"plugins"`
+
+	// Pull-through proxy configuration
+	Proxy proxy.Config `json:"proxy,omitempty" mapstructure:"proxy"`
 }
 
 // LoggingConfig defines gRPC request/response logging configuration.
@@ -276,6 +333,18 @@ func LoadConfig() (*Config, error) {
 	_ = v.BindEnv("listen_address")
 	v.SetDefault("listen_address", DefaultListenAddress)
 
+	_ = v.BindEnv("listener.socket_activation")
+	v.SetDefault("listener.socket_activation", listener.DefaultSocketActivation)
+
+	_ = v.BindEnv("listener.reuse_port")
+	v.SetDefault("listener.reuse_port", listener.DefaultReusePort)
+
+	_ = v.BindEnv("listener.drain_timeout")
+	v.SetDefault("listener.drain_timeout", listener.DefaultDrainTimeout)
+
+	_ = v.BindEnv("region")
+	v.SetDefault("region", "")
+
 	//
 	// Logging configuration (gRPC request/response logging)
 	//
@@ -309,6 +378,53 @@ func LoadConfig() (*Config, error) {
 	//       "/agntcy.dir.store.v1.StoreService/CreateRecord":
 	//         rps: 50
 	//         burst: 100
+	//
+	// The same applies to method_costs (per-method token costs).
+	// Example config:
+	//   ratelimit:
+	//     method_costs:
+	//       "/agntcy.dir.store.v1.StoreService/Push": 5
+	//       "/agntcy.dir.store.v1.StoreService/Lookup": 1
+	//
+	// The same applies to tiers and identities (per-identity tier assignments).
+	// Example config:
+	//   ratelimit:
+	//     tiers:
+	//       ci:
+	//         rps: 50
+	//         burst: 100
+	//       sync-peer:
+	//         exempt: true
+	//     identities:
+	//       "spiffe://example.org/ci": ci
+	//       "spiffe://peer.example.org/*": sync-peer
+
+	//
+	// Priority configuration (load shedding)
+	//
+	_ = v.BindEnv("priority.enabled")
+	v.SetDefault("priority.enabled", false)
+
+	_ = v.BindEnv("priority.max_inflight")
+	v.SetDefault("priority.max_inflight", priorityconfig.DefaultMaxInflight)
+
+	_ = v.BindEnv("priority.write_max_inflight")
+	v.SetDefault("priority.write_max_inflight", priorityconfig.DefaultWriteMaxInflight)
+
+	_ = v.BindEnv("priority.background_max_inflight")
+	v.SetDefault("priority.background_max_inflight", priorityconfig.DefaultBackgroundMaxInflight)
+
+	_ = v.BindEnv("priority.max_queue_depth")
+	v.SetDefault("priority.max_queue_depth", priorityconfig.DefaultMaxQueueDepth)
+
+	_ = v.BindEnv("priority.queue_timeout")
+	v.SetDefault("priority.queue_timeout", priorityconfig.DefaultQueueTimeout)
+
+	_ = v.BindEnv("priority.retry_after")
+	v.SetDefault("priority.retry_after", priorityconfig.DefaultRetryAfter)
+
+	// Note: method_classes (per-method priority class overrides) can only be
+	// configured via YAML/JSON config file.
 
 	//
 	// Authn configuration (authentication: JWT or X.509)
@@ -325,6 +441,21 @@ func LoadConfig() (*Config, error) {
 	_ = v.BindEnv("authn.audiences")
 	v.SetDefault("authn.audiences", "")
 
+	_ = v.BindEnv("authn.admins")
+	v.SetDefault("authn.admins", "")
+
+	_ = v.BindEnv("authn.tokens.secret")
+	v.SetDefault("authn.tokens.secret", "")
+
+	_ = v.BindEnv("authn.tokens.ttl")
+	v.SetDefault("authn.tokens.ttl", authn.DefaultTokensTTL)
+
+	_ = v.BindEnv("authn.tokens.max_ttl")
+	v.SetDefault("authn.tokens.max_ttl", authn.DefaultTokensMaxTTL)
+
+	_ = v.BindEnv("authn.tokens.admins")
+	v.SetDefault("authn.tokens.admins", "")
+
 	//
 	// Authz configuration (authorization policies)
 	//
@@ -334,6 +465,9 @@ func LoadConfig() (*Config, error) {
 	_ = v.BindEnv("authz.trust_domain")
 	v.SetDefault("authz.trust_domain", "")
 
+	// Event subscription policies are a list of structured entries, so they can
+	// only be set in the YAML config file.
+
 	//
 	// Store configuration
 	//
@@ -352,6 +486,12 @@ func LoadConfig() (*Config, error) {
 	_ = v.BindEnv("store.oci.repository_name")
 	v.SetDefault("store.oci.repository_name", oci.DefaultRepositoryName)
 
+	_ = v.BindEnv("store.oci.referrers_mode")
+	v.SetDefault("store.oci.referrers_mode", oci.DefaultReferrersMode)
+
+	_ = v.BindEnv("store.oci.compression")
+	v.SetDefault("store.oci.compression", oci.DefaultCompression)
+
 	_ = v.BindEnv("store.oci.auth_config.insecure")
 	v.SetDefault("store.oci.auth_config.insecure", oci.DefaultAuthConfigInsecure)
 
@@ -360,6 +500,61 @@ func LoadConfig() (*Config, error) {
 	_ = v.BindEnv("store.oci.auth_config.access_token")
 	_ = v.BindEnv("store.oci.auth_config.refresh_token")
 
+	_ = v.BindEnv("store.s3.endpoint")
+	v.SetDefault("store.s3.endpoint", stores3.DefaultEndpoint)
+
+	_ = v.BindEnv("store.s3.region")
+
+	_ = v.BindEnv("store.s3.bucket")
+	v.SetDefault("store.s3.bucket", stores3.DefaultBucket)
+
+	_ = v.BindEnv("store.s3.prefix")
+	v.SetDefault("store.s3.prefix", stores3.DefaultPrefix)
+
+	_ = v.BindEnv("store.s3.access_key_id")
+	_ = v.BindEnv("store.s3.secret_access_key")
+	_ = v.BindEnv("store.s3.session_token")
+
+	_ = v.BindEnv("store.s3.tls.insecure")
+	_ = v.BindEnv("store.s3.tls.ca_file")
+	_ = v.BindEnv("store.s3.tls.skip_verify")
+
+	_ = v.BindEnv("store.cache.enabled")
+	v.SetDefault("store.cache.enabled", storecache.DefaultEnabled)
+
+	_ = v.BindEnv("store.cache.dir")
+	v.SetDefault("store.cache.dir", "")
+
+	_ = v.BindEnv("store.cache.max_size")
+	v.SetDefault("store.cache.max_size", storecache.DefaultMaxSize)
+
+	_ = v.BindEnv("store.cache.warm.enabled")
+	v.SetDefault("store.cache.warm.enabled", storecache.DefaultWarmEnabled)
+
+	_ = v.BindEnv("store.cache.warm.top_n")
+	v.SetDefault("store.cache.warm.top_n", storecache.DefaultWarmTopN)
+
+	_ = v.BindEnv("store.cache.warm.interval")
+	v.SetDefault("store.cache.warm.interval", storecache.DefaultWarmInterval)
+
+	_ = v.BindEnv("store.archive.enabled")
+	v.SetDefault("store.archive.enabled", storearchive.DefaultEnabled)
+
+	_ = v.BindEnv("store.archive.dir")
+	v.SetDefault("store.archive.dir", "")
+
+	_ = v.BindEnv("store.archive.after")
+	v.SetDefault("store.archive.after", storearchive.DefaultAfter)
+
+	_ = v.BindEnv("store.archive.scan_interval")
+	v.SetDefault("store.archive.scan_interval", storearchive.DefaultScanInterval)
+
+	_ = v.BindEnv("store.probe.enabled")
+	v.SetDefault("store.probe.enabled", storeprobe.DefaultEnabled)
+
+	_ = v.BindEnv("store.probe.timeout")
+	v.SetDefault("store.probe.timeout", storeprobe.DefaultTimeout)
+
 	//
 	// Routing configuration
 	//
@@ -386,15 +581,75 @@ func LoadConfig() (*Config, error) {
 	_ = v.BindEnv("routing.gossipsub.enabled")
 	v.SetDefault("routing.gossipsub.enabled", routing.DefaultGossipSubEnabled)
 
+	_ = v.BindEnv("routing.read_only")
+	v.SetDefault("routing.read_only", false)
+
+	_ = v.BindEnv("routing.mode")
+	v.SetDefault("routing.mode", routing.DefaultMode)
+
+	_ = v.BindEnv("routing.federation.peers")
+	v.SetDefault("routing.federation.peers", "")
+
+	_ = v.BindEnv("routing.federation.health_check_interval")
+	v.SetDefault("routing.federation.health_check_interval", routing.DefaultFederationHealthCheckInterval)
+
+	_ = v.BindEnv("routing.federation.request_timeout")
+	v.SetDefault("routing.federation.request_timeout", routing.DefaultFederationRequestTimeout)
+
+	_ = v.BindEnv("routing.popularity.enabled")
+	v.SetDefault("routing.popularity.enabled", routing.DefaultPopularityEnabled)
+
 	//
 	// Database configuration
 	//
 	_ = v.BindEnv("database.db_type")
 	v.SetDefault("database.db_type", database.DefaultDBType)
 
+	_ = v.BindEnv("database.indexed_annotations")
+	v.SetDefault("database.indexed_annotations", "")
+
+	_ = v.BindEnv("database.locators.lowercase_scheme")
+	v.SetDefault("database.locators.lowercase_scheme", database.DefaultLocatorsLowercaseScheme)
+
+	_ = v.BindEnv("database.locators.trim_trailing_slash")
+	v.SetDefault("database.locators.trim_trailing_slash", database.DefaultLocatorsTrimTrailingSlash)
+
+	_ = v.BindEnv("database.locators.pin_digests")
+	v.SetDefault("database.locators.pin_digests", database.DefaultLocatorsPinDigests)
+
+	_ = v.BindEnv("database.locators.deduplicate")
+	v.SetDefault("database.locators.deduplicate", database.DefaultLocatorsDeduplicate)
+
+	_ = v.BindEnv("database.replicas.read_dsns")
+	v.SetDefault("database.replicas.read_dsns", "")
+
+	_ = v.BindEnv("database.replicas.health_check_interval")
+	v.SetDefault("database.replicas.health_check_interval", database.DefaultReplicaHealthCheckInterval)
+
 	_ = v.BindEnv("database.sqlite.db_path")
 	v.SetDefault("database.sqlite.db_path", sqliteconfig.DefaultSQLiteDBPath)
 
+	_ = v.BindEnv("database.sqlite.key")
+	v.SetDefault("database.sqlite.key", "")
+
+	_ = v.BindEnv("database.sqlite.key_file")
+	v.SetDefault("database.sqlite.key_file", "")
+
+	_ = v.BindEnv("database.postgres.dsn")
+	v.SetDefault("database.postgres.dsn", postgresconfig.DefaultPostgresDSN)
+
+	_ = v.BindEnv("database.postgres.max_open_conns")
+	v.SetDefault("database.postgres.max_open_conns", postgresconfig.DefaultPostgresMaxOpenConns)
+
+	_ = v.BindEnv("database.postgres.max_idle_conns")
+	v.SetDefault("database.postgres.max_idle_conns", postgresconfig.DefaultPostgresMaxIdleConns)
+
+	_ = v.BindEnv("database.postgres.conn_max_lifetime")
+	v.SetDefault("database.postgres.conn_max_lifetime", postgresconfig.DefaultPostgresConnMaxLifetime)
+
+	_ = v.BindEnv("database.postgres.conn_max_idle_time")
+	v.SetDefault("database.postgres.conn_max_idle_time", postgresconfig.DefaultPostgresConnMaxIdleTime)
+
 	//
 	// Sync configuration
 	//
@@ -408,12 +663,27 @@ func LoadConfig() (*Config, error) {
 	_ = v.BindEnv("sync.worker_timeout")
 	v.SetDefault("sync.worker_timeout", sync.DefaultSyncWorkerTimeout)
 
+	_ = v.BindEnv("sync.worker_parallelism")
+	v.SetDefault("sync.worker_parallelism", sync.DefaultSyncWorkerParallelism)
+
 	_ = v.BindEnv("sync.registry_monitor.check_interval")
 	v.SetDefault("sync.registry_monitor.check_interval", syncmonitor.DefaultCheckInterval)
 
 	_ = v.BindEnv("sync.auth_config.username")
 	_ = v.BindEnv("sync.auth_config.password")
 
+	_ = v.BindEnv("sync.invitations.secret")
+	_ = v.BindEnv("sync.invitations.directory_url")
+	_ = v.BindEnv("sync.invitations.ttl")
+	v.SetDefault("sync.invitations.ttl", sync.DefaultSyncInvitationTTL)
+
+	_ = v.BindEnv("sync.reconciliation.enabled")
+	_ = v.BindEnv("sync.reconciliation.interval")
+	v.SetDefault("sync.reconciliation.interval", sync.DefaultSyncReconciliationInterval)
+
+	_ = v.BindEnv("sync.reconciliation.item_threshold")
+	v.SetDefault("sync.reconciliation.item_threshold", sync.DefaultSyncReconciliationItemThreshold)
+
 	//
 	// Publication configuration
 	//
@@ -427,6 +697,204 @@ func LoadConfig() (*Config, error) {
 	_ = v.BindEnv("publication.worker_timeout")
 	v.SetDefault("publication.worker_timeout", publication.DefaultPublicationWorkerTimeout)
 
+	_ = v.BindEnv("publication.announce_spread_window")
+
+	_ = v.BindEnv("publication.settle_delay")
+
+	//
+	// Worker watchdog configuration
+	//
+
+	_ = v.BindEnv("watchdog.enabled")
+	v.SetDefault("watchdog.enabled", watchdog.DefaultEnabled)
+
+	_ = v.BindEnv("watchdog.check_interval")
+	v.SetDefault("watchdog.check_interval", watchdog.DefaultCheckInterval)
+
+	_ = v.BindEnv("watchdog.max_retries")
+	v.SetDefault("watchdog.max_retries", watchdog.DefaultMaxRetries)
+
+	_ = v.BindEnv("watchdog.retry_backoff")
+	v.SetDefault("watchdog.retry_backoff", watchdog.DefaultRetryBackoff)
+
+	//
+	// Churn protection configuration
+	//
+
+	_ = v.BindEnv("churn.enabled")
+	v.SetDefault("churn.enabled", churn.DefaultEnabled)
+
+	_ = v.BindEnv("churn.threshold")
+	v.SetDefault("churn.threshold", churn.DefaultThreshold)
+
+	_ = v.BindEnv("churn.window")
+	v.SetDefault("churn.window", churn.DefaultWindow)
+
+	_ = v.BindEnv("churn.quiet_period")
+	v.SetDefault("churn.quiet_period", churn.DefaultQuietPeriod)
+
+	// Note: signature_policies can only be configured via YAML/JSON config file
+	// due to its nested list structure.
+	// Example config:
+	//   publication:
+	//     signature_policies:
+	//       - name: release
+	//         threshold: 2
+	//         signers:
+	//           - name: security-team
+	//             public_key: <PEM-encoded public key>
+	//           - name: platform-team
+	//             public_key: <PEM-encoded public key>
+
+	// Note: auto_publish can only be configured via YAML/JSON config file
+	// due to its nested list structure.
+	// Example config:
+	//   publication:
+	//     auto_publish:
+	//       - name: nlp-team
+	//         namespaces: ["example.org"]
+	//         labels: ["/skills/natural_language_processing"]
+	//         signed_only: true
+
+	//
+	// Pull-through proxy configuration
+	//
+
+	_ = v.BindEnv("proxy.enabled")
+	v.SetDefault("proxy.enabled", proxy.DefaultEnabled)
+
+	_ = v.BindEnv("proxy.upstreams")
+	v.SetDefault("proxy.upstreams", "")
+
+	_ = v.BindEnv("proxy.request_timeout")
+	v.SetDefault("proxy.request_timeout", proxy.DefaultRequestTimeout)
+
+	_ = v.BindEnv("proxy.index_only")
+	v.SetDefault("proxy.index_only", proxy.DefaultIndexOnly)
+
+	_ = v.BindEnv("proxy.dir")
+	v.SetDefault("proxy.dir", "")
+
+	//
+	// Validation configuration
+	//
+
+	_ = v.BindEnv("validation.enabled")
+	v.SetDefault("validation.enabled", validation.DefaultValidationEnabled)
+
+	_ = v.BindEnv("validation.interval")
+	v.SetDefault("validation.interval", validation.DefaultValidationInterval)
+
+	_ = v.BindEnv("validation.schema_versions.min")
+	v.SetDefault("validation.schema_versions.min", "")
+
+	_ = v.BindEnv("validation.schema_versions.max")
+	v.SetDefault("validation.schema_versions.max", "")
+
+	_ = v.BindEnv("validation.schema_versions.deny")
+	v.SetDefault("validation.schema_versions.deny", "")
+
+	_ = v.BindEnv("validation.licenses.allowed")
+	v.SetDefault("validation.licenses.allowed", "")
+
+	// Authorship identities are a list of structured entries,
+	// so they can only be set in the YAML config file.
+	_ = v.BindEnv("validation.authorship.mode")
+	v.SetDefault("validation.authorship.mode", "")
+
+	_ = v.BindEnv("validation.authorship.max_clock_skew")
+	v.SetDefault("validation.authorship.max_clock_skew", 0)
+
+	_ = v.BindEnv("validation.taxonomy.mode")
+	v.SetDefault("validation.taxonomy.mode", "")
+
+	//
+	// Scanning configuration
+	//
+	// Secret patterns are a list of structured entries, so they can only be
+	// set in the YAML config file.
+	//
+
+	_ = v.BindEnv("scanning.enabled")
+	v.SetDefault("scanning.enabled", scanning.DefaultScanningEnabled)
+
+	_ = v.BindEnv("scanning.action")
+	v.SetDefault("scanning.action", scanning.DefaultScanningAction)
+
+	_ = v.BindEnv("scanning.builtin_patterns")
+	v.SetDefault("scanning.builtin_patterns", scanning.DefaultScanningBuiltinPatterns)
+
+	_ = v.BindEnv("scanning.webhook.url")
+	v.SetDefault("scanning.webhook.url", "")
+
+	_ = v.BindEnv("scanning.webhook.timeout")
+	v.SetDefault("scanning.webhook.timeout", scanning.DefaultScanningWebhookTimeout)
+
+	_ = v.BindEnv("scanning.webhook.secret")
+	v.SetDefault("scanning.webhook.secret", "")
+
+	//
+	// Consistency configuration
+	//
+
+	_ = v.BindEnv("consistency.enabled")
+	v.SetDefault("consistency.enabled", consistency.DefaultConsistencyEnabled)
+
+	_ = v.BindEnv("consistency.interval")
+	v.SetDefault("consistency.interval", consistency.DefaultConsistencyInterval)
+
+	_ = v.BindEnv("consistency.repair")
+	v.SetDefault("consistency.repair", consistency.DefaultConsistencyRepair)
+
+	//
+	// Record webhooks configuration
+	//
+
+	_ = v.BindEnv("webhooks.enabled")
+	v.SetDefault("webhooks.enabled", webhooks.DefaultWebhooksEnabled)
+
+	_ = v.BindEnv("webhooks.timeout")
+	v.SetDefault("webhooks.timeout", webhooks.DefaultWebhooksTimeout)
+
+	_ = v.BindEnv("webhooks.max_per_record")
+	v.SetDefault("webhooks.max_per_record", webhooks.DefaultWebhooksMaxPerRecord)
+
+	_ = v.BindEnv("webhooks.workers")
+	v.SetDefault("webhooks.workers", webhooks.DefaultWebhooksWorkers)
+
+	//
+	// Metrics configuration
+	//
+
+	_ = v.BindEnv("metrics.enabled")
+	v.SetDefault("metrics.enabled", metrics.DefaultEnabled)
+
+	_ = v.BindEnv("metrics.listen_address")
+	v.SetDefault("metrics.listen_address", metrics.DefaultListenAddress)
+
+	//
+	// Web UI configuration
+	//
+
+	_ = v.BindEnv("ui.enabled")
+	v.SetDefault("ui.enabled", ui.DefaultEnabled)
+
+	_ = v.BindEnv("ui.listen_address")
+	v.SetDefault("ui.listen_address", ui.DefaultListenAddress)
+
+	//
+	// Plugins configuration
+	//
+	// Design Decision: No environment variables for plugins.
+	// Plugin manifests are a list of structured entries, so they can only be
+	// set in the YAML config file:
+	//   plugins:
+	//     manifests:
+	//       - name: audit
+	//         path: /plugins/audit.so
+	//         config:
+	//           endpoint: https://audit.example.com
+
 	//
 	// Events configuration
 	//
@@ -440,6 +908,23 @@ func LoadConfig() (*Config, error) {
 	_ = v.BindEnv("events.log_published_events")
 	v.SetDefault("events.log_published_events", events.DefaultLogPublishedEvents)
 
+	_ = v.BindEnv("events.replay_buffer_size")
+	v.SetDefault("events.replay_buffer_size", events.DefaultReplayBufferSize)
+
+	_ = v.BindEnv("events.retention.default")
+	v.SetDefault("events.retention.default", 0)
+
+	_ = v.BindEnv("events.retention.cleanup_interval")
+	v.SetDefault("events.retention.cleanup_interval", events.DefaultRetentionCleanupInterval)
+
+	// Retention windows per event type are a map, so they can only be set
+	// in the YAML config file:
+	//   events:
+	//     retention:
+	//       types:
+	//         RECORD_DELETED: 2160h
+	//         RECORD_PULLED: 24h
+
 	//
 	// Connection management configuration
 	//
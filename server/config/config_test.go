@@ -10,15 +10,32 @@ import (
 
 	authn "github.com/agntcy/dir/server/authn/config"
 	authz "github.com/agntcy/dir/server/authz/config"
+	churn "github.com/agntcy/dir/server/churn/config"
+	consistency "github.com/agntcy/dir/server/consistency/config"
 	database "github.com/agntcy/dir/server/database/config"
+	postgresconfig "github.com/agntcy/dir/server/database/postgres/config"
 	sqliteconfig "github.com/agntcy/dir/server/database/sqlite/config"
+	events "github.com/agntcy/dir/server/events/config"
+	listener "github.com/agntcy/dir/server/listener/config"
+	metrics "github.com/agntcy/dir/server/metrics/config"
+	priorityconfig "github.com/agntcy/dir/server/middleware/priority/config"
 	ratelimitconfig "github.com/agntcy/dir/server/middleware/ratelimit/config"
+	proxy "github.com/agntcy/dir/server/proxy/config"
 	publication "github.com/agntcy/dir/server/publication/config"
 	routing "github.com/agntcy/dir/server/routing/config"
+	scanning "github.com/agntcy/dir/server/scanning/config"
+	storearchive "github.com/agntcy/dir/server/store/archive/config"
+	storecache "github.com/agntcy/dir/server/store/cache/config"
 	store "github.com/agntcy/dir/server/store/config"
 	oci "github.com/agntcy/dir/server/store/oci/config"
+	storeprobe "github.com/agntcy/dir/server/store/probe/config"
+	stores3 "github.com/agntcy/dir/server/store/s3/config"
 	sync "github.com/agntcy/dir/server/sync/config"
 	monitor "github.com/agntcy/dir/server/sync/monitor/config"
+	ui "github.com/agntcy/dir/server/ui/config"
+	validation "github.com/agntcy/dir/server/validation/config"
+	watchdog "github.com/agntcy/dir/server/watchdog/config"
+	webhooks "github.com/agntcy/dir/server/webhooks/config"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -31,41 +48,163 @@ func TestConfig(t *testing.T) {
 		{
 			Name: "Custom config",
 			EnvVars: map[string]string{
-				"DIRECTORY_SERVER_LISTEN_ADDRESS":                       "example.com:8889",
-				"DIRECTORY_SERVER_STORE_PROVIDER":                       "provider",
-				"DIRECTORY_SERVER_STORE_OCI_LOCAL_DIR":                  "local-dir",
-				"DIRECTORY_SERVER_STORE_OCI_REGISTRY_ADDRESS":           "example.com:5001",
-				"DIRECTORY_SERVER_STORE_OCI_REPOSITORY_NAME":            "test-dir",
-				"DIRECTORY_SERVER_STORE_OCI_AUTH_CONFIG_INSECURE":       "true",
-				"DIRECTORY_SERVER_STORE_OCI_AUTH_CONFIG_USERNAME":       "username",
-				"DIRECTORY_SERVER_STORE_OCI_AUTH_CONFIG_PASSWORD":       "password",
-				"DIRECTORY_SERVER_STORE_OCI_AUTH_CONFIG_ACCESS_TOKEN":   "access-token",
-				"DIRECTORY_SERVER_STORE_OCI_AUTH_CONFIG_REFRESH_TOKEN":  "refresh-token",
-				"DIRECTORY_SERVER_ROUTING_LISTEN_ADDRESS":               "/ip4/1.1.1.1/tcp/1",
-				"DIRECTORY_SERVER_ROUTING_BOOTSTRAP_PEERS":              "/ip4/1.1.1.1/tcp/1,/ip4/1.1.1.1/tcp/2",
-				"DIRECTORY_SERVER_ROUTING_KEY_PATH":                     "/path/to/key",
-				"DIRECTORY_SERVER_DATABASE_DB_TYPE":                     "sqlite",
-				"DIRECTORY_SERVER_DATABASE_SQLITE_DB_PATH":              "sqlite.db",
-				"DIRECTORY_SERVER_SYNC_SCHEDULER_INTERVAL":              "1s",
-				"DIRECTORY_SERVER_SYNC_WORKER_COUNT":                    "1",
-				"DIRECTORY_SERVER_SYNC_REGISTRY_MONITOR_CHECK_INTERVAL": "10s",
-				"DIRECTORY_SERVER_SYNC_WORKER_TIMEOUT":                  "10s",
-				"DIRECTORY_SERVER_SYNC_AUTH_CONFIG_USERNAME":            "sync-user",
-				"DIRECTORY_SERVER_SYNC_AUTH_CONFIG_PASSWORD":            "sync-password",
-				"DIRECTORY_SERVER_AUTHZ_ENABLED":                        "true",
-				"DIRECTORY_SERVER_AUTHZ_SOCKET_PATH":                    "/test/agent.sock",
-				"DIRECTORY_SERVER_AUTHZ_TRUST_DOMAIN":                   "dir.com",
-				"DIRECTORY_SERVER_PUBLICATION_SCHEDULER_INTERVAL":       "10s",
-				"DIRECTORY_SERVER_PUBLICATION_WORKER_COUNT":             "1",
-				"DIRECTORY_SERVER_PUBLICATION_WORKER_TIMEOUT":           "10s",
+				"DIRECTORY_SERVER_LISTEN_ADDRESS":                          "example.com:8889",
+				"DIRECTORY_SERVER_LISTENER_SOCKET_ACTIVATION":              "false",
+				"DIRECTORY_SERVER_LISTENER_REUSE_PORT":                     "true",
+				"DIRECTORY_SERVER_LISTENER_DRAIN_TIMEOUT":                  "30s",
+				"DIRECTORY_SERVER_REGION":                                  "eu-west-1",
+				"DIRECTORY_SERVER_STORE_PROVIDER":                          "provider",
+				"DIRECTORY_SERVER_STORE_OCI_LOCAL_DIR":                     "local-dir",
+				"DIRECTORY_SERVER_STORE_OCI_REGISTRY_ADDRESS":              "example.com:5001",
+				"DIRECTORY_SERVER_STORE_OCI_REPOSITORY_NAME":               "test-dir",
+				"DIRECTORY_SERVER_STORE_OCI_REFERRERS_MODE":                "tag",
+				"DIRECTORY_SERVER_STORE_OCI_COMPRESSION":                   "none",
+				"DIRECTORY_SERVER_STORE_OCI_AUTH_CONFIG_INSECURE":          "true",
+				"DIRECTORY_SERVER_STORE_OCI_AUTH_CONFIG_USERNAME":          "username",
+				"DIRECTORY_SERVER_STORE_OCI_AUTH_CONFIG_PASSWORD":          "password",
+				"DIRECTORY_SERVER_STORE_OCI_AUTH_CONFIG_ACCESS_TOKEN":      "access-token",
+				"DIRECTORY_SERVER_STORE_OCI_AUTH_CONFIG_REFRESH_TOKEN":     "refresh-token",
+				"DIRECTORY_SERVER_STORE_S3_ENDPOINT":                       "minio:9000",
+				"DIRECTORY_SERVER_STORE_S3_BUCKET":                         "test-bucket",
+				"DIRECTORY_SERVER_STORE_S3_ACCESS_KEY_ID":                  "access-key",
+				"DIRECTORY_SERVER_STORE_S3_SECRET_ACCESS_KEY":              "secret-key",
+				"DIRECTORY_SERVER_STORE_S3_TLS_INSECURE":                   "true",
+				"DIRECTORY_SERVER_STORE_CACHE_ENABLED":                     "true",
+				"DIRECTORY_SERVER_STORE_CACHE_DIR":                         "cache-dir",
+				"DIRECTORY_SERVER_STORE_CACHE_MAX_SIZE":                    "1024",
+				"DIRECTORY_SERVER_STORE_CACHE_WARM_ENABLED":                "true",
+				"DIRECTORY_SERVER_STORE_CACHE_WARM_TOP_N":                  "50",
+				"DIRECTORY_SERVER_STORE_CACHE_WARM_INTERVAL":               "15m",
+				"DIRECTORY_SERVER_STORE_ARCHIVE_ENABLED":                   "true",
+				"DIRECTORY_SERVER_STORE_ARCHIVE_DIR":                       "archive-dir",
+				"DIRECTORY_SERVER_STORE_ARCHIVE_AFTER":                     "720h",
+				"DIRECTORY_SERVER_STORE_PROBE_ENABLED":                     "false",
+				"DIRECTORY_SERVER_STORE_PROBE_TIMEOUT":                     "1m",
+				"DIRECTORY_SERVER_STORE_ARCHIVE_SCAN_INTERVAL":             "10m",
+				"DIRECTORY_SERVER_ROUTING_LISTEN_ADDRESS":                  "/ip4/1.1.1.1/tcp/1",
+				"DIRECTORY_SERVER_ROUTING_BOOTSTRAP_PEERS":                 "/ip4/1.1.1.1/tcp/1,/ip4/1.1.1.1/tcp/2",
+				"DIRECTORY_SERVER_ROUTING_KEY_PATH":                        "/path/to/key",
+				"DIRECTORY_SERVER_ROUTING_READ_ONLY":                       "true",
+				"DIRECTORY_SERVER_ROUTING_MODE":                            "federation",
+				"DIRECTORY_SERVER_ROUTING_FEDERATION_PEERS":                "dir1.example.com:8888,dir2.example.com:8888",
+				"DIRECTORY_SERVER_ROUTING_FEDERATION_REQUEST_TIMEOUT":      "5s",
+				"DIRECTORY_SERVER_ROUTING_POPULARITY_ENABLED":              "false",
+				"DIRECTORY_SERVER_DATABASE_DB_TYPE":                        "sqlite",
+				"DIRECTORY_SERVER_DATABASE_SQLITE_DB_PATH":                 "sqlite.db",
+				"DIRECTORY_SERVER_DATABASE_SQLITE_KEY_FILE":                "/etc/dir/db-key",
+				"DIRECTORY_SERVER_DATABASE_POSTGRES_DSN":                   "postgres://dir:secret@db:5432/dir",
+				"DIRECTORY_SERVER_DATABASE_POSTGRES_MAX_OPEN_CONNS":        "50",
+				"DIRECTORY_SERVER_DATABASE_POSTGRES_CONN_MAX_LIFETIME":     "1h",
+				"DIRECTORY_SERVER_DATABASE_INDEXED_ANNOTATIONS":            "team,environment",
+				"DIRECTORY_SERVER_DATABASE_LOCATORS_PIN_DIGESTS":           "false",
+				"DIRECTORY_SERVER_DATABASE_LOCATORS_DEDUPLICATE":           "false",
+				"DIRECTORY_SERVER_DATABASE_REPLICAS_READ_DSNS":             "replica1.db,replica2.db",
+				"DIRECTORY_SERVER_DATABASE_REPLICAS_HEALTH_CHECK_INTERVAL": "5s",
+				"DIRECTORY_SERVER_SYNC_SCHEDULER_INTERVAL":                 "1s",
+				"DIRECTORY_SERVER_SYNC_WORKER_COUNT":                       "1",
+				"DIRECTORY_SERVER_SYNC_REGISTRY_MONITOR_CHECK_INTERVAL":    "10s",
+				"DIRECTORY_SERVER_SYNC_WORKER_TIMEOUT":                     "10s",
+				"DIRECTORY_SERVER_SYNC_WORKER_PARALLELISM":                 "8",
+				"DIRECTORY_SERVER_SYNC_AUTH_CONFIG_USERNAME":               "sync-user",
+				"DIRECTORY_SERVER_SYNC_AUTH_CONFIG_PASSWORD":               "sync-password",
+				"DIRECTORY_SERVER_AUTHN_TOKENS_SECRET":                     "token-secret",
+				"DIRECTORY_SERVER_AUTHN_TOKENS_TTL":                        "15m",
+				"DIRECTORY_SERVER_AUTHN_TOKENS_MAX_TTL":                    "2h",
+				"DIRECTORY_SERVER_AUTHN_ADMINS":                            "spiffe://example.org/ops",
+				"DIRECTORY_SERVER_AUTHN_TOKENS_ADMINS":                     "spiffe://example.org/admin",
+				"DIRECTORY_SERVER_SYNC_INVITATIONS_SECRET":                 "invitation-secret",
+				"DIRECTORY_SERVER_SYNC_INVITATIONS_DIRECTORY_URL":          "dir.example.com:8888",
+				"DIRECTORY_SERVER_SYNC_INVITATIONS_TTL":                    "1h",
+				"DIRECTORY_SERVER_SYNC_RECONCILIATION_ENABLED":             "true",
+				"DIRECTORY_SERVER_SYNC_RECONCILIATION_INTERVAL":            "2m",
+				"DIRECTORY_SERVER_SYNC_RECONCILIATION_ITEM_THRESHOLD":      "32",
+				"DIRECTORY_SERVER_AUTHZ_ENABLED":                           "true",
+				"DIRECTORY_SERVER_AUTHZ_SOCKET_PATH":                       "/test/agent.sock",
+				"DIRECTORY_SERVER_AUTHZ_TRUST_DOMAIN":                      "dir.com",
+				"DIRECTORY_SERVER_PUBLICATION_SCHEDULER_INTERVAL":          "10s",
+				"DIRECTORY_SERVER_PUBLICATION_WORKER_COUNT":                "1",
+				"DIRECTORY_SERVER_PUBLICATION_WORKER_TIMEOUT":              "10s",
+				"DIRECTORY_SERVER_PUBLICATION_ANNOUNCE_SPREAD_WINDOW":      "1h",
+				"DIRECTORY_SERVER_PUBLICATION_SETTLE_DELAY":                "15m",
+				"DIRECTORY_SERVER_WATCHDOG_ENABLED":                        "false",
+				"DIRECTORY_SERVER_WATCHDOG_CHECK_INTERVAL":                 "10s",
+				"DIRECTORY_SERVER_WATCHDOG_MAX_RETRIES":                    "5",
+				"DIRECTORY_SERVER_WATCHDOG_RETRY_BACKOFF":                  "30s",
+				"DIRECTORY_SERVER_CHURN_ENABLED":                           "false",
+				"DIRECTORY_SERVER_CHURN_THRESHOLD":                         "10",
+				"DIRECTORY_SERVER_CHURN_WINDOW":                            "5m",
+				"DIRECTORY_SERVER_CHURN_QUIET_PERIOD":                      "2m",
+				"DIRECTORY_SERVER_VALIDATION_ENABLED":                      "false",
+				"DIRECTORY_SERVER_VALIDATION_INTERVAL":                     "10m",
+				"DIRECTORY_SERVER_VALIDATION_SCHEMA_VERSIONS_MIN":          "0.7.0",
+				"DIRECTORY_SERVER_VALIDATION_SCHEMA_VERSIONS_MAX":          "0.8.0",
+				"DIRECTORY_SERVER_VALIDATION_SCHEMA_VERSIONS_DENY":         "0.7.1,0.7.2",
+				"DIRECTORY_SERVER_VALIDATION_LICENSES_ALLOWED":             "Apache-2.0,MIT",
+				"DIRECTORY_SERVER_VALIDATION_AUTHORSHIP_MODE":              "reject",
+				"DIRECTORY_SERVER_VALIDATION_AUTHORSHIP_MAX_CLOCK_SKEW":    "5m",
+				"DIRECTORY_SERVER_VALIDATION_TAXONOMY_MODE":                "warn",
+				"DIRECTORY_SERVER_SCANNING_ENABLED":                        "true",
+				"DIRECTORY_SERVER_SCANNING_ACTION":                         "warn",
+				"DIRECTORY_SERVER_SCANNING_BUILTIN_PATTERNS":               "false",
+				"DIRECTORY_SERVER_SCANNING_WEBHOOK_URL":                    "https://scanner.example.com/scan",
+				"DIRECTORY_SERVER_SCANNING_WEBHOOK_TIMEOUT":                "30s",
+				"DIRECTORY_SERVER_SCANNING_WEBHOOK_SECRET":                 "scanner-secret",
+				"DIRECTORY_SERVER_CONSISTENCY_ENABLED":                     "true",
+				"DIRECTORY_SERVER_CONSISTENCY_INTERVAL":                    "6h",
+				"DIRECTORY_SERVER_CONSISTENCY_REPAIR":                      "true",
+				"DIRECTORY_SERVER_WEBHOOKS_ENABLED":                        "true",
+				"DIRECTORY_SERVER_WEBHOOKS_TIMEOUT":                        "10s",
+				"DIRECTORY_SERVER_WEBHOOKS_MAX_PER_RECORD":                 "3",
+				"DIRECTORY_SERVER_WEBHOOKS_WORKERS":                        "8",
+				"DIRECTORY_SERVER_METRICS_ENABLED":                         "true",
+				"DIRECTORY_SERVER_METRICS_LISTEN_ADDRESS":                  "0.0.0.0:9191",
+				"DIRECTORY_SERVER_UI_ENABLED":                              "true",
+				"DIRECTORY_SERVER_UI_LISTEN_ADDRESS":                       "0.0.0.0:8181",
+				"DIRECTORY_SERVER_PRIORITY_ENABLED":                        "true",
+				"DIRECTORY_SERVER_PRIORITY_MAX_INFLIGHT":                   "64",
+				"DIRECTORY_SERVER_PRIORITY_WRITE_MAX_INFLIGHT":             "32",
+				"DIRECTORY_SERVER_PRIORITY_BACKGROUND_MAX_INFLIGHT":        "8",
+				"DIRECTORY_SERVER_PRIORITY_MAX_QUEUE_DEPTH":                "16",
+				"DIRECTORY_SERVER_PRIORITY_QUEUE_TIMEOUT":                  "2s",
+				"DIRECTORY_SERVER_PRIORITY_RETRY_AFTER":                    "500ms",
+				"DIRECTORY_SERVER_EVENTS_SUBSCRIBER_BUFFER_SIZE":           "50",
+				"DIRECTORY_SERVER_EVENTS_REPLAY_BUFFER_SIZE":               "200",
+				"DIRECTORY_SERVER_EVENTS_RETENTION_DEFAULT":                "72h",
+				"DIRECTORY_SERVER_EVENTS_RETENTION_CLEANUP_INTERVAL":       "5m",
+				"DIRECTORY_SERVER_PROXY_ENABLED":                           "true",
+				"DIRECTORY_SERVER_PROXY_UPSTREAMS":                         "hub.example.com:8888,mirror.example.com:8888",
+				"DIRECTORY_SERVER_PROXY_REQUEST_TIMEOUT":                   "30s",
+				"DIRECTORY_SERVER_PROXY_INDEX_ONLY":                        "true",
 			},
 			ExpectedConfig: &Config{
 				ListenAddress: "example.com:8889",
-				Connection:    DefaultConnectionConfig(), // Connection defaults applied
+				Listener: listener.Config{
+					SocketActivation: false,
+					ReusePort:        true,
+					DrainTimeout:     30 * time.Second,
+				},
+				Region:     "eu-west-1",
+				Connection: DefaultConnectionConfig(), // Connection defaults applied
+				Priority: priorityconfig.Config{
+					Enabled:               true,
+					MaxInflight:           64,
+					WriteMaxInflight:      32,
+					BackgroundMaxInflight: 8,
+					MaxQueueDepth:         16,
+					QueueTimeout:          2 * time.Second,
+					RetryAfter:            500 * time.Millisecond,
+				},
 				Authn: authn.Config{
 					Enabled:   false,
 					Mode:      authn.AuthModeX509, // Default from config.go:109
 					Audiences: []string{},
+					Admins:    []string{"spiffe://example.org/ops"},
+					Tokens: authn.TokensConfig{
+						Secret: "token-secret",
+						TTL:    15 * time.Minute,
+						MaxTTL: 2 * time.Hour,
+						Admins: []string{"spiffe://example.org/admin"},
+					},
 				},
 				Store: store.Config{
 					Provider: "provider",
@@ -73,6 +212,8 @@ func TestConfig(t *testing.T) {
 						LocalDir:        "local-dir",
 						RegistryAddress: "example.com:5001",
 						RepositoryName:  "test-dir",
+						ReferrersMode:   "tag",
+						Compression:     "none",
 						AuthConfig: oci.AuthConfig{
 							Insecure:     true,
 							Username:     "username",
@@ -81,6 +222,36 @@ func TestConfig(t *testing.T) {
 							AccessToken:  "access-token",
 						},
 					},
+					S3: stores3.Config{
+						Endpoint:        "minio:9000",
+						Bucket:          "test-bucket",
+						Prefix:          stores3.DefaultPrefix,
+						AccessKeyID:     "access-key",
+						SecretAccessKey: "secret-key",
+						TLS: stores3.TLSConfig{
+							Insecure: true,
+						},
+					},
+					Cache: storecache.Config{
+						Enabled: true,
+						Dir:     "cache-dir",
+						MaxSize: 1024,
+						Warm: storecache.WarmConfig{
+							Enabled:  true,
+							TopN:     50,
+							Interval: 15 * time.Minute,
+						},
+					},
+					Archive: storearchive.Config{
+						Enabled:      true,
+						Dir:          "archive-dir",
+						After:        720 * time.Hour,
+						ScanInterval: 10 * time.Minute,
+					},
+					Probe: storeprobe.Config{
+						Enabled: false,
+						Timeout: time.Minute,
+					},
 				},
 				Routing: routing.Config{
 					ListenAddress: "/ip4/1.1.1.1/tcp/1",
@@ -92,17 +263,47 @@ func TestConfig(t *testing.T) {
 					GossipSub: routing.GossipSubConfig{
 						Enabled: true, // Default value
 					},
+					ReadOnly: true,
+					Mode:     routing.ModeFederation,
+					Federation: routing.FederationConfig{
+						Peers:               []string{"dir1.example.com:8888", "dir2.example.com:8888"},
+						HealthCheckInterval: routing.DefaultFederationHealthCheckInterval,
+						RequestTimeout:      5 * time.Second,
+					},
+					Popularity: routing.PopularityConfig{
+						Enabled: false,
+					},
 				},
 				Database: database.Config{
-					DBType: "sqlite",
+					DBType:             "sqlite",
+					IndexedAnnotations: []string{"team", "environment"},
+					Locators: database.LocatorsConfig{
+						LowercaseScheme:   database.DefaultLocatorsLowercaseScheme,
+						TrimTrailingSlash: database.DefaultLocatorsTrimTrailingSlash,
+						PinDigests:        false,
+						Deduplicate:       false,
+					},
+					Replicas: database.ReplicasConfig{
+						ReadDSNs:            []string{"replica1.db", "replica2.db"},
+						HealthCheckInterval: 5 * time.Second,
+					},
 					SQLite: sqliteconfig.Config{
-						DBPath: "sqlite.db",
+						DBPath:  "sqlite.db",
+						KeyFile: "/etc/dir/db-key",
+					},
+					Postgres: postgresconfig.Config{
+						DSN:             "postgres://dir:secret@db:5432/dir",
+						MaxOpenConns:    50,
+						MaxIdleConns:    postgresconfig.DefaultPostgresMaxIdleConns,
+						ConnMaxLifetime: time.Hour,
+						ConnMaxIdleTime: postgresconfig.DefaultPostgresConnMaxIdleTime,
 					},
 				},
 				Sync: sync.Config{
 					SchedulerInterval: 1 * time.Second,
 					WorkerCount:       1,
 					WorkerTimeout:     10 * time.Second,
+					WorkerParallelism: 8,
 					RegistryMonitor: monitor.Config{
 						CheckInterval: 10 * time.Second,
 					},
@@ -110,15 +311,103 @@ func TestConfig(t *testing.T) {
 						Username: "sync-user",
 						Password: "sync-password",
 					},
+					Invitations: sync.InvitationsConfig{
+						Secret:       "invitation-secret",
+						DirectoryURL: "dir.example.com:8888",
+						TTL:          time.Hour,
+					},
+					Reconciliation: sync.ReconciliationConfig{
+						Enabled:       true,
+						Interval:      2 * time.Minute,
+						ItemThreshold: 32,
+					},
 				},
 				Authz: authz.Config{
 					Enabled:     true,
 					TrustDomain: "dir.com",
 				},
 				Publication: publication.Config{
-					SchedulerInterval: 10 * time.Second,
-					WorkerCount:       1,
-					WorkerTimeout:     10 * time.Second,
+					SchedulerInterval:    10 * time.Second,
+					WorkerCount:          1,
+					WorkerTimeout:        10 * time.Second,
+					AnnounceSpreadWindow: time.Hour,
+					SettleDelay:          15 * time.Minute,
+				},
+				Watchdog: watchdog.Config{
+					Enabled:       false,
+					CheckInterval: 10 * time.Second,
+					MaxRetries:    5,
+					RetryBackoff:  30 * time.Second,
+				},
+				Churn: churn.Config{
+					Enabled:     false,
+					Threshold:   10,
+					Window:      5 * time.Minute,
+					QuietPeriod: 2 * time.Minute,
+				},
+				Validation: validation.Config{
+					Enabled:  false,
+					Interval: 10 * time.Minute,
+					SchemaVersions: validation.SchemaVersionsConfig{
+						Min:  "0.7.0",
+						Max:  "0.8.0",
+						Deny: []string{"0.7.1", "0.7.2"},
+					},
+					Licenses: validation.LicensesConfig{
+						Allowed: []string{"Apache-2.0", "MIT"},
+					},
+					Authorship: validation.AuthorshipConfig{
+						Mode:         validation.AuthorshipModeReject,
+						MaxClockSkew: 5 * time.Minute,
+					},
+					Taxonomy: validation.TaxonomyConfig{
+						Mode: validation.TaxonomyModeWarn,
+					},
+				},
+				Scanning: scanning.Config{
+					Enabled:         true,
+					Action:          scanning.ActionWarn,
+					BuiltinPatterns: false,
+					Webhook: scanning.WebhookConfig{
+						URL:     "https://scanner.example.com/scan",
+						Timeout: 30 * time.Second,
+						Secret:  "scanner-secret",
+					},
+				},
+				Consistency: consistency.Config{
+					Enabled:  true,
+					Interval: 6 * time.Hour,
+					Repair:   true,
+				},
+				Webhooks: webhooks.Config{
+					Enabled:      true,
+					Timeout:      10 * time.Second,
+					MaxPerRecord: 3,
+					Workers:      8,
+				},
+				Metrics: metrics.Config{
+					Enabled:       true,
+					ListenAddress: "0.0.0.0:9191",
+				},
+				UI: ui.Config{
+					Enabled:       true,
+					ListenAddress: "0.0.0.0:8181",
+				},
+				Events: events.Config{
+					SubscriberBufferSize: 50,
+					LogSlowConsumers:     events.DefaultLogSlowConsumers,
+					LogPublishedEvents:   events.DefaultLogPublishedEvents,
+					ReplayBufferSize:     200,
+					Retention: events.RetentionConfig{
+						Default:         72 * time.Hour,
+						CleanupInterval: 5 * time.Minute,
+					},
+				},
+				Proxy: proxy.Config{
+					Enabled:        true,
+					Upstreams:      []string{"hub.example.com:8888", "mirror.example.com:8888"},
+					RequestTimeout: 30 * time.Second,
+					IndexOnly:      true,
 				},
 			},
 		},
@@ -127,21 +416,66 @@ func TestConfig(t *testing.T) {
 			EnvVars: map[string]string{},
 			ExpectedConfig: &Config{
 				ListenAddress: DefaultListenAddress,
-				Connection:    DefaultConnectionConfig(), // Connection defaults applied
+				Listener: listener.Config{
+					SocketActivation: listener.DefaultSocketActivation,
+					ReusePort:        listener.DefaultReusePort,
+					DrainTimeout:     listener.DefaultDrainTimeout,
+				},
+				Connection: DefaultConnectionConfig(), // Connection defaults applied
+				Priority: priorityconfig.Config{
+					Enabled:               false,
+					MaxInflight:           priorityconfig.DefaultMaxInflight,
+					WriteMaxInflight:      priorityconfig.DefaultWriteMaxInflight,
+					BackgroundMaxInflight: priorityconfig.DefaultBackgroundMaxInflight,
+					MaxQueueDepth:         priorityconfig.DefaultMaxQueueDepth,
+					QueueTimeout:          priorityconfig.DefaultQueueTimeout,
+					RetryAfter:            priorityconfig.DefaultRetryAfter,
+				},
 				Authn: authn.Config{
 					Enabled:   false,
 					Mode:      authn.AuthModeX509, // Default from config.go:109
 					Audiences: []string{},
+					Admins:    []string{},
+					Tokens: authn.TokensConfig{
+						TTL:    authn.DefaultTokensTTL,
+						MaxTTL: authn.DefaultTokensMaxTTL,
+						Admins: []string{},
+					},
 				},
 				Store: store.Config{
 					Provider: store.DefaultProvider,
 					OCI: oci.Config{
 						RegistryAddress: oci.DefaultRegistryAddress,
 						RepositoryName:  oci.DefaultRepositoryName,
+						ReferrersMode:   oci.DefaultReferrersMode,
+						Compression:     oci.DefaultCompression,
 						AuthConfig: oci.AuthConfig{
 							Insecure: oci.DefaultAuthConfigInsecure,
 						},
 					},
+					S3: stores3.Config{
+						Endpoint: stores3.DefaultEndpoint,
+						Bucket:   stores3.DefaultBucket,
+						Prefix:   stores3.DefaultPrefix,
+					},
+					Cache: storecache.Config{
+						Enabled: storecache.DefaultEnabled,
+						MaxSize: storecache.DefaultMaxSize,
+						Warm: storecache.WarmConfig{
+							Enabled:  storecache.DefaultWarmEnabled,
+							TopN:     storecache.DefaultWarmTopN,
+							Interval: storecache.DefaultWarmInterval,
+						},
+					},
+					Archive: storearchive.Config{
+						Enabled:      storearchive.DefaultEnabled,
+						After:        storearchive.DefaultAfter,
+						ScanInterval: storearchive.DefaultScanInterval,
+					},
+					Probe: storeprobe.Config{
+						Enabled: storeprobe.DefaultEnabled,
+						Timeout: storeprobe.DefaultTimeout,
+					},
 				},
 				Routing: routing.Config{
 					ListenAddress:  routing.DefaultListenAddress,
@@ -149,20 +483,55 @@ func TestConfig(t *testing.T) {
 					GossipSub: routing.GossipSubConfig{
 						Enabled: routing.DefaultGossipSubEnabled,
 					},
+					Mode: routing.DefaultMode,
+					Federation: routing.FederationConfig{
+						Peers:               []string{},
+						HealthCheckInterval: routing.DefaultFederationHealthCheckInterval,
+						RequestTimeout:      routing.DefaultFederationRequestTimeout,
+					},
+					Popularity: routing.PopularityConfig{
+						Enabled: routing.DefaultPopularityEnabled,
+					},
 				},
 				Database: database.Config{
-					DBType: database.DefaultDBType,
+					DBType:             database.DefaultDBType,
+					IndexedAnnotations: []string{},
+					Locators: database.LocatorsConfig{
+						LowercaseScheme:   database.DefaultLocatorsLowercaseScheme,
+						TrimTrailingSlash: database.DefaultLocatorsTrimTrailingSlash,
+						PinDigests:        database.DefaultLocatorsPinDigests,
+						Deduplicate:       database.DefaultLocatorsDeduplicate,
+					},
+					Replicas: database.ReplicasConfig{
+						ReadDSNs:            []string{},
+						HealthCheckInterval: database.DefaultReplicaHealthCheckInterval,
+					},
 					SQLite: sqliteconfig.Config{
 						DBPath: sqliteconfig.DefaultSQLiteDBPath,
 					},
+					Postgres: postgresconfig.Config{
+						DSN:             postgresconfig.DefaultPostgresDSN,
+						MaxOpenConns:    postgresconfig.DefaultPostgresMaxOpenConns,
+						MaxIdleConns:    postgresconfig.DefaultPostgresMaxIdleConns,
+						ConnMaxLifetime: postgresconfig.DefaultPostgresConnMaxLifetime,
+						ConnMaxIdleTime: postgresconfig.DefaultPostgresConnMaxIdleTime,
+					},
 				},
 				Sync: sync.Config{
 					SchedulerInterval: sync.DefaultSyncSchedulerInterval,
 					WorkerCount:       sync.DefaultSyncWorkerCount,
 					WorkerTimeout:     sync.DefaultSyncWorkerTimeout,
+					WorkerParallelism: sync.DefaultSyncWorkerParallelism,
 					RegistryMonitor: monitor.Config{
 						CheckInterval: monitor.DefaultCheckInterval,
 					},
+					Invitations: sync.InvitationsConfig{
+						TTL: sync.DefaultSyncInvitationTTL,
+					},
+					Reconciliation: sync.ReconciliationConfig{
+						Interval:      sync.DefaultSyncReconciliationInterval,
+						ItemThreshold: sync.DefaultSyncReconciliationItemThreshold,
+					},
 				},
 				Authz: authz.Config{},
 				Publication: publication.Config{
@@ -170,6 +539,62 @@ func TestConfig(t *testing.T) {
 					WorkerCount:       publication.DefaultPublicationWorkerCount,
 					WorkerTimeout:     publication.DefaultPublicationWorkerTimeout,
 				},
+				Watchdog: watchdog.Config{
+					Enabled:       watchdog.DefaultEnabled,
+					CheckInterval: watchdog.DefaultCheckInterval,
+					MaxRetries:    watchdog.DefaultMaxRetries,
+					RetryBackoff:  watchdog.DefaultRetryBackoff,
+				},
+				Churn: churn.Config{
+					Enabled:     churn.DefaultEnabled,
+					Threshold:   churn.DefaultThreshold,
+					Window:      churn.DefaultWindow,
+					QuietPeriod: churn.DefaultQuietPeriod,
+				},
+				Validation: validation.Config{
+					Enabled:  validation.DefaultValidationEnabled,
+					Interval: validation.DefaultValidationInterval,
+					SchemaVersions: validation.SchemaVersionsConfig{
+						Deny: []string{},
+					},
+					Licenses: validation.LicensesConfig{
+						Allowed: []string{},
+					},
+				},
+				Scanning: scanning.Config{
+					Enabled:         scanning.DefaultScanningEnabled,
+					Action:          scanning.DefaultScanningAction,
+					BuiltinPatterns: scanning.DefaultScanningBuiltinPatterns,
+					Webhook: scanning.WebhookConfig{
+						Timeout: scanning.DefaultScanningWebhookTimeout,
+					},
+				},
+				Consistency: consistency.Config{
+					Enabled:  consistency.DefaultConsistencyEnabled,
+					Interval: consistency.DefaultConsistencyInterval,
+					Repair:   consistency.DefaultConsistencyRepair,
+				},
+				Webhooks: webhooks.Config{
+					Enabled:      webhooks.DefaultWebhooksEnabled,
+					Timeout:      webhooks.DefaultWebhooksTimeout,
+					MaxPerRecord: webhooks.DefaultWebhooksMaxPerRecord,
+					Workers:      webhooks.DefaultWebhooksWorkers,
+				},
+				Metrics: metrics.Config{
+					Enabled:       metrics.DefaultEnabled,
+					ListenAddress: metrics.DefaultListenAddress,
+				},
+				UI: ui.Config{
+					Enabled:       ui.DefaultEnabled,
+					ListenAddress: ui.DefaultListenAddress,
+				},
+				Events: events.DefaultConfig(),
+				Proxy: proxy.Config{
+					Enabled:        proxy.DefaultEnabled,
+					Upstreams:      []string{},
+					RequestTimeout: proxy.DefaultRequestTimeout,
+					IndexOnly:      proxy.DefaultIndexOnly,
+				},
 			},
 		},
 	}
@@ -0,0 +1,25 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+const (
+	DefaultConsistencyEnabled  = false
+	DefaultConsistencyInterval = 24 * time.Hour
+	DefaultConsistencyRepair   = false
+)
+
+type Config struct {
+	// Enabled turns on the periodic consistency check between the search database,
+	// the content store and the routing datastore.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// Interval at which the consistency check runs.
+	Interval time.Duration `json:"interval,omitempty" mapstructure:"interval"`
+
+	// Repair the discrepancies between the search database and the content store
+	// found by the periodic consistency check.
+	Repair bool `json:"repair,omitempty" mapstructure:"repair"`
+}
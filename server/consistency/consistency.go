@@ -0,0 +1,342 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package consistency checks that the search database, the content store and
+// the routing datastore hold the same records, and repairs the discrepancies
+// between the search database and the content store.
+package consistency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/consistency/config"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/types/adapters"
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var logger = logging.Logger("consistency")
+
+// Discrepancy is a record missing from one of the record sources.
+type Discrepancy struct {
+	CID  string
+	Type storev1.ConsistencyDiscrepancyType
+
+	// Repaired is set when the discrepancy was repaired.
+	Repaired bool
+
+	// RepairError is set when the discrepancy could not be repaired.
+	RepairError string
+}
+
+// Report is the outcome of a consistency check.
+type Report struct {
+	Indexed   int
+	Stored    int
+	Published int
+
+	Discrepancies []Discrepancy
+}
+
+// Checker compares the records held by the search database, the content store
+// and the routing datastore.
+type Checker struct {
+	store   types.StoreAPI
+	db      types.DatabaseAPI
+	routing types.RoutingAPI
+}
+
+// NewChecker creates a new consistency checker.
+func NewChecker(store types.StoreAPI, db types.DatabaseAPI, routing types.RoutingAPI) *Checker {
+	return &Checker{
+		store:   store,
+		db:      db,
+		routing: routing,
+	}
+}
+
+// Check compares the records of the search database, content store and routing datastore.
+// If repair is set, stored records missing from the search database are indexed, and
+// indexed records missing from the content store are removed from the search database.
+// Published records missing from the content store cannot be unpublished without their
+// labels, so they are only reported.
+func (c *Checker) Check(ctx context.Context, repair bool) (*Report, error) {
+	// List the index before the store, as records are stored before they are indexed.
+	// Records pushed during the check are then at worst reported as unindexed.
+	indexedCIDs, err := c.db.GetRecordCIDs()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list indexed records: %v", err)
+	}
+
+	indexed := make(map[string]struct{}, len(indexedCIDs))
+	for _, cid := range indexedCIDs {
+		indexed[cid] = struct{}{}
+	}
+
+	stored, err := c.listStored(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	published, err := c.listPublished(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Indexed:   len(indexed),
+		Stored:    len(stored),
+		Published: len(published),
+	}
+
+	for _, cid := range sortedKeys(indexed) {
+		if _, ok := stored[cid]; ok {
+			continue
+		}
+
+		discrepancy := Discrepancy{CID: cid, Type: storev1.ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_INDEXED_MISSING_BLOB}
+		if repair {
+			discrepancy.setRepairResult(c.removeIndexed(ctx, cid))
+		}
+
+		report.Discrepancies = append(report.Discrepancies, discrepancy)
+	}
+
+	for _, cid := range sortedKeys(stored) {
+		if _, ok := indexed[cid]; ok {
+			continue
+		}
+
+		discrepancy := Discrepancy{CID: cid, Type: storev1.ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_STORED_UNINDEXED}
+		if repair {
+			discrepancy.setRepairResult(c.index(ctx, cid))
+		}
+
+		report.Discrepancies = append(report.Discrepancies, discrepancy)
+	}
+
+	for _, cid := range sortedKeys(published) {
+		if _, ok := stored[cid]; ok {
+			continue
+		}
+
+		report.Discrepancies = append(report.Discrepancies, Discrepancy{
+			CID:  cid,
+			Type: storev1.ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_PUBLISHED_MISSING,
+		})
+	}
+
+	return report, nil
+}
+
+// listStored returns the CIDs of the records in the content store.
+func (c *Checker) listStored(ctx context.Context) (map[string]struct{}, error) {
+	lister, ok := c.store.(types.ListerStore)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "store does not support listing records")
+	}
+
+	stored := make(map[string]struct{})
+
+	err := lister.List(ctx, func(ref *corev1.RecordRef) error {
+		stored[ref.GetCid()] = struct{}{}
+
+		return nil
+	})
+	if err != nil {
+		st := status.Convert(err)
+
+		return nil, status.Errorf(st.Code(), "failed to list stored records: %s", st.Message())
+	}
+
+	return stored, nil
+}
+
+// listPublished returns the CIDs of the records published to the routing datastore.
+func (c *Checker) listPublished(ctx context.Context) (map[string]struct{}, error) {
+	results, err := c.routing.List(ctx, &routingv1.ListRequest{})
+	if err != nil {
+		st := status.Convert(err)
+
+		return nil, status.Errorf(st.Code(), "failed to list published records: %s", st.Message())
+	}
+
+	published := make(map[string]struct{})
+	for result := range results {
+		published[result.GetRecordRef().GetCid()] = struct{}{}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err() //nolint:wrapcheck
+	}
+
+	return published, nil
+}
+
+// index adds a stored record to the search database.
+func (c *Checker) index(ctx context.Context, cid string) error {
+	record, err := c.store.Pull(ctx, &corev1.RecordRef{Cid: cid})
+	if err != nil {
+		return fmt.Errorf("failed to pull record: %w", err)
+	}
+
+	if err := c.db.AddRecord(adapters.NewRecordAdapter(record)); err != nil {
+		return fmt.Errorf("failed to index record: %w", err)
+	}
+
+	logger.Info("Indexed stored record missing from the search database", "cid", cid)
+
+	return nil
+}
+
+// removeIndexed removes a record missing from the content store from the search database.
+// The record is looked up again first, as it may have been pushed since the store was listed.
+func (c *Checker) removeIndexed(ctx context.Context, cid string) error {
+	_, err := c.store.Lookup(ctx, &corev1.RecordRef{Cid: cid})
+	if err == nil {
+		return errors.New("record is no longer missing from the store")
+	}
+
+	if status.Code(err) != codes.NotFound {
+		return fmt.Errorf("failed to lookup record: %w", err)
+	}
+
+	if err := c.db.RemoveRecord(cid); err != nil {
+		return fmt.Errorf("failed to remove record from the search database: %w", err)
+	}
+
+	logger.Info("Removed indexed record missing from the store", "cid", cid)
+
+	return nil
+}
+
+func (d *Discrepancy) setRepairResult(err error) {
+	if err != nil {
+		d.RepairError = err.Error()
+
+		return
+	}
+
+	d.Repaired = true
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// Service periodically checks the consistency of the search database,
+// the content store and the routing datastore.
+type Service struct {
+	checker *Checker
+	config  config.Config
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New creates a new consistency service.
+func New(db types.DatabaseAPI, store types.StoreAPI, routing types.RoutingAPI, opts types.APIOptions) (*Service, error) {
+	cfg := opts.Config().Consistency
+	if cfg.Enabled && cfg.Interval <= 0 {
+		return nil, fmt.Errorf("consistency check interval must be positive, got %s", cfg.Interval)
+	}
+
+	return &Service{
+		checker: NewChecker(store, db, routing),
+		config:  cfg,
+		stopCh:  make(chan struct{}),
+	}, nil
+}
+
+// Start begins the periodic consistency check.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.config.Enabled {
+		logger.Info("Consistency check disabled")
+
+		return nil
+	}
+
+	logger.Info("Starting consistency service", "interval", s.config.Interval, "repair", s.config.Repair)
+
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		s.run(ctx)
+	}()
+
+	return nil
+}
+
+// Stop gracefully shuts down the consistency service.
+func (s *Service) Stop() error {
+	logger.Info("Stopping consistency service")
+
+	close(s.stopCh)
+	s.wg.Wait()
+
+	logger.Info("Consistency service stopped")
+
+	return nil
+}
+
+func (s *Service) run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	// The first check runs after one interval, so that it does not slow down startup
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.check(ctx)
+		}
+	}
+}
+
+// check runs a consistency check and logs the discrepancies found.
+func (s *Service) check(ctx context.Context) {
+	report, err := s.checker.Check(ctx, s.config.Repair)
+	if err != nil {
+		logger.Error("Failed to check consistency", "error", err)
+
+		return
+	}
+
+	for _, discrepancy := range report.Discrepancies {
+		logger.Warn("Consistency discrepancy found",
+			"cid", discrepancy.CID,
+			"type", discrepancy.Type.String(),
+			"repaired", discrepancy.Repaired,
+			"repair_error", discrepancy.RepairError,
+		)
+	}
+
+	logger.Info("Checked consistency",
+		"indexed", report.Indexed,
+		"stored", report.Stored,
+		"published", report.Published,
+		"discrepancies", len(report.Discrepancies),
+	)
+}
@@ -0,0 +1,177 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package consistency
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	typesv1alpha0 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/agntcy/oasf/types/v1alpha0"
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeStore struct {
+	types.StoreAPI
+
+	records map[string]*corev1.Record
+}
+
+func (s *fakeStore) Pull(_ context.Context, ref *corev1.RecordRef) (*corev1.Record, error) {
+	record, ok := s.records[ref.GetCid()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "record not found")
+	}
+
+	return record, nil
+}
+
+func (s *fakeStore) Lookup(_ context.Context, ref *corev1.RecordRef) (*corev1.RecordMeta, error) {
+	if _, ok := s.records[ref.GetCid()]; !ok {
+		return nil, status.Error(codes.NotFound, "record not found")
+	}
+
+	return &corev1.RecordMeta{Cid: ref.GetCid()}, nil
+}
+
+func (s *fakeStore) List(_ context.Context, listFn func(*corev1.RecordRef) error) error {
+	for _, cid := range slices.Sorted(maps.Keys(s.records)) {
+		if err := listFn(&corev1.RecordRef{Cid: cid}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type fakeDatabase struct {
+	types.DatabaseAPI
+
+	cids map[string]struct{}
+}
+
+func (d *fakeDatabase) GetRecordCIDs(...types.FilterOption) ([]string, error) {
+	return slices.Sorted(maps.Keys(d.cids)), nil
+}
+
+func (d *fakeDatabase) AddRecord(record types.Record) error {
+	d.cids[record.GetCid()] = struct{}{}
+
+	return nil
+}
+
+func (d *fakeDatabase) RemoveRecord(cid string) error {
+	delete(d.cids, cid)
+
+	return nil
+}
+
+type fakeRouting struct {
+	types.RoutingAPI
+
+	published []string
+}
+
+func (r *fakeRouting) List(context.Context, *routingv1.ListRequest) (<-chan *routingv1.ListResponse, error) {
+	ch := make(chan *routingv1.ListResponse, len(r.published))
+	for _, cid := range r.published {
+		ch <- &routingv1.ListResponse{RecordRef: &corev1.RecordRef{Cid: cid}}
+	}
+
+	close(ch)
+
+	return ch, nil
+}
+
+func newTestRecord(name string) *corev1.Record {
+	return corev1.New(&typesv1alpha0.Record{
+		Name:          name,
+		SchemaVersion: "v0.3.1",
+	})
+}
+
+// newTestChecker creates a checker with a consistent record, a stored but unindexed record,
+// an indexed record missing from the store, and a published record missing from the store.
+func newTestChecker() (*Checker, *fakeDatabase, map[storev1.ConsistencyDiscrepancyType]string) {
+	consistent := newTestRecord("consistent")
+	unindexed := newTestRecord("unindexed")
+	missingBlob := newTestRecord("missing-blob").GetCid()
+	missingPublished := newTestRecord("missing-published").GetCid()
+
+	store := &fakeStore{records: map[string]*corev1.Record{
+		consistent.GetCid(): consistent,
+		unindexed.GetCid():  unindexed,
+	}}
+	db := &fakeDatabase{cids: map[string]struct{}{
+		consistent.GetCid(): {},
+		missingBlob:         {},
+	}}
+	routing := &fakeRouting{published: []string{consistent.GetCid(), missingPublished}}
+
+	return NewChecker(store, db, routing), db, map[storev1.ConsistencyDiscrepancyType]string{
+		storev1.ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_INDEXED_MISSING_BLOB: missingBlob,
+		storev1.ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_STORED_UNINDEXED:     unindexed.GetCid(),
+		storev1.ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_PUBLISHED_MISSING:    missingPublished,
+	}
+}
+
+func TestCheck(t *testing.T) {
+	checker, db, expected := newTestChecker()
+
+	report, err := checker.Check(t.Context(), false)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, report.Indexed)
+	assert.Equal(t, 2, report.Stored)
+	assert.Equal(t, 2, report.Published)
+	require.Len(t, report.Discrepancies, len(expected))
+
+	for _, discrepancy := range report.Discrepancies {
+		assert.Equal(t, expected[discrepancy.Type], discrepancy.CID)
+		assert.False(t, discrepancy.Repaired)
+	}
+
+	// Nothing is repaired unless requested
+	assert.Len(t, db.cids, 2)
+}
+
+func TestCheckRepair(t *testing.T) {
+	checker, db, expected := newTestChecker()
+
+	report, err := checker.Check(t.Context(), true)
+	require.NoError(t, err)
+	require.Len(t, report.Discrepancies, len(expected))
+
+	for _, discrepancy := range report.Discrepancies {
+		// Published records missing from the store are only reported
+		repairable := discrepancy.Type != storev1.ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_PUBLISHED_MISSING
+		assert.Equal(t, repairable, discrepancy.Repaired, discrepancy.Type.String())
+		assert.Empty(t, discrepancy.RepairError)
+	}
+
+	assert.Contains(t, db.cids, expected[storev1.ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_STORED_UNINDEXED])
+	assert.NotContains(t, db.cids, expected[storev1.ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_INDEXED_MISSING_BLOB])
+
+	// The store and index are consistent after the repair
+	report, err = checker.Check(t.Context(), false)
+	require.NoError(t, err)
+	require.Len(t, report.Discrepancies, 1)
+	assert.Equal(t, storev1.ConsistencyDiscrepancyType_CONSISTENCY_DISCREPANCY_TYPE_PUBLISHED_MISSING, report.Discrepancies[0].Type)
+}
+
+func TestCheckUnlistableStore(t *testing.T) {
+	checker := NewChecker(struct{ types.StoreAPI }{}, &fakeDatabase{cids: map[string]struct{}{}}, &fakeRouting{})
+
+	_, err := checker.Check(t.Context(), false)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -0,0 +1,237 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SetAlias points a name:tag alias to a stored record with the same name.
+func (s storeCtrl) SetAlias(ctx context.Context, req *storev1.SetAliasRequest) (*storev1.SetAliasResponse, error) {
+	storeLogger.Debug("Called store controller's SetAlias method", "name", req.GetName(), "tag", req.GetTag(), "cid", req.GetRecordRef().GetCid())
+
+	if err := validateAlias(req.GetName(), req.GetTag()); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateRecordRef(req.GetRecordRef()); err != nil {
+		return nil, err
+	}
+
+	record, err := s.store.Pull(ctx, req.GetRecordRef())
+	if err != nil {
+		st := status.Convert(err)
+
+		return nil, status.Errorf(st.Code(), "failed to pull record: %s", st.Message()) //nolint:wrapcheck
+	}
+
+	// Aliases only point to records of their name, so that resolving a name never returns another record
+	if name, _ := extractRecordInfo(record); name != req.GetName() {
+		return nil, status.Errorf(codes.InvalidArgument, "record %s is named %q, not %q", req.GetRecordRef().GetCid(), name, req.GetName()) //nolint:wrapcheck
+	}
+
+	actor := callerID(ctx)
+
+	previousCID, err := s.db.SetAlias(req.GetName(), req.GetTag(), req.GetRecordRef().GetCid(), req.ExpectedCid, actor)
+	if errors.Is(err, types.ErrAliasConflict) {
+		return nil, status.Errorf(codes.Aborted, "failed to set alias: %v", err) //nolint:wrapcheck
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set alias: %v", err) //nolint:wrapcheck
+	}
+
+	storeLogger.Info("Alias set", "name", req.GetName(), "tag", req.GetTag(), "cid", req.GetRecordRef().GetCid(), "previous_cid", previousCID)
+
+	return &storev1.SetAliasResponse{
+		Alias: &storev1.Alias{
+			Name:        req.GetName(),
+			Tag:         req.GetTag(),
+			RecordRef:   &corev1.RecordRef{Cid: req.GetRecordRef().GetCid()},
+			UpdatedTime: time.Now().Format(time.RFC3339),
+			UpdatedBy:   actor,
+		},
+		PreviousCid: previousCID,
+	}, nil
+}
+
+// DeleteAlias deletes a name:tag alias.
+func (s storeCtrl) DeleteAlias(ctx context.Context, req *storev1.DeleteAliasRequest) (*emptypb.Empty, error) {
+	storeLogger.Debug("Called store controller's DeleteAlias method", "name", req.GetName(), "tag", req.GetTag())
+
+	if err := validateAlias(req.GetName(), req.GetTag()); err != nil {
+		return nil, err
+	}
+
+	err := s.db.DeleteAlias(req.GetName(), req.GetTag(), callerID(ctx))
+	if errors.Is(err, types.ErrAliasNotFound) {
+		return nil, status.Errorf(codes.NotFound, "alias %s:%s not found", req.GetName(), req.GetTag()) //nolint:wrapcheck
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete alias: %v", err) //nolint:wrapcheck
+	}
+
+	storeLogger.Info("Alias deleted", "name", req.GetName(), "tag", req.GetTag())
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListAliases lists the aliases of a record name, or all aliases.
+func (s storeCtrl) ListAliases(_ context.Context, req *storev1.ListAliasesRequest) (*storev1.ListAliasesResponse, error) {
+	storeLogger.Debug("Called store controller's ListAliases method", "name", req.GetName())
+
+	aliases, err := s.db.GetAliases(req.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list aliases: %v", err) //nolint:wrapcheck
+	}
+
+	resp := &storev1.ListAliasesResponse{Aliases: make([]*storev1.Alias, 0, len(aliases))}
+	for _, alias := range aliases {
+		resp.Aliases = append(resp.Aliases, &storev1.Alias{
+			Name:        alias.GetName(),
+			Tag:         alias.GetTag(),
+			RecordRef:   &corev1.RecordRef{Cid: alias.GetCID()},
+			UpdatedTime: alias.GetUpdatedTime(),
+			UpdatedBy:   alias.GetUpdatedBy(),
+		})
+	}
+
+	return resp, nil
+}
+
+// GetAliasHistory returns the changes of the aliases of a record name.
+func (s storeCtrl) GetAliasHistory(_ context.Context, req *storev1.GetAliasHistoryRequest) (*storev1.GetAliasHistoryResponse, error) {
+	storeLogger.Debug("Called store controller's GetAliasHistory method", "name", req.GetName(), "tag", req.GetTag())
+
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required") //nolint:wrapcheck
+	}
+
+	changes, err := s.db.GetAliasHistory(req.GetName(), req.GetTag())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get alias history: %v", err) //nolint:wrapcheck
+	}
+
+	resp := &storev1.GetAliasHistoryResponse{Changes: make([]*storev1.AliasChange, 0, len(changes))}
+	for _, change := range changes {
+		resp.Changes = append(resp.Changes, &storev1.AliasChange{
+			Name:        change.GetName(),
+			Tag:         change.GetTag(),
+			PreviousCid: change.GetPreviousCID(),
+			Cid:         change.GetCID(),
+			ChangedTime: change.GetChangedTime(),
+			ChangedBy:   change.GetChangedBy(),
+		})
+	}
+
+	return resp, nil
+}
+
+// ResolveName resolves a record name and tag to a record reference.
+func (s storeCtrl) ResolveName(ctx context.Context, req *storev1.ResolveNameRequest) (*storev1.ResolveNameResponse, error) {
+	storeLogger.Debug("Called store controller's ResolveName method", "name", req.GetName(), "tag", req.GetTag())
+
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required") //nolint:wrapcheck
+	}
+
+	tag := req.GetTag()
+	if tag == "" {
+		tag = corev1.DefaultAliasTag
+	}
+
+	return s.resolveName(ctx, req.GetName(), tag)
+}
+
+// resolveName resolves a tag as an alias of the name, then as the version of a record with that name.
+// Records under embargo are only resolved by version for their owner.
+func (s storeCtrl) resolveName(ctx context.Context, name, tag string) (*storev1.ResolveNameResponse, error) {
+	alias, err := s.db.GetAlias(name, tag)
+	if err == nil {
+		return &storev1.ResolveNameResponse{
+			RecordRef: &corev1.RecordRef{Cid: alias.GetCID()},
+			Alias:     true,
+		}, nil
+	}
+
+	if !errors.Is(err, types.ErrAliasNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get alias: %v", err) //nolint:wrapcheck
+	}
+
+	records, err := s.db.GetRecords(
+		types.WithName(name),
+		types.WithVersion(tag),
+		types.WithEmbargoes(time.Now(), callerID(ctx)),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search records: %v", err) //nolint:wrapcheck
+	}
+
+	// Name and version filters match wildcards, only exact matches resolve
+	var cids []string
+
+	for _, record := range records {
+		data, err := record.GetRecordData()
+		if err != nil {
+			continue
+		}
+
+		if data.GetName() == name && data.GetVersion() == tag {
+			cids = append(cids, record.GetCid())
+		}
+	}
+
+	switch len(cids) {
+	case 0:
+		return nil, status.Errorf(codes.NotFound, "no alias or version %q of record %q", tag, name) //nolint:wrapcheck
+	case 1:
+		return &storev1.ResolveNameResponse{RecordRef: &corev1.RecordRef{Cid: cids[0]}}, nil
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "version %q of record %q matches %d records: use an alias or CID", tag, name, len(cids)) //nolint:wrapcheck
+	}
+}
+
+// resolveRecordRef resolves "name:tag" references pulled in place of CIDs.
+func (s storeCtrl) resolveRecordRef(ctx context.Context, recordRef *corev1.RecordRef) (*corev1.RecordRef, error) {
+	if !corev1.IsNameReference(recordRef.GetCid()) {
+		return recordRef, nil
+	}
+
+	name, tag, err := corev1.ParseNameReference(recordRef.GetCid())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error()) //nolint:wrapcheck
+	}
+
+	resp, err := s.resolveName(ctx, name, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	storeLogger.Debug("Resolved name reference", "ref", recordRef.GetCid(), "cid", resp.GetRecordRef().GetCid(), "alias", resp.GetAlias())
+
+	return resp.GetRecordRef(), nil
+}
+
+// validateAlias validates the name and tag of an alias.
+func validateAlias(name, tag string) error {
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "name is required") //nolint:wrapcheck
+	}
+
+	if err := corev1.ValidateAliasTag(tag); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error()) //nolint:wrapcheck
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/types/adapters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// aliasDatabase keeps aliases in memory and searches the given records.
+type aliasDatabase struct {
+	types.DatabaseAPI
+
+	aliases map[string]string
+	records []*corev1.Record
+}
+
+func (d *aliasDatabase) SetAlias(name, tag, cid string, expectedCID *string, _ string) (string, error) {
+	previousCID := d.aliases[name+":"+tag]
+	if expectedCID != nil && *expectedCID != previousCID {
+		return "", types.ErrAliasConflict
+	}
+
+	d.aliases[name+":"+tag] = cid
+
+	return previousCID, nil
+}
+
+func (d *aliasDatabase) GetAlias(name, tag string) (types.AliasObject, error) {
+	cid, ok := d.aliases[name+":"+tag]
+	if !ok {
+		return nil, types.ErrAliasNotFound
+	}
+
+	return &testAlias{name: name, tag: tag, cid: cid}, nil
+}
+
+func (d *aliasDatabase) GetRecords(...types.FilterOption) ([]types.Record, error) {
+	records := make([]types.Record, 0, len(d.records))
+	for _, record := range d.records {
+		records = append(records, adapters.NewRecordAdapter(record))
+	}
+
+	return records, nil
+}
+
+type testAlias struct {
+	name, tag, cid string
+}
+
+func (a *testAlias) GetName() string        { return a.name }
+func (a *testAlias) GetTag() string         { return a.tag }
+func (a *testAlias) GetCID() string         { return a.cid }
+func (a *testAlias) GetUpdatedTime() string { return "" }
+func (a *testAlias) GetUpdatedBy() string   { return "" }
+
+func TestSetAlias(t *testing.T) {
+	record := newPushRecord("aliased-agent")
+	other := newPushRecord("other-agent")
+	store := &updateStore{records: map[string]*corev1.Record{record.GetCid(): record, other.GetCid(): other}}
+	ctrl := NewStoreController(store, &aliasDatabase{aliases: map[string]string{}}, nil, nil, nil, nil, nil, nil, "", nil, nil).(*storeCtrl) //nolint:forcetypeassert
+
+	resp, err := ctrl.SetAlias(context.Background(), &storev1.SetAliasRequest{
+		Name:      "aliased-agent",
+		Tag:       "stable",
+		RecordRef: &corev1.RecordRef{Cid: record.GetCid()},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, record.GetCid(), resp.GetAlias().GetRecordRef().GetCid())
+	assert.Empty(t, resp.GetPreviousCid())
+
+	stale := "bafystale"
+
+	tests := []struct {
+		name string
+		req  *storev1.SetAliasRequest
+		code codes.Code
+	}{
+		{"missing name", &storev1.SetAliasRequest{Tag: "stable", RecordRef: &corev1.RecordRef{Cid: record.GetCid()}}, codes.InvalidArgument},
+		{"invalid tag", &storev1.SetAliasRequest{Name: "aliased-agent", Tag: "-stable", RecordRef: &corev1.RecordRef{Cid: record.GetCid()}}, codes.InvalidArgument},
+		{"unknown record", &storev1.SetAliasRequest{Name: "aliased-agent", Tag: "stable", RecordRef: &corev1.RecordRef{Cid: "bafyunknown"}}, codes.NotFound},
+		{"other record name", &storev1.SetAliasRequest{Name: "aliased-agent", Tag: "stable", RecordRef: &corev1.RecordRef{Cid: other.GetCid()}}, codes.InvalidArgument},
+		{"moved alias", &storev1.SetAliasRequest{Name: "aliased-agent", Tag: "stable", RecordRef: &corev1.RecordRef{Cid: record.GetCid()}, ExpectedCid: &stale}, codes.Aborted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ctrl.SetAlias(context.Background(), tt.req)
+			assert.Equal(t, tt.code, status.Code(err), err)
+		})
+	}
+}
+
+func TestResolveName(t *testing.T) {
+	record := newPushRecord("aliased-agent")
+	db := &aliasDatabase{
+		aliases: map[string]string{"aliased-agent:stable": "bafystable"},
+		records: []*corev1.Record{record, newPushRecord("aliased-agent-2")},
+	}
+	ctrl := NewStoreController(&updateStore{}, db, nil, nil, nil, nil, nil, nil, "", nil, nil).(*storeCtrl) //nolint:forcetypeassert
+
+	// Aliases are resolved first
+	resp, err := ctrl.ResolveName(context.Background(), &storev1.ResolveNameRequest{Name: "aliased-agent", Tag: "stable"})
+	require.NoError(t, err)
+	assert.Equal(t, "bafystable", resp.GetRecordRef().GetCid())
+	assert.True(t, resp.GetAlias())
+
+	// Then versions of records with exactly that name
+	resp, err = ctrl.ResolveName(context.Background(), &storev1.ResolveNameRequest{Name: "aliased-agent", Tag: "1.0.0"})
+	require.NoError(t, err)
+	assert.Equal(t, record.GetCid(), resp.GetRecordRef().GetCid())
+	assert.False(t, resp.GetAlias())
+
+	_, err = ctrl.ResolveName(context.Background(), &storev1.ResolveNameRequest{Name: "aliased-agent"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Pull references are resolved in place of CIDs
+	ref, err := ctrl.resolveRecordRef(context.Background(), &corev1.RecordRef{Cid: "aliased-agent:stable"})
+	require.NoError(t, err)
+	assert.Equal(t, "bafystable", ref.GetCid())
+
+	ref, err = ctrl.resolveRecordRef(context.Background(), &corev1.RecordRef{Cid: record.GetCid()})
+	require.NoError(t, err)
+	assert.Equal(t, record.GetCid(), ref.GetCid())
+}
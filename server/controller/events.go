@@ -4,22 +4,51 @@
 package controller
 
 import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
 	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/server/authn"
+	"github.com/agntcy/dir/server/authz"
 	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/webhooks"
 	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 var eventsLogger = logging.Logger("controller/events")
 
+const (
+	// watchLookupAttempts and watchLookupInterval bound how long WatchName waits for
+	// a pushed record to be indexed, as push events are published before indexing.
+	watchLookupAttempts = 20
+	watchLookupInterval = 100 * time.Millisecond
+)
+
 type eventsCtlr struct {
 	eventsv1.UnimplementedEventServiceServer
 	eventService *events.Service
+	db           types.SearchDatabaseAPI
+	authorizer   *authz.Authorizer
+	webhooks     *webhooks.Service
 }
 
 // NewEventsController creates a new events controller.
-func NewEventsController(eventService *events.Service) eventsv1.EventServiceServer {
+// The database is used to resolve the latest records of watched names.
+// If authorizer is nil, subscriptions are not restricted to the caller's namespace.
+// If webhookService is nil, record webhooks cannot be managed.
+func NewEventsController(eventService *events.Service, db types.SearchDatabaseAPI, authorizer *authz.Authorizer, webhookService *webhooks.Service) eventsv1.EventServiceServer {
 	return &eventsCtlr{
 		eventService:                    eventService,
+		db:                              db,
+		authorizer:                      authorizer,
+		webhooks:                        webhookService,
 		UnimplementedEventServiceServer: eventsv1.UnimplementedEventServiceServer{},
 	}
 }
@@ -27,10 +56,28 @@ func NewEventsController(eventService *events.Service) eventsv1.EventServiceServ
 // Listen implements the event streaming RPC.
 // It creates a subscription on the event bus and streams matching events to the client.
 func (c *eventsCtlr) Listen(req *eventsv1.ListenRequest, stream eventsv1.EventService_ListenServer) error {
+	if err := events.ValidateListenRequest(req); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	req, err := c.restrictNamespaces(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	req, err = c.restrictSubscription(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
 	eventsLogger.Info("Client connected to event stream",
 		"event_types", req.GetEventTypes(),
 		"label_filters", req.GetLabelFilters(),
-		"cid_filters", req.GetCidFilters())
+		"cid_filters", req.GetCidFilters(),
+		"actor_filters", req.GetActorFilters(),
+		"namespace_filters", req.GetNamespaceFilters(),
+		"since", req.GetSince().AsTime(),
+		"filter_expression", req.GetFilterExpression())
 
 	// Subscribe to event bus
 	subID, eventCh := c.eventService.Bus().Subscribe(req)
@@ -38,6 +85,18 @@ func (c *eventsCtlr) Listen(req *eventsv1.ListenRequest, stream eventsv1.EventSe
 
 	eventsLogger.Debug("Subscription created", "subscription_id", subID)
 
+	// Replay retained events before streaming live events.
+	// The subscription is created first so that no events are missed in between,
+	// and live events that were already replayed are skipped.
+	replayed, err := c.replay(req, stream)
+	if err != nil {
+		eventsLogger.Error("Failed to replay events to client",
+			"subscription_id", subID,
+			"error", err)
+
+		return err
+	}
+
 	// Stream events to client
 	for {
 		select {
@@ -56,6 +115,10 @@ func (c *eventsCtlr) Listen(req *eventsv1.ListenRequest, stream eventsv1.EventSe
 				return nil
 			}
 
+			if _, ok := replayed[event.ID]; ok {
+				continue
+			}
+
 			// Convert event to proto and wrap in ListenResponse
 			response := &eventsv1.ListenResponse{
 				Event: event.ToProto(),
@@ -78,3 +141,315 @@ func (c *eventsCtlr) Listen(req *eventsv1.ListenRequest, stream eventsv1.EventSe
 		}
 	}
 }
+
+// replay sends the retained events requested by the since field of the request.
+// It returns the IDs of the replayed events.
+func (c *eventsCtlr) replay(req *eventsv1.ListenRequest, stream eventsv1.EventService_ListenServer) (map[string]struct{}, error) {
+	if req.GetSince() == nil {
+		return nil, nil
+	}
+
+	retained := c.eventService.Bus().Replay(req, req.GetSince().AsTime())
+	replayed := make(map[string]struct{}, len(retained))
+
+	for _, event := range retained {
+		if err := stream.Send(&eventsv1.ListenResponse{Event: event.ToProto()}); err != nil {
+			return nil, err //nolint:wrapcheck // gRPC stream error - pass through unchanged
+		}
+
+		replayed[event.ID] = struct{}{}
+	}
+
+	eventsLogger.Debug("Replayed events to client", "count", len(retained))
+
+	return replayed, nil
+}
+
+// WatchName implements the latest record watch RPC.
+// It sends the latest record of each matching name, then tracks pushed and deleted
+// records to send the latest record of a name whenever it changes.
+func (c *eventsCtlr) WatchName(req *eventsv1.WatchNameRequest, stream eventsv1.EventService_WatchNameServer) error {
+	if req.GetName() == "" {
+		return status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	listenReq := &eventsv1.ListenRequest{
+		EventTypes: []eventsv1.EventType{
+			eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED,
+			eventsv1.EventType_EVENT_TYPE_RECORD_DELETED,
+		},
+	}
+
+	if req.GetNamespace() != "" {
+		listenReq.NamespaceFilters = []string{req.GetNamespace()}
+	}
+
+	listenReq, err := c.restrictNamespaces(stream.Context(), listenReq)
+	if err != nil {
+		return err
+	}
+
+	listenReq, err = c.restrictSubscription(stream.Context(), listenReq)
+	if err != nil {
+		return err
+	}
+
+	eventsLogger.Info("Client connected to name watch",
+		"name", req.GetName(),
+		"namespace_filters", listenReq.GetNamespaceFilters())
+
+	// Subscribe before loading the current records so that no changes are missed in between
+	subID, eventCh := c.eventService.Bus().Subscribe(listenReq)
+	defer c.eventService.Bus().Unsubscribe(subID)
+
+	// Records under embargo are only watched by the identity that pushed them
+	records, err := c.db.GetRecords(types.WithName(req.GetName()), types.WithEmbargoes(time.Now(), callerID(stream.Context())))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get records: %v", err)
+	}
+
+	watch := newNameWatch(req.GetName())
+	for _, record := range records {
+		watch.add(record)
+	}
+
+	for _, latest := range watch.initial() {
+		if err := stream.Send(latest); err != nil {
+			return err //nolint:wrapcheck // gRPC stream error - pass through unchanged
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			eventsLogger.Info("Client disconnected from name watch",
+				"subscription_id", subID,
+				"reason", stream.Context().Err())
+
+			return nil
+
+		case event, ok := <-eventCh:
+			if !ok {
+				eventsLogger.Info("Event channel closed", "subscription_id", subID)
+
+				return nil
+			}
+
+			var changed *eventsv1.WatchNameResponse
+
+			switch event.Type {
+			case eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED:
+				record, err := c.lookupIndexed(stream.Context(), event.ResourceID)
+				if err != nil {
+					eventsLogger.Warn("Failed to look up pushed record", "cid", event.ResourceID, "error", err)
+
+					continue
+				}
+
+				if record != nil {
+					changed = watch.add(record)
+				}
+			case eventsv1.EventType_EVENT_TYPE_RECORD_DELETED:
+				changed = watch.remove(event.ResourceID)
+			default:
+			}
+
+			if changed == nil {
+				continue
+			}
+
+			if err := stream.Send(changed); err != nil {
+				return err //nolint:wrapcheck // gRPC stream error - pass through unchanged
+			}
+
+			eventsLogger.Debug("Latest record change sent to client",
+				"subscription_id", subID,
+				"name", changed.GetName(),
+				"cid", changed.GetCid())
+		}
+	}
+}
+
+// lookupIndexed returns the indexed record with the given CID, waiting for
+// a just pushed record to be indexed. Returns nil if the record is not indexed in time,
+// or is under embargo for the caller.
+func (c *eventsCtlr) lookupIndexed(ctx context.Context, cid string) (types.Record, error) {
+	for range watchLookupAttempts {
+		records, err := c.db.GetRecords(types.WithCIDs(cid), types.WithEmbargoes(time.Now(), callerID(ctx)))
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		if len(records) > 0 {
+			return records[0], nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil //nolint:nilnil
+		case <-time.After(watchLookupInterval):
+		}
+	}
+
+	eventsLogger.Debug("Pushed record was not indexed in time", "cid", cid)
+
+	return nil, nil //nolint:nilnil
+}
+
+// RegisterRecordWebhook registers a webhook notified of the events affecting a record.
+// The webhook is owned by the caller.
+func (c *eventsCtlr) RegisterRecordWebhook(ctx context.Context, req *eventsv1.RegisterRecordWebhookRequest) (*eventsv1.RegisterRecordWebhookResponse, error) {
+	if c.webhooks == nil {
+		return nil, status.Error(codes.Unimplemented, "record webhooks are not supported by this server")
+	}
+
+	owner := callerID(ctx)
+
+	webhook, err := c.webhooks.Register(req.GetCid(), req.GetUrl(), req.GetEventTypes(), req.GetSecret(), owner)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &eventsv1.RegisterRecordWebhookResponse{Webhook: toRecordWebhookProto(webhook)}, nil
+}
+
+// ListRecordWebhooks lists the webhooks owned by the caller.
+func (c *eventsCtlr) ListRecordWebhooks(ctx context.Context, req *eventsv1.ListRecordWebhooksRequest) (*eventsv1.ListRecordWebhooksResponse, error) {
+	if c.webhooks == nil {
+		return nil, status.Error(codes.Unimplemented, "record webhooks are not supported by this server")
+	}
+
+	webhooks, err := c.webhooks.List(callerID(ctx), req.GetCid())
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	resp := &eventsv1.ListRecordWebhooksResponse{
+		Webhooks: make([]*eventsv1.RecordWebhook, 0, len(webhooks)),
+	}
+	for _, webhook := range webhooks {
+		resp.Webhooks = append(resp.Webhooks, toRecordWebhookProto(webhook))
+	}
+
+	return resp, nil
+}
+
+// DeleteRecordWebhook deletes a webhook owned by the caller.
+func (c *eventsCtlr) DeleteRecordWebhook(ctx context.Context, req *eventsv1.DeleteRecordWebhookRequest) (*eventsv1.DeleteRecordWebhookResponse, error) {
+	if c.webhooks == nil {
+		return nil, status.Error(codes.Unimplemented, "record webhooks are not supported by this server")
+	}
+
+	if err := c.webhooks.Delete(req.GetWebhookId(), callerID(ctx)); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &eventsv1.DeleteRecordWebhookResponse{}, nil
+}
+
+// callerID returns the SPIFFE ID of the caller, or an empty ID if
+// authentication is disabled.
+func callerID(ctx context.Context) string {
+	sid, ok := authn.SpiffeIDFromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	return sid.String()
+}
+
+// toRecordWebhookProto converts a record webhook to its API representation.
+// The secret of the webhook is never returned.
+func toRecordWebhookProto(webhook types.RecordWebhookObject) *eventsv1.RecordWebhook {
+	return &eventsv1.RecordWebhook{
+		WebhookId:   webhook.GetID(),
+		Cid:         webhook.GetCID(),
+		Url:         webhook.GetURL(),
+		EventTypes:  webhook.GetEventTypes(),
+		Owner:       webhook.GetOwner(),
+		CreatedTime: webhook.GetCreatedTime(),
+	}
+}
+
+// restrictNamespaces enforces that callers who are not allowed to listen across
+// namespaces only subscribe to events from their own namespace (trust domain).
+// If no namespace filter is given, it defaults to the caller's namespace.
+func (c *eventsCtlr) restrictNamespaces(ctx context.Context, req *eventsv1.ListenRequest) (*eventsv1.ListenRequest, error) {
+	if c.authorizer == nil {
+		return req, nil
+	}
+
+	sid, ok := authn.SpiffeIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "not authenticated")
+	}
+
+	namespace := sid.TrustDomain().String()
+
+	// API tokens are limited to their own namespace
+	if _, isToken := authn.TokenFromContext(ctx); !isToken {
+		allowed, err := c.authorizer.Authorize(namespace, authz.ListenAllNamespacesPermission)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to authorize event subscription: %v", err)
+		}
+
+		if allowed {
+			return req, nil
+		}
+	}
+
+	for _, filter := range req.GetNamespaceFilters() {
+		if filter != namespace {
+			return nil, status.Errorf(codes.PermissionDenied, "not allowed to listen to events from namespace %q", filter)
+		}
+	}
+
+	restricted, _ := proto.Clone(req).(*eventsv1.ListenRequest)
+	if !slices.Contains(restricted.GetNamespaceFilters(), namespace) {
+		restricted.NamespaceFilters = []string{namespace}
+	}
+
+	return restricted, nil
+}
+
+// restrictSubscription enforces the event subscription policies of the caller.
+// Requests for event types the caller may not subscribe to are rejected, and
+// requests without event types default to the allowed ones. Label prefix
+// restrictions are added to the filter expression of the request.
+func (c *eventsCtlr) restrictSubscription(ctx context.Context, req *eventsv1.ListenRequest) (*eventsv1.ListenRequest, error) {
+	if c.authorizer == nil {
+		return req, nil
+	}
+
+	restriction := c.authorizer.RestrictEventSubscription(callerID(ctx))
+	if restriction == nil {
+		return req, nil
+	}
+
+	for _, eventType := range req.GetEventTypes() {
+		if !restriction.AllowsEventType(eventType) {
+			return nil, status.Errorf(codes.PermissionDenied, "not allowed to listen to %s events", strings.TrimPrefix(eventType.String(), "EVENT_TYPE_"))
+		}
+	}
+
+	restricted, _ := proto.Clone(req).(*eventsv1.ListenRequest)
+	if len(restricted.GetEventTypes()) == 0 {
+		restricted.EventTypes = restriction.EventTypes
+	}
+
+	if len(restriction.LabelPrefixes) > 0 {
+		conditions := make([]string, 0, len(restriction.LabelPrefixes))
+		for _, prefix := range restriction.LabelPrefixes {
+			conditions = append(conditions, fmt.Sprintf("l.startsWith('%s')", prefix))
+		}
+
+		expression := fmt.Sprintf("event.labels.exists(l, %s)", strings.Join(conditions, " || "))
+		if restricted.GetFilterExpression() != "" {
+			expression = fmt.Sprintf("(%s) && %s", restricted.GetFilterExpression(), expression)
+		}
+
+		restricted.FilterExpression = expression
+	}
+
+	return restricted, nil
+}
@@ -7,11 +7,27 @@ import (
 	"context"
 	"errors"
 	"io"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
+	oasfv1alpha1 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/agntcy/oasf/types/v1alpha1"
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/server/authn"
+	"github.com/agntcy/dir/server/authz"
+	authzconfig "github.com/agntcy/dir/server/authz/config"
+	"github.com/agntcy/dir/server/database/utils"
 	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/types/adapters"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // mockListenServer implements EventService_ListenServer for testing.
@@ -38,7 +54,7 @@ func TestEventsControllerListen(t *testing.T) {
 	defer func() { _ = eventService.Stop() }()
 
 	// Create controller
-	controller := NewEventsController(eventService)
+	controller := NewEventsController(eventService, nil, nil, nil)
 
 	// Create mock stream
 	ctx, cancel := context.WithCancel(t.Context())
@@ -101,7 +117,7 @@ func TestEventsControllerListenWithFilters(t *testing.T) {
 
 	defer func() { _ = eventService.Stop() }()
 
-	controller := NewEventsController(eventService)
+	controller := NewEventsController(eventService, nil, nil, nil)
 
 	ctx, cancel := context.WithCancel(t.Context())
 	defer cancel()
@@ -153,7 +169,7 @@ func TestEventsControllerListenContextCancellation(t *testing.T) {
 
 	defer func() { _ = eventService.Stop() }()
 
-	controller := NewEventsController(eventService)
+	controller := NewEventsController(eventService, nil, nil, nil)
 
 	// Create context that's already cancelled
 	ctx, cancel := context.WithCancel(t.Context())
@@ -177,3 +193,403 @@ func TestEventsControllerListenContextCancellation(t *testing.T) {
 		t.Errorf("Expected 0 messages with cancelled context, got %d", len(mockStream.sentMsgs))
 	}
 }
+
+func TestEventsControllerListenReplay(t *testing.T) {
+	eventService := events.New()
+
+	defer func() { _ = eventService.Stop() }()
+
+	controller := NewEventsController(eventService, nil, nil, nil)
+
+	// Publish events before the client connects
+	eventService.Bus().RecordPushed("bafyreplay1", nil)
+	eventService.Bus().RecordPushed("bafyreplay2", nil)
+	eventService.Bus().WaitForAsyncPublish()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	mockStream := &mockListenServer{
+		ctx:      ctx,
+		sentMsgs: make([]*eventsv1.ListenResponse, 0),
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		req := &eventsv1.ListenRequest{
+			Since: timestamppb.New(time.Now().Add(-time.Minute)),
+		}
+		errCh <- controller.Listen(req, mockStream)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	eventService.Bus().RecordPushed("bafylive", nil)
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for Listen to return")
+	}
+
+	// Replayed events are sent first, followed by live events
+	var resourceIDs []string
+	for _, msg := range mockStream.sentMsgs {
+		resourceIDs = append(resourceIDs, msg.GetEvent().GetResourceId())
+	}
+
+	assert.Equal(t, []string{"bafyreplay1", "bafyreplay2", "bafylive"}, resourceIDs)
+}
+
+func TestEventsControllerListenInvalidExpression(t *testing.T) {
+	eventService := events.New()
+
+	defer func() { _ = eventService.Stop() }()
+
+	controller := NewEventsController(eventService, nil, nil, nil)
+
+	mockStream := &mockListenServer{
+		ctx:      t.Context(),
+		sentMsgs: make([]*eventsv1.ListenResponse, 0),
+	}
+
+	err := controller.Listen(&eventsv1.ListenRequest{FilterExpression: "event.typ == 'RECORD_PUSHED'"}, mockStream)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 0, eventService.Bus().SubscriberCount())
+}
+
+func TestEventsControllerRestrictNamespaces(t *testing.T) {
+	authorizer, err := authz.NewAuthorizer(authzconfig.Config{TrustDomain: "dir.com"})
+	require.NoError(t, err)
+
+	ctlr := &eventsCtlr{authorizer: authorizer}
+
+	contextFor := func(id string) context.Context {
+		return context.WithValue(t.Context(), authn.SpiffeIDContextKey, spiffeid.RequireFromString(id))
+	}
+
+	t.Run("own trust domain may listen to all namespaces", func(t *testing.T) {
+		req := &eventsv1.ListenRequest{NamespaceFilters: []string{"other.com"}}
+
+		got, err := ctlr.restrictNamespaces(contextFor("spiffe://dir.com/client"), req)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other.com"}, got.GetNamespaceFilters())
+	})
+
+	t.Run("other trust domain defaults to own namespace", func(t *testing.T) {
+		req := &eventsv1.ListenRequest{CidFilters: []string{"bafytest123"}}
+
+		got, err := ctlr.restrictNamespaces(contextFor("spiffe://other.com/client"), req)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other.com"}, got.GetNamespaceFilters())
+		assert.Equal(t, []string{"bafytest123"}, got.GetCidFilters())
+		assert.Empty(t, req.GetNamespaceFilters(), "request must not be modified")
+	})
+
+	t.Run("other trust domain cannot listen to other namespaces", func(t *testing.T) {
+		req := &eventsv1.ListenRequest{NamespaceFilters: []string{"other.com", "dir.com"}}
+
+		_, err := ctlr.restrictNamespaces(contextFor("spiffe://other.com/client"), req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("missing identity", func(t *testing.T) {
+		_, err := ctlr.restrictNamespaces(t.Context(), &eventsv1.ListenRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		req := &eventsv1.ListenRequest{}
+
+		got, err := (&eventsCtlr{}).restrictNamespaces(t.Context(), req)
+		require.NoError(t, err)
+		assert.Same(t, req, got)
+	})
+}
+
+func TestEventsControllerRestrictSubscription(t *testing.T) {
+	authorizer, err := authz.NewAuthorizer(authzconfig.Config{
+		TrustDomain: "dir.com",
+		EventSubscriptions: []authzconfig.EventSubscriptionPolicy{
+			{
+				Identities:    []string{"spiffe://dir.com/ci"},
+				EventTypes:    []string{"RECORD_PUSHED", "RECORD_PUBLISHED"},
+				LabelPrefixes: []string{"/skills/"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	ctlr := &eventsCtlr{authorizer: authorizer}
+
+	contextFor := func(id string) context.Context {
+		return context.WithValue(t.Context(), authn.SpiffeIDContextKey, spiffeid.RequireFromString(id))
+	}
+
+	t.Run("unrestricted identity", func(t *testing.T) {
+		req := &eventsv1.ListenRequest{}
+
+		got, err := ctlr.restrictSubscription(contextFor("spiffe://dir.com/admin"), req)
+		require.NoError(t, err)
+		assert.Same(t, req, got)
+	})
+
+	t.Run("defaults to allowed event types and label prefixes", func(t *testing.T) {
+		req := &eventsv1.ListenRequest{}
+
+		got, err := ctlr.restrictSubscription(contextFor("spiffe://dir.com/ci"), req)
+		require.NoError(t, err)
+		assert.Equal(t, []eventsv1.EventType{
+			eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED,
+			eventsv1.EventType_EVENT_TYPE_RECORD_PUBLISHED,
+		}, got.GetEventTypes())
+		assert.Equal(t, "event.labels.exists(l, l.startsWith('/skills/'))", got.GetFilterExpression())
+		assert.Empty(t, req.GetEventTypes(), "request must not be modified")
+	})
+
+	t.Run("label prefixes narrow filter expressions", func(t *testing.T) {
+		req := &eventsv1.ListenRequest{
+			EventTypes:       []eventsv1.EventType{eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED},
+			FilterExpression: "event.actor == 'ci' || event.actor == 'bot'",
+		}
+
+		got, err := ctlr.restrictSubscription(contextFor("spiffe://dir.com/ci"), req)
+		require.NoError(t, err)
+
+		filter, err := events.ExpressionFilter(got.GetFilterExpression())
+		require.NoError(t, err)
+
+		assert.True(t, filter(&events.Event{Actor: "ci", Labels: []string{"/skills/AI"}}))
+		assert.False(t, filter(&events.Event{Actor: "bot", Labels: []string{"/domains/research"}}))
+		assert.False(t, filter(&events.Event{Actor: "human", Labels: []string{"/skills/AI"}}))
+	})
+
+	t.Run("denied event types", func(t *testing.T) {
+		req := &eventsv1.ListenRequest{
+			EventTypes: []eventsv1.EventType{
+				eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED,
+				eventsv1.EventType_EVENT_TYPE_SYNC_CREATED,
+			},
+		}
+
+		_, err := ctlr.restrictSubscription(contextFor("spiffe://dir.com/ci"), req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+// mockWatchNameServer implements EventService_WatchNameServer for testing.
+type mockWatchNameServer struct {
+	eventsv1.EventService_WatchNameServer
+	ctx context.Context //nolint:containedctx // Needed for mock gRPC stream testing
+
+	mu       sync.Mutex
+	sentMsgs []*eventsv1.WatchNameResponse
+}
+
+func (m *mockWatchNameServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockWatchNameServer) Send(resp *eventsv1.WatchNameResponse) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sentMsgs = append(m.sentMsgs, resp)
+
+	return nil
+}
+
+func (m *mockWatchNameServer) messages() []*eventsv1.WatchNameResponse {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.sentMsgs)
+}
+
+// watchDatabase is a search database holding records in memory.
+type watchDatabase struct {
+	types.SearchDatabaseAPI
+
+	mu      sync.Mutex
+	records []types.Record
+}
+
+func (d *watchDatabase) add(record types.Record) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.records = append(d.records, record)
+}
+
+func (d *watchDatabase) GetRecords(opts ...types.FilterOption) ([]types.Record, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	filters := &types.RecordFilters{}
+	for _, opt := range opts {
+		opt(filters)
+	}
+
+	var result []types.Record
+
+	for _, record := range d.records {
+		data, err := record.GetRecordData()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(filters.CIDs) > 0 && !slices.Contains(filters.CIDs, record.GetCid()) {
+			continue
+		}
+
+		if filters.Name != "" && !utils.MatchWildcard(filters.Name, data.GetName()) {
+			continue
+		}
+
+		result = append(result, record)
+	}
+
+	return result, nil
+}
+
+func newWatchRecord(name, version, createdAt string) types.Record {
+	return adapters.NewRecordAdapter(corev1.New(&oasfv1alpha1.Record{
+		Name:          name,
+		Version:       version,
+		SchemaVersion: "0.7.0",
+		CreatedAt:     createdAt,
+	}))
+}
+
+func TestNameWatch(t *testing.T) {
+	v1 := newWatchRecord("example.org/agent", "v1.0.0", "2025-01-01T00:00:00Z")
+	v2 := newWatchRecord("example.org/agent", "1.10.0", "2025-01-02T00:00:00Z")
+	v19 := newWatchRecord("example.org/agent", "v1.9.0", "2025-01-03T00:00:00Z")
+	unversioned := newWatchRecord("example.org/agent", "latest", "2025-01-04T00:00:00Z")
+	other := newWatchRecord("other.org/agent", "v2.0.0", "2025-01-01T00:00:00Z")
+
+	watch := newNameWatch("example.org/*")
+
+	t.Run("first record becomes latest", func(t *testing.T) {
+		changed := watch.add(v1)
+		require.NotNil(t, changed)
+		assert.Equal(t, "example.org/agent", changed.GetName())
+		assert.Equal(t, v1.GetCid(), changed.GetCid())
+		assert.Empty(t, changed.GetPreviousCid())
+	})
+
+	t.Run("names not matching the pattern are ignored", func(t *testing.T) {
+		assert.Nil(t, watch.add(other))
+		assert.Nil(t, watch.remove(other.GetCid()))
+	})
+
+	t.Run("higher version becomes latest", func(t *testing.T) {
+		changed := watch.add(v2)
+		require.NotNil(t, changed)
+		assert.Equal(t, v2.GetCid(), changed.GetCid())
+		assert.Equal(t, "1.10.0", changed.GetVersion())
+		assert.Equal(t, v1.GetCid(), changed.GetPreviousCid())
+	})
+
+	t.Run("lower or invalid versions do not change latest", func(t *testing.T) {
+		assert.Nil(t, watch.add(v19))
+		assert.Nil(t, watch.add(unversioned))
+	})
+
+	t.Run("deleting latest falls back to the next version", func(t *testing.T) {
+		changed := watch.remove(v2.GetCid())
+		require.NotNil(t, changed)
+		assert.Equal(t, v19.GetCid(), changed.GetCid())
+		assert.Equal(t, v2.GetCid(), changed.GetPreviousCid())
+	})
+
+	t.Run("deleting other records does not change latest", func(t *testing.T) {
+		assert.Nil(t, watch.remove(v1.GetCid()))
+	})
+
+	t.Run("deleting all records clears latest", func(t *testing.T) {
+		require.NotNil(t, watch.remove(v19.GetCid()))
+
+		changed := watch.remove(unversioned.GetCid())
+		require.NotNil(t, changed)
+		assert.Empty(t, changed.GetCid())
+		assert.Equal(t, unversioned.GetCid(), changed.GetPreviousCid())
+		assert.Empty(t, watch.initial())
+	})
+}
+
+func TestEventsControllerWatchName(t *testing.T) {
+	eventService := events.New()
+
+	defer func() { _ = eventService.Stop() }()
+
+	v1 := newWatchRecord("example.org/agent", "v1.0.0", "2025-01-01T00:00:00Z")
+	v2 := newWatchRecord("example.org/agent", "v2.0.0", "2025-01-02T00:00:00Z")
+	other := newWatchRecord("example.org/other", "v1.0.0", "2025-01-01T00:00:00Z")
+
+	db := &watchDatabase{}
+	db.add(v1)
+	db.add(other)
+
+	controller := NewEventsController(eventService, db, nil, nil)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	mockStream := &mockWatchNameServer{ctx: ctx}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- controller.WatchName(&eventsv1.WatchNameRequest{Name: "example.org/agent"}, mockStream)
+	}()
+
+	// The current latest record is sent first
+	require.Eventually(t, func() bool { return len(mockStream.messages()) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, v1.GetCid(), mockStream.messages()[0].GetCid())
+
+	// Push events are published before records are indexed
+	eventService.Bus().RecordPushed(v2.GetCid(), nil)
+	eventService.Bus().RecordPushed(other.GetCid(), nil)
+	time.Sleep(50 * time.Millisecond)
+	db.add(v2)
+
+	require.Eventually(t, func() bool { return len(mockStream.messages()) == 2 }, 2*time.Second, 10*time.Millisecond)
+
+	changed := mockStream.messages()[1]
+	assert.Equal(t, "example.org/agent", changed.GetName())
+	assert.Equal(t, v2.GetCid(), changed.GetCid())
+	assert.Equal(t, v1.GetCid(), changed.GetPreviousCid())
+
+	eventService.Bus().RecordDeleted(v2.GetCid())
+
+	require.Eventually(t, func() bool { return len(mockStream.messages()) == 3 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, v1.GetCid(), mockStream.messages()[2].GetCid())
+
+	cancel()
+
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for WatchName to return")
+	}
+}
+
+func TestEventsControllerWatchNameRequiresName(t *testing.T) {
+	eventService := events.New()
+
+	defer func() { _ = eventService.Stop() }()
+
+	controller := NewEventsController(eventService, &watchDatabase{}, nil, nil)
+
+	err := controller.WatchName(&eventsv1.WatchNameRequest{}, &mockWatchNameServer{ctx: t.Context()})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,188 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/server/database/utils"
+	"github.com/agntcy/dir/server/types"
+	"golang.org/x/mod/semver"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// watchedRecord is a record tracked by a name watch.
+type watchedRecord struct {
+	cid      string
+	name     string
+	version  string
+	storedAt time.Time
+}
+
+// nameWatch tracks the records of the names matching a pattern
+// and resolves the latest record of each name.
+type nameWatch struct {
+	pattern string
+
+	// records holds the tracked records by name and CID.
+	records map[string]map[string]watchedRecord
+
+	// names holds the names of the tracked records by CID.
+	names map[string]string
+
+	// latest holds the latest record of each name.
+	latest map[string]watchedRecord
+}
+
+func newNameWatch(pattern string) *nameWatch {
+	return &nameWatch{
+		pattern: pattern,
+		records: make(map[string]map[string]watchedRecord),
+		names:   make(map[string]string),
+		latest:  make(map[string]watchedRecord),
+	}
+}
+
+// add tracks a record if its name matches the pattern.
+// It returns the change of the latest record of its name, or nil if unchanged.
+func (w *nameWatch) add(record types.Record) *eventsv1.WatchNameResponse {
+	data, err := record.GetRecordData()
+	if err != nil || !utils.MatchWildcard(w.pattern, data.GetName()) {
+		return nil
+	}
+
+	// Records without a valid timestamp are ranked as the oldest
+	storedAt, _ := time.Parse(time.RFC3339, data.GetCreatedAt())
+
+	tracked := watchedRecord{
+		cid:      record.GetCid(),
+		name:     data.GetName(),
+		version:  data.GetVersion(),
+		storedAt: storedAt,
+	}
+
+	if _, ok := w.records[tracked.name]; !ok {
+		w.records[tracked.name] = make(map[string]watchedRecord)
+	}
+
+	w.records[tracked.name][tracked.cid] = tracked
+	w.names[tracked.cid] = tracked.name
+
+	return w.update(tracked.name)
+}
+
+// remove stops tracking a record.
+// It returns the change of the latest record of its name, or nil if unchanged.
+func (w *nameWatch) remove(cid string) *eventsv1.WatchNameResponse {
+	name, ok := w.names[cid]
+	if !ok {
+		return nil
+	}
+
+	delete(w.names, cid)
+	delete(w.records[name], cid)
+
+	if len(w.records[name]) == 0 {
+		delete(w.records, name)
+	}
+
+	return w.update(name)
+}
+
+// initial returns the latest record of each tracked name, sorted by name.
+func (w *nameWatch) initial() []*eventsv1.WatchNameResponse {
+	responses := make([]*eventsv1.WatchNameResponse, 0, len(w.latest))
+
+	for _, latest := range w.latest {
+		responses = append(responses, latestResponse(latest, ""))
+	}
+
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].GetName() < responses[j].GetName()
+	})
+
+	return responses
+}
+
+// update resolves the latest record of a name.
+// It returns the change of the latest record, or nil if unchanged.
+func (w *nameWatch) update(name string) *eventsv1.WatchNameResponse {
+	var (
+		newest watchedRecord
+		found  bool
+	)
+
+	for _, record := range w.records[name] {
+		if !found || isNewerRecord(record, newest) {
+			newest, found = record, true
+		}
+	}
+
+	previous, hadPrevious := w.latest[name]
+
+	switch {
+	case !found && !hadPrevious:
+		return nil
+	case !found:
+		delete(w.latest, name)
+
+		return &eventsv1.WatchNameResponse{
+			Name:        name,
+			PreviousCid: previous.cid,
+			Timestamp:   timestamppb.Now(),
+		}
+	case hadPrevious && previous.cid == newest.cid:
+		return nil
+	}
+
+	w.latest[name] = newest
+
+	return latestResponse(newest, previous.cid)
+}
+
+func latestResponse(latest watchedRecord, previousCID string) *eventsv1.WatchNameResponse {
+	return &eventsv1.WatchNameResponse{
+		Name:        latest.name,
+		Cid:         latest.cid,
+		Version:     latest.version,
+		PreviousCid: previousCID,
+		Timestamp:   timestamppb.Now(),
+	}
+}
+
+// isNewerRecord reports whether record a is newer than record b.
+// Records are ordered by semantic version, with versioned records ranking above
+// the others, then by the time they were stored, then by CID for a stable order.
+func isNewerRecord(a, b watchedRecord) bool {
+	aVersion, bVersion := canonicalRecordVersion(a.version), canonicalRecordVersion(b.version)
+	aValid, bValid := semver.IsValid(aVersion), semver.IsValid(bVersion)
+
+	if aValid != bValid {
+		return aValid
+	}
+
+	if aValid {
+		if cmp := semver.Compare(aVersion, bVersion); cmp != 0 {
+			return cmp > 0
+		}
+	}
+
+	if !a.storedAt.Equal(b.storedAt) {
+		return a.storedAt.After(b.storedAt)
+	}
+
+	return a.cid > b.cid
+}
+
+// canonicalRecordVersion converts record versions such as 1.2.0 and v1.2.0 to the same semver form.
+func canonicalRecordVersion(version string) string {
+	if version == "" || strings.HasPrefix(version, "v") {
+		return version
+	}
+
+	return "v" + version
+}
@@ -0,0 +1,49 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// validateReadMask checks that the read mask of a request only lists fields of its responses.
+func validateReadMask(mask *fieldmaskpb.FieldMask, response proto.Message) error {
+	if mask == nil || mask.IsValid(response) {
+		return nil
+	}
+
+	return status.Errorf(codes.InvalidArgument, "invalid read mask: %v", mask.GetPaths()) //nolint:wrapcheck
+}
+
+// applyReadMask clears the fields of a response that are not listed in the read mask.
+// Fields selected by nested paths are kept whole. Responses are left unchanged when the mask is empty.
+func applyReadMask(mask *fieldmaskpb.FieldMask, msg proto.Message) {
+	if len(mask.GetPaths()) == 0 {
+		return
+	}
+
+	var unmasked []protoreflect.FieldDescriptor
+
+	m := msg.ProtoReflect()
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !slices.ContainsFunc(mask.GetPaths(), func(path string) bool {
+			return path == string(fd.Name()) || strings.HasPrefix(path, string(fd.Name())+".")
+		}) {
+			unmasked = append(unmasked, fd)
+		}
+
+		return true
+	})
+
+	for _, fd := range unmasked {
+		m.Clear(fd)
+	}
+}
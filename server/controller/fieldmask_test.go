@@ -0,0 +1,52 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestValidateReadMask(t *testing.T) {
+	require.NoError(t, validateReadMask(nil, &searchv1.SearchResponse{}))
+	require.NoError(t, validateReadMask(&fieldmaskpb.FieldMask{Paths: []string{"record_cid", "name"}}, &searchv1.SearchResponse{}))
+
+	err := validateReadMask(&fieldmaskpb.FieldMask{Paths: []string{"unknown"}}, &searchv1.SearchResponse{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestApplyReadMask(t *testing.T) {
+	resp := &searchv1.SearchResponse{RecordCid: "bafy1", Name: "agent", Version: "v1", Skills: []string{"audio"}}
+
+	applyReadMask(&fieldmaskpb.FieldMask{Paths: []string{"record_cid", "name"}}, resp)
+	assert.Equal(t, "bafy1", resp.GetRecordCid())
+	assert.Equal(t, "agent", resp.GetName())
+	assert.Empty(t, resp.GetVersion())
+	assert.Empty(t, resp.GetSkills())
+
+	// Empty masks return all fields
+	item := &routingv1.ListResponse{RecordRef: &corev1.RecordRef{Cid: "bafy1"}, Watermark: "watermark"}
+
+	applyReadMask(nil, item)
+	assert.Equal(t, "watermark", item.GetWatermark())
+
+	// Nested paths keep the whole field
+	applyReadMask(&fieldmaskpb.FieldMask{Paths: []string{"record_ref.cid"}}, item)
+	assert.Empty(t, item.GetWatermark())
+	assert.Equal(t, "bafy1", item.GetRecordRef().GetCid())
+}
+
+func TestRecordDataFields(t *testing.T) {
+	assert.Empty(t, recordDataFields(searchReadMask(nil, false)))
+	assert.Equal(t, []string{"name", "skills"},
+		recordDataFields(&fieldmaskpb.FieldMask{Paths: []string{"record_cid", "name", "skills"}}))
+}
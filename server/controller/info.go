@@ -0,0 +1,120 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"slices"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/api/version"
+	"github.com/agntcy/dir/server/apis"
+	"github.com/agntcy/dir/server/store/probe"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/validation"
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var infoLogger = logging.Logger("controller/info")
+
+type infoCtrl struct {
+	corev1.UnimplementedInfoServiceServer
+	opts           types.APIOptions
+	schemaVersions *validation.SchemaVersionPolicy
+	storeProbe     *probe.Result
+}
+
+// NewInfoController creates a new info service controller.
+// If storeProbe is nil, the store provider was not probed at startup.
+func NewInfoController(opts types.APIOptions, schemaVersions *validation.SchemaVersionPolicy, storeProbe *probe.Result) corev1.InfoServiceServer {
+	return &infoCtrl{
+		opts:           opts,
+		schemaVersions: schemaVersions,
+		storeProbe:     storeProbe,
+	}
+}
+
+func (c *infoCtrl) GetServerInfo(_ context.Context, _ *corev1.GetServerInfoRequest) (*corev1.GetServerInfoResponse, error) {
+	infoLogger.Debug("Called info controller's GetServerInfo method")
+
+	cfg := c.opts.Config()
+	connection := cfg.Connection.WithDefaults()
+
+	limits := &corev1.ServerLimits{
+		MaxRecvMsgSize:       uint64(max(connection.MaxRecvMsgSize, 0)), //nolint:gosec // non-negative
+		MaxSendMsgSize:       uint64(max(connection.MaxSendMsgSize, 0)), //nolint:gosec // non-negative
+		MaxConcurrentStreams: connection.MaxConcurrentStreams,
+	}
+
+	if cfg.RateLimit.Enabled {
+		limits.PerClientRps = cfg.RateLimit.PerClientRPS
+		limits.PerClientBurst = uint32(max(cfg.RateLimit.PerClientBurst, 0)) //nolint:gosec // non-negative
+		limits.GlobalRps = cfg.RateLimit.GlobalRPS
+		limits.GlobalBurst = uint32(max(cfg.RateLimit.GlobalBurst, 0)) //nolint:gosec // non-negative
+	}
+
+	var storeProbe *corev1.StoreProbe
+	if c.storeProbe != nil {
+		storeProbe = c.storeProbe.ToProto()
+	}
+
+	return &corev1.GetServerInfoResponse{
+		AcceptedSchemaVersions: c.schemaVersions.AcceptedVersions(),
+		BuildInfo: &corev1.BuildInfo{
+			Version:    version.Version,
+			CommitHash: version.CommitHash,
+			GoVersion:  runtime.Version(),
+		},
+		ApiVersions:             []string{types.APIVersion},
+		SupportedSchemaVersions: slices.Clone(types.SupportedSchemaVersions),
+		Features: &corev1.ServerFeatures{
+			Authn: cfg.Authn.Enabled,
+			Authz: cfg.Authz.Enabled,
+			// Events are only delivered to connected subscribers
+			EventsPersistence: false,
+			// Event subscriptions are restricted to the caller's namespace by the authorizer
+			Namespaces: cfg.Authz.Enabled,
+			RateLimit:  cfg.RateLimit.Enabled,
+			// Index-only nodes do not accept pushes
+			ReadOnly: cfg.Routing.ReadOnly || cfg.Proxy.IndexOnly,
+		},
+		Limits:     limits,
+		StoreProbe: storeProbe,
+	}, nil
+}
+
+func (c *infoCtrl) GetApiDescriptor(_ context.Context, req *corev1.GetApiDescriptorRequest) (*corev1.GetApiDescriptorResponse, error) {
+	infoLogger.Debug("Called info controller's GetApiDescriptor method", "req", req)
+
+	var (
+		content []byte
+		err     error
+	)
+
+	switch req.GetFormat() {
+	case corev1.ApiDescriptorFormat_API_DESCRIPTOR_FORMAT_UNSPECIFIED, corev1.ApiDescriptorFormat_API_DESCRIPTOR_FORMAT_OPENAPI:
+		content, err = apis.OpenAPI()
+	case corev1.ApiDescriptorFormat_API_DESCRIPTOR_FORMAT_JSON_SCHEMA:
+		content, err = apis.JSONSchema(req.GetMessage())
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported API descriptor format: %s", req.GetFormat())
+	}
+
+	if errors.Is(err, apis.ErrUnknownMessage) {
+		return nil, status.Errorf(codes.NotFound, "failed to get API descriptor: %v", err)
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get API descriptor: %v", err)
+	}
+
+	return &corev1.GetApiDescriptorResponse{
+		ContentType: apis.ContentType,
+		Content:     content,
+	}, nil
+}
@@ -0,0 +1,95 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/operations"
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultWaitTimeout is the wait timeout used when WaitOperation requests do not set one.
+	defaultWaitTimeout = time.Minute
+
+	// maxWaitTimeout caps the wait timeout of WaitOperation requests.
+	maxWaitTimeout = 5 * time.Minute
+)
+
+var operationLogger = logging.Logger("controller/operation")
+
+type operationCtrl struct {
+	corev1.UnimplementedOperationServiceServer
+	operations *operations.Manager
+}
+
+// NewOperationController creates a new operation service controller.
+func NewOperationController(manager *operations.Manager) corev1.OperationServiceServer {
+	return &operationCtrl{
+		operations: manager,
+	}
+}
+
+func (c *operationCtrl) GetOperation(ctx context.Context, req *corev1.GetOperationRequest) (*corev1.Operation, error) {
+	operationLogger.Debug("Called operation controller's GetOperation method", "req", req)
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "operation id is required") //nolint:wrapcheck
+	}
+
+	return c.operations.Get(req.GetId(), callerID(ctx)) //nolint:wrapcheck
+}
+
+func (c *operationCtrl) ListOperations(req *corev1.ListOperationsRequest, srv corev1.OperationService_ListOperationsServer) error {
+	operationLogger.Debug("Called operation controller's ListOperations method", "req", req)
+
+	for _, op := range c.operations.List(callerID(srv.Context()), req.GetType(), req.GetRunningOnly()) {
+		if err := srv.Send(op); err != nil {
+			return fmt.Errorf("failed to send operation: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *operationCtrl) WaitOperation(ctx context.Context, req *corev1.WaitOperationRequest) (*corev1.Operation, error) {
+	operationLogger.Debug("Called operation controller's WaitOperation method", "req", req)
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "operation id is required") //nolint:wrapcheck
+	}
+
+	timeout := defaultWaitTimeout
+
+	if req.GetTimeout() != nil {
+		if err := req.GetTimeout().CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timeout: %v", err)
+		}
+
+		timeout = req.GetTimeout().AsDuration()
+		if timeout <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "timeout must be positive") //nolint:wrapcheck
+		}
+	}
+
+	timeout = min(timeout, maxWaitTimeout)
+
+	return c.operations.Wait(ctx, req.GetId(), callerID(ctx), timeout) //nolint:wrapcheck
+}
+
+func (c *operationCtrl) CancelOperation(ctx context.Context, req *corev1.CancelOperationRequest) (*corev1.Operation, error) {
+	operationLogger.Debug("Called operation controller's CancelOperation method", "req", req)
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "operation id is required") //nolint:wrapcheck
+	}
+
+	return c.operations.Cancel(req.GetId(), callerID(ctx)) //nolint:wrapcheck
+}
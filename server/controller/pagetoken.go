@@ -0,0 +1,110 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	pageTokenVersion = "v1"
+
+	// defaultSearchPageSize is the page size of paginated searches that do not set one.
+	defaultSearchPageSize = 100
+
+	// maxSearchPageSize caps the page size of paginated searches.
+	maxSearchPageSize = 1000
+)
+
+// pageToken is the position of a paginated search, carried between pages.
+type pageToken struct {
+	// snapshotTime is the time of the snapshot of the search index the first page was read at
+	snapshotTime time.Time
+
+	// fingerprint identifies the search the token was returned for
+	fingerprint string
+
+	// afterCID is the last CID of the previous page
+	afterCID string
+}
+
+// encode returns the opaque form of the token returned to clients.
+func (t pageToken) encode() string {
+	value := strings.Join([]string{
+		pageTokenVersion,
+		strconv.FormatInt(t.snapshotTime.UnixNano(), 10),
+		t.fingerprint,
+		t.afterCID,
+	}, ":")
+
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+// parsePageToken decodes the page token of a search with the given fingerprint.
+func parsePageToken(token, fingerprint string) (pageToken, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pageToken{}, status.Error(codes.InvalidArgument, "invalid page token") //nolint:wrapcheck
+	}
+
+	parts := strings.Split(string(decoded), ":")
+	if len(parts) != 4 || parts[0] != pageTokenVersion || parts[3] == "" { //nolint:mnd
+		return pageToken{}, status.Error(codes.InvalidArgument, "invalid page token") //nolint:wrapcheck
+	}
+
+	nanos, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return pageToken{}, status.Error(codes.InvalidArgument, "invalid page token") //nolint:wrapcheck
+	}
+
+	if parts[2] != fingerprint {
+		return pageToken{}, status.Error(codes.InvalidArgument, "page token was returned for a different search") //nolint:wrapcheck
+	}
+
+	return pageToken{
+		snapshotTime: time.Unix(0, nanos),
+		fingerprint:  parts[2],
+		afterCID:     parts[3],
+	}, nil
+}
+
+// searchFingerprint identifies the records matched by a search, so that page tokens
+// cannot be used to continue a different search.
+func searchFingerprint(req *searchv1.SearchRequest, updatedSince time.Time) string {
+	hash := sha256.New()
+
+	for _, query := range req.GetQueries() {
+		data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(query)
+		hash.Write(data)
+		hash.Write([]byte{0})
+	}
+
+	if !updatedSince.IsZero() {
+		hash.Write([]byte(strconv.FormatInt(updatedSince.UnixNano(), 10)))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)[:8])
+}
+
+// searchPageSize returns the page size of a paginated search.
+func searchPageSize(requested uint32) int {
+	switch {
+	case requested == 0:
+		return defaultSearchPageSize
+	case requested > maxSearchPageSize:
+		return maxSearchPageSize
+	default:
+		return int(requested)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParsePageToken(t *testing.T) {
+	req := &searchv1.SearchRequest{
+		Queries: []*searchv1.RecordQuery{{Type: searchv1.RecordQueryType_RECORD_QUERY_TYPE_NAME, Value: "web*"}},
+	}
+	fingerprint := searchFingerprint(req, time.Time{})
+
+	token := pageToken{snapshotTime: time.Now(), fingerprint: fingerprint, afterCID: "bafy-last"}
+
+	parsed, err := parsePageToken(token.encode(), fingerprint)
+	require.NoError(t, err)
+	assert.True(t, parsed.snapshotTime.Equal(token.snapshotTime))
+	assert.Equal(t, "bafy-last", parsed.afterCID)
+
+	// Tokens cannot continue a search with other queries or another updated since time
+	other := &searchv1.SearchRequest{
+		Queries: []*searchv1.RecordQuery{{Type: searchv1.RecordQueryType_RECORD_QUERY_TYPE_NAME, Value: "api*"}},
+	}
+
+	_, err = parsePageToken(token.encode(), searchFingerprint(other, time.Time{}))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = parsePageToken(token.encode(), searchFingerprint(req, time.Now()))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	for _, invalid := range []string{"not-a-token", newWatermark(time.Now())} {
+		_, err = parsePageToken(invalid, fingerprint)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), invalid)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	pagination := &searchPagination{size: 2, token: pageToken{snapshotTime: time.Now(), fingerprint: "fp"}}
+	identity := func(cid string) string { return cid }
+
+	// One more result than the page size was read, so there is a next page
+	page, next := paginate([]string{"a", "b", "c"}, pagination, identity)
+	assert.Equal(t, []string{"a", "b"}, page)
+
+	token, err := parsePageToken(next, "fp")
+	require.NoError(t, err)
+	assert.Equal(t, "b", token.afterCID)
+
+	// The last page has no next page token
+	page, next = paginate([]string{"c"}, pagination, identity)
+	assert.Equal(t, []string{"c"}, page)
+	assert.Empty(t, next)
+
+	// Results are unchanged when not paginated
+	page, next = paginate([]string{"a", "b", "c"}, nil, identity)
+	assert.Len(t, page, 3)
+	assert.Empty(t, next)
+}
+
+func TestValidatePagination(t *testing.T) {
+	pageSize := uint32(10)
+	limit := uint32(10)
+
+	require.NoError(t, validatePagination(&searchv1.SearchRequest{Limit: &limit}, false))
+	require.NoError(t, validatePagination(&searchv1.SearchRequest{PageSize: &pageSize}, true))
+
+	for _, req := range []*searchv1.SearchRequest{
+		{PageSize: &pageSize, Limit: &limit},
+		{PageSize: &pageSize, PreferredRegions: []string{"eu-west-1"}},
+		{PageToken: "token", SnapshotTime: timestamppb.Now()},
+	} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(validatePagination(req, true)), req.String())
+	}
+
+	assert.Equal(t, maxSearchPageSize, searchPageSize(maxSearchPageSize+1))
+	assert.Equal(t, defaultSearchPageSize, searchPageSize(0))
+}
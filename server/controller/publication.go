@@ -6,6 +6,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
 	"github.com/agntcy/dir/server/types"
@@ -19,19 +20,20 @@ var publicationLogger = logging.Logger("controller/publication")
 // publicationCtlr implements the PublicationService gRPC interface.
 type publicationCtlr struct {
 	routingv1.UnimplementedPublicationServiceServer
-	db   types.DatabaseAPI
-	opts types.APIOptions
+	db          types.DatabaseAPI
+	publication types.PublicationAPI
 }
 
 // NewPublicationController creates a new publication controller.
-func NewPublicationController(db types.DatabaseAPI, opts types.APIOptions) routingv1.PublicationServiceServer {
+// Publications are created through the publication service.
+func NewPublicationController(db types.DatabaseAPI, publication types.PublicationAPI) routingv1.PublicationServiceServer {
 	return &publicationCtlr{
-		db:   db,
-		opts: opts,
+		db:          db,
+		publication: publication,
 	}
 }
 
-func (c *publicationCtlr) CreatePublication(_ context.Context, req *routingv1.PublishRequest) (*routingv1.CreatePublicationResponse, error) {
+func (c *publicationCtlr) CreatePublication(ctx context.Context, req *routingv1.PublishRequest) (*routingv1.CreatePublicationResponse, error) {
 	publicationLogger.Debug("Called publication controller's CreatePublication method")
 
 	// Validate the publish request
@@ -53,7 +55,11 @@ func (c *publicationCtlr) CreatePublication(_ context.Context, req *routingv1.Pu
 		return nil, status.Errorf(codes.InvalidArgument, "invalid publish request: must specify record_refs, queries, or all_records")
 	}
 
-	id, err := c.db.CreatePublication(req)
+	// Origins are only set by the server for automatic publications
+	req.Origin = nil
+
+	// Unless confirmed, the publication is held back for the configured settle delay
+	id, err := c.publication.CreatePublication(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create publication: %w", err)
 	}
@@ -84,6 +90,8 @@ func (c *publicationCtlr) ListPublications(req *routingv1.ListPublicationsReques
 			Status:         publication.GetStatus(),
 			CreatedTime:    publication.GetCreatedTime(),
 			LastUpdateTime: publication.GetLastUpdateTime(),
+			Schedule:       publication.GetSchedule(),
+			SettleUntil:    formatSettleUntil(publication.GetSettleUntil()),
 		}); err != nil {
 			return fmt.Errorf("failed to send publication object: %w", err)
 		}
@@ -111,5 +119,65 @@ func (c *publicationCtlr) GetPublication(_ context.Context, req *routingv1.GetPu
 		Status:         publicationObj.GetStatus(),
 		CreatedTime:    publicationObj.GetCreatedTime(),
 		LastUpdateTime: publicationObj.GetLastUpdateTime(),
+		Schedule:       publicationObj.GetSchedule(),
+		SettleUntil:    formatSettleUntil(publicationObj.GetSettleUntil()),
 	}, nil
 }
+
+func (c *publicationCtlr) ConfirmPublication(_ context.Context, req *routingv1.ConfirmPublicationRequest) (*routingv1.ConfirmPublicationResponse, error) {
+	publicationLogger.Debug("Called publication controller's ConfirmPublication method", "req", req)
+
+	if req.GetPublicationId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "publication_id cannot be empty")
+	}
+
+	publicationObj, err := c.db.GetPublicationByID(req.GetPublicationId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "publication %s not found: %v", req.GetPublicationId(), err)
+	}
+
+	// Only pending publications are held back
+	if publicationObj.GetStatus() != routingv1.PublicationStatus_PUBLICATION_STATUS_PENDING {
+		return nil, status.Errorf(codes.FailedPrecondition, "publication %s is not pending: %s", req.GetPublicationId(), publicationObj.GetStatus())
+	}
+
+	// Embargoes cannot be skipped by confirming publications
+	for _, ref := range publicationObj.GetRequest().GetRecordRefs().GetRefs() {
+		embargoUntil, _, err := c.db.GetRecordEmbargo(ref.GetCid())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get record embargo: %v", err)
+		}
+
+		if embargoUntil.After(time.Now()) {
+			return nil, status.Errorf(codes.FailedPrecondition, "record %s is under embargo until %s", ref.GetCid(), embargoUntil.Format(time.RFC3339))
+		}
+	}
+
+	if err := c.db.ConfirmPublication(req.GetPublicationId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to confirm publication: %v", err)
+	}
+
+	publicationLogger.Info("Publication confirmed", "publication_id", req.GetPublicationId())
+
+	return &routingv1.ConfirmPublicationResponse{}, nil
+}
+
+func (c *publicationCtlr) GetPublicationSummary(_ context.Context, req *routingv1.GetPublicationSummaryRequest) (*routingv1.GetPublicationSummaryResponse, error) {
+	publicationLogger.Debug("Called publication controller's GetPublicationSummary method", "req", req)
+
+	summary, err := c.db.SummarizePublications(time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to summarize publications: %v", err)
+	}
+
+	return summary.ToProto(), nil
+}
+
+// formatSettleUntil formats the settle time of a publication, or returns an empty string if it is not delayed.
+func formatSettleUntil(settleUntil time.Time) string {
+	if settleUntil.IsZero() {
+		return ""
+	}
+
+	return settleUntil.Format(time.RFC3339)
+}
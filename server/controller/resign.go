@@ -0,0 +1,302 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	databaseutils "github.com/agntcy/dir/server/database/utils"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/operations"
+	"github.com/agntcy/dir/server/signpolicy"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/utils/cosign"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ResignOperationType is the type of the operations started by Resign requests.
+const ResignOperationType = "resign"
+
+// resignSigner signs the payloads of records with the provider of a Resign request.
+type resignSigner struct {
+	// publicKey is the PEM-encoded public key of a key provider, used to skip the records
+	// already signed with it. It is empty for OIDC providers, whose certificates are
+	// issued for every signature.
+	publicKey string
+
+	sign func(ctx context.Context, payload []byte) (*resignedPayload, error)
+}
+
+// resignedPayload is a payload signature along with the material it verifies against.
+type resignedPayload struct {
+	signature   string
+	publicKey   string
+	certificate string
+}
+
+// newResignSigner creates the signer of a Resign request provider.
+func newResignSigner(provider *signv1.SignRequestProvider) (*resignSigner, error) {
+	switch {
+	case provider.GetKey() != nil:
+		key := provider.GetKey()
+
+		password := key.GetPassword()
+		if password == nil {
+			password = []byte("") // Empty password is valid for cosign.
+		}
+
+		keypair, err := cosign.LoadKeypair(key.GetPrivateKey(), password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load private key: %w", err)
+		}
+
+		publicKey, err := keypair.GetPublicKeyPem()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get public key: %w", err)
+		}
+
+		return &resignSigner{
+			publicKey: publicKey,
+			sign: func(ctx context.Context, payload []byte) (*resignedPayload, error) {
+				result, err := cosign.SignBlobWithKey(ctx, &cosign.SignBlobKeyOptions{
+					Payload:    payload,
+					PrivateKey: key.GetPrivateKey(),
+					Password:   password,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to sign with key: %w", err)
+				}
+
+				return &resignedPayload{signature: result.Signature, publicKey: result.PublicKey}, nil
+			},
+		}, nil
+
+	case provider.GetOidc() != nil:
+		oidc := provider.GetOidc()
+		if oidc.GetIdToken() == "" {
+			return nil, errors.New("OIDC ID token must be set")
+		}
+
+		return &resignSigner{
+			sign: func(ctx context.Context, payload []byte) (*resignedPayload, error) {
+				result, err := cosign.SignBlobWithOIDC(ctx, &cosign.SignBlobOIDCOptions{
+					Payload:         payload,
+					IDToken:         oidc.GetIdToken(),
+					FulcioURL:       oidc.GetOptions().GetFulcioUrl(),
+					RekorURL:        oidc.GetOptions().GetRekorUrl(),
+					TimestampURL:    oidc.GetOptions().GetTimestampUrl(),
+					OIDCProviderURL: oidc.GetOptions().GetOidcProviderUrl(),
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to sign with OIDC: %w", err)
+				}
+
+				return &resignedPayload{
+					signature:   result.Signature,
+					publicKey:   result.PublicKey,
+					certificate: result.Certificate,
+				}, nil
+			},
+		}, nil
+
+	default:
+		return nil, errors.New("signing provider must be set")
+	}
+}
+
+// resignStatus is the outcome of re-signing a single record.
+type resignStatus int
+
+const (
+	resignSigned resignStatus = iota
+	resignSkipped
+	resignFailed
+)
+
+// Resign starts an operation signing the records matching the request queries with its provider.
+func (s *signCtrl) Resign(ctx context.Context, req *signv1.ResignRequest) (*signv1.ResignResponse, error) {
+	signLogger.Debug("Resign request received")
+
+	// Re-signing acts on records of all owners, so it is reserved to admins
+	if s.authnConfig.Enabled && !s.authnConfig.IsAdmin(callerID(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "only admins can re-sign records") //nolint:wrapcheck
+	}
+
+	if len(req.GetQueries()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one query is required to select the records to re-sign") //nolint:wrapcheck
+	}
+
+	filters, err := databaseutils.QueryToFilters(req.GetQueries())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid queries: %v", err)
+	}
+
+	signer, err := newResignSigner(req.GetProvider())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid signing provider: %v", err)
+	}
+
+	refStore, ok := s.store.(types.ReferrerStoreAPI)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "referrer storage not supported by current store implementation") //nolint:wrapcheck
+	}
+
+	// Events are emitted on behalf of the caller, as the operation outlives the request
+	eventBus := s.eventBus.ForContext(ctx)
+
+	id, err := s.operations.Start(ResignOperationType, callerID(ctx), func(ctx context.Context, progress *operations.Progress) (proto.Message, error) {
+		return s.resign(ctx, refStore, filters, signer, req.GetFailFast(), eventBus, progress)
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &signv1.ResignResponse{OperationId: id}, nil
+}
+
+// resign signs the records matching the filters, and summarizes the records signed, skipped and failed.
+func (s *signCtrl) resign(ctx context.Context, refStore types.ReferrerStoreAPI, filters []types.FilterOption, signer *resignSigner, failFast bool, eventBus *events.SafeEventBus, progress *operations.Progress) (*signv1.ResignResponse, error) {
+	cids, err := s.db.GetRecordCIDs(filters...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search records to re-sign: %v", err)
+	}
+
+	progress.SetTotal(len(cids))
+
+	resp := &signv1.ResignResponse{}
+
+	for _, cid := range cids {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		switch s.resignRecord(ctx, refStore, cid, signer, eventBus) {
+		case resignSigned:
+			resp.SignedCount++
+		case resignSkipped:
+			resp.SkippedCount++
+		case resignFailed:
+			resp.FailedCids = append(resp.FailedCids, cid)
+		}
+
+		progress.Add(1)
+
+		if failFast && len(resp.GetFailedCids()) > 0 {
+			break
+		}
+	}
+
+	signLogger.Info("Re-signed records", "signed", resp.GetSignedCount(), "skipped", resp.GetSkippedCount(), "failed", len(resp.GetFailedCids()))
+
+	return resp, nil
+}
+
+// resignRecord signs a record, unless it already has a signature of the key of the signer
+// that has not been revoked. Failures are logged, so that the other records are still re-signed.
+func (s *signCtrl) resignRecord(ctx context.Context, refStore types.ReferrerStoreAPI, cid string, signer *resignSigner, eventBus *events.SafeEventBus) resignStatus {
+	payload, err := signv1.CIDPayload(cid)
+	if err != nil {
+		signLogger.Error("Failed to generate payload of record to re-sign", "recordCID", cid, "error", err)
+
+		return resignFailed
+	}
+
+	if signer.publicKey != "" {
+		signed, err := signedWithKey(ctx, refStore, cid, signer.publicKey, payload)
+		if err != nil {
+			signLogger.Error("Failed to check signatures of record to re-sign", "recordCID", cid, "error", err)
+
+			return resignFailed
+		}
+
+		if signed {
+			signLogger.Debug("Record already signed with the key, skipping", "recordCID", cid)
+
+			return resignSkipped
+		}
+	}
+
+	result, err := signer.sign(ctx, payload)
+	if err != nil {
+		signLogger.Error("Failed to re-sign record", "recordCID", cid, "error", err)
+
+		return resignFailed
+	}
+
+	signature := &signv1.Signature{
+		Signature:   result.signature,
+		Certificate: result.certificate,
+		Annotations: map[string]string{
+			"payload": string(payload),
+		},
+	}
+
+	if err := pushSignature(ctx, refStore, cid, signature, result.publicKey); err != nil {
+		signLogger.Error("Failed to store signature of re-signed record", "recordCID", cid, "error", err)
+
+		return resignFailed
+	}
+
+	// Index the signer, so that the record is found by the queries of the new key or identity
+	identities, err := signpolicy.SignerIdentities(ctx, refStore, cid, signature)
+	if err != nil {
+		signLogger.Error("Failed to extract signer identities", "recordCID", cid, "error", err)
+	} else if err := s.db.AddRecordSigners(cid, signature.GetSignature(), identities); err != nil {
+		signLogger.Error("Failed to index signer identities", "recordCID", cid, "error", err)
+	}
+
+	eventBus.RecordSigned(cid, "server")
+
+	return resignSigned
+}
+
+// signedWithKey reports whether the record has a signature of the public key that has not been revoked.
+func signedWithKey(ctx context.Context, refStore types.ReferrerStoreAPI, cid, publicKey string, payload []byte) (bool, error) {
+	sigStatus, err := getSignatureStatus(ctx, refStore, cid)
+	if err != nil {
+		return false, err
+	}
+
+	for _, signature := range sigStatus.signatures {
+		if sigStatus.revoked[signature] {
+			continue
+		}
+
+		if cosign.VerifySignature([]byte(publicKey), signature, payload) == nil {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// pushSignature stores a signature of a record, after the public key it verifies against, if any.
+func pushSignature(ctx context.Context, refStore types.ReferrerStoreAPI, cid string, signature *signv1.Signature, publicKey string) error {
+	if publicKey != "" {
+		referrer, err := (&signv1.PublicKey{Key: publicKey}).MarshalReferrer()
+		if err != nil {
+			return fmt.Errorf("failed to encode public key: %w", err)
+		}
+
+		if err := refStore.PushReferrer(ctx, cid, referrer); err != nil {
+			return fmt.Errorf("failed to store public key: %w", err)
+		}
+	}
+
+	referrer, err := signature.MarshalReferrer()
+	if err != nil {
+		return fmt.Errorf("failed to encode signature: %w", err)
+	}
+
+	if err := refStore.PushReferrer(ctx, cid, referrer); err != nil {
+		return fmt.Errorf("failed to store signature: %w", err)
+	}
+
+	return nil
+}
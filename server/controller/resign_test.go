@@ -0,0 +1,235 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/agntcy/dir/server/authn"
+	authnconfig "github.com/agntcy/dir/server/authn/config"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/operations"
+	"github.com/agntcy/dir/server/types"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resignStore is an in-memory store keeping the referrers of several records.
+type resignStore struct {
+	types.StoreAPI
+
+	referrers map[string][]*corev1.RecordReferrer
+}
+
+func (s *resignStore) PushReferrer(_ context.Context, cid string, referrer *corev1.RecordReferrer) error {
+	s.referrers[cid] = append(s.referrers[cid], referrer)
+
+	return nil
+}
+
+func (s *resignStore) WalkReferrers(_ context.Context, cid string, referrerType string, walkFn func(*corev1.RecordReferrer) error) error {
+	for _, referrer := range s.referrers[cid] {
+		if referrerType != "" && referrer.GetType() != referrerType {
+			continue
+		}
+
+		if err := walkFn(referrer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resignDatabase finds the given records and indexes their signers.
+type resignDatabase struct {
+	types.DatabaseAPI
+
+	cids    []string
+	signers map[string][]string
+}
+
+func (d *resignDatabase) GetRecordCIDs(...types.FilterOption) ([]string, error) {
+	return d.cids, nil
+}
+
+func (d *resignDatabase) AddRecordSigners(cid, _ string, identities []string) error {
+	d.signers[cid] = append(d.signers[cid], identities...)
+
+	return nil
+}
+
+// newResignTestSigner returns a signer signing payloads with a new ECDSA key.
+func newResignTestSigner(t *testing.T) *resignSigner {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	publicKeyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))
+
+	return &resignSigner{
+		publicKey: publicKeyPEM,
+		sign: func(_ context.Context, payload []byte) (*resignedPayload, error) {
+			hash := sha256.Sum256(payload)
+
+			signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+			if err != nil {
+				return nil, fmt.Errorf("failed to sign payload: %w", err)
+			}
+
+			return &resignedPayload{signature: base64.StdEncoding.EncodeToString(signature), publicKey: publicKeyPEM}, nil
+		},
+	}
+}
+
+// testCIDs returns valid record CIDs.
+func testCIDs(t *testing.T, n int) []string {
+	t.Helper()
+
+	cids := make([]string, n)
+
+	for i := range cids {
+		digest, err := corev1.CalculateDigest(fmt.Appendf(nil, "record-%d", i))
+		require.NoError(t, err)
+
+		cid, err := corev1.ConvertDigestToCID(digest)
+		require.NoError(t, err)
+
+		cids[i] = cid
+	}
+
+	return cids
+}
+
+func TestSignResign(t *testing.T) {
+	signer := newResignTestSigner(t)
+	cids := testCIDs(t, 3)
+	signed, unsigned, revoked := cids[0], cids[1], cids[2]
+
+	store := &resignStore{referrers: make(map[string][]*corev1.RecordReferrer)}
+
+	// Sign the first and last records with the key, and revoke the signature of the last one
+	for _, cid := range []string{signed, revoked} {
+		payload, err := signv1.CIDPayload(cid)
+		require.NoError(t, err)
+
+		result, err := signer.sign(t.Context(), payload)
+		require.NoError(t, err)
+		require.NoError(t, pushSignature(t.Context(), store, cid, &signv1.Signature{Signature: result.signature}, result.publicKey))
+
+		if cid == revoked {
+			referrer, err := (&signv1.Revocation{Signature: result.signature}).MarshalReferrer()
+			require.NoError(t, err)
+			require.NoError(t, store.PushReferrer(t.Context(), cid, referrer))
+		}
+	}
+
+	db := &resignDatabase{cids: cids, signers: make(map[string][]string)}
+	bus := events.NewEventBus()
+	ctrl := NewSignController(store, db, nil, events.NewSafeEventBus(bus), nil, authnconfig.Config{}).(*signCtrl) //nolint:forcetypeassert
+
+	resp, err := ctrl.resign(t.Context(), store, nil, signer, false, ctrl.eventBus, nil)
+	require.NoError(t, err)
+
+	// Records already signed with the key are skipped, unless their signature was revoked
+	assert.Equal(t, uint32(2), resp.GetSignedCount())
+	assert.Equal(t, uint32(1), resp.GetSkippedCount())
+	assert.Empty(t, resp.GetFailedCids())
+
+	assert.NotContains(t, db.signers, signed)
+	assert.Len(t, db.signers[unsigned], 1)
+	assert.True(t, strings.HasPrefix(db.signers[unsigned][0], "sha256:"))
+
+	bus.WaitForAsyncPublish()
+	assert.Equal(t, uint64(2), bus.GetMetrics().PublishedTotal)
+
+	t.Run("resumed", func(t *testing.T) {
+		resp, err := ctrl.resign(t.Context(), store, nil, signer, false, ctrl.eventBus, nil)
+		require.NoError(t, err)
+
+		assert.Zero(t, resp.GetSignedCount())
+		assert.Equal(t, uint32(3), resp.GetSkippedCount())
+	})
+
+	t.Run("fail fast", func(t *testing.T) {
+		failing := &resignSigner{sign: func(context.Context, []byte) (*resignedPayload, error) {
+			return nil, errors.New("signing service unavailable")
+		}}
+
+		resp, err := ctrl.resign(t.Context(), store, nil, failing, true, ctrl.eventBus, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{signed}, resp.GetFailedCids())
+
+		resp, err = ctrl.resign(t.Context(), store, nil, failing, false, ctrl.eventBus, nil)
+		require.NoError(t, err)
+		assert.Equal(t, cids, resp.GetFailedCids())
+	})
+}
+
+func TestSignResign_Request(t *testing.T) {
+	ops := operations.New()
+	t.Cleanup(func() { _ = ops.Stop() })
+
+	ctrl := NewSignController(&resignStore{}, &resignDatabase{}, nil, nil, ops, authnconfig.Config{
+		Enabled: true,
+		Admins:  []string{"spiffe://dir.com/admins/*"},
+	})
+
+	adminCtx := context.WithValue(t.Context(), authn.SpiffeIDContextKey, spiffeid.RequireFromString("spiffe://dir.com/admins/alice"))
+	queries := []*searchv1.RecordQuery{{Type: searchv1.RecordQueryType_RECORD_QUERY_TYPE_SIGNER, Value: "spiffe://dir.com/ci"}}
+
+	t.Run("not an admin", func(t *testing.T) {
+		ctx := context.WithValue(t.Context(), authn.SpiffeIDContextKey, spiffeid.RequireFromString("spiffe://dir.com/ci"))
+
+		_, err := ctrl.Resign(ctx, &signv1.ResignRequest{Queries: queries})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for name, req := range map[string]*signv1.ResignRequest{
+			"no queries":  {},
+			"no provider": {Queries: queries},
+			"no token":    {Queries: queries, Provider: &signv1.SignRequestProvider{Request: &signv1.SignRequestProvider_Oidc{Oidc: &signv1.SignWithOIDC{}}}},
+			"invalid key": {Queries: queries, Provider: &signv1.SignRequestProvider{Request: &signv1.SignRequestProvider_Key{Key: &signv1.SignWithKey{PrivateKey: []byte("invalid")}}}},
+		} {
+			_, err := ctrl.Resign(adminCtx, req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+		}
+	})
+
+	t.Run("started as an operation", func(t *testing.T) {
+		resp, err := ctrl.Resign(adminCtx, &signv1.ResignRequest{
+			Queries:  queries,
+			Provider: &signv1.SignRequestProvider{Request: &signv1.SignRequestProvider_Oidc{Oidc: &signv1.SignWithOIDC{IdToken: "token"}}},
+		})
+		require.NoError(t, err)
+
+		op, err := ops.Wait(adminCtx, resp.GetOperationId(), "spiffe://dir.com/admins/alice", time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, ResignOperationType, op.GetType())
+		assert.Equal(t, corev1.OperationState_OPERATION_STATE_SUCCEEDED, op.GetState())
+	})
+}
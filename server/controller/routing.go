@@ -5,31 +5,47 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	databaseutils "github.com/agntcy/dir/server/database/utils"
+	"github.com/agntcy/dir/server/operations"
+	"github.com/agntcy/dir/server/signpolicy"
 	"github.com/agntcy/dir/server/types"
 	"github.com/agntcy/dir/server/types/adapters"
 	"github.com/agntcy/dir/utils/logging"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 var routingLogger = logging.Logger("controller/routing")
 
+// UnpublishOperationType is the type of the operations started by asynchronous Unpublish requests.
+const UnpublishOperationType = "unpublish"
+
 type routingCtlr struct {
 	routingv1.UnimplementedRoutingServiceServer
 	routing     types.RoutingAPI
 	store       types.StoreAPI
+	db          types.DatabaseAPI
 	publication types.PublicationAPI
+	signPolicy  *signpolicy.Evaluator
+	operations  *operations.Manager
 }
 
-func NewRoutingController(routing types.RoutingAPI, store types.StoreAPI, publication types.PublicationAPI) routingv1.RoutingServiceServer {
+func NewRoutingController(routing types.RoutingAPI, store types.StoreAPI, db types.DatabaseAPI, publication types.PublicationAPI, signPolicy *signpolicy.Evaluator, ops *operations.Manager) routingv1.RoutingServiceServer {
 	return &routingCtlr{
 		routing:                           routing,
 		store:                             store,
+		db:                                db,
 		publication:                       publication,
+		signPolicy:                        signPolicy,
+		operations:                        ops,
 		UnimplementedRoutingServiceServer: routingv1.UnimplementedRoutingServiceServer{},
 	}
 }
@@ -37,6 +53,21 @@ func NewRoutingController(routing types.RoutingAPI, store types.StoreAPI, public
 func (c *routingCtlr) Publish(ctx context.Context, req *routingv1.PublishRequest) (*emptypb.Empty, error) {
 	routingLogger.Debug("Called routing controller's Publish method", "req", req)
 
+	// Reject records not satisfying the signature policies early.
+	// Records matching queries are checked when the publication is processed.
+	for _, ref := range req.GetRecordRefs().GetRefs() {
+		if err := c.signPolicy.Check(ctx, ref.GetCid()); err != nil {
+			if errors.Is(err, signpolicy.ErrPolicyNotSatisfied) {
+				return nil, status.Error(codes.FailedPrecondition, err.Error()) //nolint:wrapcheck
+			}
+
+			return nil, status.Errorf(codes.Internal, "failed to check signature policies: %v", err)
+		}
+	}
+
+	// Origins are only set by the server for automatic publications
+	req.Origin = nil
+
 	// Create publication to be handled by the publication service
 	publicationID, err := c.publication.CreatePublication(ctx, req)
 	if err != nil {
@@ -53,18 +84,67 @@ func (c *routingCtlr) Publish(ctx context.Context, req *routingv1.PublishRequest
 func (c *routingCtlr) List(req *routingv1.ListRequest, srv routingv1.RoutingService_ListServer) error {
 	routingLogger.Debug("Called routing controller's List method", "req", req)
 
-	itemChan, err := c.routing.List(srv.Context(), req)
+	if err := validateReadMask(req.GetReadMask(), &routingv1.ListResponse{}); err != nil {
+		return err
+	}
+
+	updatedSince, err := parseUpdatedSince(req.GetUpdatedSince(), req.GetSinceWatermark())
+	if err != nil {
+		return err
+	}
+
+	// The watermark is taken before reading, so that records changed meanwhile are returned again
+	watermark := newWatermark(time.Now())
+
+	listReq := req
+
+	// Only published records that were added or updated in the search index are listed.
+	// The limit is applied once the unchanged records are filtered out.
+	var updated map[string]struct{}
+
+	if !updatedSince.IsZero() {
+		cids, err := c.db.GetRecordCIDs(types.WithUpdatedSince(updatedSince))
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get updated records: %v", err)
+		}
+
+		updated = make(map[string]struct{}, len(cids))
+		for _, cid := range cids {
+			updated[cid] = struct{}{}
+		}
+
+		listReq, _ = proto.Clone(req).(*routingv1.ListRequest)
+		listReq.Limit = nil
+	}
+
+	itemChan, err := c.routing.List(srv.Context(), listReq)
 	if err != nil {
 		st := status.Convert(err)
 
 		return status.Errorf(st.Code(), "failed to list: %s", st.Message())
 	}
 
+	var sent uint32
+
 	// Stream ListResponse items directly to the client
 	for item := range itemChan {
+		if updated != nil {
+			if _, ok := updated[item.GetRecordRef().GetCid()]; !ok {
+				continue
+			}
+		}
+
+		item.Watermark = watermark
+		applyReadMask(req.GetReadMask(), item)
+
 		if err := srv.Send(item); err != nil {
 			return status.Errorf(codes.Internal, "failed to send list response: %v", err)
 		}
+
+		sent++
+		if req.GetLimit() > 0 && sent >= req.GetLimit() {
+			break
+		}
 	}
 
 	return nil
@@ -90,22 +170,84 @@ func (c *routingCtlr) Search(req *routingv1.SearchRequest, srv routingv1.Routing
 	return nil
 }
 
-func (c *routingCtlr) Unpublish(ctx context.Context, req *routingv1.UnpublishRequest) (*emptypb.Empty, error) {
+func (c *routingCtlr) ListPeers(req *routingv1.ListPeersRequest, srv routingv1.RoutingService_ListPeersServer) error {
+	routingLogger.Debug("Called routing controller's ListPeers method", "req", req)
+
+	peers, err := c.routing.ListPeers(srv.Context())
+	if err != nil {
+		st := status.Convert(err)
+
+		return status.Errorf(st.Code(), "failed to list peers: %s", st.Message())
+	}
+
+	for _, peer := range peers {
+		if err := srv.Send(&routingv1.ListPeersResponse{Peer: peer}); err != nil {
+			return status.Errorf(codes.Internal, "failed to send list peers response: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *routingCtlr) Unpublish(ctx context.Context, req *routingv1.UnpublishRequest) (*routingv1.UnpublishResponse, error) {
 	routingLogger.Debug("Called routing controller's Unpublish method", "req", req)
 
-	// Only handle RecordRefs, not queries
-	recordRefs, ok := req.GetRequest().(*routingv1.UnpublishRequest_RecordRefs)
-	if !ok {
-		return nil, status.Error(codes.InvalidArgument, "unpublish request must specify record_refs") //nolint:wrapcheck // gRPC status errors should not be wrapped
+	var (
+		refs []*corev1.RecordRef
+		err  error
+	)
+
+	switch request := req.GetRequest().(type) {
+	case *routingv1.UnpublishRequest_RecordRefs:
+		refs = request.RecordRefs.GetRefs()
+	case *routingv1.UnpublishRequest_Queries:
+		refs, err = c.getPublishedRefs(ctx, request.Queries.GetQueries())
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unpublish request must specify record_refs or queries") //nolint:wrapcheck // gRPC status errors should not be wrapped
+	}
+
+	if req.GetDryRun() {
+		routingLogger.Info("Dry run, not unpublishing records", "count", len(refs))
+
+		return &routingv1.UnpublishResponse{RecordRefs: refs, Count: uint32(len(refs))}, nil //nolint:gosec // number of records fits in uint32
+	}
+
+	if req.GetAsync() {
+		id, err := c.operations.Start(UnpublishOperationType, callerID(ctx), func(ctx context.Context, progress *operations.Progress) (proto.Message, error) {
+			if err := c.unpublishRefs(ctx, refs, progress); err != nil {
+				return nil, err
+			}
+
+			return &routingv1.UnpublishResponse{RecordRefs: refs, Count: uint32(len(refs))}, nil //nolint:gosec // number of records fits in uint32
+		})
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		return &routingv1.UnpublishResponse{RecordRefs: refs, Count: uint32(len(refs)), OperationId: id}, nil //nolint:gosec // number of records fits in uint32
 	}
 
+	if err := c.unpublishRefs(ctx, refs, nil); err != nil {
+		return nil, err
+	}
+
+	return &routingv1.UnpublishResponse{RecordRefs: refs, Count: uint32(len(refs))}, nil //nolint:gosec // number of records fits in uint32
+}
+
+// unpublishRefs unpublishes the records, reporting its progress if progress is set.
+func (c *routingCtlr) unpublishRefs(ctx context.Context, refs []*corev1.RecordRef, progress *operations.Progress) error {
+	progress.SetTotal(len(refs))
+
 	// Process each RecordRef
-	for _, ref := range recordRefs.RecordRefs.GetRefs() {
+	for _, ref := range refs {
 		record, err := c.getRecord(ctx, ref)
 		if err != nil {
 			st := status.Convert(err)
 
-			return nil, status.Errorf(st.Code(), "failed to get record: %s", st.Message())
+			return status.Errorf(st.Code(), "failed to get record: %s", st.Message())
 		}
 
 		// Wrap record with adapter for interface-based unpublishing
@@ -115,13 +257,54 @@ func (c *routingCtlr) Unpublish(ctx context.Context, req *routingv1.UnpublishReq
 		if err != nil {
 			st := status.Convert(err)
 
-			return nil, status.Errorf(st.Code(), "failed to unpublish: %s", st.Message())
+			return status.Errorf(st.Code(), "failed to unpublish: %s", st.Message())
 		}
 
 		routingLogger.Info("Successfully unpublished record", "cid", ref.GetCid())
+
+		progress.Add(1)
 	}
 
-	return &emptypb.Empty{}, nil
+	return nil
+}
+
+// getPublishedRefs returns the records matching the queries that are currently published by this peer.
+func (c *routingCtlr) getPublishedRefs(ctx context.Context, queries []*searchv1.RecordQuery) ([]*corev1.RecordRef, error) {
+	// Require a filter so that a request cannot unpublish every record by accident
+	if len(queries) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one query is required") //nolint:wrapcheck
+	}
+
+	filterOpts, err := databaseutils.QueryToFilters(queries)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to convert queries to filters: %v", err)
+	}
+
+	cids, err := c.db.GetRecordCIDs(filterOpts...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get matching records: %v", err)
+	}
+
+	var refs []*corev1.RecordRef
+
+	for _, cid := range cids {
+		ref := &corev1.RecordRef{Cid: cid}
+
+		// Skip records that are not published by this peer
+		if _, err := c.routing.Lookup(ctx, ref); err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+
+			st := status.Convert(err)
+
+			return nil, status.Errorf(st.Code(), "failed to lookup published record: %s", st.Message())
+		}
+
+		refs = append(refs, ref)
+	}
+
+	return refs, nil
 }
 
 func (c *routingCtlr) getRecord(ctx context.Context, ref *corev1.RecordRef) (*corev1.Record, error) {
@@ -4,51 +4,359 @@
 package controller
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	searchv1 "github.com/agntcy/dir/api/search/v1"
 	databaseutils "github.com/agntcy/dir/server/database/utils"
 	"github.com/agntcy/dir/server/types"
 	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var searchLogger = logging.Logger("controller/search")
 
+// provenancePath is the read mask path of the provenance of search results.
+const provenancePath = "provenance"
+
 type searchCtlr struct {
 	searchv1.UnimplementedSearchServiceServer
 	db types.DatabaseAPI
+
+	// region of the server, preferred when requests do not set preferred regions
+	region string
 }
 
-func NewSearchController(db types.DatabaseAPI) searchv1.SearchServiceServer {
+// NewSearchController creates a new search controller.
+// If region is not empty, results with locators in the region are ranked first by default.
+func NewSearchController(db types.DatabaseAPI, region string) searchv1.SearchServiceServer {
 	return &searchCtlr{
 		UnimplementedSearchServiceServer: searchv1.UnimplementedSearchServiceServer{},
 		db:                               db,
+		region:                           region,
 	}
 }
 
 func (c *searchCtlr) Search(req *searchv1.SearchRequest, srv searchv1.SearchService_SearchServer) error {
 	searchLogger.Debug("Called search controller's Search method", "req", req)
 
+	if err := validateReadMask(req.GetReadMask(), &searchv1.SearchResponse{}); err != nil {
+		return err
+	}
+
+	paginated := req.PageSize != nil || req.GetPageToken() != ""
+	if err := validatePagination(req, paginated); err != nil {
+		return err
+	}
+
 	filterOptions, err := databaseutils.QueryToFilters(req.GetQueries())
 	if err != nil {
 		return fmt.Errorf("failed to create filter options: %w", err)
 	}
 
-	filterOptions = append(filterOptions,
-		types.WithLimit(int(req.GetLimit())),
-		types.WithOffset(int(req.GetOffset())),
-	)
+	// Records under embargo are only found by the identity that pushed them
+	filterOptions = append(filterOptions, types.WithEmbargoes(time.Now(), callerID(srv.Context())))
+
+	// Paginated results are ordered by CID, so that pages can be read without scanning the previous ones
+	if !paginated {
+		filterOptions = append(filterOptions, types.WithLimit(int(req.GetLimit())), types.WithOffset(int(req.GetOffset())))
+
+		if regions := preferredRegions(req.GetPreferredRegions(), c.region); len(regions) > 0 {
+			filterOptions = append(filterOptions, types.WithPreferredRegions(regions...))
+		}
+	}
+
+	updatedSince, err := parseUpdatedSince(req.GetUpdatedSince(), req.GetSinceWatermark())
+	if err != nil {
+		return err
+	}
+
+	if !updatedSince.IsZero() {
+		filterOptions = append(filterOptions, types.WithUpdatedSince(updatedSince))
+	}
+
+	// Results are read with a single query before streaming, so that concurrent writes
+	// cannot tear the view. Reads at a previous snapshot exclude records added since.
+	snapshotTime := timestamppb.Now()
+
+	if req.GetSnapshotTime() != nil {
+		if err := req.GetSnapshotTime().CheckValid(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid snapshot time: %v", err)
+		}
+
+		if req.GetSnapshotTime().AsTime().After(snapshotTime.AsTime()) {
+			return status.Error(codes.InvalidArgument, "snapshot time cannot be in the future") //nolint:wrapcheck
+		}
+
+		snapshotTime = req.GetSnapshotTime()
+		filterOptions = append(filterOptions, types.WithCreatedBefore(snapshotTime.AsTime()))
+	}
+
+	var pagination *searchPagination
+
+	if paginated {
+		pagination = &searchPagination{
+			size:  searchPageSize(req.GetPageSize()),
+			token: pageToken{snapshotTime: snapshotTime.AsTime(), fingerprint: searchFingerprint(req, updatedSince)},
+		}
+
+		// Further pages are read at the snapshot time of the first page
+		if req.GetPageToken() != "" {
+			pagination.token, err = parsePageToken(req.GetPageToken(), pagination.token.fingerprint)
+			if err != nil {
+				return err
+			}
+
+			snapshotTime = timestamppb.New(pagination.token.snapshotTime)
+			filterOptions = append(filterOptions, types.WithCreatedBefore(snapshotTime.AsTime()))
+		}
+
+		// One more record than the page size is read, to know whether there is a next page
+		filterOptions = append(filterOptions, types.WithCursor(pagination.token.afterCID), types.WithLimit(pagination.size+1))
+	}
+
+	// Records added after the snapshot time were not read, so the next poll starts from it
+	watermark := newWatermark(snapshotTime.AsTime())
+
+	// Record data is only read when requested, as search otherwise only needs the CIDs
+	readMask := searchReadMask(req.GetReadMask(), req.GetExpandProvenance())
+	if fields := recordDataFields(readMask); len(fields) > 0 {
+		return c.sendRecords(srv, append(filterOptions, types.WithFields(fields...)), readMask, req.GetExpandProvenance(), snapshotTime, watermark, pagination)
+	}
 
 	recordCIDs, err := c.db.GetRecordCIDs(filterOptions...)
+	if errors.Is(err, types.ErrAnnotationNotIndexed) {
+		return status.Errorf(codes.InvalidArgument, "failed to get record CIDs: %v", err)
+	}
+
 	if err != nil {
 		return fmt.Errorf("failed to get record CIDs: %w", err)
 	}
 
+	recordCIDs, nextPageToken := paginate(recordCIDs, pagination, func(cid string) string { return cid })
+
+	provenance, err := c.recordProvenance(readMask, recordCIDs)
+	if err != nil {
+		return err
+	}
+
 	for _, cid := range recordCIDs {
-		if err := srv.Send(&searchv1.SearchResponse{RecordCid: cid}); err != nil {
+		resp := &searchv1.SearchResponse{RecordCid: cid, SnapshotTime: snapshotTime, Watermark: watermark, Provenance: provenance[cid]}
+		applyReadMask(readMask, resp)
+
+		resp.NextPageToken = nextPageToken
+
+		if err := sendSearchResponse(srv, resp, req.GetExpandProvenance()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendRecords streams the records found by a search with the record data fields of the read mask.
+func (c *searchCtlr) sendRecords(srv searchv1.SearchService_SearchServer, filterOptions []types.FilterOption,
+	readMask *fieldmaskpb.FieldMask, expandProvenance bool, snapshotTime *timestamppb.Timestamp, watermark string, pagination *searchPagination,
+) error {
+	records, err := c.db.GetRecords(filterOptions...)
+	if errors.Is(err, types.ErrAnnotationNotIndexed) {
+		return status.Errorf(codes.InvalidArgument, "failed to get records: %v", err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get records: %w", err)
+	}
+
+	records, nextPageToken := paginate(records, pagination, types.Record.GetCid)
+
+	cids := make([]string, 0, len(records))
+	for _, record := range records {
+		cids = append(cids, record.GetCid())
+	}
+
+	provenance, err := c.recordProvenance(readMask, cids)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		data, err := record.GetRecordData()
+		if err != nil {
+			return fmt.Errorf("failed to get record data: %w", err)
+		}
+
+		resp := &searchv1.SearchResponse{
+			RecordCid:    record.GetCid(),
+			SnapshotTime: snapshotTime,
+			Watermark:    watermark,
+			Name:         data.GetName(),
+			Version:      data.GetVersion(),
+			CreatedAt:    data.GetCreatedAt(),
+			Provenance:   provenance[record.GetCid()],
+		}
+
+		for _, skill := range data.GetSkills() {
+			resp.Skills = append(resp.Skills, skill.GetName())
+		}
+
+		for _, locator := range data.GetLocators() {
+			resp.Locators = append(resp.Locators, locator.GetURL())
+		}
+
+		for _, module := range data.GetModules() {
+			resp.Modules = append(resp.Modules, module.GetName())
+		}
+
+		for _, domain := range data.GetDomains() {
+			resp.Domains = append(resp.Domains, domain.GetName())
+		}
+
+		applyReadMask(readMask, resp)
+
+		resp.NextPageToken = nextPageToken
+
+		if err := sendSearchResponse(srv, resp, expandProvenance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordProvenance returns the provenance of records by CID, if requested in the read mask.
+// The provenance entries of a record pushed and synced, or synced by several syncs, are merged
+// so that the record is returned once.
+func (c *searchCtlr) recordProvenance(readMask *fieldmaskpb.FieldMask, cids []string) (map[string][]*searchv1.RecordProvenance, error) {
+	if !slices.Contains(readMask.GetPaths(), provenancePath) || len(cids) == 0 {
+		return nil, nil
+	}
+
+	entries, err := c.db.GetRecordProvenance(cids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get record provenance: %w", err)
+	}
+
+	provenance := make(map[string][]*searchv1.RecordProvenance, len(entries))
+
+	for cid, recordEntries := range entries {
+		for _, entry := range recordEntries {
+			provenance[cid] = append(provenance[cid], &searchv1.RecordProvenance{
+				SyncId:             entry.SyncID,
+				RemoteDirectoryUrl: entry.RemoteDirectoryURL,
+			})
+		}
+	}
+
+	return provenance, nil
+}
+
+// sendSearchResponse streams the response of a record. If provenance is expanded,
+// a response is streamed for every provenance of the record instead.
+func sendSearchResponse(srv searchv1.SearchService_SearchServer, resp *searchv1.SearchResponse, expandProvenance bool) error {
+	responses := []*searchv1.SearchResponse{resp}
+
+	if expandProvenance && len(resp.GetProvenance()) > 1 {
+		responses = make([]*searchv1.SearchResponse, 0, len(resp.GetProvenance()))
+
+		for _, provenance := range resp.GetProvenance() {
+			expanded := proto.CloneOf(resp)
+			expanded.Provenance = []*searchv1.RecordProvenance{provenance}
+			responses = append(responses, expanded)
+		}
+	}
+
+	for _, response := range responses {
+		if err := srv.Send(response); err != nil {
 			return fmt.Errorf("failed to send record: %w", err)
 		}
 	}
 
 	return nil
 }
+
+// searchPagination is the page of a paginated search being read.
+type searchPagination struct {
+	size  int
+	token pageToken
+}
+
+// validatePagination checks that a paginated search does not set conflicting options.
+func validatePagination(req *searchv1.SearchRequest, paginated bool) error {
+	if !paginated {
+		return nil
+	}
+
+	if req.GetLimit() > 0 || req.GetOffset() > 0 || len(req.GetPreferredRegions()) > 0 {
+		return status.Error(codes.InvalidArgument, "page_size and page_token cannot be set together with limit, offset or preferred_regions") //nolint:wrapcheck
+	}
+
+	if req.GetPageToken() != "" && req.GetSnapshotTime() != nil {
+		return status.Error(codes.InvalidArgument, "page_token cannot be set together with snapshot_time") //nolint:wrapcheck
+	}
+
+	return nil
+}
+
+// paginate truncates the results read for a page to the page size, and returns the token
+// of the next page if more results were read. Results are returned unchanged if not paginated.
+func paginate[T any](results []T, pagination *searchPagination, cid func(T) string) ([]T, string) {
+	if pagination == nil || len(results) <= pagination.size {
+		return results, ""
+	}
+
+	results = results[:pagination.size]
+
+	next := pagination.token
+	next.afterCID = cid(results[len(results)-1])
+
+	return results, next.encode()
+}
+
+// searchReadMask returns the read mask of a search request, defaulting to the search metadata fields.
+// Provenance is added to the mask if it is expanded.
+func searchReadMask(mask *fieldmaskpb.FieldMask, expandProvenance bool) *fieldmaskpb.FieldMask {
+	paths := []string{"record_cid", "snapshot_time", "watermark"}
+	if len(mask.GetPaths()) > 0 {
+		paths = mask.GetPaths()
+	}
+
+	if expandProvenance && !slices.Contains(paths, provenancePath) {
+		paths = append(slices.Clone(paths), provenancePath)
+	}
+
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+// recordDataFields returns the record fields to read for the paths of a search read mask.
+func recordDataFields(mask *fieldmaskpb.FieldMask) []string {
+	var fields []string
+
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case types.RecordFieldName, types.RecordFieldVersion, types.RecordFieldCreatedAt, types.RecordFieldSkills,
+			types.RecordFieldLocators, types.RecordFieldModules, types.RecordFieldDomains:
+			fields = append(fields, path)
+		}
+	}
+
+	return fields
+}
+
+// preferredRegions returns the regions preferred by a request, defaulting to the region of the server.
+func preferredRegions(requested []string, serverRegion string) []string {
+	if len(requested) > 0 {
+		return requested
+	}
+
+	if serverRegion != "" {
+		return []string{serverRegion}
+	}
+
+	return nil
+}
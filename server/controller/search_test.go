@@ -0,0 +1,121 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// provenanceDatabase finds a record pushed to this Directory and synced by two syncs, and a record only pushed.
+type provenanceDatabase struct {
+	types.DatabaseAPI
+
+	provenanceReads int
+}
+
+func (d *provenanceDatabase) GetRecordCIDs(...types.FilterOption) ([]string, error) {
+	return []string{"cid-mirrored", "cid-pushed"}, nil
+}
+
+func (d *provenanceDatabase) GetRecordProvenance(cids []string) (map[string][]types.RecordProvenance, error) {
+	d.provenanceReads++
+
+	provenance := map[string][]types.RecordProvenance{
+		"cid-mirrored": {
+			{},
+			{SyncID: "sync-a", RemoteDirectoryURL: "https://dir-a.example.com"},
+			{SyncID: "sync-b", RemoteDirectoryURL: "https://dir-b.example.com"},
+		},
+		"cid-pushed": {{}},
+	}
+
+	found := make(map[string][]types.RecordProvenance)
+	for _, cid := range cids {
+		if entries, ok := provenance[cid]; ok {
+			found[cid] = entries
+		}
+	}
+
+	return found, nil
+}
+
+type mockSearchServer struct {
+	searchv1.SearchService_SearchServer
+	ctx       context.Context //nolint:containedctx // Needed for mock gRPC stream testing
+	responses []*searchv1.SearchResponse
+}
+
+func (m *mockSearchServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockSearchServer) Send(resp *searchv1.SearchResponse) error {
+	m.responses = append(m.responses, resp)
+
+	return nil
+}
+
+// searchProvenance returns the record CID and the sync IDs of the provenance of each response.
+func searchProvenance(responses []*searchv1.SearchResponse) [][]string {
+	var results [][]string
+
+	for _, resp := range responses {
+		result := []string{resp.GetRecordCid()}
+		for _, provenance := range resp.GetProvenance() {
+			result = append(result, provenance.GetSyncId())
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func TestSearch_Provenance(t *testing.T) {
+	search := func(t *testing.T, req *searchv1.SearchRequest) (*provenanceDatabase, []*searchv1.SearchResponse) {
+		t.Helper()
+
+		db := &provenanceDatabase{}
+		srv := &mockSearchServer{ctx: t.Context()}
+
+		require.NoError(t, NewSearchController(db, "").Search(req, srv))
+
+		return db, srv.responses
+	}
+
+	t.Run("not requested", func(t *testing.T) {
+		db, responses := search(t, &searchv1.SearchRequest{})
+
+		assert.Equal(t, [][]string{{"cid-mirrored"}, {"cid-pushed"}}, searchProvenance(responses))
+		assert.Zero(t, db.provenanceReads)
+	})
+
+	t.Run("merged", func(t *testing.T) {
+		_, responses := search(t, &searchv1.SearchRequest{
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"record_cid", "provenance"}},
+		})
+
+		// The pushed and synced record is returned once, with its provenance merged
+		assert.Equal(t, [][]string{{"cid-mirrored", "", "sync-a", "sync-b"}, {"cid-pushed", ""}}, searchProvenance(responses))
+		assert.Equal(t, "https://dir-a.example.com", responses[0].GetProvenance()[1].GetRemoteDirectoryUrl())
+	})
+
+	t.Run("expanded", func(t *testing.T) {
+		_, responses := search(t, &searchv1.SearchRequest{ExpandProvenance: true})
+
+		assert.Equal(t, [][]string{
+			{"cid-mirrored", ""},
+			{"cid-mirrored", "sync-a"},
+			{"cid-mirrored", "sync-b"},
+			{"cid-pushed", ""},
+		}, searchProvenance(responses))
+	})
+}
@@ -5,9 +5,18 @@ package controller
 
 import (
 	"context"
+	"slices"
+	"time"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/agntcy/dir/server/authn"
+	authnconfig "github.com/agntcy/dir/server/authn/config"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/operations"
+	"github.com/agntcy/dir/server/signpolicy"
 	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/validation"
 	"github.com/agntcy/dir/utils/logging"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,13 +26,30 @@ var signLogger = logging.Logger("controller/sign")
 
 type signCtrl struct {
 	signv1.UnimplementedSignServiceServer
-	store types.StoreAPI
+	store       types.StoreAPI
+	db          types.DatabaseAPI
+	signPolicy  *signpolicy.Evaluator
+	eventBus    *events.SafeEventBus
+	operations  *operations.Manager
+	authnConfig authnconfig.Config
 }
 
 // NewSignController creates a new sign service controller.
-func NewSignController(store types.StoreAPI) signv1.SignServiceServer {
+// Verification results include the results of the signature policies, if any.
+// Revoked signatures are removed from the signer index, if a database is given.
+// Records are re-signed as operations of ops, by the admins of the authentication config.
+func NewSignController(store types.StoreAPI, db types.DatabaseAPI, signPolicy *signpolicy.Evaluator, eventBus *events.SafeEventBus, ops *operations.Manager, authnConfig authnconfig.Config) signv1.SignServiceServer {
+	if eventBus == nil {
+		eventBus = events.NewSafeEventBus(nil)
+	}
+
 	return &signCtrl{
-		store: store,
+		store:       store,
+		db:          db,
+		signPolicy:  signPolicy,
+		eventBus:    eventBus,
+		operations:  ops,
+		authnConfig: authnConfig,
 	}
 }
 
@@ -43,8 +69,118 @@ func (s *signCtrl) Verify(ctx context.Context, req *signv1.VerifyRequest) (*sign
 		return nil, status.Error(codes.InvalidArgument, "record ref must be set") //nolint:wrapcheck
 	}
 
+	// Records whose signatures have all been revoked are reported as revoked
+	// regardless of the result of signature verification.
+	if refStore, ok := s.store.(types.ReferrerStoreAPI); ok {
+		sigStatus, err := getSignatureStatus(ctx, refStore, req.GetRecordRef().GetCid())
+		if err != nil {
+			return nil, err
+		}
+
+		if sigStatus.allRevoked() {
+			errMsg := "All record signatures have been revoked"
+
+			return &signv1.VerifyResponse{
+				Success:      false,
+				ErrorMessage: &errMsg,
+				Revoked:      true,
+			}, nil
+		}
+	}
+
 	// Server-side verification is enabled by zot verification.
-	return s.verify(ctx, req.GetRecordRef().GetCid())
+	resp, err := s.verify(ctx, req.GetRecordRef().GetCid())
+	if err != nil {
+		return nil, err
+	}
+
+	resp.PolicyResults, err = s.signPolicy.Evaluate(ctx, req.GetRecordRef().GetCid())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to evaluate signature policies: %v", err)
+	}
+
+	return resp, nil
+}
+
+func (s *signCtrl) Revoke(ctx context.Context, req *signv1.RevokeRequest) (*signv1.RevokeResponse, error) {
+	signLogger.Debug("Revoke request received")
+
+	// Validate request
+	if req.GetRecordRef() == nil || req.GetRecordRef().GetCid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "record ref must be set") //nolint:wrapcheck
+	}
+
+	recordCID := req.GetRecordRef().GetCid()
+
+	refStore, ok := s.store.(types.ReferrerStoreAPI)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "referrer storage not supported by current store implementation") //nolint:wrapcheck
+	}
+
+	sigStatus, err := getSignatureStatus(ctx, refStore, recordCID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Select the signatures to revoke
+	signatures := sigStatus.signatures
+	if req.Signature != nil {
+		if !sigStatus.hasSignature(req.GetSignature()) {
+			return nil, status.Errorf(codes.NotFound, "signature not found for record %s", recordCID)
+		}
+
+		signatures = []string{req.GetSignature()}
+	}
+
+	if len(signatures) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no signatures found for record %s", recordCID)
+	}
+
+	var revokedBy string
+	if sid, ok := authn.SpiffeIDFromContext(ctx); ok {
+		revokedBy = sid.String()
+	}
+
+	revokedAt := time.Now().UTC().Format(time.RFC3339)
+	response := &signv1.RevokeResponse{}
+
+	for _, signature := range signatures {
+		// Skip signatures that are already revoked
+		if sigStatus.revoked[signature] {
+			continue
+		}
+
+		revocation := &signv1.Revocation{
+			Signature: signature,
+			Reason:    req.GetReason(),
+			RevokedAt: revokedAt,
+			RevokedBy: revokedBy,
+		}
+
+		referrer, err := revocation.MarshalReferrer()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode revocation: %v", err)
+		}
+
+		referrer.CreatedAt = revokedAt
+
+		if err := refStore.PushReferrer(ctx, recordCID, referrer); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to store revocation for record %s: %v", recordCID, err)
+		}
+
+		signLogger.Info("Revoked record signature", "recordCID", recordCID, "revokedBy", revokedBy)
+
+		// Revoked signatures no longer vouch for their signers
+		if s.db != nil {
+			if err := s.db.RemoveRecordSigners(recordCID, signature); err != nil {
+				signLogger.Error("Failed to remove signer identities of revoked signature", "recordCID", recordCID, "error", err)
+			}
+		}
+
+		response.Revocations = append(response.Revocations, revocation)
+	}
+
+	return response, nil
 }
 
 // verify attempts zot verification if the store supports it.
@@ -74,3 +210,175 @@ func (s *signCtrl) verify(ctx context.Context, recordCID string) (*signv1.Verify
 		ErrorMessage: &errMsg,
 	}, nil
 }
+
+func (s *signCtrl) CreateVerificationSnapshot(ctx context.Context, req *signv1.CreateVerificationSnapshotRequest) (*signv1.CreateVerificationSnapshotResponse, error) {
+	signLogger.Debug("Create verification snapshot request received")
+
+	// Validate request
+	if req.GetRecordRef() == nil || req.GetRecordRef().GetCid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "record ref must be set") //nolint:wrapcheck
+	}
+
+	recordCID := req.GetRecordRef().GetCid()
+
+	refStore, ok := s.store.(types.ReferrerStoreAPI)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "referrer storage not supported by current store implementation") //nolint:wrapcheck
+	}
+
+	record, err := s.store.Pull(ctx, req.GetRecordRef())
+	if err != nil {
+		st := status.Convert(err)
+
+		return nil, status.Errorf(st.Code(), "failed to pull record %s: %s", recordCID, st.Message())
+	}
+
+	sigStatus, err := getSignatureStatus(ctx, refStore, recordCID)
+	if err != nil {
+		return nil, err
+	}
+
+	var createdBy string
+	if sid, ok := authn.SpiffeIDFromContext(ctx); ok {
+		createdBy = sid.String()
+	}
+
+	snapshot := &signv1.VerificationSnapshot{
+		VerifiedAt:       time.Now().UTC().Format(time.RFC3339),
+		CreatedBy:        createdBy,
+		SignatureCount:   uint32(len(sigStatus.signatures)), //nolint:gosec // number of signatures fits in uint32
+		RevokedCount:     uint32(sigStatus.revokedCount()),  //nolint:gosec // number of signatures fits in uint32
+		SignatureBundles: sigStatus.bundles,
+		SchemaVersion:    record.GetSchemaVersion(),
+		RulesVersion:     validation.RulesVersion(),
+	}
+
+	// Verify the record signature
+	var signatureError string
+
+	switch {
+	case len(sigStatus.signatures) == 0:
+		signatureError = "Record is not signed"
+	case sigStatus.allRevoked():
+		signatureError = "All record signatures have been revoked"
+	default:
+		resp, err := s.verify(ctx, recordCID)
+		if err != nil {
+			signatureError = status.Convert(err).Message()
+		} else {
+			snapshot.SignatureVerified = resp.GetSuccess()
+			signatureError = resp.GetErrorMessage()
+		}
+	}
+
+	if signatureError != "" {
+		snapshot.SignatureError = &signatureError
+	}
+
+	// Validate the record against its schema
+	valid, validationErrors, err := record.Validate()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to validate record %s: %v", recordCID, err)
+	}
+
+	snapshot.SchemaValid = valid
+	if !valid {
+		snapshot.SchemaErrors = validationErrors
+	}
+
+	referrer, err := snapshot.MarshalReferrer()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode verification snapshot: %v", err)
+	}
+
+	referrer.CreatedAt = snapshot.GetVerifiedAt()
+
+	if err := refStore.PushReferrer(ctx, recordCID, referrer); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store verification snapshot for record %s: %v", recordCID, err)
+	}
+
+	signLogger.Info("Stored verification snapshot", "recordCID", recordCID, "signatureVerified", snapshot.GetSignatureVerified(), "schemaValid", snapshot.GetSchemaValid())
+
+	return &signv1.CreateVerificationSnapshotResponse{
+		Snapshot: snapshot,
+	}, nil
+}
+
+// signatureStatus describes the signatures attached to a record and their revocations.
+type signatureStatus struct {
+	signatures []string
+	bundles    []string
+	revoked    map[string]bool
+}
+
+// hasSignature reports whether the signature is attached to the record.
+func (st *signatureStatus) hasSignature(signature string) bool {
+	return slices.Contains(st.signatures, signature)
+}
+
+// revokedCount returns the number of record signatures that have been revoked.
+func (st *signatureStatus) revokedCount() int {
+	count := 0
+
+	for _, signature := range st.signatures {
+		if st.revoked[signature] {
+			count++
+		}
+	}
+
+	return count
+}
+
+// allRevoked reports whether the record is signed and all its signatures have been revoked.
+func (st *signatureStatus) allRevoked() bool {
+	return len(st.signatures) > 0 && st.revokedCount() == len(st.signatures)
+}
+
+// getSignatureStatus collects the signatures attached to a record along with their revocations.
+func getSignatureStatus(ctx context.Context, refStore types.ReferrerStoreAPI, recordCID string) (*signatureStatus, error) {
+	result := &signatureStatus{
+		revoked: make(map[string]bool),
+	}
+
+	err := refStore.WalkReferrers(ctx, recordCID, corev1.SignatureReferrerType, func(referrer *corev1.RecordReferrer) error {
+		signature := &signv1.Signature{}
+		if err := signature.UnmarshalReferrer(referrer); err != nil {
+			signLogger.Warn("Failed to decode signature referrer", "recordCID", recordCID, "error", err)
+
+			return nil
+		}
+
+		result.signatures = append(result.signatures, signature.GetSignature())
+
+		if bundle := signature.GetContentBundle(); bundle != "" {
+			result.bundles = append(result.bundles, bundle)
+		}
+
+		return nil
+	})
+	if err != nil {
+		st := status.Convert(err)
+
+		return nil, status.Errorf(st.Code(), "failed to walk signature referrers: %s", st.Message())
+	}
+
+	err = refStore.WalkReferrers(ctx, recordCID, corev1.RevocationReferrerType, func(referrer *corev1.RecordReferrer) error {
+		revocation := &signv1.Revocation{}
+		if err := revocation.UnmarshalReferrer(referrer); err != nil {
+			signLogger.Warn("Failed to decode revocation referrer", "recordCID", recordCID, "error", err)
+
+			return nil
+		}
+
+		result.revoked[revocation.GetSignature()] = true
+
+		return nil
+	})
+	if err != nil {
+		st := status.Convert(err)
+
+		return nil, status.Errorf(st.Code(), "failed to walk revocation referrers: %s", st.Message())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,186 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	typesv1alpha0 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/agntcy/oasf/types/v1alpha0"
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	authnconfig "github.com/agntcy/dir/server/authn/config"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// referrerStore is an in-memory store keeping the referrers of a single record.
+type referrerStore struct {
+	types.StoreAPI
+
+	record    *corev1.Record
+	referrers []*corev1.RecordReferrer
+}
+
+func (s *referrerStore) Pull(_ context.Context, _ *corev1.RecordRef) (*corev1.Record, error) {
+	if s.record == nil {
+		return nil, status.Error(codes.NotFound, "record not found")
+	}
+
+	return s.record, nil
+}
+
+func (s *referrerStore) PushReferrer(_ context.Context, _ string, referrer *corev1.RecordReferrer) error {
+	s.referrers = append(s.referrers, referrer)
+
+	return nil
+}
+
+func (s *referrerStore) WalkReferrers(_ context.Context, _ string, referrerType string, walkFn func(*corev1.RecordReferrer) error) error {
+	for _, referrer := range s.referrers {
+		if referrerType != "" && referrer.GetType() != referrerType {
+			continue
+		}
+
+		if err := walkFn(referrer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newSignedReferrerStore(t *testing.T, signatures ...string) *referrerStore {
+	t.Helper()
+
+	store := &referrerStore{}
+
+	for _, signature := range signatures {
+		referrer, err := (&signv1.Signature{Signature: signature}).MarshalReferrer()
+		require.NoError(t, err)
+
+		store.referrers = append(store.referrers, referrer)
+	}
+
+	return store
+}
+
+func TestSignRevoke(t *testing.T) {
+	ctx := context.Background()
+	recordRef := &corev1.RecordRef{Cid: testCID}
+
+	t.Run("revokes all signatures", func(t *testing.T) {
+		ctrl := NewSignController(newSignedReferrerStore(t, "sig-a", "sig-b"), nil, nil, nil, nil, authnconfig.Config{})
+
+		resp, err := ctrl.Revoke(ctx, &signv1.RevokeRequest{RecordRef: recordRef, Reason: "key compromised"})
+		require.NoError(t, err)
+		require.Len(t, resp.GetRevocations(), 2)
+		assert.Equal(t, "key compromised", resp.GetRevocations()[0].GetReason())
+
+		verifyResp, err := ctrl.Verify(ctx, &signv1.VerifyRequest{RecordRef: recordRef})
+		require.NoError(t, err)
+		assert.False(t, verifyResp.GetSuccess())
+		assert.True(t, verifyResp.GetRevoked())
+
+		// Revoking again is a no-op
+		resp, err = ctrl.Revoke(ctx, &signv1.RevokeRequest{RecordRef: recordRef})
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetRevocations())
+	})
+
+	t.Run("revokes a single signature", func(t *testing.T) {
+		store := newSignedReferrerStore(t, "sig-a", "sig-b")
+		ctrl := NewSignController(store, nil, nil, nil, nil, authnconfig.Config{})
+
+		signature := "sig-a"
+
+		resp, err := ctrl.Revoke(ctx, &signv1.RevokeRequest{RecordRef: recordRef, Signature: &signature})
+		require.NoError(t, err)
+		require.Len(t, resp.GetRevocations(), 1)
+		assert.Equal(t, "sig-a", resp.GetRevocations()[0].GetSignature())
+
+		sigStatus, err := getSignatureStatus(ctx, store, testCID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, sigStatus.revokedCount())
+		assert.False(t, sigStatus.allRevoked())
+	})
+
+	t.Run("unknown signature", func(t *testing.T) {
+		ctrl := NewSignController(newSignedReferrerStore(t, "sig-a"), nil, nil, nil, nil, authnconfig.Config{})
+
+		signature := "sig-unknown"
+
+		_, err := ctrl.Revoke(ctx, &signv1.RevokeRequest{RecordRef: recordRef, Signature: &signature})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("unsigned record", func(t *testing.T) {
+		ctrl := NewSignController(newSignedReferrerStore(t), nil, nil, nil, nil, authnconfig.Config{})
+
+		_, err := ctrl.Revoke(ctx, &signv1.RevokeRequest{RecordRef: recordRef})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("missing record ref", func(t *testing.T) {
+		ctrl := NewSignController(newSignedReferrerStore(t), nil, nil, nil, nil, authnconfig.Config{})
+
+		_, err := ctrl.Revoke(ctx, &signv1.RevokeRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSignCreateVerificationSnapshot(t *testing.T) {
+	ctx := context.Background()
+	recordRef := &corev1.RecordRef{Cid: testCID}
+
+	t.Run("stores snapshot of revoked record", func(t *testing.T) {
+		store := newSignedReferrerStore(t, "sig-a")
+		store.record = corev1.New(&typesv1alpha0.Record{
+			Name:          "test-agent",
+			SchemaVersion: "v0.3.1",
+		})
+
+		ctrl := NewSignController(store, nil, nil, nil, nil, authnconfig.Config{})
+
+		_, err := ctrl.Revoke(ctx, &signv1.RevokeRequest{RecordRef: recordRef})
+		require.NoError(t, err)
+
+		resp, err := ctrl.CreateVerificationSnapshot(ctx, &signv1.CreateVerificationSnapshotRequest{RecordRef: recordRef})
+		require.NoError(t, err)
+
+		snapshot := resp.GetSnapshot()
+		assert.False(t, snapshot.GetSignatureVerified())
+		assert.Equal(t, uint32(1), snapshot.GetSignatureCount())
+		assert.Equal(t, uint32(1), snapshot.GetRevokedCount())
+		assert.NotEmpty(t, snapshot.GetSignatureError())
+		assert.NotEmpty(t, snapshot.GetVerifiedAt())
+		assert.NotEmpty(t, snapshot.GetRulesVersion())
+
+		// The snapshot is attached to the record
+		var stored []*signv1.VerificationSnapshot
+
+		err = store.WalkReferrers(ctx, testCID, corev1.VerificationSnapshotReferrerType, func(referrer *corev1.RecordReferrer) error {
+			storedSnapshot := &signv1.VerificationSnapshot{}
+			require.NoError(t, storedSnapshot.UnmarshalReferrer(referrer))
+
+			stored = append(stored, storedSnapshot)
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, stored, 1)
+		assert.Equal(t, snapshot.GetVerifiedAt(), stored[0].GetVerifiedAt())
+		assert.Equal(t, snapshot.GetSignatureError(), stored[0].GetSignatureError())
+	})
+
+	t.Run("missing record", func(t *testing.T) {
+		ctrl := NewSignController(newSignedReferrerStore(t), nil, nil, nil, nil, authnconfig.Config{})
+
+		_, err := ctrl.CreateVerificationSnapshot(ctx, &signv1.CreateVerificationSnapshotRequest{RecordRef: recordRef})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
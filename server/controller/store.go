@@ -9,39 +9,108 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"time"
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
 	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/consistency"
 	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/operations"
+	"github.com/agntcy/dir/server/proxy"
+	"github.com/agntcy/dir/server/signpolicy"
+	"github.com/agntcy/dir/server/store/eventswrap"
 	"github.com/agntcy/dir/server/types"
 	"github.com/agntcy/dir/server/types/adapters"
+	"github.com/agntcy/dir/server/validation"
 	"github.com/agntcy/dir/utils/logging"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 var storeLogger = logging.Logger("controller/store")
 
+// ConsistencyOperationType is the type of the operations started by asynchronous CheckConsistency requests.
+const ConsistencyOperationType = "check_consistency"
+
+// GarbageCollectOperationType is the type of the operations started by asynchronous GarbageCollect requests.
+const GarbageCollectOperationType = "garbage_collect"
+
+const (
+	// maxTransactionOperations limits the number of operations in a single transaction.
+	maxTransactionOperations = 100
+
+	// pushManyBufferSize limits the number of validated records waiting to be stored by PushMany.
+	pushManyBufferSize = 16
+)
+
 type storeCtrl struct {
 	storev1.UnimplementedStoreServiceServer
 	store    types.StoreAPI
 	db       types.DatabaseAPI
+	routing  types.RoutingAPI
 	eventBus *events.SafeEventBus
+
+	validator      *validation.Validator
+	checker        *consistency.Checker
+	schemaVersions *validation.SchemaVersionPolicy
+	licenses       *validation.LicensePolicy
+	authorship     *validation.AuthorshipPolicy
+	taxonomy       *validation.TaxonomyPolicy
+
+	// region of the server, preferred when resolving locators without preferred regions
+	region string
+
+	// proxy fetches records missing locally from upstream Directories, if enabled
+	proxy *proxy.Proxy
+
+	// pushes coalesces concurrent pushes of the same record, keyed by CID
+	pushes *singleflight.Group
+
+	// operations runs the requests made with async set in the background
+	operations *operations.Manager
 }
 
-func NewStoreController(store types.StoreAPI, db types.DatabaseAPI, eventBus *events.SafeEventBus) storev1.StoreServiceServer {
+// NewStoreController creates a new store controller.
+// If pullProxy is not nil, pulling records that are missing locally fetches them from its upstreams.
+func NewStoreController(store types.StoreAPI, db types.DatabaseAPI, routing types.RoutingAPI, eventBus *events.SafeEventBus, schemaVersions *validation.SchemaVersionPolicy, licenses *validation.LicensePolicy, authorship *validation.AuthorshipPolicy, taxonomy *validation.TaxonomyPolicy, region string, pullProxy *proxy.Proxy, ops *operations.Manager) storev1.StoreServiceServer {
+	if eventBus == nil {
+		eventBus = events.NewSafeEventBus(nil)
+	}
+
 	return &storeCtrl{
 		UnimplementedStoreServiceServer: storev1.UnimplementedStoreServiceServer{},
 		store:                           store,
 		db:                              db,
+		routing:                         routing,
 		eventBus:                        eventBus,
+		validator:                       validation.NewValidator(store, db, eventBus),
+		checker:                         consistency.NewChecker(store, db, routing),
+		schemaVersions:                  schemaVersions,
+		licenses:                        licenses,
+		authorship:                      authorship,
+		taxonomy:                        taxonomy,
+		region:                          region,
+		proxy:                           pullProxy,
+		pushes:                          &singleflight.Group{},
+		operations:                      ops,
 	}
 }
 
 func (s storeCtrl) Push(stream storev1.StoreService_PushServer) error {
 	storeLogger.Debug("Called store controller's Push method")
 
+	embargoUntil, err := embargoFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
 	for {
 		// Receive complete Record from stream
 		record, err := stream.Recv()
@@ -55,34 +124,103 @@ func (s storeCtrl) Push(stream storev1.StoreService_PushServer) error {
 			return status.Errorf(codes.Internal, "failed to receive record: %v", err)
 		}
 
-		isValid, validationErrors, err := record.Validate()
+		if err := s.validateRecord(stream.Context(), record); err != nil {
+			return err
+		}
+
+		pushedRef, err := s.pushEmbargoedRecord(stream.Context(), record, embargoUntil)
 		if err != nil {
-			return status.Errorf(codes.Internal, "failed to validate record: %v", err)
+			return err
+		}
+
+		// Send the RecordRef back via stream
+		if err := stream.Send(pushedRef); err != nil {
+			return status.Errorf(codes.Internal, "failed to send record reference: %v", err)
+		}
+	}
+}
+
+// pushManyItem is a received record waiting to be stored by PushMany.
+type pushManyItem struct {
+	index  uint32
+	record *corev1.Record
+	err    error
+}
+
+// PushMany stores the streamed records, returning a result for each of them.
+// Records are received and validated while previous records are being stored.
+func (s storeCtrl) PushMany(stream storev1.StoreService_PushManyServer) error {
+	storeLogger.Debug("Called store controller's PushMany method")
+
+	embargoUntil, err := embargoFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	items := make(chan pushManyItem, pushManyBufferSize)
+	recvErrCh := make(chan error, 1)
+
+	// Receive and validate records
+	go func() {
+		defer close(items)
+
+		for index := uint32(0); ; index++ {
+			record, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				recvErrCh <- status.Errorf(codes.Internal, "failed to receive record: %v", err)
+
+				return
+			}
+
+			select {
+			case items <- pushManyItem{index: index, record: record, err: s.validateRecord(ctx, record)}:
+			case <-ctx.Done():
+				return
+			}
 		}
+	}()
 
-		if !isValid {
-			// Extract record name and version for better error reporting
-			recordName, recordVersion := extractRecordInfo(record)
+	// Store the validated records
+	var pushed, failed int
 
-			// Log validation error with record details
-			storeLogger.Warn("Record validation failed",
-				"name", recordName,
-				"version", recordVersion,
-				"errors", validationErrors)
+	for item := range items {
+		resp := &storev1.PushManyResponse{Index: item.index}
 
-			return status.Errorf(codes.InvalidArgument, "record validation failed: %v", validationErrors)
+		err := item.err
+		if err == nil {
+			resp.RecordRef, err = s.pushEmbargoedRecord(ctx, item.record, embargoUntil)
 		}
 
-		pushedRef, err := s.pushRecordToStore(stream.Context(), record)
 		if err != nil {
-			return err
+			errMsg := status.Convert(err).Message()
+			resp.ErrorMessage = &errMsg
+			resp.ErrorCode = uint32(status.Code(err))
+			failed++
+		} else {
+			pushed++
 		}
 
-		// Send the RecordRef back via stream
-		if err := stream.Send(pushedRef); err != nil {
-			return status.Errorf(codes.Internal, "failed to send record reference: %v", err)
+		if err := stream.Send(resp); err != nil {
+			return status.Errorf(codes.Internal, "failed to send push result: %v", err)
 		}
 	}
+
+	select {
+	case err := <-recvErrCh:
+		return err
+	default:
+	}
+
+	storeLogger.Debug("PushMany stream completed", "pushed", pushed, "failed", failed)
+
+	return nil
 }
 
 func (s storeCtrl) Pull(stream storev1.StoreService_PullServer) error {
@@ -108,6 +246,12 @@ func (s storeCtrl) Pull(stream storev1.StoreService_PullServer) error {
 			return err
 		}
 
+		// Resolve name:tag references to the CID of the record
+		recordRef, err = s.resolveRecordRef(stream.Context(), recordRef)
+		if err != nil {
+			return err
+		}
+
 		// Pull record from store
 		record, err := s.pullRecordFromStore(stream.Context(), recordRef)
 		if err != nil {
@@ -118,6 +262,11 @@ func (s storeCtrl) Pull(stream storev1.StoreService_PullServer) error {
 		if err := stream.Send(record); err != nil {
 			return status.Errorf(codes.Internal, "failed to send record: %v", err)
 		}
+
+		// Track record pulls (secondary operation - don't fail on errors)
+		if err := s.db.IncrementRecordPullCount(recordRef.GetCid()); err != nil {
+			storeLogger.Error("Failed to increment record pull count", "error", err, "cid", recordRef.GetCid())
+		}
 	}
 }
 
@@ -154,6 +303,8 @@ func (s storeCtrl) Lookup(stream storev1.StoreService_LookupServer) error {
 
 		storeLogger.Debug("Record metadata retrieved successfully", "cid", recordRef.GetCid())
 
+		s.annotateProvenance(stream.Context(), recordMeta)
+
 		// Send RecordMeta back via stream
 		if err := stream.Send(recordMeta); err != nil {
 			return status.Errorf(codes.Internal, "failed to send record metadata: %v", err)
@@ -204,6 +355,12 @@ func (s storeCtrl) Delete(stream storev1.StoreService_DeleteServer) error {
 			storeLogger.Debug("Record removed from search index", "cid", recordRef.GetCid())
 		}
 
+		if s.proxy != nil {
+			if err := s.proxy.DeleteProvenance(stream.Context(), recordRef.GetCid()); err != nil {
+				storeLogger.Error("Failed to delete record provenance", "error", err, "cid", recordRef.GetCid())
+			}
+		}
+
 		storeLogger.Info("Record deleted successfully", "cid", recordRef.GetCid())
 	}
 }
@@ -266,7 +423,8 @@ func (s storeCtrl) pushReferrer(ctx context.Context, request *storev1.PushReferr
 
 	// Emit RECORD_SIGNED event if this is a signature referrer
 	if request.GetReferrer().GetType() == corev1.SignatureReferrerType {
-		s.eventBus.RecordSigned(request.GetRecordRef().GetCid(), "client")
+		s.indexSigners(ctx, refStore, request.GetRecordRef().GetCid(), request.GetReferrer())
+		s.eventBus.ForContext(ctx).RecordSigned(request.GetRecordRef().GetCid(), "client")
 	}
 
 	return &storev1.PushReferrerResponse{
@@ -335,53 +493,1172 @@ func (s storeCtrl) PullReferrer(stream storev1.StoreService_PullReferrerServer)
 	}
 }
 
-// pushRecordToStore pushes a record to the store and adds it to the search index.
-func (s storeCtrl) pushRecordToStore(ctx context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
-	// Push the record to store
-	pushedRef, err := s.store.Push(ctx, record)
+// RecordInfo assembles a consolidated view of the record state from the store,
+// search database, sync and publication state, and referrers.
+func (s storeCtrl) RecordInfo(ctx context.Context, req *storev1.RecordInfoRequest) (*storev1.RecordInfoResponse, error) {
+	storeLogger.Debug("Called store controller's RecordInfo method", "cid", req.GetRecordRef().GetCid())
+
+	// Validate record reference
+	if err := s.validateRecordRef(req.GetRecordRef()); err != nil {
+		return nil, err
+	}
+
+	cid := req.GetRecordRef().GetCid()
+
+	// Lookup record metadata (storage is source of truth)
+	recordMeta, err := s.store.Lookup(ctx, req.GetRecordRef())
 	if err != nil {
-		storeLogger.Error("Failed to push record to store", "error", err)
+		st := status.Convert(err)
+
+		return nil, status.Errorf(st.Code(), "failed to lookup record: %s", st.Message())
+	}
+
+	response := &storev1.RecordInfoResponse{
+		RecordRef: req.GetRecordRef(),
+		Meta:      recordMeta,
+		Stored:    true,
+	}
+
+	// Check search index presence and pull count
+	indexedCIDs, err := s.db.GetRecordCIDs(types.WithCIDs(cid), types.WithLimit(1))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check search index: %v", err)
+	}
+
+	response.Indexed = len(indexedCIDs) > 0
+
+	response.PullCount, err = s.db.GetRecordPullCount(cid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get record pull count: %v", err)
+	}
+
+	// Find syncs that explicitly included the record
+	response.SyncOrigins, err = s.recordSyncOrigins(cid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get record sync origins: %v", err)
+	}
+
+	// Check publication state
+	if s.routing != nil {
+		published, err := s.routing.Lookup(ctx, req.GetRecordRef())
+
+		switch {
+		case err == nil:
+			response.Published = true
+			response.Labels = published.GetLabels()
+		case status.Code(err) != codes.NotFound:
+			return nil, status.Errorf(codes.Internal, "failed to lookup record publication: %v", err)
+		}
+	}
+
+	// Collect signature state
+	response.Signature = s.recordSignatureInfo(ctx, cid)
+
+	return response, nil
+}
+
+// PushBundle stores a record together with its signature and attestations.
+// The complete bundle is validated before any write, and the record is only
+// indexed for search once all of its referrers have been stored.
+func (s storeCtrl) PushBundle(ctx context.Context, req *storev1.PushBundleRequest) (*storev1.PushBundleResponse, error) {
+	storeLogger.Debug("Called store controller's PushBundle method")
+
+	record := req.GetRecord()
 
+	// Validate the record
+	if err := s.validateRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	// Validate signature, public key and attestations
+	if err := validateBundleReferrers(record.GetCid(), req); err != nil {
+		return nil, err
+	}
+
+	refStore, ok := s.store.(types.ReferrerStoreAPI)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "referrer storage not supported by current store implementation")
+	}
+
+	// Records are content-addressed, so the record may already be stored.
+	// In that case it must be kept even if storing the bundle fails.
+	recordRef := &corev1.RecordRef{Cid: record.GetCid()}
+	_, lookupErr := s.store.Lookup(ctx, recordRef)
+	existed := lookupErr == nil
+
+	pushedRef, err := s.store.Push(ctx, record)
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to push record to store: %v", err)
 	}
 
-	storeLogger.Info("Record pushed to store successfully", "cid", pushedRef.GetCid())
+	// Push the signature last, so that a stored signature always
+	// comes with the rest of the bundle
+	referrers := make([]*corev1.RecordReferrer, 0, len(req.GetAttestations())+2) //nolint:mnd
+	if req.GetPublicKey() != nil {
+		referrers = append(referrers, req.GetPublicKey())
+	}
 
-	// Add record to search index for discoverability
-	// Use the adapter pattern to convert corev1.Record to types.Record
-	recordAdapter := adapters.NewRecordAdapter(record)
-	if err := s.db.AddRecord(recordAdapter); err != nil {
+	referrers = append(referrers, req.GetAttestations()...)
+	referrers = append(referrers, req.GetSignature())
+
+	for _, referrer := range referrers {
+		if err := refStore.PushReferrer(ctx, pushedRef.GetCid(), referrer); err != nil {
+			if !existed {
+				s.rollbackBundle(ctx, pushedRef)
+			}
+
+			return nil, status.Errorf(codes.Internal, "failed to store %s referrer for record %s: %v", referrer.GetType(), pushedRef.GetCid(), err)
+		}
+	}
+
+	storeLogger.Info("Record bundle pushed to store successfully", "cid", pushedRef.GetCid(), "attestations", len(req.GetAttestations()))
+
+	// Add record to search index now that the complete bundle is stored
+	if err := s.db.AddRecord(adapters.NewRecordAdapter(record)); err != nil {
 		// Log error but don't fail the push operation
 		storeLogger.Error("Failed to add record to search index", "error", err, "cid", pushedRef.GetCid())
 	} else {
-		storeLogger.Debug("Record added to search index successfully", "cid", pushedRef.GetCid())
+		s.setRecordOwner(ctx, pushedRef.GetCid())
+		s.addPushProvenance(pushedRef.GetCid())
 	}
 
-	return pushedRef, nil
+	s.indexSigners(ctx, refStore, pushedRef.GetCid(), req.GetSignature())
+
+	if s.authorship.Annotates() && !existed {
+		s.annotateAuthorship(ctx, record, pushedRef.GetCid())
+	}
+
+	s.eventBus.ForContext(ctx).RecordSigned(pushedRef.GetCid(), "client")
+
+	return &storev1.PushBundleResponse{
+		RecordRef: pushedRef,
+	}, nil
 }
 
-// validateRecordRef validates a record reference.
-func (s storeCtrl) validateRecordRef(recordRef *corev1.RecordRef) error {
-	if recordRef.GetCid() == "" {
-		return status.Error(codes.InvalidArgument, "record cid is required")
+// indexSigners stores the signer identities of a signature referrer of a record, so that
+// records can be searched by signer. Failures are logged, as the signature itself is stored.
+func (s storeCtrl) indexSigners(ctx context.Context, refStore types.ReferrerStoreAPI, recordCID string, referrer *corev1.RecordReferrer) {
+	signature := &signv1.Signature{}
+	if err := signature.UnmarshalReferrer(referrer); err != nil {
+		storeLogger.Warn("Failed to decode signature referrer", "error", err, "cid", recordCID)
+
+		return
+	}
+
+	identities, err := signpolicy.SignerIdentities(ctx, refStore, recordCID, signature)
+	if err != nil {
+		storeLogger.Error("Failed to extract signer identities", "error", err, "cid", recordCID)
+
+		return
+	}
+
+	if err := s.db.AddRecordSigners(recordCID, signature.GetSignature(), identities); err != nil {
+		storeLogger.Error("Failed to index signer identities", "error", err, "cid", recordCID)
+	}
+}
+
+// rollbackBundle removes a record whose bundle could not be stored completely.
+func (s storeCtrl) rollbackBundle(ctx context.Context, recordRef *corev1.RecordRef) {
+	// Rollback must happen even if the request was cancelled
+	if err := s.store.Delete(context.WithoutCancel(ctx), recordRef); err != nil {
+		storeLogger.Error("Failed to roll back record of incomplete bundle", "error", err, "cid", recordRef.GetCid())
+
+		return
+	}
+
+	storeLogger.Debug("Rolled back record of incomplete bundle", "cid", recordRef.GetCid())
+}
+
+// validateBundleReferrers validates the referrers of a bundle for the given record CID.
+func validateBundleReferrers(recordCID string, req *storev1.PushBundleRequest) error {
+	// Validate signature
+	if req.GetSignature() == nil {
+		return status.Error(codes.InvalidArgument, "bundle signature is required")
+	}
+
+	if req.GetSignature().GetType() != corev1.SignatureReferrerType {
+		return status.Errorf(codes.InvalidArgument, "bundle signature must be of type %s, got %q", corev1.SignatureReferrerType, req.GetSignature().GetType())
+	}
+
+	signature := &signv1.Signature{}
+	if err := signature.UnmarshalReferrer(req.GetSignature()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid bundle signature: %v", err)
+	}
+
+	if signature.GetSignature() == "" {
+		return status.Error(codes.InvalidArgument, "bundle signature is empty")
+	}
+
+	// Make sure the signature was created for this record
+	if payload, ok := signature.GetAnnotations()["payload"]; ok {
+		if err := validateSignaturePayload(recordCID, payload); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid bundle signature: %v", err)
+		}
+	}
+
+	// Validate public key
+	if req.GetPublicKey() != nil {
+		if req.GetPublicKey().GetType() != corev1.PublicKeyReferrerType {
+			return status.Errorf(codes.InvalidArgument, "bundle public key must be of type %s, got %q", corev1.PublicKeyReferrerType, req.GetPublicKey().GetType())
+		}
+
+		publicKey := &signv1.PublicKey{}
+		if err := publicKey.UnmarshalReferrer(req.GetPublicKey()); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid bundle public key: %v", err)
+		}
+
+		if publicKey.GetKey() == "" {
+			return status.Error(codes.InvalidArgument, "bundle public key is empty")
+		}
+	}
+
+	// Validate attestations
+	for i, attestation := range req.GetAttestations() {
+		if attestation.GetType() != corev1.AttestationReferrerType {
+			return status.Errorf(codes.InvalidArgument, "bundle attestation %d must be of type %s, got %q", i, corev1.AttestationReferrerType, attestation.GetType())
+		}
+
+		if attestation.GetData() == nil {
+			return status.Errorf(codes.InvalidArgument, "bundle attestation %d has no data", i)
+		}
 	}
 
 	return nil
 }
 
-// pullRecordFromStore pulls a record from the store with validation.
-func (s storeCtrl) pullRecordFromStore(ctx context.Context, recordRef *corev1.RecordRef) (*corev1.Record, error) {
-	// Pull record from store
-	record, err := s.store.Pull(ctx, recordRef)
+// validateSignaturePayload checks that a signing payload references the given record.
+func validateSignaturePayload(recordCID, payload string) error {
+	signedCID, err := signv1.PayloadCID([]byte(payload))
 	if err != nil {
-		st := status.Convert(err)
+		return err //nolint:wrapcheck
+	}
 
-		return nil, status.Errorf(st.Code(), "failed to pull record: %s", st.Message())
+	if signedCID != recordCID {
+		return fmt.Errorf("signature payload references %s, expected %s", signedCID, recordCID)
 	}
 
-	storeLogger.Debug("Record pulled successfully", "cid", recordRef.GetCid())
+	return nil
+}
 
-	return record, nil
+// ApplyTransaction applies a group of push and delete operations atomically.
+// Operations are validated upfront and applied to the store with rollback on
+// failure, and the search index is only updated once all of them succeeded.
+// Store writes are staged without events, which are only emitted once the
+// transaction is committed, so that rolled back operations are never observed.
+func (s storeCtrl) ApplyTransaction(ctx context.Context, req *storev1.ApplyTransactionRequest) (*storev1.ApplyTransactionResponse, error) {
+	storeLogger.Debug("Called store controller's ApplyTransaction method", "operations", len(req.GetOperations()))
+
+	pushes, deletes, err := validateTransaction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range pushes {
+		if err := s.checkRecordPolicies(ctx, record); err != nil {
+			return nil, err
+		}
+	}
+
+	stagingCtx := eventswrap.WithoutEvents(ctx)
+
+	// Keep copies of the records to delete, so that they can be restored on rollback.
+	// This also ensures that all records to delete exist before any write.
+	deletedRecords := make([]*corev1.Record, 0, len(deletes))
+
+	for _, recordRef := range deletes {
+		record, err := s.store.Pull(stagingCtx, recordRef)
+		if err != nil {
+			st := status.Convert(err)
+
+			return nil, status.Errorf(st.Code(), "failed to load record %s for deletion: %s", recordRef.GetCid(), st.Message())
+		}
+
+		deletedRecords = append(deletedRecords, record)
+	}
+
+	response := &storev1.ApplyTransactionResponse{}
+
+	// Records are content-addressed, so records that were already
+	// stored before the transaction must be kept on rollback
+	var createdRefs []*corev1.RecordRef
+
+	for _, record := range pushes {
+		_, lookupErr := s.store.Lookup(stagingCtx, &corev1.RecordRef{Cid: record.GetCid()})
+
+		pushedRef, err := s.store.Push(stagingCtx, record)
+		if err != nil {
+			s.rollbackTransaction(ctx, createdRefs, nil)
+
+			return nil, status.Errorf(codes.Internal, "failed to push record %s: %v", record.GetCid(), err)
+		}
+
+		if lookupErr != nil {
+			createdRefs = append(createdRefs, pushedRef)
+		}
+
+		response.PushedRefs = append(response.PushedRefs, pushedRef)
+	}
+
+	for i, recordRef := range deletes {
+		if err := s.store.Delete(stagingCtx, recordRef); err != nil {
+			s.rollbackTransaction(ctx, createdRefs, deletedRecords[:i])
+
+			st := status.Convert(err)
+
+			return nil, status.Errorf(st.Code(), "failed to delete record %s: %s", recordRef.GetCid(), st.Message())
+		}
+
+		response.DeletedRefs = append(response.DeletedRefs, recordRef)
+	}
+
+	storeLogger.Info("Transaction applied successfully", "pushed", len(pushes), "deleted", len(deletes))
+
+	// Update search index now that all operations are applied (secondary operation - don't fail on errors)
+	for _, record := range pushes {
+		if err := s.db.AddRecord(adapters.NewRecordAdapter(record)); err != nil {
+			storeLogger.Error("Failed to add record to search index", "error", err, "cid", record.GetCid())
+		} else {
+			s.setRecordOwner(ctx, record.GetCid())
+			s.addPushProvenance(record.GetCid())
+		}
+	}
+
+	for _, recordRef := range deletes {
+		if err := s.db.RemoveRecord(recordRef.GetCid()); err != nil {
+			storeLogger.Error("Failed to remove record from search index", "error", err, "cid", recordRef.GetCid())
+		}
+	}
+
+	// Emit the events of the committed operations
+	eventBus := s.eventBus.ForContext(ctx)
+
+	for _, record := range pushes {
+		eventBus.RecordPushed(record.GetCid(), eventswrap.RecordLabels(record))
+	}
+
+	for _, recordRef := range deletes {
+		eventBus.RecordDeleted(recordRef.GetCid())
+	}
+
+	if s.authorship.Annotates() {
+		for _, record := range pushes {
+			if slices.ContainsFunc(createdRefs, func(ref *corev1.RecordRef) bool { return ref.GetCid() == record.GetCid() }) {
+				s.annotateAuthorship(ctx, record, record.GetCid())
+			}
+		}
+	}
+
+	return response, nil
+}
+
+// rollbackTransaction reverts the store changes of a partially applied transaction
+// by removing the created records and restoring the deleted ones. No events are
+// emitted, as the reverted operations were never committed.
+func (s storeCtrl) rollbackTransaction(ctx context.Context, createdRefs []*corev1.RecordRef, deletedRecords []*corev1.Record) {
+	// Rollback must happen even if the request was cancelled
+	ctx = eventswrap.WithoutEvents(context.WithoutCancel(ctx))
+
+	for _, recordRef := range createdRefs {
+		if err := s.store.Delete(ctx, recordRef); err != nil {
+			storeLogger.Error("Failed to roll back pushed record", "error", err, "cid", recordRef.GetCid())
+		}
+	}
+
+	for _, record := range deletedRecords {
+		if _, err := s.store.Push(ctx, record); err != nil {
+			storeLogger.Error("Failed to roll back deleted record", "error", err, "cid", record.GetCid())
+		}
+	}
+
+	storeLogger.Debug("Rolled back transaction", "created", len(createdRefs), "deleted", len(deletedRecords))
+}
+
+// validateTransaction validates all operations of a transaction and
+// returns the records to push and the references of records to delete.
+func validateTransaction(req *storev1.ApplyTransactionRequest) ([]*corev1.Record, []*corev1.RecordRef, error) {
+	if len(req.GetOperations()) == 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "transaction has no operations")
+	}
+
+	if len(req.GetOperations()) > maxTransactionOperations {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "transaction has %d operations, maximum is %d", len(req.GetOperations()), maxTransactionOperations)
+	}
+
+	var (
+		pushes  []*corev1.Record
+		deletes []*corev1.RecordRef
+		seen    = make(map[string]struct{}, len(req.GetOperations()))
+	)
+
+	for i, op := range req.GetOperations() {
+		var cid string
+
+		switch {
+		case op.GetPush() != nil:
+			record := op.GetPush()
+
+			isValid, validationErrors, err := record.Validate()
+			if err != nil {
+				return nil, nil, status.Errorf(codes.Internal, "failed to validate record of operation %d: %v", i, err)
+			}
+
+			if !isValid {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "record validation failed for operation %d: %v", i, validationErrors)
+			}
+
+			cid = record.GetCid()
+			pushes = append(pushes, record)
+		case op.GetDelete() != nil:
+			cid = op.GetDelete().GetCid()
+			if cid == "" {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "record cid is required for operation %d", i)
+			}
+
+			deletes = append(deletes, op.GetDelete())
+		default:
+			return nil, nil, status.Errorf(codes.InvalidArgument, "operation %d has no push or delete", i)
+		}
+
+		// Conflicting operations on the same record have no well-defined outcome
+		if _, ok := seen[cid]; ok {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "record %s is referenced by more than one operation", cid)
+		}
+
+		seen[cid] = struct{}{}
+	}
+
+	return pushes, deletes, nil
+}
+
+// GetDependencies resolves the records referenced by a record from the reference graph.
+func (s storeCtrl) GetDependencies(_ context.Context, req *storev1.GetDependenciesRequest) (*storev1.GetDependenciesResponse, error) {
+	storeLogger.Debug("Called store controller's GetDependencies method", "req", req)
+
+	if err := s.validateRecordRef(req.GetRecordRef()); err != nil {
+		return nil, err
+	}
+
+	references, cycles, err := walkReferenceGraph(req.GetRecordRef().GetCid(), req.GetRecursive(), s.db.GetRecordDependencies)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storev1.GetDependenciesResponse{
+		References: references,
+		Cycles:     cycles,
+	}, nil
+}
+
+// GetDependents resolves the records referencing a record from the reference graph.
+func (s storeCtrl) GetDependents(_ context.Context, req *storev1.GetDependentsRequest) (*storev1.GetDependentsResponse, error) {
+	storeLogger.Debug("Called store controller's GetDependents method", "req", req)
+
+	if err := s.validateRecordRef(req.GetRecordRef()); err != nil {
+		return nil, err
+	}
+
+	references, cycles, err := walkReferenceGraph(req.GetRecordRef().GetCid(), req.GetRecursive(), s.db.GetRecordDependents)
+	if err != nil {
+		return nil, err
+	}
+
+	// The graph is walked in reverse, so flip the edges and cycles back
+	// to point from the referencing record to the referenced one
+	for _, reference := range references {
+		reference.RecordCid, reference.ReferencedCid = reference.GetReferencedCid(), reference.GetRecordCid()
+	}
+
+	for _, cycle := range cycles {
+		slices.Reverse(cycle.GetCids()[1:])
+	}
+
+	return &storev1.GetDependentsResponse{
+		References: references,
+		Cycles:     cycles,
+	}, nil
+}
+
+// ResolveLocator selects the locator of a record nearest to the preferred regions of the caller.
+func (s storeCtrl) ResolveLocator(ctx context.Context, req *storev1.ResolveLocatorRequest) (*storev1.ResolveLocatorResponse, error) {
+	storeLogger.Debug("Called store controller's ResolveLocator method", "req", req)
+
+	if err := s.validateRecordRef(req.GetRecordRef()); err != nil {
+		return nil, err
+	}
+
+	record, err := s.pullRecordFromStore(ctx, req.GetRecordRef())
+	if err != nil {
+		return nil, err
+	}
+
+	recordData, err := adapters.NewRecordAdapter(record).GetRecordData()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode record: %v", err)
+	}
+
+	locator, preferred := types.ResolveLocator(recordData.GetLocators(), req.GetType(), preferredRegions(req.GetPreferredRegions(), s.region))
+	if locator == nil {
+		if req.GetType() != "" {
+			return nil, status.Errorf(codes.NotFound, "record %s has no %s locator", req.GetRecordRef().GetCid(), req.GetType())
+		}
+
+		return nil, status.Errorf(codes.NotFound, "record %s has no locator", req.GetRecordRef().GetCid())
+	}
+
+	return &storev1.ResolveLocatorResponse{
+		Type:      locator.GetType(),
+		Url:       locator.GetURL(),
+		Region:    types.GetLocatorRegion(locator),
+		Preferred: preferred,
+	}, nil
+}
+
+// walkReferenceGraph walks the reference graph from root and converts the result to API types.
+func walkReferenceGraph(root string, recursive bool, next func(string) ([]string, error)) ([]*storev1.RecordReference, []*storev1.RecordReferenceCycle, error) {
+	edges, cycles, err := types.WalkReferenceGraph(root, recursive, next)
+	if err != nil {
+		if errors.Is(err, types.ErrReferenceGraphTooLarge) {
+			return nil, nil, status.Errorf(codes.ResourceExhausted, "failed to walk reference graph: %v", err)
+		}
+
+		return nil, nil, status.Errorf(codes.Internal, "failed to walk reference graph: %v", err)
+	}
+
+	references := make([]*storev1.RecordReference, 0, len(edges))
+	for _, edge := range edges {
+		references = append(references, &storev1.RecordReference{
+			RecordCid:     edge.From,
+			ReferencedCid: edge.To,
+		})
+	}
+
+	referenceCycles := make([]*storev1.RecordReferenceCycle, 0, len(cycles))
+	for _, cycle := range cycles {
+		referenceCycles = append(referenceCycles, &storev1.RecordReferenceCycle{
+			Cids: cycle,
+		})
+	}
+
+	return references, referenceCycles, nil
+}
+
+// ValidateStored re-validates stored records against the current validation rules.
+// If no records are given, all stored records are re-validated.
+func (s storeCtrl) ValidateStored(req *storev1.ValidateStoredRequest, stream storev1.StoreService_ValidateStoredServer) error {
+	storeLogger.Debug("Called store controller's ValidateStored method", "records", len(req.GetRecordRefs()), "only_invalid", req.GetOnlyInvalid())
+
+	var cids []string
+
+	for _, recordRef := range req.GetRecordRefs() {
+		if err := s.validateRecordRef(recordRef); err != nil {
+			return err
+		}
+
+		cids = append(cids, recordRef.GetCid())
+	}
+
+	if len(cids) == 0 {
+		storedCIDs, err := s.db.GetRecordCIDs()
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get stored records: %v", err)
+		}
+
+		cids = storedCIDs
+	}
+
+	for _, cid := range cids {
+		result, err := s.validator.Validate(stream.Context(), cid)
+		if err != nil {
+			st := status.Convert(err)
+
+			return status.Errorf(st.Code(), "failed to validate record %s: %s", cid, st.Message())
+		}
+
+		if req.GetOnlyInvalid() && result.Valid {
+			continue
+		}
+
+		if err := stream.Send(&storev1.ValidateStoredResponse{
+			RecordRef:     &corev1.RecordRef{Cid: result.CID},
+			Valid:         result.Valid,
+			Errors:        result.Errors,
+			SchemaVersion: result.SchemaVersion,
+			Drift:         result.Drift,
+			RulesVersion:  result.RulesVersion,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to send validation result: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// CheckConsistency compares the records of the search database, content store and routing datastore.
+func (s storeCtrl) CheckConsistency(ctx context.Context, req *storev1.CheckConsistencyRequest) (*storev1.CheckConsistencyResponse, error) {
+	storeLogger.Debug("Called store controller's CheckConsistency method", "repair", req.GetRepair(), "async", req.GetAsync())
+
+	if req.GetAsync() {
+		id, err := s.operations.Start(ConsistencyOperationType, callerID(ctx), func(ctx context.Context, _ *operations.Progress) (proto.Message, error) {
+			return s.checkConsistency(ctx, req.GetRepair())
+		})
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		return &storev1.CheckConsistencyResponse{OperationId: id}, nil
+	}
+
+	return s.checkConsistency(ctx, req.GetRepair())
+}
+
+// checkConsistency checks the consistency of the records, repairing the discrepancies if repair is set.
+func (s storeCtrl) checkConsistency(ctx context.Context, repair bool) (*storev1.CheckConsistencyResponse, error) {
+	report, err := s.checker.Check(ctx, repair)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	discrepancies := make([]*storev1.ConsistencyDiscrepancy, 0, len(report.Discrepancies))
+	for _, discrepancy := range report.Discrepancies {
+		item := &storev1.ConsistencyDiscrepancy{
+			RecordRef: &corev1.RecordRef{Cid: discrepancy.CID},
+			Type:      discrepancy.Type,
+			Repaired:  discrepancy.Repaired,
+		}
+
+		if discrepancy.RepairError != "" {
+			item.RepairError = &discrepancy.RepairError
+		}
+
+		discrepancies = append(discrepancies, item)
+	}
+
+	return &storev1.CheckConsistencyResponse{
+		IndexedRecords:   uint64(report.Indexed),   //nolint:gosec // counts are non-negative
+		StoredRecords:    uint64(report.Stored),    //nolint:gosec // counts are non-negative
+		PublishedRecords: uint64(report.Published), //nolint:gosec // counts are non-negative
+		Discrepancies:    discrepancies,
+	}, nil
+}
+
+// GarbageCollect removes the content of the content store no longer referenced by any record.
+func (s storeCtrl) GarbageCollect(ctx context.Context, req *storev1.GarbageCollectRequest) (*storev1.GarbageCollectResponse, error) {
+	storeLogger.Debug("Called store controller's GarbageCollect method", "async", req.GetAsync())
+
+	gcStore, ok := s.store.(types.GarbageCollectorStore)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "garbage collection not supported by current store implementation") //nolint:wrapcheck
+	}
+
+	if req.GetAsync() {
+		id, err := s.operations.Start(GarbageCollectOperationType, callerID(ctx), func(ctx context.Context, _ *operations.Progress) (proto.Message, error) {
+			return s.garbageCollect(ctx, gcStore)
+		})
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		return &storev1.GarbageCollectResponse{OperationId: id}, nil
+	}
+
+	return s.garbageCollect(ctx, gcStore)
+}
+
+// garbageCollect collects the garbage of the store and reports what was removed.
+func (s storeCtrl) garbageCollect(ctx context.Context, gcStore types.GarbageCollectorStore) (*storev1.GarbageCollectResponse, error) {
+	result, err := gcStore.GarbageCollect(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &storev1.GarbageCollectResponse{
+		RemovedBlobs:   result.RemovedBlobs,
+		ReclaimedBytes: result.ReclaimedBytes,
+	}, nil
+}
+
+// UpdateRecord applies a JSON patch to a stored record and pushes the result as a new record
+// linked to the patched record. The patched record must pass the same validation as pushed records.
+func (s storeCtrl) UpdateRecord(ctx context.Context, req *storev1.UpdateRecordRequest) (*storev1.UpdateRecordResponse, error) {
+	storeLogger.Debug("Called store controller's UpdateRecord method", "cid", req.GetRecordRef().GetCid())
+
+	if err := s.validateRecordRef(req.GetRecordRef()); err != nil {
+		return nil, err
+	}
+
+	if len(req.GetPatch()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "patch is required") //nolint:wrapcheck
+	}
+
+	record, err := s.pullRecordFromStore(ctx, req.GetRecordRef())
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := record.ApplyPatch(req.GetPatch())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to patch record %s: %v", req.GetRecordRef().GetCid(), err) //nolint:wrapcheck
+	}
+
+	if err := s.validateRecord(ctx, patched); err != nil {
+		return nil, err
+	}
+
+	pushedRef, err := s.pushRecordToStore(ctx, patched)
+	if err != nil {
+		return nil, err
+	}
+
+	storeLogger.Info("Record updated", "previous_cid", req.GetRecordRef().GetCid(), "cid", pushedRef.GetCid())
+
+	return &storev1.UpdateRecordResponse{
+		RecordRef:         pushedRef,
+		PreviousRecordRef: &corev1.RecordRef{Cid: req.GetRecordRef().GetCid()},
+	}, nil
+}
+
+// recordSyncOrigins returns the syncs that explicitly requested the given record.
+// Syncs of a full remote directory do not list their CIDs and are not reported.
+func (s storeCtrl) recordSyncOrigins(cid string) ([]*storev1.RecordSyncOrigin, error) {
+	syncs, err := s.db.GetSyncs(0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var origins []*storev1.RecordSyncOrigin
+
+	for _, sync := range syncs {
+		if !slices.Contains(sync.GetCIDs(), cid) {
+			continue
+		}
+
+		origins = append(origins, &storev1.RecordSyncOrigin{
+			SyncId:             sync.GetID(),
+			RemoteDirectoryUrl: sync.GetRemoteDirectoryURL(),
+			Status:             sync.GetStatus(),
+		})
+	}
+
+	return origins, nil
+}
+
+// recordSignatureInfo counts the signatures attached to the record and verifies them if supported by the store.
+func (s storeCtrl) recordSignatureInfo(ctx context.Context, cid string) *storev1.RecordSignatureInfo {
+	info := &storev1.RecordSignatureInfo{}
+
+	refStore, ok := s.store.(types.ReferrerStoreAPI)
+	if !ok {
+		return info
+	}
+
+	sigStatus, err := getSignatureStatus(ctx, refStore, cid)
+	if err != nil {
+		storeLogger.Warn("Failed to get signature status", "error", err, "cid", cid)
+
+		return info
+	}
+
+	info.SignatureCount = uint32(len(sigStatus.signatures)) //nolint:gosec // number of signatures fits in uint32
+	info.RevokedCount = uint32(sigStatus.revokedCount())    //nolint:gosec // number of signatures fits in uint32
+
+	// Records whose signatures have all been revoked are not verified
+	if info.GetSignatureCount() == 0 || sigStatus.allRevoked() {
+		return info
+	}
+
+	verifierStore, ok := s.store.(types.VerifierStore)
+	if !ok {
+		errMsg := "verification not available in this store configuration"
+		info.VerificationError = &errMsg
+
+		return info
+	}
+
+	verified, err := verifierStore.VerifyWithZot(ctx, cid)
+	if err != nil {
+		errMsg := err.Error()
+		info.VerificationError = &errMsg
+
+		return info
+	}
+
+	info.Verified = verified
+
+	return info
+}
+
+// pushRecordToStore pushes a record to the store and adds it to the search index.
+// Concurrent pushes of the same record are coalesced into a single write,
+// and all callers receive its result.
+func (s storeCtrl) pushRecordToStore(ctx context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
+	cid := record.GetCid()
+	if cid == "" {
+		return nil, status.Error(codes.InvalidArgument, "failed to compute record CID")
+	}
+
+	// The shared push is not canceled when the caller that started it goes away,
+	// as other callers may be waiting for it.
+	resultCh := s.pushes.DoChan(cid, func() (any, error) {
+		return s.pushRecord(context.WithoutCancel(ctx), record, time.Time{})
+	})
+
+	select {
+	case result := <-resultCh:
+		if result.Shared {
+			storeLogger.Debug("Coalesced concurrent push of the same record", "cid", cid)
+		}
+
+		if result.Err != nil {
+			return nil, result.Err
+		}
+
+		return result.Val.(*corev1.RecordRef), nil //nolint:forcetypeassert
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// pushEmbargoedRecord pushes a record like pushRecordToStore, and places it under embargo
+// until the given time, unless it is zero. Embargoes can only be placed on records pushed
+// for the first time, so that callers cannot hide the records of others.
+// Embargoed pushes are not coalesced, as only one of the callers can own the embargo.
+func (s storeCtrl) pushEmbargoedRecord(ctx context.Context, record *corev1.Record, until time.Time) (*corev1.RecordRef, error) {
+	if until.IsZero() {
+		return s.pushRecordToStore(ctx, record)
+	}
+
+	if record.GetCid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "failed to compute record CID")
+	}
+
+	existing, err := s.db.GetRecordCIDs(types.WithCIDs(record.GetCid()), types.WithLimit(1))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to look up record: %v", err)
+	}
+
+	if len(existing) > 0 {
+		return nil, errEmbargoedRecordExists(record.GetCid())
+	}
+
+	return s.pushRecord(ctx, record, until)
+}
+
+// errEmbargoedRecordExists is returned when placing an embargo on a record that was already pushed.
+func errEmbargoedRecordExists(cid string) error {
+	return status.Errorf(codes.FailedPrecondition, "record %s already exists: embargoes can only be set when records are first pushed", cid)
+}
+
+// embargoFromContext returns the embargo time requested with the pushed records, or a zero time if none.
+func embargoFromContext(ctx context.Context) (time.Time, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(corev1.EmbargoUntilMetadataKey)
+	if len(values) == 0 {
+		return time.Time{}, nil
+	}
+
+	until, err := corev1.ParseEmbargoUntil(values[0])
+	if err != nil {
+		return time.Time{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !until.After(time.Now()) {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "embargo time %s is not in the future", values[0])
+	}
+
+	return until, nil
+}
+
+// pushRecord pushes a record to the store and adds it to the search index, under embargo
+// until the given time unless it is zero. The push event is only emitted once the record
+// is indexed, so that subscribers such as auto-publish rules never see an embargoed record
+// before its embargo is stored.
+func (s storeCtrl) pushRecord(ctx context.Context, record *corev1.Record, embargoUntil time.Time) (*corev1.RecordRef, error) {
+	// Records already stored are only annotated by their first push,
+	// and only removed when their embargo cannot be stored
+	stored := (s.authorship.Annotates() || !embargoUntil.IsZero()) && s.recordExists(ctx, record.GetCid())
+
+	// Push the record to store
+	pushedRef, err := s.store.Push(eventswrap.WithoutEvents(ctx), record)
+	if err != nil {
+		storeLogger.Error("Failed to push record to store", "error", err)
+
+		return nil, status.Errorf(codes.Internal, "failed to push record to store: %v", err)
+	}
+
+	storeLogger.Info("Record pushed to store successfully", "cid", pushedRef.GetCid())
+
+	// Add record to search index for discoverability
+	// Use the adapter pattern to convert corev1.Record to types.Record
+	recordAdapter := adapters.NewRecordAdapter(record)
+
+	if !embargoUntil.IsZero() {
+		// The embargo is part of the indexed record, so failing to store it fails the push
+		if err := s.db.AddEmbargoedRecord(recordAdapter, callerID(ctx), embargoUntil); err != nil {
+			// The record was indexed by a concurrent push, which keeps it stored
+			if errors.Is(err, types.ErrRecordIndexed) {
+				return nil, errEmbargoedRecordExists(pushedRef.GetCid())
+			}
+
+			if !stored {
+				s.removePushedRecord(ctx, pushedRef)
+			}
+
+			return nil, status.Errorf(codes.Internal, "failed to set record embargo: %v", err)
+		}
+
+		storeLogger.Info("Record placed under embargo", "cid", pushedRef.GetCid(), "until", embargoUntil)
+		s.addPushProvenance(pushedRef.GetCid())
+	} else if err := s.db.AddRecord(recordAdapter); err != nil {
+		// Log error but don't fail the push operation
+		storeLogger.Error("Failed to add record to search index", "error", err, "cid", pushedRef.GetCid())
+	} else {
+		storeLogger.Debug("Record added to search index successfully", "cid", pushedRef.GetCid())
+		s.setRecordOwner(ctx, pushedRef.GetCid())
+		s.addPushProvenance(pushedRef.GetCid())
+	}
+
+	s.eventBus.ForContext(ctx).RecordPushed(pushedRef.GetCid(), eventswrap.RecordLabels(record))
+
+	if s.authorship.Annotates() && !stored {
+		s.annotateAuthorship(ctx, record, pushedRef.GetCid())
+	}
+
+	return pushedRef, nil
+}
+
+// removePushedRecord removes a record stored by a push that failed to be indexed.
+func (s storeCtrl) removePushedRecord(ctx context.Context, ref *corev1.RecordRef) {
+	if err := s.store.Delete(eventswrap.WithoutEvents(context.WithoutCancel(ctx)), ref); err != nil {
+		storeLogger.Error("Failed to remove pushed record", "error", err, "cid", ref.GetCid())
+	}
+}
+
+// setRecordOwner makes the caller the owner of a pushed record, unless it already has one.
+func (s storeCtrl) setRecordOwner(ctx context.Context, cid string) {
+	if err := s.db.SetRecordOwner(cid, callerID(ctx)); err != nil {
+		// Log error but don't fail the push operation
+		storeLogger.Error("Failed to set record owner", "error", err, "cid", cid)
+	}
+}
+
+// addPushProvenance records that a record was pushed to this Directory, so that searches merge it
+// with the provenance of the syncs that imported the same record.
+func (s storeCtrl) addPushProvenance(cid string) {
+	if err := s.db.AddRecordProvenance(cid, ""); err != nil {
+		// Log error but don't fail the push operation
+		storeLogger.Error("Failed to add record provenance", "error", err, "cid", cid)
+	}
+}
+
+// recordExists reports whether a record is already stored.
+func (s storeCtrl) recordExists(ctx context.Context, cid string) bool {
+	_, err := s.store.Lookup(ctx, &corev1.RecordRef{Cid: cid})
+
+	return err == nil
+}
+
+// annotateAuthorship stores the authorship mismatches of a record accepted in annotate mode
+// as a mutable annotations referrer, so that readers can tell which provenance metadata of
+// the record was not vouched for by the server. Failures are logged, as the record is stored.
+func (s storeCtrl) annotateAuthorship(ctx context.Context, record *corev1.Record, cid string) {
+	recordData, err := adapters.NewRecordAdapter(record).GetRecordData()
+	if err != nil {
+		storeLogger.Warn("Failed to decode record to check its authorship", "error", err, "cid", cid)
+
+		return
+	}
+
+	identity := callerID(ctx)
+
+	mismatches := s.authorship.Mismatches(recordData, identity)
+	if len(mismatches) == 0 {
+		return
+	}
+
+	storeLogger.Warn("Record authorship does not match the server policy", "cid", cid, "identity", identity, "mismatches", mismatches)
+
+	refStore, ok := s.store.(types.ReferrerStoreAPI)
+	if !ok {
+		storeLogger.Warn("Cannot annotate record authorship: referrer storage not supported by current store implementation", "cid", cid)
+
+		return
+	}
+
+	referrer := &corev1.RecordReferrer{
+		Type:        corev1.AnnotationsReferrerType,
+		Annotations: s.authorship.Annotations(mismatches, identity),
+	}
+
+	if err := refStore.PushReferrer(ctx, cid, referrer); err != nil {
+		storeLogger.Error("Failed to annotate record authorship", "error", err, "cid", cid)
+	}
+}
+
+// checkRecordPolicies checks that the schema version, the license, the authorship and
+// the skills and domains of a record pushed by the caller are accepted by the server.
+func (s storeCtrl) checkRecordPolicies(ctx context.Context, record *corev1.Record) error {
+	if err := s.schemaVersions.Check(record.GetSchemaVersion()); err != nil {
+		return err
+	}
+
+	if s.licenses == nil && s.authorship == nil && s.taxonomy == nil {
+		return nil
+	}
+
+	recordData, err := adapters.NewRecordAdapter(record).GetRecordData()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to decode record: %v", err)
+	}
+
+	if err := s.licenses.Check(recordData); err != nil {
+		return err
+	}
+
+	if err := s.authorship.Check(recordData, callerID(ctx)); err != nil {
+		return err
+	}
+
+	if s.taxonomy.Warns() {
+		if mismatches := s.taxonomy.Mismatches(recordData); len(mismatches) > 0 {
+			storeLogger.Warn("Record skills or domains are not in the OASF taxonomy",
+				"name", recordData.GetName(),
+				"version", recordData.GetVersion(),
+				"mismatches", mismatches)
+
+			surfaceTaxonomyWarnings(ctx, record.GetCid(), mismatches)
+		}
+	}
+
+	return s.taxonomy.Check(recordData)
+}
+
+// surfaceTaxonomyWarnings returns the taxonomy mismatches of a record accepted with
+// a warning to gRPC clients, in the trailer of the push request.
+func surfaceTaxonomyWarnings(ctx context.Context, cid string, mismatches []string) {
+	warnings := make([]string, 0, len(mismatches))
+	for _, mismatch := range mismatches {
+		warnings = append(warnings, cid+": "+mismatch)
+	}
+
+	if err := grpc.SetTrailer(ctx, metadata.MD{storev1.TaxonomyWarningsMetadataKey: warnings}); err != nil {
+		storeLogger.Debug("Failed to set taxonomy warnings trailer", "cid", cid, "error", err)
+	}
+}
+
+// validateRecord validates a record pushed by the caller before it is stored.
+// Records with a schema version, license, authorship or taxonomy not accepted by the server are rejected first.
+func (s storeCtrl) validateRecord(ctx context.Context, record *corev1.Record) error {
+	if err := s.checkRecordPolicies(ctx, record); err != nil {
+		return err
+	}
+
+	isValid, validationErrors, err := record.Validate()
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to validate record: %v", err)
+	}
+
+	if !isValid {
+		// Extract record name and version for better error reporting
+		recordName, recordVersion := extractRecordInfo(record)
+
+		// Log validation error with record details
+		storeLogger.Warn("Record validation failed",
+			"name", recordName,
+			"version", recordVersion,
+			"errors", validationErrors)
+
+		return status.Errorf(codes.InvalidArgument, "record validation failed: %v", validationErrors)
+	}
+
+	return nil
+}
+
+// validateRecordRef validates a record reference.
+func (s storeCtrl) validateRecordRef(recordRef *corev1.RecordRef) error {
+	if recordRef.GetCid() == "" {
+		return status.Error(codes.InvalidArgument, "record cid is required")
+	}
+
+	return nil
+}
+
+// pullRecordFromStore pulls a record from the store with validation.
+func (s storeCtrl) pullRecordFromStore(ctx context.Context, recordRef *corev1.RecordRef) (*corev1.Record, error) {
+	// Pull record from store
+	record, err := s.store.Pull(ctx, recordRef)
+
+	// Fetch missing records from upstreams, unless the request comes from another proxy
+	if status.Code(err) == codes.NotFound && s.proxy != nil && !proxy.IsProxyRequest(ctx) {
+		record, err = s.pullThrough(ctx, recordRef.GetCid())
+	}
+
+	if err != nil {
+		st := status.Convert(err)
+
+		return nil, status.Errorf(st.Code(), "failed to pull record: %s", st.Message())
+	}
+
+	storeLogger.Debug("Record pulled successfully", "cid", recordRef.GetCid())
+
+	return record, nil
+}
+
+// pullThrough fetches a record missing locally from the proxy upstreams,
+// stores and indexes it locally, and records its provenance.
+func (s storeCtrl) pullThrough(ctx context.Context, cid string) (*corev1.Record, error) {
+	record, provenance, err := s.proxy.Fetch(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.store.Push(ctx, record); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store record fetched from %s: %v", provenance.Upstream, err)
+	}
+
+	// Index and record the provenance of the record, but don't fail the pull on errors
+	if err := s.db.AddRecord(adapters.NewRecordAdapter(record)); err != nil {
+		storeLogger.Error("Failed to add proxied record to search index", "error", err, "cid", cid)
+	}
+
+	if err := s.proxy.SaveProvenance(ctx, cid, provenance); err != nil {
+		storeLogger.Error("Failed to save proxied record provenance", "error", err, "cid", cid)
+	}
+
+	// Surface the fetch to gRPC clients
+	if err := grpc.SetTrailer(ctx, metadata.Pairs(storev1.ProxiedFromMetadataKey, provenance.Upstream)); err != nil {
+		storeLogger.Debug("Failed to set proxy trailer", "cid", cid, "error", err)
+	}
+
+	storeLogger.Info("Record fetched through proxy", "cid", cid, "upstream", provenance.Upstream)
+
+	return record, nil
+}
+
+// annotateProvenance adds the provenance of records fetched by the proxy to their metadata.
+func (s storeCtrl) annotateProvenance(ctx context.Context, meta *corev1.RecordMeta) {
+	if s.proxy == nil {
+		return
+	}
+
+	provenance, err := s.proxy.GetProvenance(ctx, meta.GetCid())
+	if err != nil {
+		storeLogger.Debug("Failed to get record provenance", "cid", meta.GetCid(), "error", err)
+
+		return
+	}
+
+	if provenance == nil {
+		return
+	}
+
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+
+	for key, value := range provenance.Annotations() {
+		meta.Annotations[key] = value
+	}
 }
 
 // extractRecordInfo extracts name and version from a record for logging.
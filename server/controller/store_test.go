@@ -0,0 +1,707 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	oasfv1alpha1 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/agntcy/oasf/types/v1alpha1"
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/operations"
+	"github.com/agntcy/dir/server/store/eventswrap"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/validation"
+	validationconfig "github.com/agntcy/dir/server/validation/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestValidateBundleReferrers(t *testing.T) {
+	payload, err := signv1.CIDPayload(testCID)
+	require.NoError(t, err)
+
+	otherCID, err := corev1.ConvertDigestToCID("sha256:0000000000000000000000000000000000000000000000000000000000000000")
+	require.NoError(t, err)
+
+	otherPayload, err := signv1.CIDPayload(otherCID)
+	require.NoError(t, err)
+
+	newSignature := func(payload string) *corev1.RecordReferrer {
+		signature := &signv1.Signature{Signature: "c2lnbmF0dXJl"}
+		if payload != "" {
+			signature.Annotations = map[string]string{"payload": payload}
+		}
+
+		referrer, err := signature.MarshalReferrer()
+		require.NoError(t, err)
+
+		return referrer
+	}
+
+	publicKey, err := (&signv1.PublicKey{Key: "public-key"}).MarshalReferrer()
+	require.NoError(t, err)
+
+	attestation := &corev1.RecordReferrer{
+		Type: corev1.AttestationReferrerType,
+		Data: &structpb.Struct{Fields: map[string]*structpb.Value{"predicate": structpb.NewStringValue("slsa")}},
+	}
+
+	tests := []struct {
+		name    string
+		req     *storev1.PushBundleRequest
+		wantErr string
+	}{
+		{
+			name: "valid bundle",
+			req: &storev1.PushBundleRequest{
+				Signature:    newSignature(string(payload)),
+				PublicKey:    publicKey,
+				Attestations: []*corev1.RecordReferrer{attestation},
+			},
+		},
+		{
+			name: "signature without payload",
+			req: &storev1.PushBundleRequest{
+				Signature: newSignature(""),
+			},
+		},
+		{
+			name:    "missing signature",
+			req:     &storev1.PushBundleRequest{},
+			wantErr: "bundle signature is required",
+		},
+		{
+			name: "signature with wrong type",
+			req: &storev1.PushBundleRequest{
+				Signature: publicKey,
+			},
+			wantErr: "bundle signature must be of type",
+		},
+		{
+			name: "signature for another record",
+			req: &storev1.PushBundleRequest{
+				Signature: newSignature(string(otherPayload)),
+			},
+			wantErr: "signature payload references",
+		},
+		{
+			name: "public key with wrong type",
+			req: &storev1.PushBundleRequest{
+				Signature: newSignature(string(payload)),
+				PublicKey: attestation,
+			},
+			wantErr: "bundle public key must be of type",
+		},
+		{
+			name: "attestation with wrong type",
+			req: &storev1.PushBundleRequest{
+				Signature:    newSignature(string(payload)),
+				Attestations: []*corev1.RecordReferrer{publicKey},
+			},
+			wantErr: "bundle attestation 0 must be of type",
+		},
+		{
+			name: "attestation without data",
+			req: &storev1.PushBundleRequest{
+				Signature:    newSignature(string(payload)),
+				Attestations: []*corev1.RecordReferrer{{Type: corev1.AttestationReferrerType}},
+			},
+			wantErr: "bundle attestation 0 has no data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBundleReferrers(testCID, tt.req)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateTransaction(t *testing.T) {
+	deleteOp := func(cid string) *storev1.TransactionOperation {
+		return &storev1.TransactionOperation{
+			Operation: &storev1.TransactionOperation_Delete{Delete: &corev1.RecordRef{Cid: cid}},
+		}
+	}
+
+	t.Run("valid deletes", func(t *testing.T) {
+		pushes, deletes, err := validateTransaction(&storev1.ApplyTransactionRequest{
+			Operations: []*storev1.TransactionOperation{deleteOp("cid-1"), deleteOp("cid-2")},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, pushes)
+		require.Len(t, deletes, 2)
+		assert.Equal(t, "cid-1", deletes[0].GetCid())
+		assert.Equal(t, "cid-2", deletes[1].GetCid())
+	})
+
+	tests := []struct {
+		name       string
+		operations []*storev1.TransactionOperation
+		wantErr    string
+	}{
+		{
+			name:    "no operations",
+			wantErr: "transaction has no operations",
+		},
+		{
+			name:       "empty operation",
+			operations: []*storev1.TransactionOperation{{}},
+			wantErr:    "operation 0 has no push or delete",
+		},
+		{
+			name:       "delete without cid",
+			operations: []*storev1.TransactionOperation{deleteOp("")},
+			wantErr:    "record cid is required for operation 0",
+		},
+		{
+			name:       "duplicate record",
+			operations: []*storev1.TransactionOperation{deleteOp("cid-1"), deleteOp("cid-1")},
+			wantErr:    "record cid-1 is referenced by more than one operation",
+		},
+		{
+			name:       "too many operations",
+			operations: make([]*storev1.TransactionOperation, maxTransactionOperations+1),
+			wantErr:    "maximum is",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := validateTransaction(&storev1.ApplyTransactionRequest{Operations: tt.operations})
+
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+// mockPushManyServer implements StoreService_PushManyServer for testing.
+type mockPushManyServer struct {
+	storev1.StoreService_PushManyServer
+	ctx      context.Context //nolint:containedctx // Needed for mock gRPC stream testing
+	records  []*corev1.Record
+	sentMsgs []*storev1.PushManyResponse
+}
+
+func (m *mockPushManyServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockPushManyServer) Recv() (*corev1.Record, error) {
+	if len(m.records) == 0 {
+		return nil, io.EOF
+	}
+
+	record := m.records[0]
+	m.records = m.records[1:]
+
+	return record, nil
+}
+
+func (m *mockPushManyServer) Send(resp *storev1.PushManyResponse) error {
+	m.sentMsgs = append(m.sentMsgs, resp)
+
+	return nil
+}
+
+// pushStore is a store failing to push records with the given name.
+type pushStore struct {
+	types.StoreAPI
+
+	failName string
+}
+
+func (s *pushStore) Push(_ context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
+	if record.GetData().GetFields()["name"].GetStringValue() == s.failName {
+		return nil, errors.New("storage unavailable")
+	}
+
+	return &corev1.RecordRef{Cid: record.GetCid()}, nil
+}
+
+func (s *pushStore) Lookup(context.Context, *corev1.RecordRef) (*corev1.RecordMeta, error) {
+	return nil, status.Error(codes.NotFound, "record not found")
+}
+
+type pushDatabase struct {
+	types.DatabaseAPI
+}
+
+func (d *pushDatabase) AddRecord(types.Record) error {
+	return nil
+}
+
+func (d *pushDatabase) SetRecordOwner(string, string) error {
+	return nil
+}
+
+func (d *pushDatabase) AddRecordProvenance(string, string) error {
+	return nil
+}
+
+func newPushRecord(name string) *corev1.Record {
+	return corev1.New(&oasfv1alpha1.Record{
+		Name:          name,
+		SchemaVersion: "0.7.0",
+		Description:   "A valid agent record",
+		Version:       "1.0.0",
+		CreatedAt:     "2024-01-01T00:00:00Z",
+		Authors:       []string{"Jane Doe <jane.doe@example.com>"},
+		Locators: []*oasfv1alpha1.Locator{
+			{Type: "helm_chart", Url: "https://example.com/helm-chart.tgz"},
+		},
+		Skills: []*oasfv1alpha1.Skill{
+			{Name: "natural_language_processing/natural_language_understanding"},
+		},
+	})
+}
+
+func TestPushMany(t *testing.T) {
+	ctrl := NewStoreController(&pushStore{failName: "failing-agent"}, &pushDatabase{}, nil, nil, nil, nil, nil, nil, "", nil, nil)
+
+	stream := &mockPushManyServer{
+		ctx: context.Background(),
+		records: []*corev1.Record{
+			newPushRecord("first-agent"),
+			{},
+			newPushRecord("failing-agent"),
+			newPushRecord("last-agent"),
+		},
+	}
+
+	require.NoError(t, ctrl.PushMany(stream))
+	require.Len(t, stream.sentMsgs, 4)
+
+	for i, resp := range stream.sentMsgs {
+		assert.Equal(t, uint32(i), resp.GetIndex()) //nolint:gosec // test index fits in uint32
+	}
+
+	// Failing records do not cancel the stream
+	assert.NotEmpty(t, stream.sentMsgs[0].GetRecordRef().GetCid())
+	// Records without an accepted schema version are rejected before validation
+	assert.Contains(t, stream.sentMsgs[1].GetErrorMessage(), "schema version \"\" is not accepted")
+	assert.Equal(t, uint32(codes.FailedPrecondition), stream.sentMsgs[1].GetErrorCode())
+	assert.Contains(t, stream.sentMsgs[2].GetErrorMessage(), "storage unavailable")
+	assert.NotEmpty(t, stream.sentMsgs[3].GetRecordRef().GetCid())
+	assert.Nil(t, stream.sentMsgs[3].ErrorMessage)
+}
+
+// embargoDatabase stores the embargoes of pushed records, and the number of
+// events published when the embargo of the last record was stored.
+type embargoDatabase struct {
+	pushDatabase
+
+	bus *events.EventBus
+
+	mu                  sync.Mutex
+	embargoes           map[string]time.Time
+	eventsBeforeEmbargo uint64
+}
+
+func (d *embargoDatabase) GetRecordCIDs(...types.FilterOption) ([]string, error) {
+	return nil, nil
+}
+
+func (d *embargoDatabase) AddEmbargoedRecord(record types.Record, _ string, until time.Time) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.eventsBeforeEmbargo = d.bus.GetMetrics().PublishedTotal
+	d.embargoes[record.GetCid()] = until
+
+	return nil
+}
+
+func (d *embargoDatabase) GetRecordEmbargo(cid string) (time.Time, string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.embargoes[cid], "", nil
+}
+
+func TestPushEmbargoedRecord_AutoPublish(t *testing.T) {
+	bus := events.NewEventBus()
+	eventBus := events.NewSafeEventBus(bus)
+	db := &embargoDatabase{bus: bus, embargoes: map[string]time.Time{}}
+	ctrl := NewStoreController(eventswrap.Wrap(&pushStore{}, eventBus), db, nil, eventBus, nil, nil, nil, nil, "", nil, nil).(*storeCtrl) //nolint:forcetypeassert
+
+	// An auto-publish rule publishes all pushed records, holding back the
+	// publications of records under embargo until their embargo lifts
+	subID, eventCh := bus.Subscribe(&eventsv1.ListenRequest{
+		EventTypes: []eventsv1.EventType{eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED},
+	})
+	defer bus.Unsubscribe(subID)
+
+	until := time.Now().Add(time.Hour)
+
+	ref, err := ctrl.pushEmbargoedRecord(t.Context(), newPushRecord("embargoed-agent"), until)
+	require.NoError(t, err)
+
+	select {
+	case event := <-eventCh:
+		require.Equal(t, ref.GetCid(), event.ResourceID)
+
+		embargoUntil, _, err := db.GetRecordEmbargo(event.ResourceID)
+		require.NoError(t, err)
+		assert.Equal(t, until, embargoUntil)
+	case <-time.After(time.Second):
+		t.Fatal("Expected to receive RECORD_PUSHED event")
+	}
+
+	// The push event is only published once the embargo is stored
+	assert.Zero(t, db.eventsBeforeEmbargo)
+}
+
+func TestPush_TaxonomyWarnings(t *testing.T) {
+	taxonomy, err := validation.NewTaxonomyPolicy(validationconfig.TaxonomyConfig{Mode: validationconfig.TaxonomyModeWarn})
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	storev1.RegisterStoreServiceServer(server, NewStoreController(&pushStore{}, &pushDatabase{}, nil, nil, nil, nil, nil, taxonomy, "", nil, nil))
+
+	go server.Serve(lis) //nolint:errcheck
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	record := newPushRecord("misspelled-agent")
+	record.GetData().GetFields()["skills"] = structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+		structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"name": structpb.NewStringValue("analytical_skills/coding_skills/text_to_cod"),
+		}}),
+	}})
+
+	stream, err := storev1.NewStoreServiceClient(conn).Push(t.Context())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(record))
+	require.NoError(t, stream.CloseSend())
+
+	for err == nil {
+		_, err = stream.Recv()
+	}
+
+	// The mismatches are returned with their suggestions, whether the record is then
+	// accepted or rejected by the validation of its schema
+	assert.Equal(t, []string{
+		record.GetCid() + `: unknown skill "analytical_skills/coding_skills/text_to_cod" (did you mean "analytical_skills/coding_skills/text_to_code"?)`,
+	}, stream.Trailer().Get(storev1.TaxonomyWarningsMetadataKey))
+}
+
+// blockingPushStore counts pushes and blocks them until released.
+type blockingPushStore struct {
+	types.StoreAPI
+
+	pushes  atomic.Int32
+	release chan struct{}
+}
+
+func (s *blockingPushStore) Push(_ context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
+	s.pushes.Add(1)
+	<-s.release
+
+	return &corev1.RecordRef{Cid: record.GetCid()}, nil
+}
+
+func TestPushRecordToStore_Coalesced(t *testing.T) {
+	store := &blockingPushStore{release: make(chan struct{})}
+	ctrl := NewStoreController(store, &pushDatabase{}, nil, nil, nil, nil, nil, nil, "", nil, nil).(*storeCtrl) //nolint:forcetypeassert
+
+	const callers = 5
+
+	record := newPushRecord("concurrent-agent")
+
+	var wg sync.WaitGroup
+
+	refs := make([]*corev1.RecordRef, callers)
+	errs := make([]error, callers)
+
+	for i := range callers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			refs[i], errs[i] = ctrl.pushRecordToStore(context.Background(), record)
+		}()
+	}
+
+	// Wait for the first push to reach the store, then give the others time to join it
+	require.Eventually(t, func() bool { return store.pushes.Load() > 0 }, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(store.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), store.pushes.Load())
+
+	for i := range callers {
+		require.NoError(t, errs[i])
+		assert.Equal(t, record.GetCid(), refs[i].GetCid())
+	}
+
+	// Later pushes of the same record are not coalesced with finished ones
+	_, err := ctrl.pushRecordToStore(context.Background(), record)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), store.pushes.Load())
+}
+
+func TestPushRecordToStore_CallerCanceled(t *testing.T) {
+	store := &blockingPushStore{release: make(chan struct{})}
+	ctrl := NewStoreController(store, &pushDatabase{}, nil, nil, nil, nil, nil, nil, "", nil, nil).(*storeCtrl) //nolint:forcetypeassert
+
+	record := newPushRecord("canceled-agent")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+
+	go func() {
+		_, err := ctrl.pushRecordToStore(ctx, record)
+		errCh <- err
+	}()
+
+	require.Eventually(t, func() bool { return store.pushes.Load() > 0 }, time.Second, time.Millisecond)
+
+	// The canceled caller stops waiting, while the shared push completes for other callers
+	cancel()
+	assert.Equal(t, codes.Canceled, status.Code(<-errCh))
+
+	resultCh := make(chan error, 1)
+
+	go func() {
+		_, err := ctrl.pushRecordToStore(context.Background(), record)
+		resultCh <- err
+	}()
+
+	close(store.release)
+	require.NoError(t, <-resultCh)
+}
+
+// updateStore is a store holding the records pushed to it.
+type updateStore struct {
+	types.StoreAPI
+
+	records map[string]*corev1.Record
+}
+
+func (s *updateStore) Push(_ context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
+	s.records[record.GetCid()] = record
+
+	return &corev1.RecordRef{Cid: record.GetCid()}, nil
+}
+
+func (s *updateStore) Pull(_ context.Context, ref *corev1.RecordRef) (*corev1.Record, error) {
+	record, ok := s.records[ref.GetCid()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "record not found")
+	}
+
+	return record, nil
+}
+
+func TestUpdateRecord(t *testing.T) {
+	record := newPushRecord("patched-agent")
+	store := &updateStore{records: map[string]*corev1.Record{record.GetCid(): record}}
+	ctrl := NewStoreController(store, &pushDatabase{}, nil, nil, nil, nil, nil, nil, "", nil, nil).(*storeCtrl) //nolint:forcetypeassert
+
+	resp, err := ctrl.UpdateRecord(context.Background(), &storev1.UpdateRecordRequest{
+		RecordRef: &corev1.RecordRef{Cid: record.GetCid()},
+		Patch:     []byte(`[{"op": "replace", "path": "/version", "value": "1.1.0"}]`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, record.GetCid(), resp.GetPreviousRecordRef().GetCid())
+
+	// The new record is stored next to the patched one and links to it
+	updated := store.records[resp.GetRecordRef().GetCid()]
+	require.NotNil(t, updated)
+	assert.Len(t, store.records, 2)
+	assert.Equal(t, "1.1.0", updated.GetData().GetFields()["version"].GetStringValue())
+	assert.Equal(t, record.GetCid(), updated.GetData().GetFields()["previous_record_cid"].GetStringValue())
+
+	tests := []struct {
+		name  string
+		cid   string
+		patch string
+		code  codes.Code
+	}{
+		{"missing cid", "", `[{"op": "remove", "path": "/description"}]`, codes.InvalidArgument},
+		{"missing patch", record.GetCid(), "", codes.InvalidArgument},
+		{"unknown record", "bafyunknown", `[{"op": "remove", "path": "/description"}]`, codes.NotFound},
+		{"invalid patch", record.GetCid(), `{"op": "remove"}`, codes.InvalidArgument},
+		{"invalid result", record.GetCid(), `[{"op": "remove", "path": "/name"}]`, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ctrl.UpdateRecord(context.Background(), &storev1.UpdateRecordRequest{
+				RecordRef: &corev1.RecordRef{Cid: tt.cid},
+				Patch:     []byte(tt.patch),
+			})
+			assert.Equal(t, tt.code, status.Code(err), err)
+		})
+	}
+}
+
+// transactionStore is a store holding the records pushed to it, failing to delete the given record.
+type transactionStore struct {
+	updateStore
+
+	failDelete string
+}
+
+func (s *transactionStore) Lookup(_ context.Context, ref *corev1.RecordRef) (*corev1.RecordMeta, error) {
+	if _, ok := s.records[ref.GetCid()]; !ok {
+		return nil, status.Error(codes.NotFound, "record not found")
+	}
+
+	return &corev1.RecordMeta{Cid: ref.GetCid()}, nil
+}
+
+func (s *transactionStore) Delete(_ context.Context, ref *corev1.RecordRef) error {
+	if ref.GetCid() == s.failDelete {
+		return status.Error(codes.Unavailable, "storage unavailable")
+	}
+
+	delete(s.records, ref.GetCid())
+
+	return nil
+}
+
+type transactionDatabase struct {
+	pushDatabase
+}
+
+func (d *transactionDatabase) RemoveRecord(string) error {
+	return nil
+}
+
+func TestApplyTransaction_Events(t *testing.T) {
+	pushed := newPushRecord("pushed-agent")
+	deleted := newPushRecord("deleted-agent")
+	locked := newPushRecord("locked-agent")
+
+	apply := func(t *testing.T, deletes ...*corev1.Record) (*transactionStore, *events.EventBus, error) {
+		t.Helper()
+
+		bus := events.NewEventBus()
+		eventBus := events.NewSafeEventBus(bus)
+		store := &transactionStore{
+			updateStore: updateStore{records: map[string]*corev1.Record{deleted.GetCid(): deleted, locked.GetCid(): locked}},
+			failDelete:  locked.GetCid(),
+		}
+		ctrl := NewStoreController(eventswrap.Wrap(store, eventBus), &transactionDatabase{}, nil, eventBus, nil, nil, nil, nil, "", nil, nil)
+
+		operations := []*storev1.TransactionOperation{
+			{Operation: &storev1.TransactionOperation_Push{Push: pushed}},
+		}
+		for _, record := range deletes {
+			operations = append(operations, &storev1.TransactionOperation{
+				Operation: &storev1.TransactionOperation_Delete{Delete: &corev1.RecordRef{Cid: record.GetCid()}},
+			})
+		}
+
+		_, err := ctrl.ApplyTransaction(t.Context(), &storev1.ApplyTransactionRequest{Operations: operations})
+		bus.WaitForAsyncPublish()
+
+		return store, bus, err
+	}
+
+	t.Run("committed", func(t *testing.T) {
+		store, bus, err := apply(t, deleted)
+		require.NoError(t, err)
+
+		assert.Contains(t, store.records, pushed.GetCid())
+		assert.NotContains(t, store.records, deleted.GetCid())
+
+		// One event for each committed operation, and none for staging them
+		assert.Equal(t, uint64(2), bus.GetMetrics().PublishedTotal)
+	})
+
+	t.Run("rolled back", func(t *testing.T) {
+		store, bus, err := apply(t, deleted, locked)
+		require.Error(t, err)
+
+		// The pushed record is removed and the deleted one restored
+		assert.NotContains(t, store.records, pushed.GetCid())
+		assert.Contains(t, store.records, deleted.GetCid())
+
+		assert.Zero(t, bus.GetMetrics().PublishedTotal)
+	})
+}
+
+// gcStore is a store collecting a fixed amount of garbage.
+type gcStore struct {
+	types.StoreAPI
+}
+
+func (s *gcStore) GarbageCollect(context.Context) (*types.GarbageCollectResult, error) {
+	return &types.GarbageCollectResult{RemovedBlobs: 2, ReclaimedBytes: 1024}, nil
+}
+
+func TestGarbageCollect(t *testing.T) {
+	ops := operations.New()
+	t.Cleanup(func() { _ = ops.Stop() })
+
+	ctrl := NewStoreController(&gcStore{}, nil, nil, nil, nil, nil, nil, nil, "", nil, ops)
+
+	resp, err := ctrl.GarbageCollect(t.Context(), &storev1.GarbageCollectRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), resp.GetRemovedBlobs())
+	assert.Equal(t, uint64(1024), resp.GetReclaimedBytes())
+
+	t.Run("async", func(t *testing.T) {
+		resp, err := ctrl.GarbageCollect(t.Context(), &storev1.GarbageCollectRequest{Async: true})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.GetOperationId())
+
+		op, err := ops.Wait(t.Context(), resp.GetOperationId(), "", time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, GarbageCollectOperationType, op.GetType())
+		assert.Equal(t, corev1.OperationState_OPERATION_STATE_SUCCEEDED, op.GetState())
+
+		result := &storev1.GarbageCollectResponse{}
+		require.NoError(t, op.GetResponse().UnmarshalTo(result))
+		assert.Equal(t, uint64(2), result.GetRemovedBlobs())
+	})
+
+	t.Run("not supported", func(t *testing.T) {
+		ctrl := NewStoreController(&pushStore{}, nil, nil, nil, nil, nil, nil, nil, "", nil, ops)
+
+		_, err := ctrl.GarbageCollect(t.Context(), &storev1.GarbageCollectRequest{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
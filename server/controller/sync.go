@@ -7,31 +7,50 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"strings"
+	"time"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
 	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/operations"
 	ociconfig "github.com/agntcy/dir/server/store/oci/config"
+	"github.com/agntcy/dir/server/sync/invitation"
+	"github.com/agntcy/dir/server/sync/monitor"
+	"github.com/agntcy/dir/server/sync/reconcile"
 	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/types/adapters"
 	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 var syncLogger = logging.Logger("controller/sync")
 
+// WarmCacheOperationType is the type of the operations started by asynchronous WarmCache requests.
+const WarmCacheOperationType = "warm_cache"
+
 // syncCtlr implements the SyncService gRPC interface.
 type syncCtlr struct {
 	storev1.UnimplementedSyncServiceServer
-	db   types.DatabaseAPI
-	opts types.APIOptions
+	db         types.DatabaseAPI
+	store      types.StoreAPI
+	opts       types.APIOptions
+	operations *operations.Manager
 }
 
 // NewSyncController creates a new sync controller.
-func NewSyncController(db types.DatabaseAPI, opts types.APIOptions) storev1.SyncServiceServer {
+func NewSyncController(db types.DatabaseAPI, store types.StoreAPI, opts types.APIOptions, ops *operations.Manager) storev1.SyncServiceServer {
 	return &syncCtlr{
-		db:   db,
-		opts: opts,
+		db:         db,
+		store:      store,
+		opts:       opts,
+		operations: ops,
 	}
 }
 
@@ -43,7 +62,17 @@ func (c *syncCtlr) CreateSync(_ context.Context, req *storev1.CreateSyncRequest)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid remote directory URL: %v", err)
 	}
 
-	id, err := c.db.CreateSync(req.GetRemoteDirectoryUrl(), req.GetCids())
+	mappings, err := types.NamespaceMappingsFromProto(req.GetNamespaceMappings())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace mapping: %v", err)
+	}
+
+	trustPolicy, err := types.SyncTrustPolicyFromProto(req.GetTrustPolicy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid trust policy: %v", err)
+	}
+
+	id, err := c.db.CreateSync(req.GetRemoteDirectoryUrl(), req.GetCids(), mappings, trustPolicy)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create sync: %w", err)
 	}
@@ -92,6 +121,11 @@ func (c *syncCtlr) GetSync(_ context.Context, req *storev1.GetSyncRequest) (*sto
 		SyncId:             syncObj.GetID(),
 		RemoteDirectoryUrl: syncObj.GetRemoteDirectoryURL(),
 		Status:             syncObj.GetStatus(),
+		Parallelism:        uint32(max(syncObj.GetParallelism(), 0)),   //nolint:gosec // non-negative
+		SyncedRecords:      uint64(max(syncObj.GetSyncedRecords(), 0)), //nolint:gosec // non-negative
+		Throughput:         syncObj.GetThroughput(),
+		NamespaceMappings:  types.NamespaceMappingsToProto(syncObj.GetNamespaceMappings()),
+		TrustPolicy:        types.SyncTrustPolicyToProto(syncObj.GetTrustPolicy()),
 	}, nil
 }
 
@@ -134,6 +168,18 @@ func (c *syncCtlr) RequestRegistryCredentials(_ context.Context, req *storev1.Re
 	ociConfig := c.opts.Config().Store.OCI
 	syncConfig := c.opts.Config().Sync
 
+	// Invited nodes present their invitation, which must have been issued by this node
+	if req.GetInvitationToken() != "" {
+		if _, err := invitation.Verify(syncConfig.Invitations.Secret, req.GetInvitationToken(), time.Now()); err != nil {
+			syncLogger.Warn("Refused registry credentials for invalid invitation", "requesting_node_id", req.GetRequestingNodeId(), "error", err)
+
+			return &storev1.RequestRegistryCredentialsResponse{
+				Success:      false,
+				ErrorMessage: fmt.Sprintf("invalid invitation: %v", err),
+			}, nil
+		}
+	}
+
 	// Build registry URL based on configuration
 	registryURL := ociConfig.RegistryAddress
 	if registryURL == "" {
@@ -152,6 +198,368 @@ func (c *syncCtlr) RequestRegistryCredentials(_ context.Context, req *storev1.Re
 	}, nil
 }
 
+// CreateSyncInvitation issues an invitation token for a remote Directory node to sync from this node.
+func (c *syncCtlr) CreateSyncInvitation(_ context.Context, req *storev1.CreateSyncInvitationRequest) (*storev1.CreateSyncInvitationResponse, error) {
+	syncLogger.Debug("Called sync controller's CreateSyncInvitation method", "cids", len(req.GetCids()))
+
+	cfg := c.opts.Config().Sync.Invitations
+	if cfg.Secret == "" || cfg.DirectoryURL == "" {
+		return nil, status.Error(codes.FailedPrecondition, "sync invitations are not enabled on this server")
+	}
+
+	ttl := cfg.TTL
+	if req.Ttl != nil {
+		if err := req.GetTtl().CheckValid(); err != nil || req.GetTtl().AsDuration() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invitation ttl must be positive")
+		}
+
+		ttl = req.GetTtl().AsDuration()
+	}
+
+	expiresAt := time.Now().Add(ttl)
+
+	token, err := invitation.Issue(cfg.Secret, &invitation.Invitation{
+		DirectoryURL: cfg.DirectoryURL,
+		CIDs:         req.GetCids(),
+		ExpiresAt:    expiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue invitation: %v", err)
+	}
+
+	syncLogger.Info("Issued sync invitation", "cids", len(req.GetCids()), "expires_at", expiresAt)
+
+	return &storev1.CreateSyncInvitationResponse{
+		Token:       token,
+		ExpiresTime: expiresAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// AcceptSyncInvitation creates a sync from the remote Directory node that issued the invitation.
+// The invitation is verified by the remote node when credentials are negotiated.
+func (c *syncCtlr) AcceptSyncInvitation(_ context.Context, req *storev1.AcceptSyncInvitationRequest) (*storev1.AcceptSyncInvitationResponse, error) {
+	syncLogger.Debug("Called sync controller's AcceptSyncInvitation method")
+
+	inv, err := invitation.Parse(req.GetToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation: %v", err)
+	}
+
+	if inv.Expired(time.Now()) {
+		return nil, status.Error(codes.FailedPrecondition, "invitation expired")
+	}
+
+	if err := validateRemoteDirectoryURL(inv.DirectoryURL); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid remote directory URL in invitation: %v", err)
+	}
+
+	mappings, err := types.NamespaceMappingsFromProto(req.GetNamespaceMappings())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace mapping: %v", err)
+	}
+
+	trustPolicy, err := types.SyncTrustPolicyFromProto(req.GetTrustPolicy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid trust policy: %v", err)
+	}
+
+	id, err := c.db.CreateInvitedSync(inv.DirectoryURL, inv.CIDs, req.GetToken(), mappings, trustPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sync: %w", err)
+	}
+
+	syncLogger.Info("Accepted sync invitation", "sync_id", id, "remote_url", inv.DirectoryURL)
+
+	return &storev1.AcceptSyncInvitationResponse{
+		SyncId:             id,
+		RemoteDirectoryUrl: inv.DirectoryURL,
+		Cids:               inv.CIDs,
+	}, nil
+}
+
+// ReconcileCIDs compares ranges of the CIDs stored on a remote Directory node with the CIDs stored locally.
+func (c *syncCtlr) ReconcileCIDs(ctx context.Context, req *storev1.ReconcileCIDsRequest) (*storev1.ReconcileCIDsResponse, error) {
+	syncLogger.Debug("Called sync controller's ReconcileCIDs method", "ranges", len(req.GetRanges()))
+
+	lister, ok := c.store.(types.ListerStore)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "listing records is not supported by current store implementation") //nolint:wrapcheck
+	}
+
+	var cids []string
+
+	if err := lister.List(ctx, func(ref *corev1.RecordRef) error {
+		cids = append(cids, ref.GetCid())
+
+		return nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list records: %v", err)
+	}
+
+	threshold := reconcile.DefaultItemThreshold
+	if req.GetItemThreshold() > 0 {
+		threshold = min(int(req.GetItemThreshold()), reconcile.MaxItemThreshold)
+	}
+
+	return reconcile.Respond(reconcile.NewSet(cids), req.GetRanges(), threshold, reconcile.DefaultBranching), nil
+}
+
+// ListQuarantinedRecords streams the records quarantined by the trust policies of syncs that are not released.
+func (c *syncCtlr) ListQuarantinedRecords(req *storev1.ListQuarantinedRecordsRequest, srv storev1.SyncService_ListQuarantinedRecordsServer) error {
+	syncLogger.Debug("Called sync controller's ListQuarantinedRecords method", "req", req)
+
+	records, err := c.db.GetQuarantinedRecords(req.GetSyncId(), int(req.GetOffset()), int(req.GetLimit()))
+	if err != nil {
+		return fmt.Errorf("failed to list quarantined records: %w", err)
+	}
+
+	for _, record := range records {
+		if err := srv.Send(&storev1.QuarantinedRecord{
+			Cid:             record.GetCID(),
+			SyncId:          record.GetSyncID(),
+			Reason:          record.GetReason(),
+			QuarantinedTime: record.GetQuarantinedAt().Format(time.RFC3339),
+		}); err != nil {
+			return fmt.Errorf("failed to send quarantined record: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ReleaseQuarantinedRecord indexes a quarantined record after review.
+// The record is renamed with the namespace mappings of its sync, as done for records passing the trust policy.
+func (c *syncCtlr) ReleaseQuarantinedRecord(ctx context.Context, req *storev1.ReleaseQuarantinedRecordRequest) (*storev1.ReleaseQuarantinedRecordResponse, error) {
+	syncLogger.Debug("Called sync controller's ReleaseQuarantinedRecord method", "req", req)
+
+	quarantined, err := c.db.GetQuarantinedRecord(req.GetCid())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get quarantined record: %v", err)
+	}
+
+	if quarantined == nil || quarantined.IsReleased() {
+		return nil, status.Errorf(codes.NotFound, "record %s is not quarantined", req.GetCid())
+	}
+
+	record, err := c.store.Pull(ctx, &corev1.RecordRef{Cid: req.GetCid()})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to pull quarantined record: %v", err)
+	}
+
+	// The sync may have been deleted since the record was quarantined
+	if syncObj, err := c.db.GetSyncByID(quarantined.GetSyncID()); err == nil {
+		record, err = monitor.RenameRecord(ctx, c.store, record, syncObj.GetNamespaceMappings())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to rename released record: %v", err)
+		}
+	}
+
+	// Release first, so that the record is not quarantined again when the registry is rescanned
+	if err := c.db.ReleaseQuarantinedRecord(req.GetCid()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to release quarantined record: %v", err)
+	}
+
+	if err := c.db.AddRecord(adapters.NewRecordAdapter(record)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to index released record: %v", err)
+	}
+
+	if err := c.db.AddRecordProvenance(record.GetCid(), quarantined.GetSyncID()); err != nil {
+		syncLogger.Error("Failed to add released record provenance", "error", err, "cid", record.GetCid())
+	}
+
+	syncLogger.Info("Released quarantined record", "cid", req.GetCid(), "indexed_cid", record.GetCid(), "sync_id", quarantined.GetSyncID())
+
+	return &storev1.ReleaseQuarantinedRecordResponse{
+		Cid: record.GetCid(),
+	}, nil
+}
+
+// WarmCache prefetches records from a remote Directory node into the local store.
+func (c *syncCtlr) WarmCache(ctx context.Context, req *storev1.WarmCacheRequest) (*storev1.WarmCacheResponse, error) {
+	syncLogger.Debug("Called sync controller's WarmCache method", "req", req)
+
+	remoteDirectoryURL, syncCIDs, err := c.resolveWarmCacheSource(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetAsync() {
+		id, err := c.operations.Start(WarmCacheOperationType, callerID(ctx), func(ctx context.Context, progress *operations.Progress) (proto.Message, error) {
+			return c.warmCache(ctx, req, remoteDirectoryURL, syncCIDs, progress)
+		})
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		return &storev1.WarmCacheResponse{OperationId: id}, nil
+	}
+
+	return c.warmCache(ctx, req, remoteDirectoryURL, syncCIDs, nil)
+}
+
+// warmCache prefetches the records requested by req from the remote Directory,
+// reporting its progress if progress is set.
+func (c *syncCtlr) warmCache(ctx context.Context, req *storev1.WarmCacheRequest, remoteDirectoryURL string, syncCIDs []string, progress *operations.Progress) (*storev1.WarmCacheResponse, error) {
+	conn, err := grpc.NewClient(
+		remoteDirectoryURL,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to create gRPC connection to remote node %s: %v", remoteDirectoryURL, err)
+	}
+	defer conn.Close()
+
+	// Collect the CIDs to prefetch
+	cids := req.GetCids()
+
+	switch {
+	case len(req.GetQueries()) > 0:
+		matched, err := searchRemoteCIDs(ctx, searchv1.NewSearchServiceClient(conn), req.GetQueries())
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to search remote directory %s: %v", remoteDirectoryURL, err)
+		}
+
+		cids = append(cids, matched...)
+	case len(cids) == 0 && len(syncCIDs) > 0:
+		cids = syncCIDs
+	case len(cids) == 0:
+		all, err := searchRemoteCIDs(ctx, searchv1.NewSearchServiceClient(conn), nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to search remote directory %s: %v", remoteDirectoryURL, err)
+		}
+
+		cids = all
+	}
+
+	storeClient := storev1.NewStoreServiceClient(conn)
+	resp := &storev1.WarmCacheResponse{}
+	seen := make(map[string]struct{}, len(cids))
+
+	progress.SetTotal(len(cids))
+
+	for _, cid := range cids {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err() //nolint:wrapcheck
+		}
+
+		progress.Add(1)
+
+		if _, ok := seen[cid]; ok {
+			continue
+		}
+
+		seen[cid] = struct{}{}
+
+		// Skip records that are already present locally
+		if _, err := c.store.Lookup(ctx, &corev1.RecordRef{Cid: cid}); err == nil {
+			resp.CachedCount++
+
+			continue
+		}
+
+		if err := c.fetchRemoteRecord(ctx, storeClient, cid); err != nil {
+			syncLogger.Warn("Failed to prefetch record", "cid", cid, "remote_url", remoteDirectoryURL, "error", err)
+
+			resp.FailedCids = append(resp.FailedCids, cid)
+
+			continue
+		}
+
+		resp.FetchedCount++
+	}
+
+	syncLogger.Info("Cache warming completed", "remote_url", remoteDirectoryURL,
+		"fetched", resp.GetFetchedCount(), "cached", resp.GetCachedCount(), "failed", len(resp.GetFailedCids()))
+
+	return resp, nil
+}
+
+// resolveWarmCacheSource returns the remote directory URL to prefetch from,
+// along with the CIDs tracked by the sync if the source is given by sync ID.
+func (c *syncCtlr) resolveWarmCacheSource(req *storev1.WarmCacheRequest) (string, []string, error) {
+	if (req.GetSyncId() == "") == (req.GetRemoteDirectoryUrl() == "") {
+		return "", nil, status.Error(codes.InvalidArgument, "exactly one of sync ID or remote directory URL is required")
+	}
+
+	if req.GetRemoteDirectoryUrl() != "" {
+		if err := validateRemoteDirectoryURL(req.GetRemoteDirectoryUrl()); err != nil {
+			return "", nil, status.Errorf(codes.InvalidArgument, "invalid remote directory URL: %v", err)
+		}
+
+		return req.GetRemoteDirectoryUrl(), nil, nil
+	}
+
+	syncObj, err := c.db.GetSyncByID(req.GetSyncId())
+	if err != nil {
+		return "", nil, status.Errorf(codes.NotFound, "failed to get sync by ID: %v", err)
+	}
+
+	return syncObj.GetRemoteDirectoryURL(), syncObj.GetCIDs(), nil
+}
+
+// fetchRemoteRecord pulls a single record from the remote Directory
+// and adds it to the local store and search index.
+func (c *syncCtlr) fetchRemoteRecord(ctx context.Context, client storev1.StoreServiceClient, cid string) error {
+	stream, err := client.Pull(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create pull stream: %w", err)
+	}
+
+	if err := stream.Send(&corev1.RecordRef{Cid: cid}); err != nil {
+		return fmt.Errorf("failed to send pull request: %w", err)
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return fmt.Errorf("failed to close send stream: %w", err)
+	}
+
+	record, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("failed to receive record: %w", err)
+	}
+
+	// Records are content-addressed, make sure the remote returned what was asked for
+	if record.GetCid() != cid {
+		return fmt.Errorf("remote returned record with mismatched CID %s", record.GetCid())
+	}
+
+	if _, err := c.store.Push(ctx, record); err != nil {
+		return fmt.Errorf("failed to push record to store: %w", err)
+	}
+
+	// Add record to search index, but don't fail the prefetch if indexing fails
+	if err := c.db.AddRecord(adapters.NewRecordAdapter(record)); err != nil {
+		syncLogger.Error("Failed to add prefetched record to search index", "cid", cid, "error", err)
+	}
+
+	return nil
+}
+
+// searchRemoteCIDs returns the CIDs of records matching the queries on the remote Directory.
+func searchRemoteCIDs(ctx context.Context, client searchv1.SearchServiceClient, queries []*searchv1.RecordQuery) ([]string, error) {
+	stream, err := client.Search(ctx, &searchv1.SearchRequest{Queries: queries})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create search stream: %w", err)
+	}
+
+	var cids []string
+
+	for {
+		obj, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to receive search result: %w", err)
+		}
+
+		cids = append(cids, obj.GetRecordCid())
+	}
+
+	return cids, nil
+}
+
 // validateRemoteDirectoryURL validates the format of a remote directory URL.
 func validateRemoteDirectoryURL(rawURL string) error {
 	if rawURL == "" {
@@ -0,0 +1,202 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/authn"
+	"github.com/agntcy/dir/server/authn/config"
+	apitoken "github.com/agntcy/dir/server/authn/token"
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var tokenLogger = logging.Logger("controller/token")
+
+// tokenScopes maps the API scopes to the scopes of API tokens.
+var tokenScopes = map[corev1.TokenScope]apitoken.Scope{
+	corev1.TokenScope_TOKEN_SCOPE_READ:    apitoken.ScopeRead,
+	corev1.TokenScope_TOKEN_SCOPE_PUSH:    apitoken.ScopePush,
+	corev1.TokenScope_TOKEN_SCOPE_PUBLISH: apitoken.ScopePublish,
+}
+
+type tokenCtlr struct {
+	corev1.UnimplementedTokenServiceServer
+	config    config.TokensConfig
+	exchanger *authn.Exchanger
+}
+
+// NewTokenController creates a new token service controller.
+// API tokens are not enabled on the server if the secret of the configuration is empty.
+func NewTokenController(cfg config.TokensConfig, exchanger *authn.Exchanger) corev1.TokenServiceServer {
+	return &tokenCtlr{
+		config:    cfg,
+		exchanger: exchanger,
+	}
+}
+
+// CreateToken mints a token for a namespace on behalf of the caller, who must be a token admin.
+func (c *tokenCtlr) CreateToken(ctx context.Context, req *corev1.CreateTokenRequest) (*corev1.Token, error) {
+	tokenLogger.Debug("Called token controller's CreateToken method", "namespace", req.GetNamespace(), "scopes", req.GetScopes())
+
+	if !c.config.Enabled() {
+		return nil, status.Error(codes.FailedPrecondition, "API tokens are not enabled on this server")
+	}
+
+	// Tokens must not outlive nor widen their own grants by minting other tokens
+	if _, ok := authn.TokenFromContext(ctx); ok {
+		return nil, status.Error(codes.PermissionDenied, "API tokens cannot mint tokens")
+	}
+
+	if !c.config.IsAdmin(callerID(ctx)) {
+		tokenLogger.Warn("Refused token creation by non-admin caller", "caller", callerID(ctx), "namespace", req.GetNamespace())
+
+		return nil, status.Error(codes.PermissionDenied, "only token admins can mint API tokens")
+	}
+
+	scopes, err := toTokenScopes(req.GetScopes())
+	if err != nil {
+		return nil, err
+	}
+
+	ttl, err := c.tokenTTL(req.GetTtl())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.issue(req.GetNamespace(), scopes, callerID(ctx), time.Now().Add(ttl))
+}
+
+// ExchangeToken mints a token in exchange for an OIDC ID token matching an exchange policy.
+func (c *tokenCtlr) ExchangeToken(ctx context.Context, req *corev1.ExchangeTokenRequest) (*corev1.Token, error) {
+	tokenLogger.Debug("Called token controller's ExchangeToken method", "scopes", req.GetScopes())
+
+	if !c.config.Enabled() || c.exchanger == nil {
+		return nil, status.Error(codes.FailedPrecondition, "API tokens are not enabled on this server")
+	}
+
+	if req.GetIdToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "ID token is required")
+	}
+
+	exchange, err := c.exchanger.Verify(ctx, req.GetIdToken())
+	if err != nil {
+		tokenLogger.Warn("Refused token exchange", "error", err)
+
+		if errors.Is(err, authn.ErrNoExchangePolicy) {
+			return nil, status.Error(codes.PermissionDenied, "ID token cannot be exchanged for API tokens")
+		}
+
+		return nil, status.Errorf(codes.Unauthenticated, "invalid ID token: %v", err)
+	}
+
+	// Exchanged tokens default to the scopes of the policy, and cannot exceed them
+	scopes := exchange.Scopes
+
+	if len(req.GetScopes()) > 0 {
+		scopes, err = toTokenScopes(req.GetScopes())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, scope := range scopes {
+			if !slices.Contains(exchange.Scopes, scope) {
+				return nil, status.Errorf(codes.PermissionDenied, "ID token cannot be exchanged for the %s scope", scope)
+			}
+		}
+	}
+
+	ttl, err := c.tokenTTL(req.GetTtl())
+	if err != nil {
+		return nil, err
+	}
+
+	// Exchanged tokens never outlive their ID token
+	expiresAt := time.Now().Add(ttl)
+	if !exchange.IDToken.Expiry.IsZero() && exchange.IDToken.Expiry.Before(expiresAt) {
+		expiresAt = exchange.IDToken.Expiry
+	}
+
+	return c.issue(exchange.Policy.Namespace, scopes, exchange.IDToken.Issuer+"#"+exchange.IDToken.Subject, expiresAt)
+}
+
+// issue mints a token and returns its API representation.
+func (c *tokenCtlr) issue(namespace string, scopes []apitoken.Scope, subject string, expiresAt time.Time) (*corev1.Token, error) {
+	token, err := apitoken.New(namespace, scopes, subject, expiresAt)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %v", err)
+	}
+
+	bearer, err := apitoken.Issue(c.config.Secret, token)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue token: %v", err)
+	}
+
+	tokenLogger.Info("Issued API token", "token_id", token.ID, "namespace", namespace, "scopes", scopes,
+		"subject", subject, "expires_at", expiresAt)
+
+	apiScopes := make([]corev1.TokenScope, 0, len(scopes))
+
+	for apiScope, scope := range tokenScopes {
+		if slices.Contains(scopes, scope) {
+			apiScopes = append(apiScopes, apiScope)
+		}
+	}
+
+	slices.Sort(apiScopes)
+
+	return &corev1.Token{
+		Token:       bearer,
+		TokenId:     token.ID,
+		Namespace:   namespace,
+		Scopes:      apiScopes,
+		ExpiresTime: expiresAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// tokenTTL returns the requested validity period of a token, or the default one if not set.
+func (c *tokenCtlr) tokenTTL(ttl *durationpb.Duration) (time.Duration, error) {
+	if ttl == nil {
+		return c.config.TTL, nil
+	}
+
+	if err := ttl.CheckValid(); err != nil || ttl.AsDuration() <= 0 {
+		return 0, status.Error(codes.InvalidArgument, "token ttl must be positive")
+	}
+
+	if ttl.AsDuration() > c.config.MaxTTL {
+		return 0, status.Errorf(codes.InvalidArgument, "token ttl must not exceed %s", c.config.MaxTTL)
+	}
+
+	return ttl.AsDuration(), nil
+}
+
+// toTokenScopes converts the requested API scopes, of which there must be at least one.
+func toTokenScopes(apiScopes []corev1.TokenScope) ([]apitoken.Scope, error) {
+	if len(apiScopes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one token scope is required")
+	}
+
+	scopes := make([]apitoken.Scope, 0, len(apiScopes))
+
+	for _, apiScope := range apiScopes {
+		scope, ok := tokenScopes[apiScope]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid token scope %s", apiScope)
+		}
+
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes, nil
+}
@@ -0,0 +1,96 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/authn"
+	"github.com/agntcy/dir/server/authn/config"
+	apitoken "github.com/agntcy/dir/server/authn/token"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestCreateToken(t *testing.T) {
+	ctlr := NewTokenController(config.TokensConfig{
+		Secret: "secret",
+		TTL:    time.Hour,
+		MaxTTL: 24 * time.Hour,
+		Admins: []string{"spiffe://dir.com/admins/*"},
+	}, nil)
+
+	adminCtx := context.WithValue(t.Context(), authn.SpiffeIDContextKey, spiffeid.RequireFromString("spiffe://dir.com/admins/alice"))
+
+	resp, err := ctlr.CreateToken(adminCtx, &corev1.CreateTokenRequest{
+		Namespace: "ml",
+		Scopes:    []corev1.TokenScope{corev1.TokenScope_TOKEN_SCOPE_PUSH, corev1.TokenScope_TOKEN_SCOPE_READ},
+		Ttl:       durationpb.New(30 * time.Minute),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "ml", resp.GetNamespace())
+	assert.Equal(t, []corev1.TokenScope{corev1.TokenScope_TOKEN_SCOPE_READ, corev1.TokenScope_TOKEN_SCOPE_PUSH}, resp.GetScopes())
+
+	// Minted tokens authenticate their bearer in the namespace
+	token, err := apitoken.Verify("secret", resp.GetToken(), time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, resp.GetTokenId(), token.ID)
+	assert.ElementsMatch(t, []apitoken.Scope{apitoken.ScopePush, apitoken.ScopeRead}, token.Scopes)
+	assert.WithinDuration(t, time.Now().Add(30*time.Minute), time.Unix(token.ExpiresAt, 0), time.Minute)
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for name, req := range map[string]*corev1.CreateTokenRequest{
+			"no scopes":         {Namespace: "ml"},
+			"invalid scope":     {Namespace: "ml", Scopes: []corev1.TokenScope{corev1.TokenScope_TOKEN_SCOPE_UNSPECIFIED}},
+			"invalid ttl":       {Namespace: "ml", Scopes: []corev1.TokenScope{corev1.TokenScope_TOKEN_SCOPE_READ}, Ttl: durationpb.New(-time.Hour)},
+			"ttl over maximum":  {Namespace: "ml", Scopes: []corev1.TokenScope{corev1.TokenScope_TOKEN_SCOPE_READ}, Ttl: durationpb.New(48 * time.Hour)},
+			"invalid namespace": {Namespace: "Not A Namespace", Scopes: []corev1.TokenScope{corev1.TokenScope_TOKEN_SCOPE_READ}},
+		} {
+			_, err := ctlr.CreateToken(adminCtx, req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+		}
+	})
+
+	t.Run("only admins mint tokens", func(t *testing.T) {
+		for _, ctx := range []context.Context{
+			t.Context(),
+			context.WithValue(t.Context(), authn.SpiffeIDContextKey, spiffeid.RequireFromString("spiffe://dir.com/ci")),
+		} {
+			_, err := ctlr.CreateToken(ctx, &corev1.CreateTokenRequest{
+				Namespace: "ml",
+				Scopes:    []corev1.TokenScope{corev1.TokenScope_TOKEN_SCOPE_PUSH},
+			})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		}
+	})
+
+	t.Run("tokens cannot mint tokens", func(t *testing.T) {
+		ctx := context.WithValue(adminCtx, authn.TokenContextKey, token)
+
+		_, err := ctlr.CreateToken(ctx, &corev1.CreateTokenRequest{
+			Namespace: "ml",
+			Scopes:    []corev1.TokenScope{corev1.TokenScope_TOKEN_SCOPE_READ},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("tokens disabled", func(t *testing.T) {
+		_, err := NewTokenController(config.TokensConfig{}, nil).CreateToken(t.Context(), &corev1.CreateTokenRequest{
+			Namespace: "ml",
+			Scopes:    []corev1.TokenScope{corev1.TokenScope_TOKEN_SCOPE_READ},
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = NewTokenController(config.TokensConfig{}, nil).ExchangeToken(t.Context(), &corev1.ExchangeTokenRequest{IdToken: "id-token"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	watermarkVersion = "v1:"
+
+	// watermarkMargin moves watermarks back from the time a request was served, so that
+	// records written while it was served are returned again rather than missed.
+	watermarkMargin = time.Second
+)
+
+// newWatermark returns the watermark of a request served at the given time.
+func newWatermark(servedAt time.Time) string {
+	value := watermarkVersion + strconv.FormatInt(servedAt.Add(-watermarkMargin).UnixNano(), 10)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+// parseUpdatedSince returns the time since which records must have been added or updated
+// to be returned, from either a time or a watermark of a previous request.
+// Returns the zero time if neither is set.
+func parseUpdatedSince(updatedSince *timestamppb.Timestamp, watermark string) (time.Time, error) {
+	if updatedSince != nil && watermark != "" {
+		return time.Time{}, status.Error(codes.InvalidArgument, "updated_since and since_watermark cannot be set together") //nolint:wrapcheck
+	}
+
+	if updatedSince != nil {
+		if err := updatedSince.CheckValid(); err != nil {
+			return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid updated_since time: %v", err) //nolint:wrapcheck
+		}
+
+		return updatedSince.AsTime(), nil
+	}
+
+	if watermark == "" {
+		return time.Time{}, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(watermark)
+	if err != nil || !strings.HasPrefix(string(decoded), watermarkVersion) {
+		return time.Time{}, status.Error(codes.InvalidArgument, "invalid watermark") //nolint:wrapcheck
+	}
+
+	nanos, err := strconv.ParseInt(strings.TrimPrefix(string(decoded), watermarkVersion), 10, 64)
+	if err != nil {
+		return time.Time{}, status.Error(codes.InvalidArgument, "invalid watermark") //nolint:wrapcheck
+	}
+
+	return time.Unix(0, nanos), nil
+}
@@ -0,0 +1,38 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParseUpdatedSince(t *testing.T) {
+	servedAt := time.Now()
+
+	// Watermarks are moved back from the time the request was served
+	since, err := parseUpdatedSince(nil, newWatermark(servedAt))
+	require.NoError(t, err)
+	assert.True(t, since.Equal(servedAt.Add(-watermarkMargin)))
+
+	since, err = parseUpdatedSince(timestamppb.New(servedAt), "")
+	require.NoError(t, err)
+	assert.True(t, since.Equal(servedAt))
+
+	since, err = parseUpdatedSince(nil, "")
+	require.NoError(t, err)
+	assert.True(t, since.IsZero())
+
+	_, err = parseUpdatedSince(timestamppb.New(servedAt), newWatermark(servedAt))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = parseUpdatedSince(nil, "not-a-watermark")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -4,17 +4,74 @@
 package config
 
 import (
+	"time"
+
+	postgresconfig "github.com/agntcy/dir/server/database/postgres/config"
 	sqliteconfig "github.com/agntcy/dir/server/database/sqlite/config"
 )
 
 const (
 	DefaultDBType = "sqlite"
+
+	DefaultReplicaHealthCheckInterval = 30 * time.Second
+
+	DefaultLocatorsLowercaseScheme   = true
+	DefaultLocatorsTrimTrailingSlash = true
+	DefaultLocatorsPinDigests        = true
+	DefaultLocatorsDeduplicate       = true
 )
 
 type Config struct {
-	// DBType is the type of the database.
+	// DBType is the type of the database, either sqlite or postgres.
 	DBType string `json:"db_type,omitempty" mapstructure:"db_type"`
 
+	// IndexedAnnotations are the record annotation keys indexed by the database.
+	// Records can be searched by these annotations, other annotations remain unindexed.
+	// Changes apply to records indexed afterwards.
+	IndexedAnnotations []string `json:"indexed_annotations,omitempty" mapstructure:"indexed_annotations"`
+
+	// Normalization of indexed record locators.
+	Locators LocatorsConfig `json:"locators,omitempty" mapstructure:"locators"`
+
+	// Read replicas of the database.
+	Replicas ReplicasConfig `json:"replicas,omitempty" mapstructure:"replicas"`
+
 	// Config for SQLite database.
 	SQLite sqliteconfig.Config `json:"sqlite,omitempty" mapstructure:"sqlite"`
+
+	// Config for PostgreSQL database.
+	Postgres postgresconfig.Config `json:"postgres,omitempty" mapstructure:"postgres"`
+}
+
+// ReplicasConfig configures read replicas.
+// Read-only queries (search, list and lookup of records) are routed to healthy replicas,
+// while writes and all other queries go to the primary database.
+type ReplicasConfig struct {
+	// ReadDSNs are the data source names of the read replicas.
+	// For SQLite, these are paths to database files replicated from the primary.
+	// For PostgreSQL, these are connection strings of streaming replicas, sharing the pool settings of the primary.
+	ReadDSNs []string `json:"read_dsns,omitempty" mapstructure:"read_dsns"`
+
+	// HealthCheckInterval is the minimum interval between health checks of a replica.
+	// Unhealthy replicas are skipped until a later health check succeeds.
+	HealthCheckInterval time.Duration `json:"health_check_interval,omitempty" mapstructure:"health_check_interval"`
+}
+
+// LocatorsConfig configures the normalization of locator URLs when records are indexed,
+// and of the locator URLs searched for, so that equivalent URLs match the same records.
+// Stored records are not modified, as their content is addressed by their CID.
+// Changes apply to records indexed afterwards.
+type LocatorsConfig struct {
+	// LowercaseScheme lowercases the scheme of locator URLs, e.g. HTTPS://host to https://host.
+	LowercaseScheme bool `json:"lowercase_scheme,omitempty" mapstructure:"lowercase_scheme"`
+
+	// TrimTrailingSlash removes trailing slashes from locator URLs.
+	TrimTrailingSlash bool `json:"trim_trailing_slash,omitempty" mapstructure:"trim_trailing_slash"`
+
+	// PinDigests appends the digest of docker-image locators to image references
+	// without one, e.g. ghcr.io/org/image:v1 to ghcr.io/org/image:v1@sha256:...
+	PinDigests bool `json:"pin_digests,omitempty" mapstructure:"pin_digests"`
+
+	// Deduplicate indexes locators with the same type and normalized URL only once.
+	Deduplicate bool `json:"deduplicate,omitempty" mapstructure:"deduplicate"`
 }
@@ -6,6 +6,7 @@ package database
 import (
 	"fmt"
 
+	"github.com/agntcy/dir/server/database/postgres"
 	"github.com/agntcy/dir/server/database/sqlite"
 	"github.com/agntcy/dir/server/types"
 )
@@ -13,18 +14,30 @@ import (
 type DB string
 
 const (
-	SQLite DB = "sqlite"
+	SQLite   DB = "sqlite"
+	Postgres DB = "postgres"
 )
 
 func New(opts types.APIOptions) (types.DatabaseAPI, error) {
 	switch db := DB(opts.Config().Database.DBType); db {
 	case SQLite:
-		sqliteDB, err := sqlite.New(opts.Config().Database.SQLite.DBPath)
+		cfg := opts.Config().Database
+
+		sqliteDB, err := sqlite.New(cfg.SQLite, cfg.IndexedAnnotations, cfg.Locators, cfg.Replicas)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create SQLite database: %w", err)
 		}
 
 		return sqliteDB, nil
+	case Postgres:
+		cfg := opts.Config().Database
+
+		postgresDB, err := postgres.New(cfg.Postgres, cfg.IndexedAnnotations, cfg.Locators, cfg.Replicas)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create PostgreSQL database: %w", err)
+		}
+
+		return postgresDB, nil
 	default:
 		return nil, fmt.Errorf("unsupported database=%s", db)
 	}
@@ -0,0 +1,216 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/agntcy/dir/server/types"
+	"gorm.io/gorm"
+)
+
+type Alias struct {
+	GormID      uint `gorm:"primarykey"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Name        string `gorm:"not null;uniqueIndex:idx_alias_name_tag"`
+	Tag         string `gorm:"not null;uniqueIndex:idx_alias_name_tag"`
+	CID         string `gorm:"column:record_cid;not null;index"`
+	UpdatedBy   string // SPIFFE ID of the identity that last changed the alias, empty without authentication
+	UpdatedTime string `gorm:"not null"`
+}
+
+func (a *Alias) GetName() string {
+	return a.Name
+}
+
+func (a *Alias) GetTag() string {
+	return a.Tag
+}
+
+func (a *Alias) GetCID() string {
+	return a.CID
+}
+
+func (a *Alias) GetUpdatedTime() string {
+	return a.UpdatedTime
+}
+
+func (a *Alias) GetUpdatedBy() string {
+	return a.UpdatedBy
+}
+
+type AliasChange struct {
+	GormID      uint `gorm:"primarykey"`
+	CreatedAt   time.Time
+	Name        string `gorm:"not null;index:idx_alias_change_name_tag"`
+	Tag         string `gorm:"not null;index:idx_alias_change_name_tag"`
+	PreviousCID string // Empty if the alias was created
+	CID         string `gorm:"column:record_cid"` // Empty if the alias was deleted
+	ChangedBy   string
+	ChangedTime string `gorm:"not null"`
+}
+
+func (c *AliasChange) GetName() string {
+	return c.Name
+}
+
+func (c *AliasChange) GetTag() string {
+	return c.Tag
+}
+
+func (c *AliasChange) GetPreviousCID() string {
+	return c.PreviousCID
+}
+
+func (c *AliasChange) GetCID() string {
+	return c.CID
+}
+
+func (c *AliasChange) GetChangedTime() string {
+	return c.ChangedTime
+}
+
+func (c *AliasChange) GetChangedBy() string {
+	return c.ChangedBy
+}
+
+func (d *DB) SetAlias(name, tag, cid string, expectedCID *string, actor string) (string, error) {
+	var previousCID string
+
+	err := d.gormDB.Transaction(func(tx *gorm.DB) error {
+		var alias Alias
+
+		err := tx.Where("name = ? AND tag = ?", name, tag).First(&alias).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get alias: %w", err)
+		}
+
+		previousCID = alias.CID
+
+		if expectedCID != nil && *expectedCID != previousCID {
+			return fmt.Errorf("%w: %s:%s points to %q", types.ErrAliasConflict, name, tag, previousCID)
+		}
+
+		now := time.Now().Format(time.RFC3339)
+
+		alias.Name = name
+		alias.Tag = tag
+		alias.CID = cid
+		alias.UpdatedBy = actor
+		alias.UpdatedTime = now
+
+		if err := tx.Save(&alias).Error; err != nil {
+			return fmt.Errorf("failed to save alias: %w", err)
+		}
+
+		return appendAliasChange(tx, name, tag, previousCID, cid, actor, now)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	logger.Debug("Set alias in database", "name", name, "tag", tag, "cid", cid, "previous_cid", previousCID)
+
+	return previousCID, nil
+}
+
+func (d *DB) DeleteAlias(name, tag, actor string) error {
+	err := d.gormDB.Transaction(func(tx *gorm.DB) error {
+		var alias Alias
+		if err := tx.Where("name = ? AND tag = ?", name, tag).First(&alias).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return types.ErrAliasNotFound
+			}
+
+			return fmt.Errorf("failed to get alias: %w", err)
+		}
+
+		if err := tx.Delete(&alias).Error; err != nil {
+			return fmt.Errorf("failed to delete alias: %w", err)
+		}
+
+		return appendAliasChange(tx, name, tag, alias.CID, "", actor, time.Now().Format(time.RFC3339))
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Deleted alias from database", "name", name, "tag", tag)
+
+	return nil
+}
+
+func (d *DB) GetAlias(name, tag string) (types.AliasObject, error) {
+	var alias Alias
+	if err := d.gormDB.Where("name = ? AND tag = ?", name, tag).First(&alias).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrAliasNotFound
+		}
+
+		return nil, err
+	}
+
+	return &alias, nil
+}
+
+func (d *DB) GetAliases(name string) ([]types.AliasObject, error) {
+	query := d.gormDB.Order("name, tag")
+
+	if name != "" {
+		query = query.Where("name = ?", name)
+	}
+
+	var aliases []Alias
+	if err := query.Find(&aliases).Error; err != nil {
+		return nil, err
+	}
+
+	aliasObjects := make([]types.AliasObject, len(aliases))
+	for i := range aliases {
+		aliasObjects[i] = &aliases[i]
+	}
+
+	return aliasObjects, nil
+}
+
+func (d *DB) GetAliasHistory(name, tag string) ([]types.AliasChangeObject, error) {
+	query := d.gormDB.Where("name = ?", name).Order("gorm_id")
+
+	if tag != "" {
+		query = query.Where("tag = ?", tag)
+	}
+
+	var changes []AliasChange
+	if err := query.Find(&changes).Error; err != nil {
+		return nil, err
+	}
+
+	changeObjects := make([]types.AliasChangeObject, len(changes))
+	for i := range changes {
+		changeObjects[i] = &changes[i]
+	}
+
+	return changeObjects, nil
+}
+
+// appendAliasChange appends a change of an alias to the alias history.
+func appendAliasChange(tx *gorm.DB, name, tag, previousCID, cid, actor, changedTime string) error {
+	change := &AliasChange{
+		Name:        name,
+		Tag:         tag,
+		PreviousCID: previousCID,
+		CID:         cid,
+		ChangedBy:   actor,
+		ChangedTime: changedTime,
+	}
+
+	if err := tx.Create(change).Error; err != nil {
+		return fmt.Errorf("failed to append alias change: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"testing"
+
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAliases(t *testing.T) {
+	db := setupTestDB(t)
+
+	const (
+		name  = "directory.agntcy.org/example/agent"
+		alice = "spiffe://example.org/alice"
+	)
+
+	previous, err := db.SetAlias(name, "stable", "cid-1", nil, alice)
+	require.NoError(t, err)
+	assert.Empty(t, previous)
+
+	alias, err := db.GetAlias(name, "stable")
+	require.NoError(t, err)
+	assert.Equal(t, "cid-1", alias.GetCID())
+	assert.Equal(t, alice, alias.GetUpdatedBy())
+
+	// Retargets are rejected if the alias moved
+	stale := "cid-0"
+	_, err = db.SetAlias(name, "stable", "cid-2", &stale, "")
+	require.ErrorIs(t, err, types.ErrAliasConflict)
+
+	created := ""
+	_, err = db.SetAlias(name, "stable", "cid-2", &created, "")
+	require.ErrorIs(t, err, types.ErrAliasConflict)
+
+	current := "cid-1"
+	previous, err = db.SetAlias(name, "stable", "cid-2", &current, "")
+	require.NoError(t, err)
+	assert.Equal(t, "cid-1", previous)
+
+	_, err = db.SetAlias(name, "latest", "cid-2", &created, "")
+	require.NoError(t, err)
+
+	_, err = db.SetAlias("other", "latest", "cid-3", nil, "")
+	require.NoError(t, err)
+
+	aliases, err := db.GetAliases(name)
+	require.NoError(t, err)
+	require.Len(t, aliases, 2)
+	assert.Equal(t, "latest", aliases[0].GetTag())
+	assert.Equal(t, "stable", aliases[1].GetTag())
+
+	aliases, err = db.GetAliases("")
+	require.NoError(t, err)
+	assert.Len(t, aliases, 3)
+
+	require.NoError(t, db.DeleteAlias(name, "stable", alice))
+	require.ErrorIs(t, db.DeleteAlias(name, "stable", alice), types.ErrAliasNotFound)
+
+	_, err = db.GetAlias(name, "stable")
+	require.ErrorIs(t, err, types.ErrAliasNotFound)
+
+	// The history keeps every applied change, oldest first
+	history, err := db.GetAliasHistory(name, "stable")
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	assert.Equal(t, []string{"", "cid-1", "cid-2"}, []string{history[0].GetPreviousCID(), history[1].GetPreviousCID(), history[2].GetPreviousCID()})
+	assert.Equal(t, []string{"cid-1", "cid-2", ""}, []string{history[0].GetCID(), history[1].GetCID(), history[2].GetCID()})
+	assert.Equal(t, alice, history[2].GetChangedBy())
+
+	history, err = db.GetAliasHistory(name, "")
+	require.NoError(t, err)
+	assert.Len(t, history, 4)
+}
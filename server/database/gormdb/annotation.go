@@ -0,0 +1,91 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/agntcy/dir/server/database/utils"
+	"github.com/agntcy/dir/server/types"
+	"gorm.io/gorm"
+)
+
+// Annotation is an indexed record annotation.
+// Only annotations with keys declared as indexed are stored.
+type Annotation struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	RecordCID string `gorm:"column:record_cid;not null;index"`
+	Key       string `gorm:"not null;index:idx_annotations_key_value,priority:1"`
+	Value     string `gorm:"not null;index:idx_annotations_key_value,priority:2"`
+}
+
+// convertAnnotations transforms the indexed annotations to database structs.
+func convertAnnotations(annotations map[string]string, indexedKeys []string, recordCID string) []Annotation {
+	var result []Annotation
+
+	for _, key := range indexedKeys {
+		value, ok := annotations[key]
+		if !ok {
+			continue
+		}
+
+		result = append(result, Annotation{
+			RecordCID: recordCID,
+			Key:       key,
+			Value:     value,
+		})
+	}
+
+	return result
+}
+
+// handleAnnotationFilters applies annotation filters to the query.
+// Each key is matched through its own join so that filters on different keys
+// are combined with AND semantics, while values of the same key are OR'ed.
+func (d *DB) handleAnnotationFilters(query *gorm.DB, annotations map[string][]string) *gorm.DB {
+	keys := make([]string, 0, len(annotations))
+	for key := range annotations {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for i, key := range keys {
+		if !slices.Contains(d.indexedAnnotations, key) {
+			_ = query.AddError(fmt.Errorf("%w: %q", types.ErrAnnotationNotIndexed, key))
+
+			return query
+		}
+
+		alias := fmt.Sprintf("annotations_%d", i)
+		query = query.Joins(fmt.Sprintf("JOIN annotations AS %[1]s ON %[1]s.record_cid = records.record_cid AND %[1]s.key = ?", alias), key)
+
+		// Values are matched case-sensitively so that the key/value index is used
+		conditions := make([]string, 0, len(annotations[key]))
+		args := make([]any, 0, len(annotations[key]))
+
+		for _, value := range annotations[key] {
+			if utils.ContainsWildcards(value) {
+				condition, arg := utils.BuildGlobCondition(d.dialect, alias+".value", value)
+				conditions = append(conditions, condition)
+				args = append(args, arg)
+			} else {
+				conditions = append(conditions, alias+".value = ?")
+				args = append(args, value)
+			}
+		}
+
+		if len(conditions) > 0 {
+			query = query.Where("("+strings.Join(conditions, " OR ")+")", args...)
+		}
+	}
+
+	return query
+}
@@ -0,0 +1,56 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"testing"
+
+	dbconfig "github.com/agntcy/dir/server/database/config"
+	"github.com/agntcy/dir/server/types"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// TestSQLiteQueries checks the SQL generated for the SQLite dialect by the queries
+// adapted to the dialect. The PostgreSQL dialect is tested in the postgres package.
+func TestSQLiteQueries(t *testing.T) {
+	gormDB, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+		Logger: NewLogger(),
+		DryRun: true,
+	})
+	require.NoError(t, err)
+
+	var queries []string
+
+	err = gormDB.Callback().Query().After("gorm:query").Register("test:capture", func(tx *gorm.DB) {
+		queries = append(queries, tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...))
+	})
+	require.NoError(t, err)
+
+	db := New(gormDB, nil, []string{"team"}, dbconfig.LocatorsConfig{}, dbconfig.ReplicasConfig{})
+
+	_, err = db.GetRecordCIDs(
+		types.WithSkillNames("Natural*"),
+		types.WithAnnotation("team", "Team-?"),
+		types.WithPreferredRegions("EU-West", "us-east"),
+	)
+	require.NoError(t, err)
+	require.Len(t, queries, 1)
+
+	query := queries[0]
+
+	// Records matched through several joined rows are deduplicated with DISTINCT
+	assert.Contains(t, query, "SELECT DISTINCT records.record_cid FROM `records`")
+	assert.NotContains(t, query, "GROUP BY")
+
+	// Wildcards are matched with GLOB, case-insensitively for fields and case-sensitively for annotations
+	assert.Contains(t, query, `LOWER(skills.name) GLOB "natural*"`)
+	assert.Contains(t, query, `(annotations_0.value GLOB "Team-?")`)
+
+	// Records are ranked by their best locator region among the preferred regions
+	assert.Contains(t, query, `ORDER BY COALESCE((SELECT MIN(CASE locators.region WHEN "eu-west" THEN 0 WHEN "us-east" THEN 1 ELSE 2 END) `+
+		`FROM locators WHERE locators.record_cid = records.record_cid), 2)`)
+}
@@ -1,7 +1,7 @@
 // Copyright AGNTCY Contributors (https://github.com/agntcy)
 // SPDX-License-Identifier: Apache-2.0
 
-package sqlite
+package gormdb
 
 import (
 	"time"
@@ -19,7 +19,7 @@ type Domain struct {
 }
 
 func (domain *Domain) GetAnnotations() map[string]string {
-	// SQLite domains don't store annotations, return empty map
+	// Indexed domains don't store annotations, return empty map
 	return make(map[string]string)
 }
 
@@ -31,7 +31,7 @@ func (domain *Domain) GetID() uint64 {
 	return domain.DomainID
 }
 
-// convertDomains converts domain interfaces to SQLite Domain structs.
+// convertDomains converts domain interfaces to database Domain structs.
 func convertDomains(domains []types.Domain, recordCID string) []Domain {
 	result := make([]Domain, len(domains))
 	for i, domain := range domains {
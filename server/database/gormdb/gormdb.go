@@ -0,0 +1,144 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gormdb implements the database on GORM, shared by the SQL databases.
+// Queries are written for SQLite and adapted to the dialect of the connections,
+// so that databases of other dialects supported by GORM, such as PostgreSQL,
+// share the same models and queries.
+package gormdb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	dbconfig "github.com/agntcy/dir/server/database/config"
+	"github.com/agntcy/dir/server/database/utils"
+	"github.com/agntcy/dir/utils/logging"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+var logger = logging.Logger("database/gormdb")
+
+type DB struct {
+	gormDB *gorm.DB
+
+	// dialect is the SQL dialect of the database, which queries are adapted to
+	dialect utils.Dialect
+
+	// replicas routes read-only queries to read replicas, if configured
+	replicas *utils.Replicas
+
+	// indexedAnnotations are the annotation keys indexed for search
+	indexedAnnotations []string
+
+	// locators configures the normalization of indexed and searched locator URLs
+	locators dbconfig.LocatorsConfig
+}
+
+// NewLogger returns the GORM logger of the database connections.
+func NewLogger() gormlogger.Interface {
+	// Create a custom logger configuration that ignores "record not found" errors
+	// since these are expected during normal operation (checking if records exist)
+	return gormlogger.New(
+		log.New(os.Stdout, "\r\n", log.LstdFlags),
+		gormlogger.Config{
+			SlowThreshold:             200 * time.Millisecond, //nolint:mnd
+			LogLevel:                  gormlogger.Warn,
+			IgnoreRecordNotFoundError: true,
+			Colorful:                  true,
+		},
+	)
+}
+
+// Migrate creates or updates the schema of the database.
+func Migrate(db *gorm.DB) error {
+	// Migrate record-related schema
+	if err := db.AutoMigrate(Record{}, Locator{}, Skill{}, Module{}, Domain{}, Reference{}, Annotation{}); err != nil {
+		return fmt.Errorf("failed to migrate record schema: %w", err)
+	}
+
+	// Migrate scan-related schema
+	if err := db.AutoMigrate(RecordScan{}); err != nil {
+		return fmt.Errorf("failed to migrate scan schema: %w", err)
+	}
+
+	// Migrate signer-related schema
+	if err := db.AutoMigrate(RecordSigner{}); err != nil {
+		return fmt.Errorf("failed to migrate signer schema: %w", err)
+	}
+
+	// Migrate alias-related schema
+	if err := db.AutoMigrate(Alias{}, AliasChange{}); err != nil {
+		return fmt.Errorf("failed to migrate alias schema: %w", err)
+	}
+
+	// Migrate sync-related schema
+	if err := db.AutoMigrate(Sync{}, QuarantinedRecord{}, RecordProvenance{}); err != nil {
+		return fmt.Errorf("failed to migrate sync schema: %w", err)
+	}
+
+	// Migrate publication-related schema
+	if err := db.AutoMigrate(Publication{}); err != nil {
+		return fmt.Errorf("failed to migrate publication schema: %w", err)
+	}
+
+	// Migrate webhook-related schema
+	if err := db.AutoMigrate(RecordWebhook{}); err != nil {
+		return fmt.Errorf("failed to migrate webhook schema: %w", err)
+	}
+
+	return nil
+}
+
+// New creates a database from GORM connections to a database migrated with Migrate, and its read replicas.
+func New(db *gorm.DB, replicaDBs []*gorm.DB, indexedAnnotations []string, locatorsConfig dbconfig.LocatorsConfig, replicasConfig dbconfig.ReplicasConfig) *DB {
+	return &DB{
+		gormDB:             db,
+		dialect:            utils.Dialect(db.Dialector.Name()),
+		replicas:           utils.NewReplicas(db, replicaDBs, replicasConfig.HealthCheckInterval),
+		indexedAnnotations: indexedAnnotations,
+		locators:           locatorsConfig,
+	}
+}
+
+// reader returns the connection to use for read-only queries.
+func (d *DB) reader() *gorm.DB {
+	if d.replicas == nil {
+		return d.gormDB
+	}
+
+	return d.replicas.Reader()
+}
+
+// IsReady checks if the database connection is ready to serve traffic.
+// Returns true if the database connection is established and can execute queries.
+func (d *DB) IsReady(ctx context.Context) bool {
+	if d.gormDB == nil {
+		logger.Debug("Database not ready: gormDB is nil")
+
+		return false
+	}
+
+	// Get the underlying SQL database
+	sqlDB, err := d.gormDB.DB()
+	if err != nil {
+		logger.Debug("Database not ready: failed to get SQL DB", "error", err)
+
+		return false
+	}
+
+	// Ping the database with context
+	if err := sqlDB.PingContext(ctx); err != nil {
+		logger.Debug("Database not ready: ping failed", "error", err)
+
+		return false
+	}
+
+	logger.Debug("Database ready")
+
+	return true
+}
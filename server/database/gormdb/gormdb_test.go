@@ -0,0 +1,64 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"path/filepath"
+	"testing"
+
+	dbconfig "github.com/agntcy/dir/server/database/config"
+	"github.com/agntcy/dir/server/types"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// openTestDB opens and migrates a SQLite database at the given path.
+func openTestDB(t *testing.T, path string) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{
+		Logger: NewLogger(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, Migrate(db))
+
+	return db
+}
+
+func TestReadReplicas(t *testing.T) {
+	dir := t.TempDir()
+
+	// Prepare a replica holding records that the primary does not have
+	replicaDB := openTestDB(t, filepath.Join(dir, "replica.db"))
+	replica := New(replicaDB, nil, nil, dbconfig.LocatorsConfig{}, dbconfig.ReplicasConfig{})
+	createTestData(t, replica)
+
+	db := New(openTestDB(t, filepath.Join(dir, "primary.db")), []*gorm.DB{replicaDB}, nil, dbconfig.LocatorsConfig{}, dbconfig.ReplicasConfig{
+		// Check replica health on every read
+		HealthCheckInterval: 0,
+	})
+
+	// Reads are served by the replica
+	cids, err := db.GetRecordCIDs()
+	require.NoError(t, err)
+	assert.Len(t, cids, 3)
+
+	// Writes go to the primary
+	syncID, err := db.CreateSync("remote:8888", nil, nil, types.SyncTrustPolicy{})
+	require.NoError(t, err)
+
+	_, err = replica.GetSyncByID(syncID)
+	require.Error(t, err)
+
+	// Reads fail back to the primary when the replica is unhealthy
+	replicaSQLDB, err := db.reader().DB()
+	require.NoError(t, err)
+	require.NoError(t, replicaSQLDB.Close())
+
+	cids, err = db.GetRecordCIDs()
+	require.NoError(t, err)
+	assert.Empty(t, cids)
+}
@@ -0,0 +1,90 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/agntcy/dir/server/types"
+	"gorm.io/gorm/clause"
+)
+
+type Locator struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	RecordCID string `gorm:"column:record_cid;not null;index"`
+	Type      string `gorm:"not null"`
+	URL       string `gorm:"not null"`
+
+	// Lowercase region hint of the locator, if any
+	Region string `gorm:"not null;default:'';index"`
+}
+
+func (locator *Locator) GetAnnotations() map[string]string {
+	// Indexed locators only store the region hint annotation
+	annotations := make(map[string]string)
+	if locator.Region != "" {
+		annotations[types.LocatorRegionAnnotation] = locator.Region
+	}
+
+	return annotations
+}
+
+func (locator *Locator) GetType() string {
+	return locator.Type
+}
+
+func (locator *Locator) GetURL() string {
+	return locator.URL
+}
+
+func (locator *Locator) GetSize() uint64 {
+	// Indexed locators don't store size information
+	return 0
+}
+
+func (locator *Locator) GetDigest() string {
+	// Indexed locators don't store digest information
+	return ""
+}
+
+// convertLocators transforms interface types to database structs.
+func convertLocators(locators []types.Locator, recordCID string) []Locator {
+	result := make([]Locator, len(locators))
+	for i, locator := range locators {
+		result[i] = Locator{
+			RecordCID: recordCID,
+			Type:      locator.GetType(),
+			URL:       locator.GetURL(),
+			Region:    strings.ToLower(types.GetLocatorRegion(locator)),
+		}
+	}
+
+	return result
+}
+
+// regionRankOrder orders records by the rank of their best locator region among the preferred regions.
+// Records without a locator in a preferred region come last.
+func regionRankOrder(preferredRegions []string) clause.OrderBy {
+	var sql strings.Builder
+
+	vars := make([]any, 0, len(preferredRegions))
+
+	sql.WriteString("COALESCE((SELECT MIN(CASE locators.region")
+
+	for i, region := range preferredRegions {
+		fmt.Fprintf(&sql, " WHEN ? THEN %d", i)
+
+		vars = append(vars, strings.ToLower(strings.TrimSpace(region)))
+	}
+
+	fmt.Fprintf(&sql, " ELSE %[1]d END) FROM locators WHERE locators.record_cid = records.record_cid), %[1]d)", len(preferredRegions))
+
+	return clause.OrderBy{
+		Expression: clause.Expr{SQL: sql.String(), Vars: vars, WithoutParentheses: true},
+	}
+}
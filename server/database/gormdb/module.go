@@ -1,7 +1,7 @@
 // Copyright AGNTCY Contributors (https://github.com/agntcy)
 // SPDX-License-Identifier: Apache-2.0
 
-package sqlite
+package gormdb
 
 import (
 	"time"
@@ -22,11 +22,11 @@ func (module *Module) GetName() string {
 }
 
 func (module *Module) GetData() map[string]any {
-	// SQLite modules don't store data, return empty map
+	// Indexed modules don't store data, return empty map
 	return make(map[string]any)
 }
 
-// convertModules transforms interface types to SQLite structs.
+// convertModules transforms interface types to database structs.
 func convertModules(modules []types.Module, recordCID string) []Module {
 	result := make([]Module, len(modules))
 	for i, module := range modules {
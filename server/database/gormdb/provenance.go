@@ -0,0 +1,72 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/agntcy/dir/server/types"
+	"gorm.io/gorm/clause"
+)
+
+// provenanceBatchSize is the maximum number of records whose provenance is read with a single query,
+// to stay below the limit of query parameters.
+const provenanceBatchSize = 500
+
+// RecordProvenance is a source a record was added to the search index from: a push to this
+// Directory, with an empty sync ID, or a sync importing it. A record pushed and synced,
+// or synced by several syncs, has several provenance entries.
+type RecordProvenance struct {
+	RecordCID string `gorm:"column:record_cid;primarykey;not null"`
+	SyncID    string `gorm:"primarykey;not null;default:''"`
+	CreatedAt time.Time
+}
+
+// AddRecordProvenance records that a record was imported by a sync, or pushed if syncID is empty.
+func (d *DB) AddRecordProvenance(cid, syncID string) error {
+	provenance := &RecordProvenance{RecordCID: cid, SyncID: syncID}
+
+	if err := d.gormDB.Clauses(clause.OnConflict{DoNothing: true}).Create(provenance).Error; err != nil {
+		return fmt.Errorf("failed to add record provenance: %w", err)
+	}
+
+	logger.Debug("Added record provenance", "cid", cid, "sync_id", syncID)
+
+	return nil
+}
+
+// GetRecordProvenance retrieves the distinct provenance of records by CID, with the remote
+// Directory of their syncs. Pushes sort first, as their sync ID is empty.
+func (d *DB) GetRecordProvenance(cids []string) (map[string][]types.RecordProvenance, error) {
+	provenance := make(map[string][]types.RecordProvenance)
+
+	for batch := range slices.Chunk(cids, provenanceBatchSize) {
+		var rows []struct {
+			RecordCID          string `gorm:"column:record_cid"`
+			SyncID             string `gorm:"column:sync_id"`
+			RemoteDirectoryURL string `gorm:"column:remote_directory_url"`
+		}
+
+		// Rows are made distinct, as sync IDs are not unique in the syncs table
+		if err := d.reader().Table("record_provenances").
+			Select("DISTINCT record_provenances.record_cid, record_provenances.sync_id, COALESCE(syncs.remote_directory_url, '') AS remote_directory_url").
+			Joins("LEFT JOIN syncs ON syncs.id = record_provenances.sync_id AND record_provenances.sync_id <> ''").
+			Where("record_provenances.record_cid IN ?", batch).
+			Order("record_provenances.record_cid, record_provenances.sync_id").
+			Scan(&rows).Error; err != nil {
+			return nil, fmt.Errorf("failed to get record provenance: %w", err)
+		}
+
+		for _, row := range rows {
+			provenance[row.RecordCID] = append(provenance[row.RecordCID], types.RecordProvenance{
+				SyncID:             row.SyncID,
+				RemoteDirectoryURL: row.RemoteDirectoryURL,
+			})
+		}
+	}
+
+	return provenance, nil
+}
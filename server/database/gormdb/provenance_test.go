@@ -0,0 +1,64 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"testing"
+
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordProvenance(t *testing.T) {
+	db := setupTestDB(t)
+
+	require.NoError(t, db.AddRecord(&TestRecord{cid: "cid-mirrored", data: &TestRecordData{name: "mirrored", version: "1.0.0"}}))
+	require.NoError(t, db.AddRecord(&TestRecord{cid: "cid-pushed", data: &TestRecordData{name: "pushed", version: "1.0.0"}}))
+
+	syncA, err := db.CreateSync("https://dir-a.example.com", nil, nil, types.SyncTrustPolicy{})
+	require.NoError(t, err)
+
+	syncB, err := db.CreateSync("https://dir-b.example.com", nil, nil, types.SyncTrustPolicy{})
+	require.NoError(t, err)
+
+	// The mirrored record was pushed, then imported by both syncs, twice by the first one
+	require.NoError(t, db.AddRecordProvenance("cid-mirrored", ""))
+	require.NoError(t, db.AddRecordProvenance("cid-mirrored", syncA))
+	require.NoError(t, db.AddRecordProvenance("cid-mirrored", syncB))
+	require.NoError(t, db.AddRecordProvenance("cid-mirrored", syncA))
+	require.NoError(t, db.AddRecordProvenance("cid-pushed", ""))
+
+	provenance, err := db.GetRecordProvenance([]string{"cid-mirrored", "cid-pushed", "cid-unknown"})
+	require.NoError(t, err)
+
+	mirrored := []types.RecordProvenance{
+		{SyncID: syncA, RemoteDirectoryURL: "https://dir-a.example.com"},
+		{SyncID: syncB, RemoteDirectoryURL: "https://dir-b.example.com"},
+	}
+	if syncB < syncA {
+		mirrored[0], mirrored[1] = mirrored[1], mirrored[0]
+	}
+
+	assert.Equal(t, map[string][]types.RecordProvenance{
+		"cid-mirrored": append([]types.RecordProvenance{{}}, mirrored...),
+		"cid-pushed":   {{}},
+	}, provenance)
+
+	t.Run("deleted sync", func(t *testing.T) {
+		require.NoError(t, db.DeleteSync(syncB))
+
+		provenance, err := db.GetRecordProvenance([]string{"cid-mirrored"})
+		require.NoError(t, err)
+		assert.Contains(t, provenance["cid-mirrored"], types.RecordProvenance{SyncID: syncB})
+	})
+
+	t.Run("removed record", func(t *testing.T) {
+		require.NoError(t, db.RemoveRecord("cid-mirrored"))
+
+		provenance, err := db.GetRecordProvenance([]string{"cid-mirrored"})
+		require.NoError(t, err)
+		assert.Empty(t, provenance)
+	})
+}
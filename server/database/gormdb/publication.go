@@ -0,0 +1,281 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gorm.io/gorm"
+)
+
+type Publication struct {
+	GormID         uint `gorm:"primarykey"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	ID             string                      `gorm:"not null;index"`
+	RequestJSON    string                      `gorm:"not null"` // JSON-encoded PublishRequest
+	ScheduleJSON   string                      // JSON-encoded PublicationSchedule, empty until processing starts
+	SettleUntil    time.Time                   // Zero if the publication is not delayed
+	Namespace      string                      `gorm:"not null;default:''"` // Trust domain the publication is attributed to
+	LabelsJSON     string                      // JSON-encoded labels of the published records
+	Status         routingv1.PublicationStatus `gorm:"not null"`
+	CreatedTime    string                      `gorm:"not null"`
+	LastUpdateTime string                      `gorm:"not null"`
+}
+
+func (pub *Publication) GetID() string {
+	return pub.ID
+}
+
+func (pub *Publication) GetRequest() *routingv1.PublishRequest {
+	var request routingv1.PublishRequest
+	if err := protojson.Unmarshal([]byte(pub.RequestJSON), &request); err != nil {
+		logger.Error("Failed to unmarshal publish request", "error", err)
+
+		return nil
+	}
+
+	return &request
+}
+
+func (pub *Publication) GetSchedule() *routingv1.PublicationSchedule {
+	if pub.ScheduleJSON == "" {
+		return nil
+	}
+
+	var schedule routingv1.PublicationSchedule
+	if err := protojson.Unmarshal([]byte(pub.ScheduleJSON), &schedule); err != nil {
+		logger.Error("Failed to unmarshal publication schedule", "error", err)
+
+		return nil
+	}
+
+	return &schedule
+}
+
+func (pub *Publication) GetSettleUntil() time.Time {
+	return pub.SettleUntil
+}
+
+func (pub *Publication) GetNamespace() string {
+	return pub.Namespace
+}
+
+func (pub *Publication) GetLabels() []string {
+	if pub.LabelsJSON == "" {
+		return nil
+	}
+
+	var labels []string
+	if err := json.Unmarshal([]byte(pub.LabelsJSON), &labels); err != nil {
+		logger.Error("Failed to unmarshal publication labels", "error", err)
+
+		return nil
+	}
+
+	return labels
+}
+
+func (pub *Publication) GetStatus() routingv1.PublicationStatus {
+	return pub.Status
+}
+
+func (pub *Publication) GetCreatedTime() string {
+	return pub.CreatedTime
+}
+
+func (pub *Publication) GetLastUpdateTime() string {
+	return pub.LastUpdateTime
+}
+
+func (d *DB) CreatePublication(request *routingv1.PublishRequest, settleUntil time.Time, namespace string, labels []string) (string, error) {
+	requestJSON, err := protojson.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal publish request: %w", err)
+	}
+
+	var labelsJSON []byte
+	if len(labels) > 0 {
+		labelsJSON, err = json.Marshal(labels)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal publication labels: %w", err)
+		}
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	publication := &Publication{
+		ID:             uuid.NewString(),
+		RequestJSON:    string(requestJSON),
+		Status:         routingv1.PublicationStatus_PUBLICATION_STATUS_PENDING,
+		SettleUntil:    settleUntil,
+		Namespace:      namespace,
+		LabelsJSON:     string(labelsJSON),
+		CreatedTime:    now,
+		LastUpdateTime: now,
+	}
+
+	if err := d.gormDB.Create(publication).Error; err != nil {
+		return "", fmt.Errorf("failed to create publication: %w", err)
+	}
+
+	logger.Debug("Added publication to database", "publication_id", publication.ID, "settle_until", settleUntil,
+		"namespace", namespace, "labels", labels)
+
+	return publication.ID, nil
+}
+
+func (d *DB) GetPublicationByID(publicationID string) (types.PublicationObject, error) {
+	var publication Publication
+	if err := d.gormDB.Where("id = ?", publicationID).First(&publication).Error; err != nil {
+		return nil, err
+	}
+
+	return &publication, nil
+}
+
+func (d *DB) GetPublications(offset, limit int) ([]types.PublicationObject, error) {
+	var publications []Publication
+
+	query := d.gormDB.Offset(offset)
+
+	// Only apply limit if it's greater than 0
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Find(&publications).Error; err != nil {
+		return nil, err
+	}
+
+	// convert to types.PublicationObject
+	publicationObjects := make([]types.PublicationObject, len(publications))
+	for i, publication := range publications {
+		publicationObjects[i] = &publication
+	}
+
+	return publicationObjects, nil
+}
+
+func (d *DB) SummarizePublications(now time.Time) (*types.PublicationSummary, error) {
+	// Read all the publications to count in a single query, so that the summary is a consistent snapshot
+	var publications []Publication
+	if err := d.gormDB.Select("namespace", "labels_json", "status", "settle_until").
+		Where("status <> ?", routingv1.PublicationStatus_PUBLICATION_STATUS_COMPLETED).
+		Find(&publications).Error; err != nil {
+		return nil, err
+	}
+
+	summary := types.NewPublicationSummary()
+	for _, publication := range publications {
+		summary.Add(publication.GetNamespace(), publication.GetLabels(), publication.GetStatus(), publication.GetSettleUntil(), now)
+	}
+
+	return summary, nil
+}
+
+func (d *DB) GetPublicationsByStatus(status routingv1.PublicationStatus) ([]types.PublicationObject, error) {
+	var publications []Publication
+	if err := d.gormDB.Where("status = ?", status).Find(&publications).Error; err != nil {
+		return nil, err
+	}
+
+	// convert to types.PublicationObject
+	publicationObjects := make([]types.PublicationObject, len(publications))
+	for i, publication := range publications {
+		publicationObjects[i] = &publication
+	}
+
+	return publicationObjects, nil
+}
+
+func (d *DB) UpdatePublicationStatus(publicationID string, status routingv1.PublicationStatus) error {
+	publicationObj, err := d.GetPublicationByID(publicationID)
+	if err != nil {
+		return err
+	}
+
+	publication, ok := publicationObj.(*Publication)
+	if !ok {
+		return gorm.ErrInvalidData
+	}
+
+	publication.Status = status
+	publication.LastUpdateTime = time.Now().Format(time.RFC3339)
+
+	if err := d.gormDB.Save(publication).Error; err != nil {
+		return err
+	}
+
+	logger.Debug("Updated publication in database", "publication_id", publication.GetID(), "status", publication.GetStatus())
+
+	return nil
+}
+
+func (d *DB) UpdatePublicationSchedule(publicationID string, schedule *routingv1.PublicationSchedule) error {
+	publicationObj, err := d.GetPublicationByID(publicationID)
+	if err != nil {
+		return err
+	}
+
+	publication, ok := publicationObj.(*Publication)
+	if !ok {
+		return gorm.ErrInvalidData
+	}
+
+	scheduleJSON, err := protojson.Marshal(schedule)
+	if err != nil {
+		return fmt.Errorf("failed to marshal publication schedule: %w", err)
+	}
+
+	publication.ScheduleJSON = string(scheduleJSON)
+	publication.LastUpdateTime = time.Now().Format(time.RFC3339)
+
+	if err := d.gormDB.Save(publication).Error; err != nil {
+		return err
+	}
+
+	logger.Debug("Updated publication schedule in database", "publication_id", publication.GetID(),
+		"announced_records", schedule.GetAnnouncedRecords(), "total_records", schedule.GetTotalRecords())
+
+	return nil
+}
+
+func (d *DB) ConfirmPublication(publicationID string) error {
+	publicationObj, err := d.GetPublicationByID(publicationID)
+	if err != nil {
+		return err
+	}
+
+	publication, ok := publicationObj.(*Publication)
+	if !ok {
+		return gorm.ErrInvalidData
+	}
+
+	publication.SettleUntil = time.Time{}
+	publication.LastUpdateTime = time.Now().Format(time.RFC3339)
+
+	if err := d.gormDB.Save(publication).Error; err != nil {
+		return err
+	}
+
+	logger.Debug("Confirmed publication in database", "publication_id", publication.GetID())
+
+	return nil
+}
+
+func (d *DB) DeletePublication(publicationID string) error {
+	if err := d.gormDB.Where("id = ?", publicationID).Delete(&Publication{}).Error; err != nil {
+		return err
+	}
+
+	logger.Debug("Deleted publication from database", "publication_id", publicationID)
+
+	return nil
+}
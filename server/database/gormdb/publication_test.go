@@ -0,0 +1,120 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"testing"
+	"time"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicationSchedule(t *testing.T) {
+	db := setupTestDB(t)
+
+	publicationID, err := db.CreatePublication(&routingv1.PublishRequest{}, time.Time{}, "", nil)
+	require.NoError(t, err)
+
+	// No schedule until the publication is processed
+	publicationObj, err := db.GetPublicationByID(publicationID)
+	require.NoError(t, err)
+	assert.Nil(t, publicationObj.GetSchedule())
+
+	require.NoError(t, db.UpdatePublicationSchedule(publicationID, &routingv1.PublicationSchedule{
+		TotalRecords:     10,
+		AnnouncedRecords: 3,
+		FailedRecords:    1,
+		AnnounceInterval: "1m0s",
+		NextAnnounceTime: "2026-01-01T00:04:00Z",
+	}))
+
+	// Status updates keep the schedule
+	require.NoError(t, db.UpdatePublicationStatus(publicationID, routingv1.PublicationStatus_PUBLICATION_STATUS_IN_PROGRESS))
+
+	publicationObj, err = db.GetPublicationByID(publicationID)
+	require.NoError(t, err)
+	assert.Equal(t, routingv1.PublicationStatus_PUBLICATION_STATUS_IN_PROGRESS, publicationObj.GetStatus())
+
+	schedule := publicationObj.GetSchedule()
+	require.NotNil(t, schedule)
+	assert.Equal(t, uint32(10), schedule.GetTotalRecords())
+	assert.Equal(t, uint32(3), schedule.GetAnnouncedRecords())
+	assert.Equal(t, uint32(1), schedule.GetFailedRecords())
+	assert.Equal(t, "1m0s", schedule.GetAnnounceInterval())
+	assert.Equal(t, "2026-01-01T00:04:00Z", schedule.GetNextAnnounceTime())
+
+	// Unknown publications fail
+	require.Error(t, db.UpdatePublicationSchedule("non-existent-publication", &routingv1.PublicationSchedule{}))
+}
+
+func TestPublicationSettleDelay(t *testing.T) {
+	db := setupTestDB(t)
+
+	settleUntil := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	publicationID, err := db.CreatePublication(&routingv1.PublishRequest{}, settleUntil, "", nil)
+	require.NoError(t, err)
+
+	publicationObj, err := db.GetPublicationByID(publicationID)
+	require.NoError(t, err)
+	assert.True(t, settleUntil.Equal(publicationObj.GetSettleUntil()))
+
+	// Confirmation ends the settle delay
+	require.NoError(t, db.ConfirmPublication(publicationID))
+
+	publicationObj, err = db.GetPublicationByID(publicationID)
+	require.NoError(t, err)
+	assert.True(t, publicationObj.GetSettleUntil().IsZero())
+	assert.Equal(t, routingv1.PublicationStatus_PUBLICATION_STATUS_PENDING, publicationObj.GetStatus())
+
+	// Unknown publications fail
+	require.Error(t, db.ConfirmPublication("non-existent-publication"))
+}
+
+func TestSummarizePublications(t *testing.T) {
+	db := setupTestDB(t)
+
+	now := time.Now()
+
+	queuedID, err := db.CreatePublication(&routingv1.PublishRequest{}, time.Time{}, "example.org", []string{"/skills/AI", "/domains/research"})
+	require.NoError(t, err)
+
+	_, err = db.CreatePublication(&routingv1.PublishRequest{}, now.Add(time.Hour), "example.org", []string{"/skills/AI"})
+	require.NoError(t, err)
+
+	failedID, err := db.CreatePublication(&routingv1.PublishRequest{}, time.Time{}, "", nil)
+	require.NoError(t, err)
+	require.NoError(t, db.UpdatePublicationStatus(failedID, routingv1.PublicationStatus_PUBLICATION_STATUS_FAILED))
+
+	completedID, err := db.CreatePublication(&routingv1.PublishRequest{}, time.Time{}, "other.org", []string{"/skills/AI"})
+	require.NoError(t, err)
+	require.NoError(t, db.UpdatePublicationStatus(completedID, routingv1.PublicationStatus_PUBLICATION_STATUS_COMPLETED))
+
+	// Namespace and labels are kept with the publication
+	publicationObj, err := db.GetPublicationByID(queuedID)
+	require.NoError(t, err)
+	assert.Equal(t, "example.org", publicationObj.GetNamespace())
+	assert.Equal(t, []string{"/skills/AI", "/domains/research"}, publicationObj.GetLabels())
+
+	summary, err := db.SummarizePublications(now)
+	require.NoError(t, err)
+
+	assert.Equal(t, types.PublicationCounts{Queued: 1, Settling: 1, Failed: 1}, summary.Total)
+	assert.Equal(t, map[string]*types.PublicationCounts{
+		"example.org": {Queued: 1, Settling: 1},
+		"":            {Failed: 1},
+	}, summary.Namespaces)
+	assert.Equal(t, map[string]*types.PublicationCounts{
+		"/skills/AI":        {Queued: 1, Settling: 1},
+		"/domains/research": {Queued: 1},
+	}, summary.Labels)
+
+	// Publications are queued once their settle delay has elapsed
+	summary, err = db.SummarizePublications(now.Add(2 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, types.PublicationCounts{Queued: 2, Failed: 1}, summary.Total)
+}
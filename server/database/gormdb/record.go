@@ -0,0 +1,725 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/agntcy/dir/server/database/utils"
+	"github.com/agntcy/dir/server/types"
+	"gorm.io/gorm"
+)
+
+type Record struct {
+	CreatedAt time.Time `gorm:"index"`
+	UpdatedAt time.Time `gorm:"index"`
+	RecordCID string    `gorm:"column:record_cid;primarykey;not null"`
+	Name      string    `gorm:"not null"`
+	Version   string    `gorm:"not null"`
+	PullCount uint64    `gorm:"not null;default:0"`
+
+	// SPDX identifier of the license declared by the record, if any
+	License string `gorm:"not null;default:'';index"`
+
+	// Result of the last re-validation of the stored record
+	ValidationRules  string `gorm:"not null;default:''"`
+	ValidationErrors string `gorm:"not null;default:''"`
+
+	// Embargo of the record, hiding it from other identities than its owner until the given time
+	EmbargoUntil *time.Time `gorm:"index"`
+	EmbargoOwner string     `gorm:"not null;default:''"`
+
+	// Identity that first pushed the record, empty for synced records
+	Owner string `gorm:"not null;default:''"`
+
+	Skills   []Skill   `gorm:"foreignKey:RecordCID;references:RecordCID;constraint:OnDelete:CASCADE"`
+	Locators []Locator `gorm:"foreignKey:RecordCID;references:RecordCID;constraint:OnDelete:CASCADE"`
+	Modules  []Module  `gorm:"foreignKey:RecordCID;references:RecordCID;constraint:OnDelete:CASCADE"`
+	Domains  []Domain  `gorm:"foreignKey:RecordCID;references:RecordCID;constraint:OnDelete:CASCADE"`
+
+	References  []Reference  `gorm:"foreignKey:RecordCID;references:RecordCID;constraint:OnDelete:CASCADE"`
+	Annotations []Annotation `gorm:"foreignKey:RecordCID;references:RecordCID;constraint:OnDelete:CASCADE"`
+}
+
+// Implement central Record interface.
+func (r *Record) GetCid() string {
+	return r.RecordCID
+}
+
+func (r *Record) GetRecordData() (types.RecordData, error) {
+	return &RecordDataAdapter{record: r}, nil
+}
+
+// RecordDataAdapter adapts database Record to central RecordData interface.
+type RecordDataAdapter struct {
+	record *Record
+}
+
+func (r *RecordDataAdapter) GetAnnotations() map[string]string {
+	// Indexed records only store indexed annotations
+	annotations := make(map[string]string, len(r.record.Annotations))
+	for _, annotation := range r.record.Annotations {
+		annotations[annotation.Key] = annotation.Value
+	}
+
+	return annotations
+}
+
+func (r *RecordDataAdapter) GetDomains() []types.Domain {
+	domains := make([]types.Domain, len(r.record.Domains))
+	for i, domain := range r.record.Domains {
+		domains[i] = &domain
+	}
+
+	return domains
+}
+
+func (r *RecordDataAdapter) GetSchemaVersion() string {
+	// Default schema version for search records
+	return "v1"
+}
+
+func (r *RecordDataAdapter) GetName() string {
+	return r.record.Name
+}
+
+func (r *RecordDataAdapter) GetVersion() string {
+	return r.record.Version
+}
+
+func (r *RecordDataAdapter) GetDescription() string {
+	// Indexed records don't store description
+	return ""
+}
+
+func (r *RecordDataAdapter) GetAuthors() []string {
+	// Indexed records don't store authors
+	return []string{}
+}
+
+func (r *RecordDataAdapter) GetCreatedAt() string {
+	return r.record.CreatedAt.Format("2006-01-02T15:04:05Z")
+}
+
+func (r *RecordDataAdapter) GetSkills() []types.Skill {
+	skills := make([]types.Skill, len(r.record.Skills))
+	for i, skill := range r.record.Skills {
+		skills[i] = &skill
+	}
+
+	return skills
+}
+
+func (r *RecordDataAdapter) GetLocators() []types.Locator {
+	locators := make([]types.Locator, len(r.record.Locators))
+	for i, locator := range r.record.Locators {
+		locators[i] = &locator
+	}
+
+	return locators
+}
+
+func (r *RecordDataAdapter) GetModules() []types.Module {
+	modules := make([]types.Module, len(r.record.Modules))
+	for i, module := range r.record.Modules {
+		modules[i] = &module
+	}
+
+	return modules
+}
+
+func (r *RecordDataAdapter) GetSignature() types.Signature {
+	// Indexed records don't store signature information
+	return nil
+}
+
+func (r *RecordDataAdapter) GetPreviousRecordCid() string {
+	// Indexed records don't store previous record CID
+	return ""
+}
+
+func (d *DB) AddRecord(record types.Record) error {
+	// Build complete Record with all associations
+	indexedRecord, err := d.newRecord(record)
+	if err != nil {
+		return err
+	}
+
+	cid := indexedRecord.RecordCID
+
+	// Check if record already exists
+	var existingRecord Record
+
+	err = d.gormDB.Where("record_cid = ?", cid).First(&existingRecord).Error
+	if err == nil {
+		// Record exists, skip insert
+		logger.Debug("Record already exists in search database, skipping insert", "record_cid", existingRecord.RecordCID, "cid", cid)
+
+		return nil
+	}
+
+	// If error is not "record not found", return the error
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to check existing record: %w", err)
+	}
+
+	// Let GORM handle the entire creation with associations
+	if err := d.gormDB.Create(indexedRecord).Error; err != nil {
+		return fmt.Errorf("failed to add record to database: %w", err)
+	}
+
+	logger.Debug("Added new record with associations to database", "record_cid", indexedRecord.RecordCID, "cid", cid,
+		"skills", len(indexedRecord.Skills), "locators", len(indexedRecord.Locators), "modules", len(indexedRecord.Modules), "domains", len(indexedRecord.Domains),
+		"references", len(indexedRecord.References), "annotations", len(indexedRecord.Annotations))
+
+	return nil
+}
+
+// AddEmbargoedRecord adds a record owned by the given identity and under embargo until the given time.
+// The record and its embargo are inserted in a single transaction, so that searches and publications
+// never see the record without its embargo.
+func (d *DB) AddEmbargoedRecord(record types.Record, owner string, until time.Time) error {
+	indexedRecord, err := d.newRecord(record)
+	if err != nil {
+		return err
+	}
+
+	embargoUntil := until.Local()
+	indexedRecord.EmbargoUntil = &embargoUntil
+	indexedRecord.EmbargoOwner = owner
+	indexedRecord.Owner = owner
+
+	err = d.gormDB.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&Record{}).Where("record_cid = ?", indexedRecord.RecordCID).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check existing record: %w", err)
+		}
+
+		if count > 0 {
+			return fmt.Errorf("%w: %s", types.ErrRecordIndexed, indexedRecord.RecordCID)
+		}
+
+		if err := tx.Create(indexedRecord).Error; err != nil {
+			return fmt.Errorf("failed to add record to database: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Added new record under embargo to database", "cid", indexedRecord.RecordCID, "until", until, "owner", owner)
+
+	return nil
+}
+
+// newRecord converts a record to its search database model with all associations.
+func (d *DB) newRecord(record types.Record) (*Record, error) {
+	// Extract record data
+	recordData, err := record.GetRecordData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get record data: %w", err)
+	}
+
+	// Get CID
+	cid := record.GetCid()
+
+	return &Record{
+		RecordCID: cid,
+		Name:      recordData.GetName(),
+		Version:   recordData.GetVersion(),
+		License:   types.GetRecordLicense(recordData),
+		Skills:    convertSkills(recordData.GetSkills(), cid),
+		Locators:  convertLocators(utils.NormalizeLocators(recordData.GetLocators(), d.locators), cid),
+		Modules:   convertModules(recordData.GetModules(), cid),
+		Domains:   convertDomains(recordData.GetDomains(), cid),
+
+		References:  convertReferences(types.GetRecordReferences(cid, recordData), cid),
+		Annotations: convertAnnotations(recordData.GetAnnotations(), d.indexedAnnotations, cid),
+	}, nil
+}
+
+// GetRecords retrieves records based on the provided options.
+func (d *DB) GetRecords(opts ...types.FilterOption) ([]types.Record, error) {
+	// Create default configuration.
+	cfg := &types.RecordFilters{}
+
+	// Apply all options.
+	for _, opt := range opts {
+		if opt == nil {
+			return nil, errors.New("nil option provided")
+		}
+
+		opt(cfg)
+	}
+
+	// Start with the base query for records, only selecting the requested fields if any.
+	query := d.reader().Model(&Record{})
+	if len(cfg.Fields) > 0 {
+		query = query.Select(recordColumns(cfg.Fields))
+	}
+
+	query = d.distinctRecords(query)
+
+	// Apply pagination.
+	if cfg.Limit > 0 {
+		query = query.Limit(cfg.Limit)
+	}
+
+	if cfg.Offset > 0 {
+		query = query.Offset(cfg.Offset)
+	}
+
+	// Apply all filters.
+	query = d.handleFilterOptions(query, cfg)
+
+	// Execute the query to get records.
+	var dbRecords []Record
+	for _, association := range recordAssociations(cfg.Fields) {
+		query = query.Preload(association)
+	}
+
+	if err := query.Find(&dbRecords).Error; err != nil {
+		return nil, fmt.Errorf("failed to query records: %w", err)
+	}
+
+	// Convert to Record interfaces.
+	result := make([]types.Record, len(dbRecords))
+	for i := range dbRecords {
+		result[i] = &dbRecords[i]
+	}
+
+	return result, nil
+}
+
+// distinctRecords returns each record matched by the query once, as filters join the tables of its fields.
+// PostgreSQL only orders distinct rows by selected expressions, so records are grouped by their
+// primary key instead, which still allows ordering them by the rank of their locator regions.
+func (d *DB) distinctRecords(query *gorm.DB) *gorm.DB {
+	if d.dialect == utils.DialectPostgres {
+		return query.Group("records.record_cid")
+	}
+
+	return query.Distinct()
+}
+
+// recordColumns returns the columns of the records table holding the given record fields.
+func recordColumns(fields []string) []string {
+	columns := []string{"records.record_cid"}
+
+	for _, field := range fields {
+		switch field {
+		case types.RecordFieldName:
+			columns = append(columns, "records.name")
+		case types.RecordFieldVersion:
+			columns = append(columns, "records.version")
+		case types.RecordFieldCreatedAt:
+			columns = append(columns, "records.created_at")
+		}
+	}
+
+	return columns
+}
+
+// recordAssociations returns the associations to preload for the given record fields, all if no fields are given.
+func recordAssociations(fields []string) []string {
+	associations := map[string]string{
+		types.RecordFieldSkills:      "Skills",
+		types.RecordFieldLocators:    "Locators",
+		types.RecordFieldModules:     "Modules",
+		types.RecordFieldDomains:     "Domains",
+		types.RecordFieldAnnotations: "Annotations",
+	}
+
+	if len(fields) == 0 {
+		return []string{"Skills", "Locators", "Modules", "Domains", "Annotations"}
+	}
+
+	var preloads []string
+
+	for _, field := range fields {
+		if association, ok := associations[field]; ok {
+			preloads = append(preloads, association)
+		}
+	}
+
+	return preloads
+}
+
+// GetRecordCIDs retrieves only record CIDs based on the provided options.
+// This is optimized for cases where only CIDs are needed, avoiding expensive joins and preloads.
+func (d *DB) GetRecordCIDs(opts ...types.FilterOption) ([]string, error) {
+	// Create default configuration.
+	cfg := &types.RecordFilters{}
+
+	// Apply all options.
+	for _, opt := range opts {
+		if opt == nil {
+			return nil, errors.New("nil option provided")
+		}
+
+		opt(cfg)
+	}
+
+	// Start with the base query for records - only select CID for efficiency.
+	query := d.distinctRecords(d.reader().Model(&Record{}).Select("records.record_cid"))
+
+	// Apply pagination.
+	if cfg.Limit > 0 {
+		query = query.Limit(cfg.Limit)
+	}
+
+	if cfg.Offset > 0 {
+		query = query.Offset(cfg.Offset)
+	}
+
+	// Apply all filters.
+	query = d.handleFilterOptions(query, cfg)
+
+	// Execute the query to get only CIDs (no preloading needed).
+	var cids []string
+	if err := query.Pluck("record_cid", &cids).Error; err != nil {
+		return nil, fmt.Errorf("failed to query record CIDs: %w", err)
+	}
+
+	// Return CIDs directly - no need for wrapper objects.
+	return cids, nil
+}
+
+// RemoveRecord removes a record from the search database by CID.
+// Uses CASCADE DELETE to automatically remove related Skills, Locators, and Modules.
+func (d *DB) RemoveRecord(cid string) error {
+	// Remove reference graph edges explicitly, as stale edges would otherwise
+	// keep showing up as dependents if foreign keys are not enforced
+	if err := d.gormDB.Where("record_cid = ?", cid).Delete(&Reference{}).Error; err != nil {
+		return fmt.Errorf("failed to remove record references from search database: %w", err)
+	}
+
+	if err := d.gormDB.Where("record_cid = ?", cid).Delete(&Annotation{}).Error; err != nil {
+		return fmt.Errorf("failed to remove record annotations from search database: %w", err)
+	}
+
+	if err := d.gormDB.Where("record_cid = ?", cid).Delete(&RecordSigner{}).Error; err != nil {
+		return fmt.Errorf("failed to remove record signers from search database: %w", err)
+	}
+
+	if err := d.gormDB.Where("record_cid = ?", cid).Delete(&RecordProvenance{}).Error; err != nil {
+		return fmt.Errorf("failed to remove record provenance from search database: %w", err)
+	}
+
+	result := d.gormDB.Where("record_cid = ?", cid).Delete(&Record{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to remove record from search database: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		// Record not found in search database (might not have been indexed)
+		logger.Debug("No record found in search database", "cid", cid)
+
+		return nil // Not an error - might be a storage-only record
+	}
+
+	logger.Debug("Removed record from search database", "cid", cid, "rows_affected", result.RowsAffected)
+
+	return nil
+}
+
+// IncrementRecordPullCount increments the pull counter of a record by CID.
+// Records that are not indexed in the search database are ignored.
+func (d *DB) IncrementRecordPullCount(cid string) error {
+	result := d.gormDB.Model(&Record{}).
+		Where("record_cid = ?", cid).
+		UpdateColumn("pull_count", gorm.Expr("pull_count + ?", 1))
+	if result.Error != nil {
+		return fmt.Errorf("failed to increment record pull count: %w", result.Error)
+	}
+
+	return nil
+}
+
+// GetRecordPullCount retrieves the pull counter of a record by CID.
+// Returns zero if the record is not indexed in the search database.
+func (d *DB) GetRecordPullCount(cid string) (uint64, error) {
+	var counts []uint64
+	if err := d.gormDB.Model(&Record{}).Where("record_cid = ?", cid).Pluck("pull_count", &counts).Error; err != nil {
+		return 0, fmt.Errorf("failed to get record pull count: %w", err)
+	}
+
+	if len(counts) == 0 {
+		return 0, nil
+	}
+
+	return counts[0], nil
+}
+
+// GetMostPulledRecordCIDs retrieves the CIDs of the limit most pulled records, most pulled first.
+// Records that were never pulled are omitted.
+func (d *DB) GetMostPulledRecordCIDs(limit int) ([]string, error) {
+	var cids []string
+	if err := d.reader().Model(&Record{}).
+		Where("pull_count > 0").
+		Order("pull_count DESC").
+		Order("record_cid").
+		Limit(limit).
+		Pluck("record_cid", &cids).Error; err != nil {
+		return nil, fmt.Errorf("failed to get most pulled records: %w", err)
+	}
+
+	return cids, nil
+}
+
+// handleFilterOptions applies the provided filters to the query.
+//
+//nolint:gocognit,cyclop,nestif
+func (d *DB) handleFilterOptions(query *gorm.DB, cfg *types.RecordFilters) *gorm.DB {
+	// Exclude records added after the snapshot time.
+	// Timestamps are compared in the local time zone, in which GORM stores them.
+	if !cfg.CreatedBefore.IsZero() {
+		query = query.Where("records.created_at <= ?", cfg.CreatedBefore.Local())
+	}
+
+	// Only include records added or updated after the given time.
+	if !cfg.UpdatedSince.IsZero() {
+		query = query.Where("records.updated_at > ?", cfg.UpdatedSince.Local())
+	}
+
+	// Hide records under embargo from identities other than their owner.
+	if !cfg.EmbargoedAt.IsZero() {
+		query = query.Where("records.embargo_until IS NULL OR records.embargo_until <= ? OR (records.embargo_owner <> '' AND records.embargo_owner = ?)",
+			cfg.EmbargoedAt.Local(), cfg.EmbargoViewer)
+	}
+
+	// Apply exact CID filter.
+	if len(cfg.CIDs) > 0 {
+		query = query.Where("records.record_cid IN ?", cfg.CIDs)
+	}
+
+	// Apply record-level filters with wildcard support.
+	if cfg.Name != "" {
+		condition, arg := utils.BuildSingleWildcardCondition(d.dialect, "records.name", cfg.Name)
+		query = query.Where(condition, arg)
+	}
+
+	if cfg.Version != "" {
+		condition, arg := utils.BuildSingleWildcardCondition(d.dialect, "records.version", cfg.Version)
+		query = query.Where(condition, arg)
+	}
+
+	if len(cfg.Licenses) > 0 {
+		condition, args := utils.BuildWildcardCondition(d.dialect, "records.license", cfg.Licenses)
+		if condition != "" {
+			query = query.Where(condition, args...)
+		}
+	}
+
+	// Only include records signed by one of the given signer identities.
+	if len(cfg.Signers) > 0 {
+		condition, args := utils.BuildWildcardCondition(d.dialect, "record_signers.identity", cfg.Signers)
+		if condition != "" {
+			query = query.Where("records.record_cid IN (SELECT record_signers.record_cid FROM record_signers WHERE "+condition+")", args...)
+		}
+	}
+
+	// Handle skill filters with wildcard support.
+	if len(cfg.SkillIDs) > 0 || len(cfg.SkillNames) > 0 {
+		query = query.Joins("JOIN skills ON skills.record_cid = records.record_cid")
+
+		if len(cfg.SkillIDs) > 0 {
+			query = query.Where("skills.skill_id IN ?", cfg.SkillIDs)
+		}
+
+		if len(cfg.SkillNames) > 0 {
+			condition, args := utils.BuildWildcardCondition(d.dialect, "skills.name", cfg.SkillNames)
+			if condition != "" {
+				query = query.Where(condition, args...)
+			}
+		}
+	}
+
+	// Handle locator filters with wildcard support.
+	if len(cfg.LocatorTypes) > 0 || len(cfg.LocatorURLs) > 0 {
+		query = query.Joins("JOIN locators ON locators.record_cid = records.record_cid")
+
+		if len(cfg.LocatorTypes) > 0 {
+			condition, args := utils.BuildWildcardCondition(d.dialect, "locators.type", cfg.LocatorTypes)
+			if condition != "" {
+				query = query.Where(condition, args...)
+			}
+		}
+
+		if len(cfg.LocatorURLs) > 0 {
+			// Searched URLs are normalized like indexed URLs, so that equivalent URLs match
+			locatorURLs := make([]string, len(cfg.LocatorURLs))
+			for i, url := range cfg.LocatorURLs {
+				locatorURLs[i] = utils.NormalizeLocatorURL("", url, "", d.locators)
+			}
+
+			condition, args := utils.BuildWildcardCondition(d.dialect, "locators.url", locatorURLs)
+			if condition != "" {
+				query = query.Where(condition, args...)
+			}
+		}
+	}
+
+	// Handle module filters with wildcard support.
+	if len(cfg.ModuleNames) > 0 {
+		query = query.Joins("JOIN modules ON modules.record_cid = records.record_cid")
+
+		if len(cfg.ModuleNames) > 0 {
+			condition, args := utils.BuildWildcardCondition(d.dialect, "modules.name", cfg.ModuleNames)
+			if condition != "" {
+				query = query.Where(condition, args...)
+			}
+		}
+	}
+
+	// Handle domain filters with wildcard support.
+	if len(cfg.DomainIDs) > 0 || len(cfg.DomainNames) > 0 {
+		query = query.Joins("JOIN domains ON domains.record_cid = records.record_cid")
+
+		if len(cfg.DomainIDs) > 0 {
+			query = query.Where("domains.domain_id IN ?", cfg.DomainIDs)
+		}
+
+		if len(cfg.DomainNames) > 0 {
+			condition, args := utils.BuildWildcardCondition(d.dialect, "domains.name", cfg.DomainNames)
+			if condition != "" {
+				query = query.Where(condition, args...)
+			}
+		}
+	}
+
+	// Handle annotation filters, only indexed annotations can be filtered.
+	if len(cfg.Annotations) > 0 {
+		query = d.handleAnnotationFilters(query, cfg.Annotations)
+	}
+
+	// Rank records with locators in the preferred regions first.
+	if len(cfg.PreferredRegions) > 0 {
+		query = query.Order(regionRankOrder(cfg.PreferredRegions))
+	}
+
+	// Read pages of records by CID, continuing after the last CID of the previous page.
+	if cfg.OrderByCID {
+		if cfg.AfterCID != "" {
+			query = query.Where("records.record_cid > ?", cfg.AfterCID)
+		}
+
+		query = query.Order("records.record_cid")
+	}
+
+	return query
+}
+
+// SetRecordValidation stores the result of validating a record with the given rules version.
+// Records that are not indexed in the search database are ignored.
+func (d *DB) SetRecordValidation(cid, rulesVersion string, validationErrors []string) error {
+	encodedErrors := ""
+
+	if len(validationErrors) > 0 {
+		data, err := json.Marshal(validationErrors)
+		if err != nil {
+			return fmt.Errorf("failed to encode validation errors: %w", err)
+		}
+
+		encodedErrors = string(data)
+	}
+
+	result := d.gormDB.Model(&Record{}).
+		Where("record_cid = ?", cid).
+		UpdateColumns(map[string]any{
+			"validation_rules":  rulesVersion,
+			"validation_errors": encodedErrors,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to set record validation: %w", result.Error)
+	}
+
+	return nil
+}
+
+// GetRecordValidation retrieves the rules version and errors of the last validation of a record.
+// Returns an empty rules version if the record was not validated or is not indexed in the search database.
+func (d *DB) GetRecordValidation(cid string) (string, []string, error) {
+	var records []Record
+	if err := d.gormDB.Model(&Record{}).
+		Select("validation_rules", "validation_errors").
+		Where("record_cid = ?", cid).
+		Find(&records).Error; err != nil {
+		return "", nil, fmt.Errorf("failed to get record validation: %w", err)
+	}
+
+	if len(records) == 0 {
+		return "", nil, nil
+	}
+
+	record := records[0]
+	if record.ValidationErrors == "" {
+		return record.ValidationRules, nil, nil
+	}
+
+	var validationErrors []string
+	if err := json.Unmarshal([]byte(record.ValidationErrors), &validationErrors); err != nil {
+		return "", nil, fmt.Errorf("failed to decode validation errors: %w", err)
+	}
+
+	return record.ValidationRules, validationErrors, nil
+}
+
+// GetRecordEmbargo retrieves the embargo time and owner of a record.
+// Returns a zero time if the record has no embargo or is not indexed in the search database.
+func (d *DB) GetRecordEmbargo(cid string) (time.Time, string, error) {
+	var records []Record
+	if err := d.gormDB.Model(&Record{}).
+		Select("embargo_until", "embargo_owner").
+		Where("record_cid = ?", cid).
+		Find(&records).Error; err != nil {
+		return time.Time{}, "", fmt.Errorf("failed to get record embargo: %w", err)
+	}
+
+	if len(records) == 0 || records[0].EmbargoUntil == nil {
+		return time.Time{}, "", nil
+	}
+
+	return *records[0].EmbargoUntil, records[0].EmbargoOwner, nil
+}
+
+// SetRecordOwner sets the identity owning a record, unless the record already has an owner.
+// Records that are not indexed in the search database are ignored.
+func (d *DB) SetRecordOwner(cid, owner string) error {
+	if owner == "" {
+		return nil
+	}
+
+	if err := d.gormDB.Model(&Record{}).
+		Where("record_cid = ? AND owner = ''", cid).
+		UpdateColumn("owner", owner).Error; err != nil {
+		return fmt.Errorf("failed to set record owner: %w", err)
+	}
+
+	return nil
+}
+
+// GetRecordOwner retrieves the identity owning a record.
+// Returns an empty owner if the record has no owner or is not indexed in the search database.
+func (d *DB) GetRecordOwner(cid string) (string, error) {
+	var records []Record
+	if err := d.gormDB.Model(&Record{}).
+		Select("owner").
+		Where("record_cid = ?", cid).
+		Find(&records).Error; err != nil {
+		return "", fmt.Errorf("failed to get record owner: %w", err)
+	}
+
+	if len(records) == 0 {
+		return "", nil
+	}
+
+	return records[0].Owner, nil
+}
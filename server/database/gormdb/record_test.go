@@ -1,15 +1,17 @@
 // Copyright AGNTCY Contributors (https://github.com/agntcy)
 // SPDX-License-Identifier: Apache-2.0
 
-package sqlite
+package gormdb
 
 import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	typesv1alpha0 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/agntcy/oasf/types/v1alpha0"
 	corev1 "github.com/agntcy/dir/api/core/v1"
+	dbconfig "github.com/agntcy/dir/server/database/config"
 	"github.com/agntcy/dir/server/types"
 	"github.com/agntcy/dir/server/types/adapters"
 	"github.com/glebarez/sqlite"
@@ -43,15 +45,20 @@ func mustGetRecordData(t *testing.T, record types.Record) types.RecordData {
 
 // TestRecordData implements types.RecordData interface for testing.
 type TestRecordData struct {
-	name     string
-	version  string
-	skills   []types.Skill
-	locators []types.Locator
-	modules  []types.Module
-	domains  []types.Domain
+	name        string
+	version     string
+	annotations map[string]string
+	skills      []types.Skill
+	locators    []types.Locator
+	modules     []types.Module
+	domains     []types.Domain
 }
 
 func (r *TestRecordData) GetAnnotations() map[string]string {
+	if r.annotations != nil {
+		return r.annotations
+	}
+
 	return make(map[string]string)
 }
 
@@ -124,10 +131,16 @@ func (s *TestSkill) GetID() uint64 {
 type TestLocator struct {
 	locType string
 	url     string
+	region  string
+	digest  string
 }
 
 func (l *TestLocator) GetAnnotations() map[string]string {
-	return make(map[string]string)
+	if l.region == "" {
+		return make(map[string]string)
+	}
+
+	return map[string]string{types.LocatorRegionAnnotation: l.region}
 }
 
 func (l *TestLocator) GetType() string {
@@ -143,11 +156,12 @@ func (l *TestLocator) GetSize() uint64 {
 }
 
 func (l *TestLocator) GetDigest() string {
-	return ""
+	return l.digest
 }
 
 type TestModule struct {
 	name string
+	data map[string]any
 }
 
 func (m *TestModule) GetName() string {
@@ -155,7 +169,11 @@ func (m *TestModule) GetName() string {
 }
 
 func (m *TestModule) GetData() map[string]any {
-	return make(map[string]any)
+	if m.data == nil {
+		return make(map[string]any)
+	}
+
+	return m.data
 }
 
 type TestDomain struct {
@@ -179,11 +197,11 @@ func setupTestDB(t *testing.T) *DB {
 	t.Helper()
 
 	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
-		Logger: newCustomLogger(),
+		Logger: NewLogger(),
 	})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&Record{}, &Skill{}, &Locator{}, &Module{}, &Domain{}, &Sync{})
+	err = db.AutoMigrate(&Record{}, &Skill{}, &Locator{}, &Module{}, &Domain{}, &Reference{}, &Annotation{}, &Sync{}, &Publication{}, &RecordWebhook{}, &Alias{}, &AliasChange{}, &RecordScan{}, &RecordSigner{}, &QuarantinedRecord{}, &RecordProvenance{})
 	require.NoError(t, err)
 
 	return &DB{
@@ -331,6 +349,546 @@ func TestGetRecords_SingleOptions(t *testing.T) {
 	assert.Equal(t, "agent2", mustGetRecordData(t, records[0]).GetName())
 }
 
+// TestGetRecords_CIDsOption tests filtering records by exact CIDs.
+func TestGetRecords_CIDsOption(t *testing.T) {
+	db := setupTestDB(t)
+	createTestData(t, db)
+
+	cids, err := db.GetRecordCIDs(types.WithCIDs("bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"}, cids)
+
+	cids, err = db.GetRecordCIDs(types.WithCIDs("non-existent-cid"))
+	require.NoError(t, err)
+	assert.Empty(t, cids)
+}
+
+func TestGetRecords_FieldsOption(t *testing.T) {
+	db := setupTestDB(t)
+	createTestData(t, db)
+
+	records, err := db.GetRecords(
+		types.WithCIDs("bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"),
+		types.WithFields(types.RecordFieldName, types.RecordFieldSkills),
+	)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", records[0].GetCid())
+
+	data, err := records[0].GetRecordData()
+	require.NoError(t, err)
+
+	// Requested fields are loaded
+	assert.Equal(t, "agent1", data.GetName())
+	assert.Len(t, data.GetSkills(), 2)
+
+	// Other fields are not
+	assert.Empty(t, data.GetVersion())
+	assert.Empty(t, data.GetLocators())
+	assert.Empty(t, data.GetModules())
+	assert.Empty(t, data.GetDomains())
+}
+
+// TestGetRecords_CreatedBeforeOption tests reading records at a snapshot time.
+func TestGetRecords_CreatedBeforeOption(t *testing.T) {
+	db := setupTestDB(t)
+	createTestData(t, db)
+
+	snapshot := time.Now()
+
+	// Make sure the next record is created strictly after the snapshot time
+	time.Sleep(time.Millisecond)
+
+	require.NoError(t, db.AddRecord(&TestRecord{
+		cid:  "bafybeiaddedaftersnapshot",
+		data: &TestRecordData{name: "agent-late", version: "1.0.0"},
+	}))
+
+	cids, err := db.GetRecordCIDs()
+	require.NoError(t, err)
+	assert.Len(t, cids, 4)
+
+	cids, err = db.GetRecordCIDs(types.WithCreatedBefore(snapshot))
+	require.NoError(t, err)
+	assert.Len(t, cids, 3)
+	assert.NotContains(t, cids, "bafybeiaddedaftersnapshot")
+
+	// The time zone of the snapshot time does not matter
+	cids, err = db.GetRecordCIDs(types.WithCreatedBefore(snapshot.In(time.FixedZone("test", 5*60*60))), types.WithVersion("1.0.0"))
+	require.NoError(t, err)
+	assert.Len(t, cids, 2)
+}
+
+// TestGetRecords_UpdatedSinceOption tests polling for records changed since a time.
+func TestGetRecords_UpdatedSinceOption(t *testing.T) {
+	db := setupTestDB(t)
+	createTestData(t, db)
+
+	since := time.Now()
+
+	// Make sure the next record is created strictly after the since time
+	time.Sleep(time.Millisecond)
+
+	require.NoError(t, db.AddRecord(&TestRecord{
+		cid:  "bafybeiaddedafterwatermark",
+		data: &TestRecordData{name: "agent-late", version: "1.0.0"},
+	}))
+
+	cids, err := db.GetRecordCIDs(types.WithUpdatedSince(since))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bafybeiaddedafterwatermark"}, cids)
+
+	// The time zone of the since time does not matter
+	cids, err = db.GetRecordCIDs(types.WithUpdatedSince(since.In(time.FixedZone("test", 5*60*60))), types.WithVersion("2.0.0"))
+	require.NoError(t, err)
+	assert.Empty(t, cids)
+
+	cids, err = db.GetRecordCIDs(types.WithUpdatedSince(time.Now()))
+	require.NoError(t, err)
+	assert.Empty(t, cids)
+}
+
+// TestGetRecords_CursorOption tests reading pages of records by CID.
+func TestGetRecords_CursorOption(t *testing.T) {
+	db := setupTestDB(t)
+	createTestData(t, db)
+
+	all, err := db.GetRecordCIDs(types.WithCursor(""))
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.IsNonDecreasing(t, all)
+
+	// Each page continues after the last CID of the previous page
+	var pages []string
+
+	after := ""
+
+	for {
+		page, err := db.GetRecordCIDs(types.WithCursor(after), types.WithLimit(2))
+		require.NoError(t, err)
+
+		if len(page) == 0 {
+			break
+		}
+
+		pages = append(pages, page...)
+		after = page[len(page)-1]
+	}
+
+	assert.Equal(t, all, pages)
+
+	// The cursor also applies when reading record data
+	records, err := db.GetRecords(types.WithCursor(all[0]), types.WithFields(types.RecordFieldName))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, all[1], records[0].GetCid())
+}
+
+// TestRecordPullCount tests incrementing and retrieving record pull counters.
+func TestRecordPullCount(t *testing.T) {
+	db := setupTestDB(t)
+	createTestData(t, db)
+
+	cid := "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+
+	count, err := db.GetRecordPullCount(cid)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), count)
+
+	for range 3 {
+		require.NoError(t, db.IncrementRecordPullCount(cid))
+	}
+
+	count, err = db.GetRecordPullCount(cid)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), count)
+
+	// Records that are not indexed are ignored
+	require.NoError(t, db.IncrementRecordPullCount("non-existent-cid"))
+
+	count, err = db.GetRecordPullCount("non-existent-cid")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), count)
+}
+
+// TestMostPulledRecordCIDs tests retrieving the most pulled records.
+func TestMostPulledRecordCIDs(t *testing.T) {
+	db := setupTestDB(t)
+	createTestData(t, db)
+
+	cids, err := db.GetMostPulledRecordCIDs(10)
+	require.NoError(t, err)
+	assert.Empty(t, cids, "records never pulled should be omitted")
+
+	agent1 := "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+	agent2 := "bafybeihkoviema7g3gxyt6la7b7kbblo2hm7zgi3f6d67dqd7wy3yqhqxu"
+
+	require.NoError(t, db.IncrementRecordPullCount(agent1))
+
+	for range 2 {
+		require.NoError(t, db.IncrementRecordPullCount(agent2))
+	}
+
+	cids, err = db.GetMostPulledRecordCIDs(10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{agent2, agent1}, cids)
+
+	cids, err = db.GetMostPulledRecordCIDs(1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{agent2}, cids)
+}
+
+// TestRecordValidation tests storing and retrieving record validation results.
+func TestRecordValidation(t *testing.T) {
+	db := setupTestDB(t)
+	createTestData(t, db)
+
+	cid := "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+
+	// Records are not validated when indexed
+	rulesVersion, validationErrors, err := db.GetRecordValidation(cid)
+	require.NoError(t, err)
+	assert.Empty(t, rulesVersion)
+	assert.Empty(t, validationErrors)
+
+	// Invalid record
+	require.NoError(t, db.SetRecordValidation(cid, "v1", []string{"missing field"}))
+
+	rulesVersion, validationErrors, err = db.GetRecordValidation(cid)
+	require.NoError(t, err)
+	assert.Equal(t, "v1", rulesVersion)
+	assert.Equal(t, []string{"missing field"}, validationErrors)
+
+	// Valid record
+	require.NoError(t, db.SetRecordValidation(cid, "v2", nil))
+
+	rulesVersion, validationErrors, err = db.GetRecordValidation(cid)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", rulesVersion)
+	assert.Empty(t, validationErrors)
+
+	// Records that are not indexed are ignored
+	require.NoError(t, db.SetRecordValidation("non-existent-cid", "v1", []string{"error"}))
+
+	rulesVersion, _, err = db.GetRecordValidation("non-existent-cid")
+	require.NoError(t, err)
+	assert.Empty(t, rulesVersion)
+}
+
+// TestRecordEmbargo tests hiding embargoed records from other identities than their owner.
+func TestRecordEmbargo(t *testing.T) {
+	db := setupTestDB(t)
+	createTestData(t, db)
+
+	cid := "bafybeiembargoedrecordcid"
+	owner := "spiffe://example.org/owner"
+	now := time.Now()
+
+	// Records are not under embargo when indexed
+	until, _, err := db.GetRecordEmbargo("bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi")
+	require.NoError(t, err)
+	assert.True(t, until.IsZero())
+
+	record := &TestRecord{cid: cid, data: &TestRecordData{name: "embargoed", version: "1.0.0"}}
+	require.NoError(t, db.AddEmbargoedRecord(record, owner, now.Add(time.Hour)))
+
+	until, embargoOwner, err := db.GetRecordEmbargo(cid)
+	require.NoError(t, err)
+	assert.WithinDuration(t, now.Add(time.Hour), until, time.Second)
+	assert.Equal(t, owner, embargoOwner)
+
+	recordOwner, err := db.GetRecordOwner(cid)
+	require.NoError(t, err)
+	assert.Equal(t, owner, recordOwner)
+
+	// Embargoes can only be placed on records that are not indexed yet
+	err = db.AddEmbargoedRecord(record, "spiffe://example.org/other", now.Add(2*time.Hour))
+	require.ErrorIs(t, err, types.ErrRecordIndexed)
+
+	_, embargoOwner, err = db.GetRecordEmbargo(cid)
+	require.NoError(t, err)
+	assert.Equal(t, owner, embargoOwner)
+
+	// Hidden from other identities until the embargo lifts
+	cids, err := db.GetRecordCIDs(types.WithEmbargoes(now, "spiffe://example.org/other"))
+	require.NoError(t, err)
+	assert.Len(t, cids, 3)
+	assert.NotContains(t, cids, cid)
+
+	cids, err = db.GetRecordCIDs(types.WithEmbargoes(now, ""))
+	require.NoError(t, err)
+	assert.NotContains(t, cids, cid)
+
+	cids, err = db.GetRecordCIDs(types.WithEmbargoes(now, owner))
+	require.NoError(t, err)
+	assert.Contains(t, cids, cid)
+
+	cids, err = db.GetRecordCIDs(types.WithEmbargoes(now.Add(2*time.Hour), ""))
+	require.NoError(t, err)
+	assert.Contains(t, cids, cid)
+
+	// Embargoes are ignored without the option
+	cids, err = db.GetRecordCIDs()
+	require.NoError(t, err)
+	assert.Contains(t, cids, cid)
+}
+
+// TestRecordOwner tests that records are owned by the identity that first sets their owner.
+func TestRecordOwner(t *testing.T) {
+	db := setupTestDB(t)
+	createTestData(t, db)
+
+	cid := "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+
+	owner, err := db.GetRecordOwner(cid)
+	require.NoError(t, err)
+	assert.Empty(t, owner)
+
+	require.NoError(t, db.SetRecordOwner(cid, "spiffe://example.org/owner"))
+	require.NoError(t, db.SetRecordOwner(cid, "spiffe://example.org/other"))
+
+	owner, err = db.GetRecordOwner(cid)
+	require.NoError(t, err)
+	assert.Equal(t, "spiffe://example.org/owner", owner)
+
+	// Records that are not indexed are ignored
+	require.NoError(t, db.SetRecordOwner("non-existent-cid", "spiffe://example.org/owner"))
+
+	owner, err = db.GetRecordOwner("non-existent-cid")
+	require.NoError(t, err)
+	assert.Empty(t, owner)
+}
+
+// TestRecordReferences tests indexing and querying of record references.
+func TestRecordReferences(t *testing.T) {
+	db := setupTestDB(t)
+
+	records := []types.Record{
+		&TestRecord{
+			cid:  "cid-app",
+			data: &TestRecordData{name: "app", version: "1.0.0", annotations: map[string]string{types.DependenciesAnnotation: "cid-lib1,cid-lib2"}},
+		},
+		&TestRecord{
+			cid:  "cid-lib1",
+			data: &TestRecordData{name: "lib1", version: "1.0.0", annotations: map[string]string{types.DependenciesAnnotation: "cid-lib2"}},
+		},
+	}
+
+	for _, record := range records {
+		require.NoError(t, db.AddRecord(record))
+	}
+
+	dependencies, err := db.GetRecordDependencies("cid-app")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cid-lib1", "cid-lib2"}, dependencies)
+
+	dependents, err := db.GetRecordDependents("cid-lib2")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"cid-app", "cid-lib1"}, dependents)
+
+	// References are removed together with the record
+	require.NoError(t, db.RemoveRecord("cid-app"))
+
+	dependents, err = db.GetRecordDependents("cid-lib2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cid-lib1"}, dependents)
+}
+
+// TestRecordLicenses tests indexing and filtering of record licenses.
+func TestRecordLicenses(t *testing.T) {
+	db := setupTestDB(t)
+
+	licensed := func(cid, license string) types.Record {
+		return &TestRecord{
+			cid: cid,
+			data: &TestRecordData{name: cid, version: "1.0.0", modules: []types.Module{
+				&TestModule{name: types.LicenseModuleName, data: map[string]any{types.LicenseModuleKey: license}},
+			}},
+		}
+	}
+
+	records := []types.Record{
+		licensed("cid-apache", "Apache-2.0"),
+		licensed("cid-bsd2", "BSD-2-Clause"),
+		licensed("cid-bsd3", "BSD-3-Clause"),
+		&TestRecord{cid: "cid-none", data: &TestRecordData{name: "cid-none", version: "1.0.0"}},
+	}
+
+	for _, record := range records {
+		require.NoError(t, db.AddRecord(record))
+	}
+
+	tests := []struct {
+		name     string
+		licenses []string
+		want     []string
+	}{
+		{"exact match is case-insensitive", []string{"apache-2.0"}, []string{"cid-apache"}},
+		{"wildcard", []string{"BSD-*"}, []string{"cid-bsd2", "cid-bsd3"}},
+		{"several licenses", []string{"Apache-2.0", "BSD-2-Clause"}, []string{"cid-apache", "cid-bsd2"}},
+		{"no match", []string{"MIT"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cids, err := db.GetRecordCIDs(types.WithLicenses(tt.licenses...))
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, cids)
+		})
+	}
+}
+
+// TestLocatorNormalization tests that locator URLs are normalized and deduplicated
+// when indexed and searched.
+func TestLocatorNormalization(t *testing.T) {
+	db := setupTestDB(t)
+	db.locators = dbconfig.LocatorsConfig{
+		LowercaseScheme:   true,
+		TrimTrailingSlash: true,
+		PinDigests:        true,
+		Deduplicate:       true,
+	}
+
+	require.NoError(t, db.AddRecord(&TestRecord{
+		cid: "cid-located",
+		data: &TestRecordData{name: "located", version: "1.0.0", locators: []types.Locator{
+			&TestLocator{locType: "source-code", url: "HTTPS://github.com/agntcy/dir/"},
+			&TestLocator{locType: "source-code", url: "https://github.com/agntcy/dir"},
+			&TestLocator{locType: "docker-image", url: "ghcr.io/agntcy/dir:v1", digest: "sha256:abc"},
+		}},
+	}))
+
+	records, err := db.GetRecords(types.WithCIDs("cid-located"))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	var urls []string
+	for _, locator := range mustGetRecordData(t, records[0]).GetLocators() {
+		urls = append(urls, locator.GetURL())
+	}
+
+	assert.ElementsMatch(t, []string{"https://github.com/agntcy/dir", "ghcr.io/agntcy/dir:v1@sha256:abc"}, urls)
+
+	// Equivalent URLs match the normalized locators
+	cids, err := db.GetRecordCIDs(types.WithLocatorURLs("Https://github.com/agntcy/dir/"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cid-located"}, cids)
+}
+
+// TestGetRecords_PreferredRegionsOption tests ranking records by the regions of their locators.
+func TestGetRecords_PreferredRegionsOption(t *testing.T) {
+	db := setupTestDB(t)
+
+	located := func(cid string, regions ...string) types.Record {
+		locators := make([]types.Locator, 0, len(regions))
+		for _, region := range regions {
+			locators = append(locators, &TestLocator{locType: "docker_image", url: "https://example.com/" + cid, region: region})
+		}
+
+		return &TestRecord{cid: cid, data: &TestRecordData{name: cid, version: "1.0.0", locators: locators}}
+	}
+
+	records := []types.Record{
+		located("cid-none"),
+		located("cid-us", "us-east-1"),
+		located("cid-eu-us", "us-east-1", "EU-West-1"),
+		located("cid-ap", "ap-south-1"),
+	}
+
+	for _, record := range records {
+		require.NoError(t, db.AddRecord(record))
+	}
+
+	cids, err := db.GetRecordCIDs(types.WithPreferredRegions("eu-west-1", "us-east-1"))
+	require.NoError(t, err)
+	require.Len(t, cids, 4)
+	assert.Equal(t, []string{"cid-eu-us", "cid-us"}, cids[:2])
+	assert.ElementsMatch(t, []string{"cid-none", "cid-ap"}, cids[2:])
+
+	// Ranking applies before pagination
+	cids, err = db.GetRecordCIDs(types.WithPreferredRegions("ap-south-1"), types.WithLimit(1))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cid-ap"}, cids)
+
+	// Region hints are kept in the locator annotations
+	indexed, err := db.GetRecords(types.WithCIDs("cid-us"))
+	require.NoError(t, err)
+	require.Len(t, indexed, 1)
+
+	locators := mustGetRecordData(t, indexed[0]).GetLocators()
+	require.Len(t, locators, 1)
+	assert.Equal(t, "us-east-1", types.GetLocatorRegion(locators[0]))
+}
+
+// TestRecordAnnotations tests indexing and filtering of declared annotations.
+func TestRecordAnnotations(t *testing.T) {
+	db := setupTestDB(t)
+	db.indexedAnnotations = []string{"team", "environment"}
+
+	records := []types.Record{
+		&TestRecord{
+			cid:  "cid-a",
+			data: &TestRecordData{name: "a", version: "1.0.0", annotations: map[string]string{"team": "platform", "environment": "production", "owner": "alice"}},
+		},
+		&TestRecord{
+			cid:  "cid-b",
+			data: &TestRecordData{name: "b", version: "1.0.0", annotations: map[string]string{"team": "platform", "environment": "staging"}},
+		},
+		&TestRecord{
+			cid:  "cid-c",
+			data: &TestRecordData{name: "c", version: "1.0.0", annotations: map[string]string{"team": "research"}},
+		},
+	}
+
+	for _, record := range records {
+		require.NoError(t, db.AddRecord(record))
+	}
+
+	t.Run("equality", func(t *testing.T) {
+		cids, err := db.GetRecordCIDs(types.WithAnnotation("team", "platform"))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"cid-a", "cid-b"}, cids)
+	})
+
+	t.Run("prefix", func(t *testing.T) {
+		cids, err := db.GetRecordCIDs(types.WithAnnotation("environment", "prod*"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cid-a"}, cids)
+	})
+
+	t.Run("multiple keys", func(t *testing.T) {
+		cids, err := db.GetRecordCIDs(
+			types.WithAnnotation("team", "platform", "research"),
+			types.WithAnnotation("environment", "staging"),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cid-b"}, cids)
+	})
+
+	t.Run("undeclared key", func(t *testing.T) {
+		_, err := db.GetRecordCIDs(types.WithAnnotation("owner", "alice"))
+		require.ErrorIs(t, err, types.ErrAnnotationNotIndexed)
+	})
+
+	t.Run("only indexed annotations are stored", func(t *testing.T) {
+		records, err := db.GetRecords(types.WithCIDs("cid-a"))
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+
+		data, err := records[0].GetRecordData()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"team": "platform", "environment": "production"}, data.GetAnnotations())
+	})
+
+	t.Run("removed with record", func(t *testing.T) {
+		require.NoError(t, db.RemoveRecord("cid-a"))
+
+		var count int64
+		require.NoError(t, db.gormDB.Model(&Annotation{}).Where("record_cid = ?", "cid-a").Count(&count).Error)
+		assert.Zero(t, count)
+	})
+}
+
 // TestGetRecords_CombinedOptions tests combinations of options.
 func TestGetRecords_CombinedOptions(t *testing.T) {
 	db := setupTestDB(t)
@@ -0,0 +1,60 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"fmt"
+	"time"
+)
+
+// Reference is an edge of the record reference graph,
+// pointing from a record to a record it references.
+type Reference struct {
+	ID            uint `gorm:"primarykey"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	RecordCID     string `gorm:"column:record_cid;not null;index"`
+	ReferencedCID string `gorm:"column:referenced_cid;not null;index"`
+}
+
+// convertReferences transforms referenced CIDs to database structs.
+func convertReferences(referencedCIDs []string, recordCID string) []Reference {
+	result := make([]Reference, len(referencedCIDs))
+	for i, referencedCID := range referencedCIDs {
+		result[i] = Reference{
+			RecordCID:     recordCID,
+			ReferencedCID: referencedCID,
+		}
+	}
+
+	return result
+}
+
+// GetRecordDependencies retrieves the CIDs of records referenced by the given record.
+func (d *DB) GetRecordDependencies(cid string) ([]string, error) {
+	var cids []string
+
+	if err := d.reader().Model(&Reference{}).
+		Where("record_cid = ?", cid).
+		Order("id").
+		Pluck("referenced_cid", &cids).Error; err != nil {
+		return nil, fmt.Errorf("failed to query record dependencies: %w", err)
+	}
+
+	return cids, nil
+}
+
+// GetRecordDependents retrieves the CIDs of records referencing the given record.
+func (d *DB) GetRecordDependents(cid string) ([]string, error) {
+	var cids []string
+
+	if err := d.reader().Model(&Reference{}).
+		Where("referenced_cid = ?", cid).
+		Order("id").
+		Pluck("record_cid", &cids).Error; err != nil {
+		return nil, fmt.Errorf("failed to query record dependents: %w", err)
+	}
+
+	return cids, nil
+}
@@ -0,0 +1,77 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// RecordScan holds the verdict of the last content scan of a record.
+// Scans are kept apart from the search index as they may complete before the record is indexed.
+type RecordScan struct {
+	RecordCID string `gorm:"column:record_cid;primarykey;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Verdict   string `gorm:"not null;index"`
+	Findings  string `gorm:"not null;default:''"`
+}
+
+// SetRecordScan stores the verdict and findings of the content scan of a record,
+// replacing the previous scan of the record.
+func (d *DB) SetRecordScan(cid, verdict string, findings []string) error {
+	encodedFindings := ""
+
+	if len(findings) > 0 {
+		data, err := json.Marshal(findings)
+		if err != nil {
+			return fmt.Errorf("failed to encode scan findings: %w", err)
+		}
+
+		encodedFindings = string(data)
+	}
+
+	scan := &RecordScan{
+		RecordCID: cid,
+		Verdict:   verdict,
+		Findings:  encodedFindings,
+	}
+
+	if err := d.gormDB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "record_cid"}},
+		DoUpdates: clause.AssignmentColumns([]string{"verdict", "findings", "updated_at"}),
+	}).Create(scan).Error; err != nil {
+		return fmt.Errorf("failed to set record scan: %w", err)
+	}
+
+	return nil
+}
+
+// GetRecordScan retrieves the verdict and findings of the last content scan of a record.
+// Returns an empty verdict if the record was not scanned.
+func (d *DB) GetRecordScan(cid string) (string, []string, error) {
+	var scans []RecordScan
+	if err := d.gormDB.Where("record_cid = ?", cid).Limit(1).Find(&scans).Error; err != nil {
+		return "", nil, fmt.Errorf("failed to get record scan: %w", err)
+	}
+
+	if len(scans) == 0 {
+		return "", nil, nil
+	}
+
+	scan := scans[0]
+	if scan.Findings == "" {
+		return scan.Verdict, nil, nil
+	}
+
+	var findings []string
+	if err := json.Unmarshal([]byte(scan.Findings), &findings); err != nil {
+		return "", nil, fmt.Errorf("failed to decode scan findings: %w", err)
+	}
+
+	return scan.Verdict, findings, nil
+}
@@ -0,0 +1,37 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"testing"
+
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordScan(t *testing.T) {
+	db := setupTestDB(t)
+
+	// Records that were not scanned have no verdict
+	verdict, findings, err := db.GetRecordScan("cid-1")
+	require.NoError(t, err)
+	assert.Empty(t, verdict)
+	assert.Empty(t, findings)
+
+	require.NoError(t, db.SetRecordScan("cid-1", types.ScanVerdictBlocked, []string{"secrets: aws_access_key in record"}))
+
+	verdict, findings, err = db.GetRecordScan("cid-1")
+	require.NoError(t, err)
+	assert.Equal(t, types.ScanVerdictBlocked, verdict)
+	assert.Equal(t, []string{"secrets: aws_access_key in record"}, findings)
+
+	// Rescans replace the previous verdict
+	require.NoError(t, db.SetRecordScan("cid-1", types.ScanVerdictClean, nil))
+
+	verdict, findings, err = db.GetRecordScan("cid-1")
+	require.NoError(t, err)
+	assert.Equal(t, types.ScanVerdictClean, verdict)
+	assert.Empty(t, findings)
+}
@@ -0,0 +1,61 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// RecordSigner is an identity of a signer of a record, extracted from one of its signatures.
+// Signers are kept apart from the search index as signatures may be stored before the record is indexed.
+type RecordSigner struct {
+	RecordCID string `gorm:"column:record_cid;primarykey;not null"`
+	Signature string `gorm:"primarykey;not null"`
+	Identity  string `gorm:"primarykey;not null;index"`
+	CreatedAt time.Time
+}
+
+// AddRecordSigners stores the signer identities of a signature of a record.
+// Identities already stored for the signature are ignored.
+func (d *DB) AddRecordSigners(cid, signature string, identities []string) error {
+	if len(identities) == 0 {
+		return nil
+	}
+
+	signers := make([]RecordSigner, 0, len(identities))
+	for _, identity := range identities {
+		signers = append(signers, RecordSigner{RecordCID: cid, Signature: signature, Identity: identity})
+	}
+
+	if err := d.gormDB.Clauses(clause.OnConflict{DoNothing: true}).Create(&signers).Error; err != nil {
+		return fmt.Errorf("failed to add record signers: %w", err)
+	}
+
+	logger.Debug("Added record signers", "cid", cid, "identities", identities)
+
+	return nil
+}
+
+// RemoveRecordSigners removes the signer identities of a signature of a record.
+func (d *DB) RemoveRecordSigners(cid, signature string) error {
+	if err := d.gormDB.Where("record_cid = ? AND signature = ?", cid, signature).Delete(&RecordSigner{}).Error; err != nil {
+		return fmt.Errorf("failed to remove record signers: %w", err)
+	}
+
+	return nil
+}
+
+// GetRecordSigners retrieves the sorted distinct signer identities of a record.
+func (d *DB) GetRecordSigners(cid string) ([]string, error) {
+	var identities []string
+	if err := d.gormDB.Model(&RecordSigner{}).Where("record_cid = ?", cid).
+		Distinct("identity").Order("identity").Pluck("identity", &identities).Error; err != nil {
+		return nil, fmt.Errorf("failed to get record signers: %w", err)
+	}
+
+	return identities, nil
+}
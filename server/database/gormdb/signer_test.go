@@ -0,0 +1,68 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"testing"
+
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordSigners(t *testing.T) {
+	db := setupTestDB(t)
+
+	for _, cid := range []string{"cid-ci", "cid-release", "cid-unsigned"} {
+		require.NoError(t, db.AddRecord(&TestRecord{cid: cid, data: &TestRecordData{name: cid, version: "1.0.0"}}))
+	}
+
+	require.NoError(t, db.AddRecordSigners("cid-ci", "sig-1", []string{"spiffe://acme/ci", "sha256:aaa"}))
+	require.NoError(t, db.AddRecordSigners("cid-ci", "sig-1", []string{"spiffe://acme/ci"}))
+	require.NoError(t, db.AddRecordSigners("cid-release", "sig-2", []string{"spiffe://acme/release"}))
+	require.NoError(t, db.AddRecordSigners("cid-release", "sig-3", []string{"release@acme.com"}))
+
+	signers, err := db.GetRecordSigners("cid-ci")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sha256:aaa", "spiffe://acme/ci"}, signers)
+
+	tests := []struct {
+		name    string
+		signers []string
+		want    []string
+	}{
+		{"exact match", []string{"spiffe://acme/ci"}, []string{"cid-ci"}},
+		{"wildcard", []string{"spiffe://acme/*"}, []string{"cid-ci", "cid-release"}},
+		{"several signers", []string{"sha256:aaa", "release@acme.com"}, []string{"cid-ci", "cid-release"}},
+		{"no match", []string{"spiffe://other/*"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cids, err := db.GetRecordCIDs(types.WithSigners(tt.signers...))
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, cids)
+		})
+	}
+
+	t.Run("revoked signature is no longer matched", func(t *testing.T) {
+		require.NoError(t, db.RemoveRecordSigners("cid-release", "sig-2"))
+
+		cids, err := db.GetRecordCIDs(types.WithSigners("spiffe://acme/*"))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"cid-ci"}, cids)
+
+		signers, err := db.GetRecordSigners("cid-release")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"release@acme.com"}, signers)
+	})
+
+	t.Run("removed record drops its signers", func(t *testing.T) {
+		require.NoError(t, db.RemoveRecord("cid-ci"))
+
+		signers, err := db.GetRecordSigners("cid-ci")
+		require.NoError(t, err)
+		assert.Empty(t, signers)
+	})
+}
@@ -1,7 +1,7 @@
 // Copyright AGNTCY Contributors (https://github.com/agntcy)
 // SPDX-License-Identifier: Apache-2.0
 
-package sqlite
+package gormdb
 
 import (
 	"time"
@@ -19,7 +19,7 @@ type Skill struct {
 }
 
 func (skill *Skill) GetAnnotations() map[string]string {
-	// SQLite skills don't store annotations, return empty map
+	// Indexed skills don't store annotations, return empty map
 	return make(map[string]string)
 }
 
@@ -31,7 +31,7 @@ func (skill *Skill) GetName() string {
 	return skill.Name
 }
 
-// convertSkills transforms interface types to SQLite structs.
+// convertSkills transforms interface types to database structs.
 func convertSkills(skills []types.Skill, recordCID string) []Skill {
 	result := make([]Skill, len(skills))
 	for i, skill := range skills {
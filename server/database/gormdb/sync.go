@@ -0,0 +1,359 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"fmt"
+	"time"
+
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Sync struct {
+	GormID             uint `gorm:"primarykey"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	ID                 string             `gorm:"not null;index"`
+	RemoteDirectoryURL string             `gorm:"not null"`
+	RemoteRegistryURL  string             `gorm:"not null"`
+	CIDs               []string           `gorm:"serializer:json;not null"`
+	Status             storev1.SyncStatus `gorm:"not null"`
+	Parallelism        int
+	SyncedRecords      int
+	FetchDuration      time.Duration
+	InvitationToken    string
+	NamespaceMappings  []types.NamespaceMapping `gorm:"serializer:json"`
+	TrustPolicy        types.SyncTrustPolicy    `gorm:"serializer:json"`
+}
+
+// QuarantinedRecord is a record imported by a sync that failed its trust policy.
+// Released records are kept so that they are not quarantined again when the registry is rescanned.
+type QuarantinedRecord struct {
+	RecordCID string `gorm:"column:record_cid;primarykey;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	SyncID    string `gorm:"not null;index"`
+	Reason    string `gorm:"not null;default:''"`
+	Released  bool   `gorm:"not null;default:false;index"`
+}
+
+func (record *QuarantinedRecord) GetCID() string {
+	return record.RecordCID
+}
+
+func (record *QuarantinedRecord) GetSyncID() string {
+	return record.SyncID
+}
+
+func (record *QuarantinedRecord) GetReason() string {
+	return record.Reason
+}
+
+func (record *QuarantinedRecord) GetQuarantinedAt() time.Time {
+	return record.CreatedAt
+}
+
+func (record *QuarantinedRecord) IsReleased() bool {
+	return record.Released
+}
+
+func (sync *Sync) GetID() string {
+	return sync.ID
+}
+
+func (sync *Sync) GetRemoteDirectoryURL() string {
+	return sync.RemoteDirectoryURL
+}
+
+func (sync *Sync) GetRemoteRegistryURL() string {
+	return sync.RemoteRegistryURL
+}
+
+func (sync *Sync) GetCIDs() []string {
+	return sync.CIDs
+}
+
+func (sync *Sync) GetInvitationToken() string {
+	return sync.InvitationToken
+}
+
+func (sync *Sync) GetNamespaceMappings() []types.NamespaceMapping {
+	return sync.NamespaceMappings
+}
+
+func (sync *Sync) GetTrustPolicy() types.SyncTrustPolicy {
+	return sync.TrustPolicy
+}
+
+func (sync *Sync) GetStatus() storev1.SyncStatus {
+	return sync.Status
+}
+
+func (sync *Sync) GetParallelism() int {
+	return sync.Parallelism
+}
+
+func (sync *Sync) GetSyncedRecords() int {
+	return sync.SyncedRecords
+}
+
+func (sync *Sync) GetThroughput() float64 {
+	if sync.FetchDuration <= 0 {
+		return 0
+	}
+
+	return float64(sync.SyncedRecords) / sync.FetchDuration.Seconds()
+}
+
+func (d *DB) CreateSync(remoteURL string, cids []string, mappings []types.NamespaceMapping, trustPolicy types.SyncTrustPolicy) (string, error) {
+	return d.CreateInvitedSync(remoteURL, cids, "", mappings, trustPolicy)
+}
+
+func (d *DB) CreateInvitedSync(remoteURL string, cids []string, invitationToken string, mappings []types.NamespaceMapping, trustPolicy types.SyncTrustPolicy) (string, error) {
+	sync := &Sync{
+		ID:                 uuid.NewString(),
+		RemoteDirectoryURL: remoteURL,
+		CIDs:               cids,
+		Status:             storev1.SyncStatus_SYNC_STATUS_PENDING,
+		InvitationToken:    invitationToken,
+		NamespaceMappings:  mappings,
+		TrustPolicy:        trustPolicy,
+	}
+
+	if err := d.gormDB.Create(sync).Error; err != nil {
+		return "", err
+	}
+
+	logger.Debug("Added sync to database", "sync_id", sync.ID)
+
+	return sync.ID, nil
+}
+
+func (d *DB) GetSyncByID(syncID string) (types.SyncObject, error) {
+	var sync Sync
+	if err := d.gormDB.Where("id = ?", syncID).First(&sync).Error; err != nil {
+		return nil, err
+	}
+
+	return &sync, nil
+}
+
+func (d *DB) GetSyncs(offset, limit int) ([]types.SyncObject, error) {
+	var syncs []Sync
+
+	query := d.gormDB.Offset(offset)
+
+	// Only apply limit if it's greater than 0
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Find(&syncs).Error; err != nil {
+		return nil, err
+	}
+
+	// convert to types.SyncObject
+	syncObjects := make([]types.SyncObject, len(syncs))
+	for i, sync := range syncs {
+		syncObjects[i] = &sync
+	}
+
+	return syncObjects, nil
+}
+
+func (d *DB) GetSyncsByStatus(status storev1.SyncStatus) ([]types.SyncObject, error) {
+	var syncs []Sync
+	if err := d.gormDB.Where("status = ?", status).Find(&syncs).Error; err != nil {
+		return nil, err
+	}
+
+	// convert to types.SyncObject
+	syncObjects := make([]types.SyncObject, len(syncs))
+	for i, sync := range syncs {
+		syncObjects[i] = &sync
+	}
+
+	return syncObjects, nil
+}
+
+func (d *DB) UpdateSyncStatus(syncID string, status storev1.SyncStatus) error {
+	syncObj, err := d.GetSyncByID(syncID)
+	if err != nil {
+		return err
+	}
+
+	sync, ok := syncObj.(*Sync)
+	if !ok {
+		return gorm.ErrInvalidData
+	}
+
+	sync.Status = status
+
+	if err := d.gormDB.Save(sync).Error; err != nil {
+		return err
+	}
+
+	logger.Debug("Updated sync in database", "sync_id", sync.GetID(), "status", sync.GetStatus())
+
+	return nil
+}
+
+func (d *DB) UpdateSyncRemoteRegistry(syncID string, remoteRegistry string) error {
+	syncObj, err := d.GetSyncByID(syncID)
+	if err != nil {
+		return err
+	}
+
+	sync, ok := syncObj.(*Sync)
+	if !ok {
+		return gorm.ErrInvalidData
+	}
+
+	sync.RemoteRegistryURL = remoteRegistry
+
+	if err := d.gormDB.Save(sync).Error; err != nil {
+		return err
+	}
+
+	logger.Debug("Updated sync in database", "sync_id", sync.GetID(), "remote_registry", sync.GetRemoteRegistryURL())
+
+	return nil
+}
+
+func (d *DB) UpdateSyncProgress(syncID string, parallelism int, records int, duration time.Duration) error {
+	syncObj, err := d.GetSyncByID(syncID)
+	if err != nil {
+		return err
+	}
+
+	sync, ok := syncObj.(*Sync)
+	if !ok {
+		return gorm.ErrInvalidData
+	}
+
+	sync.Parallelism = max(sync.Parallelism, parallelism)
+	sync.SyncedRecords += records
+	sync.FetchDuration += duration
+
+	if err := d.gormDB.Save(sync).Error; err != nil {
+		return err
+	}
+
+	logger.Debug("Updated sync progress in database", "sync_id", sync.GetID(), "synced_records", sync.GetSyncedRecords(), "throughput", sync.GetThroughput())
+
+	return nil
+}
+
+func (d *DB) GetSyncRemoteRegistry(syncID string) (string, error) {
+	syncObj, err := d.GetSyncByID(syncID)
+	if err != nil {
+		return "", err
+	}
+
+	sync, ok := syncObj.(*Sync)
+	if !ok {
+		return "", gorm.ErrInvalidData
+	}
+
+	return sync.GetRemoteRegistryURL(), nil
+}
+
+func (d *DB) GetSyncCIDs(syncID string) ([]string, error) {
+	syncObj, err := d.GetSyncByID(syncID)
+	if err != nil {
+		return nil, err
+	}
+
+	sync, ok := syncObj.(*Sync)
+	if !ok {
+		return nil, gorm.ErrInvalidData
+	}
+
+	return sync.GetCIDs(), nil
+}
+
+func (d *DB) DeleteSync(syncID string) error {
+	if err := d.gormDB.Where("id = ?", syncID).Delete(&Sync{}).Error; err != nil {
+		return err
+	}
+
+	logger.Debug("Deleted sync from database", "sync_id", syncID)
+
+	return nil
+}
+
+func (d *DB) QuarantineRecord(syncID, cid, reason string) error {
+	record := &QuarantinedRecord{
+		RecordCID: cid,
+		SyncID:    syncID,
+		Reason:    reason,
+	}
+
+	if err := d.gormDB.Clauses(clause.OnConflict{DoNothing: true}).Create(record).Error; err != nil {
+		return fmt.Errorf("failed to quarantine record: %w", err)
+	}
+
+	logger.Debug("Quarantined record in database", "cid", cid, "sync_id", syncID)
+
+	return nil
+}
+
+func (d *DB) GetQuarantinedRecords(syncID string, offset, limit int) ([]types.QuarantinedRecordObject, error) {
+	var records []QuarantinedRecord
+
+	query := d.gormDB.Where("released = ?", false).Order("created_at").Offset(offset)
+
+	if syncID != "" {
+		query = query.Where("sync_id = ?", syncID)
+	}
+
+	// Only apply limit if it's greater than 0
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	// convert to types.QuarantinedRecordObject
+	objects := make([]types.QuarantinedRecordObject, len(records))
+	for i := range records {
+		objects[i] = &records[i]
+	}
+
+	return objects, nil
+}
+
+func (d *DB) GetQuarantinedRecord(cid string) (types.QuarantinedRecordObject, error) {
+	var records []QuarantinedRecord
+	if err := d.gormDB.Where("record_cid = ?", cid).Limit(1).Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	return &records[0], nil
+}
+
+func (d *DB) ReleaseQuarantinedRecord(cid string) error {
+	result := d.gormDB.Model(&QuarantinedRecord{}).Where("record_cid = ?", cid).Update("released", true)
+	if result.Error != nil {
+		return fmt.Errorf("failed to release quarantined record: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	logger.Debug("Released quarantined record in database", "cid", cid)
+
+	return nil
+}
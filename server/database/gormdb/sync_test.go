@@ -0,0 +1,126 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncProgress(t *testing.T) {
+	db := setupTestDB(t)
+
+	syncID, err := db.CreateSync("remote:8888", nil, nil, types.SyncTrustPolicy{})
+	require.NoError(t, err)
+
+	syncObj, err := db.GetSyncByID(syncID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, syncObj.GetParallelism())
+	assert.Equal(t, 0, syncObj.GetSyncedRecords())
+	assert.Zero(t, syncObj.GetThroughput())
+
+	require.NoError(t, db.UpdateSyncProgress(syncID, 4, 10, time.Second))
+	require.NoError(t, db.UpdateSyncProgress(syncID, 2, 30, time.Second))
+
+	syncObj, err = db.GetSyncByID(syncID)
+	require.NoError(t, err)
+	assert.Equal(t, 4, syncObj.GetParallelism())
+	assert.Equal(t, 40, syncObj.GetSyncedRecords())
+	assert.InDelta(t, 20.0, syncObj.GetThroughput(), 0.001)
+
+	// Unknown syncs fail
+	require.Error(t, db.UpdateSyncProgress("non-existent-sync", 1, 1, time.Second))
+}
+
+func TestInvitedSync(t *testing.T) {
+	db := setupTestDB(t)
+
+	syncID, err := db.CreateInvitedSync("remote:8888", []string{"bafy1"}, "dirinv1.token.signature", nil, types.SyncTrustPolicy{})
+	require.NoError(t, err)
+
+	syncObj, err := db.GetSyncByID(syncID)
+	require.NoError(t, err)
+	assert.Equal(t, "remote:8888", syncObj.GetRemoteDirectoryURL())
+	assert.Equal(t, []string{"bafy1"}, syncObj.GetCIDs())
+	assert.Equal(t, "dirinv1.token.signature", syncObj.GetInvitationToken())
+
+	// Syncs created directly have no invitation
+	syncID, err = db.CreateSync("remote:8888", nil, nil, types.SyncTrustPolicy{})
+	require.NoError(t, err)
+
+	syncObj, err = db.GetSyncByID(syncID)
+	require.NoError(t, err)
+	assert.Empty(t, syncObj.GetInvitationToken())
+}
+
+func TestSyncNamespaceMappings(t *testing.T) {
+	db := setupTestDB(t)
+
+	mappings := []types.NamespaceMapping{{Remote: "acme/*", Local: "mirrors/acme/*"}}
+
+	syncID, err := db.CreateSync("remote:8888", nil, mappings, types.SyncTrustPolicy{})
+	require.NoError(t, err)
+
+	syncObj, err := db.GetSyncByID(syncID)
+	require.NoError(t, err)
+	assert.Equal(t, mappings, syncObj.GetNamespaceMappings())
+}
+
+func TestSyncTrustPolicy(t *testing.T) {
+	db := setupTestDB(t)
+
+	policy := types.SyncTrustPolicy{TrustedPublicKeys: []string{"-----BEGIN PUBLIC KEY-----\nkey\n-----END PUBLIC KEY-----\n"}}
+
+	syncID, err := db.CreateSync("remote:8888", nil, nil, policy)
+	require.NoError(t, err)
+
+	syncObj, err := db.GetSyncByID(syncID)
+	require.NoError(t, err)
+	assert.Equal(t, policy, syncObj.GetTrustPolicy())
+	assert.True(t, syncObj.GetTrustPolicy().Enabled())
+}
+
+func TestQuarantinedRecords(t *testing.T) {
+	db := setupTestDB(t)
+
+	require.NoError(t, db.QuarantineRecord("sync1", "bafy1", "untrusted"))
+	require.NoError(t, db.QuarantineRecord("sync2", "bafy2", "untrusted"))
+
+	// Records already quarantined are left unchanged
+	require.NoError(t, db.QuarantineRecord("sync2", "bafy1", "other"))
+
+	records, err := db.GetQuarantinedRecords("", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	records, err = db.GetQuarantinedRecords("sync1", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "bafy1", records[0].GetCID())
+	assert.Equal(t, "untrusted", records[0].GetReason())
+	assert.False(t, records[0].GetQuarantinedAt().IsZero())
+
+	// Released records are kept but no longer listed
+	require.NoError(t, db.ReleaseQuarantinedRecord("bafy1"))
+
+	records, err = db.GetQuarantinedRecords("", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "bafy2", records[0].GetCID())
+
+	record, err := db.GetQuarantinedRecord("bafy1")
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	assert.True(t, record.IsReleased())
+
+	// Unknown records
+	record, err = db.GetQuarantinedRecord("bafy3")
+	require.NoError(t, err)
+	assert.Nil(t, record)
+	require.Error(t, db.ReleaseQuarantinedRecord("bafy3"))
+}
@@ -0,0 +1,144 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/google/uuid"
+)
+
+type RecordWebhook struct {
+	GormID      uint `gorm:"primarykey"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	ID          string `gorm:"not null;index"`
+	CID         string `gorm:"column:record_cid;not null;index"`
+	URL         string `gorm:"not null"`
+	EventTypes  string // Comma-separated event type names, empty for all record events
+	Secret      string
+	Owner       string `gorm:"index"` // SPIFFE ID of the registering identity, empty without authentication
+	CreatedTime string `gorm:"not null"`
+}
+
+func (w *RecordWebhook) GetID() string {
+	return w.ID
+}
+
+func (w *RecordWebhook) GetCID() string {
+	return w.CID
+}
+
+func (w *RecordWebhook) GetURL() string {
+	return w.URL
+}
+
+func (w *RecordWebhook) GetEventTypes() []eventsv1.EventType {
+	if w.EventTypes == "" {
+		return nil
+	}
+
+	names := strings.Split(w.EventTypes, ",")
+	eventTypes := make([]eventsv1.EventType, 0, len(names))
+
+	for _, name := range names {
+		eventTypes = append(eventTypes, eventsv1.EventType(eventsv1.EventType_value[name]))
+	}
+
+	return eventTypes
+}
+
+func (w *RecordWebhook) GetSecret() string {
+	return w.Secret
+}
+
+func (w *RecordWebhook) GetOwner() string {
+	return w.Owner
+}
+
+func (w *RecordWebhook) GetCreatedTime() string {
+	return w.CreatedTime
+}
+
+func (d *DB) CreateRecordWebhook(cid, url string, eventTypes []eventsv1.EventType, secret, owner string) (string, error) {
+	names := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		names = append(names, eventType.String())
+	}
+
+	webhook := &RecordWebhook{
+		ID:          uuid.NewString(),
+		CID:         cid,
+		URL:         url,
+		EventTypes:  strings.Join(names, ","),
+		Secret:      secret,
+		Owner:       owner,
+		CreatedTime: time.Now().Format(time.RFC3339),
+	}
+
+	if err := d.gormDB.Create(webhook).Error; err != nil {
+		return "", fmt.Errorf("failed to create record webhook: %w", err)
+	}
+
+	logger.Debug("Added record webhook to database", "webhook_id", webhook.ID, "cid", cid, "owner", owner)
+
+	return webhook.ID, nil
+}
+
+func (d *DB) GetRecordWebhookByID(webhookID string) (types.RecordWebhookObject, error) {
+	var webhook RecordWebhook
+	if err := d.gormDB.Where("id = ?", webhookID).First(&webhook).Error; err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func (d *DB) GetRecordWebhooksByOwner(owner, cid string) ([]types.RecordWebhookObject, error) {
+	query := d.gormDB.Where("owner = ?", owner)
+
+	if cid != "" {
+		query = query.Where("record_cid = ?", cid)
+	}
+
+	var webhooks []RecordWebhook
+	if err := query.Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+
+	return toRecordWebhookObjects(webhooks), nil
+}
+
+func (d *DB) GetRecordWebhooksByCID(cid string) ([]types.RecordWebhookObject, error) {
+	var webhooks []RecordWebhook
+	if err := d.gormDB.Where("record_cid = ?", cid).Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+
+	return toRecordWebhookObjects(webhooks), nil
+}
+
+func (d *DB) DeleteRecordWebhook(webhookID string) error {
+	if err := d.gormDB.Where("id = ?", webhookID).Delete(&RecordWebhook{}).Error; err != nil {
+		return err
+	}
+
+	logger.Debug("Deleted record webhook from database", "webhook_id", webhookID)
+
+	return nil
+}
+
+// toRecordWebhookObjects converts record webhooks to types.RecordWebhookObject.
+func toRecordWebhookObjects(webhooks []RecordWebhook) []types.RecordWebhookObject {
+	webhookObjects := make([]types.RecordWebhookObject, len(webhooks))
+	for i := range webhooks {
+		webhookObjects[i] = &webhooks[i]
+	}
+
+	return webhookObjects
+}
@@ -0,0 +1,68 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gormdb
+
+import (
+	"testing"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordWebhooks(t *testing.T) {
+	db := setupTestDB(t)
+
+	const (
+		alice = "spiffe://example.org/alice"
+		bob   = "spiffe://example.org/bob"
+	)
+
+	eventTypes := []eventsv1.EventType{
+		eventsv1.EventType_EVENT_TYPE_RECORD_PULLED,
+		eventsv1.EventType_EVENT_TYPE_RECORD_VALIDATION_DRIFT,
+	}
+
+	aliceID, err := db.CreateRecordWebhook("cid-1", "https://alice.example.org/hook", eventTypes, "secret", alice)
+	require.NoError(t, err)
+
+	_, err = db.CreateRecordWebhook("cid-2", "https://alice.example.org/hook", nil, "", alice)
+	require.NoError(t, err)
+
+	_, err = db.CreateRecordWebhook("cid-1", "https://bob.example.org/hook", nil, "", bob)
+	require.NoError(t, err)
+
+	webhook, err := db.GetRecordWebhookByID(aliceID)
+	require.NoError(t, err)
+	assert.Equal(t, "cid-1", webhook.GetCID())
+	assert.Equal(t, "https://alice.example.org/hook", webhook.GetURL())
+	assert.Equal(t, eventTypes, webhook.GetEventTypes())
+	assert.Equal(t, "secret", webhook.GetSecret())
+	assert.Equal(t, alice, webhook.GetOwner())
+
+	// Owners only see their own webhooks
+	owned, err := db.GetRecordWebhooksByOwner(alice, "")
+	require.NoError(t, err)
+	assert.Len(t, owned, 2)
+
+	owned, err = db.GetRecordWebhooksByOwner(alice, "cid-1")
+	require.NoError(t, err)
+	require.Len(t, owned, 1)
+	assert.Equal(t, aliceID, owned[0].GetID())
+
+	// All webhooks of a record are dispatched
+	webhooks, err := db.GetRecordWebhooksByCID("cid-1")
+	require.NoError(t, err)
+	assert.Len(t, webhooks, 2)
+
+	require.NoError(t, db.DeleteRecordWebhook(aliceID))
+
+	_, err = db.GetRecordWebhookByID(aliceID)
+	require.Error(t, err)
+
+	webhooks, err = db.GetRecordWebhooksByCID("cid-1")
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Empty(t, webhooks[0].GetEventTypes())
+}
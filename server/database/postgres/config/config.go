@@ -0,0 +1,35 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+const (
+	DefaultPostgresDSN             = "postgres://dir@localhost:5432/dir?sslmode=disable"
+	DefaultPostgresMaxOpenConns    = 25
+	DefaultPostgresMaxIdleConns    = 5
+	DefaultPostgresConnMaxLifetime = 30 * time.Minute
+	DefaultPostgresConnMaxIdleTime = 5 * time.Minute
+)
+
+type Config struct {
+	// DSN is the data source name of the PostgreSQL database,
+	// either as a URL or as key=value pairs.
+	DSN string `json:"dsn,omitempty" mapstructure:"dsn"`
+
+	// MaxOpenConns is the maximum number of open connections to the database.
+	// Zero means unlimited.
+	MaxOpenConns int `json:"max_open_conns,omitempty" mapstructure:"max_open_conns"`
+
+	// MaxIdleConns is the maximum number of idle connections kept in the pool.
+	MaxIdleConns int `json:"max_idle_conns,omitempty" mapstructure:"max_idle_conns"`
+
+	// ConnMaxLifetime is the maximum time a connection is reused.
+	// Zero means connections are reused forever.
+	ConnMaxLifetime time.Duration `json:"conn_max_lifetime,omitempty" mapstructure:"conn_max_lifetime"`
+
+	// ConnMaxIdleTime is the maximum time a connection stays idle in the pool.
+	// Zero means idle connections are not closed due to idle time.
+	ConnMaxIdleTime time.Duration `json:"conn_max_idle_time,omitempty" mapstructure:"conn_max_idle_time"`
+}
@@ -0,0 +1,81 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package postgres implements the database on PostgreSQL, sharing the GORM
+// models and queries of the gormdb package, which adapts them to the dialect.
+package postgres
+
+import (
+	"fmt"
+
+	dbconfig "github.com/agntcy/dir/server/database/config"
+	"github.com/agntcy/dir/server/database/gormdb"
+	postgresconfig "github.com/agntcy/dir/server/database/postgres/config"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// migrationLockID is the key of the advisory lock held while migrating the schema,
+// so that directory servers started together do not migrate it concurrently.
+const migrationLockID = 0x646972 // "dir"
+
+type DB struct {
+	*gormdb.DB
+}
+
+func New(cfg postgresconfig.Config, indexedAnnotations []string, locatorsConfig dbconfig.LocatorsConfig, replicasConfig dbconfig.ReplicasConfig) (*DB, error) {
+	db, err := open(cfg.DSN, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to PostgreSQL database: %w", err)
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		// The lock is released when the transaction ends
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockID).Error; err != nil {
+			return fmt.Errorf("failed to lock schema migration: %w", err)
+		}
+
+		return gormdb.Migrate(tx)
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	// Open read replicas. The schema is migrated by the primary and replicated to them.
+	replicaDBs := make([]*gorm.DB, 0, len(replicasConfig.ReadDSNs))
+
+	for _, dsn := range replicasConfig.ReadDSNs {
+		replicaDB, err := open(dsn, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to PostgreSQL read replica: %w", err)
+		}
+
+		replicaDBs = append(replicaDBs, replicaDB)
+	}
+
+	return &DB{
+		DB: gormdb.New(db, replicaDBs, indexedAnnotations, locatorsConfig, replicasConfig),
+	}, nil
+}
+
+// open connects to a PostgreSQL database with the connection pool settings of the configuration.
+func open(dsn string, cfg postgresconfig.Config) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: gormdb.NewLogger(),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection pool: %w", err)
+	}
+
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+	return db, nil
+}
@@ -0,0 +1,60 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"testing"
+
+	dbconfig "github.com/agntcy/dir/server/database/config"
+	"github.com/agntcy/dir/server/database/gormdb"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// TestQueries checks the SQL generated for the PostgreSQL dialect by the queries
+// adapted to the dialect, without connecting to a database.
+func TestQueries(t *testing.T) {
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost dbname=dir"}), &gorm.Config{
+		Logger:               gormdb.NewLogger(),
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	require.NoError(t, err)
+
+	var queries []string
+
+	err = gormDB.Callback().Query().After("gorm:query").Register("test:capture", func(tx *gorm.DB) {
+		queries = append(queries, tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...))
+	})
+	require.NoError(t, err)
+
+	db := gormdb.New(gormDB, nil, []string{"team"}, dbconfig.LocatorsConfig{}, dbconfig.ReplicasConfig{})
+
+	_, err = db.GetRecordCIDs(
+		types.WithSkillNames("Natural*"),
+		types.WithAnnotation("team", "Team-?"),
+		types.WithPreferredRegions("EU-West", "us-east"),
+	)
+	require.NoError(t, err)
+	require.Len(t, queries, 1)
+
+	query := queries[0]
+
+	// Records matched through several joined rows are grouped by their primary key,
+	// as PostgreSQL only orders distinct rows by selected expressions
+	assert.Contains(t, query, `SELECT records.record_cid FROM "records"`)
+	assert.Contains(t, query, `GROUP BY "records"."record_cid"`)
+	assert.NotContains(t, query, "DISTINCT")
+
+	// Wildcards are matched with anchored regular expressions, as PostgreSQL has no GLOB operator
+	assert.Contains(t, query, `LOWER(skills.name) ~ '^natural.*$'`)
+	assert.Contains(t, query, `(annotations_0.value ~ '^Team-.$')`)
+
+	// Records are ranked by their best locator region among the preferred regions
+	assert.Contains(t, query, `ORDER BY COALESCE((SELECT MIN(CASE locators.region WHEN 'eu-west' THEN 0 WHEN 'us-east' THEN 1 ELSE 2 END) `+
+		`FROM locators WHERE locators.record_cid = records.record_cid), 2)`)
+}
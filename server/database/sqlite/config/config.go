@@ -3,6 +3,13 @@
 
 package config
 
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
 const (
 	DefaultSQLiteDBPath = "/tmp/dir.db"
 )
@@ -10,4 +17,35 @@ const (
 type Config struct {
 	// DBPath is the path to the SQLite database file.
 	DBPath string `json:"db_path,omitempty" mapstructure:"db_path"`
+
+	// Key is the passphrase encrypting the database with SQLCipher.
+	// The database is not encrypted if neither Key nor KeyFile is set.
+	Key string `json:"key,omitempty" mapstructure:"key"`
+
+	// KeyFile is the path to a file holding the passphrase, e.g. a mounted secret.
+	KeyFile string `json:"key_file,omitempty" mapstructure:"key_file"`
+}
+
+// EncryptionKey returns the passphrase encrypting the database, read from KeyFile if set.
+// It returns an empty passphrase if the database is not encrypted.
+func (c Config) EncryptionKey() (string, error) {
+	if c.KeyFile == "" {
+		return c.Key, nil
+	}
+
+	if c.Key != "" {
+		return "", errors.New("only one of key and key file can be set")
+	}
+
+	data, err := os.ReadFile(c.KeyFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	key := strings.TrimSpace(string(data))
+	if key == "" {
+		return "", fmt.Errorf("key file %s is empty", c.KeyFile)
+	}
+
+	return key, nil
 }
@@ -0,0 +1,49 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEncryptionKey(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key")
+	if err := os.WriteFile(keyFile, []byte("file-secret\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write key file: %v", err)
+	}
+
+	emptyKeyFile := filepath.Join(t.TempDir(), "empty")
+	if err := os.WriteFile(emptyKeyFile, nil, 0o600); err != nil {
+		t.Fatalf("Failed to write key file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		want    string
+		wantErr bool
+	}{
+		{name: "not encrypted", cfg: Config{}},
+		{name: "key", cfg: Config{Key: "secret"}, want: "secret"},
+		{name: "key file", cfg: Config{KeyFile: keyFile}, want: "file-secret"},
+		{name: "key and key file", cfg: Config{Key: "secret", KeyFile: keyFile}, wantErr: true},
+		{name: "empty key file", cfg: Config{KeyFile: emptyKeyFile}, wantErr: true},
+		{name: "missing key file", cfg: Config{KeyFile: filepath.Join(t.TempDir(), "missing")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cfg.EncryptionKey()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EncryptionKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("EncryptionKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !sqlcipher
+
+package sqlite
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// encryptedDialector is unsupported, as the pure Go SQLite driver cannot encrypt databases.
+func encryptedDialector(string, string) (gorm.Dialector, error) {
+	return nil, errors.New("database encryption requires a server built with the sqlcipher build tag")
+}
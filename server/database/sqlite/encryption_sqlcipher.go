@@ -0,0 +1,35 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build sqlcipher
+
+package sqlite
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/glebarez/sqlite"
+	_ "github.com/mutecomm/go-sqlcipher/v4" // Registers the sqlite3 driver with SQLCipher support
+	"gorm.io/gorm"
+)
+
+// sqlcipherDriverName is the name of the database/sql driver of SQLCipher.
+const sqlcipherDriverName = "sqlite3"
+
+// encryptedDialector returns a dialector opening the database with SQLCipher, keyed with the passphrase.
+// Building with SQLCipher requires cgo.
+func encryptedDialector(dsn string, key string) (gorm.Dialector, error) {
+	// The key is set with "PRAGMA key" when connections are opened, as a quoted passphrase
+	pragmaKey := "'" + strings.ReplaceAll(key, "'", "''") + "'"
+
+	separator := "?"
+	if strings.Contains(dsn, "?") {
+		separator = "&"
+	}
+
+	return sqlite.New(sqlite.Config{
+		DriverName: sqlcipherDriverName,
+		DSN:        dsn + separator + "_pragma_key=" + url.QueryEscape(pragmaKey),
+	}), nil
+}
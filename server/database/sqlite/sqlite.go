@@ -1,94 +1,80 @@
 // Copyright AGNTCY Contributors (https://github.com/agntcy)
 // SPDX-License-Identifier: Apache-2.0
 
+// Package sqlite implements the database on SQLite, optionally encrypted with SQLCipher.
 package sqlite
 
 import (
-	"context"
 	"fmt"
-	"log"
-	"os"
-	"time"
 
-	"github.com/agntcy/dir/utils/logging"
+	dbconfig "github.com/agntcy/dir/server/database/config"
+	"github.com/agntcy/dir/server/database/gormdb"
+	sqliteconfig "github.com/agntcy/dir/server/database/sqlite/config"
 	"github.com/glebarez/sqlite"
 	"gorm.io/gorm"
-	gormlogger "gorm.io/gorm/logger"
 )
 
-var logger = logging.Logger("database/sqlite")
-
 type DB struct {
-	gormDB *gorm.DB
+	*gormdb.DB
 }
 
-func newCustomLogger() gormlogger.Interface {
-	// Create a custom logger configuration that ignores "record not found" errors
-	// since these are expected during normal operation (checking if records exist)
-	return gormlogger.New(
-		log.New(os.Stdout, "\r\n", log.LstdFlags),
-		gormlogger.Config{
-			SlowThreshold:             200 * time.Millisecond, //nolint:mnd
-			LogLevel:                  gormlogger.Warn,
-			IgnoreRecordNotFoundError: true,
-			Colorful:                  true,
-		},
-	)
-}
+func New(cfg sqliteconfig.Config, indexedAnnotations []string, locatorsConfig dbconfig.LocatorsConfig, replicasConfig dbconfig.ReplicasConfig) (*DB, error) {
+	key, err := cfg.EncryptionKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve SQLite encryption key: %w", err)
+	}
 
-func New(path string) (*DB, error) {
-	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{
-		Logger: newCustomLogger(),
-	})
+	db, err := open(cfg.DBPath, key)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to SQLite database: %w", err)
 	}
 
-	// Migrate record-related schema
-	if err := db.AutoMigrate(Record{}, Locator{}, Skill{}, Module{}, Domain{}); err != nil {
-		return nil, fmt.Errorf("failed to migrate record schema: %w", err)
+	if err := gormdb.Migrate(db); err != nil {
+		return nil, err //nolint:wrapcheck
 	}
 
-	// Migrate sync-related schema
-	if err := db.AutoMigrate(Sync{}); err != nil {
-		return nil, fmt.Errorf("failed to migrate sync schema: %w", err)
-	}
+	// Open read replicas. The schema is migrated by the primary and replicated to them,
+	// and replicas of an encrypted database are encrypted with the same key.
+	replicaDBs := make([]*gorm.DB, 0, len(replicasConfig.ReadDSNs))
+
+	for _, dsn := range replicasConfig.ReadDSNs {
+		replicaDB, err := open(dsn, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to SQLite read replica: %w", err)
+		}
 
-	// Migrate publication-related schema
-	if err := db.AutoMigrate(Publication{}); err != nil {
-		return nil, fmt.Errorf("failed to migrate publication schema: %w", err)
+		replicaDBs = append(replicaDBs, replicaDB)
 	}
 
 	return &DB{
-		gormDB: db,
+		DB: gormdb.New(db, replicaDBs, indexedAnnotations, locatorsConfig, replicasConfig),
 	}, nil
 }
 
-// IsReady checks if the database connection is ready to serve traffic.
-// Returns true if the database connection is established and can execute queries.
-func (d *DB) IsReady(ctx context.Context) bool {
-	if d.gormDB == nil {
-		logger.Debug("Database not ready: gormDB is nil")
-
-		return false
+// open connects to the SQLite database with the given DSN, decrypting it with the key if set.
+func open(dsn string, key string) (*gorm.DB, error) {
+	if key == "" {
+		return gorm.Open(sqlite.Open(dsn), &gorm.Config{ //nolint:wrapcheck
+			Logger: gormdb.NewLogger(),
+		})
 	}
 
-	// Get the underlying SQL database
-	sqlDB, err := d.gormDB.DB()
+	dialector, err := encryptedDialector(dsn, key)
 	if err != nil {
-		logger.Debug("Database not ready: failed to get SQL DB", "error", err)
-
-		return false
+		return nil, err
 	}
 
-	// Ping the database with context
-	if err := sqlDB.PingContext(ctx); err != nil {
-		logger.Debug("Database not ready: ping failed", "error", err)
-
-		return false
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger: gormdb.NewLogger(),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
 	}
 
-	logger.Debug("Database ready")
+	// The key is only checked when the database is first read
+	if err := db.Exec("SELECT count(*) FROM sqlite_master").Error; err != nil {
+		return nil, fmt.Errorf("failed to decrypt database, the key may be invalid: %w", err)
+	}
 
-	return true
+	return db, nil
 }
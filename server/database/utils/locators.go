@@ -0,0 +1,80 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"strings"
+
+	"github.com/agntcy/dir/server/database/config"
+	"github.com/agntcy/dir/server/types"
+)
+
+// dockerImageLocatorType is the type of locators referencing container images.
+const dockerImageLocatorType = "docker-image"
+
+// NormalizeLocatorURL normalizes a locator URL with the configured rules.
+// The digest is only used to pin docker-image references, and may be empty.
+func NormalizeLocatorURL(locatorType, url, digest string, cfg config.LocatorsConfig) string {
+	url = strings.TrimSpace(url)
+
+	if cfg.LowercaseScheme {
+		if scheme, rest, ok := strings.Cut(url, "://"); ok {
+			url = strings.ToLower(scheme) + "://" + rest
+		}
+	}
+
+	if cfg.TrimTrailingSlash {
+		trimmed := strings.TrimRight(url, "/")
+
+		// Keep URLs consisting of a scheme only, e.g. file:///
+		if !strings.HasSuffix(trimmed, ":") {
+			url = trimmed
+		}
+	}
+
+	if cfg.PinDigests && digest != "" && strings.EqualFold(locatorType, dockerImageLocatorType) && !strings.Contains(url, "@") {
+		url += "@" + digest
+	}
+
+	return url
+}
+
+// NormalizeLocators returns the locators with normalized URLs.
+// Locators with the same type and normalized URL are returned once if deduplication
+// is enabled, keeping the first one.
+func NormalizeLocators(locators []types.Locator, cfg config.LocatorsConfig) []types.Locator {
+	result := make([]types.Locator, 0, len(locators))
+	seen := make(map[string]struct{}, len(locators))
+
+	for _, locator := range locators {
+		normalized := &normalizedLocator{
+			Locator: locator,
+			url:     NormalizeLocatorURL(locator.GetType(), locator.GetURL(), locator.GetDigest(), cfg),
+		}
+
+		if cfg.Deduplicate {
+			key := strings.ToLower(locator.GetType()) + "\x00" + normalized.url
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+		}
+
+		result = append(result, normalized)
+	}
+
+	return result
+}
+
+// normalizedLocator overrides the URL of a locator with its normalized URL.
+type normalizedLocator struct {
+	types.Locator
+
+	url string
+}
+
+func (l *normalizedLocator) GetURL() string {
+	return l.url
+}
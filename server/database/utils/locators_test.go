@@ -0,0 +1,118 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/agntcy/dir/server/database/config"
+	"github.com/agntcy/dir/server/types"
+)
+
+var allLocatorRules = config.LocatorsConfig{
+	LowercaseScheme:   true,
+	TrimTrailingSlash: true,
+	PinDigests:        true,
+	Deduplicate:       true,
+}
+
+func TestNormalizeLocatorURL(t *testing.T) {
+	tests := []struct {
+		name        string
+		locatorType string
+		url         string
+		digest      string
+		cfg         config.LocatorsConfig
+		expected    string
+	}{
+		{
+			name:     "lowercase scheme",
+			url:      "HTTPS://Example.com/Path",
+			cfg:      allLocatorRules,
+			expected: "https://Example.com/Path",
+		},
+		{
+			name:     "trailing slashes",
+			url:      "https://example.com/path//",
+			cfg:      allLocatorRules,
+			expected: "https://example.com/path",
+		},
+		{
+			name:     "scheme only",
+			url:      "file:///",
+			cfg:      allLocatorRules,
+			expected: "file:///",
+		},
+		{
+			name:        "pin docker image digest",
+			locatorType: "docker-image",
+			url:         "ghcr.io/agntcy/dir:v1",
+			digest:      "sha256:abc",
+			cfg:         allLocatorRules,
+			expected:    "ghcr.io/agntcy/dir:v1@sha256:abc",
+		},
+		{
+			name:        "already pinned docker image",
+			locatorType: "docker-image",
+			url:         "ghcr.io/agntcy/dir@sha256:def",
+			digest:      "sha256:abc",
+			cfg:         allLocatorRules,
+			expected:    "ghcr.io/agntcy/dir@sha256:def",
+		},
+		{
+			name:        "digest of other locator types",
+			locatorType: "source-code",
+			url:         "https://github.com/agntcy/dir",
+			digest:      "sha256:abc",
+			cfg:         allLocatorRules,
+			expected:    "https://github.com/agntcy/dir",
+		},
+		{
+			name:        "rules disabled",
+			locatorType: "docker-image",
+			url:         "HTTPS://example.com/image/",
+			digest:      "sha256:abc",
+			expected:    "HTTPS://example.com/image/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeLocatorURL(tt.locatorType, tt.url, tt.digest, tt.cfg); got != tt.expected {
+				t.Errorf("NormalizeLocatorURL() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+type testLocator struct {
+	types.Locator
+
+	locatorType, url string
+}
+
+func (l *testLocator) GetType() string   { return l.locatorType }
+func (l *testLocator) GetURL() string    { return l.url }
+func (l *testLocator) GetDigest() string { return "" }
+
+func TestNormalizeLocatorsDeduplicate(t *testing.T) {
+	locators := []types.Locator{
+		&testLocator{locatorType: "source-code", url: "https://github.com/agntcy/dir/"},
+		&testLocator{locatorType: "source-code", url: "HTTPS://github.com/agntcy/dir"},
+		&testLocator{locatorType: "binary", url: "https://github.com/agntcy/dir"},
+	}
+
+	normalized := NormalizeLocators(locators, allLocatorRules)
+	if len(normalized) != 2 { //nolint:mnd
+		t.Fatalf("NormalizeLocators() returned %d locators, want 2", len(normalized))
+	}
+
+	// Without deduplication, all locators are kept
+	cfg := allLocatorRules
+	cfg.Deduplicate = false
+
+	if got := NormalizeLocators(locators, cfg); len(got) != len(locators) {
+		t.Errorf("NormalizeLocators() returned %d locators, want %d", len(got), len(locators))
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// replicaPingTimeout bounds the health check of a single read replica.
+const replicaPingTimeout = 2 * time.Second
+
+// Replicas routes read-only queries to read replicas and writes to the primary.
+// Replicas are selected in round-robin order among the healthy ones. Each replica is
+// health checked at most once per interval when it is selected, so that failed replicas
+// are skipped until they recover. Reads fall back to the primary if no replica is healthy.
+type Replicas struct {
+	primary  *gorm.DB
+	replicas []*replica
+	interval time.Duration
+	next     atomic.Uint64
+}
+
+type replica struct {
+	index int
+	db    *gorm.DB
+
+	mu        sync.Mutex
+	healthy   bool
+	checkedAt time.Time
+}
+
+// NewReplicas creates a router for the given primary and read replica connections.
+func NewReplicas(primary *gorm.DB, replicas []*gorm.DB, healthCheckInterval time.Duration) *Replicas {
+	r := &Replicas{
+		primary:  primary,
+		interval: healthCheckInterval,
+	}
+
+	for i, db := range replicas {
+		r.replicas = append(r.replicas, &replica{index: i, db: db})
+	}
+
+	return r
+}
+
+// Primary returns the connection used for writes and read-your-writes queries.
+func (r *Replicas) Primary() *gorm.DB {
+	return r.primary
+}
+
+// Reader returns a connection for read-only queries.
+func (r *Replicas) Reader() *gorm.DB {
+	if len(r.replicas) == 0 {
+		return r.primary
+	}
+
+	start := r.next.Add(1)
+
+	for i := range uint64(len(r.replicas)) {
+		replica := r.replicas[(start+i)%uint64(len(r.replicas))]
+		if replica.isHealthy(r.interval) {
+			return replica.db
+		}
+	}
+
+	logger.Debug("No healthy read replica, reading from primary")
+
+	return r.primary
+}
+
+// isHealthy returns the health of the replica, checking it again if the last check is older than interval.
+func (r *replica) isHealthy(interval time.Duration) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.checkedAt.IsZero() && time.Since(r.checkedAt) < interval {
+		return r.healthy
+	}
+
+	healthy := r.ping()
+	if healthy != r.healthy {
+		if healthy {
+			logger.Info("Read replica is healthy, routing reads to it", "replica", r.index)
+		} else {
+			logger.Warn("Read replica is unhealthy, failing back to other connections", "replica", r.index)
+		}
+	}
+
+	r.healthy = healthy
+	r.checkedAt = time.Now()
+
+	return r.healthy
+}
+
+func (r *replica) ping() bool {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), replicaPingTimeout)
+	defer cancel()
+
+	return sqlDB.PingContext(ctx) == nil
+}
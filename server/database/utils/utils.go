@@ -93,6 +93,24 @@ func QueryToFilters(queries []*searchv1.RecordQuery) ([]types.FilterOption, erro
 		case searchv1.RecordQueryType_RECORD_QUERY_TYPE_DOMAIN_NAME:
 			options = append(options, types.WithDomainNames(query.GetValue()))
 
+		case searchv1.RecordQueryType_RECORD_QUERY_TYPE_ANNOTATION:
+			key, value, ok := strings.Cut(query.GetValue(), "=")
+			if !ok || strings.TrimSpace(key) == "" {
+				return nil, fmt.Errorf("invalid annotation query %q: expected key=value", query.GetValue())
+			}
+
+			options = append(options, types.WithAnnotation(strings.TrimSpace(key), value))
+
+		case searchv1.RecordQueryType_RECORD_QUERY_TYPE_LICENSE:
+			if strings.TrimSpace(query.GetValue()) != "" {
+				options = append(options, types.WithLicenses(strings.TrimSpace(query.GetValue())))
+			}
+
+		case searchv1.RecordQueryType_RECORD_QUERY_TYPE_SIGNER:
+			if strings.TrimSpace(query.GetValue()) != "" {
+				options = append(options, types.WithSigners(strings.TrimSpace(query.GetValue())))
+			}
+
 		default:
 			logger.Warn("Unknown query type", "type", query.GetType())
 		}
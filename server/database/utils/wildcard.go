@@ -4,6 +4,7 @@
 package utils
 
 import (
+	"regexp"
 	"strings"
 )
 
@@ -20,9 +21,17 @@ func containsListWildcard(pattern string) bool {
 	return openIdx != -1 && closeIdx > openIdx
 }
 
+// Dialect is the SQL dialect of a database, as named by its GORM dialector.
+type Dialect string
+
+const (
+	DialectSQLite   Dialect = "sqlite"
+	DialectPostgres Dialect = "postgres"
+)
+
 // BuildWildcardCondition builds a WHERE condition for wildcard or exact matching.
 // Returns the condition string and arguments for the WHERE clause.
-func BuildWildcardCondition(field string, patterns []string) (string, []interface{}) {
+func BuildWildcardCondition(dialect Dialect, field string, patterns []string) (string, []interface{}) {
 	if len(patterns) == 0 {
 		return "", nil
 	}
@@ -31,7 +40,7 @@ func BuildWildcardCondition(field string, patterns []string) (string, []interfac
 	args := make([]interface{}, 0, len(patterns))
 
 	for _, pattern := range patterns {
-		condition, arg := BuildSingleWildcardCondition(field, pattern)
+		condition, arg := BuildSingleWildcardCondition(dialect, field, pattern)
 		conditions = append(conditions, condition)
 		args = append(args, arg)
 	}
@@ -46,10 +55,88 @@ func BuildWildcardCondition(field string, patterns []string) (string, []interfac
 
 // BuildSingleWildcardCondition builds a WHERE condition for a single field with wildcard or exact matching.
 // Returns the condition string and argument for the WHERE clause.
-func BuildSingleWildcardCondition(field, pattern string) (string, string) {
+func BuildSingleWildcardCondition(dialect Dialect, field, pattern string) (string, string) {
 	if ContainsWildcards(pattern) {
-		return "LOWER(" + field + ") GLOB ?", strings.ToLower(pattern)
+		return BuildGlobCondition(dialect, "LOWER("+field+")", strings.ToLower(pattern))
 	}
 
 	return "LOWER(" + field + ") = ?", strings.ToLower(pattern)
 }
+
+// BuildGlobCondition builds a WHERE condition matching a field against a case-sensitive GLOB pattern.
+// PostgreSQL has no GLOB operator, so patterns are matched as anchored regular expressions instead.
+func BuildGlobCondition(dialect Dialect, field, pattern string) (string, string) {
+	if dialect == DialectPostgres {
+		return field + " ~ ?", "^" + globToRegexp(pattern) + "$"
+	}
+
+	return field + " GLOB ?", pattern
+}
+
+// MatchWildcard reports whether a value matches a pattern with the semantics of
+// BuildSingleWildcardCondition: case-insensitive GLOB matching for patterns with
+// wildcards and case-insensitive equality otherwise.
+func MatchWildcard(pattern, value string) bool {
+	if !ContainsWildcards(pattern) {
+		return strings.EqualFold(pattern, value)
+	}
+
+	re, err := regexp.Compile("(?is)^" + globToRegexp(pattern) + "$")
+	if err != nil {
+		return false
+	}
+
+	return re.MatchString(value)
+}
+
+// globToRegexp converts a GLOB pattern to a regular expression.
+// A [ without a matching ] is matched literally.
+func globToRegexp(pattern string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		case '[':
+			body, end, ok := globClass(pattern[i+1:])
+			if !ok {
+				sb.WriteString(regexp.QuoteMeta("["))
+
+				continue
+			}
+
+			sb.WriteString("[" + body + "]")
+
+			i += end + 1
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	return sb.String()
+}
+
+// globClass converts the character class starting after a [ and returns
+// the index of its closing ] in rest. A ] right after the opening [ or ^
+// is part of the class.
+func globClass(rest string) (string, int, bool) {
+	start := 0
+
+	prefix := ""
+	if strings.HasPrefix(rest, "^") {
+		prefix = "^"
+		start = 1
+	}
+
+	closing := strings.IndexByte(rest[min(start+1, len(rest)):], ']')
+	if closing == -1 {
+		return "", 0, false
+	}
+
+	end := min(start+1, len(rest)) + closing
+
+	return prefix + regexp.QuoteMeta(rest[start:end]), end, true
+}
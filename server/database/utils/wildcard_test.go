@@ -252,7 +252,7 @@ func TestBuildSingleWildcardCondition(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			condition, arg := BuildSingleWildcardCondition(tt.field, tt.pattern)
+			condition, arg := BuildSingleWildcardCondition(DialectSQLite, tt.field, tt.pattern)
 
 			if condition != tt.expectedCondition {
 				t.Errorf("BuildSingleWildcardCondition(%q, %q) condition = %q, want %q",
@@ -405,7 +405,7 @@ func TestBuildWildcardCondition(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			condition, args := BuildWildcardCondition(tt.field, tt.patterns)
+			condition, args := BuildWildcardCondition(DialectSQLite, tt.field, tt.patterns)
 
 			if condition != tt.expectedCondition {
 				t.Errorf("BuildWildcardCondition(%q, %v) condition = %q, want %q",
@@ -482,7 +482,7 @@ func TestWildcardIntegration(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			condition, args := BuildWildcardCondition(tt.field, tt.patterns)
+			condition, args := BuildWildcardCondition(DialectSQLite, tt.field, tt.patterns)
 
 			if condition != tt.expectedCondition {
 				t.Errorf("Integration test %q: condition = %q, want %q",
@@ -550,7 +550,7 @@ func TestQuestionMarkWildcardFunctionality(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			condition, args := BuildWildcardCondition(tt.field, tt.patterns)
+			condition, args := BuildWildcardCondition(DialectSQLite, tt.field, tt.patterns)
 
 			if condition != tt.expectedCondition {
 				t.Errorf("%s: condition = %q, want %q", tt.description, condition, tt.expectedCondition)
@@ -640,7 +640,7 @@ func TestListWildcardFunctionality(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			condition, args := BuildWildcardCondition(tt.field, tt.patterns)
+			condition, args := BuildWildcardCondition(DialectSQLite, tt.field, tt.patterns)
 
 			if condition != tt.expectedCondition {
 				t.Errorf("%s: condition = %q, want %q", tt.description, condition, tt.expectedCondition)
@@ -653,7 +653,60 @@ func TestListWildcardFunctionality(t *testing.T) {
 	}
 }
 
+func TestBuildWildcardCondition_Postgres(t *testing.T) {
+	condition, args := BuildWildcardCondition(DialectPostgres, "skills.name", []string{"Natural*", "a.b?", "v[0-9]"})
+
+	if want := "(LOWER(skills.name) ~ ? OR LOWER(skills.name) ~ ? OR LOWER(skills.name) ~ ?)"; condition != want {
+		t.Errorf("BuildWildcardCondition() condition = %q, want %q", condition, want)
+	}
+
+	if want := []interface{}{"^natural.*$", `^a\.b.$`, "^v[0-9]$"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("BuildWildcardCondition() args = %v, want %v", args, want)
+	}
+
+	// Exact matches do not depend on the dialect
+	condition, arg := BuildSingleWildcardCondition(DialectPostgres, "records.name", "Agent")
+	if condition != "LOWER(records.name) = ?" || arg != "agent" {
+		t.Errorf("BuildSingleWildcardCondition() = %q, %q", condition, arg)
+	}
+
+	// GLOB conditions are case-sensitive
+	condition, arg = BuildGlobCondition(DialectPostgres, "annotations.value", "Team-*")
+	if condition != "annotations.value ~ ?" || arg != "^Team-.*$" {
+		t.Errorf("BuildGlobCondition() = %q, %q", condition, arg)
+	}
+}
+
 // Benchmark tests to ensure performance is acceptable.
+func TestMatchWildcard(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		value    string
+		expected bool
+	}{
+		{name: "exact match", pattern: "agent", value: "agent", expected: true},
+		{name: "exact match is case-insensitive", pattern: "Agent", value: "aGENT", expected: true},
+		{name: "exact mismatch", pattern: "agent", value: "agents", expected: false},
+		{name: "asterisk matches slashes", pattern: "example.org/*", value: "example.org/team/agent", expected: true},
+		{name: "asterisk prefix mismatch", pattern: "example.org/*", value: "other.org/agent", expected: false},
+		{name: "question mark", pattern: "agent-?", value: "agent-1", expected: true},
+		{name: "question mark needs a character", pattern: "agent-?", value: "agent-", expected: false},
+		{name: "dot is literal", pattern: "a.c*", value: "abc", expected: false},
+		{name: "character range", pattern: "v[0-9]*", value: "v1.0.0", expected: true},
+		{name: "negated character range", pattern: "v[^0-9]*", value: "v1.0.0", expected: false},
+		{name: "unterminated bracket is literal", pattern: "a[b*", value: "a[bc", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchWildcard(tt.pattern, tt.value); got != tt.expected {
+				t.Errorf("MatchWildcard(%q, %q) = %v, want %v", tt.pattern, tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
 func BenchmarkContainsWildcards(b *testing.B) {
 	patterns := []string{
 		"simple",
@@ -692,6 +745,6 @@ func BenchmarkBuildWildcardCondition(b *testing.B) {
 	b.ResetTimer()
 
 	for range b.N {
-		BuildWildcardCondition(field, patterns)
+		BuildWildcardCondition(DialectSQLite, field, patterns)
 	}
 }
@@ -5,6 +5,7 @@ package events
 
 import (
 	"strconv"
+	"strings"
 
 	eventsv1 "github.com/agntcy/dir/api/events/v1"
 )
@@ -62,6 +63,14 @@ func (eb *EventBuilder) WithMetadataMap(metadata map[string]string) *EventBuilde
 	return eb
 }
 
+// WithActor sets the identity that caused the event and its namespace.
+func (eb *EventBuilder) WithActor(actor, namespace string) *EventBuilder {
+	eb.event.Actor = actor
+	eb.event.Namespace = namespace
+
+	return eb
+}
+
 // Build returns the constructed event.
 // After building, publish it explicitly with bus.Publish(event).
 func (eb *EventBuilder) Build() *Event {
@@ -73,72 +82,139 @@ func (eb *EventBuilder) Build() *Event {
 
 // RecordPushed publishes a record push event.
 func (b *EventBus) RecordPushed(cid string, labels []string) {
-	event := NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, cid).
-		WithLabels(labels).
-		Build()
-	b.Publish(event)
+	b.Publish(newRecordPushedEvent(cid, labels))
 }
 
 // RecordPulled publishes a record pull event.
 func (b *EventBus) RecordPulled(cid string, labels []string) {
-	event := NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_PULLED, cid).
-		WithLabels(labels).
-		Build()
-	b.Publish(event)
+	b.Publish(newRecordPulledEvent(cid, labels))
 }
 
 // RecordDeleted publishes a record delete event.
 func (b *EventBus) RecordDeleted(cid string) {
-	event := NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_DELETED, cid).
-		Build()
-	b.Publish(event)
+	b.Publish(newRecordDeletedEvent(cid))
 }
 
 // RecordPublished publishes a record publish event (announced to network).
 func (b *EventBus) RecordPublished(cid string, labels []string) {
-	event := NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_PUBLISHED, cid).
-		WithLabels(labels).
-		Build()
-	b.Publish(event)
+	b.Publish(newRecordPublishedEvent(cid, labels))
 }
 
 // RecordUnpublished publishes a record unpublish event.
 func (b *EventBus) RecordUnpublished(cid string) {
-	event := NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_UNPUBLISHED, cid).
-		Build()
-	b.Publish(event)
+	b.Publish(newRecordUnpublishedEvent(cid))
 }
 
 // SyncCreated publishes a sync created event.
 func (b *EventBus) SyncCreated(syncID, remoteURL string) {
-	event := NewEventBuilder(eventsv1.EventType_EVENT_TYPE_SYNC_CREATED, syncID).
-		WithMetadata("remote_url", remoteURL).
-		Build()
-	b.Publish(event)
+	b.Publish(newSyncCreatedEvent(syncID, remoteURL))
 }
 
 // SyncCompleted publishes a sync completed event.
 func (b *EventBus) SyncCompleted(syncID, remoteURL string, recordCount int) {
-	event := NewEventBuilder(eventsv1.EventType_EVENT_TYPE_SYNC_COMPLETED, syncID).
+	b.Publish(newSyncCompletedEvent(syncID, remoteURL, recordCount))
+}
+
+// SyncFailed publishes a sync failed event.
+func (b *EventBus) SyncFailed(syncID, remoteURL, errorMsg string) {
+	b.Publish(newSyncFailedEvent(syncID, remoteURL, errorMsg))
+}
+
+// RecordSigned publishes a record signed event.
+func (b *EventBus) RecordSigned(cid, signer string) {
+	b.Publish(newRecordSignedEvent(cid, signer))
+}
+
+// RecordValidationDrift publishes a record validation drift event.
+func (b *EventBus) RecordValidationDrift(cid, schemaVersion, rulesVersion string, validationErrors []string) {
+	b.Publish(newRecordValidationDriftEvent(cid, schemaVersion, rulesVersion, validationErrors))
+}
+
+// RecordFlagged publishes a record flagged event.
+func (b *EventBus) RecordFlagged(cid, verdict string, findings []string) {
+	b.Publish(newRecordFlaggedEvent(cid, verdict, findings))
+}
+
+// WorkerStalled publishes a worker stalled event.
+func (b *EventBus) WorkerStalled(kind, name string, workerID int, lastCheckpoint string) {
+	b.Publish(newWorkerStalledEvent(kind, name, workerID, lastCheckpoint))
+}
+
+// Event constructors shared by the EventBus and SafeEventBus convenience methods.
+
+func newRecordPushedEvent(cid string, labels []string) *Event {
+	return NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, cid).
+		WithLabels(labels).
+		Build()
+}
+
+func newRecordPulledEvent(cid string, labels []string) *Event {
+	return NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_PULLED, cid).
+		WithLabels(labels).
+		Build()
+}
+
+func newRecordDeletedEvent(cid string) *Event {
+	return NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_DELETED, cid).
+		Build()
+}
+
+func newRecordPublishedEvent(cid string, labels []string) *Event {
+	return NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_PUBLISHED, cid).
+		WithLabels(labels).
+		Build()
+}
+
+func newRecordUnpublishedEvent(cid string) *Event {
+	return NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_UNPUBLISHED, cid).
+		Build()
+}
+
+func newSyncCreatedEvent(syncID, remoteURL string) *Event {
+	return NewEventBuilder(eventsv1.EventType_EVENT_TYPE_SYNC_CREATED, syncID).
+		WithMetadata("remote_url", remoteURL).
+		Build()
+}
+
+func newSyncCompletedEvent(syncID, remoteURL string, recordCount int) *Event {
+	return NewEventBuilder(eventsv1.EventType_EVENT_TYPE_SYNC_COMPLETED, syncID).
 		WithMetadata("remote_url", remoteURL).
 		WithMetadata("record_count", strconv.Itoa(recordCount)).
 		Build()
-	b.Publish(event)
 }
 
-// SyncFailed publishes a sync failed event.
-func (b *EventBus) SyncFailed(syncID, remoteURL, errorMsg string) {
-	event := NewEventBuilder(eventsv1.EventType_EVENT_TYPE_SYNC_FAILED, syncID).
+func newSyncFailedEvent(syncID, remoteURL, errorMsg string) *Event {
+	return NewEventBuilder(eventsv1.EventType_EVENT_TYPE_SYNC_FAILED, syncID).
 		WithMetadata("remote_url", remoteURL).
 		WithMetadata("error", errorMsg).
 		Build()
-	b.Publish(event)
 }
 
-// RecordSigned publishes a record signed event.
-func (b *EventBus) RecordSigned(cid, signer string) {
-	event := NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_SIGNED, cid).
+func newRecordSignedEvent(cid, signer string) *Event {
+	return NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_SIGNED, cid).
 		WithMetadata("signer", signer).
 		Build()
-	b.Publish(event)
+}
+
+func newRecordValidationDriftEvent(cid, schemaVersion, rulesVersion string, validationErrors []string) *Event {
+	return NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_VALIDATION_DRIFT, cid).
+		WithMetadata("schema_version", schemaVersion).
+		WithMetadata("rules_version", rulesVersion).
+		WithMetadata("errors", strings.Join(validationErrors, "; ")).
+		Build()
+}
+
+func newRecordFlaggedEvent(cid, verdict string, findings []string) *Event {
+	return NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_FLAGGED, cid).
+		WithMetadata("verdict", verdict).
+		WithMetadata("findings", strings.Join(findings, "; ")).
+		Build()
+}
+
+func newWorkerStalledEvent(kind, name string, workerID int, lastCheckpoint string) *Event {
+	return NewEventBuilder(eventsv1.EventType_EVENT_TYPE_WORKER_STALLED, name).
+		WithMetadata("kind", kind).
+		WithMetadata("worker_id", strconv.Itoa(workerID)).
+		WithMetadata("last_checkpoint", lastCheckpoint).
+		Build()
 }
@@ -331,6 +331,37 @@ func TestRecordSignedConvenience(t *testing.T) {
 	}
 }
 
+func TestRecordValidationDriftConvenience(t *testing.T) {
+	bus := NewEventBus()
+
+	req := &eventsv1.ListenRequest{}
+
+	subID, eventCh := bus.Subscribe(req)
+	defer bus.Unsubscribe(subID)
+
+	bus.RecordValidationDrift(TestCID123, "0.7.0", "v0.0.12", []string{"missing field", "invalid skill"})
+
+	// Wait for async delivery to complete
+	bus.WaitForAsyncPublish()
+
+	select {
+	case event := <-eventCh:
+		if event.Type != eventsv1.EventType_EVENT_TYPE_RECORD_VALIDATION_DRIFT {
+			t.Errorf("Expected RECORD_VALIDATION_DRIFT, got %v", event.Type)
+		}
+
+		if event.Metadata["schema_version"] != "0.7.0" || event.Metadata["rules_version"] != "v0.0.12" {
+			t.Errorf("Expected versions in metadata, got %v", event.Metadata)
+		}
+
+		if event.Metadata["errors"] != "missing field; invalid skill" {
+			t.Errorf("Expected errors in metadata, got %v", event.Metadata)
+		}
+	default:
+		t.Error("Expected to receive event")
+	}
+}
+
 func TestBuilderChaining(t *testing.T) {
 	// Test that chaining returns the builder for fluent API
 	builder := NewEventBuilder(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, "test")
@@ -5,6 +5,7 @@ package events
 
 import (
 	"sync"
+	"time"
 
 	eventsv1 "github.com/agntcy/dir/api/events/v1"
 	"github.com/agntcy/dir/server/events/config"
@@ -30,6 +31,11 @@ type EventBus struct {
 	config      config.Config
 	metrics     Metrics
 	wg          sync.WaitGroup // Tracks in-flight publishAsync goroutines
+
+	historyMu sync.RWMutex
+	history   []*Event // Recent events retained for replay, oldest first
+
+	retention *retention // Retention windows of retained events
 }
 
 // NewEventBus creates a new event bus with default configuration.
@@ -42,6 +48,7 @@ func NewEventBusWithConfig(cfg config.Config) *EventBus {
 	return &EventBus{
 		subscribers: make(map[string]*Subscription),
 		config:      cfg,
+		retention:   newRetention(cfg.Retention),
 	}
 }
 
@@ -74,12 +81,18 @@ func (b *EventBus) Publish(event *Event) {
 		Labels:     append([]string(nil), event.Labels...),
 		Metadata:   make(map[string]string, len(event.Metadata)),
 		Timestamp:  event.Timestamp,
+		Actor:      event.Actor,
+		Namespace:  event.Namespace,
 	}
 
 	for k, v := range event.Metadata {
 		eventCopy.Metadata[k] = v
 	}
 
+	// Retain the event for replay before delivery so that subscribers
+	// replaying history never miss an event that is still in flight.
+	b.retain(eventCopy)
+
 	// Track the async goroutine so Unsubscribe can wait for completion
 	b.wg.Add(1)
 
@@ -206,7 +219,10 @@ func (b *EventBus) Subscribe(req *eventsv1.ListenRequest) (string, <-chan *Event
 		"subscription_id", id,
 		"event_types", req.GetEventTypes(),
 		"label_filters", req.GetLabelFilters(),
-		"cid_filters", req.GetCidFilters())
+		"cid_filters", req.GetCidFilters(),
+		"actor_filters", req.GetActorFilters(),
+		"namespace_filters", req.GetNamespaceFilters(),
+		"filter_expression", req.GetFilterExpression())
 
 	return id, sub.ch
 }
@@ -247,6 +263,51 @@ func (b *EventBus) Unsubscribe(id string) {
 	logger.Info("Subscription removed", "subscription_id", id)
 }
 
+// Replay returns the retained events that occurred at or after since and
+// match the filters of the request, oldest first.
+//
+// Only the most recent events are retained (see config.Config.ReplayBufferSize),
+// and events are not replayed once their retention window has expired.
+// Callers should subscribe before replaying and skip live events that were
+// already replayed, so that no events are missed in between.
+func (b *EventBus) Replay(req *eventsv1.ListenRequest, since time.Time) []*Event {
+	filters := BuildFilters(req)
+
+	now := time.Now()
+
+	b.historyMu.RLock()
+	defer b.historyMu.RUnlock()
+
+	var events []*Event
+
+	for _, event := range b.history {
+		if event.Timestamp.Before(since) || b.retention.expired(event, now) || !Matches(event, filters) {
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	return events
+}
+
+// retain stores the event in the replay history, evicting the oldest
+// event once the history is full.
+func (b *EventBus) retain(event *Event) {
+	if b.config.ReplayBufferSize <= 0 {
+		return
+	}
+
+	b.historyMu.Lock()
+	defer b.historyMu.Unlock()
+
+	if len(b.history) >= b.config.ReplayBufferSize {
+		b.history = append(b.history[:0], b.history[len(b.history)-b.config.ReplayBufferSize+1:]...)
+	}
+
+	b.history = append(b.history, event)
+}
+
 // SubscriberCount returns the current number of active subscribers.
 func (b *EventBus) SubscriberCount() int {
 	b.mu.RLock()
@@ -263,6 +324,7 @@ func (b *EventBus) GetMetrics() MetricsSnapshot {
 		DeliveredTotal:   b.metrics.DeliveredTotal.Load(),
 		DroppedTotal:     b.metrics.DroppedTotal.Load(),
 		SubscribersTotal: b.metrics.SubscribersTotal.Load(),
+		ExpiredTotal:     b.metrics.ExpiredTotal.Load(),
 	}
 }
 
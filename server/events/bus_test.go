@@ -370,3 +370,54 @@ func TestEventBusNoSubscribers(t *testing.T) {
 		t.Errorf("Expected 0 delivered events (no subscribers), got %d", metrics.DeliveredTotal)
 	}
 }
+
+func TestEventBusReplay(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.ReplayBufferSize = 2
+	bus := NewEventBusWithConfig(cfg)
+
+	start := time.Now()
+
+	first := NewEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, TestCID123)
+	first.Timestamp = start.Add(-time.Minute)
+	second := NewEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, TestCID456)
+	third := NewEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUBLISHED, "bafytest789")
+
+	bus.Publish(first)
+	bus.Publish(second)
+	bus.Publish(third)
+	bus.WaitForAsyncPublish()
+
+	// The oldest event is evicted once the history is full
+	replayed := bus.Replay(&eventsv1.ListenRequest{}, start.Add(-time.Hour))
+	if len(replayed) != 2 || replayed[0].ID != second.ID || replayed[1].ID != third.ID {
+		t.Fatalf("Expected the two most recent events, got %v", replayed)
+	}
+
+	// Filters are applied to replayed events
+	replayed = bus.Replay(&eventsv1.ListenRequest{
+		EventTypes: []eventsv1.EventType{eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED},
+	}, start.Add(-time.Hour))
+	if len(replayed) != 1 || replayed[0].ID != second.ID {
+		t.Errorf("Expected only the pushed event, got %v", replayed)
+	}
+
+	// Events before the start time are not replayed
+	replayed = bus.Replay(&eventsv1.ListenRequest{}, time.Now().Add(time.Minute))
+	if len(replayed) != 0 {
+		t.Errorf("Expected no events, got %v", replayed)
+	}
+}
+
+func TestEventBusReplayDisabled(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.ReplayBufferSize = 0
+	bus := NewEventBusWithConfig(cfg)
+
+	bus.Publish(NewEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, TestCID123))
+	bus.WaitForAsyncPublish()
+
+	if replayed := bus.Replay(&eventsv1.ListenRequest{}, time.Time{}); len(replayed) != 0 {
+		t.Errorf("Expected no events when replay is disabled, got %v", replayed)
+	}
+}
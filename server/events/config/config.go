@@ -3,6 +3,15 @@
 
 package config
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+)
+
 const (
 	// DefaultSubscriberBufferSize is the default channel buffer size per subscriber.
 	DefaultSubscriberBufferSize = 100
@@ -12,6 +21,12 @@ const (
 
 	// DefaultLogPublishedEvents is the default setting for logging all published events.
 	DefaultLogPublishedEvents = false
+
+	// DefaultReplayBufferSize is the default number of recent events retained for replay.
+	DefaultReplayBufferSize = 1000
+
+	// DefaultRetentionCleanupInterval is the default interval between removals of expired events.
+	DefaultRetentionCleanupInterval = time.Minute
 )
 
 // Config holds event system configuration.
@@ -20,17 +35,94 @@ type Config struct {
 	// Larger buffers allow subscribers to fall behind temporarily without
 	// dropping events, but use more memory.
 	// Default: 100
-	SubscriberBufferSize int
+	SubscriberBufferSize int `json:"subscriber_buffer_size,omitempty" mapstructure:"subscriber_buffer_size"`
 
 	// LogSlowConsumers enables logging when events are dropped due to
 	// full subscriber buffers (slow consumers).
 	// Default: true
-	LogSlowConsumers bool
+	LogSlowConsumers bool `json:"log_slow_consumers,omitempty" mapstructure:"log_slow_consumers"`
 
 	// LogPublishedEvents enables debug logging of all published events.
 	// This can be very verbose in production.
 	// Default: false
-	LogPublishedEvents bool
+	LogPublishedEvents bool `json:"log_published_events,omitempty" mapstructure:"log_published_events"`
+
+	// ReplayBufferSize is the number of recent events retained in memory
+	// so that subscribers can replay events missed while disconnected.
+	// Set to 0 to disable replay.
+	// Default: 1000
+	ReplayBufferSize int `json:"replay_buffer_size,omitempty" mapstructure:"replay_buffer_size"`
+
+	// Retention configures how long retained events are kept per event type.
+	Retention RetentionConfig `json:"retention,omitempty" mapstructure:"retention"`
+}
+
+// RetentionConfig holds the retention windows of retained events.
+// Events are kept until their retention window expires or they are evicted
+// from the replay buffer, whichever comes first.
+type RetentionConfig struct {
+	// Default is the retention window of event types without their own window.
+	// Zero keeps events until they are evicted from the replay buffer.
+	// Default: 0
+	Default time.Duration `json:"default,omitempty" mapstructure:"default"`
+
+	// Types maps event types to their retention window, e.g.
+	// RECORD_DELETED: 2160h and RECORD_PULLED: 24h.
+	// Event types are case-insensitive and the EVENT_TYPE_ prefix is optional.
+	// Zero keeps events of the type until they are evicted from the replay buffer.
+	Types map[string]time.Duration `json:"types,omitempty" mapstructure:"types"`
+
+	// CleanupInterval is the interval at which expired events are removed.
+	// Default: 1m
+	CleanupInterval time.Duration `json:"cleanup_interval,omitempty" mapstructure:"cleanup_interval"`
+}
+
+// Enabled reports whether any retention window is configured.
+func (c RetentionConfig) Enabled() bool {
+	if c.Default > 0 {
+		return true
+	}
+
+	for _, window := range c.Types {
+		if window > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Windows returns the retention windows of the configured event types.
+func (c RetentionConfig) Windows() (map[eventsv1.EventType]time.Duration, error) {
+	if c.Default < 0 {
+		return nil, errors.New("default retention window must not be negative")
+	}
+
+	windows := make(map[eventsv1.EventType]time.Duration, len(c.Types))
+
+	for name, window := range c.Types {
+		eventType, ok := eventsv1.EventType_value["EVENT_TYPE_"+strings.TrimPrefix(strings.ToUpper(name), "EVENT_TYPE_")]
+		if !ok || eventType == int32(eventsv1.EventType_EVENT_TYPE_UNSPECIFIED) {
+			return nil, fmt.Errorf("unknown event type %q in retention config", name)
+		}
+
+		if window < 0 {
+			return nil, fmt.Errorf("retention window of %s must not be negative", name)
+		}
+
+		windows[eventsv1.EventType(eventType)] = window
+	}
+
+	return windows, nil
+}
+
+// Validate checks the event system configuration.
+func (c *Config) Validate() error {
+	if _, err := c.Retention.Windows(); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // DefaultConfig returns the default event system configuration.
@@ -39,5 +131,9 @@ func DefaultConfig() Config {
 		SubscriberBufferSize: DefaultSubscriberBufferSize,
 		LogSlowConsumers:     DefaultLogSlowConsumers,
 		LogPublishedEvents:   DefaultLogPublishedEvents,
+		ReplayBufferSize:     DefaultReplayBufferSize,
+		Retention: RetentionConfig{
+			CleanupInterval: DefaultRetentionCleanupInterval,
+		},
 	}
 }
@@ -4,6 +4,9 @@
 package events
 
 import (
+	"sync"
+	"time"
+
 	"github.com/agntcy/dir/server/events/config"
 )
 
@@ -13,6 +16,11 @@ import (
 type Service struct {
 	bus    *EventBus
 	config config.Config
+
+	// Retention cleanup worker lifecycle
+	stopCleanup chan struct{}
+	cleanupDone chan struct{}
+	stopOnce    sync.Once
 }
 
 // New creates a new event service with default configuration.
@@ -32,7 +40,8 @@ func New() *Service {
 	logger.Info("Initializing event service",
 		"subscriber_buffer_size", cfg.SubscriberBufferSize,
 		"log_slow_consumers", cfg.LogSlowConsumers,
-		"log_published_events", cfg.LogPublishedEvents)
+		"log_published_events", cfg.LogPublishedEvents,
+		"replay_buffer_size", cfg.ReplayBufferSize)
 
 	return &Service{
 		bus:    NewEventBusWithConfig(cfg),
@@ -41,16 +50,26 @@ func New() *Service {
 }
 
 // NewWithConfig creates a new event service with custom configuration.
+// If retention windows are configured, a cleanup worker periodically removes
+// expired events from the replay buffer until the service is stopped.
 func NewWithConfig(cfg config.Config) *Service {
 	logger.Info("Initializing event service with custom config",
 		"subscriber_buffer_size", cfg.SubscriberBufferSize,
 		"log_slow_consumers", cfg.LogSlowConsumers,
-		"log_published_events", cfg.LogPublishedEvents)
+		"log_published_events", cfg.LogPublishedEvents,
+		"replay_buffer_size", cfg.ReplayBufferSize,
+		"retention", cfg.Retention)
 
-	return &Service{
+	s := &Service{
 		bus:    NewEventBusWithConfig(cfg),
 		config: cfg,
 	}
+
+	if cfg.ReplayBufferSize > 0 && cfg.Retention.Enabled() {
+		s.startCleanup()
+	}
+
+	return s
 }
 
 // Bus returns the event bus for publishing events.
@@ -59,18 +78,69 @@ func (s *Service) Bus() *EventBus {
 	return s.bus
 }
 
+// startCleanup starts the worker removing expired events from the replay buffer.
+func (s *Service) startCleanup() {
+	interval := s.config.Retention.CleanupInterval
+	if interval <= 0 {
+		interval = config.DefaultRetentionCleanupInterval
+	}
+
+	s.stopCleanup = make(chan struct{})
+	s.cleanupDone = make(chan struct{})
+
+	go func() {
+		defer close(s.cleanupDone)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.stopCleanup:
+				return
+			case now := <-ticker.C:
+				s.cleanup(now)
+			}
+		}
+	}()
+}
+
+// cleanup removes expired events from the replay buffer.
+func (s *Service) cleanup(now time.Time) {
+	expired := s.bus.ExpireRetained(now)
+	if len(expired) == 0 {
+		return
+	}
+
+	for eventType, count := range expired {
+		logger.Debug("Removed expired events", "type", eventType, "count", count)
+	}
+
+	logger.Info("Event retention cleanup completed",
+		"retained", s.bus.RetainedCount(),
+		"total_expired", s.bus.GetMetrics().ExpiredTotal)
+}
+
 // Stop gracefully shuts down the event service.
 // This closes all active subscriptions and prevents new ones.
 func (s *Service) Stop() error {
 	logger.Info("Stopping event service",
 		"active_subscribers", s.bus.SubscriberCount())
 
+	s.stopOnce.Do(func() {
+		if s.stopCleanup != nil {
+			close(s.stopCleanup)
+			<-s.cleanupDone
+		}
+	})
+
 	// Get final metrics
 	metrics := s.bus.GetMetrics()
 	logger.Info("Event service stopped",
 		"total_published", metrics.PublishedTotal,
 		"total_delivered", metrics.DeliveredTotal,
-		"total_dropped", metrics.DroppedTotal)
+		"total_dropped", metrics.DroppedTotal,
+		"total_expired", metrics.ExpiredTotal)
 
 	// Note: We don't close subscriptions here because:
 	// 1. They are managed by the controller (gRPC stream lifecycle)
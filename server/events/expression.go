@@ -0,0 +1,908 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// maxExpressionLength limits the size of filter expressions sent by clients.
+const maxExpressionLength = 4096
+
+// ExpressionFilter creates a filter from a filter expression.
+// Returns an error if the expression is not valid.
+//
+// Filter expressions use a subset of the Common Expression Language (CEL).
+// The event is available as the event variable with the fields id, type
+// (e.g. 'RECORD_PUSHED'), resource_id, labels, metadata, actor and namespace.
+//
+// The following constructs are supported:
+//   - string, integer, boolean and list literals
+//   - field selection (event.type) and indexing (event.metadata['team'], event.labels[0])
+//   - the operators ==, !=, <, <=, >, >=, in, &&, || and !
+//   - size(x) and x.size() for strings, lists and maps
+//   - the string methods contains, startsWith, endsWith and matches (RE2)
+//   - the list macros exists(x, predicate) and all(x, predicate)
+//
+// As in CEL, evaluation errors such as accessing a missing metadata key
+// make the expression fail, in which case the event does not match.
+//
+// Example:
+//
+//	filter, err := ExpressionFilter(`event.type == 'RECORD_PUSHED' && event.metadata['team'] == 'ml'`)
+func ExpressionFilter(expression string) (Filter, error) {
+	if len(expression) > maxExpressionLength {
+		return nil, fmt.Errorf("filter expression exceeds %d characters", maxExpressionLength)
+	}
+
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter expression: %w", err)
+	}
+
+	p := &exprParser{tokens: tokens, scope: []string{"event"}}
+
+	root, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter expression: %w", err)
+	}
+
+	return func(e *Event) bool {
+		value, err := root.eval(map[string]any{"event": eventActivation(e)})
+		if err != nil {
+			return false
+		}
+
+		matched, ok := value.(bool)
+
+		return ok && matched
+	}, nil
+}
+
+// eventFields are the fields of the event variable available in expressions.
+var eventFields = []string{"id", "type", "resource_id", "labels", "metadata", "actor", "namespace"}
+
+// eventActivation converts the event to the value of the event variable.
+func eventActivation(e *Event) map[string]any {
+	labels := make([]any, 0, len(e.Labels))
+	for _, label := range e.Labels {
+		labels = append(labels, label)
+	}
+
+	metadata := make(map[string]any, len(e.Metadata))
+	for k, v := range e.Metadata {
+		metadata[k] = v
+	}
+
+	return map[string]any{
+		"id":          e.ID,
+		"type":        strings.TrimPrefix(e.Type.String(), "EVENT_TYPE_"),
+		"resource_id": e.ResourceID,
+		"labels":      labels,
+		"metadata":    metadata,
+		"actor":       e.Actor,
+		"namespace":   e.Namespace,
+	}
+}
+
+//
+// Lexer
+//
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenInt
+	tokenOperator
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value any
+	pos   int
+}
+
+// operators are the punctuation tokens, longest first.
+var operators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", "[", "]", ",", "."}
+
+func tokenize(input string) ([]token, error) {
+	var tokens []token
+
+	for pos := 0; pos < len(input); {
+		c := rune(input[pos])
+
+		switch {
+		case unicode.IsSpace(c):
+			pos++
+
+		case c == '_' || unicode.IsLetter(c):
+			start := pos
+			for pos < len(input) && (input[pos] == '_' || unicode.IsLetter(rune(input[pos])) || unicode.IsDigit(rune(input[pos]))) {
+				pos++
+			}
+
+			tokens = append(tokens, token{kind: tokenIdent, text: input[start:pos], pos: start})
+
+		case unicode.IsDigit(c):
+			start := pos
+			for pos < len(input) && unicode.IsDigit(rune(input[pos])) {
+				pos++
+			}
+
+			value, err := strconv.ParseInt(input[start:pos], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid integer at position %d", start)
+			}
+
+			tokens = append(tokens, token{kind: tokenInt, text: input[start:pos], value: value, pos: start})
+
+		case c == '\'' || c == '"':
+			value, end, err := readString(input, pos)
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, token{kind: tokenString, text: input[pos:end], value: value, pos: pos})
+			pos = end
+
+		default:
+			op := ""
+
+			for _, candidate := range operators {
+				if strings.HasPrefix(input[pos:], candidate) {
+					op = candidate
+
+					break
+				}
+			}
+
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, pos)
+			}
+
+			tokens = append(tokens, token{kind: tokenOperator, text: op, pos: pos})
+			pos += len(op)
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(input)}), nil
+}
+
+// readString reads the quoted string starting at pos and returns its value
+// and the position after the closing quote.
+func readString(input string, pos int) (string, int, error) {
+	quote := input[pos]
+
+	var sb strings.Builder
+
+	for i := pos + 1; i < len(input); i++ {
+		switch input[i] {
+		case quote:
+			return sb.String(), i + 1, nil
+		case '\\':
+			if i+1 >= len(input) {
+				return "", 0, fmt.Errorf("unterminated string at position %d", pos)
+			}
+
+			i++
+
+			switch input[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case '\\', '\'', '"':
+				sb.WriteByte(input[i])
+			default:
+				return "", 0, fmt.Errorf("invalid escape sequence at position %d", i-1)
+			}
+		default:
+			sb.WriteByte(input[i])
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated string at position %d", pos)
+}
+
+//
+// Parser
+//
+
+// exprNode is a node of a parsed expression.
+type exprNode interface {
+	eval(vars map[string]any) (any, error)
+}
+
+type exprParser struct {
+	tokens []token
+	pos    int
+	scope  []string // Variables in scope, including macro variables
+}
+
+func (p *exprParser) parse() (exprNode, error) {
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+	}
+
+	return node, nil
+}
+
+func (p *exprParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+
+	return tok
+}
+
+// accept consumes the next token if it is the given operator or keyword.
+func (p *exprParser) accept(text string) bool {
+	tok := p.peek()
+	if (tok.kind == tokenOperator || tok.kind == tokenIdent) && tok.text == text {
+		p.pos++
+
+		return true
+	}
+
+	return false
+}
+
+func (p *exprParser) expect(text string) error {
+	if !p.accept(text) {
+		tok := p.peek()
+		if tok.kind == tokenEOF {
+			return fmt.Errorf("expected %q at end of expression", text)
+		}
+
+		return fmt.Errorf("expected %q at position %d, got %q", text, tok.pos, tok.text)
+	}
+
+	return nil
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &logicalNode{or: true, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	left, err := p.parseRelation()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("&&") {
+		right, err := p.parseRelation()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &logicalNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseRelation() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">", "in"} {
+		if p.accept(op) {
+			right, err := p.parseUnary()
+			if err != nil {
+				return nil, err
+			}
+
+			return &relationNode{op: op, left: left, right: right}, nil
+		}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.accept("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &notNode{operand: operand}, nil
+	}
+
+	return p.parseMember()
+}
+
+func (p *exprParser) parseMember() (exprNode, error) {
+	node, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch {
+		case p.accept("."):
+			name := p.next()
+			if name.kind != tokenIdent {
+				return nil, fmt.Errorf("expected field or method name at position %d", name.pos)
+			}
+
+			if p.peek().text == "(" {
+				node, err = p.parseMethod(node, name)
+			} else {
+				node, err = p.parseField(node, name)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+		case p.accept("["):
+			index, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+
+			node = &indexNode{target: node, index: index}
+
+		default:
+			return node, nil
+		}
+	}
+}
+
+func (p *exprParser) parseField(target exprNode, name token) (exprNode, error) {
+	// Fields of the event variable are known, so typos are reported early
+	if ident, ok := target.(*identNode); ok && ident.name == "event" && !slices.Contains(eventFields, name.text) {
+		return nil, fmt.Errorf("unknown event field %q at position %d (available: %s)",
+			name.text, name.pos, strings.Join(eventFields, ", "))
+	}
+
+	return &indexNode{target: target, index: &literalNode{value: name.text}}, nil
+}
+
+func (p *exprParser) parseMethod(target exprNode, name token) (exprNode, error) {
+	_ = p.next() // (
+
+	switch name.text {
+	case "exists", "all":
+		variable := p.next()
+		if variable.kind != tokenIdent {
+			return nil, fmt.Errorf("expected variable name at position %d", variable.pos)
+		}
+
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+
+		p.scope = append(p.scope, variable.text)
+
+		predicate, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		p.scope = p.scope[:len(p.scope)-1]
+
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		return &macroNode{all: name.text == "all", target: target, variable: variable.text, predicate: predicate}, nil
+
+	case "size":
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		return &sizeNode{operand: target}, nil
+
+	case "contains", "startsWith", "endsWith", "matches":
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		node := &stringMethodNode{method: name.text, target: target, arg: arg}
+
+		// Compile constant patterns once
+		if literal, ok := arg.(*literalNode); ok && name.text == "matches" {
+			pattern, ok := literal.value.(string)
+			if !ok {
+				return nil, fmt.Errorf("matches expects a string pattern at position %d", name.pos)
+			}
+
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern at position %d: %w", name.pos, err)
+			}
+
+			node.re = re
+		}
+
+		return node, nil
+
+	default:
+		return nil, fmt.Errorf("unknown method %q at position %d", name.text, name.pos)
+	}
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokenString, tokenInt:
+		return &literalNode{value: tok.value}, nil
+
+	case tokenIdent:
+		switch tok.text {
+		case "true", "false":
+			return &literalNode{value: tok.text == "true"}, nil
+		case "size":
+			if err := p.expect("("); err != nil {
+				return nil, err
+			}
+
+			operand, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+
+			return &sizeNode{operand: operand}, nil
+		}
+
+		if !slices.Contains(p.scope, tok.text) {
+			return nil, fmt.Errorf("unknown variable %q at position %d", tok.text, tok.pos)
+		}
+
+		return &identNode{name: tok.text}, nil
+
+	case tokenOperator:
+		switch tok.text {
+		case "(":
+			node, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+
+			return node, nil
+
+		case "[":
+			var elements []exprNode
+
+			for !p.accept("]") {
+				if len(elements) > 0 {
+					if err := p.expect(","); err != nil {
+						return nil, err
+					}
+				}
+
+				element, err := p.parseOr()
+				if err != nil {
+					return nil, err
+				}
+
+				elements = append(elements, element)
+			}
+
+			return &listNode{elements: elements}, nil
+		}
+
+	case tokenEOF:
+		return nil, errors.New("unexpected end of expression")
+	}
+
+	return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+}
+
+//
+// Evaluation
+//
+
+type literalNode struct {
+	value any
+}
+
+func (n *literalNode) eval(map[string]any) (any, error) {
+	return n.value, nil
+}
+
+type identNode struct {
+	name string
+}
+
+func (n *identNode) eval(vars map[string]any) (any, error) {
+	return vars[n.name], nil
+}
+
+type listNode struct {
+	elements []exprNode
+}
+
+func (n *listNode) eval(vars map[string]any) (any, error) {
+	list := make([]any, 0, len(n.elements))
+
+	for _, element := range n.elements {
+		value, err := element.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, value)
+	}
+
+	return list, nil
+}
+
+type indexNode struct {
+	target exprNode
+	index  exprNode
+}
+
+func (n *indexNode) eval(vars map[string]any) (any, error) {
+	target, err := n.target.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := n.index.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	switch target := target.(type) {
+	case map[string]any:
+		key, ok := index.(string)
+		if !ok {
+			return nil, errors.New("map key must be a string")
+		}
+
+		value, ok := target[key]
+		if !ok {
+			return nil, fmt.Errorf("no such key: %s", key)
+		}
+
+		return value, nil
+
+	case []any:
+		i, ok := index.(int64)
+		if !ok {
+			return nil, errors.New("list index must be an integer")
+		}
+
+		if i < 0 || i >= int64(len(target)) {
+			return nil, fmt.Errorf("index out of range: %d", i)
+		}
+
+		return target[i], nil
+	}
+
+	return nil, errors.New("value does not support indexing")
+}
+
+type logicalNode struct {
+	or    bool
+	left  exprNode
+	right exprNode
+}
+
+// eval follows the CEL semantics where a decisive operand wins over an error,
+// e.g. false && error is false and true || error is true.
+func (n *logicalNode) eval(vars map[string]any) (any, error) {
+	left, leftErr := evalBool(n.left, vars)
+	if leftErr == nil && left == n.or {
+		return n.or, nil
+	}
+
+	right, rightErr := evalBool(n.right, vars)
+	if rightErr == nil && right == n.or {
+		return n.or, nil
+	}
+
+	if leftErr != nil {
+		return nil, leftErr
+	}
+
+	if rightErr != nil {
+		return nil, rightErr
+	}
+
+	return !n.or, nil
+}
+
+type notNode struct {
+	operand exprNode
+}
+
+func (n *notNode) eval(vars map[string]any) (any, error) {
+	value, err := evalBool(n.operand, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return !value, nil
+}
+
+type relationNode struct {
+	op    string
+	left  exprNode
+	right exprNode
+}
+
+func (n *relationNode) eval(vars map[string]any) (any, error) {
+	left, err := n.left.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := n.right.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "in":
+		switch right := right.(type) {
+		case []any:
+			return slices.ContainsFunc(right, func(element any) bool { return equal(left, element) }), nil
+		case map[string]any:
+			key, ok := left.(string)
+			if !ok {
+				return false, nil
+			}
+
+			_, found := right[key]
+
+			return found, nil
+		}
+
+		return nil, errors.New("in requires a list or map")
+	}
+
+	order, err := compare(left, right)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "<":
+		return order < 0, nil
+	case "<=":
+		return order <= 0, nil
+	case ">":
+		return order > 0, nil
+	default:
+		return order >= 0, nil
+	}
+}
+
+type sizeNode struct {
+	operand exprNode
+}
+
+func (n *sizeNode) eval(vars map[string]any) (any, error) {
+	value, err := n.operand.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	switch value := value.(type) {
+	case string:
+		return int64(len([]rune(value))), nil
+	case []any:
+		return int64(len(value)), nil
+	case map[string]any:
+		return int64(len(value)), nil
+	}
+
+	return nil, errors.New("size requires a string, list or map")
+}
+
+type stringMethodNode struct {
+	method string
+	target exprNode
+	arg    exprNode
+	re     *regexp.Regexp
+}
+
+func (n *stringMethodNode) eval(vars map[string]any) (any, error) {
+	target, err := evalString(n.target, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	arg, err := evalString(n.arg, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.method {
+	case "contains":
+		return strings.Contains(target, arg), nil
+	case "startsWith":
+		return strings.HasPrefix(target, arg), nil
+	case "endsWith":
+		return strings.HasSuffix(target, arg), nil
+	}
+
+	re := n.re
+	if re == nil {
+		if re, err = regexp.Compile(arg); err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+	}
+
+	return re.MatchString(target), nil
+}
+
+type macroNode struct {
+	all       bool
+	target    exprNode
+	variable  string
+	predicate exprNode
+}
+
+func (n *macroNode) eval(vars map[string]any) (any, error) {
+	target, err := n.target.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	var elements []any
+
+	switch target := target.(type) {
+	case []any:
+		elements = target
+	case map[string]any:
+		for key := range target {
+			elements = append(elements, key)
+		}
+	default:
+		return nil, errors.New("exists and all require a list or map")
+	}
+
+	scoped := make(map[string]any, len(vars)+1)
+	for k, v := range vars {
+		scoped[k] = v
+	}
+
+	var firstErr error
+
+	for _, element := range elements {
+		scoped[n.variable] = element
+
+		matched, err := evalBool(n.predicate, scoped)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+
+			continue
+		}
+
+		// A decisive result wins over errors of other elements
+		if matched != n.all {
+			return !n.all, nil
+		}
+	}
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return n.all, nil
+}
+
+func evalBool(node exprNode, vars map[string]any) (bool, error) {
+	value, err := node.eval(vars)
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := value.(bool)
+	if !ok {
+		return false, errors.New("expected a boolean value")
+	}
+
+	return b, nil
+}
+
+func evalString(node exprNode, vars map[string]any) (string, error) {
+	value, err := node.eval(vars)
+	if err != nil {
+		return "", err
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", errors.New("expected a string value")
+	}
+
+	return s, nil
+}
+
+func equal(left, right any) bool {
+	switch left := left.(type) {
+	case string, int64, bool:
+		return left == right
+	case []any:
+		right, ok := right.([]any)
+
+		return ok && slices.EqualFunc(left, right, equal)
+	}
+
+	return false
+}
+
+func compare(left, right any) (int, error) {
+	switch left := left.(type) {
+	case int64:
+		if right, ok := right.(int64); ok {
+			return cmp.Compare(left, right), nil
+		}
+	case string:
+		if right, ok := right.(string); ok {
+			return strings.Compare(left, right), nil
+		}
+	}
+
+	return 0, errors.New("values cannot be compared")
+}
@@ -0,0 +1,115 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"strings"
+	"testing"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+)
+
+func TestExpressionFilter(t *testing.T) {
+	event := NewEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, TestCID123)
+	event.Labels = []string{"AI", "/skills/natural_language_processing/summarization"}
+	event.Metadata = map[string]string{"team": "ml"}
+	event.Actor = "spiffe://example.org/agent"
+	event.Namespace = "example.org"
+
+	tests := []struct {
+		expression string
+		want       bool
+	}{
+		{`event.type == 'RECORD_PUSHED' && 'AI' in event.labels && event.metadata['team'] == 'ml'`, true},
+		{`event.type == "RECORD_PUBLISHED"`, false},
+		{`event.type in ['RECORD_PUBLISHED', 'RECORD_PUSHED']`, true},
+		{`event.resource_id == 'bafytest123' && event.namespace != 'other.org'`, true},
+		{`!(event.actor.startsWith('spiffe://example.org/'))`, false},
+		{`event.labels.exists(l, l.startsWith('/skills/natural_language_processing'))`, true},
+		{`event.labels.all(l, l.contains('AI'))`, false},
+		{`size(event.labels) == 2 && event.metadata.size() >= 1`, true},
+		{`event.resource_id.matches('^bafy[a-z0-9]+$')`, true},
+		{`'team' in event.metadata && event.labels[0] == 'AI'`, true},
+		// Missing keys are evaluation errors and do not match...
+		{`event.metadata['owner'] == 'ml'`, false},
+		// ...unless the result is decided by another operand
+		{`event.metadata['owner'] == 'ml' || event.type == 'RECORD_PUSHED'`, true},
+		{`event.type == 'RECORD_DELETED' && event.metadata['owner'] == 'ml'`, false},
+		// Non-boolean results do not match
+		{`event.type`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			filter, err := ExpressionFilter(tt.expression)
+			if err != nil {
+				t.Fatalf("ExpressionFilter() error = %v", err)
+			}
+
+			if got := filter(event); got != tt.want {
+				t.Errorf("filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpressionFilterInvalid(t *testing.T) {
+	tests := []struct {
+		expression string
+		wantErr    string
+	}{
+		{`event.typ == 'RECORD_PUSHED'`, "unknown event field"},
+		{`record.type == 'RECORD_PUSHED'`, "unknown variable"},
+		{`event.type == 'RECORD_PUSHED`, "unterminated string"},
+		{`event.type ==`, "unexpected end of expression"},
+		{`(event.type == 'RECORD_PUSHED'`, "expected \")\""},
+		{`event.type == 'RECORD_PUSHED' event.actor`, "unexpected"},
+		{`event.labels.first()`, "unknown method"},
+		{`event.resource_id.matches('[')`, "invalid pattern"},
+		{`event.type # 1`, "unexpected character"},
+		{strings.Repeat("a", maxExpressionLength+1), "exceeds"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			_, err := ExpressionFilter(tt.expression)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ExpressionFilter() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuildFiltersWithExpression(t *testing.T) {
+	pushed := NewEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, TestCID123)
+	pushed.Metadata["team"] = "ml"
+
+	req := &eventsv1.ListenRequest{
+		EventTypes:       []eventsv1.EventType{eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED},
+		FilterExpression: `event.metadata['team'] == 'ml'`,
+	}
+
+	if err := ValidateListenRequest(req); err != nil {
+		t.Fatalf("ValidateListenRequest() error = %v", err)
+	}
+
+	if !Matches(pushed, BuildFilters(req)) {
+		t.Error("Expected event to match type filter and expression")
+	}
+
+	pushed.Metadata["team"] = "web"
+	if Matches(pushed, BuildFilters(req)) {
+		t.Error("Expected event not to match expression")
+	}
+
+	// Invalid expressions are rejected and match no events
+	req.FilterExpression = `event.team == 'ml'`
+	if err := ValidateListenRequest(req); err == nil {
+		t.Error("Expected ValidateListenRequest() to reject invalid expression")
+	}
+
+	if Matches(pushed, BuildFilters(req)) {
+		t.Error("Expected invalid expression to match no events")
+	}
+}
@@ -17,6 +17,8 @@ type Filter func(*Event) bool
 // These filters are applied when determining which events to deliver to a subscriber.
 //
 // If no filters are specified in the request, returns an empty slice (matches all events).
+// An invalid filter expression matches no events; use ValidateListenRequest
+// to reject such requests beforehand.
 func BuildFilters(req *eventsv1.ListenRequest) []Filter {
 	var filters []Filter
 
@@ -32,9 +34,39 @@ func BuildFilters(req *eventsv1.ListenRequest) []Filter {
 		filters = append(filters, LabelFilter(req.GetLabelFilters()...))
 	}
 
+	if len(req.GetActorFilters()) > 0 {
+		filters = append(filters, ActorFilter(req.GetActorFilters()...))
+	}
+
+	if len(req.GetNamespaceFilters()) > 0 {
+		filters = append(filters, NamespaceFilter(req.GetNamespaceFilters()...))
+	}
+
+	if req.GetFilterExpression() != "" {
+		filter, err := ExpressionFilter(req.GetFilterExpression())
+		if err != nil {
+			logger.Warn("Invalid filter expression", "error", err)
+
+			filter = func(*Event) bool { return false }
+		}
+
+		filters = append(filters, filter)
+	}
+
 	return filters
 }
 
+// ValidateListenRequest checks that the filters of the request are valid.
+func ValidateListenRequest(req *eventsv1.ListenRequest) error {
+	if req.GetFilterExpression() == "" {
+		return nil
+	}
+
+	_, err := ExpressionFilter(req.GetFilterExpression())
+
+	return err
+}
+
 // Matches checks if an event passes all the given filters.
 // Returns true if all filters pass (AND logic), false otherwise.
 // If filters slice is empty, returns true (matches everything).
@@ -109,6 +141,44 @@ func LabelFilter(labelFilters ...string) Filter {
 	}
 }
 
+// ActorFilter creates a filter that matches events caused by any of the specified identities.
+// Returns true if the event's actor matches any of the provided SPIFFE IDs (OR logic).
+// Events without an actor never match.
+//
+// Example:
+//
+//	filter := ActorFilter("spiffe://example.org/agent")
+func ActorFilter(actors ...string) Filter {
+	return func(e *Event) bool {
+		for _, actor := range actors {
+			if e.Actor != "" && e.Actor == actor {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// NamespaceFilter creates a filter that matches events caused by identities in any of the specified namespaces.
+// Returns true if the event's namespace matches any of the provided trust domains (OR logic).
+// Events without a namespace never match.
+//
+// Example:
+//
+//	filter := NamespaceFilter("example.org")
+func NamespaceFilter(namespaces ...string) Filter {
+	return func(e *Event) bool {
+		for _, namespace := range namespaces {
+			if e.Namespace != "" && e.Namespace == namespace {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
 // Or combines multiple filters with OR logic.
 // Returns true if ANY of the filters matches (short-circuits on first match).
 //
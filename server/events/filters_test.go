@@ -95,6 +95,95 @@ func TestCIDFilter(t *testing.T) {
 	}
 }
 
+func TestActorFilter(t *testing.T) {
+	filter := ActorFilter("spiffe://example.org/agent")
+
+	tests := []struct {
+		name  string
+		actor string
+		want  bool
+	}{
+		{
+			name:  "matches actor",
+			actor: "spiffe://example.org/agent",
+			want:  true,
+		},
+		{
+			name:  "does not match other actor",
+			actor: "spiffe://example.org/other",
+			want:  false,
+		},
+		{
+			name:  "does not match event without actor",
+			actor: "",
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := &Event{
+				ID:         TestEventID,
+				Type:       eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED,
+				Timestamp:  time.Now(),
+				ResourceID: TestCID123,
+				Actor:      tt.actor,
+			}
+
+			if got := filter(event); got != tt.want {
+				t.Errorf("ActorFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNamespaceFilter(t *testing.T) {
+	filter := NamespaceFilter("example.org", "other.org")
+
+	tests := []struct {
+		name      string
+		namespace string
+		want      bool
+	}{
+		{
+			name:      "matches first namespace",
+			namespace: "example.org",
+			want:      true,
+		},
+		{
+			name:      "matches second namespace",
+			namespace: "other.org",
+			want:      true,
+		},
+		{
+			name:      "does not match other namespace",
+			namespace: "third.org",
+			want:      false,
+		},
+		{
+			name:      "does not match event without namespace",
+			namespace: "",
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := &Event{
+				ID:         TestEventID,
+				Type:       eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED,
+				Timestamp:  time.Now(),
+				ResourceID: TestCID123,
+				Namespace:  tt.namespace,
+			}
+
+			if got := filter(event); got != tt.want {
+				t.Errorf("NamespaceFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestLabelFilter(t *testing.T) {
 	filter := LabelFilter("/skills/AI", "/domains/research")
 
@@ -315,11 +404,13 @@ func TestBuildFilters(t *testing.T) {
 		{
 			name: "all filters specified",
 			req: &eventsv1.ListenRequest{
-				EventTypes:   []eventsv1.EventType{eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED},
-				CidFilters:   []string{"bafytest123"},
-				LabelFilters: []string{"/skills/AI"},
+				EventTypes:       []eventsv1.EventType{eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED},
+				CidFilters:       []string{"bafytest123"},
+				LabelFilters:     []string{"/skills/AI"},
+				ActorFilters:     []string{"spiffe://example.org/agent"},
+				NamespaceFilters: []string{"example.org"},
 			},
-			wantLen: 3,
+			wantLen: 5,
 		},
 		{
 			name: "only event type filter",
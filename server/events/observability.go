@@ -20,6 +20,9 @@ type Metrics struct {
 	// SubscribersTotal is the current number of active subscribers
 	// This can be negative temporarily during concurrent operations, but will stabilize
 	SubscribersTotal atomic.Int64
+
+	// ExpiredTotal is the total number of retained events removed after their retention window expired
+	ExpiredTotal atomic.Uint64
 }
 
 // MetricsSnapshot is a point-in-time snapshot of metrics values.
@@ -29,4 +32,5 @@ type MetricsSnapshot struct {
 	DeliveredTotal   uint64
 	DroppedTotal     uint64
 	SubscribersTotal int64
+	ExpiredTotal     uint64
 }
@@ -0,0 +1,91 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"time"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/server/events/config"
+)
+
+// retention holds the retention windows of retained events.
+// A zero window keeps events until they are evicted from the replay buffer.
+type retention struct {
+	windows       map[eventsv1.EventType]time.Duration
+	defaultWindow time.Duration
+}
+
+func newRetention(cfg config.RetentionConfig) *retention {
+	windows, err := cfg.Windows()
+	if err != nil {
+		// The config is validated when the server starts, so this only
+		// happens for event buses created with an unvalidated config.
+		logger.Warn("Ignoring invalid event retention config", "error", err)
+
+		return &retention{}
+	}
+
+	return &retention{
+		windows:       windows,
+		defaultWindow: cfg.Default,
+	}
+}
+
+// window returns the retention window of an event type.
+func (r *retention) window(eventType eventsv1.EventType) time.Duration {
+	if window, ok := r.windows[eventType]; ok {
+		return window
+	}
+
+	return r.defaultWindow
+}
+
+// expired reports whether the retention window of an event has expired at the given time.
+func (r *retention) expired(event *Event, now time.Time) bool {
+	window := r.window(event.Type)
+
+	return window > 0 && now.Sub(event.Timestamp) > window
+}
+
+// ExpireRetained removes the retained events whose retention window has expired
+// at the given time. It returns the number of removed events per event type.
+func (b *EventBus) ExpireRetained(now time.Time) map[eventsv1.EventType]int {
+	b.historyMu.Lock()
+	defer b.historyMu.Unlock()
+
+	expired := make(map[eventsv1.EventType]int)
+	kept := b.history[:0]
+
+	for _, event := range b.history {
+		if b.retention.expired(event, now) {
+			expired[event.Type]++
+
+			continue
+		}
+
+		kept = append(kept, event)
+	}
+
+	// Release references to removed events
+	clear(b.history[len(kept):])
+	b.history = kept
+
+	var total int
+	for _, count := range expired {
+		total += count
+	}
+
+	b.metrics.ExpiredTotal.Add(uint64(total)) //nolint:gosec // count is never negative
+
+	return expired
+}
+
+// RetainedCount returns the number of events currently retained for replay.
+func (b *EventBus) RetainedCount() int {
+	b.historyMu.RLock()
+	defer b.historyMu.RUnlock()
+
+	return len(b.history)
+}
@@ -0,0 +1,134 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/server/events/config"
+)
+
+func TestRetentionConfigWindows(t *testing.T) {
+	cfg := config.RetentionConfig{
+		Types: map[string]time.Duration{
+			"record_deleted":           90 * 24 * time.Hour,
+			"EVENT_TYPE_RECORD_PULLED": 24 * time.Hour,
+			"RECORD_VALIDATION_DRIFT":  0,
+		},
+	}
+
+	windows, err := cfg.Windows()
+	if err != nil {
+		t.Fatalf("Windows() error: %v", err)
+	}
+
+	if windows[eventsv1.EventType_EVENT_TYPE_RECORD_DELETED] != 90*24*time.Hour {
+		t.Errorf("Expected 90 days for RECORD_DELETED, got %v", windows[eventsv1.EventType_EVENT_TYPE_RECORD_DELETED])
+	}
+
+	if windows[eventsv1.EventType_EVENT_TYPE_RECORD_PULLED] != 24*time.Hour {
+		t.Errorf("Expected 1 day for RECORD_PULLED, got %v", windows[eventsv1.EventType_EVENT_TYPE_RECORD_PULLED])
+	}
+
+	if !cfg.Enabled() {
+		t.Error("Expected retention to be enabled")
+	}
+
+	invalid := []config.RetentionConfig{
+		{Types: map[string]time.Duration{"RECORD_EXPLODED": time.Hour}},
+		{Types: map[string]time.Duration{"UNSPECIFIED": time.Hour}},
+		{Types: map[string]time.Duration{"RECORD_PULLED": -time.Hour}},
+		{Default: -time.Hour},
+	}
+
+	for _, cfg := range invalid {
+		if _, err := cfg.Windows(); err == nil {
+			t.Errorf("Expected error for %+v", cfg)
+		}
+	}
+
+	if (config.RetentionConfig{}).Enabled() {
+		t.Error("Expected retention to be disabled without windows")
+	}
+}
+
+func TestEventBusExpireRetained(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Retention.Default = time.Hour
+	cfg.Retention.Types = map[string]time.Duration{
+		"RECORD_PULLED":  time.Minute,
+		"RECORD_DELETED": 0,
+	}
+	bus := NewEventBusWithConfig(cfg)
+
+	now := time.Now()
+
+	pulled := NewEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PULLED, TestCID123)
+	pulled.Timestamp = now.Add(-2 * time.Minute)
+	pushed := NewEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, TestCID123)
+	pushed.Timestamp = now.Add(-2 * time.Minute)
+	oldPushed := NewEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, TestCID456)
+	oldPushed.Timestamp = now.Add(-2 * time.Hour)
+	deleted := NewEvent(eventsv1.EventType_EVENT_TYPE_RECORD_DELETED, TestCID456)
+	deleted.Timestamp = now.Add(-24 * time.Hour)
+
+	for _, event := range []*Event{deleted, oldPushed, pulled, pushed} {
+		bus.Publish(event)
+	}
+
+	bus.WaitForAsyncPublish()
+
+	// Expired events are not replayed before they are removed
+	if replayed := bus.Replay(&eventsv1.ListenRequest{}, time.Time{}); len(replayed) != 2 {
+		t.Errorf("Expected 2 unexpired events to be replayed, got %v", replayed)
+	}
+
+	expired := bus.ExpireRetained(now)
+	if expired[eventsv1.EventType_EVENT_TYPE_RECORD_PULLED] != 1 || expired[eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED] != 1 || len(expired) != 2 {
+		t.Errorf("Expected one expired pulled and pushed event, got %v", expired)
+	}
+
+	if count := bus.RetainedCount(); count != 2 {
+		t.Errorf("Expected 2 retained events, got %d", count)
+	}
+
+	replayed := bus.Replay(&eventsv1.ListenRequest{}, time.Time{})
+	if len(replayed) != 2 || replayed[0].ID != deleted.ID || replayed[1].ID != pushed.ID {
+		t.Errorf("Expected the deleted and recent pushed events, got %v", replayed)
+	}
+
+	if metrics := bus.GetMetrics(); metrics.ExpiredTotal != 2 {
+		t.Errorf("Expected 2 expired events in metrics, got %d", metrics.ExpiredTotal)
+	}
+}
+
+func TestServiceRetentionCleanup(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Retention.Types = map[string]time.Duration{"RECORD_PULLED": time.Millisecond}
+	cfg.Retention.CleanupInterval = 10 * time.Millisecond
+
+	service := NewWithConfig(cfg)
+
+	defer func() { _ = service.Stop() }()
+
+	service.Bus().Publish(NewEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PULLED, TestCID123))
+	service.Bus().Publish(NewEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, TestCID123))
+	service.Bus().WaitForAsyncPublish()
+
+	deadline := time.Now().Add(time.Second)
+	for service.Bus().RetainedCount() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the pulled event to be removed, %d events retained", service.Bus().RetainedCount())
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// Stopping twice is safe
+	if err := service.Stop(); err != nil {
+		t.Errorf("Stop() error: %v", err)
+	}
+}
@@ -3,7 +3,13 @@
 
 package events
 
-import eventsv1 "github.com/agntcy/dir/api/events/v1"
+import (
+	"context"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/server/authn"
+	"github.com/agntcy/dir/server/churn"
+)
 
 // SafeEventBus is a nil-safe wrapper around EventBus.
 // All methods are safe to call even if the underlying bus is nil.
@@ -11,6 +17,23 @@ import eventsv1 "github.com/agntcy/dir/api/events/v1"
 // in services without checking for nil.
 type SafeEventBus struct {
 	bus *EventBus
+
+	// actor, namespace and metadata are attached to events published via convenience methods
+	actor     string
+	namespace string
+	metadata  map[string]string
+
+	// damper coalesces the lifecycle events of flapping records, nil if disabled
+	damper *churn.Damper
+}
+
+type metadataContextKey struct{}
+
+// WithMetadata returns a context carrying metadata attached to the events
+// published via the convenience methods of buses returned by ForContext.
+// Metadata set by the event itself takes precedence.
+func WithMetadata(ctx context.Context, metadata map[string]string) context.Context {
+	return context.WithValue(ctx, metadataContextKey{}, metadata)
 }
 
 // NewSafeEventBus creates a nil-safe wrapper around an event bus.
@@ -19,6 +42,46 @@ func NewSafeEventBus(bus *EventBus) *SafeEventBus {
 	return &SafeEventBus{bus: bus}
 }
 
+// WithChurnDamper returns a bus coalescing the push/delete and publish/unpublish events
+// of flapping records into the event of their final state. A nil damper disables it.
+func (s *SafeEventBus) WithChurnDamper(damper *churn.Damper) *SafeEventBus {
+	return &SafeEventBus{
+		bus:       s.bus,
+		actor:     s.actor,
+		namespace: s.namespace,
+		metadata:  s.metadata,
+		damper:    damper,
+	}
+}
+
+// ForContext returns a bus that attaches the authenticated identity from ctx
+// as the actor of events published via convenience methods, together with
+// the metadata set on ctx with WithMetadata.
+// If ctx carries neither, the bus is returned unchanged.
+func (s *SafeEventBus) ForContext(ctx context.Context) *SafeEventBus {
+	sid, hasIdentity := authn.SpiffeIDFromContext(ctx)
+	metadata, _ := ctx.Value(metadataContextKey{}).(map[string]string)
+
+	if !hasIdentity && len(metadata) == 0 {
+		return s
+	}
+
+	bus := &SafeEventBus{
+		bus:       s.bus,
+		actor:     s.actor,
+		namespace: s.namespace,
+		metadata:  metadata,
+		damper:    s.damper,
+	}
+
+	if hasIdentity {
+		bus.actor = sid.String()
+		bus.namespace = sid.TrustDomain().String()
+	}
+
+	return bus
+}
+
 // Publish publishes an event. No-op if bus is nil.
 func (s *SafeEventBus) Publish(event *Event) {
 	if s.bus != nil {
@@ -47,63 +110,84 @@ func (s *SafeEventBus) Unsubscribe(id string) {
 // RecordPushed publishes a record push event. No-op if bus is nil.
 func (s *SafeEventBus) RecordPushed(cid string, labels []string) {
 	if s.bus != nil {
-		s.bus.RecordPushed(cid, labels)
+		s.publishDamped(storedEventsKey(cid), newRecordPushedEvent(cid, labels))
 	}
 }
 
 // RecordPulled publishes a record pull event. No-op if bus is nil.
 func (s *SafeEventBus) RecordPulled(cid string, labels []string) {
 	if s.bus != nil {
-		s.bus.RecordPulled(cid, labels)
+		s.publishWithActor(newRecordPulledEvent(cid, labels))
 	}
 }
 
 // RecordDeleted publishes a record delete event. No-op if bus is nil.
 func (s *SafeEventBus) RecordDeleted(cid string) {
 	if s.bus != nil {
-		s.bus.RecordDeleted(cid)
+		s.publishDamped(storedEventsKey(cid), newRecordDeletedEvent(cid))
 	}
 }
 
 // RecordPublished publishes a record publish event. No-op if bus is nil.
 func (s *SafeEventBus) RecordPublished(cid string, labels []string) {
 	if s.bus != nil {
-		s.bus.RecordPublished(cid, labels)
+		s.publishDamped(publishedEventsKey(cid), newRecordPublishedEvent(cid, labels))
 	}
 }
 
 // RecordUnpublished publishes a record unpublish event. No-op if bus is nil.
 func (s *SafeEventBus) RecordUnpublished(cid string) {
 	if s.bus != nil {
-		s.bus.RecordUnpublished(cid)
+		s.publishDamped(publishedEventsKey(cid), newRecordUnpublishedEvent(cid))
 	}
 }
 
 // SyncCreated publishes a sync created event. No-op if bus is nil.
 func (s *SafeEventBus) SyncCreated(syncID, remoteURL string) {
 	if s.bus != nil {
-		s.bus.SyncCreated(syncID, remoteURL)
+		s.publishWithActor(newSyncCreatedEvent(syncID, remoteURL))
 	}
 }
 
 // SyncCompleted publishes a sync completed event. No-op if bus is nil.
 func (s *SafeEventBus) SyncCompleted(syncID, remoteURL string, recordCount int) {
 	if s.bus != nil {
-		s.bus.SyncCompleted(syncID, remoteURL, recordCount)
+		s.publishWithActor(newSyncCompletedEvent(syncID, remoteURL, recordCount))
 	}
 }
 
 // SyncFailed publishes a sync failed event. No-op if bus is nil.
 func (s *SafeEventBus) SyncFailed(syncID, remoteURL, errorMsg string) {
 	if s.bus != nil {
-		s.bus.SyncFailed(syncID, remoteURL, errorMsg)
+		s.publishWithActor(newSyncFailedEvent(syncID, remoteURL, errorMsg))
 	}
 }
 
 // RecordSigned publishes a record signed event. No-op if bus is nil.
 func (s *SafeEventBus) RecordSigned(cid, signer string) {
 	if s.bus != nil {
-		s.bus.RecordSigned(cid, signer)
+		s.publishWithActor(newRecordSignedEvent(cid, signer))
+	}
+}
+
+// RecordValidationDrift publishes a record validation drift event. No-op if bus is nil.
+func (s *SafeEventBus) RecordValidationDrift(cid, schemaVersion, rulesVersion string, validationErrors []string) {
+	if s.bus != nil {
+		s.publishWithActor(newRecordValidationDriftEvent(cid, schemaVersion, rulesVersion, validationErrors))
+	}
+}
+
+// RecordFlagged publishes a record flagged event. No-op if bus is nil.
+func (s *SafeEventBus) RecordFlagged(cid, verdict string, findings []string) {
+	if s.bus != nil {
+		s.publishWithActor(newRecordFlaggedEvent(cid, verdict, findings))
+	}
+}
+
+// WorkerStalled publishes a worker stalled event. No-op if bus is nil.
+func (s *SafeEventBus) WorkerStalled(kind, name string, workerID int, lastCheckpoint string) {
+	if s.bus != nil {
+		s.publishWithActor(newWorkerStalledEvent(kind, name, workerID, lastCheckpoint))
 	}
 }
 
@@ -124,3 +208,42 @@ func (s *SafeEventBus) GetMetrics() MetricsSnapshot {
 
 	return MetricsSnapshot{}
 }
+
+// publishDamped publishes a lifecycle event of a record, unless the record is flapping
+// between the states of the key, in which case only the event of its final state is published.
+func (s *SafeEventBus) publishDamped(key string, event *Event) {
+	// Publishing events never fails
+	_, _ = s.damper.Change(context.Background(), key, event.Type.String(), func(context.Context) error {
+		s.publishWithActor(event)
+
+		return nil
+	})
+}
+
+// storedEventsKey is the churn key of the push and delete events of a record.
+func storedEventsKey(cid string) string {
+	return cid + "/stored"
+}
+
+// publishedEventsKey is the churn key of the publish and unpublish events of a record.
+func publishedEventsKey(cid string) string {
+	return cid + "/published"
+}
+
+// publishWithActor attaches the bus actor to the event and publishes it.
+func (s *SafeEventBus) publishWithActor(event *Event) {
+	event.Actor = s.actor
+	event.Namespace = s.namespace
+
+	if event.Metadata == nil && len(s.metadata) > 0 {
+		event.Metadata = make(map[string]string, len(s.metadata))
+	}
+
+	for key, value := range s.metadata {
+		if _, ok := event.Metadata[key]; !ok {
+			event.Metadata[key] = value
+		}
+	}
+
+	s.bus.Publish(event)
+}
@@ -4,9 +4,16 @@
 package events
 
 import (
+	"context"
+	"slices"
 	"testing"
+	"time"
 
 	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/server/authn"
+	"github.com/agntcy/dir/server/churn"
+	churnconfig "github.com/agntcy/dir/server/churn/config"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
 )
 
 const (
@@ -55,6 +62,9 @@ func TestSafeEventBusNilSafety(t *testing.T) {
 	safeBus.SyncCompleted("sync-id", "url", 10)
 	safeBus.SyncFailed("sync-id", "url", "error")
 	safeBus.RecordSigned("cid", "signer")
+	safeBus.RecordValidationDrift("cid", "0.7.0", "v1", []string{"error"})
+	safeBus.RecordFlagged("cid", "blocked", []string{"finding"})
+	safeBus.WorkerStalled("sync", "sync-id", 0, "")
 
 	// Test SubscriberCount - should return 0
 	count := safeBus.SubscriberCount()
@@ -181,6 +191,21 @@ func TestSafeEventBusAllConvenienceMethods(t *testing.T) {
 			publish:  func() { safeBus.RecordSigned("cid6", "signer") },
 			expected: eventsv1.EventType_EVENT_TYPE_RECORD_SIGNED,
 		},
+		{
+			name:     "RecordValidationDrift",
+			publish:  func() { safeBus.RecordValidationDrift("cid7", "0.7.0", "v1", []string{"error"}) },
+			expected: eventsv1.EventType_EVENT_TYPE_RECORD_VALIDATION_DRIFT,
+		},
+		{
+			name:     "RecordFlagged",
+			publish:  func() { safeBus.RecordFlagged("cid8", "blocked", []string{"finding"}) },
+			expected: eventsv1.EventType_EVENT_TYPE_RECORD_FLAGGED,
+		},
+		{
+			name:     "WorkerStalled",
+			publish:  func() { safeBus.WorkerStalled("sync", "sync4", 1, "negotiating credentials") },
+			expected: eventsv1.EventType_EVENT_TYPE_WORKER_STALLED,
+		},
 	}
 
 	for _, tt := range tests {
@@ -318,3 +343,142 @@ func TestSafeEventBusPublishDirect(t *testing.T) {
 		t.Error("Expected to receive event")
 	}
 }
+
+func TestSafeEventBusForContext(t *testing.T) {
+	bus := NewEventBus()
+	safeBus := NewSafeEventBus(bus)
+
+	subID, eventCh := safeBus.Subscribe(&eventsv1.ListenRequest{
+		NamespaceFilters: []string{"example.org"},
+	})
+	defer safeBus.Unsubscribe(subID)
+
+	// Events without an identity in context have no actor and are filtered out
+	safeBus.ForContext(t.Context()).RecordPushed(TestCID123, nil)
+
+	// Events with an identity in context carry the actor and its namespace
+	sid := spiffeid.RequireFromString("spiffe://example.org/agent")
+	ctx := context.WithValue(t.Context(), authn.SpiffeIDContextKey, sid)
+	safeBus.ForContext(ctx).RecordPushed(TestCID456, nil)
+
+	bus.WaitForAsyncPublish()
+
+	select {
+	case event := <-eventCh:
+		if event.ResourceID != TestCID456 {
+			t.Errorf("Expected %s, got %s", TestCID456, event.ResourceID)
+		}
+
+		if event.Actor != "spiffe://example.org/agent" {
+			t.Errorf("Expected actor spiffe://example.org/agent, got %s", event.Actor)
+		}
+
+		if event.Namespace != "example.org" {
+			t.Errorf("Expected namespace example.org, got %s", event.Namespace)
+		}
+	default:
+		t.Error("Expected to receive event")
+	}
+
+	select {
+	case event := <-eventCh:
+		t.Errorf("Unexpected event for %s", event.ResourceID)
+	default:
+	}
+}
+
+func TestSafeEventBusForContextMetadata(t *testing.T) {
+	bus := NewEventBus()
+	safeBus := NewSafeEventBus(bus)
+
+	subID, eventCh := safeBus.Subscribe(&eventsv1.ListenRequest{})
+	defer safeBus.Unsubscribe(subID)
+
+	// Metadata from context is attached without overriding event metadata
+	ctx := WithMetadata(t.Context(), map[string]string{"publication_id": "pub-1", "signer": "context"})
+	safeBus.ForContext(ctx).RecordSigned(TestCID123, "client")
+
+	bus.WaitForAsyncPublish()
+
+	select {
+	case event := <-eventCh:
+		if event.Metadata["publication_id"] != "pub-1" {
+			t.Errorf("Expected publication_id pub-1, got %q", event.Metadata["publication_id"])
+		}
+
+		if event.Metadata["signer"] != "client" {
+			t.Errorf("Expected signer client, got %q", event.Metadata["signer"])
+		}
+	default:
+		t.Error("Expected to receive event")
+	}
+}
+
+func TestSafeEventBusChurnDamper(t *testing.T) {
+	bus := NewEventBus()
+	damper := churn.New(churnconfig.Config{
+		Enabled:     true,
+		Threshold:   2,
+		Window:      time.Minute,
+		QuietPeriod: 50 * time.Millisecond,
+	}, "events")
+	defer damper.Stop()
+
+	safeBus := NewSafeEventBus(bus).WithChurnDamper(damper)
+
+	subID, eventCh := safeBus.Subscribe(&eventsv1.ListenRequest{})
+	defer safeBus.Unsubscribe(subID)
+
+	// Flapping publications are coalesced, without affecting other events of the record
+	safeBus.RecordPublished(TestCID123, nil)
+	safeBus.RecordUnpublished(TestCID123)
+	safeBus.RecordPublished(TestCID123, nil)
+	safeBus.RecordUnpublished(TestCID123)
+	safeBus.ForContext(t.Context()).RecordPublished(TestCID123, nil)
+	safeBus.RecordPushed(TestCID123, nil)
+
+	received := func(count int) []eventsv1.EventType {
+		var types []eventsv1.EventType
+
+		timeout := time.After(time.Second)
+
+		for len(types) < count {
+			select {
+			case event := <-eventCh:
+				types = append(types, event.Type)
+			case <-timeout:
+				return types
+			}
+		}
+
+		return types
+	}
+
+	// Publish, unpublish and push events are delivered immediately, the final publish after the quiet period
+	types := received(4)
+	expected := []eventsv1.EventType{
+		eventsv1.EventType_EVENT_TYPE_RECORD_PUBLISHED,
+		eventsv1.EventType_EVENT_TYPE_RECORD_UNPUBLISHED,
+		eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED,
+		eventsv1.EventType_EVENT_TYPE_RECORD_PUBLISHED,
+	}
+
+	if len(types) != len(expected) {
+		t.Fatalf("Expected events %v, got %v", expected, types)
+	}
+
+	// Immediate events are delivered asynchronously, in any order
+	for _, eventType := range expected[:3] {
+		if !slices.Contains(types[:3], eventType) {
+			t.Errorf("Expected event %s, got %v", eventType, types)
+		}
+	}
+
+	if types[3] != expected[3] {
+		t.Errorf("Expected final event %s, got %s", expected[3], types[3])
+	}
+
+	if extra := received(1); len(extra) != 0 {
+		t.Errorf("Unexpected events %v", extra)
+	}
+}
@@ -10,8 +10,9 @@
 //
 // Key characteristics:
 //   - Simple: In-memory event bus with no external dependencies
-//   - Real-time: Events delivered from subscription time forward (no history/replay)
-//   - Filtered: Client-side control over event types, labels, and CIDs
+//   - Real-time: Events delivered from subscription time forward, with optional
+//     replay of a bounded number of recent events
+//   - Filtered: Client-side control over event types, labels, CIDs, actors, and namespaces
 //   - Type-safe: Protocol buffer enums for all event types
 //   - Observable: Built-in metrics and logging for monitoring
 //
@@ -67,6 +68,13 @@ type Event struct {
 	// Metadata contains optional additional context for the event.
 	// This provides flexibility for event-specific data.
 	Metadata map[string]string
+
+	// Actor is the SPIFFE ID of the identity that caused the event.
+	// Empty for events caused by the server itself.
+	Actor string
+
+	// Namespace is the trust domain of the identity that caused the event.
+	Namespace string
 }
 
 // NewEvent creates a new event with auto-generated ID and timestamp.
@@ -96,6 +104,8 @@ func (e *Event) ToProto() *eventsv1.Event {
 		ResourceId: e.ResourceID,
 		Labels:     e.Labels,
 		Metadata:   e.Metadata,
+		Actor:      e.Actor,
+		Namespace:  e.Namespace,
 	}
 }
 
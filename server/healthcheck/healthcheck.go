@@ -59,6 +59,18 @@ func (c *Checker) AddReadinessCheck(name string, check CheckFunc) {
 	c.readinessChecks[name] = check
 }
 
+// SetServiceStatus sets the health status of a named service, which clients can
+// check separately from the overall health, e.g. with grpc-health-probe -service.
+// It does not affect readiness.
+func (c *Checker) SetServiceStatus(service string, serving bool) {
+	status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+
+	c.healthServer.SetServingStatus(service, status)
+}
+
 // Register registers the health service with the gRPC server.
 func (c *Checker) Register(grpcServer *grpc.Server) {
 	grpc_health_v1.RegisterHealthServer(grpcServer, c.healthServer)
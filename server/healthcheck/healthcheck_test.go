@@ -30,6 +30,32 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestSetServiceStatus(t *testing.T) {
+	checker := New()
+
+	checker.SetServiceStatus("store-probe", false)
+
+	resp, err := checker.healthServer.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "store-probe"})
+	if err != nil {
+		t.Fatalf("Check() error: %v", err)
+	}
+
+	if resp.GetStatus() != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("Expected NOT_SERVING, got %v", resp.GetStatus())
+	}
+
+	checker.SetServiceStatus("store-probe", true)
+
+	resp, err = checker.healthServer.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "store-probe"})
+	if err != nil {
+		t.Fatalf("Check() error: %v", err)
+	}
+
+	if resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Errorf("Expected SERVING, got %v", resp.GetStatus())
+	}
+}
+
 func TestAddReadinessCheck(t *testing.T) {
 	checker := New()
 
@@ -0,0 +1,30 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+const (
+	DefaultSocketActivation = true
+	DefaultReusePort        = false
+	DefaultDrainTimeout     = 0
+)
+
+// Config is the configuration of the gRPC listener of the server, allowing it to be
+// restarted or re-bound to new addresses without refusing connections.
+type Config struct {
+	// SocketActivation serves on the socket passed by systemd socket activation, if any,
+	// instead of binding the listen address. The socket named "grpc" is used if the
+	// sockets are named with FileDescriptorName, otherwise the first one.
+	SocketActivation bool `json:"socket_activation,omitempty" mapstructure:"socket_activation"`
+
+	// ReusePort binds the listen address with SO_REUSEPORT, so that a new server
+	// process can bind the same address while the previous one is draining.
+	ReusePort bool `json:"reuse_port,omitempty" mapstructure:"reuse_port"`
+
+	// DrainTimeout is how long in-flight RPCs, such as event streams, are kept on
+	// shutdown after the server stops accepting connections, before being ended.
+	// Zero ends them immediately.
+	DrainTimeout time.Duration `json:"drain_timeout,omitempty" mapstructure:"drain_timeout"`
+}
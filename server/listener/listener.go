@@ -0,0 +1,105 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package listener creates the network listener of the server, either inherited
+// from systemd socket activation or bound to the listen address, optionally with
+// SO_REUSEPORT, so that servers can hand off their listen address to a new process.
+package listener
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/agntcy/dir/server/listener/config"
+	"github.com/agntcy/dir/utils/logging"
+)
+
+var logger = logging.Logger("listener")
+
+const (
+	// SocketName is the name of the activation socket served by the gRPC server.
+	SocketName = "grpc"
+
+	// listenFDsStart is the first file descriptor passed by systemd socket activation.
+	listenFDsStart = 3
+)
+
+// ErrReusePortUnsupported is returned when SO_REUSEPORT is not supported on the platform.
+var ErrReusePortUnsupported = errors.New("SO_REUSEPORT is not supported on this platform")
+
+// Listen returns the listener of the server. If socket activation is enabled and the
+// process was socket activated, the activation socket is used and the address is ignored.
+func Listen(ctx context.Context, cfg config.Config, address string) (net.Listener, error) {
+	if cfg.SocketActivation {
+		listener, err := activationListener(SocketName)
+		if err != nil {
+			return nil, err
+		}
+
+		if listener != nil {
+			logger.Info("Using socket activation listener", "address", listener.Addr().String())
+
+			return listener, nil
+		}
+	}
+
+	listenConfig := &net.ListenConfig{}
+	if cfg.ReusePort {
+		listenConfig.Control = reusePort
+	}
+
+	listener, err := listenConfig.Listen(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	return listener, nil
+}
+
+// activationListener returns the socket passed by systemd with the given name,
+// or the first one if the sockets are not named. It returns nil if the process
+// was not socket activated. The activation variables are unset, so that they
+// are not inherited by child processes.
+func activationListener(name string) (net.Listener, error) {
+	pid, fds, names := os.Getenv("LISTEN_PID"), os.Getenv("LISTEN_FDS"), os.Getenv("LISTEN_FDNAMES")
+	if fds == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	for _, key := range []string{"LISTEN_PID", "LISTEN_FDS", "LISTEN_FDNAMES"} {
+		_ = os.Unsetenv(key)
+	}
+
+	// Sockets passed to another process, e.g. the parent shell
+	if pid != strconv.Itoa(os.Getpid()) {
+		return nil, nil //nolint:nilnil
+	}
+
+	count, err := strconv.Atoi(fds)
+	if err != nil || count < 1 {
+		return nil, fmt.Errorf("invalid LISTEN_FDS: %q", fds)
+	}
+
+	index := 0
+	if names != "" {
+		if i := slices.Index(strings.Split(names, ":"), name); i >= 0 && i < count {
+			index = i
+		}
+	}
+
+	file := os.NewFile(uintptr(listenFDsStart+index), "activation-"+name)
+	defer file.Close()
+
+	listener, err := net.FileListener(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to use activation socket: %w", err)
+	}
+
+	return listener, nil
+}
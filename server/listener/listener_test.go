@@ -0,0 +1,70 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package listener
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/agntcy/dir/server/listener/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenReusePort(t *testing.T) {
+	cfg := config.Config{ReusePort: true}
+
+	first, err := Listen(t.Context(), cfg, "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer first.Close()
+
+	// A second server can bind the same address while the first one is serving
+	second, err := Listen(t.Context(), cfg, first.Addr().String())
+	require.NoError(t, err)
+	require.NoError(t, second.Close())
+
+	// Without SO_REUSEPORT, the address is in use
+	_, err = Listen(t.Context(), config.Config{}, first.Addr().String())
+	require.Error(t, err)
+}
+
+func TestListenSocketActivation(t *testing.T) {
+	t.Run("not activated", func(t *testing.T) {
+		listener, err := activationListener(SocketName)
+		require.NoError(t, err)
+		assert.Nil(t, listener)
+	})
+
+	t.Run("sockets of another process", func(t *testing.T) {
+		t.Setenv("LISTEN_PID", "1")
+		t.Setenv("LISTEN_FDS", "1")
+
+		listener, err := activationListener(SocketName)
+		require.NoError(t, err)
+		assert.Nil(t, listener)
+
+		// Activation variables are not inherited
+		_, ok := os.LookupEnv("LISTEN_FDS")
+		assert.False(t, ok)
+	})
+
+	t.Run("invalid sockets", func(t *testing.T) {
+		t.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()))
+		t.Setenv("LISTEN_FDS", "none")
+
+		_, err := activationListener(SocketName)
+		require.Error(t, err)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()))
+		t.Setenv("LISTEN_FDS", "none")
+
+		listener, err := Listen(t.Context(), config.Config{}, "127.0.0.1:0")
+		require.NoError(t, err)
+		require.NoError(t, listener.Close())
+	})
+}
@@ -0,0 +1,13 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !unix
+
+package listener
+
+import "syscall"
+
+// reusePort fails, as SO_REUSEPORT is not supported on the platform.
+func reusePort(_, _ string, _ syscall.RawConn) error {
+	return ErrReusePortUnsupported
+}
@@ -0,0 +1,18 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+const (
+	DefaultEnabled       = false
+	DefaultListenAddress = "0.0.0.0:9090"
+)
+
+// Config is the configuration of the Prometheus metrics endpoint of the server.
+type Config struct {
+	// Enabled turns on the metrics endpoint.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// ListenAddress is the address the metrics are served on, under /metrics.
+	ListenAddress string `json:"listen_address,omitempty" mapstructure:"listen_address"`
+}
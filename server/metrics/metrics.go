@@ -0,0 +1,125 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metrics serves the metrics of the server in the Prometheus format.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/agntcy/dir/server/metrics/config"
+	"github.com/agntcy/dir/utils/logging"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var logger = logging.Logger("metrics")
+
+const (
+	// Path is the HTTP path the metrics are served on.
+	Path = "/metrics"
+
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+// Server serves the metrics registered with it over HTTP.
+type Server struct {
+	config   config.Config
+	registry *prometheus.Registry
+	handlers map[string]http.Handler
+	server   *http.Server
+	wg       sync.WaitGroup
+}
+
+// New creates a metrics server with an empty registry.
+func New(cfg config.Config) *Server {
+	return &Server{
+		config:   cfg,
+		registry: prometheus.NewRegistry(),
+		handlers: map[string]http.Handler{},
+	}
+}
+
+// Handle serves an additional handler on the metrics address, e.g. for server descriptors.
+// Handlers must be added before the server is started.
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.handlers[pattern] = handler
+}
+
+// Register adds collectors to the metrics served by the server.
+func (s *Server) Register(collectors ...prometheus.Collector) error {
+	for _, collector := range collectors {
+		if err := s.registry.Register(collector); err != nil {
+			return fmt.Errorf("failed to register metrics collector: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Handler returns the HTTP handler serving the registered metrics.
+func (s *Server) Handler() http.Handler {
+	return promhttp.HandlerFor(s.registry, promhttp.HandlerOpts{})
+}
+
+// Start starts serving the metrics on the configured address.
+func (s *Server) Start(ctx context.Context) error {
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", s.config.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.config.ListenAddress, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(Path, s.Handler())
+
+	for pattern, handler := range s.handlers {
+		mux.Handle(pattern, handler)
+	}
+
+	s.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("Metrics server failed", "error", err)
+		}
+	}()
+
+	logger.Info("Metrics server started", "address", listener.Addr().String(), "path", Path)
+
+	return nil
+}
+
+// Stop stops serving the metrics.
+func (s *Server) Stop() error {
+	if s.server == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	err := s.server.Shutdown(ctx)
+	s.wg.Wait()
+
+	if err != nil {
+		return fmt.Errorf("failed to stop metrics server: %w", err)
+	}
+
+	logger.Info("Metrics server stopped")
+
+	return nil
+}
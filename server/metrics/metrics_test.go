@@ -0,0 +1,43 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/agntcy/dir/server/metrics/config"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerHandler(t *testing.T) {
+	server := New(config.Config{})
+
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "dir_test_gauge", Help: "Test gauge."})
+	gauge.Set(42)
+
+	require.NoError(t, server.Register(gauge))
+
+	// Collectors can only be registered once
+	require.Error(t, server.Register(gauge))
+
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "dir_test_gauge 42")
+}
+
+func TestServerStartStop(t *testing.T) {
+	server := New(config.Config{ListenAddress: "127.0.0.1:0"})
+
+	// Stopping a server that was not started is a no-op
+	require.NoError(t, server.Stop())
+
+	require.NoError(t, server.Start(t.Context()))
+	require.NoError(t, server.Stop())
+}
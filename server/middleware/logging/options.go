@@ -6,6 +6,7 @@ package logging
 import (
 	"context"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	"github.com/agntcy/dir/server/authn"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors"
 	grpc_logging "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
@@ -21,8 +22,8 @@ const (
 )
 
 // Typical field count for pre-allocating fields slice.
-// Includes: spiffe_id, request_id, correlation_id, user_agent (4 keys + 4 values = 8 items).
-const typicalFieldCount = 8
+// Includes: spiffe_id, request_id, correlation_id, user_agent, client_instance_id (5 keys + 5 values = 10 items).
+const typicalFieldCount = 10
 
 // Noisy endpoints that should be excluded from logging by default.
 var noisyEndpoints = map[string]bool{
@@ -61,6 +62,11 @@ func extractFieldsFromContext(ctx context.Context) grpc_logging.Fields {
 		fields = append(fields, "user_agent", userAgent[0])
 	}
 
+	// Extract Client Instance ID, ignoring malformed values to keep logs clean
+	if instanceID := md.Get(corev1.ClientInstanceIDMetadataKey); len(instanceID) > 0 && corev1.ValidateClientInstanceID(instanceID[0]) == nil {
+		fields = append(fields, "client_instance_id", instanceID[0])
+	}
+
 	return fields
 }
 
@@ -70,6 +76,7 @@ func extractFieldsFromContext(ctx context.Context) grpc_logging.Fields {
 // - Request ID from metadata
 // - Correlation ID from metadata
 // - User Agent from metadata
+// - Client Instance ID from metadata
 // - Filters out noisy endpoints (health checks, probes).
 func ExtractFields(ctx context.Context, c interceptors.CallMeta) grpc_logging.Fields {
 	// Filter out noisy endpoints by returning nil fields
@@ -7,6 +7,7 @@ import (
 	"context"
 	"testing"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	"github.com/agntcy/dir/server/authn"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors"
 	grpc_logging "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
@@ -120,6 +121,24 @@ func TestExtractFields(t *testing.T) {
 				"user_agent": "custom-client/2.0",
 			},
 		},
+		{
+			name: "context with client instance ID",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				corev1.ClientInstanceIDMetadataKey, "ci-runner-01",
+			)),
+			callMeta: interceptors.NewServerCallMeta("/test.Service/Method", nil, nil),
+			expectedFields: map[string]string{
+				"client_instance_id": "ci-runner-01",
+			},
+		},
+		{
+			name: "context with malformed client instance ID",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				corev1.ClientInstanceIDMetadataKey, "not a valid id",
+			)),
+			callMeta:       interceptors.NewServerCallMeta("/test.Service/Method", nil, nil),
+			expectedFields: map[string]string{},
+		},
 		{
 			name: "context with partial metadata",
 			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
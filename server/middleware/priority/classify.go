@@ -0,0 +1,90 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package priority
+
+import (
+	"strings"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/middleware/priority/config"
+)
+
+// writeMethods are RPCs that modify server state.
+var writeMethods = map[string]bool{
+	storev1.StoreService_Push_FullMethodName:                      true,
+	storev1.StoreService_PushMany_FullMethodName:                  true,
+	storev1.StoreService_Delete_FullMethodName:                    true,
+	storev1.StoreService_PushReferrer_FullMethodName:              true,
+	storev1.StoreService_PushBundle_FullMethodName:                true,
+	storev1.StoreService_ApplyTransaction_FullMethodName:          true,
+	storev1.StoreService_UpdateRecord_FullMethodName:              true,
+	storev1.StoreService_SetAlias_FullMethodName:                  true,
+	storev1.StoreService_DeleteAlias_FullMethodName:               true,
+	storev1.SyncService_CreateSync_FullMethodName:                 true,
+	storev1.SyncService_DeleteSync_FullMethodName:                 true,
+	storev1.SyncService_AcceptSyncInvitation_FullMethodName:       true,
+	storev1.SyncService_ReleaseQuarantinedRecord_FullMethodName:   true,
+	routingv1.RoutingService_Publish_FullMethodName:               true,
+	routingv1.RoutingService_Unpublish_FullMethodName:             true,
+	routingv1.PublicationService_CreatePublication_FullMethodName: true,
+	signv1.SignService_Sign_FullMethodName:                        true,
+	signv1.SignService_Resign_FullMethodName:                      true,
+	corev1.OperationService_CancelOperation_FullMethodName:        true,
+	eventsv1.EventService_RegisterRecordWebhook_FullMethodName:    true,
+	eventsv1.EventService_DeleteRecordWebhook_FullMethodName:      true,
+}
+
+// backgroundMethods are RPCs issued by automation such as sync peers
+// or maintenance jobs, which can tolerate being delayed.
+var backgroundMethods = map[string]bool{
+	storev1.SyncService_RequestRegistryCredentials_FullMethodName: true,
+	storev1.SyncService_WarmCache_FullMethodName:                  true,
+	storev1.SyncService_ReconcileCIDs_FullMethodName:              true,
+	storev1.StoreService_ValidateStored_FullMethodName:            true,
+	storev1.StoreService_CheckConsistency_FullMethodName:          true,
+	storev1.StoreService_GarbageCollect_FullMethodName:            true,
+}
+
+// exemptMethods are long-lived calls that would otherwise hold capacity indefinitely.
+var exemptMethods = map[string]bool{
+	eventsv1.EventService_Listen_FullMethodName:          true,
+	eventsv1.EventService_WatchName_FullMethodName:       true,
+	corev1.OperationService_WaitOperation_FullMethodName: true,
+}
+
+// exemptPrefixes are infrastructure services that must stay available under load.
+var exemptPrefixes = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
+// Classify returns the priority class of a gRPC method.
+// Configured method classes take precedence over the built-in classification.
+// Methods that are not writes, background work, or exempt are interactive.
+func Classify(cfg *config.Config, method string) string {
+	if class, exists := cfg.MethodClasses[method]; exists {
+		return class
+	}
+
+	switch {
+	case exemptMethods[method]:
+		return config.ClassExempt
+	case writeMethods[method]:
+		return config.ClassWrite
+	case backgroundMethods[method]:
+		return config.ClassBackground
+	}
+
+	for _, prefix := range exemptPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return config.ClassExempt
+		}
+	}
+
+	return config.ClassInteractive
+}
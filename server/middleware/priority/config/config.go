@@ -0,0 +1,148 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Default priority configuration values.
+const (
+	// DefaultMaxInflight is the default number of inflight RPCs above which
+	// interactive requests are queued.
+	DefaultMaxInflight = 256
+
+	// DefaultWriteMaxInflight is the default number of inflight RPCs above which
+	// write requests are queued.
+	DefaultWriteMaxInflight = 128
+
+	// DefaultBackgroundMaxInflight is the default number of inflight RPCs above which
+	// background requests are queued.
+	DefaultBackgroundMaxInflight = 32
+
+	// DefaultMaxQueueDepth is the default number of requests waiting for capacity
+	// above which requests are shed immediately.
+	DefaultMaxQueueDepth = 256
+
+	// DefaultQueueTimeout is the default time a request waits for capacity before being shed.
+	DefaultQueueTimeout = 5 * time.Second
+
+	// DefaultRetryAfter is the default retry delay suggested to clients of shed requests.
+	DefaultRetryAfter = 1 * time.Second
+)
+
+// Request priority classes, from highest to lowest priority.
+const (
+	ClassInteractive = "interactive"
+	ClassWrite       = "write"
+	ClassBackground  = "background"
+
+	// ClassExempt is not subject to load shedding and not counted as inflight,
+	// e.g. for long-lived streams and health checks.
+	ClassExempt = "exempt"
+)
+
+// Config defines request priority and load shedding configuration for the gRPC server.
+// RPCs are classified as interactive reads, writes, or background work. When the number
+// of inflight RPCs exceeds the threshold of a class, requests of that class are queued,
+// and shed with codes.Unavailable once the queue is full or the queue timeout expires.
+// Lower priority classes have lower thresholds so they are shed first.
+type Config struct {
+	// Enabled determines if load shedding is active.
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+
+	// MaxInflight is the inflight RPC threshold for interactive requests.
+	// Default: 256
+	MaxInflight int `json:"max_inflight" mapstructure:"max_inflight"`
+
+	// WriteMaxInflight is the inflight RPC threshold for write requests.
+	// Default: 128
+	WriteMaxInflight int `json:"write_max_inflight" mapstructure:"write_max_inflight"`
+
+	// BackgroundMaxInflight is the inflight RPC threshold for background requests.
+	// Default: 32
+	BackgroundMaxInflight int `json:"background_max_inflight" mapstructure:"background_max_inflight"`
+
+	// MaxQueueDepth is the maximum number of requests waiting for capacity.
+	// Zero disables queueing, requests above their threshold are shed immediately.
+	// Default: 256
+	MaxQueueDepth int `json:"max_queue_depth" mapstructure:"max_queue_depth"`
+
+	// QueueTimeout is the maximum time a request waits for capacity.
+	// Default: 5s
+	QueueTimeout time.Duration `json:"queue_timeout" mapstructure:"queue_timeout"`
+
+	// RetryAfter is the retry delay returned to clients in RetryInfo when requests are shed.
+	// Default: 1s
+	RetryAfter time.Duration `json:"retry_after" mapstructure:"retry_after"`
+
+	// MethodClasses defines optional per-method class overrides.
+	// Keys are full gRPC method paths, values are one of
+	// "interactive", "write", "background", or "exempt".
+	MethodClasses map[string]string `json:"method_classes,omitempty" mapstructure:"method_classes"`
+}
+
+// Validate checks if the configuration is valid and returns an error if not.
+func (c *Config) Validate() error {
+	// If load shedding is disabled, no validation needed
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.MaxInflight <= 0 {
+		return fmt.Errorf("max_inflight must be positive, got: %d", c.MaxInflight)
+	}
+
+	// Lower priority classes must be shed before higher priority classes
+	if c.WriteMaxInflight <= 0 || c.WriteMaxInflight > c.MaxInflight {
+		return fmt.Errorf("write_max_inflight must be between 1 and max_inflight (%d), got: %d", c.MaxInflight, c.WriteMaxInflight)
+	}
+
+	if c.BackgroundMaxInflight <= 0 || c.BackgroundMaxInflight > c.WriteMaxInflight {
+		return fmt.Errorf("background_max_inflight must be between 1 and write_max_inflight (%d), got: %d", c.WriteMaxInflight, c.BackgroundMaxInflight)
+	}
+
+	if c.MaxQueueDepth < 0 {
+		return fmt.Errorf("max_queue_depth must be non-negative, got: %d", c.MaxQueueDepth)
+	}
+
+	if c.QueueTimeout < 0 {
+		return fmt.Errorf("queue_timeout must be non-negative, got: %s", c.QueueTimeout)
+	}
+
+	if c.RetryAfter < 0 {
+		return fmt.Errorf("retry_after must be non-negative, got: %s", c.RetryAfter)
+	}
+
+	for method, class := range c.MethodClasses {
+		if method == "" {
+			return errors.New("method class key cannot be empty")
+		}
+
+		switch class {
+		case ClassInteractive, ClassWrite, ClassBackground, ClassExempt:
+		default:
+			return fmt.Errorf("method %s: unknown class %q", method, class)
+		}
+	}
+
+	return nil
+}
+
+// DefaultConfig returns a configuration with sensible default values.
+// Load shedding is disabled by default for backward compatibility.
+func DefaultConfig() *Config {
+	return &Config{
+		Enabled:               false,
+		MaxInflight:           DefaultMaxInflight,
+		WriteMaxInflight:      DefaultWriteMaxInflight,
+		BackgroundMaxInflight: DefaultBackgroundMaxInflight,
+		MaxQueueDepth:         DefaultMaxQueueDepth,
+		QueueTimeout:          DefaultQueueTimeout,
+		RetryAfter:            DefaultRetryAfter,
+		MethodClasses:         make(map[string]string),
+	}
+}
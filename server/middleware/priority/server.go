@@ -0,0 +1,59 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package priority
+
+import (
+	"context"
+
+	"github.com/agntcy/dir/server/middleware/priority/config"
+	"google.golang.org/grpc"
+)
+
+// ServerOptions creates unary and stream load shedding interceptors for gRPC server.
+// These interceptors classify RPCs by priority (interactive reads > writes > background)
+// and queue or shed low priority requests when the server is overloaded, so that
+// interactive clients stay responsive during bulk operations.
+//
+// Returns an error if the configuration is invalid.
+//
+// IMPORTANT: These interceptors should be placed AFTER recovery and rate limiting
+// middleware, so that requests rejected by rate limiting never occupy capacity.
+func ServerOptions(cfg *config.Config) ([]grpc.ServerOption, error) {
+	shedder, err := NewShedder(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryServerInterceptor(shedder)),
+		grpc.ChainStreamInterceptor(StreamServerInterceptor(shedder)),
+	}, nil
+}
+
+// UnaryServerInterceptor returns a unary interceptor admitting requests through the shedder.
+func UnaryServerInterceptor(shedder *Shedder) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		release, err := shedder.Acquire(ctx, Classify(shedder.config, info.FullMethod))
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a stream interceptor admitting streams through the shedder.
+// A stream occupies capacity for its whole lifetime.
+func StreamServerInterceptor(shedder *Shedder) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		release, err := shedder.Acquire(ss.Context(), Classify(shedder.config, info.FullMethod))
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package priority
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/agntcy/dir/server/middleware/priority/config"
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var logger = logging.Logger("priority")
+
+// Shedder admits RPCs based on their priority class and the current server load.
+// Each class may only start while the number of inflight RPCs is below its threshold.
+// Requests above their threshold wait in a bounded queue until capacity frees up,
+// and are shed with codes.Unavailable when the queue is full or the wait times out.
+//
+// Thread Safety:
+// Shedder is safe for concurrent use by multiple goroutines.
+type Shedder struct {
+	config *config.Config
+
+	mu       sync.Mutex
+	inflight int
+	queued   int
+
+	// released is closed and replaced whenever an RPC finishes,
+	// waking up all queued requests to re-check capacity
+	released chan struct{}
+}
+
+// NewShedder creates a new Shedder with the given configuration.
+func NewShedder(cfg *config.Config) (*Shedder, error) {
+	if cfg == nil {
+		return nil, errors.New("config cannot be nil")
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid priority config: %w", err)
+	}
+
+	return &Shedder{
+		config:   cfg,
+		released: make(chan struct{}),
+	}, nil
+}
+
+// Acquire admits an RPC of the given class, waiting for capacity if needed.
+// On success, the returned release function must be called when the RPC finishes.
+// Returns a codes.Unavailable error with RetryInfo if the request is shed.
+func (s *Shedder) Acquire(ctx context.Context, class string) (func(), error) {
+	if !s.config.Enabled || class == config.ClassExempt {
+		return func() {}, nil
+	}
+
+	limit := s.limitFor(class)
+
+	s.mu.Lock()
+
+	if s.inflight < limit {
+		s.inflight++
+		s.mu.Unlock()
+
+		return s.release, nil
+	}
+
+	if s.queued >= s.config.MaxQueueDepth {
+		s.mu.Unlock()
+
+		return nil, s.shed(class, "queue full")
+	}
+
+	s.queued++
+
+	timer := time.NewTimer(s.config.QueueTimeout)
+	defer timer.Stop()
+
+	for {
+		released := s.released
+		s.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-timer.C:
+			s.dequeue()
+
+			return nil, s.shed(class, "queue timeout")
+		case <-ctx.Done():
+			s.dequeue()
+
+			return nil, status.FromContextError(ctx.Err()).Err() //nolint:wrapcheck // gRPC status error for client
+		}
+
+		s.mu.Lock()
+
+		if s.inflight < limit {
+			s.inflight++
+			s.queued--
+			s.mu.Unlock()
+
+			return s.release, nil
+		}
+	}
+}
+
+// Load returns the number of inflight and queued RPCs.
+// This is primarily useful for testing and monitoring.
+func (s *Shedder) Load() (int, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.inflight, s.queued
+}
+
+func (s *Shedder) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inflight--
+
+	close(s.released)
+	s.released = make(chan struct{})
+}
+
+func (s *Shedder) dequeue() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.queued--
+}
+
+func (s *Shedder) limitFor(class string) int {
+	switch class {
+	case config.ClassWrite:
+		return s.config.WriteMaxInflight
+	case config.ClassBackground:
+		return s.config.BackgroundMaxInflight
+	default:
+		return s.config.MaxInflight
+	}
+}
+
+// shed returns the error for a shed request, suggesting a retry delay to the client.
+func (s *Shedder) shed(class, reason string) error {
+	inflight, queued := s.Load()
+
+	logger.Warn("Request shed due to server load",
+		"class", class,
+		"reason", reason,
+		"inflight", inflight,
+		"queued", queued,
+	)
+
+	st := status.New(codes.Unavailable, "server overloaded, retry later")
+
+	withDetails, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(s.config.RetryAfter),
+	})
+	if err != nil {
+		return st.Err() //nolint:wrapcheck // gRPC status error for client
+	}
+
+	return withDetails.Err() //nolint:wrapcheck // gRPC status error for client
+}
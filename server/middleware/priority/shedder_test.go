@@ -0,0 +1,206 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package priority
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agntcy/dir/server/middleware/priority/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestShedder(t *testing.T, queueDepth int, queueTimeout time.Duration) *Shedder {
+	t.Helper()
+
+	shedder, err := NewShedder(&config.Config{
+		Enabled:               true,
+		MaxInflight:           4,
+		WriteMaxInflight:      2,
+		BackgroundMaxInflight: 1,
+		MaxQueueDepth:         queueDepth,
+		QueueTimeout:          queueTimeout,
+		RetryAfter:            time.Second,
+	})
+	require.NoError(t, err)
+
+	return shedder
+}
+
+func TestShedder_ClassThresholds(t *testing.T) {
+	shedder := newTestShedder(t, 0, 0)
+	ctx := context.Background()
+
+	// Background work is shed first
+	releaseBackground, err := shedder.Acquire(ctx, config.ClassBackground)
+	require.NoError(t, err)
+
+	_, err = shedder.Acquire(ctx, config.ClassBackground)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Writes are admitted until their threshold
+	releaseWrite, err := shedder.Acquire(ctx, config.ClassWrite)
+	require.NoError(t, err)
+
+	_, err = shedder.Acquire(ctx, config.ClassWrite)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Interactive requests still get through
+	for range 2 {
+		_, err = shedder.Acquire(ctx, config.ClassInteractive)
+		require.NoError(t, err)
+	}
+
+	_, err = shedder.Acquire(ctx, config.ClassInteractive)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Exempt requests are never shed or counted
+	release, err := shedder.Acquire(ctx, config.ClassExempt)
+	require.NoError(t, err)
+	release()
+
+	inflight, _ := shedder.Load()
+	assert.Equal(t, 4, inflight)
+
+	releaseBackground()
+	releaseWrite()
+
+	inflight, _ = shedder.Load()
+	assert.Equal(t, 2, inflight)
+}
+
+func TestShedder_RetryInfo(t *testing.T) {
+	shedder := newTestShedder(t, 0, 0)
+
+	_, err := shedder.Acquire(context.Background(), config.ClassBackground)
+	require.NoError(t, err)
+
+	_, err = shedder.Acquire(context.Background(), config.ClassBackground)
+	require.Error(t, err)
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.Unavailable, st.Code())
+	require.Len(t, st.Details(), 1)
+
+	retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.Equal(t, time.Second, retryInfo.GetRetryDelay().AsDuration())
+}
+
+func TestShedder_Queueing(t *testing.T) {
+	t.Run("queued request admitted on release", func(t *testing.T) {
+		shedder := newTestShedder(t, 1, 5*time.Second)
+
+		release, err := shedder.Acquire(context.Background(), config.ClassBackground)
+		require.NoError(t, err)
+
+		admitted := make(chan error, 1)
+
+		go func() {
+			_, err := shedder.Acquire(context.Background(), config.ClassBackground)
+			admitted <- err
+		}()
+
+		require.Eventually(t, func() bool {
+			_, queued := shedder.Load()
+
+			return queued == 1
+		}, time.Second, 10*time.Millisecond)
+
+		// Queue is full, further requests are shed immediately
+		_, err = shedder.Acquire(context.Background(), config.ClassBackground)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+
+		release()
+
+		select {
+		case err := <-admitted:
+			require.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("queued request was not admitted")
+		}
+
+		inflight, queued := shedder.Load()
+		assert.Equal(t, 1, inflight)
+		assert.Equal(t, 0, queued)
+	})
+
+	t.Run("queue timeout", func(t *testing.T) {
+		shedder := newTestShedder(t, 1, 50*time.Millisecond)
+
+		_, err := shedder.Acquire(context.Background(), config.ClassBackground)
+		require.NoError(t, err)
+
+		_, err = shedder.Acquire(context.Background(), config.ClassBackground)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+
+		_, queued := shedder.Load()
+		assert.Equal(t, 0, queued)
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		shedder := newTestShedder(t, 1, 5*time.Second)
+
+		_, err := shedder.Acquire(context.Background(), config.ClassBackground)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err = shedder.Acquire(ctx, config.ClassBackground)
+		assert.Equal(t, codes.Canceled, status.Code(err))
+	})
+}
+
+func TestShedder_Disabled(t *testing.T) {
+	shedder, err := NewShedder(&config.Config{Enabled: false})
+	require.NoError(t, err)
+
+	for range 100 {
+		_, err := shedder.Acquire(context.Background(), config.ClassBackground)
+		require.NoError(t, err)
+	}
+}
+
+func TestNewShedder_InvalidConfig(t *testing.T) {
+	_, err := NewShedder(nil)
+	require.ErrorContains(t, err, "config cannot be nil")
+
+	cfg := config.DefaultConfig()
+	cfg.Enabled = true
+	cfg.BackgroundMaxInflight = cfg.WriteMaxInflight + 1
+
+	_, err = NewShedder(cfg)
+	require.ErrorContains(t, err, "background_max_inflight must be between 1 and write_max_inflight")
+}
+
+func TestClassify(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.MethodClasses["/agntcy.dir.search.v1.SearchService/Search"] = config.ClassBackground
+
+	tests := []struct {
+		method string
+		want   string
+	}{
+		{method: "/agntcy.dir.store.v1.StoreService/Pull", want: config.ClassInteractive},
+		{method: "/agntcy.dir.store.v1.StoreService/Push", want: config.ClassWrite},
+		{method: "/agntcy.dir.store.v1.StoreService/PushMany", want: config.ClassWrite},
+		{method: "/agntcy.dir.store.v1.SyncService/RequestRegistryCredentials", want: config.ClassBackground},
+		{method: "/agntcy.dir.store.v1.SyncService/ReconcileCIDs", want: config.ClassBackground},
+		{method: "/agntcy.dir.events.v1.EventService/Listen", want: config.ClassExempt},
+		{method: "/grpc.health.v1.Health/Check", want: config.ClassExempt},
+		{method: "/agntcy.dir.search.v1.SearchService/Search", want: config.ClassBackground},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			assert.Equal(t, tt.want, Classify(cfg, tt.method))
+		})
+	}
+}
@@ -6,6 +6,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // Default rate limiting configuration values.
@@ -61,6 +62,40 @@ type Config struct {
 	// These limits override the per-client limits for specific methods.
 	// This allows protecting expensive operations with stricter limits.
 	MethodLimits map[string]MethodLimit `json:"method_limits,omitempty" mapstructure:"method_limits"`
+
+	// MethodCosts defines optional per-method token costs.
+	// Keys are full gRPC method paths (e.g., "/agntcy.dir.store.v1.StoreService/Push").
+	// A request to a method consumes its cost in tokens from the same bucket that
+	// applies to the client (tier, per-client or global), so that expensive writes
+	// drain the bucket faster than cheap reads. Methods not listed cost one token.
+	// Costs do not apply to method-specific limits, which count requests.
+	MethodCosts map[string]int `json:"method_costs,omitempty" mapstructure:"method_costs"`
+
+	// Tiers defines named rate limit tiers (e.g., "ci", "interactive", "sync-peer")
+	// that can be assigned to authenticated clients through Identities.
+	Tiers map[string]Tier `json:"tiers,omitempty" mapstructure:"tiers"`
+
+	// Identities assigns client identities (SPIFFE IDs) to tiers.
+	// Keys are either exact SPIFFE IDs or prefixes ending with "*"
+	// (e.g., "spiffe://peer.example.org/*"); exact matches take precedence,
+	// then the longest matching prefix. Values are tier names.
+	// Clients without an assigned tier use the per-client limits.
+	Identities map[string]string `json:"identities,omitempty" mapstructure:"identities"`
+}
+
+// Tier defines rate limiting parameters for a named group of client identities.
+// These limits replace the per-client limits for identities assigned to the tier.
+type Tier struct {
+	// Exempt disables rate limiting for identities in this tier,
+	// including method-specific overrides.
+	Exempt bool `json:"exempt,omitempty" mapstructure:"exempt"`
+
+	// RPS defines the requests per second limit for each identity in this tier.
+	// Zero means unlimited.
+	RPS float64 `json:"rps" mapstructure:"rps"`
+
+	// Burst defines the burst capacity for each identity in this tier.
+	Burst int `json:"burst" mapstructure:"burst"`
 }
 
 // MethodLimit defines rate limiting parameters for a specific gRPC method.
@@ -95,6 +130,16 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	// Validate method token costs
+	if err := c.validateMethodCosts(); err != nil {
+		return err
+	}
+
+	// Validate tiers and identity assignments
+	if err := c.validateTiers(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -162,6 +207,100 @@ func (c *Config) validateMethodLimits() error {
 	return nil
 }
 
+// validateMethodCosts validates the per-method token costs.
+// It checks that all costs have valid keys and cost at least one token.
+func (c *Config) validateMethodCosts() error {
+	for method, cost := range c.MethodCosts {
+		if method == "" {
+			return errors.New("method cost key cannot be empty")
+		}
+
+		if cost < 1 {
+			return fmt.Errorf("method %s: cost must be at least 1, got: %d", method, cost)
+		}
+	}
+
+	return nil
+}
+
+// MethodCost returns the number of tokens consumed by a request to a method.
+func (c *Config) MethodCost(method string) int {
+	if cost, exists := c.MethodCosts[method]; exists {
+		return cost
+	}
+
+	return 1
+}
+
+// validateTiers validates the tier configuration and identity assignments.
+// It checks that all tiers have valid names and limits, and that identities
+// are only assigned to configured tiers.
+func (c *Config) validateTiers() error {
+	for name, tier := range c.Tiers {
+		if name == "" {
+			return errors.New("tier name cannot be empty")
+		}
+
+		if tier.RPS < 0 {
+			return fmt.Errorf("tier %s: rps must be non-negative, got: %f", name, tier.RPS)
+		}
+
+		if tier.Burst < 0 {
+			return fmt.Errorf("tier %s: burst must be non-negative, got: %d", name, tier.Burst)
+		}
+
+		// Validate burst capacity relative to rate
+		if tier.RPS > 0 && tier.Burst > 0 && float64(tier.Burst) < tier.RPS {
+			return fmt.Errorf("tier %s: burst (%d) should be >= rps (%f) for optimal performance", name, tier.Burst, tier.RPS)
+		}
+	}
+
+	for identity, tierName := range c.Identities {
+		if identity == "" || identity == "*" {
+			return errors.New("identity cannot be empty")
+		}
+
+		if _, exists := c.Tiers[tierName]; !exists {
+			return fmt.Errorf("identity %s: unknown tier %q", identity, tierName)
+		}
+	}
+
+	return nil
+}
+
+// TierForIdentity returns the name of the tier assigned to a client identity.
+// Exact identity assignments take precedence over prefix assignments, and
+// longer prefixes take precedence over shorter ones.
+// Returns false if no tier is assigned to the identity.
+func (c *Config) TierForIdentity(identity string) (string, bool) {
+	if identity == "" {
+		return "", false
+	}
+
+	if tierName, exists := c.Identities[identity]; exists {
+		return tierName, true
+	}
+
+	var (
+		matchedTier   string
+		matchedPrefix string
+		found         bool
+	)
+
+	for pattern, tierName := range c.Identities {
+		prefix, isPrefix := strings.CutSuffix(pattern, "*")
+		if !isPrefix || !strings.HasPrefix(identity, prefix) {
+			continue
+		}
+
+		if !found || len(prefix) > len(matchedPrefix) {
+			matchedTier, matchedPrefix, found = tierName, prefix, true
+		}
+	}
+
+	return matchedTier, found
+}
+
 // DefaultConfig returns a configuration with sensible default values.
 // Rate limiting is disabled by default for backward compatibility.
 func DefaultConfig() *Config {
@@ -172,5 +311,8 @@ func DefaultConfig() *Config {
 		PerClientRPS:   DefaultPerClientRPS,
 		PerClientBurst: DefaultPerClientBurst,
 		MethodLimits:   make(map[string]MethodLimit),
+		MethodCosts:    make(map[string]int),
+		Tiers:          make(map[string]Tier),
+		Identities:     make(map[string]string),
 	}
 }
@@ -524,6 +524,193 @@ func TestMethodLimit_Validation(t *testing.T) {
 	}
 }
 
+// TestConfig_Validate_Tiers tests validation of tiers and identity assignments.
+func TestConfig_Validate_Tiers(t *testing.T) {
+	tests := []struct {
+		name       string
+		tiers      map[string]Tier
+		identities map[string]string
+		wantErr    bool
+		errMsg     string
+	}{
+		{
+			name: "valid tiers and identities",
+			tiers: map[string]Tier{
+				"ci":        {RPS: 50.0, Burst: 100},
+				"sync-peer": {Exempt: true},
+			},
+			identities: map[string]string{
+				"spiffe://example.org/ci":     "ci",
+				"spiffe://peer.example.org/*": "sync-peer",
+			},
+			wantErr: false,
+		},
+		{
+			name:    "empty tier name should fail",
+			tiers:   map[string]Tier{"": {RPS: 50.0, Burst: 100}},
+			wantErr: true,
+			errMsg:  "tier name cannot be empty",
+		},
+		{
+			name:    "negative tier RPS should fail",
+			tiers:   map[string]Tier{"ci": {RPS: -1.0, Burst: 100}},
+			wantErr: true,
+			errMsg:  "tier ci: rps must be non-negative",
+		},
+		{
+			name:    "burst less than RPS should fail",
+			tiers:   map[string]Tier{"ci": {RPS: 100.0, Burst: 50}},
+			wantErr: true,
+			errMsg:  "tier ci: burst (50) should be >= rps",
+		},
+		{
+			name:       "unknown tier should fail",
+			tiers:      map[string]Tier{"ci": {RPS: 50.0, Burst: 100}},
+			identities: map[string]string{"spiffe://example.org/ci": "interactive"},
+			wantErr:    true,
+			errMsg:     "unknown tier \"interactive\"",
+		},
+		{
+			name:       "match-all identity should fail",
+			tiers:      map[string]Tier{"ci": {RPS: 50.0, Burst: 100}},
+			identities: map[string]string{"*": "ci"},
+			wantErr:    true,
+			errMsg:     "identity cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Enabled = true
+			cfg.Tiers = tt.tiers
+			cfg.Identities = tt.identities
+
+			err := cfg.Validate()
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+
+					return
+				}
+
+				if tt.errMsg != "" && !contains(err.Error(), tt.errMsg) {
+					t.Errorf("Error message = %q, want to contain %q", err.Error(), tt.errMsg)
+				}
+			} else if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestConfig_Validate_MethodCosts tests validation of per-method token costs.
+func TestConfig_Validate_MethodCosts(t *testing.T) {
+	tests := []struct {
+		name    string
+		costs   map[string]int
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid method costs",
+			costs: map[string]int{
+				"/agntcy.dir.store.v1.StoreService/Push":   5,
+				"/agntcy.dir.store.v1.StoreService/Lookup": 1,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "empty method key should fail",
+			costs:   map[string]int{"": 5},
+			wantErr: true,
+			errMsg:  "method cost key cannot be empty",
+		},
+		{
+			name:    "zero cost should fail",
+			costs:   map[string]int{"/agntcy.dir.store.v1.StoreService/Lookup": 0},
+			wantErr: true,
+			errMsg:  "cost must be at least 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Enabled = true
+			cfg.MethodCosts = tt.costs
+
+			err := cfg.Validate()
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+
+					return
+				}
+
+				if tt.errMsg != "" && !contains(err.Error(), tt.errMsg) {
+					t.Errorf("Error message = %q, want to contain %q", err.Error(), tt.errMsg)
+				}
+			} else if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestConfig_MethodCost tests resolution of method token costs.
+func TestConfig_MethodCost(t *testing.T) {
+	cfg := Config{
+		MethodCosts: map[string]int{
+			"/agntcy.dir.store.v1.StoreService/Push": 5,
+		},
+	}
+
+	if cost := cfg.MethodCost("/agntcy.dir.store.v1.StoreService/Push"); cost != 5 {
+		t.Errorf("MethodCost(Push) = %d, want 5", cost)
+	}
+
+	if cost := cfg.MethodCost("/agntcy.dir.store.v1.StoreService/Lookup"); cost != 1 {
+		t.Errorf("MethodCost(Lookup) = %d, want 1", cost)
+	}
+}
+
+// TestConfig_TierForIdentity tests resolution of identities to tiers.
+func TestConfig_TierForIdentity(t *testing.T) {
+	cfg := Config{
+		Identities: map[string]string{
+			"spiffe://example.org/ci":          "ci",
+			"spiffe://example.org/*":           "interactive",
+			"spiffe://example.org/sync/*":      "sync-peer",
+			"spiffe://example.org/sync/legacy": "interactive",
+		},
+	}
+
+	tests := []struct {
+		identity string
+		wantTier string
+		wantOK   bool
+	}{
+		{identity: "spiffe://example.org/ci", wantTier: "ci", wantOK: true},
+		{identity: "spiffe://example.org/user", wantTier: "interactive", wantOK: true},
+		{identity: "spiffe://example.org/sync/peer1", wantTier: "sync-peer", wantOK: true},
+		{identity: "spiffe://example.org/sync/legacy", wantTier: "interactive", wantOK: true},
+		{identity: "spiffe://other.org/user", wantOK: false},
+		{identity: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.identity, func(t *testing.T) {
+			tier, ok := cfg.TierForIdentity(tt.identity)
+			if ok != tt.wantOK || tier != tt.wantTier {
+				t.Errorf("TierForIdentity(%q) = (%q, %v), want (%q, %v)", tt.identity, tier, ok, tt.wantTier, tt.wantOK)
+			}
+		})
+	}
+}
+
 // contains checks if a string contains a substring.
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(substr) == 0 ||
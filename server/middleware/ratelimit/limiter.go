@@ -8,18 +8,33 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	"github.com/agntcy/dir/server/authn"
 	"github.com/agntcy/dir/server/middleware/ratelimit/config"
 	"github.com/agntcy/dir/utils/logging"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 var logger = logging.Logger("ratelimit")
 
+const (
+	// instanceKeyPrefix prefixes the limiter keys of client instance IDs,
+	// so they cannot collide with SPIFFE IDs.
+	instanceKeyPrefix = "instance:"
+
+	// maxInstanceLimiters bounds the number of per-instance limiters.
+	// Client instance IDs are not authenticated, so clients could otherwise
+	// create an unbounded number of limiters by rotating IDs.
+	maxInstanceLimiters = 10000
+)
+
 // Limiter defines the interface for rate limiting operations.
 // This interface matches the go-grpc-middleware/v2 Limiter interface,
 // allowing this implementation to be used with standard interceptors.
@@ -47,6 +62,9 @@ type ClientLimiter struct {
 	// globalLimiter is the fallback rate limiter for unauthenticated clients
 	globalLimiter *rate.Limiter
 
+	// instanceLimiters counts the limiters created for client instance IDs
+	instanceLimiters atomic.Int64
+
 	// config holds the rate limiting configuration
 	config *config.Config
 }
@@ -88,6 +106,8 @@ func NewClientLimiter(cfg *config.Config) (*ClientLimiter, error) {
 		"per_client_rps", cfg.PerClientRPS,
 		"per_client_burst", cfg.PerClientBurst,
 		"method_overrides", len(cfg.MethodLimits),
+		"tiers", len(cfg.Tiers),
+		"identities", len(cfg.Identities),
 	)
 
 	return &ClientLimiter{
@@ -105,10 +125,19 @@ func NewClientLimiter(cfg *config.Config) (*ClientLimiter, error) {
 // - Returns codes.ResourceExhausted error if rate limited
 //
 // The method checks rate limits in the following order:
-// 1. If rate limiting is disabled, always allow
+// 1. If rate limiting is disabled or the client's tier is exempt, always allow
 // 2. Check for method-specific override
-// 3. Check per-client limit (if clientID provided)
-// 4. Fall back to global limit (for anonymous/unauthenticated clients).
+// 3. Check tier limit (if clientID is assigned to a tier)
+// 4. Check per-client limit (if clientID provided)
+// 5. Fall back to global limit (for anonymous/unauthenticated clients).
+//
+// Unauthenticated clients sending a client instance ID are additionally limited
+// per instance with the per-client limits, so that automation sources sharing
+// the global limit can be told apart. The global limit still applies to them.
+//
+// Requests consume the token cost of their method (one token by default) from
+// the tier, per-client, instance and global buckets, and one token from
+// method-specific buckets.
 func (l *ClientLimiter) Limit(ctx context.Context) error {
 	// If rate limiting is disabled, always allow
 	if !l.config.Enabled {
@@ -121,8 +150,24 @@ func (l *ClientLimiter) Limit(ctx context.Context) error {
 	// Extract method name from context
 	method, _ := grpc.Method(ctx)
 
-	// Get the appropriate rate limiter
-	limiter := l.getLimiterForRequest(clientID, method)
+	// Resolve the number of tokens the request consumes from shared buckets
+	cost := l.config.MethodCost(method)
+
+	// Check the per-instance limit of unauthenticated clients first,
+	// so that rejected requests do not consume global tokens
+	if clientID == "" {
+		if instanceID, instanceLimiter := l.getInstanceLimiter(ctx); instanceLimiter != nil && !allow(instanceLimiter, cost) {
+			logger.Warn("Rate limit exceeded",
+				"client_instance_id", instanceID,
+				"method", method,
+			)
+
+			return status.Error(codes.ResourceExhausted, "rate limit exceeded") //nolint:wrapcheck // gRPC status error for client
+		}
+	}
+
+	// Get the appropriate rate limiter and the number of tokens to consume from it
+	limiter, tokens := l.getLimiterForRequest(clientID, method, cost)
 
 	// If no limiter is configured (both client and global limiters are nil or zero-rate),
 	// allow the request
@@ -131,7 +176,7 @@ func (l *ClientLimiter) Limit(ctx context.Context) error {
 	}
 
 	// Check if request is allowed by the token bucket
-	if !limiter.Allow() {
+	if !allow(limiter, tokens) {
 		logger.Warn("Rate limit exceeded",
 			"client_id", clientID,
 			"method", method,
@@ -156,31 +201,101 @@ func extractClientID(ctx context.Context) string {
 	return ""
 }
 
+// extractClientInstanceID extracts the client instance ID from the gRPC metadata.
+// It returns an empty string if no ID was sent or the ID is malformed.
+func extractClientInstanceID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(corev1.ClientInstanceIDMetadataKey)
+	if len(values) == 0 || corev1.ValidateClientInstanceID(values[0]) != nil {
+		return ""
+	}
+
+	return values[0]
+}
+
+// getInstanceLimiter returns the client instance ID of the request and its rate limiter.
+// Returns a nil limiter if the request has no client instance ID, per-client limits
+// are not configured, or the maximum number of instance limiters has been reached.
+func (l *ClientLimiter) getInstanceLimiter(ctx context.Context) (string, *rate.Limiter) {
+	if l.config.PerClientRPS <= 0 {
+		return "", nil
+	}
+
+	instanceID := extractClientInstanceID(ctx)
+	if instanceID == "" {
+		return "", nil
+	}
+
+	key := instanceKeyPrefix + instanceID
+
+	if _, exists := l.limiters.Load(key); !exists {
+		if l.instanceLimiters.Load() >= maxInstanceLimiters {
+			return instanceID, nil
+		}
+
+		l.instanceLimiters.Add(1)
+	}
+
+	return instanceID, l.getOrCreateLimiter(key, l.config.PerClientRPS, l.config.PerClientBurst)
+}
+
 // getLimiterForRequest returns the appropriate rate limiter for a request.
 // It checks in order:
-// 1. Method-specific override (if configured)
-// 2. Per-client limiter (if clientID provided)
-// 3. Global limiter (fallback)
+// 1. Exempt tier (no limiter)
+// 2. Method-specific override (if configured)
+// 3. Tier limiter (if clientID is assigned to a tier)
+// 4. Per-client limiter (if clientID provided)
+// 5. Global limiter (fallback)
 //
+// It also returns the number of tokens the request consumes from the limiter,
+// which is the given method cost for all but method-specific limiters.
 // Returns nil if no rate limiter is applicable.
-func (l *ClientLimiter) getLimiterForRequest(clientID string, method string) *rate.Limiter {
+func (l *ClientLimiter) getLimiterForRequest(clientID string, method string, cost int) (*rate.Limiter, int) {
+	// Resolve the tier assigned to the client, anonymous clients never have one
+	tierName, hasTier := l.config.TierForIdentity(clientID)
+	tier := l.config.Tiers[tierName]
+
+	if hasTier && tier.Exempt {
+		return nil, 0
+	}
+
 	// Check for method-specific override first
 	if method != "" {
 		if methodLimit, exists := l.config.MethodLimits[method]; exists {
 			// Create a unique key combining client and method
 			key := fmt.Sprintf("%s:%s", clientID, method)
 
-			return l.getOrCreateLimiter(key, methodLimit.RPS, methodLimit.Burst)
+			return l.getOrCreateLimiter(key, methodLimit.RPS, methodLimit.Burst), 1
 		}
 	}
 
+	// If client is assigned to a tier, use the tier limits
+	if hasTier {
+		return l.getOrCreateLimiter(clientID, tier.RPS, tier.Burst), cost
+	}
+
 	// If client ID is provided, use per-client limiter
 	if clientID != "" && l.config.PerClientRPS > 0 {
-		return l.getOrCreateLimiter(clientID, l.config.PerClientRPS, l.config.PerClientBurst)
+		return l.getOrCreateLimiter(clientID, l.config.PerClientRPS, l.config.PerClientBurst), cost
 	}
 
 	// Fall back to global limiter
-	return l.globalLimiter
+	return l.globalLimiter, cost
+}
+
+// allow reports whether the limiter has enough tokens for a request and consumes them.
+// Costs above the burst capacity are capped to it, so that such requests are
+// still allowed once the bucket is full instead of always being rejected.
+func allow(limiter *rate.Limiter, tokens int) bool {
+	if burst := limiter.Burst(); burst > 0 && tokens > burst {
+		tokens = burst
+	}
+
+	return limiter.AllowN(time.Now(), tokens)
 }
 
 // getOrCreateLimiter gets an existing rate limiter or creates a new one.
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	"github.com/agntcy/dir/server/authn"
 	"github.com/agntcy/dir/server/middleware/ratelimit/config"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
@@ -36,6 +37,11 @@ func contextWithClientAndMethod(clientID string, method string) context.Context
 	return ctx
 }
 
+// contextWithInstanceAndMethod creates a context with a client instance ID and gRPC method for testing.
+func contextWithInstanceAndMethod(instanceID string, method string) context.Context {
+	return metadata.NewIncomingContext(contextWithMethod(method), metadata.Pairs(corev1.ClientInstanceIDMetadataKey, instanceID))
+}
+
 // mockServerTransportStream is a minimal implementation for setting method in context.
 type mockServerTransportStream struct {
 	method string
@@ -219,6 +225,56 @@ func TestClientLimiter_Limit_GlobalLimiting(t *testing.T) {
 	}
 }
 
+func TestClientLimiter_Limit_ClientInstances(t *testing.T) {
+	cfg := &config.Config{
+		Enabled:        true,
+		GlobalRPS:      1.0,
+		GlobalBurst:    15,
+		PerClientRPS:   1.0,
+		PerClientBurst: 10,
+		MethodLimits:   make(map[string]config.MethodLimit),
+	}
+
+	limiter, err := NewClientLimiter(cfg)
+	if err != nil {
+		t.Fatalf("NewClientLimiter() error: %v", err)
+	}
+
+	ctx1 := contextWithInstanceAndMethod("instance-1", "/test/Method")
+	ctx2 := contextWithInstanceAndMethod("instance-2", "/test/Method")
+
+	// Instance 1: Exhaust its own burst capacity
+	for i := range 10 {
+		if err := limiter.Limit(ctx1); err != nil {
+			t.Errorf("Request %d should be allowed (within instance burst), got error: %v", i+1, err)
+		}
+	}
+
+	if err := limiter.Limit(ctx1); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Instance 1 request 11 should be rate limited, got: %v", err)
+	}
+
+	// Instance 2: Has its own limiter, but remains bound by the global limit (5 tokens left)
+	for i := range 5 {
+		if err := limiter.Limit(ctx2); err != nil {
+			t.Errorf("Instance 2 request %d should be allowed, got error: %v", i+1, err)
+		}
+	}
+
+	if err := limiter.Limit(ctx2); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Instance 2 request 6 should be limited by the global limit, got: %v", err)
+	}
+
+	// Malformed instance IDs are ignored and only the global limit applies
+	if err := limiter.Limit(contextWithInstanceAndMethod("not valid", "/test/Method")); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Request with malformed instance ID should be limited by the global limit, got: %v", err)
+	}
+
+	if count := limiter.GetLimiterCount(); count != 2 {
+		t.Errorf("GetLimiterCount() = %d, want 2", count)
+	}
+}
+
 func TestClientLimiter_Limit_MethodOverrides(t *testing.T) {
 	cfg := &config.Config{
 		Enabled:        true,
@@ -477,6 +533,158 @@ func TestClientLimiter_ZeroRPS(t *testing.T) {
 	}
 }
 
+func TestClientLimiter_Limit_Tiers(t *testing.T) {
+	cfg := &config.Config{
+		Enabled:        true,
+		GlobalRPS:      10.0,
+		GlobalBurst:    10,
+		PerClientRPS:   10.0,
+		PerClientBurst: 10,
+		MethodLimits: map[string]config.MethodLimit{
+			"/expensive/Method": {
+				RPS:   1.0,
+				Burst: 1,
+			},
+		},
+		Tiers: map[string]config.Tier{
+			"ci":        {RPS: 50.0, Burst: 50},
+			"sync-peer": {Exempt: true},
+		},
+		Identities: map[string]string{
+			"spiffe://example.org/ci":     "ci",
+			"spiffe://peer.example.org/*": "sync-peer",
+		},
+	}
+
+	limiter, err := NewClientLimiter(cfg)
+	if err != nil {
+		t.Fatalf("NewClientLimiter() error: %v", err)
+	}
+
+	// Tier limits replace the per-client limits (burst 50 instead of 10)
+	ctxCI := contextWithClientAndMethod("spiffe://example.org/ci", "/test/Method")
+	for i := range 50 {
+		if err := limiter.Limit(ctxCI); err != nil {
+			t.Errorf("CI request %d should be allowed (within tier burst), got error: %v", i+1, err)
+		}
+	}
+
+	if err := limiter.Limit(ctxCI); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("CI request 51 should be rate limited, got: %v", err)
+	}
+
+	// Exempt tier is never limited, not even by method overrides
+	for _, method := range []string{"/test/Method", "/expensive/Method"} {
+		ctxPeer := contextWithClientAndMethod("spiffe://peer.example.org/dir", method)
+		for i := range 100 {
+			if err := limiter.Limit(ctxPeer); err != nil {
+				t.Errorf("Sync peer request %d to %s should be allowed (exempt), got error: %v", i+1, method, err)
+			}
+		}
+	}
+
+	// Clients without a tier use the per-client limits
+	ctxUser := contextWithClientAndMethod("spiffe://example.org/user", "/test/Method")
+	for i := range 10 {
+		if err := limiter.Limit(ctxUser); err != nil {
+			t.Errorf("User request %d should be allowed (within burst), got error: %v", i+1, err)
+		}
+	}
+
+	if err := limiter.Limit(ctxUser); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("User request 11 should be rate limited, got: %v", err)
+	}
+
+	// Anonymous clients stay constrained by the global limit
+	ctxAnonymous := contextWithMethod("/test/Method")
+	for range 10 {
+		_ = limiter.Limit(ctxAnonymous)
+	}
+
+	if err := limiter.Limit(ctxAnonymous); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Anonymous request 11 should be rate limited, got: %v", err)
+	}
+}
+
+func TestClientLimiter_Limit_MethodCosts(t *testing.T) {
+	cfg := &config.Config{
+		Enabled:        true,
+		GlobalRPS:      1.0,
+		GlobalBurst:    10,
+		PerClientRPS:   1.0,
+		PerClientBurst: 10,
+		MethodLimits: map[string]config.MethodLimit{
+			"/limited/Method": {RPS: 1.0, Burst: 2},
+		},
+		MethodCosts: map[string]int{
+			"/store/Push":     5,
+			"/limited/Method": 5,
+			"/huge/Method":    50,
+		},
+	}
+
+	limiter, err := NewClientLimiter(cfg)
+	if err != nil {
+		t.Fatalf("NewClientLimiter() error: %v", err)
+	}
+
+	// Two pushes drain the per-client bucket (2 x 5 tokens)
+	ctxPush := contextWithClientAndMethod("spiffe://example.org/client1", "/store/Push")
+	for i := range 2 {
+		if err := limiter.Limit(ctxPush); err != nil {
+			t.Errorf("Push %d should be allowed (within burst), got error: %v", i+1, err)
+		}
+	}
+
+	if err := limiter.Limit(ctxPush); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Push 3 should be rate limited, got: %v", err)
+	}
+
+	// Reads of the same client consume from the same, now empty, bucket
+	ctxLookup := contextWithClientAndMethod("spiffe://example.org/client1", "/store/Lookup")
+	if err := limiter.Limit(ctxLookup); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Lookup after pushes should be rate limited, got: %v", err)
+	}
+
+	// Cheap reads of another client are allowed up to the full burst
+	ctxOtherLookup := contextWithClientAndMethod("spiffe://example.org/client2", "/store/Lookup")
+	for i := range 10 {
+		if err := limiter.Limit(ctxOtherLookup); err != nil {
+			t.Errorf("Lookup %d should be allowed (within burst), got error: %v", i+1, err)
+		}
+	}
+
+	// Costs do not apply to method-specific limits, which count requests
+	ctxLimited := contextWithClientAndMethod("spiffe://example.org/client3", "/limited/Method")
+	for i := range 2 {
+		if err := limiter.Limit(ctxLimited); err != nil {
+			t.Errorf("Limited method request %d should be allowed (within method burst), got error: %v", i+1, err)
+		}
+	}
+
+	// Costs above the burst capacity are capped, so the request is allowed on a full bucket
+	ctxHuge := contextWithClientAndMethod("spiffe://example.org/client4", "/huge/Method")
+	if err := limiter.Limit(ctxHuge); err != nil {
+		t.Errorf("Request costing more than the burst should be allowed on a full bucket, got error: %v", err)
+	}
+
+	if err := limiter.Limit(ctxHuge); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Second request costing more than the burst should be rate limited, got: %v", err)
+	}
+
+	// Anonymous clients pay the cost against the global bucket
+	ctxAnonymous := contextWithMethod("/store/Push")
+	for i := range 2 {
+		if err := limiter.Limit(ctxAnonymous); err != nil {
+			t.Errorf("Anonymous push %d should be allowed (within global burst), got error: %v", i+1, err)
+		}
+	}
+
+	if err := limiter.Limit(ctxAnonymous); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Anonymous push 3 should be rate limited, got: %v", err)
+	}
+}
+
 // TestClientLimiter_PanicOnInvalidTypeInMap tests the defensive panic
 // when an invalid type is stored in the limiters map.
 // This should never happen in normal operation but protects against internal bugs.
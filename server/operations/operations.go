@@ -0,0 +1,361 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package operations runs long-running operations in the background and tracks
+// their state, so that clients can poll, wait for or cancel them instead of
+// holding a connection open while they run.
+//
+// Operations are only visible to the identity that started them. Bulk unpublishing,
+// cache warming, consistency checks with repair, which reindex stored records,
+// garbage collection of the content store and record re-signing run as operations.
+// Record export and import run in dirctl, which streams the records to and from
+// local archives and external registries; they are not server operations yet.
+package operations
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/utils/logging"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var logger = logging.Logger("operations")
+
+const (
+	// DefaultRetention is how long finished operations are kept.
+	DefaultRetention = time.Hour
+
+	// DefaultMaxOperations bounds the number of tracked operations.
+	// When it is reached, the oldest finished operations are forgotten first.
+	DefaultMaxOperations = 1000
+)
+
+// RunFunc runs an operation until it completes or ctx is canceled.
+// It reports its progress through progress and returns the response of the operation.
+type RunFunc func(ctx context.Context, progress *Progress) (proto.Message, error)
+
+// operation is a tracked operation.
+type operation struct {
+	owner string // Identity that started the operation
+
+	mu     sync.Mutex
+	state  *corev1.Operation
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+// snapshot returns a copy of the operation state.
+func (o *operation) snapshot() *corev1.Operation {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return proto.Clone(o.state).(*corev1.Operation) //nolint:forcetypeassert
+}
+
+// Progress reports the progress of an operation.
+// A nil Progress can be used by code that runs both as an operation and synchronously.
+type Progress struct {
+	op *operation
+}
+
+// SetTotal sets the total number of items the operation processes.
+func (p *Progress) SetTotal(total int) {
+	if p == nil {
+		return
+	}
+
+	p.op.mu.Lock()
+	defer p.op.mu.Unlock()
+
+	p.op.state.Total = uint64(max(total, 0))
+	p.op.state.UpdatedAt = timestamppb.Now()
+}
+
+// Add adds to the number of items processed by the operation.
+func (p *Progress) Add(processed int) {
+	if p == nil {
+		return
+	}
+
+	p.op.mu.Lock()
+	defer p.op.mu.Unlock()
+
+	p.op.state.Processed += uint64(max(processed, 0))
+	p.op.state.UpdatedAt = timestamppb.Now()
+}
+
+// Manager runs and tracks operations.
+type Manager struct {
+	mu         sync.Mutex
+	operations map[string]*operation
+
+	retention     time.Duration
+	maxOperations int
+
+	ctx    context.Context //nolint:containedctx
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a new operation manager.
+func New() *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Manager{
+		operations:    make(map[string]*operation),
+		retention:     DefaultRetention,
+		maxOperations: DefaultMaxOperations,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+// Start starts an operation of the given type in the background on behalf of owner,
+// and returns its ID. The operation does not inherit the cancellation of the request
+// that started it.
+func (m *Manager) Start(opType, owner string, run RunFunc) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.ctx.Err() != nil {
+		return "", status.Error(codes.Unavailable, "server is shutting down") //nolint:wrapcheck
+	}
+
+	m.evictLocked()
+
+	if len(m.operations) >= m.maxOperations {
+		return "", status.Errorf(codes.ResourceExhausted, "too many running operations (max %d)", m.maxOperations)
+	}
+
+	now := timestamppb.Now()
+	ctx, cancel := context.WithCancel(m.ctx)
+
+	op := &operation{
+		owner: owner,
+		state: &corev1.Operation{
+			Id:        uuid.NewString(),
+			Type:      opType,
+			State:     corev1.OperationState_OPERATION_STATE_RUNNING,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		cancel: cancel,
+		doneCh: make(chan struct{}),
+	}
+
+	m.operations[op.state.GetId()] = op
+
+	m.wg.Add(1)
+
+	go func() {
+		defer m.wg.Done()
+		defer cancel()
+
+		resp, err := run(ctx, &Progress{op: op})
+		m.finish(op, resp, err, ctx.Err() != nil)
+	}()
+
+	logger.Info("Operation started", "id", op.state.GetId(), "type", opType, "owner", owner)
+
+	return op.state.GetId(), nil
+}
+
+// finish records the outcome of an operation.
+// Operations failing after they were cancelled are recorded as cancelled.
+func (m *Manager) finish(op *operation, resp proto.Message, err error, cancelled bool) {
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	defer close(op.doneCh)
+
+	state := op.state
+	state.Done = true
+	state.UpdatedAt = timestamppb.Now()
+
+	switch {
+	case err == nil:
+		state.State = corev1.OperationState_OPERATION_STATE_SUCCEEDED
+
+		if resp != nil {
+			response, marshalErr := anypb.New(resp)
+			if marshalErr != nil {
+				state.State = corev1.OperationState_OPERATION_STATE_FAILED
+				state.ErrorMessage = "failed to marshal operation response: " + marshalErr.Error()
+				state.ErrorCode = uint32(codes.Internal)
+
+				break
+			}
+
+			state.Response = response
+		}
+	case cancelled || errors.Is(err, context.Canceled) || status.Code(err) == codes.Canceled:
+		state.State = corev1.OperationState_OPERATION_STATE_CANCELLED
+		state.ErrorMessage = err.Error()
+		state.ErrorCode = uint32(codes.Canceled)
+	default:
+		state.State = corev1.OperationState_OPERATION_STATE_FAILED
+		state.ErrorMessage = status.Convert(err).Message()
+		state.ErrorCode = uint32(status.Code(err)) //nolint:gosec // gRPC codes are small positive numbers
+	}
+
+	logger.Info("Operation finished", "id", state.GetId(), "type", state.GetType(), "state", state.GetState().String(),
+		"processed", state.GetProcessed(), "error", state.GetErrorMessage())
+}
+
+// evictLocked forgets finished operations past their retention period and,
+// if the manager is full, the oldest finished operations.
+func (m *Manager) evictLocked() {
+	cutoff := time.Now().Add(-m.retention)
+
+	var finished []*corev1.Operation
+
+	for id, op := range m.operations {
+		state := op.snapshot()
+		if !state.GetDone() {
+			continue
+		}
+
+		if state.GetUpdatedAt().AsTime().Before(cutoff) {
+			delete(m.operations, id)
+
+			continue
+		}
+
+		finished = append(finished, state)
+	}
+
+	if len(m.operations) < m.maxOperations {
+		return
+	}
+
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].GetUpdatedAt().AsTime().Before(finished[j].GetUpdatedAt().AsTime())
+	})
+
+	for _, state := range finished {
+		if len(m.operations) < m.maxOperations {
+			return
+		}
+
+		delete(m.operations, state.GetId())
+	}
+}
+
+// get returns a tracked operation of the owner, or a NotFound error.
+// Operations of other owners are reported as not found, so that their IDs are not disclosed.
+func (m *Manager) get(id, owner string) (*operation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	op, ok := m.operations[id]
+	if !ok || op.owner != owner {
+		return nil, status.Errorf(codes.NotFound, "operation %s not found", id)
+	}
+
+	return op, nil
+}
+
+// Get returns the latest state of an operation of the owner.
+func (m *Manager) Get(id, owner string) (*corev1.Operation, error) {
+	op, err := m.get(id, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return op.snapshot(), nil
+}
+
+// List returns the tracked operations of the owner, most recently created first.
+// If opType is set, only operations of that type are returned.
+// If runningOnly is set, only operations that are not done are returned.
+func (m *Manager) List(owner, opType string, runningOnly bool) []*corev1.Operation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	states := make([]*corev1.Operation, 0, len(m.operations))
+
+	for _, op := range m.operations {
+		if op.owner != owner {
+			continue
+		}
+
+		state := op.snapshot()
+
+		if opType != "" && state.GetType() != opType {
+			continue
+		}
+
+		if runningOnly && state.GetDone() {
+			continue
+		}
+
+		states = append(states, state)
+	}
+
+	sort.Slice(states, func(i, j int) bool {
+		a, b := states[i].GetCreatedAt().AsTime(), states[j].GetCreatedAt().AsTime()
+		if !a.Equal(b) {
+			return a.After(b)
+		}
+
+		return states[i].GetId() < states[j].GetId()
+	})
+
+	return states
+}
+
+// Wait waits until an operation of the owner is done, the timeout elapses or ctx is canceled,
+// and returns its latest state.
+func (m *Manager) Wait(ctx context.Context, id, owner string, timeout time.Duration) (*corev1.Operation, error) {
+	op, err := m.get(id, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-op.doneCh:
+	case <-timer.C:
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err() //nolint:wrapcheck
+	}
+
+	return op.snapshot(), nil
+}
+
+// Cancel requests the cancellation of an operation of the owner and returns its latest state.
+// Cancelling a finished operation has no effect.
+func (m *Manager) Cancel(id, owner string) (*corev1.Operation, error) {
+	op, err := m.get(id, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	op.cancel()
+
+	logger.Info("Operation cancellation requested", "id", id)
+
+	return op.snapshot(), nil
+}
+
+// Stop cancels the running operations and waits for them to finish.
+func (m *Manager) Stop() error {
+	m.mu.Lock()
+	m.cancel()
+	m.mu.Unlock()
+
+	m.wg.Wait()
+
+	return nil
+}
@@ -0,0 +1,266 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const testOwner = "spiffe://example.org/owner"
+
+func newTestManager(t *testing.T) *Manager {
+	t.Helper()
+
+	m := New()
+	t.Cleanup(func() { _ = m.Stop() })
+
+	return m
+}
+
+func waitDone(t *testing.T, m *Manager, id string) *corev1.Operation {
+	t.Helper()
+
+	op, err := m.Wait(context.Background(), id, testOwner, 5*time.Second)
+	require.NoError(t, err)
+	require.True(t, op.GetDone(), "operation did not finish in time")
+
+	return op
+}
+
+// blockUntilCancelled runs until the operation is cancelled.
+func blockUntilCancelled(ctx context.Context, _ *Progress) (proto.Message, error) {
+	<-ctx.Done()
+
+	return nil, ctx.Err()
+}
+
+func TestManager_Succeeded(t *testing.T) {
+	m := newTestManager(t)
+
+	id, err := m.Start("test", testOwner, func(_ context.Context, progress *Progress) (proto.Message, error) {
+		progress.SetTotal(2)
+		progress.Add(1)
+		progress.Add(1)
+
+		return wrapperspb.String("result"), nil
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, id)
+
+	op := waitDone(t, m, id)
+	assert.Equal(t, id, op.GetId())
+	assert.Equal(t, "test", op.GetType())
+	assert.Equal(t, corev1.OperationState_OPERATION_STATE_SUCCEEDED, op.GetState())
+	assert.Equal(t, uint64(2), op.GetProcessed())
+	assert.Equal(t, uint64(2), op.GetTotal())
+	assert.Empty(t, op.GetErrorMessage())
+
+	resp := &wrapperspb.StringValue{}
+	require.NoError(t, op.GetResponse().UnmarshalTo(resp))
+	assert.Equal(t, "result", resp.GetValue())
+}
+
+func TestManager_Failed(t *testing.T) {
+	m := newTestManager(t)
+
+	id, err := m.Start("test", testOwner, func(context.Context, *Progress) (proto.Message, error) {
+		return nil, status.Error(codes.NotFound, "record not found")
+	})
+	require.NoError(t, err)
+
+	op := waitDone(t, m, id)
+	assert.Equal(t, corev1.OperationState_OPERATION_STATE_FAILED, op.GetState())
+	assert.Equal(t, "record not found", op.GetErrorMessage())
+	assert.Equal(t, uint32(codes.NotFound), op.GetErrorCode())
+	assert.Nil(t, op.GetResponse())
+
+	id, err = m.Start("test", testOwner, func(context.Context, *Progress) (proto.Message, error) {
+		return nil, errors.New("plain error")
+	})
+	require.NoError(t, err)
+
+	op = waitDone(t, m, id)
+	assert.Equal(t, corev1.OperationState_OPERATION_STATE_FAILED, op.GetState())
+	assert.Equal(t, uint32(codes.Unknown), op.GetErrorCode())
+}
+
+func TestManager_Cancel(t *testing.T) {
+	m := newTestManager(t)
+
+	id, err := m.Start("test", testOwner, blockUntilCancelled)
+	require.NoError(t, err)
+
+	op, err := m.Get(id, testOwner)
+	require.NoError(t, err)
+	assert.Equal(t, corev1.OperationState_OPERATION_STATE_RUNNING, op.GetState())
+	assert.False(t, op.GetDone())
+
+	_, err = m.Cancel(id, testOwner)
+	require.NoError(t, err)
+
+	op = waitDone(t, m, id)
+	assert.Equal(t, corev1.OperationState_OPERATION_STATE_CANCELLED, op.GetState())
+	assert.Equal(t, uint32(codes.Canceled), op.GetErrorCode())
+
+	// Cancelling a finished operation has no effect
+	op, err = m.Cancel(id, testOwner)
+	require.NoError(t, err)
+	assert.Equal(t, corev1.OperationState_OPERATION_STATE_CANCELLED, op.GetState())
+}
+
+func TestManager_NotFound(t *testing.T) {
+	m := newTestManager(t)
+
+	_, err := m.Get("unknown", testOwner)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = m.Wait(context.Background(), "unknown", testOwner, time.Second)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = m.Cancel("unknown", testOwner)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestManager_Owner(t *testing.T) {
+	m := newTestManager(t)
+
+	id, err := m.Start("test", testOwner, blockUntilCancelled)
+	require.NoError(t, err)
+
+	// Operations of other owners are not found
+	other := "spiffe://example.org/other"
+
+	_, err = m.Get(id, other)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = m.Wait(context.Background(), id, other, time.Second)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = m.Cancel(id, other)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	assert.Empty(t, m.List(other, "", false))
+	assert.Len(t, m.List(testOwner, "", false), 1)
+
+	op, err := m.Get(id, testOwner)
+	require.NoError(t, err)
+	assert.False(t, op.GetDone())
+}
+
+func TestManager_Wait(t *testing.T) {
+	m := newTestManager(t)
+
+	id, err := m.Start("test", testOwner, blockUntilCancelled)
+	require.NoError(t, err)
+
+	// Returns the running operation when the timeout elapses
+	op, err := m.Wait(context.Background(), id, testOwner, 10*time.Millisecond)
+	require.NoError(t, err)
+	assert.False(t, op.GetDone())
+
+	// Fails when the caller goes away
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = m.Wait(ctx, id, testOwner, time.Minute)
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestManager_List(t *testing.T) {
+	m := newTestManager(t)
+
+	done, err := m.Start("unpublish", testOwner, func(context.Context, *Progress) (proto.Message, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+	waitDone(t, m, done)
+
+	time.Sleep(time.Millisecond)
+
+	running, err := m.Start("warm_cache", testOwner, blockUntilCancelled)
+	require.NoError(t, err)
+
+	ids := func(ops []*corev1.Operation) []string {
+		result := make([]string, 0, len(ops))
+		for _, op := range ops {
+			result = append(result, op.GetId())
+		}
+
+		return result
+	}
+
+	assert.Equal(t, []string{running, done}, ids(m.List(testOwner, "", false)))
+	assert.Equal(t, []string{running}, ids(m.List(testOwner, "", true)))
+	assert.Equal(t, []string{done}, ids(m.List(testOwner, "unpublish", false)))
+	assert.Empty(t, m.List(testOwner, "unpublish", true))
+}
+
+func TestManager_Eviction(t *testing.T) {
+	m := newTestManager(t)
+	m.maxOperations = 2
+
+	first, err := m.Start("test", testOwner, func(context.Context, *Progress) (proto.Message, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+	waitDone(t, m, first)
+
+	_, err = m.Start("test", testOwner, blockUntilCancelled)
+	require.NoError(t, err)
+
+	// The oldest finished operation is forgotten to make room
+	_, err = m.Start("test", testOwner, blockUntilCancelled)
+	require.NoError(t, err)
+
+	_, err = m.Get(first, testOwner)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Running operations are never forgotten
+	_, err = m.Start("test", testOwner, blockUntilCancelled)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestManager_Retention(t *testing.T) {
+	m := newTestManager(t)
+	m.retention = 0
+
+	id, err := m.Start("test", testOwner, func(context.Context, *Progress) (proto.Message, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+	waitDone(t, m, id)
+
+	_, err = m.Start("test", testOwner, blockUntilCancelled)
+	require.NoError(t, err)
+
+	_, err = m.Get(id, testOwner)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestManager_Stop(t *testing.T) {
+	m := New()
+
+	id, err := m.Start("test", testOwner, blockUntilCancelled)
+	require.NoError(t, err)
+
+	require.NoError(t, m.Stop())
+
+	op, err := m.Get(id, testOwner)
+	require.NoError(t, err)
+	assert.Equal(t, corev1.OperationState_OPERATION_STATE_CANCELLED, op.GetState())
+
+	_, err = m.Start("test", testOwner, blockUntilCancelled)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,59 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/types"
+	"google.golang.org/grpc"
+)
+
+// Option configures a Server created with New.
+// Options allow other Go programs to embed a Directory node with custom implementations.
+type Option func(*serverOptions)
+
+type serverOptions struct {
+	store        types.StoreAPI
+	database     types.DatabaseAPI
+	eventService *events.Service
+	services     []registeredService
+}
+
+// registeredService is an additional gRPC service served by the embedded server.
+type registeredService struct {
+	desc *grpc.ServiceDesc
+	impl any
+}
+
+// WithStore sets the store implementation used instead of the configured store provider.
+// The store is wrapped to emit record events, like the built-in providers.
+func WithStore(store types.StoreAPI) Option {
+	return func(o *serverOptions) {
+		o.store = store
+	}
+}
+
+// WithDatabase sets the database implementation used instead of the configured database type.
+func WithDatabase(database types.DatabaseAPI) Option {
+	return func(o *serverOptions) {
+		o.database = database
+	}
+}
+
+// WithEventService sets the event service used instead of one created from the events config.
+// This allows embedding programs to subscribe to server events in-process.
+// The server takes ownership of the event service and stops it on close.
+func WithEventService(eventService *events.Service) Option {
+	return func(o *serverOptions) {
+		o.eventService = eventService
+	}
+}
+
+// WithService registers an additional gRPC service on the server.
+// The service goes through the same interceptors as the built-in services.
+func WithService(desc *grpc.ServiceDesc, impl any) Option {
+	return func(o *serverOptions) {
+		o.services = append(o.services, registeredService{desc: desc, impl: impl})
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+// Config is the configuration of server plugins.
+// Plugins can only be configured in the config file.
+type Config struct {
+	// Manifests of the plugins to load at startup.
+	// Plugins are started in order and stopped in reverse order.
+	Manifests []Manifest `json:"manifests,omitempty" mapstructure:"manifests"`
+}
+
+// Manifest describes a plugin to load.
+type Manifest struct {
+	// Name identifies the plugin in logs and errors.
+	Name string `json:"name,omitempty" mapstructure:"name"`
+
+	// Path to the plugin shared object built with -buildmode=plugin.
+	Path string `json:"path,omitempty" mapstructure:"path"`
+
+	// Disabled skips loading the plugin.
+	Disabled bool `json:"disabled,omitempty" mapstructure:"disabled"`
+
+	// Config is passed as is to the plugin constructor.
+	Config map[string]any `json:"config,omitempty" mapstructure:"config"`
+}
@@ -0,0 +1,290 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package plugins loads server extensions built as Go plugins.
+//
+// A plugin is a shared object built with -buildmode=plugin against the same
+// version of this module as the server. It exports a constructor named by
+// ConstructorSymbol with the Constructor signature. The returned Plugin may
+// additionally implement any of InterceptorProvider, RecordValidator,
+// StoreProvider and EventSink to extend the server.
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"plugin"
+	"sync"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/plugins/config"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc"
+)
+
+var logger = logging.Logger("plugins")
+
+// ConstructorSymbol is the name of the constructor exported by plugins.
+const ConstructorSymbol = "NewPlugin"
+
+// Constructor creates a plugin from the config of its manifest.
+type Constructor = func(config map[string]any) (Plugin, error)
+
+// Plugin is a server extension with a lifecycle managed by the server.
+type Plugin interface {
+	// Start is called once when the server starts, before serving requests.
+	Start(ctx context.Context) error
+
+	// Stop is called once when the server stops.
+	Stop() error
+}
+
+// InterceptorProvider adds gRPC interceptors to the server.
+// Interceptors run after the built-in ones, so authenticated peers are available.
+// Either interceptor may be nil.
+type InterceptorProvider interface {
+	UnaryServerInterceptor() grpc.UnaryServerInterceptor
+	StreamServerInterceptor() grpc.StreamServerInterceptor
+}
+
+// RecordValidator validates records before they are pushed.
+// A non-nil error rejects the push and is returned to the client.
+type RecordValidator interface {
+	ValidateRecord(ctx context.Context, record *corev1.Record) error
+}
+
+// StoreProvider provides a store implementation selected with the store provider config.
+type StoreProvider interface {
+	// StoreProvider returns the name of the store provider.
+	StoreProvider() string
+
+	// NewStore creates the store.
+	NewStore(opts types.APIOptions) (types.StoreAPI, error)
+}
+
+// EventSink receives all server events.
+// Events are delivered asynchronously and may be dropped if the sink is too slow.
+type EventSink interface {
+	HandleEvent(event *events.Event)
+}
+
+// namedPlugin is a loaded plugin.
+type namedPlugin struct {
+	name   string
+	plugin Plugin
+}
+
+// Manager manages the lifecycle of loaded plugins.
+type Manager struct {
+	plugins []namedPlugin
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Load loads the plugins of the enabled manifests.
+func Load(cfg config.Config) (*Manager, error) {
+	var loaded []namedPlugin
+
+	for _, manifest := range cfg.Manifests {
+		if manifest.Disabled {
+			logger.Info("Skipping disabled plugin", "name", manifest.Name)
+
+			continue
+		}
+
+		p, err := open(manifest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load plugin %s: %w", manifest.Name, err)
+		}
+
+		logger.Info("Loaded plugin", "name", manifest.Name, "path", manifest.Path)
+
+		loaded = append(loaded, namedPlugin{name: manifest.Name, plugin: p})
+	}
+
+	return &Manager{plugins: loaded}, nil
+}
+
+// open opens a plugin shared object and creates the plugin.
+func open(manifest config.Manifest) (Plugin, error) {
+	if manifest.Name == "" {
+		return nil, errors.New("plugin name is required")
+	}
+
+	if manifest.Path == "" {
+		return nil, errors.New("plugin path is required")
+	}
+
+	so, err := plugin.Open(manifest.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open plugin: %w", err)
+	}
+
+	symbol, err := so.Lookup(ConstructorSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup %s: %w", ConstructorSymbol, err)
+	}
+
+	constructor, ok := symbol.(Constructor)
+	if !ok {
+		return nil, fmt.Errorf("%s has type %T, expected %T", ConstructorSymbol, symbol, Constructor(nil))
+	}
+
+	p, err := constructor(manifest.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create plugin: %w", err)
+	}
+
+	if p == nil {
+		return nil, errors.New("plugin constructor returned nil")
+	}
+
+	return p, nil
+}
+
+// ServerOptions returns the gRPC server options adding the interceptors of plugins.
+func (m *Manager) ServerOptions() []grpc.ServerOption {
+	var (
+		unary  []grpc.UnaryServerInterceptor
+		stream []grpc.StreamServerInterceptor
+	)
+
+	for _, p := range m.plugins {
+		provider, ok := p.plugin.(InterceptorProvider)
+		if !ok {
+			continue
+		}
+
+		if interceptor := provider.UnaryServerInterceptor(); interceptor != nil {
+			unary = append(unary, interceptor)
+		}
+
+		if interceptor := provider.StreamServerInterceptor(); interceptor != nil {
+			stream = append(stream, interceptor)
+		}
+	}
+
+	var opts []grpc.ServerOption
+
+	if len(unary) > 0 {
+		opts = append(opts, grpc.ChainUnaryInterceptor(unary...))
+	}
+
+	if len(stream) > 0 {
+		opts = append(opts, grpc.ChainStreamInterceptor(stream...))
+	}
+
+	return opts
+}
+
+// NewStore creates a store using the plugin providing the given store provider.
+// Returns nil if no plugin provides it.
+func (m *Manager) NewStore(provider string, opts types.APIOptions) (types.StoreAPI, error) {
+	for _, p := range m.plugins {
+		storeProvider, ok := p.plugin.(StoreProvider)
+		if !ok || storeProvider.StoreProvider() != provider {
+			continue
+		}
+
+		store, err := storeProvider.NewStore(opts)
+		if err != nil {
+			return nil, fmt.Errorf("plugin %s failed to create store: %w", p.name, err)
+		}
+
+		return store, nil
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+// WrapStore wraps the store to validate pushed records with the validators of plugins.
+func (m *Manager) WrapStore(store types.StoreAPI) types.StoreAPI {
+	var validators []RecordValidator
+
+	for _, p := range m.plugins {
+		if validator, ok := p.plugin.(RecordValidator); ok {
+			validators = append(validators, validator)
+		}
+	}
+
+	if len(validators) == 0 {
+		return store
+	}
+
+	return &validatingStore{StoreAPI: store, validators: validators}
+}
+
+// Start starts all plugins and delivers events to event sinks.
+func (m *Manager) Start(ctx context.Context, bus *events.SafeEventBus) error {
+	ctx, m.cancel = context.WithCancel(ctx)
+
+	for _, p := range m.plugins {
+		if err := p.plugin.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start plugin %s: %w", p.name, err)
+		}
+
+		if sink, ok := p.plugin.(EventSink); ok {
+			m.startEventSink(ctx, p.name, sink, bus)
+		}
+
+		logger.Info("Started plugin", "name", p.name)
+	}
+
+	return nil
+}
+
+// startEventSink subscribes the event sink to all events.
+func (m *Manager) startEventSink(ctx context.Context, name string, sink EventSink, bus *events.SafeEventBus) {
+	subID, eventCh := bus.Subscribe(&eventsv1.ListenRequest{})
+	if eventCh == nil {
+		logger.Info("Event bus disabled, plugin does not receive events", "name", name)
+
+		return
+	}
+
+	m.wg.Add(1)
+
+	go func() {
+		defer m.wg.Done()
+		defer bus.Unsubscribe(subID)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-eventCh:
+				if !ok {
+					return
+				}
+
+				sink.HandleEvent(event)
+			}
+		}
+	}()
+}
+
+// Stop stops all plugins in reverse order.
+func (m *Manager) Stop() error {
+	if m.cancel != nil {
+		m.cancel()
+	}
+
+	m.wg.Wait()
+
+	var errs []error
+
+	for i := len(m.plugins) - 1; i >= 0; i-- {
+		p := m.plugins[i]
+
+		if err := p.plugin.Stop(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop plugin %s: %w", p.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
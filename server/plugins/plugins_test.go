@@ -0,0 +1,185 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package plugins
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	typesv1alpha0 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/agntcy/oasf/types/v1alpha0"
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/plugins/config"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockStore is a minimal store implementation for testing.
+type mockStore struct {
+	pushCalled bool
+}
+
+func (m *mockStore) Push(_ context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
+	m.pushCalled = true
+
+	return &corev1.RecordRef{Cid: record.GetCid()}, nil
+}
+
+func (m *mockStore) Pull(_ context.Context, _ *corev1.RecordRef) (*corev1.Record, error) {
+	return nil, status.Error(codes.NotFound, "record not found")
+}
+
+func (m *mockStore) Lookup(_ context.Context, _ *corev1.RecordRef) (*corev1.RecordMeta, error) {
+	return nil, status.Error(codes.NotFound, "record not found")
+}
+
+func (m *mockStore) Delete(_ context.Context, _ *corev1.RecordRef) error {
+	return nil
+}
+
+func (m *mockStore) IsReady(_ context.Context) bool {
+	return true
+}
+
+// testPlugin implements all plugin capabilities for testing.
+type testPlugin struct {
+	name    string
+	stopped *[]string
+	store   types.StoreAPI
+	reject  bool
+
+	mu     sync.Mutex
+	events []*events.Event
+}
+
+func (p *testPlugin) Start(_ context.Context) error { return nil }
+
+func (p *testPlugin) Stop() error {
+	*p.stopped = append(*p.stopped, p.name)
+
+	return nil
+}
+
+func (p *testPlugin) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(ctx, req)
+	}
+}
+
+func (p *testPlugin) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return nil
+}
+
+func (p *testPlugin) ValidateRecord(_ context.Context, _ *corev1.Record) error {
+	if p.reject {
+		return errors.New("rejected")
+	}
+
+	return nil
+}
+
+func (p *testPlugin) StoreProvider() string { return p.name }
+
+func (p *testPlugin) NewStore(_ types.APIOptions) (types.StoreAPI, error) {
+	return p.store, nil
+}
+
+func (p *testPlugin) HandleEvent(event *events.Event) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, event)
+}
+
+func (p *testPlugin) eventCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.events)
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("Disabled plugins are skipped", func(t *testing.T) {
+		manager, err := Load(config.Config{
+			Manifests: []config.Manifest{
+				{Name: "disabled", Path: "/does/not/exist.so", Disabled: true},
+			},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, manager.plugins)
+	})
+
+	t.Run("Missing plugin fails", func(t *testing.T) {
+		_, err := Load(config.Config{
+			Manifests: []config.Manifest{
+				{Name: "missing", Path: "/does/not/exist.so"},
+			},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("Manifest without path fails", func(t *testing.T) {
+		_, err := Load(config.Config{
+			Manifests: []config.Manifest{{Name: "no-path"}},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestManager(t *testing.T) {
+	var stopped []string
+
+	source := &mockStore{}
+	first := &testPlugin{name: "first", stopped: &stopped, store: source}
+	second := &testPlugin{name: "second", stopped: &stopped, reject: true}
+
+	manager := &Manager{plugins: []namedPlugin{
+		{name: first.name, plugin: first},
+		{name: second.name, plugin: second},
+	}}
+
+	// Unary interceptors are chained, nil stream interceptors are skipped
+	assert.Len(t, manager.ServerOptions(), 1)
+
+	// Store providers are selected by name
+	store, err := manager.NewStore("first", types.NewOptions(nil))
+	require.NoError(t, err)
+	assert.Equal(t, source, store)
+
+	store, err = manager.NewStore("unknown", types.NewOptions(nil))
+	require.NoError(t, err)
+	assert.Nil(t, store)
+
+	// Records rejected by a validator are not pushed
+	record := corev1.New(&typesv1alpha0.Record{
+		Name:          "test-record",
+		SchemaVersion: "v0.3.1",
+	})
+
+	_, err = manager.WrapStore(source).Push(t.Context(), record)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.False(t, source.pushCalled)
+
+	// Event sinks receive events after start
+	bus := events.NewSafeEventBus(events.NewEventBus())
+	require.NoError(t, manager.Start(t.Context(), bus))
+
+	bus.RecordPushed(record.GetCid(), nil)
+
+	assert.Eventually(t, func() bool {
+		return first.eventCount() == 1 && second.eventCount() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// Plugins are stopped in reverse order
+	require.NoError(t, manager.Stop())
+	assert.Equal(t, []string{"second", "first"}, stopped)
+}
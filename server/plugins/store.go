@@ -0,0 +1,85 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package plugins
+
+import (
+	"context"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validatingStore wraps a store to validate records with plugin validators before they are pushed.
+type validatingStore struct {
+	types.StoreAPI
+
+	validators []RecordValidator
+}
+
+// Push validates the record with all validators and pushes it to the wrapped store.
+func (s *validatingStore) Push(ctx context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
+	for _, validator := range s.validators {
+		if err := validator.ValidateRecord(ctx, record); err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+
+			return nil, status.Errorf(codes.InvalidArgument, "record rejected by plugin: %v", err)
+		}
+	}
+
+	return s.StoreAPI.Push(ctx, record)
+}
+
+// VerifyWithZot delegates to the wrapped store if it supports Zot verification.
+func (s *validatingStore) VerifyWithZot(ctx context.Context, recordCID string) (bool, error) {
+	zotStore, ok := s.StoreAPI.(types.VerifierStore)
+	if !ok {
+		return false, nil
+	}
+
+	return zotStore.VerifyWithZot(ctx, recordCID)
+}
+
+// PushReferrer delegates to the wrapped store if it supports referrer operations.
+func (s *validatingStore) PushReferrer(ctx context.Context, recordCID string, referrer *corev1.RecordReferrer) error {
+	referrerStore, ok := s.StoreAPI.(types.ReferrerStoreAPI)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "source store does not support referrer operations")
+	}
+
+	return referrerStore.PushReferrer(ctx, recordCID, referrer)
+}
+
+// WalkReferrers delegates to the wrapped store if it supports referrer operations.
+func (s *validatingStore) WalkReferrers(ctx context.Context, recordCID string, referrerType string, walkFn func(*corev1.RecordReferrer) error) error {
+	referrerStore, ok := s.StoreAPI.(types.ReferrerStoreAPI)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "source store does not support referrer operations")
+	}
+
+	return referrerStore.WalkReferrers(ctx, recordCID, referrerType, walkFn)
+}
+
+// List delegates to the wrapped store if it supports listing records.
+func (s *validatingStore) List(ctx context.Context, listFn func(*corev1.RecordRef) error) error {
+	listerStore, ok := s.StoreAPI.(types.ListerStore)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "source store does not support listing records")
+	}
+
+	return listerStore.List(ctx, listFn)
+}
+
+// GarbageCollect delegates to the wrapped store if it supports garbage collection.
+func (s *validatingStore) GarbageCollect(ctx context.Context) (*types.GarbageCollectResult, error) {
+	gcStore, ok := s.StoreAPI.(types.GarbageCollectorStore)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "source store does not support garbage collection")
+	}
+
+	return gcStore.GarbageCollect(ctx)
+}
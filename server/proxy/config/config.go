@@ -0,0 +1,40 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+const (
+	DefaultEnabled        = false
+	DefaultIndexOnly      = false
+	DefaultRequestTimeout = 10 * time.Second
+)
+
+// Config is the configuration for the pull-through proxy mode.
+// When enabled, pulling a record that is missing locally fetches it from the
+// allowlisted upstream Directories, stores it locally and records its provenance,
+// like a pull-through container registry cache.
+type Config struct {
+	// Enabled turns on the pull-through proxy mode.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// Upstreams are the Directory API addresses records may be fetched from,
+	// e.g. "dir.example.com:8888". Upstreams are tried in order.
+	// Records are never fetched from other Directories.
+	Upstreams []string `json:"upstreams,omitempty" mapstructure:"upstreams"`
+
+	// RequestTimeout is the timeout of a fetch from a single upstream.
+	// Default: 10s
+	RequestTimeout time.Duration `json:"request_timeout,omitempty" mapstructure:"request_timeout"`
+
+	// IndexOnly runs the node as a lightweight discovery node.
+	// The node maintains the search index and participates in routing, but stores no record blobs:
+	// pulls are served from the upstreams, starting with the one the record originates from,
+	// fetched records are only indexed, and pushes are rejected. Requires the proxy mode.
+	IndexOnly bool `json:"index_only,omitempty" mapstructure:"index_only"`
+
+	// Dir is the path to a local directory that will hold the provenance of fetched records.
+	// If empty, provenance is kept in memory.
+	Dir string `json:"dir,omitempty" mapstructure:"dir"`
+}
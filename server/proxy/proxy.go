@@ -0,0 +1,347 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package proxy implements the pull-through proxy mode, which fetches records
+// that are missing locally from allowlisted upstream Directories.
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/datastore"
+	"github.com/agntcy/dir/server/proxy/config"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/utils/logging"
+	ipfsdatastore "github.com/ipfs/go-datastore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var logger = logging.Logger("proxy")
+
+// RequestMetadataKey is the gRPC metadata key marking Pull requests sent by a proxy.
+// Proxies do not forward such requests to their own upstreams, so that Directories
+// proxying each other do not forward requests for missing records in a loop.
+const RequestMetadataKey = "x-dir-proxy-request"
+
+// provenancePrefix is the datastore key prefix holding the provenance of fetched records.
+const provenancePrefix = "/provenance/"
+
+// Provenance describes where a record fetched by the proxy came from.
+type Provenance struct {
+	// Upstream is the address of the upstream Directory the record was fetched from.
+	Upstream string `json:"upstream"`
+
+	// FetchedAt is the time at which the record was fetched.
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// upstream is an allowlisted upstream Directory.
+type upstream struct {
+	address string
+	conn    *grpc.ClientConn
+	store   storev1.StoreServiceClient
+}
+
+// Proxy fetches records from upstream Directories and tracks their provenance.
+type Proxy struct {
+	upstreams      []*upstream
+	requestTimeout time.Duration
+	provenance     types.Datastore
+}
+
+// New creates a proxy for the configured upstreams.
+// Returns nil if the proxy mode is disabled.
+func New(cfg config.Config) (*Proxy, error) {
+	if !cfg.Enabled {
+		if cfg.IndexOnly {
+			return nil, errors.New("index-only mode requires the proxy mode to be enabled")
+		}
+
+		return nil, nil //nolint:nilnil
+	}
+
+	if len(cfg.Upstreams) == 0 {
+		return nil, errors.New("proxy mode requires at least one upstream")
+	}
+
+	var dsOpts []datastore.Option
+	if cfg.Dir != "" {
+		dsOpts = append(dsOpts, datastore.WithFsProvider(cfg.Dir))
+	}
+
+	provenance, err := datastore.New(dsOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create provenance datastore: %w", err)
+	}
+
+	requestTimeout := cfg.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = config.DefaultRequestTimeout
+	}
+
+	p := &Proxy{
+		requestTimeout: requestTimeout,
+		provenance:     provenance,
+	}
+
+	for _, address := range cfg.Upstreams {
+		if address == "" {
+			_ = p.Close()
+
+			return nil, errors.New("proxy upstream address must not be empty")
+		}
+
+		conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			_ = p.Close()
+
+			return nil, fmt.Errorf("failed to create gRPC connection to proxy upstream %s: %w", address, err)
+		}
+
+		p.upstreams = append(p.upstreams, &upstream{
+			address: address,
+			conn:    conn,
+			store:   storev1.NewStoreServiceClient(conn),
+		})
+	}
+
+	logger.Info("Pull-through proxy enabled", "upstreams", cfg.Upstreams, "index_only", cfg.IndexOnly)
+
+	return p, nil
+}
+
+// IsProxyRequest reports whether the incoming request was sent by another proxy.
+func IsProxyRequest(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+
+	return ok && len(md.Get(RequestMetadataKey)) > 0
+}
+
+// Fetch pulls a record from the first upstream that has it,
+// starting with the upstream the record was previously fetched from.
+// Returns a NotFound error if no upstream has the record.
+func (p *Proxy) Fetch(ctx context.Context, cid string) (*corev1.Record, *Provenance, error) {
+	var record *corev1.Record
+
+	u, err := p.tryUpstreams(ctx, cid, func(u *upstream) error {
+		var err error
+
+		record, err = p.fetchFrom(ctx, u, cid)
+
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logger.Info("Fetched record from proxy upstream", "cid", cid, "upstream", u.address)
+
+	return record, &Provenance{Upstream: u.address, FetchedAt: time.Now().UTC()}, nil
+}
+
+// Lookup returns the metadata of a record from the first upstream that has it,
+// starting with the upstream the record was previously fetched from.
+// Returns a NotFound error if no upstream has the record.
+func (p *Proxy) Lookup(ctx context.Context, cid string) (*corev1.RecordMeta, error) {
+	var meta *corev1.RecordMeta
+
+	_, err := p.tryUpstreams(ctx, cid, func(u *upstream) error {
+		var err error
+
+		meta, err = p.lookupFrom(ctx, u, cid)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return meta, nil
+}
+
+// tryUpstreams calls fn with each upstream in turn until it succeeds, and returns that upstream.
+// The upstream a record was previously fetched from is tried first.
+func (p *Proxy) tryUpstreams(ctx context.Context, cid string, fn func(u *upstream) error) (*upstream, error) {
+	var errs []error
+
+	notFound := true
+
+	for _, u := range p.orderUpstreams(ctx, cid) {
+		err := fn(u)
+		if err == nil {
+			return u, nil
+		}
+
+		if status.Code(err) != codes.NotFound {
+			notFound = false
+
+			logger.Warn("Failed to request record from proxy upstream", "cid", cid, "upstream", u.address, "error", err)
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", u.address, err))
+	}
+
+	if notFound {
+		return nil, status.Errorf(codes.NotFound, "record %s not found locally or in proxy upstreams", cid)
+	}
+
+	return nil, status.Errorf(codes.Unavailable, "failed to fetch record %s from proxy upstreams: %v", cid, errors.Join(errs...))
+}
+
+// orderUpstreams returns the upstreams, starting with the one the record was previously fetched from.
+func (p *Proxy) orderUpstreams(ctx context.Context, cid string) []*upstream {
+	provenance, err := p.GetProvenance(ctx, cid)
+	if err != nil || provenance == nil {
+		return p.upstreams
+	}
+
+	ordered := make([]*upstream, 0, len(p.upstreams))
+
+	for _, u := range p.upstreams {
+		if u.address == provenance.Upstream {
+			ordered = append([]*upstream{u}, ordered...)
+		} else {
+			ordered = append(ordered, u)
+		}
+	}
+
+	return ordered
+}
+
+// fetchFrom pulls a single record from an upstream.
+func (p *Proxy) fetchFrom(ctx context.Context, u *upstream, cid string) (*corev1.Record, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.requestTimeout)
+	defer cancel()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, RequestMetadataKey, "true")
+
+	stream, err := u.store.Pull(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull stream: %w", err)
+	}
+
+	if err := stream.Send(&corev1.RecordRef{Cid: cid}); err != nil {
+		return nil, fmt.Errorf("failed to send pull request: %w", err)
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return nil, fmt.Errorf("failed to close send stream: %w", err)
+	}
+
+	record, err := stream.Recv()
+	if err != nil {
+		return nil, err //nolint:wrapcheck // keep the gRPC status of the upstream
+	}
+
+	// Records are content-addressed, make sure the upstream returned what was asked for
+	if record.GetCid() != cid {
+		return nil, fmt.Errorf("upstream returned record with mismatched CID %s", record.GetCid())
+	}
+
+	return record, nil
+}
+
+// lookupFrom looks up the metadata of a single record from an upstream.
+func (p *Proxy) lookupFrom(ctx context.Context, u *upstream, cid string) (*corev1.RecordMeta, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.requestTimeout)
+	defer cancel()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, RequestMetadataKey, "true")
+
+	stream, err := u.store.Lookup(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create lookup stream: %w", err)
+	}
+
+	if err := stream.Send(&corev1.RecordRef{Cid: cid}); err != nil {
+		return nil, fmt.Errorf("failed to send lookup request: %w", err)
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return nil, fmt.Errorf("failed to close send stream: %w", err)
+	}
+
+	meta, err := stream.Recv()
+	if err != nil {
+		return nil, err //nolint:wrapcheck // keep the gRPC status of the upstream
+	}
+
+	return meta, nil
+}
+
+// SaveProvenance records the provenance of a fetched record.
+func (p *Proxy) SaveProvenance(ctx context.Context, cid string, provenance *Provenance) error {
+	data, err := json.Marshal(provenance)
+	if err != nil {
+		return fmt.Errorf("failed to marshal provenance: %w", err)
+	}
+
+	if err := p.provenance.Put(ctx, ipfsdatastore.NewKey(provenancePrefix+cid), data); err != nil {
+		return fmt.Errorf("failed to save provenance: %w", err)
+	}
+
+	return nil
+}
+
+// GetProvenance returns the provenance of a record, or nil if it was not fetched by the proxy.
+func (p *Proxy) GetProvenance(ctx context.Context, cid string) (*Provenance, error) {
+	data, err := p.provenance.Get(ctx, ipfsdatastore.NewKey(provenancePrefix+cid))
+	if errors.Is(err, ipfsdatastore.ErrNotFound) {
+		return nil, nil //nolint:nilnil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get provenance: %w", err)
+	}
+
+	var provenance Provenance
+	if err := json.Unmarshal(data, &provenance); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal provenance: %w", err)
+	}
+
+	return &provenance, nil
+}
+
+// DeleteProvenance forgets the provenance of a deleted record.
+func (p *Proxy) DeleteProvenance(ctx context.Context, cid string) error {
+	if err := p.provenance.Delete(ctx, ipfsdatastore.NewKey(provenancePrefix+cid)); err != nil {
+		return fmt.Errorf("failed to delete provenance: %w", err)
+	}
+
+	return nil
+}
+
+// Annotations returns the record metadata annotations describing a provenance.
+func (p *Provenance) Annotations() map[string]string {
+	return map[string]string{
+		storev1.ProxiedFromAnnotation: p.Upstream,
+		storev1.ProxiedAtAnnotation:   p.FetchedAt.Format(time.RFC3339),
+	}
+}
+
+// Close closes the connections to the upstreams and the provenance datastore.
+func (p *Proxy) Close() error {
+	var errs []error
+
+	for _, u := range p.upstreams {
+		if err := u.conn.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close connection to proxy upstream %s: %w", u.address, err))
+		}
+	}
+
+	if err := p.provenance.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close provenance datastore: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,288 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	typesv1alpha0 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/agntcy/oasf/types/v1alpha0"
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/proxy/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeUpstream serves a fixed set of records over the Store API.
+type fakeUpstream struct {
+	storev1.UnimplementedStoreServiceServer
+
+	records map[string]*corev1.Record
+	err     error
+
+	// proxyRequests counts the pulls marked as sent by a proxy.
+	proxyRequests atomic.Int32
+
+	// pulls counts all pulls.
+	pulls atomic.Int32
+}
+
+func (u *fakeUpstream) Pull(stream storev1.StoreService_PullServer) error {
+	if IsProxyRequest(stream.Context()) {
+		u.proxyRequests.Add(1)
+	}
+
+	u.pulls.Add(1)
+
+	for {
+		ref, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if u.err != nil {
+			return u.err
+		}
+
+		record, ok := u.records[ref.GetCid()]
+		if !ok {
+			return status.Errorf(codes.NotFound, "record not found: %s", ref.GetCid())
+		}
+
+		if err := stream.Send(record); err != nil {
+			return err
+		}
+	}
+}
+
+func (u *fakeUpstream) Lookup(stream storev1.StoreService_LookupServer) error {
+	for {
+		ref, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := u.records[ref.GetCid()]; !ok {
+			return status.Errorf(codes.NotFound, "record not found: %s", ref.GetCid())
+		}
+
+		if err := stream.Send(&corev1.RecordMeta{Cid: ref.GetCid(), SchemaVersion: "v0.3.1"}); err != nil {
+			return err
+		}
+	}
+}
+
+// startUpstream serves the fake upstream and returns its address.
+func startUpstream(t *testing.T, upstream *fakeUpstream) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	storev1.RegisterStoreServiceServer(server, upstream)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+func newTestProxy(t *testing.T, upstreams ...string) *Proxy {
+	t.Helper()
+
+	p, err := New(config.Config{
+		Enabled:        true,
+		Upstreams:      upstreams,
+		RequestTimeout: 5 * time.Second,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = p.Close() })
+
+	return p
+}
+
+func newTestRecord(name string) *corev1.Record {
+	return corev1.New(&typesv1alpha0.Record{
+		Name:          name,
+		Version:       "v1.0.0",
+		SchemaVersion: "v0.3.1",
+	})
+}
+
+func TestNew(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		p, err := New(config.Config{Upstreams: []string{"dir.example.com:8888"}})
+		require.NoError(t, err)
+		assert.Nil(t, p)
+	})
+
+	t.Run("no upstreams", func(t *testing.T) {
+		_, err := New(config.Config{Enabled: true})
+		assert.Error(t, err)
+	})
+
+	t.Run("empty upstream", func(t *testing.T) {
+		_, err := New(config.Config{Enabled: true, Upstreams: []string{"dir.example.com:8888", ""}})
+		assert.Error(t, err)
+	})
+
+	t.Run("index-only without proxy mode", func(t *testing.T) {
+		_, err := New(config.Config{IndexOnly: true, Upstreams: []string{"dir.example.com:8888"}})
+		assert.Error(t, err)
+	})
+}
+
+func TestIsProxyRequest(t *testing.T) {
+	assert.False(t, IsProxyRequest(context.Background()))
+	assert.False(t, IsProxyRequest(metadata.NewIncomingContext(context.Background(), metadata.Pairs("other", "value"))))
+	assert.True(t, IsProxyRequest(metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestMetadataKey, "true"))))
+}
+
+func TestFetch(t *testing.T) {
+	ctx := context.Background()
+	record := newTestRecord("proxied-agent")
+
+	empty := &fakeUpstream{records: map[string]*corev1.Record{}}
+	hub := &fakeUpstream{records: map[string]*corev1.Record{record.GetCid(): record}}
+	broken := &fakeUpstream{err: status.Error(codes.Internal, "storage failure")}
+
+	emptyAddr := startUpstream(t, empty)
+	hubAddr := startUpstream(t, hub)
+	brokenAddr := startUpstream(t, broken)
+
+	t.Run("fetches from first upstream having the record", func(t *testing.T) {
+		p := newTestProxy(t, emptyAddr, hubAddr)
+
+		fetched, provenance, err := p.Fetch(ctx, record.GetCid())
+		require.NoError(t, err)
+		assert.Equal(t, record.GetCid(), fetched.GetCid())
+		assert.Equal(t, hubAddr, provenance.Upstream)
+		assert.False(t, provenance.FetchedAt.IsZero())
+
+		// Upstreams must not forward proxied requests to their own upstreams
+		assert.Positive(t, hub.proxyRequests.Load())
+	})
+
+	t.Run("not found in any upstream", func(t *testing.T) {
+		p := newTestProxy(t, emptyAddr, hubAddr)
+
+		_, _, err := p.Fetch(ctx, newTestRecord("missing-agent").GetCid())
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("unavailable when an upstream fails", func(t *testing.T) {
+		p := newTestProxy(t, brokenAddr, emptyAddr)
+
+		_, _, err := p.Fetch(ctx, record.GetCid())
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("skips failing upstreams", func(t *testing.T) {
+		p := newTestProxy(t, brokenAddr, hubAddr)
+
+		_, provenance, err := p.Fetch(ctx, record.GetCid())
+		require.NoError(t, err)
+		assert.Equal(t, hubAddr, provenance.Upstream)
+	})
+
+	t.Run("rejects mismatched record", func(t *testing.T) {
+		other := newTestRecord("other-agent")
+		liar := &fakeUpstream{records: map[string]*corev1.Record{record.GetCid(): other}}
+
+		p := newTestProxy(t, startUpstream(t, liar))
+
+		_, _, err := p.Fetch(ctx, record.GetCid())
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
+
+func TestProvenance(t *testing.T) {
+	ctx := context.Background()
+	p := newTestProxy(t, "dir.example.com:8888")
+
+	provenance, err := p.GetProvenance(ctx, "cid-1")
+	require.NoError(t, err)
+	assert.Nil(t, provenance)
+
+	fetchedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, p.SaveProvenance(ctx, "cid-1", &Provenance{Upstream: "dir.example.com:8888", FetchedAt: fetchedAt}))
+
+	provenance, err = p.GetProvenance(ctx, "cid-1")
+	require.NoError(t, err)
+	require.NotNil(t, provenance)
+	assert.Equal(t, "dir.example.com:8888", provenance.Upstream)
+	assert.True(t, fetchedAt.Equal(provenance.FetchedAt))
+
+	assert.Equal(t, map[string]string{
+		storev1.ProxiedFromAnnotation: "dir.example.com:8888",
+		storev1.ProxiedAtAnnotation:   "2025-01-02T03:04:05Z",
+	}, provenance.Annotations())
+
+	require.NoError(t, p.DeleteProvenance(ctx, "cid-1"))
+
+	provenance, err = p.GetProvenance(ctx, "cid-1")
+	require.NoError(t, err)
+	assert.Nil(t, provenance)
+}
+
+func TestFetchPrefersOrigin(t *testing.T) {
+	ctx := context.Background()
+	record := newTestRecord("mirrored-agent")
+
+	hub := &fakeUpstream{records: map[string]*corev1.Record{record.GetCid(): record}}
+	origin := &fakeUpstream{records: map[string]*corev1.Record{record.GetCid(): record}}
+
+	hubAddr := startUpstream(t, hub)
+	originAddr := startUpstream(t, origin)
+
+	p := newTestProxy(t, hubAddr, originAddr)
+
+	// The record was previously fetched from the origin
+	require.NoError(t, p.SaveProvenance(ctx, record.GetCid(), &Provenance{Upstream: originAddr, FetchedAt: time.Now()}))
+
+	_, provenance, err := p.Fetch(ctx, record.GetCid())
+	require.NoError(t, err)
+	assert.Equal(t, originAddr, provenance.Upstream)
+	assert.Zero(t, hub.pulls.Load())
+}
+
+func TestLookup(t *testing.T) {
+	ctx := context.Background()
+	record := newTestRecord("proxied-agent")
+
+	empty := &fakeUpstream{records: map[string]*corev1.Record{}}
+	hub := &fakeUpstream{records: map[string]*corev1.Record{record.GetCid(): record}}
+
+	p := newTestProxy(t, startUpstream(t, empty), startUpstream(t, hub))
+
+	meta, err := p.Lookup(ctx, record.GetCid())
+	require.NoError(t, err)
+	assert.Equal(t, record.GetCid(), meta.GetCid())
+
+	_, err = p.Lookup(ctx, newTestRecord("missing-agent").GetCid())
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,166 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package publication
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/publication/config"
+)
+
+// pendingSignatureTimeout is how long records matching a signed-only rule wait for their signature.
+const pendingSignatureTimeout = time.Hour
+
+// autoPublisher publishes pushed records matching the auto-publish rules.
+type autoPublisher struct {
+	rules   []config.AutoPublishRule
+	publish func(ctx context.Context, req *routingv1.PublishRequest) (string, error)
+
+	// pending records waiting for their signature, only accessed by the event loop
+	pending map[string]pendingRecord
+}
+
+// pendingRecord is a pushed record matching a signed-only rule.
+type pendingRecord struct {
+	rule          string
+	namespace     string
+	pushedEventID string
+	pushedAt      time.Time
+}
+
+func newAutoPublisher(rules []config.AutoPublishRule, publish func(ctx context.Context, req *routingv1.PublishRequest) (string, error)) *autoPublisher {
+	return &autoPublisher{
+		rules:   rules,
+		publish: publish,
+		pending: make(map[string]pendingRecord),
+	}
+}
+
+// run handles record events until the context is done, the service is stopped or the channel is closed.
+func (a *autoPublisher) run(ctx context.Context, stopCh <-chan struct{}, eventCh <-chan *events.Event) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stopCh:
+			return
+		case event, ok := <-eventCh:
+			if !ok {
+				return
+			}
+
+			a.handle(ctx, event)
+		}
+	}
+}
+
+// handle publishes the record of a pushed event if it matches a rule,
+// or of a signed event if it was waiting for its signature.
+func (a *autoPublisher) handle(ctx context.Context, event *events.Event) {
+	a.expirePending(event.Timestamp)
+
+	switch event.Type {
+	case eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED:
+		var signedOnly *config.AutoPublishRule
+
+		for i, rule := range a.rules {
+			if !matchesRule(rule, event) {
+				continue
+			}
+
+			if !rule.SignedOnly {
+				a.create(ctx, event.ResourceID, rule.Name, event.Namespace, event.ID)
+
+				return
+			}
+
+			if signedOnly == nil {
+				signedOnly = &a.rules[i]
+			}
+		}
+
+		if signedOnly != nil {
+			logger.Debug("Auto-publication waiting for record signature", "cid", event.ResourceID, "rule", signedOnly.Name)
+
+			a.pending[event.ResourceID] = pendingRecord{
+				rule:          signedOnly.Name,
+				namespace:     event.Namespace,
+				pushedEventID: event.ID,
+				pushedAt:      event.Timestamp,
+			}
+		}
+
+	case eventsv1.EventType_EVENT_TYPE_RECORD_SIGNED:
+		pending, ok := a.pending[event.ResourceID]
+		if !ok {
+			return
+		}
+
+		delete(a.pending, event.ResourceID)
+		a.create(ctx, event.ResourceID, pending.rule, pending.namespace, pending.pushedEventID)
+
+	default:
+	}
+}
+
+// create enqueues the publication of a record, linked to the rule and push that triggered it.
+// The publication is attributed to the namespace of the push.
+func (a *autoPublisher) create(ctx context.Context, cid, rule, namespace, pushedEventID string) {
+	publicationID, err := a.publish(withNamespace(ctx, namespace), &routingv1.PublishRequest{
+		Request: &routingv1.PublishRequest_RecordRefs{
+			RecordRefs: &routingv1.RecordRefs{Refs: []*corev1.RecordRef{{Cid: cid}}},
+		},
+		Origin: &routingv1.PublicationOrigin{
+			Rule:          rule,
+			PushedEventId: pushedEventID,
+		},
+	})
+	if err != nil {
+		logger.Error("Failed to create auto-publication", "cid", cid, "rule", rule, "error", err)
+
+		return
+	}
+
+	logger.Info("Created auto-publication", "publication_id", publicationID, "cid", cid, "rule", rule, "pushed_event_id", pushedEventID)
+}
+
+// expirePending drops the records that waited too long for their signature.
+func (a *autoPublisher) expirePending(now time.Time) {
+	for cid, pending := range a.pending {
+		if now.Sub(pending.pushedAt) >= pendingSignatureTimeout {
+			logger.Info("Record not signed in time for auto-publication", "cid", cid, "rule", pending.rule)
+
+			delete(a.pending, cid)
+		}
+	}
+}
+
+// matchesRule reports whether the pushed record of an event matches all the criteria of the rule.
+func matchesRule(rule config.AutoPublishRule, event *events.Event) bool {
+	if len(rule.Namespaces) > 0 && !slices.Contains(rule.Namespaces, event.Namespace) {
+		return false
+	}
+
+	for _, label := range rule.Labels {
+		if !hasLabel(event.Labels, label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasLabel reports whether a label, or a label nested under it, is in labels.
+func hasLabel(labels []string, label string) bool {
+	return slices.ContainsFunc(labels, func(l string) bool {
+		return l == label || strings.HasPrefix(l, strings.TrimSuffix(label, "/")+"/")
+	})
+}
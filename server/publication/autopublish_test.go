@@ -0,0 +1,164 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package publication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/publication/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAutoPublisher(rules ...config.AutoPublishRule) (*autoPublisher, *[]*routingv1.PublishRequest) {
+	var created []*routingv1.PublishRequest
+
+	publisher := newAutoPublisher(rules, func(_ context.Context, req *routingv1.PublishRequest) (string, error) {
+		created = append(created, req)
+
+		return "publication-id", nil
+	})
+
+	return publisher, &created
+}
+
+func testEvent(eventType eventsv1.EventType, id, cid, namespace string, labels ...string) *events.Event {
+	return &events.Event{
+		ID:         id,
+		Type:       eventType,
+		Timestamp:  time.Now(),
+		ResourceID: cid,
+		Namespace:  namespace,
+		Labels:     labels,
+	}
+}
+
+func TestMatchesRule(t *testing.T) {
+	event := testEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, "e1", "cid", "example.org",
+		"/skills/natural_language_processing/text_completion", "/domains/research")
+
+	tests := []struct {
+		name  string
+		rule  config.AutoPublishRule
+		match bool
+	}{
+		{"empty rule", config.AutoPublishRule{}, true},
+		{"namespace", config.AutoPublishRule{Namespaces: []string{"other.org", "example.org"}}, true},
+		{"other namespace", config.AutoPublishRule{Namespaces: []string{"other.org"}}, false},
+		{"exact label", config.AutoPublishRule{Labels: []string{"/domains/research"}}, true},
+		{"parent label", config.AutoPublishRule{Labels: []string{"/skills/natural_language_processing"}}, true},
+		{"label prefix is not a parent", config.AutoPublishRule{Labels: []string{"/skills/natural"}}, false},
+		{"all labels required", config.AutoPublishRule{Labels: []string{"/domains/research", "/domains/finance"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.match, matchesRule(tt.rule, event))
+		})
+	}
+}
+
+func TestAutoPublishOnPush(t *testing.T) {
+	publisher, created := newTestAutoPublisher(
+		config.AutoPublishRule{Name: "research", Labels: []string{"/domains/research"}},
+	)
+
+	publisher.handle(t.Context(), testEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, "e1", "cid1", "", "/domains/finance"))
+	assert.Empty(t, *created)
+
+	publisher.handle(t.Context(), testEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, "e2", "cid2", "", "/domains/research"))
+	require.Len(t, *created, 1)
+
+	req := (*created)[0]
+	assert.Equal(t, "cid2", req.GetRecordRefs().GetRefs()[0].GetCid())
+	assert.Equal(t, "research", req.GetOrigin().GetRule())
+	assert.Equal(t, "e2", req.GetOrigin().GetPushedEventId())
+}
+
+func TestAutoPublishSignedOnly(t *testing.T) {
+	publisher, created := newTestAutoPublisher(
+		config.AutoPublishRule{Name: "signed", SignedOnly: true},
+	)
+
+	// Signatures of records that were not pushed matching a rule are ignored
+	publisher.handle(t.Context(), testEvent(eventsv1.EventType_EVENT_TYPE_RECORD_SIGNED, "e1", "cid0", ""))
+	assert.Empty(t, *created)
+
+	publisher.handle(t.Context(), testEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, "e2", "cid1", ""))
+	assert.Empty(t, *created)
+
+	publisher.handle(t.Context(), testEvent(eventsv1.EventType_EVENT_TYPE_RECORD_SIGNED, "e3", "cid1", ""))
+	require.Len(t, *created, 1)
+	assert.Equal(t, "signed", (*created)[0].GetOrigin().GetRule())
+	assert.Equal(t, "e2", (*created)[0].GetOrigin().GetPushedEventId())
+
+	// Records are published once, even if signed again
+	publisher.handle(t.Context(), testEvent(eventsv1.EventType_EVENT_TYPE_RECORD_SIGNED, "e4", "cid1", ""))
+	assert.Len(t, *created, 1)
+}
+
+func TestAutoPublishSignedOnlyExpires(t *testing.T) {
+	publisher, created := newTestAutoPublisher(
+		config.AutoPublishRule{Name: "signed", SignedOnly: true},
+	)
+
+	pushed := testEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, "e1", "cid1", "")
+	pushed.Timestamp = time.Now().Add(-2 * pendingSignatureTimeout)
+	publisher.handle(t.Context(), pushed)
+
+	publisher.handle(t.Context(), testEvent(eventsv1.EventType_EVENT_TYPE_RECORD_SIGNED, "e2", "cid1", ""))
+	assert.Empty(t, *created)
+}
+
+func TestAutoPublishPrefersUnsignedRules(t *testing.T) {
+	publisher, created := newTestAutoPublisher(
+		config.AutoPublishRule{Name: "signed", SignedOnly: true},
+		config.AutoPublishRule{Name: "trusted", Namespaces: []string{"example.org"}},
+	)
+
+	publisher.handle(t.Context(), testEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, "e1", "cid1", "example.org"))
+	require.Len(t, *created, 1)
+	assert.Equal(t, "trusted", (*created)[0].GetOrigin().GetRule())
+	assert.Empty(t, publisher.pending)
+}
+
+func TestAutoPublishNamespace(t *testing.T) {
+	var namespaces []string
+
+	publisher := newAutoPublisher([]config.AutoPublishRule{
+		{Name: "all"},
+		{Name: "signed", Namespaces: []string{"signed.org"}, SignedOnly: true},
+	}, func(ctx context.Context, _ *routingv1.PublishRequest) (string, error) {
+		namespaces = append(namespaces, publicationNamespace(ctx))
+
+		return "publication-id", nil
+	})
+
+	// Publications are attributed to the namespace of the push, even once signed
+	publisher.handle(t.Context(), testEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, "e1", "cid1", "example.org"))
+	publisher.rules = publisher.rules[1:]
+	publisher.handle(t.Context(), testEvent(eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED, "e2", "cid2", "signed.org"))
+	publisher.handle(t.Context(), testEvent(eventsv1.EventType_EVENT_TYPE_RECORD_SIGNED, "e3", "cid2", "other.org"))
+
+	assert.Equal(t, []string{"example.org", "signed.org"}, namespaces)
+}
+
+func TestPublicationNamespace(t *testing.T) {
+	assert.Empty(t, publicationNamespace(t.Context()))
+	assert.Equal(t, "example.org", publicationNamespace(withNamespace(t.Context(), "example.org")))
+}
+
+func TestPublicationMetadata(t *testing.T) {
+	assert.Equal(t, map[string]string{"publication_id": "p1"}, publicationMetadata("p1", nil))
+	assert.Equal(t, map[string]string{
+		"publication_id":    "p1",
+		"auto_publish_rule": "research",
+		"pushed_event_id":   "e1",
+	}, publicationMetadata("p1", &routingv1.PublicationOrigin{Rule: "research", PushedEventId: "e1"}))
+}
@@ -3,7 +3,11 @@
 
 package config
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 const (
 	DefaultPublicationSchedulerInterval = 1 * time.Hour
@@ -22,4 +26,116 @@ type Config struct {
 
 	// Worker timeout.
 	WorkerTimeout time.Duration `json:"worker_timeout,omitempty" mapstructure:"worker_timeout"`
+
+	// Announce spread window.
+	// The announcements of a publication are staggered evenly over this window
+	// instead of being sent in a single burst, so that peers do not rate-limit or drop them.
+	// The worker timeout is extended by the window. Zero disables spreading.
+	AnnounceSpreadWindow time.Duration `json:"announce_spread_window,omitempty" mapstructure:"announce_spread_window"`
+
+	// Settle delay.
+	// New publications are held back for this delay before their records are announced,
+	// giving publishers a window to catch mistakes before global propagation.
+	// Publications can be confirmed to end the delay early. Zero disables the delay.
+	SettleDelay time.Duration `json:"settle_delay,omitempty" mapstructure:"settle_delay"`
+
+	// Signature policies.
+	// Records can only be published once all the policies are satisfied.
+	SignaturePolicies []SignaturePolicy `json:"signature_policies,omitempty" mapstructure:"signature_policies"`
+
+	// Auto-publish rules.
+	// Pushed records matching any of the rules are published automatically,
+	// without waiting for an explicit Publish request.
+	AutoPublish []AutoPublishRule `json:"auto_publish,omitempty" mapstructure:"auto_publish"`
+}
+
+// AutoPublishRule selects the pushed records that are published automatically.
+// All the criteria of a rule must match. Empty criteria match any record.
+type AutoPublishRule struct {
+	// Name of the rule, attached to the publications it creates.
+	Name string `json:"name,omitempty" mapstructure:"name"`
+
+	// Namespaces are the trust domains of the identities whose pushes match the rule.
+	Namespaces []string `json:"namespaces,omitempty" mapstructure:"namespaces"`
+
+	// Labels that records must all have, such as "/skills/natural_language_processing".
+	// A label also matches the labels nested under it.
+	Labels []string `json:"labels,omitempty" mapstructure:"labels"`
+
+	// SignedOnly defers the publication of matching records until they are signed.
+	SignedOnly bool `json:"signed_only,omitempty" mapstructure:"signed_only"`
+}
+
+// SignaturePolicy requires records to be signed by at least Threshold of the Signers (N-of-M).
+type SignaturePolicy struct {
+	// Name of the policy, reported in verification results.
+	Name string `json:"name,omitempty" mapstructure:"name"`
+
+	// Threshold is the number of distinct signers that must have signed the record.
+	Threshold int `json:"threshold,omitempty" mapstructure:"threshold"`
+
+	// Signers is the set of identities allowed to satisfy the policy.
+	Signers []Signer `json:"signers,omitempty" mapstructure:"signers"`
+}
+
+// Signer is an identity allowed to sign records.
+type Signer struct {
+	// Name of the signer, reported in verification results.
+	Name string `json:"name,omitempty" mapstructure:"name"`
+
+	// PEM-encoded public key of the signer.
+	PublicKey string `json:"public_key,omitempty" mapstructure:"public_key"`
+}
+
+// SettleUntil returns the time until which a new publication is held back.
+// It returns the zero time if the settle delay is disabled or the publication is confirmed.
+func (c *Config) SettleUntil(confirmed bool, now time.Time) time.Time {
+	if confirmed || c.SettleDelay <= 0 {
+		return time.Time{}
+	}
+
+	return now.Add(c.SettleDelay)
+}
+
+// Validate checks that the signature policies and auto-publish rules are well-formed.
+func (c *Config) Validate() error {
+	names := make(map[string]bool, len(c.SignaturePolicies))
+
+	for _, policy := range c.SignaturePolicies {
+		if policy.Name == "" {
+			return errors.New("signature policy name is required")
+		}
+
+		if names[policy.Name] {
+			return fmt.Errorf("duplicate signature policy %q", policy.Name)
+		}
+
+		names[policy.Name] = true
+
+		if policy.Threshold < 1 || policy.Threshold > len(policy.Signers) {
+			return fmt.Errorf("signature policy %q threshold must be between 1 and the number of signers (%d)", policy.Name, len(policy.Signers))
+		}
+
+		for _, signer := range policy.Signers {
+			if signer.Name == "" || signer.PublicKey == "" {
+				return fmt.Errorf("signature policy %q signers must have a name and a public key", policy.Name)
+			}
+		}
+	}
+
+	rules := make(map[string]bool, len(c.AutoPublish))
+
+	for _, rule := range c.AutoPublish {
+		if rule.Name == "" {
+			return errors.New("auto-publish rule name is required")
+		}
+
+		if rules[rule.Name] {
+			return fmt.Errorf("duplicate auto-publish rule %q", rule.Name)
+		}
+
+		rules[rule.Name] = true
+	}
+
+	return nil
 }
@@ -5,12 +5,19 @@ package publication
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/server/events"
 	"github.com/agntcy/dir/server/publication/config"
 	publypes "github.com/agntcy/dir/server/publication/types"
+	"github.com/agntcy/dir/server/signpolicy"
 	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/watchdog"
 	"github.com/agntcy/dir/utils/logging"
 )
 
@@ -18,10 +25,13 @@ var logger = logging.Logger("publication")
 
 // Service manages the publication operations.
 type Service struct {
-	db      types.DatabaseAPI
-	store   types.StoreAPI
-	routing types.RoutingAPI
-	config  config.Config
+	db       types.DatabaseAPI
+	store    types.StoreAPI
+	routing  types.RoutingAPI
+	policy   *signpolicy.Evaluator
+	config   config.Config
+	eventBus *events.SafeEventBus
+	watchdog *watchdog.Watchdog
 
 	scheduler *Scheduler
 	workers   []*Worker
@@ -31,24 +41,60 @@ type Service struct {
 }
 
 // New creates a new publication service.
-func New(db types.DatabaseAPI, store types.StoreAPI, routing types.RoutingAPI, opts types.APIOptions) (*Service, error) {
+// Records that do not satisfy the signature policy are not published.
+func New(db types.DatabaseAPI, store types.StoreAPI, routing types.RoutingAPI, policy *signpolicy.Evaluator, opts types.APIOptions) (*Service, error) {
+	if opts.Config().Publication.AnnounceSpreadWindow < 0 {
+		return nil, errors.New("publication announce spread window cannot be negative")
+	}
+
+	if opts.Config().Publication.SettleDelay < 0 {
+		return nil, errors.New("publication settle delay cannot be negative")
+	}
+
 	return &Service{
-		db:      db,
-		store:   store,
-		routing: routing,
-		config:  opts.Config().Publication,
-		stopCh:  make(chan struct{}),
+		db:       db,
+		store:    store,
+		routing:  routing,
+		policy:   policy,
+		config:   opts.Config().Publication,
+		eventBus: opts.EventBus(),
+		watchdog: watchdog.New(opts.Config().Watchdog, func(stall watchdog.Stall) {
+			opts.EventBus().WorkerStalled(stall.Kind, stall.Name, stall.WorkerID, stall.LastCheckpoint)
+		}),
+		stopCh: make(chan struct{}),
 	}, nil
 }
 
 // CreatePublication creates a new publication task to be processed.
-func (s *Service) CreatePublication(_ context.Context, req *routingv1.PublishRequest) (string, error) {
-	return s.db.CreatePublication(req) //nolint:wrapcheck
+// Unless confirmed, the publication is held back for the configured settle delay.
+// Publications of records under embargo are held back until their embargo lifts, even if confirmed.
+// The publication is summarized under the namespace of the caller and the labels of its records.
+func (s *Service) CreatePublication(ctx context.Context, req *routingv1.PublishRequest) (string, error) {
+	settleUntil := s.config.SettleUntil(req.GetConfirmed(), time.Now())
+
+	for _, ref := range req.GetRecordRefs().GetRefs() {
+		embargoUntil, _, err := s.db.GetRecordEmbargo(ref.GetCid())
+		if err != nil {
+			return "", fmt.Errorf("failed to get record embargo: %w", err)
+		}
+
+		if embargoUntil.After(settleUntil) {
+			settleUntil = embargoUntil
+		}
+	}
+
+	labels, err := s.publicationLabels(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get publication labels: %w", err)
+	}
+
+	return s.db.CreatePublication(req, settleUntil, publicationNamespace(ctx), labels) //nolint:wrapcheck
 }
 
 // Start begins the publication service operations.
 func (s *Service) Start(ctx context.Context) error {
-	logger.Info("Starting publication service", "workers", s.config.WorkerCount, "interval", s.config.SchedulerInterval)
+	logger.Info("Starting publication service", "workers", s.config.WorkerCount, "interval", s.config.SchedulerInterval,
+		"announce_spread_window", s.config.AnnounceSpreadWindow)
 
 	// Create work queue
 	workQueue := make(chan publypes.WorkItem, 100) //nolint:mnd
@@ -59,9 +105,12 @@ func (s *Service) Start(ctx context.Context) error {
 	// Create and start workers
 	s.workers = make([]*Worker, s.config.WorkerCount)
 	for i := range s.config.WorkerCount {
-		s.workers[i] = NewWorker(i, s.db, s.store, s.routing, workQueue, s.config.WorkerTimeout)
+		s.workers[i] = NewWorker(i, s.db, s.store, s.routing, s.policy, workQueue, s.config.WorkerTimeout, s.config.AnnounceSpreadWindow, s.watchdog, workQueue)
 	}
 
+	// Start watchdog cancelling and retrying stalled publications
+	s.watchdog.Start(ctx)
+
 	// Start scheduler
 	s.wg.Add(1)
 
@@ -82,11 +131,45 @@ func (s *Service) Start(ctx context.Context) error {
 		}(worker)
 	}
 
+	s.startAutoPublish(ctx)
+
 	logger.Info("Publication service started successfully")
 
 	return nil
 }
 
+// startAutoPublish publishes the pushed records matching the auto-publish rules, if any.
+func (s *Service) startAutoPublish(ctx context.Context) {
+	if len(s.config.AutoPublish) == 0 {
+		return
+	}
+
+	subID, eventCh := s.eventBus.Subscribe(&eventsv1.ListenRequest{
+		EventTypes: []eventsv1.EventType{
+			eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED,
+			eventsv1.EventType_EVENT_TYPE_RECORD_SIGNED,
+		},
+	})
+	if eventCh == nil {
+		logger.Warn("Event bus disabled, auto-publish rules are ignored")
+
+		return
+	}
+
+	logger.Info("Starting auto-publication", "rules", len(s.config.AutoPublish))
+
+	publisher := newAutoPublisher(s.config.AutoPublish, s.CreatePublication)
+
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+		defer s.eventBus.Unsubscribe(subID)
+
+		publisher.run(ctx, s.stopCh, eventCh)
+	}()
+}
+
 // Stop gracefully shuts down the publication service.
 func (s *Service) Stop() error {
 	logger.Info("Stopping publication service")
@@ -95,6 +178,9 @@ func (s *Service) Stop() error {
 	close(s.stopCh)
 	s.wg.Wait()
 
+	// Stop watchdog, dropping the pending retries
+	s.watchdog.Stop()
+
 	logger.Info("Publication service stopped")
 
 	return nil
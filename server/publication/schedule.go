@@ -0,0 +1,65 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package publication
+
+import (
+	"time"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+)
+
+// announceSchedule spreads the announcements of a publication evenly over a window.
+// The first record is announced at start and the last one at start+window.
+type announceSchedule struct {
+	start     time.Time
+	interval  time.Duration
+	total     int
+	announced int
+	failed    int
+}
+
+// newAnnounceSchedule creates the schedule of total announcements starting at start.
+// A zero window announces all records in a single burst.
+func newAnnounceSchedule(start time.Time, total int, window time.Duration) *announceSchedule {
+	var interval time.Duration
+	if window > 0 && total > 1 {
+		interval = window / time.Duration(total-1)
+	}
+
+	return &announceSchedule{
+		start:    start,
+		interval: interval,
+		total:    total,
+	}
+}
+
+// processed returns the number of records announced or failed so far.
+func (s *announceSchedule) processed() int {
+	return s.announced + s.failed
+}
+
+// announceTime returns the time at which the i-th record is scheduled to be announced.
+func (s *announceSchedule) announceTime(i int) time.Time {
+	return s.start.Add(time.Duration(i) * s.interval)
+}
+
+// toProto returns the schedule and progress reported in the publication status.
+func (s *announceSchedule) toProto() *routingv1.PublicationSchedule {
+	schedule := &routingv1.PublicationSchedule{
+		TotalRecords:            uint32(s.total),     //nolint:gosec
+		AnnouncedRecords:        uint32(s.announced), //nolint:gosec
+		FailedRecords:           uint32(s.failed),    //nolint:gosec
+		EstimatedCompletionTime: s.announceTime(max(s.total-1, 0)).Format(time.RFC3339),
+	}
+
+	if s.interval > 0 {
+		schedule.AnnounceInterval = s.interval.String()
+	}
+
+	if s.processed() < s.total {
+		schedule.NextAnnounceTime = s.announceTime(s.processed()).Format(time.RFC3339)
+	}
+
+	return schedule
+}
@@ -0,0 +1,53 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package publication
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnounceSchedule(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// 5 records over 1h are announced every 15m, the last one at the end of the window
+	schedule := newAnnounceSchedule(start, 5, time.Hour)
+	assert.Equal(t, 15*time.Minute, schedule.interval)
+	assert.Equal(t, start, schedule.announceTime(0))
+	assert.Equal(t, start.Add(time.Hour), schedule.announceTime(4))
+
+	schedule.announced = 2
+	schedule.failed = 1
+
+	status := schedule.toProto()
+	assert.Equal(t, uint32(5), status.GetTotalRecords())
+	assert.Equal(t, uint32(2), status.GetAnnouncedRecords())
+	assert.Equal(t, uint32(1), status.GetFailedRecords())
+	assert.Equal(t, "15m0s", status.GetAnnounceInterval())
+	assert.Equal(t, "2026-01-01T00:45:00Z", status.GetNextAnnounceTime())
+	assert.Equal(t, "2026-01-01T01:00:00Z", status.GetEstimatedCompletionTime())
+
+	// Nothing is scheduled once all records are processed
+	schedule.announced = 4
+	assert.Empty(t, schedule.toProto().GetNextAnnounceTime())
+}
+
+func TestAnnounceScheduleBurst(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, schedule := range []*announceSchedule{
+		newAnnounceSchedule(start, 1000, 0),
+		newAnnounceSchedule(start, 1, time.Hour),
+		newAnnounceSchedule(start, 0, time.Hour),
+	} {
+		assert.Zero(t, schedule.interval)
+		assert.Equal(t, start, schedule.announceTime(schedule.total))
+
+		status := schedule.toProto()
+		assert.Empty(t, status.GetAnnounceInterval())
+		assert.Equal(t, "2026-01-01T00:00:00Z", status.GetEstimatedCompletionTime())
+	}
+}
@@ -65,7 +65,16 @@ func (s *Scheduler) processPendingPublications(ctx context.Context) {
 		return
 	}
 
+	now := time.Now()
+
 	for _, publication := range publications {
+		// Hold back publications until their settle delay has elapsed or they are confirmed
+		if settleUntil := publication.GetSettleUntil(); now.Before(settleUntil) {
+			logger.Debug("Publication is settling, skipping", "publication_id", publication.GetID(), "settle_until", settleUntil)
+
+			continue
+		}
+
 		select {
 		case <-ctx.Done():
 			logger.Info("Stopping publication processing due to context cancellation")
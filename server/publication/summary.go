@@ -0,0 +1,170 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package publication
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	"github.com/agntcy/dir/server/authn"
+	databaseutils "github.com/agntcy/dir/server/database/utils"
+	"github.com/agntcy/dir/server/types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// namespaceContextKey is the context key of the namespace publications are attributed to.
+type namespaceContextKey struct{}
+
+// withNamespace attributes the publications created with ctx to the namespace,
+// instead of the trust domain of the authenticated identity.
+func withNamespace(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceContextKey{}, namespace)
+}
+
+// publicationNamespace returns the namespace a publication created with ctx is attributed to,
+// or an empty namespace if ctx carries no identity.
+func publicationNamespace(ctx context.Context) string {
+	if namespace, ok := ctx.Value(namespaceContextKey{}).(string); ok {
+		return namespace
+	}
+
+	if sid, ok := authn.SpiffeIDFromContext(ctx); ok {
+		return sid.TrustDomain().String()
+	}
+
+	return ""
+}
+
+// queryLabelTypes maps the search query types to the label types they match.
+var queryLabelTypes = map[searchv1.RecordQueryType]types.LabelType{
+	searchv1.RecordQueryType_RECORD_QUERY_TYPE_SKILL_NAME:  types.LabelTypeSkill,
+	searchv1.RecordQueryType_RECORD_QUERY_TYPE_DOMAIN_NAME: types.LabelTypeDomain,
+	searchv1.RecordQueryType_RECORD_QUERY_TYPE_MODULE:      types.LabelTypeModule,
+}
+
+// publicationLabels returns the sorted labels a publication is summarized under.
+// These are the labels of the referenced records, or the labels matched exactly by the queries.
+func (s *Service) publicationLabels(req *routingv1.PublishRequest) ([]string, error) {
+	var labels []string
+
+	for _, query := range req.GetQueries().GetQueries() {
+		if labelType, ok := queryLabelTypes[query.GetType()]; ok && !databaseutils.ContainsWildcards(query.GetValue()) {
+			labels = append(labels, labelType.Prefix()+query.GetValue())
+		}
+	}
+
+	if refs := req.GetRecordRefs().GetRefs(); len(refs) > 0 {
+		cids := make([]string, 0, len(refs))
+		for _, ref := range refs {
+			cids = append(cids, ref.GetCid())
+		}
+
+		records, err := s.db.GetRecords(types.WithCIDs(cids...))
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		for _, record := range records {
+			labels = append(labels, recordLabels(record)...)
+		}
+	}
+
+	slices.Sort(labels)
+
+	return slices.Compact(labels), nil
+}
+
+// recordLabels returns the labels of an indexed record.
+func recordLabels(record types.Record) []string {
+	data, err := record.GetRecordData()
+	if err != nil || data == nil {
+		return nil
+	}
+
+	var labels []string
+
+	for _, skill := range data.GetSkills() {
+		labels = append(labels, types.LabelTypeSkill.Prefix()+skill.GetName())
+	}
+
+	for _, domain := range data.GetDomains() {
+		labels = append(labels, types.LabelTypeDomain.Prefix()+domain.GetName())
+	}
+
+	for _, module := range data.GetModules() {
+		labels = append(labels, types.LabelTypeModule.Prefix()+module.GetName())
+	}
+
+	for _, locator := range data.GetLocators() {
+		labels = append(labels, types.LabelTypeLocator.Prefix()+locator.GetType())
+	}
+
+	return labels
+}
+
+var (
+	publicationsDesc = prometheus.NewDesc(
+		"dir_publications",
+		"Number of publications that are not completed, by namespace and state.",
+		[]string{"namespace", "state"}, nil,
+	)
+	labelPublicationsDesc = prometheus.NewDesc(
+		"dir_label_publications",
+		"Number of publications that are not completed, by label of the published records and state.",
+		[]string{"label", "state"}, nil,
+	)
+)
+
+// Collector exports the publication summary as gauges.
+// The summary is read from the database on each collection, so that the gauges
+// are consistent with the GetPublicationSummary API.
+type Collector struct {
+	db types.PublicationDatabaseAPI
+}
+
+// NewCollector creates a collector of the publication gauges.
+func NewCollector(db types.PublicationDatabaseAPI) *Collector {
+	return &Collector{db: db}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- publicationsDesc
+	ch <- labelPublicationsDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	summary, err := c.db.SummarizePublications(time.Now())
+	if err != nil {
+		logger.Error("Failed to summarize publications", "error", err)
+
+		ch <- prometheus.NewInvalidMetric(publicationsDesc, err)
+
+		return
+	}
+
+	for namespace, counts := range summary.Namespaces {
+		collectCounts(ch, publicationsDesc, namespace, counts)
+	}
+
+	for label, counts := range summary.Labels {
+		collectCounts(ch, labelPublicationsDesc, label, counts)
+	}
+}
+
+// collectCounts sends a gauge per publication state of a group.
+func collectCounts(ch chan<- prometheus.Metric, desc *prometheus.Desc, group string, counts *types.PublicationCounts) {
+	for state, value := range map[string]uint32{
+		"queued":      counts.Queued,
+		"settling":    counts.Settling,
+		"in_progress": counts.InProgress,
+		"failed":      counts.Failed,
+	} {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(value), group, state)
+	}
+}
@@ -7,4 +7,7 @@ package types
 type WorkItem struct {
 	// PublicationID is the unique identifier of the publication to process
 	PublicationID string
+
+	// Attempt is the number of times the item stalled and was retried by the watchdog
+	Attempt int
 }
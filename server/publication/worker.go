@@ -7,14 +7,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
 	databaseutils "github.com/agntcy/dir/server/database/utils"
+	"github.com/agntcy/dir/server/events"
 	publypes "github.com/agntcy/dir/server/publication/types"
+	"github.com/agntcy/dir/server/signpolicy"
 	"github.com/agntcy/dir/server/types"
 	"github.com/agntcy/dir/server/types/adapters"
+	"github.com/agntcy/dir/server/watchdog"
 )
 
 // Worker processes publication requests from the work queue.
@@ -23,19 +27,31 @@ type Worker struct {
 	db        types.DatabaseAPI
 	store     types.StoreAPI
 	routing   types.RoutingAPI
+	policy    *signpolicy.Evaluator
 	workQueue <-chan publypes.WorkItem
 	timeout   time.Duration
+	spread    time.Duration
+
+	// Stalled work items are retried through the retry queue
+	watchdog   *watchdog.Watchdog
+	retryQueue chan<- publypes.WorkItem
 }
 
 // NewWorker creates a new worker instance.
-func NewWorker(id int, db types.DatabaseAPI, store types.StoreAPI, routing types.RoutingAPI, workQueue <-chan publypes.WorkItem, timeout time.Duration) *Worker {
+// The announcements of each publication are spread evenly over the spread window.
+// Work items stalled according to the watchdog are sent back to the retry queue, if the watchdog is set.
+func NewWorker(id int, db types.DatabaseAPI, store types.StoreAPI, routing types.RoutingAPI, policy *signpolicy.Evaluator, workQueue <-chan publypes.WorkItem, timeout, spread time.Duration, wd *watchdog.Watchdog, retryQueue chan<- publypes.WorkItem) *Worker {
 	return &Worker{
-		id:        id,
-		db:        db,
-		store:     store,
-		routing:   routing,
-		workQueue: workQueue,
-		timeout:   timeout,
+		id:         id,
+		db:         db,
+		store:      store,
+		routing:    routing,
+		policy:     policy,
+		workQueue:  workQueue,
+		timeout:    timeout,
+		spread:     spread,
+		watchdog:   wd,
+		retryQueue: retryQueue,
 	}
 }
 
@@ -54,17 +70,41 @@ func (w *Worker) Run(ctx context.Context, stopCh <-chan struct{}) {
 
 			return
 		case workItem := <-w.workQueue:
-			w.processPublication(ctx, workItem)
+			w.supervisePublication(ctx, workItem)
 		}
 	}
 }
 
+// supervisePublication processes a publication under the watchdog, which cancels it if it makes
+// no progress for the worker timeout. Stalled publications are retried after a backoff,
+// and marked as failed once they exceeded the maximum number of retries.
+func (w *Worker) supervisePublication(ctx context.Context, workItem publypes.WorkItem) {
+	err := w.watchdog.Supervise(ctx, "publication", workItem.PublicationID, w.id, workItem.Attempt, w.timeout+w.spread, func(ctx context.Context) {
+		w.processPublication(ctx, workItem)
+	})
+	if err == nil {
+		return
+	}
+
+	attempt := workItem.Attempt
+	workItem.Attempt++
+
+	if watchdog.Retry(ctx, w.watchdog, w.retryQueue, workItem, attempt) {
+		logger.Warn("Publication stalled, retrying", "worker_id", w.id, "publication_id", workItem.PublicationID, "attempt", workItem.Attempt)
+
+		return
+	}
+
+	logger.Error("Publication stalled too many times", "worker_id", w.id, "publication_id", workItem.PublicationID)
+	w.markPublicationFailed(workItem.PublicationID)
+}
+
 // processPublication processes a single publication request.
 func (w *Worker) processPublication(ctx context.Context, workItem publypes.WorkItem) {
 	logger.Info("Processing publication", "worker_id", w.id, "publication_id", workItem.PublicationID)
 
-	// Create a timeout context for this operation
-	timeoutCtx, cancel := context.WithTimeout(ctx, w.timeout)
+	// Create a timeout context for this operation, leaving room for the spread announcements
+	timeoutCtx, cancel := context.WithTimeout(ctx, w.timeout+w.spread)
 	defer cancel()
 
 	// Get the publication from database
@@ -84,7 +124,12 @@ func (w *Worker) processPublication(ctx context.Context, workItem publypes.WorkI
 		return
 	}
 
+	// Link the published events to the publication, and to the push that triggered automatic publications
+	timeoutCtx = events.WithMetadata(timeoutCtx, publicationMetadata(workItem.PublicationID, request.GetOrigin()))
+
 	// Get CIDs to publish based on the request type
+	watchdog.Checkpoint(timeoutCtx, "listing records")
+
 	cids, err := w.getCIDsFromRequest(timeoutCtx, request)
 	if err != nil {
 		logger.Error("Failed to get CIDs from request", "publication_id", workItem.PublicationID, "error", err)
@@ -100,24 +145,50 @@ func (w *Worker) processPublication(ctx context.Context, workItem publypes.WorkI
 		return
 	}
 
-	// Announce each CID to the DHT
-	successCount := 0
+	// Announce each CID to the DHT, staggered over the spread window
+	schedule := newAnnounceSchedule(time.Now(), len(cids), w.spread)
+	w.updateSchedule(workItem.PublicationID, schedule)
+
+	for i, cid := range cids {
+		if err := waitUntil(timeoutCtx, schedule.announceTime(i)); err != nil {
+			logger.Error("Publication interrupted before all CIDs were announced", "publication_id", workItem.PublicationID,
+				"announced", schedule.announced, "remaining", len(cids)-i, "error", err)
+
+			break
+		}
+
+		watchdog.Checkpoint(timeoutCtx, "announcing "+cid)
 
-	for _, cid := range cids {
 		if err := w.announceToDHT(timeoutCtx, cid); err != nil {
+			schedule.failed++
+
 			logger.Error("Failed to announce CID to DHT", "publication_id", workItem.PublicationID, "cid", cid, "error", err)
 		} else {
-			successCount++
+			schedule.announced++
 
 			logger.Debug("Successfully announced CID to DHT", "publication_id", workItem.PublicationID, "cid", cid)
 		}
+
+		// Report progress after each announcement only when spreading, bursts are reported once done
+		if schedule.interval > 0 {
+			w.updateSchedule(workItem.PublicationID, schedule)
+		}
+	}
+
+	if schedule.interval == 0 {
+		w.updateSchedule(workItem.PublicationID, schedule)
+	}
+
+	// Stalled publications are retried or marked as failed by the watchdog
+	if errors.Is(context.Cause(timeoutCtx), watchdog.ErrStalled) {
+		return
 	}
 
 	logger.Info("Publication processing completed", "worker_id", w.id, "publication_id", workItem.PublicationID,
-		"total_cids", len(cids), "successful_announcements", successCount)
+		"total_cids", len(cids), "successful_announcements", schedule.announced)
 
 	// Mark as completed if we announced all CIDs successfully
-	if successCount == len(cids) {
+	if schedule.announced == len(cids) {
 		w.markPublicationCompleted(workItem.PublicationID)
 	} else {
 		w.markPublicationFailed(workItem.PublicationID)
@@ -143,7 +214,9 @@ func (w *Worker) getCIDsFromRequest(_ context.Context, request *routingv1.Publis
 			return nil, fmt.Errorf("failed to convert query to filter options: %w", err)
 		}
 
-		// Get CIDs using the filter options
+		// Get CIDs using the filter options, skipping records under embargo
+		filterOpts = append(filterOpts, types.WithEmbargoes(time.Now(), ""))
+
 		return w.db.GetRecordCIDs(filterOpts...) //nolint:wrapcheck
 
 	default:
@@ -153,6 +226,31 @@ func (w *Worker) getCIDsFromRequest(_ context.Context, request *routingv1.Publis
 
 // announceToDHT announces a single CID to the DHT.
 func (w *Worker) announceToDHT(ctx context.Context, cid string) error {
+	// Never announce records under embargo, even if their publication was confirmed
+	embargoUntil, _, err := w.db.GetRecordEmbargo(cid)
+	if err != nil {
+		return fmt.Errorf("failed to get record embargo: %w", err)
+	}
+
+	if embargoUntil.After(time.Now()) {
+		return fmt.Errorf("record is under embargo until %s", embargoUntil.Format(time.RFC3339))
+	}
+
+	// Never announce records blocked by content scanning
+	verdict, findings, err := w.db.GetRecordScan(cid)
+	if err != nil {
+		return fmt.Errorf("failed to get record scan: %w", err)
+	}
+
+	if verdict == types.ScanVerdictBlocked {
+		return fmt.Errorf("record is blocked by content scanning: %s", strings.Join(findings, "; "))
+	}
+
+	// Only publish records satisfying the signature policies
+	if err := w.policy.Check(ctx, cid); err != nil {
+		return fmt.Errorf("failed to check signature policies: %w", err)
+	}
+
 	// Create a RecordRef for the CID
 	recordRef := &corev1.RecordRef{
 		Cid: cid,
@@ -176,6 +274,43 @@ func (w *Worker) announceToDHT(ctx context.Context, cid string) error {
 	return nil
 }
 
+// publicationMetadata returns the metadata attached to the events published for a publication.
+func publicationMetadata(publicationID string, origin *routingv1.PublicationOrigin) map[string]string {
+	metadata := map[string]string{"publication_id": publicationID}
+
+	if origin != nil {
+		metadata["auto_publish_rule"] = origin.GetRule()
+		metadata["pushed_event_id"] = origin.GetPushedEventId()
+	}
+
+	return metadata
+}
+
+// updateSchedule stores the announcement schedule and progress of a publication.
+func (w *Worker) updateSchedule(publicationID string, schedule *announceSchedule) {
+	if err := w.db.UpdatePublicationSchedule(publicationID, schedule.toProto()); err != nil {
+		logger.Error("Failed to update publication schedule", "publication_id", publicationID, "error", err)
+	}
+}
+
+// waitUntil waits until the given time or until the context is done.
+func waitUntil(ctx context.Context, t time.Time) error {
+	delay := time.Until(t)
+	if delay <= 0 {
+		return ctx.Err() //nolint:wrapcheck
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck
+	case <-timer.C:
+		return nil
+	}
+}
+
 // markPublicationCompleted marks a publication as completed.
 func (w *Worker) markPublicationCompleted(publicationID string) {
 	if err := w.db.UpdatePublicationStatus(publicationID, routingv1.PublicationStatus_PUBLICATION_STATUS_COMPLETED); err != nil {
@@ -0,0 +1,192 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package routing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/server/routing/pubsub"
+	"github.com/agntcy/dir/server/types"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// peerCapabilitiesPrefix is the datastore key prefix for capabilities advertised by remote peers.
+const peerCapabilitiesPrefix = "peer_capabilities/"
+
+// newLocalCapabilities builds the capabilities this node advertises to the network.
+func newLocalCapabilities(opts types.APIOptions) pubsub.CapabilitiesEvent {
+	var maxMessageSize uint64
+	if size := opts.Config().Connection.WithDefaults().MaxRecvMsgSize; size > 0 {
+		maxMessageSize = uint64(size)
+	}
+
+	return pubsub.CapabilitiesEvent{
+		APIVersions:    []string{types.APIVersion},
+		SchemaVersions: types.SupportedSchemaVersions,
+		MaxMessageSize: maxMessageSize,
+		// Index-only nodes do not accept pushes
+		ReadOnly: opts.Config().Routing.ReadOnly || opts.Config().Proxy.IndexOnly,
+	}
+}
+
+// startCapabilityAnnouncements starts a background goroutine that periodically
+// announces this node's capabilities via GossipSub.
+//
+// This method should only be called when GossipSub is enabled.
+func (r *routeRemote) startCapabilityAnnouncements(capabilities pubsub.CapabilitiesEvent) {
+	if r.pubsubManager == nil {
+		return
+	}
+
+	announce := func() {
+		event := capabilities
+		event.Timestamp = time.Now()
+
+		if err := r.pubsubManager.PublishCapabilities(r.ctx, &event); err != nil {
+			remoteLogger.Warn("Failed to announce capabilities", "error", err)
+		}
+	}
+
+	r.wg.Add(1)
+
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(CapabilitiesInterval)
+		defer ticker.Stop()
+
+		announce()
+
+		for {
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-ticker.C:
+				announce()
+			}
+		}
+	}()
+}
+
+// handleCapabilitiesEvent stores the capabilities announced by a remote peer,
+// replacing any previously announced ones.
+func (r *routeRemote) handleCapabilitiesEvent(ctx context.Context, authenticatedPeerID string, event *pubsub.CapabilitiesEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		remoteLogger.Warn("Failed to marshal peer capabilities", "peer", authenticatedPeerID, "error", err)
+
+		return
+	}
+
+	if err := r.dstore.Put(ctx, datastore.NewKey(peerCapabilitiesPrefix+authenticatedPeerID), data); err != nil {
+		remoteLogger.Warn("Failed to store peer capabilities", "peer", authenticatedPeerID, "error", err)
+
+		return
+	}
+
+	remoteLogger.Debug("Stored peer capabilities", "peer", authenticatedPeerID, "apiVersions", event.APIVersions)
+}
+
+// getPeerCapabilities returns the capabilities announced by a remote peer.
+// Returns nil if the peer has not announced capabilities recently.
+func (r *routeRemote) getPeerCapabilities(ctx context.Context, peerID string) *routingv1.PeerCapabilities {
+	data, err := r.dstore.Get(ctx, datastore.NewKey(peerCapabilitiesPrefix+peerID))
+	if err != nil {
+		return nil
+	}
+
+	var event pubsub.CapabilitiesEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		remoteLogger.Warn("Failed to unmarshal peer capabilities", "peer", peerID, "error", err)
+
+		return nil
+	}
+
+	// Treat capabilities that have not been re-announced as unknown
+	if time.Since(event.Timestamp) > MaxLabelAge {
+		return nil
+	}
+
+	return &routingv1.PeerCapabilities{
+		ApiVersions:    event.APIVersions,
+		SchemaVersions: event.SchemaVersions,
+		MaxMessageSize: event.MaxMessageSize,
+		ReadOnly:       event.ReadOnly,
+		UpdatedAt:      event.Timestamp.Format(time.RFC3339),
+	}
+}
+
+// isPeerCompatible checks the capabilities announced by a remote peer against this node.
+// Results are memoized in the given map for the duration of a single operation.
+func (r *routeRemote) isPeerCompatible(ctx context.Context, peerID string, checked map[string]bool) bool {
+	if compatible, ok := checked[peerID]; ok {
+		return compatible
+	}
+
+	err := types.CheckPeerCompatibility(r.getPeerCapabilities(ctx, peerID))
+	if err != nil {
+		remoteLogger.Debug("Skipping incompatible peer", "peer", peerID, "error", err)
+	}
+
+	checked[peerID] = err == nil
+
+	return err == nil
+}
+
+// ListPeers returns the connected peers and the peers that announced capabilities.
+func (r *routeRemote) ListPeers(ctx context.Context) ([]*routingv1.Peer, error) {
+	host := r.server.Host()
+	localPeerID := host.ID().String()
+
+	var peerIDs []string
+
+	add := func(peerID string) {
+		if peerID != localPeerID && !slices.Contains(peerIDs, peerID) {
+			peerIDs = append(peerIDs, peerID)
+		}
+	}
+
+	for _, p := range host.Network().Peers() {
+		add(p.String())
+	}
+
+	results, err := r.dstore.Query(ctx, query.Query{Prefix: "/" + peerCapabilitiesPrefix, KeysOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query peer capabilities: %w", err)
+	}
+	defer results.Close()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			continue
+		}
+
+		add(strings.TrimPrefix(result.Key, "/"+peerCapabilitiesPrefix))
+	}
+
+	slices.Sort(peerIDs)
+
+	peers := make([]*routingv1.Peer, 0, len(peerIDs))
+
+	for _, peerID := range peerIDs {
+		info := r.createPeerInfo(ctx, peerID)
+
+		if pid, err := peer.Decode(peerID); err == nil && host.Network().Connectedness(pid) == network.Connected {
+			info.Connection = routingv1.PeerConnectionType_PEER_CONNECTION_TYPE_CONNECTED
+		}
+
+		peers = append(peers, info)
+	}
+
+	return peers, nil
+}
@@ -0,0 +1,87 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package routing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/agntcy/dir/server/routing/pubsub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerCapabilities(t *testing.T) {
+	ctx := t.Context()
+
+	dstore, cleanup := setupTestDatastore(t)
+	defer cleanup()
+
+	r := &routeRemote{
+		dstore: dstore,
+	}
+
+	t.Run("unknown peer", func(t *testing.T) {
+		assert.Nil(t, r.getPeerCapabilities(ctx, "unknown-peer"))
+		assert.True(t, r.isPeerCompatible(ctx, "unknown-peer", map[string]bool{}))
+	})
+
+	t.Run("announced capabilities are stored", func(t *testing.T) {
+		r.handleCapabilitiesEvent(ctx, "compatible-peer", &pubsub.CapabilitiesEvent{
+			APIVersions:    []string{"v1"},
+			SchemaVersions: []string{"0.8.0"},
+			MaxMessageSize: 1024,
+			ReadOnly:       true,
+			Timestamp:      time.Now(),
+		})
+
+		capabilities := r.getPeerCapabilities(ctx, "compatible-peer")
+		require.NotNil(t, capabilities)
+		assert.Equal(t, []string{"v1"}, capabilities.GetApiVersions())
+		assert.Equal(t, []string{"0.8.0"}, capabilities.GetSchemaVersions())
+		assert.Equal(t, uint64(1024), capabilities.GetMaxMessageSize())
+		assert.True(t, capabilities.GetReadOnly())
+		assert.NotEmpty(t, capabilities.GetUpdatedAt())
+
+		assert.True(t, r.isPeerCompatible(ctx, "compatible-peer", map[string]bool{}))
+	})
+
+	t.Run("incompatible peer", func(t *testing.T) {
+		r.handleCapabilitiesEvent(ctx, "incompatible-peer", &pubsub.CapabilitiesEvent{
+			APIVersions: []string{"v2"},
+			Timestamp:   time.Now(),
+		})
+
+		assert.False(t, r.isPeerCompatible(ctx, "incompatible-peer", map[string]bool{}))
+	})
+
+	t.Run("stale capabilities are ignored", func(t *testing.T) {
+		r.handleCapabilitiesEvent(ctx, "stale-peer", &pubsub.CapabilitiesEvent{
+			APIVersions: []string{"v2"},
+			Timestamp:   time.Now().Add(-MaxLabelAge - time.Hour),
+		})
+
+		assert.Nil(t, r.getPeerCapabilities(ctx, "stale-peer"))
+		assert.True(t, r.isPeerCompatible(ctx, "stale-peer", map[string]bool{}))
+	})
+}
+
+func TestCapabilitiesEvent(t *testing.T) {
+	event := &pubsub.CapabilitiesEvent{
+		APIVersions:    []string{"v1"},
+		SchemaVersions: []string{"0.7.0"},
+		Timestamp:      time.Now().UTC(),
+	}
+
+	data, err := event.Marshal()
+	require.NoError(t, err)
+
+	decoded, err := pubsub.UnmarshalCapabilitiesEvent(data)
+	require.NoError(t, err)
+	assert.Equal(t, event.APIVersions, decoded.APIVersions)
+	assert.Equal(t, event.SchemaVersions, decoded.SchemaVersions)
+
+	_, err = pubsub.UnmarshalCapabilitiesEvent([]byte(`{"timestamp":"2025-10-01T10:00:00Z"}`))
+	require.ErrorContains(t, err, "no API versions provided")
+}
@@ -13,9 +13,39 @@ var (
 
 	// GossipSub default (only enable/disable is configurable).
 	DefaultGossipSubEnabled = true
+
+	// DefaultPopularityEnabled is the default for record popularity gossip.
+	DefaultPopularityEnabled = true
+
+	// DefaultMode is the default routing mode.
+	DefaultMode = ModeDHT
+
+	// DefaultFederationHealthCheckInterval is the default interval between federation peer health checks.
+	DefaultFederationHealthCheckInterval = 30 * time.Second
+
+	// DefaultFederationRequestTimeout is the default timeout of requests to federation peers.
+	DefaultFederationRequestTimeout = 10 * time.Second
+)
+
+// Routing modes.
+const (
+	// ModeDHT discovers peers and records over a libp2p DHT and GossipSub.
+	ModeDHT = "dht"
+
+	// ModeFederation searches a static list of peer Directory APIs over gRPC,
+	// for deployments that cannot run a DHT.
+	ModeFederation = "federation"
+
+	// ModeLocal disables routing across the network: records are published and
+	// listed on this node only, and remote search returns no results.
+	ModeLocal = "local"
 )
 
 type Config struct {
+	// Mode selects how remote records are discovered: "dht" (default), "federation",
+	// or "local" to disable routing across the network.
+	Mode string `json:"mode,omitempty" mapstructure:"mode"`
+
 	// Address to use for routing
 	ListenAddress string `json:"listen_address,omitempty" mapstructure:"listen_address"`
 
@@ -41,6 +71,46 @@ type Config struct {
 
 	// GossipSub configuration for label announcements
 	GossipSub GossipSubConfig `json:"gossipsub,omitempty" mapstructure:"gossipsub"`
+
+	// ReadOnly advertises this node as read-only in its capabilities,
+	// so that peers do not attempt to write to it.
+	ReadOnly bool `json:"read_only,omitempty" mapstructure:"read_only"`
+
+	// Federation configures the static peers used in federation mode.
+	Federation FederationConfig `json:"federation,omitempty" mapstructure:"federation"`
+
+	// Popularity configures the gossip of record pull counts.
+	Popularity PopularityConfig `json:"popularity,omitempty" mapstructure:"popularity"`
+}
+
+// PopularityConfig configures record popularity gossip.
+// When enabled, nodes periodically announce aggregate pull counts per CID via GossipSub
+// and rank remote search results by their network-wide pull count.
+// Announcements contain only CIDs and counts, never who pulled a record.
+// Requires GossipSub to be enabled.
+type PopularityConfig struct {
+	// Enabled controls participation in popularity gossip.
+	// When false, pull counts are neither announced nor received,
+	// and search results are not ranked by popularity.
+	// Default: true
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+}
+
+// FederationConfig configures the federation routing mode.
+// In federation mode no DHT is started: remote searches are sent to the
+// SearchService of the configured peers, and records are only published locally.
+type FederationConfig struct {
+	// Peers are the Directory API addresses of the federated peers, e.g. "dir.example.com:8888".
+	Peers []string `json:"peers,omitempty" mapstructure:"peers"`
+
+	// HealthCheckInterval is the interval between peer health checks.
+	// Unhealthy peers are skipped by searches until they recover.
+	// Default: 30s
+	HealthCheckInterval time.Duration `json:"health_check_interval,omitempty" mapstructure:"health_check_interval"`
+
+	// RequestTimeout is the timeout of health checks and searches sent to a peer.
+	// Default: 10s
+	RequestTimeout time.Duration `json:"request_timeout,omitempty" mapstructure:"request_timeout"`
 }
 
 // GossipSubConfig configures GossipSub-based label announcements.
@@ -23,6 +23,11 @@ const (
 	// RefreshInterval defines how often DHT routing tables are refreshed.
 	// This is a shorter interval for maintaining network connectivity.
 	RefreshInterval = 30 * time.Second
+	// CapabilitiesInterval defines how often node capabilities are announced via GossipSub.
+	// Capabilities are re-announced periodically so that newly joined peers learn them.
+	CapabilitiesInterval = 5 * time.Minute
+	// PopularityInterval defines how often record pull counts are announced via GossipSub.
+	PopularityInterval = 10 * time.Minute
 )
 
 // Protocol constants for libp2p DHT and discovery.
@@ -46,6 +51,10 @@ const (
 	// Labels older than this will be cleaned up during periodic cleanup cycles.
 	MaxLabelAge = 72 * time.Hour
 
+	// MaxPopularityAge defines when popularity announcements of a peer are considered stale.
+	// Counts of peers that stopped announcing no longer contribute to network-wide popularity.
+	MaxPopularityAge = 6 * PopularityInterval
+
 	// DefaultMinMatchScore defines the minimum allowed match score for production safety.
 	// Per proto specification: "If not set, it will return records that match at least one query".
 	// Any value below this threshold is automatically corrected to this value.
@@ -182,3 +182,22 @@ func withRandomIdentity() Option {
 		return nil
 	}
 }
+
+// IdentityPeerID returns the peer ID of the identity key stored at keyPath,
+// or of a random identity if keyPath is empty, without starting a host.
+func IdentityPeerID(keyPath string) (string, error) {
+	opts := &options{}
+
+	for _, opt := range []Option{WithIdentityKeyPath(keyPath), withRandomIdentity()} {
+		if err := opt(opts); err != nil {
+			return "", err
+		}
+	}
+
+	id, err := peer.IDFromPrivateKey(opts.Key)
+	if err != nil {
+		return "", fmt.Errorf("failed to derive peer ID: %w", err)
+	}
+
+	return id.String(), nil
+}
@@ -0,0 +1,248 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package routing
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/routing/pubsub"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+)
+
+const (
+	// localPopularityPrefix is the datastore key prefix for pull counts of records served by this node.
+	localPopularityPrefix = "popularity/"
+
+	// peerPopularityPrefix is the datastore key prefix for pull counts announced by remote peers.
+	peerPopularityPrefix = "peer_popularity/"
+)
+
+// startPopularityTracking counts the pulls of records served by this node
+// from the record pulled events published on the event bus.
+func (r *routeRemote) startPopularityTracking(bus *events.SafeEventBus) {
+	subID, eventCh := bus.Subscribe(&eventsv1.ListenRequest{
+		EventTypes: []eventsv1.EventType{eventsv1.EventType_EVENT_TYPE_RECORD_PULLED},
+	})
+	if eventCh == nil {
+		remoteLogger.Info("Event bus disabled, record pulls are not counted for popularity gossip")
+
+		return
+	}
+
+	r.wg.Add(1)
+
+	go func() {
+		defer r.wg.Done()
+		defer bus.Unsubscribe(subID)
+
+		for {
+			select {
+			case <-r.ctx.Done():
+				return
+			case event, ok := <-eventCh:
+				if !ok {
+					return
+				}
+
+				r.incrementLocalPullCount(r.ctx, event.ResourceID)
+			}
+		}
+	}()
+}
+
+// incrementLocalPullCount increments the pull count of a record served by this node.
+func (r *routeRemote) incrementLocalPullCount(ctx context.Context, cid string) {
+	if cid == "" {
+		return
+	}
+
+	key := datastore.NewKey(localPopularityPrefix + cid)
+
+	var count uint64
+
+	if data, err := r.dstore.Get(ctx, key); err == nil {
+		count, _ = strconv.ParseUint(string(data), 10, 64)
+	}
+
+	if err := r.dstore.Put(ctx, key, []byte(strconv.FormatUint(count+1, 10))); err != nil {
+		remoteLogger.Warn("Failed to store record pull count", "cid", cid, "error", err)
+	}
+}
+
+// localPullCounts returns the pull counts of records served by this node.
+func (r *routeRemote) localPullCounts(ctx context.Context) map[string]uint64 {
+	counts := make(map[string]uint64)
+
+	results, err := r.dstore.Query(ctx, query.Query{Prefix: "/" + localPopularityPrefix})
+	if err != nil {
+		remoteLogger.Warn("Failed to query record pull counts", "error", err)
+
+		return counts
+	}
+	defer results.Close()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			continue
+		}
+
+		count, err := strconv.ParseUint(string(result.Value), 10, 64)
+		if err != nil || count == 0 {
+			continue
+		}
+
+		counts[strings.TrimPrefix(result.Key, "/"+localPopularityPrefix)] = count
+	}
+
+	return counts
+}
+
+// newPopularityEvent builds the announcement of the most pulled records served by this node.
+// Returns nil if no record was pulled.
+func (r *routeRemote) newPopularityEvent(ctx context.Context) *pubsub.PopularityEvent {
+	counts := r.localPullCounts(ctx)
+	if len(counts) == 0 {
+		return nil
+	}
+
+	cids := make([]string, 0, len(counts))
+	for cid := range counts {
+		cids = append(cids, cid)
+	}
+
+	// Announce the most pulled records if they do not fit into a single announcement
+	slices.SortFunc(cids, func(a, b string) int {
+		return cmp.Or(cmp.Compare(counts[b], counts[a]), strings.Compare(a, b))
+	})
+
+	event := &pubsub.PopularityEvent{
+		Counts:    make(map[string]uint64),
+		Timestamp: time.Now(),
+	}
+
+	for _, cid := range cids[:min(len(cids), pubsub.MaxPopularityEntries)] {
+		event.Counts[cid] = counts[cid]
+	}
+
+	return event
+}
+
+// startPopularityAnnouncements starts a background goroutine that periodically
+// announces the pull counts of records served by this node via GossipSub.
+//
+// This method should only be called when GossipSub and popularity gossip are enabled.
+func (r *routeRemote) startPopularityAnnouncements() {
+	if r.pubsubManager == nil {
+		return
+	}
+
+	announce := func() {
+		event := r.newPopularityEvent(r.ctx)
+		if event == nil {
+			return
+		}
+
+		if err := r.pubsubManager.PublishPopularity(r.ctx, event); err != nil {
+			remoteLogger.Warn("Failed to announce record popularity", "error", err)
+		}
+	}
+
+	r.wg.Add(1)
+
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(PopularityInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-ticker.C:
+				announce()
+			}
+		}
+	}()
+}
+
+// handlePopularityEvent stores the pull counts announced by a remote peer,
+// replacing any previously announced ones.
+func (r *routeRemote) handlePopularityEvent(ctx context.Context, authenticatedPeerID string, event *pubsub.PopularityEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		remoteLogger.Warn("Failed to marshal peer popularity", "peer", authenticatedPeerID, "error", err)
+
+		return
+	}
+
+	if err := r.dstore.Put(ctx, datastore.NewKey(peerPopularityPrefix+authenticatedPeerID), data); err != nil {
+		remoteLogger.Warn("Failed to store peer popularity", "peer", authenticatedPeerID, "error", err)
+
+		return
+	}
+
+	remoteLogger.Debug("Stored peer popularity", "peer", authenticatedPeerID, "records", len(event.Counts))
+}
+
+// networkPopularity returns the network-wide pull counts of records,
+// summing the counts of this node and the recent announcements of remote peers.
+func (r *routeRemote) networkPopularity(ctx context.Context) map[string]uint64 {
+	popularity := r.localPullCounts(ctx)
+
+	results, err := r.dstore.Query(ctx, query.Query{Prefix: "/" + peerPopularityPrefix})
+	if err != nil {
+		remoteLogger.Warn("Failed to query peer popularity", "error", err)
+
+		return popularity
+	}
+	defer results.Close()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			continue
+		}
+
+		var event pubsub.PopularityEvent
+		if err := json.Unmarshal(result.Value, &event); err != nil {
+			continue
+		}
+
+		// Ignore peers that stopped announcing, e.g. because they left the network
+		if time.Since(event.Timestamp) > MaxPopularityAge {
+			continue
+		}
+
+		for cid, count := range event.Counts {
+			popularity[cid] += count
+		}
+	}
+
+	return popularity
+}
+
+// rankByPopularity sets the popularity of the search results and sorts them
+// by match score, then by popularity, both descending.
+func rankByPopularity(results []*routingv1.SearchResponse, popularity map[string]uint64) {
+	for _, result := range results {
+		result.Popularity = popularity[result.GetRecordRef().GetCid()]
+	}
+
+	slices.SortStableFunc(results, func(a, b *routingv1.SearchResponse) int {
+		return cmp.Or(
+			cmp.Compare(b.GetMatchScore(), a.GetMatchScore()),
+			cmp.Compare(b.GetPopularity(), a.GetPopularity()),
+		)
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package routing
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/server/routing/pubsub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkPopularity(t *testing.T) {
+	ctx := t.Context()
+
+	dstore, cleanup := setupTestDatastore(t)
+	defer cleanup()
+
+	r := &routeRemote{
+		dstore: dstore,
+	}
+
+	t.Run("no pulls", func(t *testing.T) {
+		assert.Nil(t, r.newPopularityEvent(ctx))
+		assert.Empty(t, r.networkPopularity(ctx))
+	})
+
+	t.Run("local pulls are counted and announced", func(t *testing.T) {
+		r.incrementLocalPullCount(ctx, "cid-a")
+		r.incrementLocalPullCount(ctx, "cid-a")
+		r.incrementLocalPullCount(ctx, "cid-b")
+		r.incrementLocalPullCount(ctx, "")
+
+		event := r.newPopularityEvent(ctx)
+		require.NotNil(t, event)
+		assert.Equal(t, map[string]uint64{"cid-a": 2, "cid-b": 1}, event.Counts)
+		require.NoError(t, event.Validate())
+	})
+
+	t.Run("peer announcements are summed", func(t *testing.T) {
+		r.handlePopularityEvent(ctx, "peer-1", &pubsub.PopularityEvent{
+			Counts:    map[string]uint64{"cid-a": 10, "cid-c": 5},
+			Timestamp: time.Now(),
+		})
+		r.handlePopularityEvent(ctx, "peer-2", &pubsub.PopularityEvent{
+			Counts:    map[string]uint64{"cid-c": 1},
+			Timestamp: time.Now(),
+		})
+
+		assert.Equal(t, map[string]uint64{"cid-a": 12, "cid-b": 1, "cid-c": 6}, r.networkPopularity(ctx))
+	})
+
+	t.Run("announcements replace previous ones", func(t *testing.T) {
+		r.handlePopularityEvent(ctx, "peer-2", &pubsub.PopularityEvent{
+			Counts:    map[string]uint64{"cid-c": 3},
+			Timestamp: time.Now(),
+		})
+
+		assert.Equal(t, uint64(8), r.networkPopularity(ctx)["cid-c"])
+	})
+
+	t.Run("stale announcements are ignored", func(t *testing.T) {
+		r.handlePopularityEvent(ctx, "peer-1", &pubsub.PopularityEvent{
+			Counts:    map[string]uint64{"cid-a": 10},
+			Timestamp: time.Now().Add(-MaxPopularityAge - time.Minute),
+		})
+
+		assert.Equal(t, uint64(2), r.networkPopularity(ctx)["cid-a"])
+	})
+}
+
+func TestNewPopularityEventLimit(t *testing.T) {
+	ctx := t.Context()
+
+	dstore, cleanup := setupTestDatastore(t)
+	defer cleanup()
+
+	r := &routeRemote{
+		dstore: dstore,
+	}
+
+	for i := range pubsub.MaxPopularityEntries + 10 {
+		for range i + 1 {
+			r.incrementLocalPullCount(ctx, fmt.Sprintf("cid-%03d", i))
+		}
+	}
+
+	// Only the most pulled records are announced
+	event := r.newPopularityEvent(ctx)
+	require.NotNil(t, event)
+	assert.Len(t, event.Counts, pubsub.MaxPopularityEntries)
+	assert.NotContains(t, event.Counts, "cid-000")
+	assert.Contains(t, event.Counts, fmt.Sprintf("cid-%03d", pubsub.MaxPopularityEntries+9))
+}
+
+func TestRankByPopularity(t *testing.T) {
+	result := func(cid string, score uint32) *routingv1.SearchResponse {
+		return &routingv1.SearchResponse{RecordRef: &corev1.RecordRef{Cid: cid}, MatchScore: score}
+	}
+
+	results := []*routingv1.SearchResponse{
+		result("unknown", 1),
+		result("popular", 1),
+		result("best-match", 2),
+		result("less-popular", 1),
+	}
+
+	rankByPopularity(results, map[string]uint64{"popular": 100, "less-popular": 5, "best-match": 1})
+
+	var cids []string
+	for _, r := range results {
+		cids = append(cids, r.GetRecordRef().GetCid())
+	}
+
+	// Match score takes precedence, popularity ranks results with equal scores
+	assert.Equal(t, []string{"best-match", "popular", "less-popular", "unknown"}, cids)
+	assert.Equal(t, uint64(100), results[1].GetPopularity())
+	assert.Zero(t, results[3].GetPopularity())
+}
+
+func TestPopularityEvent(t *testing.T) {
+	event := &pubsub.PopularityEvent{
+		Counts:    map[string]uint64{"bafy-test": 42},
+		Timestamp: time.Now().UTC(),
+	}
+
+	data, err := event.Marshal()
+	require.NoError(t, err)
+
+	decoded, err := pubsub.UnmarshalPopularityEvent(data)
+	require.NoError(t, err)
+	assert.Equal(t, event.Counts, decoded.Counts)
+
+	_, err = pubsub.UnmarshalPopularityEvent([]byte(`{"timestamp":"2025-10-01T10:00:00Z"}`))
+	require.ErrorContains(t, err, "no counts provided")
+
+	counts := make(map[string]uint64)
+	for i := range pubsub.MaxPopularityEntries + 1 {
+		counts[fmt.Sprintf("cid-%d", i)] = 1
+	}
+
+	require.ErrorContains(t, (&pubsub.PopularityEvent{Counts: counts, Timestamp: time.Now()}).Validate(), "too many counts")
+}
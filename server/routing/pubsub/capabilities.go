@@ -0,0 +1,93 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// CapabilitiesEvent is the wire format for node capability announcements via GossipSub.
+// Peers store the latest announcement received from each peer and use it
+// to avoid incompatible calls.
+//
+// As for RecordPublishEvent, the PeerID is NOT included in the wire format.
+// Recipients use the authenticated sender (msg.ReceivedFrom) instead.
+//
+// Example wire format:
+//
+//	{
+//	  "api_versions": ["v1"],
+//	  "schema_versions": ["0.3.1", "0.7.0", "0.8.0"],
+//	  "max_message_size": 4194304,
+//	  "read_only": false,
+//	  "timestamp": "2025-10-01T10:00:00Z"
+//	}
+type CapabilitiesEvent struct {
+	// APIVersions lists the Directory API versions served by the node.
+	APIVersions []string `json:"api_versions"`
+
+	// SchemaVersions lists the record schema versions the node can decode.
+	SchemaVersions []string `json:"schema_versions"`
+
+	// MaxMessageSize is the maximum message size in bytes accepted by the node's Directory API.
+	MaxMessageSize uint64 `json:"max_message_size"`
+
+	// ReadOnly is set if the node does not accept writes.
+	ReadOnly bool `json:"read_only"`
+
+	// Timestamp is when this announcement was created.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Validate checks if the event is well-formed and safe to process.
+func (e *CapabilitiesEvent) Validate() error {
+	if len(e.APIVersions) == 0 {
+		return errors.New("no API versions provided")
+	}
+
+	if len(e.APIVersions) > MaxCapabilityValues || len(e.SchemaVersions) > MaxCapabilityValues {
+		return errors.New("too many versions")
+	}
+
+	if e.Timestamp.IsZero() {
+		return errors.New("missing timestamp")
+	}
+
+	return nil
+}
+
+// Marshal serializes the event to JSON for network transmission.
+func (e *CapabilitiesEvent) Marshal() ([]byte, error) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal capabilities event: %w", err)
+	}
+
+	if len(data) > MaxMessageSize {
+		return nil, errors.New("event exceeds maximum size")
+	}
+
+	return data, nil
+}
+
+// UnmarshalCapabilitiesEvent deserializes and validates a capabilities event.
+func UnmarshalCapabilitiesEvent(data []byte) (*CapabilitiesEvent, error) {
+	if len(data) > MaxMessageSize {
+		return nil, errors.New("event exceeds maximum size")
+	}
+
+	var event CapabilitiesEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal capabilities event: %w", err)
+	}
+
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
@@ -29,4 +29,20 @@ const (
 	// This prevents abuse from malicious peers.
 	// 100 labels is generous for typical records.
 	MaxLabelsPerAnnouncement = 100
+
+	// TopicCapabilities is the GossipSub topic for node capability announcements.
+	// Versioned independently from the labels topic.
+	TopicCapabilities = "dir/capabilities/v1"
+
+	// MaxCapabilityValues is the maximum number of API or schema versions
+	// per capability announcement.
+	MaxCapabilityValues = 32
+
+	// TopicPopularity is the GossipSub topic for record popularity announcements.
+	// Only nodes with popularity gossip enabled join this topic.
+	TopicPopularity = "dir/popularity/v1"
+
+	// MaxPopularityEntries is the maximum number of CIDs per popularity announcement.
+	// 100 entries of ~60-byte CIDs with counts fit within MaxMessageSize.
+	MaxPopularityEntries = 100
 )
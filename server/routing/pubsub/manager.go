@@ -40,12 +40,28 @@ type Manager struct {
 	localPeerID string
 	topicName   string // Topic name (protocol constant)
 
+	// Capability announcements topic and subscription
+	capTopic *pubsub.Topic
+	capSub   *pubsub.Subscription
+
+	// Popularity announcements topic and subscription (nil unless JoinPopularity was called)
+	popTopic *pubsub.Topic
+	popSub   *pubsub.Subscription
+
 	// Callback invoked when record publish event is received.
 	// Parameters:
 	//   - context.Context: Operation context
 	//   - string: Authenticated peer ID (from msg.ReceivedFrom, cryptographically verified)
 	//   - *RecordPublishEvent: The announcement payload
 	onRecordPublishEvent func(context.Context, string, *RecordPublishEvent)
+
+	// Callback invoked when a capabilities event is received.
+	// Parameters are the same as for onRecordPublishEvent.
+	onCapabilitiesEvent func(context.Context, string, *CapabilitiesEvent)
+
+	// Callback invoked when a popularity event is received.
+	// Parameters are the same as for onRecordPublishEvent.
+	onPopularityEvent func(context.Context, string, *PopularityEvent)
 }
 
 // New creates a new GossipSub manager for label announcements.
@@ -88,6 +104,17 @@ func New(ctx context.Context, h host.Host) (*Manager, error) {
 		return nil, fmt.Errorf("failed to subscribe to labels topic %q: %w", TopicLabels, err)
 	}
 
+	// Join the capabilities topic
+	capTopic, err := ps.Join(TopicCapabilities)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join capabilities topic %q: %w", TopicCapabilities, err)
+	}
+
+	capSub, err := capTopic.Subscribe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to capabilities topic %q: %w", TopicCapabilities, err)
+	}
+
 	manager := &Manager{
 		ctx:         ctx,
 		host:        h,
@@ -96,10 +123,13 @@ func New(ctx context.Context, h host.Host) (*Manager, error) {
 		sub:         sub,
 		localPeerID: h.ID().String(),
 		topicName:   TopicLabels,
+		capTopic:    capTopic,
+		capSub:      capSub,
 	}
 
-	// Start message handler goroutine
+	// Start message handler goroutines
 	go manager.handleMessages()
+	go manager.handleCapabilityMessages()
 
 	logger.Info("GossipSub manager initialized",
 		"topic", TopicLabels,
@@ -217,6 +247,96 @@ func (m *Manager) SetOnRecordPublishEvent(fn func(context.Context, string, *Reco
 	m.onRecordPublishEvent = fn
 }
 
+// PublishCapabilities announces this node's capabilities to the network.
+// The announcement should be repeated periodically so that newly joined peers receive it.
+func (m *Manager) PublishCapabilities(ctx context.Context, event *CapabilitiesEvent) error {
+	if err := event.Validate(); err != nil {
+		return fmt.Errorf("invalid capabilities announcement: %w", err)
+	}
+
+	data, err := event.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal capabilities announcement: %w", err)
+	}
+
+	if err := m.capTopic.Publish(ctx, data); err != nil {
+		return fmt.Errorf("failed to publish capabilities announcement: %w", err)
+	}
+
+	logger.Debug("Published capabilities announcement",
+		"topicPeers", len(m.capTopic.ListPeers()),
+		"size", len(data))
+
+	return nil
+}
+
+// SetOnCapabilitiesEvent sets the callback for received capabilities events.
+// As for SetOnRecordPublishEvent, the callback receives the authenticated peer ID
+// of the sender, which must be used to store the capabilities.
+func (m *Manager) SetOnCapabilitiesEvent(fn func(context.Context, string, *CapabilitiesEvent)) {
+	m.onCapabilitiesEvent = fn
+}
+
+// JoinPopularity joins the popularity topic and starts processing popularity announcements.
+// Popularity gossip is optional, so unlike the labels and capabilities topics
+// the popularity topic is only joined by nodes that participate in it.
+// The callback set with SetOnPopularityEvent should be set before calling this method.
+func (m *Manager) JoinPopularity() error {
+	popTopic, err := m.pubsub.Join(TopicPopularity)
+	if err != nil {
+		return fmt.Errorf("failed to join popularity topic %q: %w", TopicPopularity, err)
+	}
+
+	popSub, err := popTopic.Subscribe()
+	if err != nil {
+		_ = popTopic.Close()
+
+		return fmt.Errorf("failed to subscribe to popularity topic %q: %w", TopicPopularity, err)
+	}
+
+	m.popTopic = popTopic
+	m.popSub = popSub
+
+	go m.handlePopularityMessages()
+
+	return nil
+}
+
+// PublishPopularity announces the pull counts of records served by this node.
+// JoinPopularity must have been called before.
+func (m *Manager) PublishPopularity(ctx context.Context, event *PopularityEvent) error {
+	if m.popTopic == nil {
+		return errors.New("popularity topic not joined")
+	}
+
+	if err := event.Validate(); err != nil {
+		return fmt.Errorf("invalid popularity announcement: %w", err)
+	}
+
+	data, err := event.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal popularity announcement: %w", err)
+	}
+
+	if err := m.popTopic.Publish(ctx, data); err != nil {
+		return fmt.Errorf("failed to publish popularity announcement: %w", err)
+	}
+
+	logger.Debug("Published popularity announcement",
+		"records", len(event.Counts),
+		"topicPeers", len(m.popTopic.ListPeers()),
+		"size", len(data))
+
+	return nil
+}
+
+// SetOnPopularityEvent sets the callback for received popularity events.
+// As for SetOnRecordPublishEvent, the callback receives the authenticated peer ID
+// of the sender, which must be used to store the counts.
+func (m *Manager) SetOnPopularityEvent(fn func(context.Context, string, *PopularityEvent)) {
+	m.onPopularityEvent = fn
+}
+
 // handleMessages is the main message processing loop.
 // It runs in a goroutine and processes all incoming label announcements.
 //
@@ -291,6 +411,84 @@ func (m *Manager) handleMessages() {
 	}
 }
 
+// handleCapabilityMessages processes incoming capability announcements.
+// It follows the same flow and error handling as handleMessages.
+func (m *Manager) handleCapabilityMessages() {
+	for {
+		msg, err := m.capSub.Next(m.ctx)
+		if err != nil {
+			if m.ctx.Err() != nil || errors.Is(err, context.Canceled) || err.Error() == "subscription cancelled" {
+				logger.Debug("Capabilities handler stopping")
+
+				return
+			}
+
+			logger.Error("Error reading from capabilities topic", "error", err)
+
+			continue
+		}
+
+		if msg.ReceivedFrom == m.host.ID() {
+			continue
+		}
+
+		event, err := UnmarshalCapabilitiesEvent(msg.Data)
+		if err != nil {
+			logger.Warn("Received invalid capabilities announcement",
+				"from", msg.ReceivedFrom,
+				"error", err,
+				"size", len(msg.Data))
+
+			continue
+		}
+
+		logger.Debug("Received capabilities announcement", "from", msg.ReceivedFrom.String())
+
+		if m.onCapabilitiesEvent != nil {
+			m.onCapabilitiesEvent(m.ctx, msg.ReceivedFrom.String(), event)
+		}
+	}
+}
+
+// handlePopularityMessages processes incoming popularity announcements.
+// It follows the same flow and error handling as handleMessages.
+func (m *Manager) handlePopularityMessages() {
+	for {
+		msg, err := m.popSub.Next(m.ctx)
+		if err != nil {
+			if m.ctx.Err() != nil || errors.Is(err, context.Canceled) || err.Error() == "subscription cancelled" {
+				logger.Debug("Popularity handler stopping")
+
+				return
+			}
+
+			logger.Error("Error reading from popularity topic", "error", err)
+
+			continue
+		}
+
+		if msg.ReceivedFrom == m.host.ID() {
+			continue
+		}
+
+		event, err := UnmarshalPopularityEvent(msg.Data)
+		if err != nil {
+			logger.Warn("Received invalid popularity announcement",
+				"from", msg.ReceivedFrom,
+				"error", err,
+				"size", len(msg.Data))
+
+			continue
+		}
+
+		logger.Debug("Received popularity announcement", "from", msg.ReceivedFrom.String(), "records", len(event.Counts))
+
+		if m.onPopularityEvent != nil {
+			m.onPopularityEvent(m.ctx, msg.ReceivedFrom.String(), event)
+		}
+	}
+}
+
 // GetTopicPeers returns the list of peers subscribed to the labels topic.
 // This is useful for monitoring network connectivity and debugging.
 //
@@ -325,11 +523,26 @@ func (m *Manager) GetMeshPeerCount() int {
 //   - error: If cleanup fails (rare)
 func (m *Manager) Close() error {
 	m.sub.Cancel()
+	m.capSub.Cancel()
+
+	if m.popSub != nil {
+		m.popSub.Cancel()
+	}
 
 	if err := m.topic.Close(); err != nil {
 		return fmt.Errorf("failed to close gossipsub topic: %w", err)
 	}
 
+	if err := m.capTopic.Close(); err != nil {
+		return fmt.Errorf("failed to close capabilities topic: %w", err)
+	}
+
+	if m.popTopic != nil {
+		if err := m.popTopic.Close(); err != nil {
+			return fmt.Errorf("failed to close popularity topic: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,90 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PopularityEvent is the wire format for record popularity announcements via GossipSub.
+// It carries the aggregate number of times each record was pulled from the announcing node.
+// Only CIDs and counts are included: nothing identifies who pulled a record.
+//
+// Counts are cumulative, so recipients replace the previous announcement of a peer
+// instead of adding to it. As for CapabilitiesEvent, the PeerID is NOT included
+// in the wire format; recipients use the authenticated sender (msg.ReceivedFrom) instead.
+//
+// Example wire format:
+//
+//	{
+//	  "counts": {
+//	    "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi": 42
+//	  },
+//	  "timestamp": "2025-10-01T10:00:00Z"
+//	}
+type PopularityEvent struct {
+	// Counts maps record CIDs to their pull count on the announcing node.
+	Counts map[string]uint64 `json:"counts"`
+
+	// Timestamp is when this announcement was created.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Validate checks if the event is well-formed and safe to process.
+func (e *PopularityEvent) Validate() error {
+	if len(e.Counts) == 0 {
+		return errors.New("no counts provided")
+	}
+
+	if len(e.Counts) > MaxPopularityEntries {
+		return fmt.Errorf("too many counts: %d (max: %d)", len(e.Counts), MaxPopularityEntries)
+	}
+
+	for cid := range e.Counts {
+		if cid == "" {
+			return errors.New("empty CID")
+		}
+	}
+
+	if e.Timestamp.IsZero() {
+		return errors.New("missing timestamp")
+	}
+
+	return nil
+}
+
+// Marshal serializes the event to JSON for network transmission.
+func (e *PopularityEvent) Marshal() ([]byte, error) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal popularity event: %w", err)
+	}
+
+	if len(data) > MaxMessageSize {
+		return nil, errors.New("event exceeds maximum size")
+	}
+
+	return data, nil
+}
+
+// UnmarshalPopularityEvent deserializes and validates a popularity event.
+func UnmarshalPopularityEvent(data []byte) (*PopularityEvent, error) {
+	if len(data) > MaxMessageSize {
+		return nil, errors.New("event exceeds maximum size")
+	}
+
+	var event PopularityEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal popularity event: %w", err)
+	}
+
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
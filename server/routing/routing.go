@@ -7,6 +7,7 @@
 // The routing system consists of:
 // - Local routing: Fast queries against local datastore
 // - Remote routing: DHT-based discovery across the network
+// - Federation routing: gRPC search across statically configured peers, for deployments without a DHT
 // - Cleanup service: Automatic removal of stale labels and orphaned records
 //
 // Label metadata is stored in JSON format with timestamps for lifecycle management.
@@ -16,19 +17,32 @@ import (
 	"context"
 	"fmt"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/server/churn"
 	"github.com/agntcy/dir/server/datastore"
 	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/routing/config"
+	"github.com/agntcy/dir/server/routing/internal/p2p"
 	"github.com/agntcy/dir/server/types"
 	"google.golang.org/grpc/status"
 )
 
 type route struct {
-	local    *routeLocal
-	remote   *routeRemote
-	eventBus *events.SafeEventBus
+	local      *routeLocal
+	remote     *routeRemote
+	federation *routeFederation // Used instead of remote in federation mode
+	localOnly  bool             // Set in local mode, where neither remote nor federation is used
+	eventBus   *events.SafeEventBus
+	damper     *churn.Damper // Coalesces the network announcements of flapping records
 }
 
+// States of records in the churn damper of network announcements.
+const (
+	statePublished   = "published"
+	stateUnpublished = "unpublished"
+)
+
 // hasPeersInRoutingTable checks if we have any peers in the DHT routing table.
 // This determines whether we can perform network operations or should fall back to local-only mode.
 func (r *route) hasPeersInRoutingTable() bool {
@@ -43,6 +57,7 @@ func New(ctx context.Context, store types.StoreAPI, opts types.APIOptions) (type
 	// Create main router
 	mainRounter := &route{
 		eventBus: opts.EventBus(),
+		damper:   churn.New(opts.Config().Churn, "announcements"),
 	}
 
 	// Create routing datastore
@@ -56,6 +71,16 @@ func New(ctx context.Context, store types.StoreAPI, opts types.APIOptions) (type
 		return nil, fmt.Errorf("failed to create routing datastore: %w", err)
 	}
 
+	switch mode := opts.Config().Routing.Mode; mode {
+	case config.ModeFederation:
+		return newFederationRoute(ctx, mainRounter, store, dstore, opts)
+	case config.ModeLocal:
+		return newLocalRoute(mainRounter, store, dstore, opts)
+	case "", config.ModeDHT:
+	default:
+		return nil, fmt.Errorf("unknown routing mode %q", mode)
+	}
+
 	// Create remote router first to get the peer ID
 	mainRounter.remote, err = newRemote(ctx, store, dstore, opts)
 	if err != nil {
@@ -71,6 +96,37 @@ func New(ctx context.Context, store types.StoreAPI, opts types.APIOptions) (type
 	return mainRounter, nil
 }
 
+// newFederationRoute completes the router for federation mode, where no DHT is started.
+func newFederationRoute(ctx context.Context, r *route, store types.StoreAPI, dstore types.Datastore, opts types.APIOptions) (*route, error) {
+	// The peer ID only identifies records published by this node in the routing datastore
+	localPeerID, err := p2p.IdentityPeerID(opts.Config().Routing.KeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load routing identity: %w", err)
+	}
+
+	r.federation, err = newFederation(ctx, opts.Config().Routing.Federation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create federation routing: %w", err)
+	}
+
+	r.local = newLocal(store, dstore, localPeerID)
+
+	return r, nil
+}
+
+// newLocalRoute completes the router for local mode, where no network routing is started.
+func newLocalRoute(r *route, store types.StoreAPI, dstore types.Datastore, opts types.APIOptions) (*route, error) {
+	localPeerID, err := p2p.IdentityPeerID(opts.Config().Routing.KeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load routing identity: %w", err)
+	}
+
+	r.local = newLocal(store, dstore, localPeerID)
+	r.localOnly = true
+
+	return r, nil
+}
+
 func (r *route) Publish(ctx context.Context, record types.Record) error {
 	// Always publish data locally for archival/querying
 	err := r.local.Publish(ctx, record)
@@ -80,14 +136,19 @@ func (r *route) Publish(ctx context.Context, record types.Record) error {
 		return status.Errorf(st.Code(), "failed to publish locally: %s", st.Message())
 	}
 
-	// Only publish to network if peers are available
-	if r.hasPeersInRoutingTable() {
-		err = r.remote.Publish(ctx, record)
-		if err != nil {
-			st := status.Convert(err)
-
-			return status.Errorf(st.Code(), "failed to publish to the network: %s", st.Message())
+	// Only publish to network if peers are available.
+	// Records flapping between published and unpublished are announced once they settle.
+	_, err = r.damper.Change(ctx, record.GetCid(), statePublished, func(ctx context.Context) error {
+		if !r.hasPeersInRoutingTable() {
+			return nil
 		}
+
+		return r.remote.Publish(ctx, record)
+	})
+	if err != nil {
+		st := status.Convert(err)
+
+		return status.Errorf(st.Code(), "failed to publish to the network: %s", st.Message())
 	}
 
 	// Emit RECORD_PUBLISHED event after successful publication
@@ -98,7 +159,7 @@ func (r *route) Publish(ctx context.Context, record types.Record) error {
 		labelStrings[i] = label.String()
 	}
 
-	r.eventBus.RecordPublished(record.GetCid(), labelStrings)
+	r.eventBus.ForContext(ctx).RecordPublished(record.GetCid(), labelStrings)
 
 	return nil
 }
@@ -109,12 +170,43 @@ func (r *route) List(ctx context.Context, req *routingv1.ListRequest) (<-chan *r
 	return r.local.List(ctx, req)
 }
 
+func (r *route) Lookup(ctx context.Context, ref *corev1.RecordRef) (*routingv1.ListResponse, error) {
+	// Lookup is local-only, same as List
+	return r.local.Lookup(ctx, ref)
+}
+
 func (r *route) Search(ctx context.Context, req *routingv1.SearchRequest) (<-chan *routingv1.SearchResponse, error) {
+	// In federation mode, the configured peers are searched directly
+	if r.federation != nil {
+		return r.federation.Search(ctx, req)
+	}
+
+	// In local mode, there are no other peers to search
+	if r.localOnly {
+		outCh := make(chan *routingv1.SearchResponse)
+		close(outCh)
+
+		return outCh, nil
+	}
+
 	// Search is always remote-only - it returns records from other peers using cached announcements
 	// This operation queries locally cached remote announcements from DHT
 	return r.remote.Search(ctx, req)
 }
 
+func (r *route) ListPeers(ctx context.Context) ([]*routingv1.Peer, error) {
+	if r.federation != nil {
+		return r.federation.ListPeers(ctx)
+	}
+
+	if r.localOnly {
+		return []*routingv1.Peer{}, nil
+	}
+
+	// Peers are known from the network, so this is served by the remote router
+	return r.remote.ListPeers(ctx)
+}
+
 func (r *route) Unpublish(ctx context.Context, record types.Record) error {
 	err := r.local.Unpublish(ctx, record)
 	if err != nil {
@@ -123,8 +215,11 @@ func (r *route) Unpublish(ctx context.Context, record types.Record) error {
 		return status.Errorf(st.Code(), "failed to unpublish locally: %s", st.Message())
 	}
 
+	// Record the change, so that flapping records are not announced until they settle
+	_, _ = r.damper.Change(ctx, record.GetCid(), stateUnpublished, func(context.Context) error { return nil })
+
 	// Emit RECORD_UNPUBLISHED event after successful unpublication
-	r.eventBus.RecordUnpublished(record.GetCid())
+	r.eventBus.ForContext(ctx).RecordUnpublished(record.GetCid())
 
 	// no need to explicitly handle unpublishing from the network
 	// TODO clarify if network sync trigger is needed here
@@ -134,6 +229,9 @@ func (r *route) Unpublish(ctx context.Context, record types.Record) error {
 // Stop stops the routing services and releases resources.
 // This should be called during server shutdown to clean up gracefully.
 func (r *route) Stop() error {
+	// Discard the announcements held back for flapping records
+	r.damper.Stop()
+
 	// Stop remote routing (includes GossipSub and p2p server)
 	if r.remote != nil {
 		if err := r.remote.Stop(); err != nil {
@@ -141,6 +239,12 @@ func (r *route) Stop() error {
 		}
 	}
 
+	if r.federation != nil {
+		if err := r.federation.Stop(); err != nil {
+			return fmt.Errorf("failed to stop federation routing: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -154,6 +258,14 @@ func (r *route) IsReady(ctx context.Context) bool {
 		return false
 	}
 
+	if r.federation != nil {
+		return r.federation.IsReady(ctx)
+	}
+
+	if r.localOnly {
+		return true
+	}
+
 	if r.remote == nil {
 		remoteLogger.Debug("Routing not ready: remote router is nil")
 
@@ -0,0 +1,386 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package routing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	routingconfig "github.com/agntcy/dir/server/routing/config"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+var federationLogger = logging.Logger("routing/federation")
+
+// routeFederation searches a static list of peer Directory APIs over gRPC.
+// It replaces DHT-based remote routing for deployments that cannot run a DHT.
+type routeFederation struct {
+	peers          []*federationPeer
+	requestTimeout time.Duration
+	cancel         context.CancelFunc
+	wg             sync.WaitGroup
+}
+
+// federationPeer is a federated peer Directory API and its last known health.
+type federationPeer struct {
+	address string
+	conn    *grpc.ClientConn
+	search  searchv1.SearchServiceClient
+	info    corev1.InfoServiceClient
+
+	mu           sync.RWMutex
+	healthy      bool
+	capabilities *routingv1.PeerCapabilities
+}
+
+func newFederation(ctx context.Context, cfg routingconfig.FederationConfig) (*routeFederation, error) {
+	if len(cfg.Peers) == 0 {
+		return nil, errors.New("federation mode requires at least one peer")
+	}
+
+	requestTimeout := cfg.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = routingconfig.DefaultFederationRequestTimeout
+	}
+
+	healthCheckInterval := cfg.HealthCheckInterval
+	if healthCheckInterval <= 0 {
+		healthCheckInterval = routingconfig.DefaultFederationHealthCheckInterval
+	}
+
+	f := &routeFederation{requestTimeout: requestTimeout}
+
+	for _, address := range cfg.Peers {
+		conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			_ = f.Stop()
+
+			return nil, fmt.Errorf("failed to create gRPC connection to federation peer %s: %w", address, err)
+		}
+
+		f.peers = append(f.peers, &federationPeer{
+			address: address,
+			conn:    conn,
+			search:  searchv1.NewSearchServiceClient(conn),
+			info:    corev1.NewInfoServiceClient(conn),
+		})
+	}
+
+	healthCtx, cancel := context.WithCancel(ctx)
+	f.cancel = cancel
+
+	// Check peers once before serving so that searches do not start with all peers unhealthy
+	f.checkPeers(healthCtx)
+
+	f.wg.Add(1)
+
+	go func() {
+		defer f.wg.Done()
+
+		ticker := time.NewTicker(healthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-healthCtx.Done():
+				return
+			case <-ticker.C:
+				f.checkPeers(healthCtx)
+			}
+		}
+	}()
+
+	federationLogger.Info("Federation routing started", "peers", cfg.Peers)
+
+	return f, nil
+}
+
+// checkPeers refreshes the health and capabilities of all peers.
+func (f *routeFederation) checkPeers(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for _, p := range f.peers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			f.checkPeer(ctx, p)
+		}()
+	}
+
+	wg.Wait()
+}
+
+// checkPeer marks the peer healthy if its Directory API responds,
+// and records the capabilities it reports.
+func (f *routeFederation) checkPeer(ctx context.Context, p *federationPeer) {
+	ctx, cancel := context.WithTimeout(ctx, f.requestTimeout)
+	defer cancel()
+
+	var capabilities *routingv1.PeerCapabilities
+
+	info, err := p.info.GetServerInfo(ctx, &corev1.GetServerInfoRequest{})
+
+	switch {
+	case err == nil:
+		capabilities = capabilitiesFromServerInfo(info)
+	case status.Code(err) == codes.Unimplemented:
+		// Peers predating the info service are reachable but advertise no capabilities
+		err = nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if (err == nil) != p.healthy {
+		if err == nil {
+			federationLogger.Info("Federation peer is healthy", "peer", p.address)
+		} else {
+			federationLogger.Warn("Federation peer is unhealthy", "peer", p.address, "error", err)
+		}
+	}
+
+	p.healthy = err == nil
+	p.capabilities = capabilities
+}
+
+// capabilitiesFromServerInfo converts the server info reported by a peer to peer capabilities.
+func capabilitiesFromServerInfo(info *corev1.GetServerInfoResponse) *routingv1.PeerCapabilities {
+	schemaVersions := info.GetSupportedSchemaVersions()
+	if len(schemaVersions) == 0 {
+		schemaVersions = info.GetAcceptedSchemaVersions()
+	}
+
+	return &routingv1.PeerCapabilities{
+		ApiVersions:    info.GetApiVersions(),
+		SchemaVersions: schemaVersions,
+		MaxMessageSize: info.GetLimits().GetMaxRecvMsgSize(),
+		ReadOnly:       info.GetFeatures().GetReadOnly(),
+		UpdatedAt:      time.Now().Format(time.RFC3339),
+	}
+}
+
+// peerInfo returns the peer in the routing API representation and whether it is healthy.
+func (p *federationPeer) peerInfo() (*routingv1.Peer, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	connection := routingv1.PeerConnectionType_PEER_CONNECTION_TYPE_CANNOT_CONNECT
+	if p.healthy {
+		connection = routingv1.PeerConnectionType_PEER_CONNECTION_TYPE_CONNECTED
+	}
+
+	return &routingv1.Peer{
+		Id:           p.address,
+		Addrs:        []string{p.address},
+		Connection:   connection,
+		Capabilities: p.capabilities,
+	}, p.healthy
+}
+
+// Search sends the queries to the SearchService of all healthy, compatible peers.
+// Records are returned if they match at least minMatchScore queries (OR logic),
+// with the same semantics as the DHT-based remote search.
+func (f *routeFederation) Search(ctx context.Context, req *routingv1.SearchRequest) (<-chan *routingv1.SearchResponse, error) {
+	federationLogger.Debug("Called federation routing's Search method", "req", req)
+
+	queries := deduplicateQueries(req.GetQueries())
+
+	minMatchScore := req.GetMinMatchScore()
+	if minMatchScore < DefaultMinMatchScore {
+		minMatchScore = DefaultMinMatchScore
+	}
+
+	outCh := make(chan *routingv1.SearchResponse)
+
+	go func() {
+		defer close(outCh)
+
+		processedCIDs := make(map[string]bool)
+		limit := int(req.GetLimit())
+
+		for _, p := range f.peers {
+			peer, healthy := p.peerInfo()
+			if !healthy {
+				continue
+			}
+
+			if err := types.CheckPeerCompatibility(peer.GetCapabilities()); err != nil {
+				federationLogger.Debug("Skipping incompatible federation peer", "peer", p.address, "error", err)
+
+				continue
+			}
+
+			results, err := f.searchPeer(ctx, p, queries)
+			if err != nil {
+				federationLogger.Warn("Failed to search federation peer", "peer", p.address, "error", err)
+
+				continue
+			}
+
+			for _, result := range results {
+				if processedCIDs[result.cid] || safeIntToUint32(len(result.matchQueries)) < minMatchScore {
+					continue
+				}
+
+				select {
+				case outCh <- &routingv1.SearchResponse{
+					RecordRef:    &corev1.RecordRef{Cid: result.cid},
+					Peer:         peer,
+					MatchQueries: result.matchQueries,
+					MatchScore:   safeIntToUint32(len(result.matchQueries)),
+				}:
+				case <-ctx.Done():
+					return
+				}
+
+				processedCIDs[result.cid] = true
+
+				if limit > 0 && len(processedCIDs) >= limit {
+					return
+				}
+			}
+		}
+	}()
+
+	return outCh, nil
+}
+
+// federationResult is a record found on a peer with the queries it matched.
+type federationResult struct {
+	cid          string
+	matchQueries []*routingv1.RecordQuery
+}
+
+// searchPeer runs each query against the peer and returns the matching records
+// in the order they were first found.
+func (f *routeFederation) searchPeer(ctx context.Context, p *federationPeer, queries []*routingv1.RecordQuery) ([]*federationResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.requestTimeout)
+	defer cancel()
+
+	var results []*federationResult
+
+	byCID := make(map[string]*federationResult)
+
+	for _, query := range queries {
+		cids, err := searchPeerCIDs(ctx, p.search, toSearchQueries(query))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cid := range cids {
+			result, ok := byCID[cid]
+			if !ok {
+				result = &federationResult{cid: cid}
+				byCID[cid] = result
+				results = append(results, result)
+			}
+
+			result.matchQueries = append(result.matchQueries, query)
+		}
+	}
+
+	return results, nil
+}
+
+// searchPeerCIDs returns the CIDs of the peer's records matching the search queries.
+func searchPeerCIDs(ctx context.Context, client searchv1.SearchServiceClient, queries []*searchv1.RecordQuery) ([]string, error) {
+	stream, err := client.Search(ctx, &searchv1.SearchRequest{Queries: queries})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+
+	var cids []string
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return cids, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to receive search results: %w", err)
+		}
+
+		cids = append(cids, resp.GetRecordCid())
+	}
+}
+
+// toSearchQueries converts a routing query to the equivalent SearchService queries.
+// Hierarchical routing queries match the value itself and everything below it,
+// e.g. the skill "category" also matches "category/class".
+func toSearchQueries(query *routingv1.RecordQuery) []*searchv1.RecordQuery {
+	hierarchical := func(queryType searchv1.RecordQueryType) []*searchv1.RecordQuery {
+		return []*searchv1.RecordQuery{
+			{Type: queryType, Value: query.GetValue()},
+			{Type: queryType, Value: query.GetValue() + "/*"},
+		}
+	}
+
+	switch query.GetType() {
+	case routingv1.RecordQueryType_RECORD_QUERY_TYPE_SKILL:
+		return hierarchical(searchv1.RecordQueryType_RECORD_QUERY_TYPE_SKILL_NAME)
+	case routingv1.RecordQueryType_RECORD_QUERY_TYPE_DOMAIN:
+		return hierarchical(searchv1.RecordQueryType_RECORD_QUERY_TYPE_DOMAIN_NAME)
+	case routingv1.RecordQueryType_RECORD_QUERY_TYPE_MODULE:
+		return hierarchical(searchv1.RecordQueryType_RECORD_QUERY_TYPE_MODULE)
+	case routingv1.RecordQueryType_RECORD_QUERY_TYPE_LOCATOR:
+		return []*searchv1.RecordQuery{
+			{Type: searchv1.RecordQueryType_RECORD_QUERY_TYPE_LOCATOR, Value: query.GetValue()},
+		}
+	default:
+		// Unspecified queries match everything
+		return nil
+	}
+}
+
+// ListPeers returns the configured peers with their health and capabilities.
+func (f *routeFederation) ListPeers(context.Context) ([]*routingv1.Peer, error) {
+	peers := make([]*routingv1.Peer, 0, len(f.peers))
+
+	for _, p := range f.peers {
+		peer, _ := p.peerInfo()
+		peers = append(peers, peer)
+	}
+
+	return peers, nil
+}
+
+// IsReady always reports ready: unhealthy peers are skipped by searches
+// and must not make this node unavailable.
+func (f *routeFederation) IsReady(context.Context) bool {
+	return true
+}
+
+// Stop stops the health checks and closes the peer connections.
+func (f *routeFederation) Stop() error {
+	if f.cancel != nil {
+		f.cancel()
+	}
+
+	f.wg.Wait()
+
+	var errs error
+
+	for _, p := range f.peers {
+		if err := p.conn.Close(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to close connection to %s: %w", p.address, err))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,153 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package routing
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	routingconfig "github.com/agntcy/dir/server/routing/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakeFederationPeer serves the search and info services of a peer with fixed records.
+type fakeFederationPeer struct {
+	searchv1.UnimplementedSearchServiceServer
+	corev1.UnimplementedInfoServiceServer
+
+	// skills maps record CIDs to their skill names
+	skills map[string][]string
+}
+
+func (p *fakeFederationPeer) Search(req *searchv1.SearchRequest, stream searchv1.SearchService_SearchServer) error {
+	for cid, skills := range p.skills {
+		if slices.ContainsFunc(skills, func(skill string) bool {
+			return slices.ContainsFunc(req.GetQueries(), func(query *searchv1.RecordQuery) bool {
+				prefix, wildcard := strings.CutSuffix(query.GetValue(), "*")
+				if wildcard {
+					return strings.HasPrefix(skill, prefix)
+				}
+
+				return skill == query.GetValue()
+			})
+		}) {
+			if err := stream.Send(&searchv1.SearchResponse{RecordCid: cid}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *fakeFederationPeer) GetServerInfo(_ context.Context, _ *corev1.GetServerInfoRequest) (*corev1.GetServerInfoResponse, error) {
+	return &corev1.GetServerInfoResponse{
+		ApiVersions:             []string{"v1"},
+		SupportedSchemaVersions: []string{"0.7.0"},
+	}, nil
+}
+
+func startFakeFederationPeer(t *testing.T, peer *fakeFederationPeer) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	searchv1.RegisterSearchServiceServer(server, peer)
+	corev1.RegisterInfoServiceServer(server, peer)
+
+	go func() { _ = server.Serve(lis) }()
+
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestFederationSearch(t *testing.T) {
+	healthy := startFakeFederationPeer(t, &fakeFederationPeer{
+		skills: map[string][]string{
+			"bafy-both":  {"natural_language_processing/summarization", "images_computer_vision/image_segmentation"},
+			"bafy-nlp":   {"natural_language_processing"},
+			"bafy-other": {"audio/speech_recognition"},
+		},
+	})
+
+	// Reserve an address nobody listens on
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	unreachable := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	federation, err := newFederation(t.Context(), routingconfig.FederationConfig{
+		Peers:               []string{unreachable, healthy},
+		HealthCheckInterval: time.Hour,
+		RequestTimeout:      time.Second,
+	})
+	require.NoError(t, err)
+
+	defer func() { _ = federation.Stop() }()
+
+	// Peers are reported with their health and capabilities
+	peers, err := federation.ListPeers(t.Context())
+	require.NoError(t, err)
+	require.Len(t, peers, 2)
+	assert.Equal(t, routingv1.PeerConnectionType_PEER_CONNECTION_TYPE_CANNOT_CONNECT, peers[0].GetConnection())
+	assert.Equal(t, routingv1.PeerConnectionType_PEER_CONNECTION_TYPE_CONNECTED, peers[1].GetConnection())
+	assert.Equal(t, []string{healthy}, peers[1].GetAddrs())
+	assert.Equal(t, []string{"0.7.0"}, peers[1].GetCapabilities().GetSchemaVersions())
+
+	search := func(minMatchScore uint32) map[string]uint32 {
+		resultCh, err := federation.Search(t.Context(), &routingv1.SearchRequest{
+			Queries: []*routingv1.RecordQuery{
+				{Type: routingv1.RecordQueryType_RECORD_QUERY_TYPE_SKILL, Value: "natural_language_processing"},
+				{Type: routingv1.RecordQueryType_RECORD_QUERY_TYPE_SKILL, Value: "images_computer_vision"},
+			},
+			MinMatchScore: &minMatchScore,
+		})
+		require.NoError(t, err)
+
+		scores := map[string]uint32{}
+		for result := range resultCh {
+			assert.Equal(t, healthy, result.GetPeer().GetId())
+			scores[result.GetRecordRef().GetCid()] = result.GetMatchScore()
+		}
+
+		return scores
+	}
+
+	// Records matching any query are returned with their match score
+	assert.Equal(t, map[string]uint32{"bafy-both": 2, "bafy-nlp": 1}, search(1))
+
+	// The minimum match score filters out partial matches
+	assert.Equal(t, map[string]uint32{"bafy-both": 2}, search(2))
+}
+
+func TestToSearchQueries(t *testing.T) {
+	queries := toSearchQueries(&routingv1.RecordQuery{
+		Type:  routingv1.RecordQueryType_RECORD_QUERY_TYPE_DOMAIN,
+		Value: "research",
+	})
+	assert.Equal(t, []string{"research", "research/*"}, []string{queries[0].GetValue(), queries[1].GetValue()})
+	assert.Equal(t, searchv1.RecordQueryType_RECORD_QUERY_TYPE_DOMAIN_NAME, queries[0].GetType())
+
+	queries = toSearchQueries(&routingv1.RecordQuery{
+		Type:  routingv1.RecordQueryType_RECORD_QUERY_TYPE_LOCATOR,
+		Value: "docker_image",
+	})
+	require.Len(t, queries, 1)
+	assert.Equal(t, searchv1.RecordQueryType_RECORD_QUERY_TYPE_LOCATOR, queries[0].GetType())
+
+	assert.Empty(t, toSearchQueries(&routingv1.RecordQuery{}))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+	"sync"
 	"time"
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
@@ -17,6 +18,7 @@ import (
 	"github.com/ipfs/go-datastore/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var localLogger = logging.Logger("routing/local")
@@ -26,6 +28,10 @@ type routeLocal struct {
 	store       types.StoreAPI
 	dstore      types.Datastore
 	localPeerID string // Cached local peer ID for efficient filtering
+
+	// mu serializes publishing and unpublishing with reads of the local index,
+	// so that reads see every publish or unpublish either fully or not at all.
+	mu sync.RWMutex
 }
 
 func newLocal(store types.StoreAPI, dstore types.Datastore, localPeerID string) *routeLocal {
@@ -48,6 +54,9 @@ func (r *routeLocal) Publish(ctx context.Context, record types.Record) error {
 
 	localLogger.Debug("Called local routing's Publish method", "cid", cid)
 
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	metrics, err := loadMetrics(ctx, r.dstore)
 	if err != nil {
 		return status.Errorf(codes.Internal, "failed to load metrics: %v", err)
@@ -143,7 +152,16 @@ func (r *routeLocal) List(ctx context.Context, req *routingv1.ListRequest) (<-ch
 	go func() {
 		defer close(outCh)
 
-		r.listLocalRecords(ctx, deduplicatedQueries, req.GetLimit(), outCh)
+		// Read a consistent snapshot before streaming, so that slow consumers do not block writers
+		results := r.listLocalRecords(ctx, deduplicatedQueries, req.GetLimit())
+
+		for _, result := range results {
+			select {
+			case outCh <- result:
+			case <-ctx.Done():
+				return
+			}
+		}
 	}()
 
 	return outCh, nil
@@ -151,10 +169,16 @@ func (r *routeLocal) List(ctx context.Context, req *routingv1.ListRequest) (<-ch
 
 // listLocalRecords lists all local records with optional query filtering.
 // Uses the simple and efficient approach: start with /records/ index, then filter by queries.
-func (r *routeLocal) listLocalRecords(ctx context.Context, queries []*routingv1.RecordQuery, limit uint32, outCh chan<- *routingv1.ListResponse) {
-	processedCount := 0
+// The records are read from a consistent snapshot of the local index, whose time is set on the results.
+func (r *routeLocal) listLocalRecords(ctx context.Context, queries []*routingv1.RecordQuery, limit uint32) []*routingv1.ListResponse {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	snapshotTime := timestamppb.Now()
 	limitInt := int(limit)
 
+	var results []*routingv1.ListResponse
+
 	// Step 1: Get all local record CIDs from /records/ index
 	recordResults, err := r.dstore.Query(ctx, query.Query{
 		Prefix: "/records/",
@@ -162,7 +186,7 @@ func (r *routeLocal) listLocalRecords(ctx context.Context, queries []*routingv1.
 	if err != nil {
 		localLogger.Error("Failed to query local records", "error", err)
 
-		return
+		return nil
 	}
 	defer recordResults.Close()
 
@@ -191,20 +215,56 @@ func (r *routeLocal) listLocalRecords(ctx context.Context, queries []*routingv1.
 				apiLabels[i] = label.String()
 			}
 
-			// Send the response
-			outCh <- &routingv1.ListResponse{
-				RecordRef: &corev1.RecordRef{Cid: cid},
-				Labels:    apiLabels,
-			}
+			results = append(results, &routingv1.ListResponse{
+				RecordRef:    &corev1.RecordRef{Cid: cid},
+				Labels:       apiLabels,
+				SnapshotTime: snapshotTime,
+			})
 
-			processedCount++
-			if limitInt > 0 && processedCount >= limitInt {
+			if limitInt > 0 && len(results) >= limitInt {
 				break
 			}
 		}
 	}
 
-	localLogger.Debug("Completed List operation", "processed", processedCount, "queries", len(queries))
+	localLogger.Debug("Completed List operation", "processed", len(results), "queries", len(queries))
+
+	return results
+}
+
+// Lookup checks if a record is published by this peer and returns its announced labels.
+func (r *routeLocal) Lookup(ctx context.Context, ref *corev1.RecordRef) (*routingv1.ListResponse, error) {
+	cid := ref.GetCid()
+	if cid == "" {
+		return nil, status.Error(codes.InvalidArgument, "record CID is required") //nolint:wrapcheck
+	}
+
+	localLogger.Debug("Called local routing's Lookup method", "cid", cid)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	recordExists, err := r.dstore.Has(ctx, datastore.NewKey("/records/"+cid))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check if record exists: %v", err)
+	}
+
+	if !recordExists {
+		return nil, status.Errorf(codes.NotFound, "record not published: %s", cid)
+	}
+
+	// Convert []Label to []string for gRPC API boundary
+	internalLabels := r.getRecordLabelsEfficiently(ctx, cid)
+
+	apiLabels := make([]string, len(internalLabels))
+	for i, label := range internalLabels {
+		apiLabels[i] = label.String()
+	}
+
+	return &routingv1.ListResponse{
+		RecordRef: &corev1.RecordRef{Cid: cid},
+		Labels:    apiLabels,
+	}, nil
 }
 
 // matchesAllQueries checks if a record matches ALL provided queries (AND relationship).
@@ -259,6 +319,9 @@ func (r *routeLocal) Unpublish(ctx context.Context, record types.Record) error {
 
 	localLogger.Debug("Called local routing's Unpublish method", "cid", cid)
 
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	// load metrics for the client
 	metrics, err := loadMetrics(ctx, r.dstore)
 	if err != nil {
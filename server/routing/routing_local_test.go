@@ -46,6 +46,102 @@ func TestPublish_InvalidObject(t *testing.T) {
 	})
 }
 
+func TestPublishLookup(t *testing.T) {
+	testRecord := corev1.New(&typesv1alpha0.Record{
+		Name:          "test-agent-lookup",
+		SchemaVersion: "v0.3.1",
+		Skills: []*typesv1alpha0.Skill{
+			{CategoryName: toPtr("category1"), ClassName: toPtr("class1")},
+		},
+	})
+	testRef := &corev1.RecordRef{Cid: testRecord.GetCid()}
+
+	dstore, err := datastore.New()
+	assert.NoError(t, err)
+
+	r := newLocal(newMockStore(), dstore, testPeerID)
+
+	t.Run("not published", func(t *testing.T) {
+		_, err := r.Lookup(t.Context(), testRef)
+		assert.Error(t, err)
+		assert.ErrorContains(t, err, "record not published")
+	})
+
+	t.Run("published", func(t *testing.T) {
+		err := r.Publish(t.Context(), adapters.NewRecordAdapter(testRecord))
+		assert.NoError(t, err)
+
+		resp, err := r.Lookup(t.Context(), testRef)
+		assert.NoError(t, err)
+		assert.Equal(t, testRef.GetCid(), resp.GetRecordRef().GetCid())
+		assert.Contains(t, resp.GetLabels(), "/skills/category1/class1")
+	})
+}
+
+func TestList_Snapshot(t *testing.T) {
+	stableRecord := corev1.New(&typesv1alpha0.Record{
+		Name:          "test-agent-stable",
+		SchemaVersion: "v0.3.1",
+		Skills: []*typesv1alpha0.Skill{
+			{CategoryName: toPtr("category1"), ClassName: toPtr("class1")},
+		},
+	})
+	flappingRecord := corev1.New(&typesv1alpha0.Record{
+		Name:          "test-agent-flapping",
+		SchemaVersion: "v0.3.1",
+		Skills: []*typesv1alpha0.Skill{
+			{CategoryName: toPtr("category1"), ClassName: toPtr("class1")},
+			{CategoryName: toPtr("category2"), ClassName: toPtr("class2")},
+		},
+	})
+
+	dstore, err := datastore.New()
+	assert.NoError(t, err)
+
+	r := newLocal(newMockStore(), dstore, testPeerID)
+	assert.NoError(t, r.Publish(t.Context(), adapters.NewRecordAdapter(stableRecord)))
+
+	// Publish and unpublish a record concurrently with listing
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		adapter := adapters.NewRecordAdapter(flappingRecord)
+		for ctx.Err() == nil {
+			_ = r.Publish(ctx, adapter)
+			_ = r.Unpublish(ctx, adapter)
+		}
+	}()
+
+	for range 20 {
+		refsChan, err := r.List(t.Context(), &routingv1.ListRequest{})
+		assert.NoError(t, err)
+
+		var refs []*routingv1.ListResponse
+		for ref := range refsChan {
+			refs = append(refs, ref)
+		}
+
+		assert.NotEmpty(t, refs)
+
+		for _, ref := range refs {
+			// All results are read from the same snapshot
+			assert.NotNil(t, ref.GetSnapshotTime())
+			assert.True(t, refs[0].GetSnapshotTime().AsTime().Equal(ref.GetSnapshotTime().AsTime()))
+
+			// Records are never seen partially published
+			if ref.GetRecordRef().GetCid() == flappingRecord.GetCid() {
+				assert.Len(t, ref.GetLabels(), 2)
+			}
+		}
+	}
+
+	cancel()
+	<-done
+}
+
 type mockStore struct {
 	data map[string]*corev1.Record
 }
@@ -399,3 +495,25 @@ func Benchmark_RouteLocal(b *testing.B) {
 	_ = inMemoryDatastore.Delete(b.Context(), ipfsdatastore.NewKey("/")) // Delete all keys
 	localLogger = logging.Logger("routing/local")
 }
+
+func TestLocalMode(t *testing.T) {
+	dstore, err := datastore.New()
+	assert.NoError(t, err)
+
+	r := &route{local: newLocal(newMockStore(), dstore, testPeerID), localOnly: true}
+
+	// Remote search has no peers to query
+	resultCh, err := r.Search(t.Context(), &routingv1.SearchRequest{})
+	assert.NoError(t, err)
+
+	for range resultCh {
+		t.Fatal("unexpected search result in local mode")
+	}
+
+	peers, err := r.ListPeers(t.Context())
+	assert.NoError(t, err)
+	assert.Empty(t, peers)
+
+	assert.True(t, r.IsReady(t.Context()))
+	assert.NoError(t, r.Stop())
+}
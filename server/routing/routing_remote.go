@@ -104,6 +104,7 @@ type routeRemote struct {
 	cleanupManager  *CleanupManager
 	pubsubManager   *pubsub.Manager // GossipSub manager for label announcements (nil if disabled)
 	isBootstrapNode bool            // True if this node is a bootstrap node (no bootstrap peers configured)
+	popularity      bool            // True if remote search results are ranked by network-wide popularity
 
 	// Lifecycle management
 	//nolint:containedctx // Context needed for managing lifecycle of multiple long-running goroutines (handleNotify, cleanup tasks)
@@ -207,6 +208,27 @@ func newRemote(parentCtx context.Context,
 		// Set callback for received label announcements
 		pubsubManager.SetOnRecordPublishEvent(routeAPI.handleRecordPublishEvent)
 
+		// Store capabilities announced by peers and announce our own
+		pubsubManager.SetOnCapabilitiesEvent(routeAPI.handleCapabilitiesEvent)
+		routeAPI.startCapabilityAnnouncements(newLocalCapabilities(opts))
+
+		// Count record pulls and exchange them with peers to rank search results
+		if opts.Config().Routing.Popularity.Enabled {
+			pubsubManager.SetOnPopularityEvent(routeAPI.handlePopularityEvent)
+
+			if err := pubsubManager.JoinPopularity(); err != nil {
+				defer server.Close()
+
+				return nil, fmt.Errorf("failed to join popularity gossip: %w", err)
+			}
+
+			routeAPI.popularity = true
+			routeAPI.startPopularityTracking(opts.EventBus())
+			routeAPI.startPopularityAnnouncements()
+
+			remoteLogger.Info("Popularity gossip enabled")
+		}
+
 		// Start periodic mesh peer tagging to protect them from Connection Manager pruning
 		routeAPI.startMeshPeerTagging()
 
@@ -341,9 +363,18 @@ func (r *routeRemote) Search(ctx context.Context, req *routingv1.SearchRequest)
 func (r *routeRemote) searchRemoteRecords(ctx context.Context, queries []*routingv1.RecordQuery, limit uint32, minMatchScore uint32, outCh chan<- *routingv1.SearchResponse) {
 	localPeerID := r.server.Host().ID().String()
 	processedCIDs := make(map[string]bool) // Avoid duplicates
+	checkedPeers := make(map[string]bool)  // Peer compatibility by peer ID
 	processedCount := 0
 	limitInt := int(limit)
 
+	// With popularity gossip, all matching records are collected and ranked before being returned
+	var ranked []*routingv1.SearchResponse
+
+	collectLimit := limitInt
+	if r.popularity {
+		collectLimit = 0
+	}
+
 	remoteLogger.Debug("Starting remote search with OR logic and minimum threshold", "queries", len(queries), "minMatchScore", minMatchScore, "localPeerID", localPeerID)
 
 	// Query all namespaces to find remote records
@@ -355,7 +386,7 @@ func (r *routeRemote) searchRemoteRecords(ctx context.Context, queries []*routin
 	}
 
 	for _, entry := range entries {
-		if limitInt > 0 && processedCount >= limitInt {
+		if collectLimit > 0 && processedCount >= collectLimit {
 			break
 		}
 
@@ -376,6 +407,11 @@ func (r *routeRemote) searchRemoteRecords(ctx context.Context, queries []*routin
 			continue
 		}
 
+		// Skip records from peers whose advertised capabilities are incompatible
+		if !r.isPeerCompatible(ctx, keyPeerID, checkedPeers) {
+			continue
+		}
+
 		// Calculate match score using OR logic (how many queries match this record)
 		matchQueries, score := r.calculateMatchScore(ctx, keyCID, queries, keyPeerID)
 
@@ -384,20 +420,25 @@ func (r *routeRemote) searchRemoteRecords(ctx context.Context, queries []*routin
 		// Apply minimum match score filter (record included if score ≥ threshold)
 		if score >= minMatchScore {
 			peer := r.createPeerInfo(ctx, keyPeerID)
-
-			outCh <- &routingv1.SearchResponse{
+			result := &routingv1.SearchResponse{
 				RecordRef:    &corev1.RecordRef{Cid: keyCID},
 				Peer:         peer,
 				MatchQueries: matchQueries,
 				MatchScore:   score,
 			}
 
+			if r.popularity {
+				ranked = append(ranked, result)
+			} else {
+				outCh <- result
+			}
+
 			processedCIDs[keyCID] = true
 			processedCount++
 
 			remoteLogger.Debug("Record meets minimum threshold, including in results", "cid", keyCID, "score", score)
 
-			if limitInt > 0 && processedCount >= limitInt {
+			if collectLimit > 0 && processedCount >= collectLimit {
 				break
 			}
 		} else {
@@ -405,6 +446,22 @@ func (r *routeRemote) searchRemoteRecords(ctx context.Context, queries []*routin
 		}
 	}
 
+	if r.popularity {
+		rankByPopularity(ranked, r.networkPopularity(ctx))
+
+		if limitInt > 0 && len(ranked) > limitInt {
+			ranked = ranked[:limitInt]
+		}
+
+		for _, result := range ranked {
+			select {
+			case outCh <- result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+
 	remoteLogger.Debug("Completed Search operation", "processed", processedCount, "queries", len(queries))
 }
 
@@ -466,8 +523,9 @@ func (r *routeRemote) createPeerInfo(ctx context.Context, peerID string) *routin
 	dirAPIAddr := r.getDirectoryAPIAddress(ctx, peerID)
 
 	return &routingv1.Peer{
-		Id:    peerID,
-		Addrs: []string{dirAPIAddr},
+		Id:           peerID,
+		Addrs:        []string{dirAPIAddr},
+		Capabilities: r.getPeerCapabilities(ctx, peerID),
 	}
 }
 
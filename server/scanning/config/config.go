@@ -0,0 +1,116 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// Actions taken on records with scan findings.
+const (
+	// ActionWarn flags the record and emits a RECORD_FLAGGED event.
+	ActionWarn = "warn"
+
+	// ActionBlock additionally blocks the publication of the record.
+	ActionBlock = "block"
+)
+
+const (
+	DefaultScanningEnabled         = false
+	DefaultScanningAction          = ActionBlock
+	DefaultScanningBuiltinPatterns = true
+	DefaultScanningWebhookTimeout  = 10 * time.Second
+)
+
+type Config struct {
+	// Enabled turns on the scanning of pushed records and their attached artifacts.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// Action taken on records with findings, either warn or block.
+	Action string `json:"action,omitempty" mapstructure:"action"`
+
+	// BuiltinPatterns enables the built-in secret detection patterns.
+	BuiltinPatterns bool `json:"builtin_patterns,omitempty" mapstructure:"builtin_patterns"`
+
+	// SecretPatterns are additional secret detection patterns.
+	SecretPatterns []SecretPattern `json:"secret_patterns,omitempty" mapstructure:"secret_patterns"`
+
+	// Webhook configures an optional external scanner.
+	Webhook WebhookConfig `json:"webhook,omitempty" mapstructure:"webhook"`
+}
+
+// SecretPattern is a named regular expression matching secrets in record content.
+type SecretPattern struct {
+	// Name of the pattern reported in findings, e.g. aws_access_key.
+	Name string `json:"name,omitempty" mapstructure:"name"`
+
+	// Regex matched against the record content and attached artifacts.
+	Regex string `json:"regex,omitempty" mapstructure:"regex"`
+}
+
+// WebhookConfig configures an external scanner receiving the scanned content over HTTP.
+type WebhookConfig struct {
+	// URL of the external scanner. The external scanner is disabled if empty.
+	URL string `json:"url,omitempty" mapstructure:"url"`
+
+	// Timeout of the requests sent to the external scanner.
+	Timeout time.Duration `json:"timeout,omitempty" mapstructure:"timeout"`
+
+	// Secret used to sign the requests sent to the external scanner, if set.
+	Secret string `json:"secret,omitempty" mapstructure:"secret"`
+}
+
+// BuiltinSecretPatterns are the secret detection patterns enabled by BuiltinPatterns.
+var BuiltinSecretPatterns = []SecretPattern{
+	{Name: "aws_access_key", Regex: `\b(AKIA|ASIA)[0-9A-Z]{16}\b`},
+	{Name: "github_token", Regex: `\bgh[pousr]_[A-Za-z0-9]{36,}\b`},
+	{Name: "gitlab_token", Regex: `\bglpat-[A-Za-z0-9_-]{20,}\b`},
+	{Name: "google_api_key", Regex: `\bAIza[0-9A-Za-z_-]{35}\b`},
+	{Name: "slack_token", Regex: `\bxox[abprs]-[A-Za-z0-9-]{10,}\b`},
+	{Name: "private_key", Regex: `-----BEGIN ([A-Z]+ )?PRIVATE KEY-----`},
+}
+
+// Patterns returns the secret detection patterns in use.
+func (c Config) Patterns() []SecretPattern {
+	var patterns []SecretPattern
+
+	if c.BuiltinPatterns {
+		patterns = append(patterns, BuiltinSecretPatterns...)
+	}
+
+	return append(patterns, c.SecretPatterns...)
+}
+
+// Validate checks the scanning configuration.
+func (c Config) Validate() error {
+	if c.Action != ActionWarn && c.Action != ActionBlock {
+		return fmt.Errorf("invalid scanning action %q, expected %s or %s", c.Action, ActionWarn, ActionBlock)
+	}
+
+	for _, pattern := range c.SecretPatterns {
+		if pattern.Name == "" {
+			return errors.New("secret pattern name is required")
+		}
+
+		if _, err := regexp.Compile(pattern.Regex); err != nil {
+			return fmt.Errorf("invalid regex of secret pattern %s: %w", pattern.Name, err)
+		}
+	}
+
+	if c.Webhook.URL != "" {
+		if u, err := url.Parse(c.Webhook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid scanner webhook URL %q", c.Webhook.URL)
+		}
+
+		if c.Webhook.Timeout <= 0 {
+			return fmt.Errorf("scanner webhook timeout must be positive, got %s", c.Webhook.Timeout)
+		}
+	}
+
+	return nil
+}
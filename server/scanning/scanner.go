@@ -0,0 +1,91 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package scanning
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/agntcy/dir/server/scanning/config"
+)
+
+// Target is a piece of content scanned for a record, i.e. the record itself or an attached artifact.
+type Target struct {
+	// Name identifies the target in findings, e.g. record or referrer agntcy.dir.sign.v1.Signature.
+	Name string
+
+	// Content is the scanned content.
+	Content []byte
+}
+
+// Finding is an issue reported by a scanner.
+type Finding struct {
+	// Scanner is the name of the scanner reporting the finding.
+	Scanner string
+
+	// Rule is the rule of the scanner matching the content, e.g. aws_access_key.
+	Rule string
+
+	// Target is the name of the target containing the finding.
+	Target string
+}
+
+// String returns a description of the finding. It never contains the matched content.
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s in %s", f.Scanner, f.Rule, f.Target)
+}
+
+// Scanner scans the content of a record and reports its findings.
+type Scanner interface {
+	// Name returns the name of the scanner reported in findings.
+	Name() string
+
+	// Scan scans the targets of the record and returns the findings.
+	Scan(ctx context.Context, cid string, targets []Target) ([]Finding, error)
+}
+
+type secretPattern struct {
+	name  string
+	regex *regexp.Regexp
+}
+
+// secretScanner detects secrets in content with regular expressions.
+type secretScanner struct {
+	patterns []secretPattern
+}
+
+// NewSecretScanner creates a scanner detecting secrets matching the patterns.
+func NewSecretScanner(patterns []config.SecretPattern) (Scanner, error) {
+	scanner := &secretScanner{}
+
+	for _, pattern := range patterns {
+		regex, err := regexp.Compile(pattern.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex of secret pattern %s: %w", pattern.Name, err)
+		}
+
+		scanner.patterns = append(scanner.patterns, secretPattern{name: pattern.Name, regex: regex})
+	}
+
+	return scanner, nil
+}
+
+func (s *secretScanner) Name() string {
+	return "secrets"
+}
+
+func (s *secretScanner) Scan(_ context.Context, _ string, targets []Target) ([]Finding, error) {
+	var findings []Finding
+
+	for _, target := range targets {
+		for _, pattern := range s.patterns {
+			if pattern.regex.Match(target.Content) {
+				findings = append(findings, Finding{Scanner: s.Name(), Rule: pattern.name, Target: target.Name})
+			}
+		}
+	}
+
+	return findings, nil
+}
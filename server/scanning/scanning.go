@@ -0,0 +1,225 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package scanning scans the content of pushed records and their attached
+// artifacts for secrets and other issues, and blocks the publication of
+// flagged records according to the configured action.
+package scanning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/scanning/config"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var logger = logging.Logger("scanning")
+
+// Result is the outcome of scanning a record.
+type Result struct {
+	CID      string
+	Verdict  string
+	Findings []Finding
+}
+
+// Service scans pushed records asynchronously and stores their verdicts.
+type Service struct {
+	db       types.DatabaseAPI
+	store    types.StoreAPI
+	eventBus *events.SafeEventBus
+	config   config.Config
+	scanners []Scanner
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New creates a new scanning service.
+func New(db types.DatabaseAPI, store types.StoreAPI, opts types.APIOptions) (*Service, error) {
+	cfg := opts.Config().Scanning
+
+	service := &Service{
+		db:       db,
+		store:    store,
+		eventBus: opts.EventBus(),
+		config:   cfg,
+		stopCh:   make(chan struct{}),
+	}
+
+	if !cfg.Enabled {
+		return service, nil
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid scanning configuration: %w", err)
+	}
+
+	if patterns := cfg.Patterns(); len(patterns) > 0 {
+		secretScanner, err := NewSecretScanner(patterns)
+		if err != nil {
+			return nil, err
+		}
+
+		service.scanners = append(service.scanners, secretScanner)
+	}
+
+	if cfg.Webhook.URL != "" {
+		service.scanners = append(service.scanners, NewWebhookScanner(cfg.Webhook))
+	}
+
+	if len(service.scanners) == 0 {
+		return nil, errors.New("scanning is enabled without any scanner")
+	}
+
+	return service, nil
+}
+
+// Scan runs the scanners over the record and its attached artifacts and stores the verdict.
+// A RECORD_FLAGGED event is emitted for records with findings.
+func (s *Service) Scan(ctx context.Context, cid string) (*Result, error) {
+	targets, err := s.targets(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{CID: cid, Verdict: types.ScanVerdictClean}
+
+	for _, scanner := range s.scanners {
+		findings, err := scanner.Scan(ctx, cid, targets)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan record with %s scanner: %w", scanner.Name(), err)
+		}
+
+		result.Findings = append(result.Findings, findings...)
+	}
+
+	if len(result.Findings) > 0 {
+		result.Verdict = types.ScanVerdictFlagged
+		if s.config.Action == config.ActionBlock {
+			result.Verdict = types.ScanVerdictBlocked
+		}
+	}
+
+	findings := make([]string, 0, len(result.Findings))
+	for _, finding := range result.Findings {
+		findings = append(findings, finding.String())
+	}
+
+	if err := s.db.SetRecordScan(cid, result.Verdict, findings); err != nil {
+		return nil, fmt.Errorf("failed to store scan verdict: %w", err)
+	}
+
+	if len(findings) > 0 {
+		logger.Warn("Record flagged by content scanning", "cid", cid, "verdict", result.Verdict, "findings", findings)
+
+		s.eventBus.RecordFlagged(cid, result.Verdict, findings)
+	}
+
+	return result, nil
+}
+
+// targets returns the record content and the content of its attached artifacts.
+func (s *Service) targets(ctx context.Context, cid string) ([]Target, error) {
+	record, err := s.store.Pull(ctx, &corev1.RecordRef{Cid: cid})
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull record: %w", err)
+	}
+
+	content, err := record.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal record: %w", err)
+	}
+
+	targets := []Target{{Name: "record", Content: content}}
+
+	referrerStore, ok := s.store.(types.ReferrerStoreAPI)
+	if !ok {
+		return targets, nil
+	}
+
+	err = referrerStore.WalkReferrers(ctx, cid, "", func(referrer *corev1.RecordReferrer) error {
+		content, err := protojson.Marshal(referrer.GetData())
+		if err != nil {
+			return fmt.Errorf("failed to marshal referrer: %w", err)
+		}
+
+		targets = append(targets, Target{Name: "referrer " + referrer.GetType(), Content: content})
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk referrers: %w", err)
+	}
+
+	return targets, nil
+}
+
+// Start begins scanning pushed records. Records are scanned again when they are signed
+// to cover the artifacts attached after the push.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.config.Enabled {
+		logger.Info("Record content scanning disabled")
+
+		return nil
+	}
+
+	subID, eventCh := s.eventBus.Subscribe(&eventsv1.ListenRequest{
+		EventTypes: []eventsv1.EventType{
+			eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED,
+			eventsv1.EventType_EVENT_TYPE_RECORD_SIGNED,
+		},
+	})
+	if eventCh == nil {
+		logger.Warn("Event bus disabled, pushed records are not scanned")
+
+		return nil
+	}
+
+	logger.Info("Starting scanning service", "scanners", len(s.scanners), "action", s.config.Action)
+
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+		defer s.eventBus.Unsubscribe(subID)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stopCh:
+				return
+			case event, ok := <-eventCh:
+				if !ok {
+					return
+				}
+
+				if _, err := s.Scan(ctx, event.ResourceID); err != nil {
+					logger.Error("Failed to scan record", "cid", event.ResourceID, "error", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop gracefully shuts down the scanning service.
+func (s *Service) Stop() error {
+	logger.Info("Stopping scanning service")
+
+	close(s.stopCh)
+	s.wg.Wait()
+
+	logger.Info("Scanning service stopped")
+
+	return nil
+}
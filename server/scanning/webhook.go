@@ -0,0 +1,104 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package scanning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/agntcy/dir/server/scanning/config"
+	"github.com/agntcy/dir/server/webhooks"
+)
+
+// maxWebhookResponseSize limits the size of the responses read from the external scanner.
+const maxWebhookResponseSize = 1 << 20
+
+// webhookRequest is the body posted to the external scanner.
+type webhookRequest struct {
+	CID     string          `json:"cid"`
+	Targets []webhookTarget `json:"targets"`
+}
+
+type webhookTarget struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// webhookResponse is the body returned by the external scanner.
+type webhookResponse struct {
+	Findings []webhookFinding `json:"findings"`
+}
+
+type webhookFinding struct {
+	Rule   string `json:"rule"`
+	Target string `json:"target"`
+}
+
+// webhookScanner delegates scanning to an external scanner over HTTP.
+// Requests are signed like record webhook deliveries if a secret is configured.
+type webhookScanner struct {
+	config config.WebhookConfig
+	client *http.Client
+}
+
+// NewWebhookScanner creates a scanner posting the content to an external scanner.
+func NewWebhookScanner(cfg config.WebhookConfig) Scanner {
+	return &webhookScanner{
+		config: cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+func (s *webhookScanner) Name() string {
+	return "webhook"
+}
+
+func (s *webhookScanner) Scan(ctx context.Context, cid string, targets []Target) ([]Finding, error) {
+	request := webhookRequest{CID: cid, Targets: make([]webhookTarget, 0, len(targets))}
+	for _, target := range targets {
+		request.Targets = append(request.Targets, webhookTarget{Name: target.Name, Content: string(target.Content)})
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal scan request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if s.config.Secret != "" {
+		req.Header.Set(webhooks.SignatureHeader, webhooks.Sign(s.config.Secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	var response webhookResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxWebhookResponseSize)).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode scan response: %w", err)
+	}
+
+	findings := make([]Finding, 0, len(response.Findings))
+	for _, finding := range response.Findings {
+		findings = append(findings, Finding{Scanner: s.Name(), Rule: finding.Rule, Target: finding.Target})
+	}
+
+	return findings, nil
+}
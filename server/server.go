@@ -5,36 +5,57 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"net"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	eventsv1 "github.com/agntcy/dir/api/events/v1"
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
 	searchv1 "github.com/agntcy/dir/api/search/v1"
 	signv1 "github.com/agntcy/dir/api/sign/v1"
 	storev1 "github.com/agntcy/dir/api/store/v1"
 	"github.com/agntcy/dir/api/version"
+	"github.com/agntcy/dir/server/apis"
 	"github.com/agntcy/dir/server/authn"
+	authnconfig "github.com/agntcy/dir/server/authn/config"
 	"github.com/agntcy/dir/server/authz"
+	"github.com/agntcy/dir/server/churn"
 	"github.com/agntcy/dir/server/config"
+	"github.com/agntcy/dir/server/consistency"
 	"github.com/agntcy/dir/server/controller"
 	"github.com/agntcy/dir/server/database"
 	"github.com/agntcy/dir/server/events"
 	"github.com/agntcy/dir/server/healthcheck"
+	"github.com/agntcy/dir/server/listener"
+	"github.com/agntcy/dir/server/metrics"
 	grpclogging "github.com/agntcy/dir/server/middleware/logging"
+	grpcpriority "github.com/agntcy/dir/server/middleware/priority"
 	grpcratelimit "github.com/agntcy/dir/server/middleware/ratelimit"
 	grpcrecovery "github.com/agntcy/dir/server/middleware/recovery"
+	"github.com/agntcy/dir/server/operations"
+	"github.com/agntcy/dir/server/plugins"
+	"github.com/agntcy/dir/server/proxy"
 	"github.com/agntcy/dir/server/publication"
 	"github.com/agntcy/dir/server/routing"
+	"github.com/agntcy/dir/server/scanning"
+	"github.com/agntcy/dir/server/signpolicy"
 	"github.com/agntcy/dir/server/store"
+	"github.com/agntcy/dir/server/store/cache"
+	"github.com/agntcy/dir/server/store/eventswrap"
+	"github.com/agntcy/dir/server/store/indexonly"
+	"github.com/agntcy/dir/server/store/probe"
 	"github.com/agntcy/dir/server/sync"
 	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/ui"
+	"github.com/agntcy/dir/server/validation"
+	"github.com/agntcy/dir/server/webhooks"
 	"github.com/agntcy/dir/utils/logging"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 )
@@ -44,6 +65,10 @@ const (
 	bytesToMB = 1024 * 1024
 )
 
+// StoreProbeHealthService is the health service reporting whether the store provider
+// was probed at startup without error or configuration mismatch.
+const StoreProbeHealthService = "store-probe"
+
 var (
 	_      types.API = &Server{}
 	logger           = logging.Logger("server")
@@ -55,12 +80,23 @@ type Server struct {
 	routing            types.RoutingAPI
 	database           types.DatabaseAPI
 	eventService       *events.Service
+	eventsDamper       *churn.Damper
 	syncService        *sync.Service
 	authnService       *authn.Service
 	authzService       *authz.Service
 	publicationService *publication.Service
+	validationService  *validation.Service
+	scanningService    *scanning.Service
+	consistencyService *consistency.Service
+	cacheWarmer        *cache.Warmer
+	webhookService     *webhooks.Service
+	metricsServer      *metrics.Server
+	pluginManager      *plugins.Manager
+	operations         *operations.Manager
+	proxy              *proxy.Proxy
 	health             *healthcheck.Checker
 	grpcServer         *grpc.Server
+	uiServer           *ui.Server
 }
 
 // buildConnectionOptions creates gRPC server options for connection management.
@@ -135,9 +171,23 @@ func Run(ctx context.Context, cfg *config.Config) error {
 	}
 }
 
-func New(ctx context.Context, cfg *config.Config) (*Server, error) {
+// New creates a Directory server from the config.
+// Options replace built-in implementations and register additional gRPC services,
+// so that the server can be embedded in other Go programs.
+func New(ctx context.Context, cfg *config.Config, opts ...Option) (*Server, error) {
 	logger.Debug("Creating server with config", "config", cfg, "version", version.String())
 
+	embedOpts := &serverOptions{}
+	for _, opt := range opts {
+		opt(embedOpts)
+	}
+
+	// Load plugins first (so they can provide the store and interceptors)
+	pluginManager, err := plugins.Load(cfg.Plugins)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plugins: %w", err)
+	}
+
 	// Load options
 	options := types.NewOptions(cfg)
 	serverOpts := []grpc.ServerOption{}
@@ -168,40 +218,105 @@ func New(ctx context.Context, cfg *config.Config) (*Server, error) {
 		)
 	}
 
+	// Add load shedding interceptors (after rate limiting, so rejected requests never occupy capacity)
+	// This keeps interactive requests responsive by queueing or shedding lower priority work under load
+	if cfg.Priority.Enabled {
+		priorityOpts, err := grpcpriority.ServerOptions(&cfg.Priority)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create priority interceptors: %w", err)
+		}
+
+		serverOpts = append(serverOpts, priorityOpts...)
+
+		logger.Info("Load shedding enabled",
+			"max_inflight", cfg.Priority.MaxInflight,
+			"write_max_inflight", cfg.Priority.WriteMaxInflight,
+			"background_max_inflight", cfg.Priority.BackgroundMaxInflight,
+		)
+	}
+
 	// Add gRPC logging interceptors (after recovery and rate limiting, before auth/authz)
 	grpcLogger := logging.Logger("grpc")
 	loggingOpts := grpclogging.ServerOptions(grpcLogger, cfg.Logging.Verbose)
 	serverOpts = append(serverOpts, loggingOpts...)
 
 	// Create event service first (so other services can emit events)
-	eventService := events.New()
-	safeEventBus := events.NewSafeEventBus(eventService.Bus())
+	eventService := embedOpts.eventService
+	if eventService == nil {
+		if err := cfg.Events.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid events config: %w", err)
+		}
+
+		eventService = events.NewWithConfig(cfg.Events)
+	}
+
+	if err := cfg.Churn.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid churn config: %w", err)
+	}
+
+	// Coalesce the lifecycle events of flapping records
+	eventsDamper := churn.New(cfg.Churn, "events")
+	safeEventBus := events.NewSafeEventBus(eventService.Bus()).WithChurnDamper(eventsDamper)
 
 	// Add event bus to options for other services
 	options = options.WithEventBus(safeEventBus)
 
-	// Create APIs
-	storeAPI, err := store.New(options) //nolint:staticcheck
-	if err != nil {
-		return nil, fmt.Errorf("failed to create store: %w", err)
+	databaseAPI := embedOpts.database
+	if databaseAPI == nil {
+		databaseAPI, err = database.New(options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create database API: %w", err)
+		}
 	}
 
-	routingAPI, err := routing.New(ctx, storeAPI, options)
+	// Create pull-through proxy for records missing locally
+	pullProxy, err := proxy.New(cfg.Proxy)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create routing: %w", err)
+		return nil, fmt.Errorf("failed to create pull-through proxy: %w", err)
 	}
 
-	databaseAPI, err := database.New(options)
+	// Create APIs
+	var (
+		storeAPI   types.StoreAPI
+		storeProbe *probe.Result
+	)
+
+	if cfg.Proxy.IndexOnly {
+		// Index-only nodes store no blobs, records are pulled from the proxy upstreams
+		storeAPI = eventswrap.Wrap(indexonly.New(pullProxy, databaseAPI), options.EventBus())
+
+		logger.Info("Index-only mode enabled, record blobs are not stored locally")
+	} else {
+		storeAPI, storeProbe, err = newStore(ctx, options, embedOpts.store, pluginManager)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create store: %w", err)
+		}
+	}
+
+	// Validate pushed records with plugins (outermost, so rejected records emit no events)
+	storeAPI = pluginManager.WrapStore(storeAPI)
+
+	routingAPI, err := routing.New(ctx, storeAPI, options)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create database API: %w", err)
+		return nil, fmt.Errorf("failed to create routing: %w", err)
 	}
 
 	// Create services
-	syncService, err := sync.New(databaseAPI, storeAPI, options)
+	syncService, err := sync.New(databaseAPI, storeAPI, routingAPI, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create sync service: %w", err)
 	}
 
+	// Browsers authenticate to the web UI with bearer tokens, e.g. API tokens
+	if cfg.UI.Enabled && cfg.Authn.Enabled && cfg.Authn.Mode != authnconfig.AuthModeJWT {
+		return nil, errors.New("the web UI requires the jwt authentication mode")
+	}
+
+	// API tokens only grant the methods of their scopes when authorization is enforced
+	if cfg.Authn.Enabled && cfg.Authn.Tokens.Enabled() && !cfg.Authz.Enabled {
+		return nil, errors.New("API tokens require authorization to be enabled")
+	}
+
 	// Create JWT authentication service if enabled
 	var authnService *authn.Service
 	if cfg.Authn.Enabled {
@@ -225,12 +340,112 @@ func New(ctx context.Context, cfg *config.Config) (*Server, error) {
 		serverOpts = append(serverOpts, authzService.GetServerOptions()...)
 	}
 
+	// Add plugin interceptors (after auth/authz, so plugins see authenticated peers)
+	serverOpts = append(serverOpts, pluginManager.ServerOptions()...)
+
+	// Mint API tokens if enabled
+	var (
+		tokensConfig   authnconfig.TokensConfig
+		tokenExchanger *authn.Exchanger
+	)
+	if authnService != nil {
+		tokensConfig, tokenExchanger = authnService.Tokens()
+	}
+
+	// Restrict event subscriptions to the caller's namespace when authz is enabled
+	var eventsAuthorizer *authz.Authorizer
+	if authzService != nil {
+		eventsAuthorizer = authzService.Authorizer()
+	}
+
+	// Create signature policy evaluator
+	signPolicy, err := signpolicy.New(storeAPI, cfg.Publication)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature policy: %w", err)
+	}
+
 	// Create publication service
-	publicationService, err := publication.New(databaseAPI, storeAPI, routingAPI, options)
+	publicationService, err := publication.New(databaseAPI, storeAPI, routingAPI, signPolicy, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create publication service: %w", err)
 	}
 
+	// Create stored record validation service
+	validationService, err := validation.New(databaseAPI, storeAPI, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create validation service: %w", err)
+	}
+
+	// Create record content scanning service
+	scanningService, err := scanning.New(databaseAPI, storeAPI, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scanning service: %w", err)
+	}
+
+	// Create consistency check service
+	consistencyService, err := consistency.New(databaseAPI, storeAPI, routingAPI, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consistency service: %w", err)
+	}
+
+	// Create cache warmer keeping the most pulled records in the local cache tier
+	cacheWarmer := newCacheWarmer(cfg, embedOpts.store, databaseAPI, storeAPI)
+
+	// Create record webhook service
+	webhookService, err := webhooks.New(databaseAPI, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook service: %w", err)
+	}
+
+	// Create metrics server exporting the publication gauges
+	var metricsServer *metrics.Server
+	if cfg.Metrics.Enabled {
+		metricsServer = metrics.New(cfg.Metrics)
+		if err := metricsServer.Register(publication.NewCollector(databaseAPI)); err != nil {
+			return nil, fmt.Errorf("failed to register publication metrics: %w", err)
+		}
+
+		// Serve the API descriptors next to the metrics
+		metricsServer.Handle(apis.Path, apis.Handler())
+		metricsServer.Handle(apis.Path+"/", apis.Handler())
+	}
+
+	// Create web UI server, calling the APIs over a connection to the gRPC server once it listens
+	var uiServer *ui.Server
+	if cfg.UI.Enabled {
+		uiServer = ui.New(cfg.UI, cfg.Authn.Enabled)
+	}
+
+	// Create schema version policy for pushed records
+	schemaVersions, err := validation.NewSchemaVersionPolicy(cfg.Validation.SchemaVersions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schema version policy: %w", err)
+	}
+
+	// Create license policy for pushed records
+	licenses := validation.NewLicensePolicy(cfg.Validation.Licenses)
+
+	// Create authorship policy for pushed records
+	authorship, err := validation.NewAuthorshipPolicy(cfg.Validation.Authorship)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authorship policy: %w", err)
+	}
+
+	// Create OASF taxonomy policy for pushed records
+	taxonomy, err := validation.NewTaxonomyPolicy(cfg.Validation.Taxonomy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create taxonomy policy: %w", err)
+	}
+
+	// The store of index-only nodes already pulls records from the proxy upstreams
+	storePullProxy := pullProxy
+	if cfg.Proxy.IndexOnly {
+		storePullProxy = nil
+	}
+
+	// Create manager of long-running operations
+	operationManager := operations.New()
+
 	// Create a server
 	grpcServer := grpc.NewServer(serverOpts...)
 
@@ -238,17 +453,30 @@ func New(ctx context.Context, cfg *config.Config) (*Server, error) {
 	healthChecker := healthcheck.New()
 
 	// Register APIs
-	eventsv1.RegisterEventServiceServer(grpcServer, controller.NewEventsController(eventService))
-	storev1.RegisterStoreServiceServer(grpcServer, controller.NewStoreController(storeAPI, databaseAPI, options.EventBus()))
-	routingv1.RegisterRoutingServiceServer(grpcServer, controller.NewRoutingController(routingAPI, storeAPI, publicationService))
-	routingv1.RegisterPublicationServiceServer(grpcServer, controller.NewPublicationController(databaseAPI, options))
-	searchv1.RegisterSearchServiceServer(grpcServer, controller.NewSearchController(databaseAPI))
-	storev1.RegisterSyncServiceServer(grpcServer, controller.NewSyncController(databaseAPI, options))
-	signv1.RegisterSignServiceServer(grpcServer, controller.NewSignController(storeAPI))
+	eventsv1.RegisterEventServiceServer(grpcServer, controller.NewEventsController(eventService, databaseAPI, eventsAuthorizer, webhookService))
+	corev1.RegisterInfoServiceServer(grpcServer, controller.NewInfoController(options, schemaVersions, storeProbe))
+	corev1.RegisterOperationServiceServer(grpcServer, controller.NewOperationController(operationManager))
+	corev1.RegisterTokenServiceServer(grpcServer, controller.NewTokenController(tokensConfig, tokenExchanger))
+	storev1.RegisterStoreServiceServer(grpcServer, controller.NewStoreController(storeAPI, databaseAPI, routingAPI, options.EventBus(), schemaVersions, licenses, authorship, taxonomy, cfg.Region, storePullProxy, operationManager))
+	routingv1.RegisterRoutingServiceServer(grpcServer, controller.NewRoutingController(routingAPI, storeAPI, databaseAPI, publicationService, signPolicy, operationManager))
+	routingv1.RegisterPublicationServiceServer(grpcServer, controller.NewPublicationController(databaseAPI, publicationService))
+	searchv1.RegisterSearchServiceServer(grpcServer, controller.NewSearchController(databaseAPI, cfg.Region))
+	storev1.RegisterSyncServiceServer(grpcServer, controller.NewSyncController(databaseAPI, storeAPI, options, operationManager))
+	signv1.RegisterSignServiceServer(grpcServer, controller.NewSignController(storeAPI, databaseAPI, signPolicy, options.EventBus(), operationManager, cfg.Authn))
+
+	// Register additional services of embedding programs
+	for _, service := range embedOpts.services {
+		grpcServer.RegisterService(service.desc, service.impl)
+	}
 
 	// Register health service
 	healthChecker.Register(grpcServer)
 
+	// Report the store probe results as a separate health service
+	if storeProbe != nil {
+		healthChecker.SetServiceStatus(StoreProbeHealthService, storeProbe.Healthy())
+	}
+
 	// Register reflection service
 	reflection.Register(grpcServer)
 
@@ -258,15 +486,87 @@ func New(ctx context.Context, cfg *config.Config) (*Server, error) {
 		routing:            routingAPI,
 		database:           databaseAPI,
 		eventService:       eventService,
+		eventsDamper:       eventsDamper,
 		syncService:        syncService,
 		authnService:       authnService,
 		authzService:       authzService,
 		publicationService: publicationService,
+		validationService:  validationService,
+		scanningService:    scanningService,
+		consistencyService: consistencyService,
+		cacheWarmer:        cacheWarmer,
+		webhookService:     webhookService,
+		metricsServer:      metricsServer,
+		pluginManager:      pluginManager,
+		operations:         operationManager,
+		proxy:              pullProxy,
 		health:             healthChecker,
 		grpcServer:         grpcServer,
+		uiServer:           uiServer,
 	}, nil
 }
 
+// newCacheWarmer creates the warmer of the local cache tier of the store if enabled.
+// Returns nil if the store has no local cache tier to warm.
+func newCacheWarmer(cfg *config.Config, customStore types.StoreAPI, db types.DatabaseAPI, storeAPI types.StoreAPI) *cache.Warmer {
+	if !cfg.Store.Cache.Warm.Enabled {
+		return nil
+	}
+
+	if !cfg.Store.Cache.Enabled || cfg.Proxy.IndexOnly || customStore != nil {
+		logger.Warn("Cache warming enabled without local cache tier, ignoring")
+
+		return nil
+	}
+
+	return cache.NewWarmer(db, storeAPI, cfg.Store.Cache.Warm)
+}
+
+// newStore creates the configured store, or wraps the given custom store to emit record events.
+// The configured store provider is served by a plugin if one provides it.
+// The store provider is probed before it is wrapped if enabled, so that probe records emit no events.
+func newStore(ctx context.Context, options types.APIOptions, customStore types.StoreAPI, pluginManager *plugins.Manager) (types.StoreAPI, *probe.Result, error) {
+	if customStore != nil {
+		return eventswrap.Wrap(customStore, options.EventBus()), nil, nil
+	}
+
+	cfg := options.Config()
+
+	pluginStore, err := pluginManager.NewStore(cfg.Store.Provider, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create plugin store: %w", err)
+	}
+
+	provider := pluginStore
+	if provider == nil {
+		provider, err = store.NewProvider(options)
+		if err != nil {
+			return nil, nil, err //nolint:wrapcheck
+		}
+	}
+
+	var probeResult *probe.Result
+
+	if cfg.Store.Probe.Enabled {
+		probeCtx, cancel := context.WithTimeout(ctx, cfg.Store.Probe.Timeout)
+		probeResult = probe.Run(probeCtx, cfg.Store.Provider, provider, cfg.Connection.WithDefaults().MaxRecvMsgSize)
+		probeResult.Log()
+
+		cancel()
+	}
+
+	if pluginStore != nil {
+		return eventswrap.Wrap(pluginStore, options.EventBus()), probeResult, nil
+	}
+
+	wrapped, err := store.Wrap(options, provider)
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
+	return wrapped, probeResult, nil
+}
+
 func (s Server) Options() types.APIOptions { return s.options }
 
 func (s Server) Store() types.StoreAPI { return s.store }
@@ -286,6 +586,13 @@ func (s Server) Close(ctx context.Context) {
 		}
 	}
 
+	// Stop accepting connections before stopping the services, so that a server
+	// taking over the listen address serves new clients while in-flight requests drain
+	grpcStopped := s.drain()
+
+	// Discard the events held back for flapping records
+	s.eventsDamper.Stop()
+
 	// Stop event service
 	if s.eventService != nil {
 		if err := s.eventService.Stop(); err != nil {
@@ -293,6 +600,13 @@ func (s Server) Close(ctx context.Context) {
 		}
 	}
 
+	// Stop long-running operations before the services they use
+	if s.operations != nil {
+		if err := s.operations.Stop(); err != nil {
+			logger.Error("Failed to stop operation manager", "error", err)
+		}
+	}
+
 	// Stop routing service (closes GossipSub, p2p server, DHT)
 	if s.routing != nil {
 		if err := s.routing.Stop(); err != nil {
@@ -328,10 +642,124 @@ func (s Server) Close(ctx context.Context) {
 		}
 	}
 
-	s.grpcServer.GracefulStop()
+	// Stop validation service if running
+	if s.validationService != nil {
+		if err := s.validationService.Stop(); err != nil {
+			logger.Error("Failed to stop validation service", "error", err)
+		}
+	}
+
+	// Stop scanning service if running
+	if s.scanningService != nil {
+		if err := s.scanningService.Stop(); err != nil {
+			logger.Error("Failed to stop scanning service", "error", err)
+		}
+	}
+
+	// Stop consistency service if running
+	if s.consistencyService != nil {
+		if err := s.consistencyService.Stop(); err != nil {
+			logger.Error("Failed to stop consistency service", "error", err)
+		}
+	}
+
+	// Stop cache warmer if running
+	if s.cacheWarmer != nil {
+		if err := s.cacheWarmer.Stop(); err != nil {
+			logger.Error("Failed to stop cache warmer", "error", err)
+		}
+	}
+
+	// Stop webhook service if running
+	if s.webhookService != nil {
+		if err := s.webhookService.Stop(); err != nil {
+			logger.Error("Failed to stop webhook service", "error", err)
+		}
+	}
+
+	// Stop web UI server if running
+	if s.uiServer != nil {
+		if err := s.uiServer.Stop(); err != nil {
+			logger.Error("Failed to stop web UI server", "error", err)
+		}
+	}
+
+	// Stop metrics server if running
+	if s.metricsServer != nil {
+		if err := s.metricsServer.Stop(); err != nil {
+			logger.Error("Failed to stop metrics server", "error", err)
+		}
+	}
+
+	// Wait for the in-flight requests, ended with the services they use
+	<-grpcStopped
+
+	// Close pull-through proxy once no more requests are served
+	if s.proxy != nil {
+		if err := s.proxy.Close(); err != nil {
+			logger.Error("Failed to close pull-through proxy", "error", err)
+		}
+	}
+
+	// Stop plugins last, as their interceptors serve requests until the server stops
+	if s.pluginManager != nil {
+		if err := s.pluginManager.Stop(); err != nil {
+			logger.Error("Failed to stop plugins", "error", err)
+		}
+	}
+}
+
+// drain gracefully stops the gRPC server in the background, which stops accepting
+// connections and asks clients to reconnect for new requests, and waits up to the
+// drain timeout for in-flight requests such as event streams to complete.
+// The returned channel is closed once the gRPC server is stopped.
+func (s Server) drain() <-chan struct{} {
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		s.grpcServer.GracefulStop()
+	}()
+
+	drainTimeout := s.options.Config().Listener.DrainTimeout
+	if drainTimeout <= 0 {
+		return stopped
+	}
+
+	logger.Info("Draining in-flight requests", "timeout", drainTimeout)
+
+	select {
+	case <-stopped:
+	case <-time.After(drainTimeout):
+		logger.Info("Drain timeout elapsed, ending in-flight requests")
+	}
+
+	return stopped
+}
+
+// Run starts the server and blocks until the context is canceled, then stops the server.
+// Unlike the package-level Run, it does not handle OS signals, which is left to embedding programs.
+func (s Server) Run(ctx context.Context) error {
+	if err := s.start(ctx); err != nil {
+		return fmt.Errorf("failed to start server: %w", err)
+	}
+
+	<-ctx.Done()
+
+	s.Close(context.WithoutCancel(ctx))
+
+	return nil
 }
 
 func (s Server) start(ctx context.Context) error {
+	// Start plugins first, as they serve store and interceptor calls of other services
+	if s.pluginManager != nil {
+		if err := s.pluginManager.Start(ctx, s.options.EventBus()); err != nil {
+			return fmt.Errorf("failed to start plugins: %w", err)
+		}
+	}
+
 	// Start sync service
 	if s.syncService != nil {
 		if err := s.syncService.Start(ctx); err != nil {
@@ -350,10 +778,62 @@ func (s Server) start(ctx context.Context) error {
 		logger.Info("Publication service started")
 	}
 
-	// Create a listener on TCP port
-	listen, err := net.Listen("tcp", s.Options().Config().ListenAddress) //nolint:noctx
+	// Start validation service
+	if s.validationService != nil {
+		if err := s.validationService.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start validation service: %w", err)
+		}
+
+		logger.Info("Validation service started")
+	}
+
+	// Start scanning service
+	if s.scanningService != nil {
+		if err := s.scanningService.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start scanning service: %w", err)
+		}
+
+		logger.Info("Scanning service started")
+	}
+
+	// Start consistency service
+	if s.consistencyService != nil {
+		if err := s.consistencyService.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start consistency service: %w", err)
+		}
+
+		logger.Info("Consistency service started")
+	}
+
+	// Start cache warmer
+	if s.cacheWarmer != nil {
+		if err := s.cacheWarmer.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start cache warmer: %w", err)
+		}
+
+		logger.Info("Cache warmer started")
+	}
+
+	// Start webhook service
+	if s.webhookService != nil {
+		if err := s.webhookService.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start webhook service: %w", err)
+		}
+
+		logger.Info("Webhook service started")
+	}
+
+	// Start metrics server
+	if s.metricsServer != nil {
+		if err := s.metricsServer.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start metrics server: %w", err)
+		}
+	}
+
+	// Create a listener on TCP port, or use the socket passed by systemd socket activation
+	listen, err := listener.Listen(ctx, s.Options().Config().Listener, s.Options().Config().ListenAddress)
 	if err != nil {
-		return fmt.Errorf("failed to listen on %s: %w", s.Options().Config().ListenAddress, err)
+		return fmt.Errorf("failed to create listener: %w", err)
 	}
 
 	// Add readiness checks
@@ -370,12 +850,27 @@ func (s Server) start(ctx context.Context) error {
 
 	// Serve gRPC server in the background
 	go func() {
-		logger.Info("Server starting", "address", s.Options().Config().ListenAddress)
+		logger.Info("Server starting", "address", listen.Addr().String())
 
 		if err := s.grpcServer.Serve(listen); err != nil {
 			logger.Error("Failed to start server", "error", err)
 		}
 	}()
 
+	// Start web UI server, calling the APIs like any other client of the gRPC server
+	if s.uiServer != nil {
+		creds := insecure.NewCredentials()
+		if s.authnService != nil {
+			creds, err = s.authnService.ClientCredentials()
+			if err != nil {
+				return fmt.Errorf("failed to create web UI credentials: %w", err)
+			}
+		}
+
+		if err := s.uiServer.Start(ctx, listen.Addr(), creds); err != nil {
+			return fmt.Errorf("failed to start web UI server: %w", err)
+		}
+	}
+
 	return nil
 }
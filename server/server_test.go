@@ -8,8 +8,11 @@ import (
 	"time"
 
 	"github.com/agntcy/dir/server/config"
+	"github.com/agntcy/dir/server/events"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
 )
 
@@ -235,3 +238,22 @@ func TestKeepaliveEnforcementPolicy_StructCreation(t *testing.T) {
 	assert.Equal(t, 1*time.Minute, policy.MinTime)
 	assert.True(t, policy.PermitWithoutStream)
 }
+
+// TestOptions verifies that embedding options are applied.
+func TestOptions(t *testing.T) {
+	eventService := events.New()
+
+	opts := &serverOptions{}
+	for _, opt := range []Option{
+		WithEventService(eventService),
+		WithService(&healthpb.Health_ServiceDesc, health.NewServer()),
+	} {
+		opt(opts)
+	}
+
+	assert.Same(t, eventService, opts.eventService)
+	assert.Nil(t, opts.store)
+	assert.Nil(t, opts.database)
+	assert.Len(t, opts.services, 1)
+	assert.Equal(t, healthpb.Health_ServiceDesc.ServiceName, opts.services[0].desc.ServiceName)
+}
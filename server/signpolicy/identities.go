@@ -0,0 +1,134 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package signpolicy
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/utils/cosign"
+)
+
+// SignerIdentities returns the identities of the signer of a record signature.
+//
+// Identities are only extracted from material the signature verifies against:
+// the fingerprints of the record public keys that verified it, and the URI
+// (e.g. SPIFFE ID) and email (OIDC subject) SANs of its signing certificate.
+// The certificate chain is not verified against a trust root.
+func SignerIdentities(ctx context.Context, refStore types.ReferrerStoreAPI, recordCID string, signature *signv1.Signature) ([]string, error) {
+	payload, err := signv1.CIDPayload(recordCID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate payload: %w", err)
+	}
+
+	var identities []string
+
+	err = refStore.WalkReferrers(ctx, recordCID, corev1.PublicKeyReferrerType, func(referrer *corev1.RecordReferrer) error {
+		publicKey := &signv1.PublicKey{}
+		if err := publicKey.UnmarshalReferrer(referrer); err != nil {
+			logger.Warn("Failed to decode public key referrer", "recordCID", recordCID, "error", err)
+
+			return nil
+		}
+
+		if cosign.VerifySignature([]byte(publicKey.GetKey()), signature.GetSignature(), payload) != nil {
+			return nil
+		}
+
+		fingerprint, err := keyFingerprint([]byte(publicKey.GetKey()))
+		if err != nil {
+			logger.Warn("Failed to fingerprint public key", "recordCID", recordCID, "error", err)
+
+			return nil
+		}
+
+		identities = append(identities, fingerprint)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk public key referrers: %w", err)
+	}
+
+	if signature.GetCertificate() != "" {
+		certIdentities, err := certificateIdentities(signature.GetCertificate(), signature.GetSignature(), payload)
+		if err != nil {
+			logger.Warn("Failed to extract identities from signing certificate", "recordCID", recordCID, "error", err)
+		}
+
+		identities = append(identities, certIdentities...)
+	}
+
+	slices.Sort(identities)
+
+	return slices.Compact(identities), nil
+}
+
+// certificateIdentities returns the SAN identities of a signing certificate,
+// provided the signature verifies against the certificate public key.
+// The certificate may be PEM-encoded or base64-encoded DER.
+func certificateIdentities(encoded, signature string, payload []byte) ([]string, error) {
+	der := []byte(encoded)
+	if block, _ := pem.Decode(der); block != nil {
+		der = block.Bytes
+	} else if decoded, err := base64.StdEncoding.DecodeString(encoded); err == nil {
+		der = decoded
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(cert.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode certificate public key: %w", err)
+	}
+
+	publicKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})
+	if err := cosign.VerifySignature(publicKeyPEM, signature, payload); err != nil {
+		return nil, errors.New("signature does not match the certificate public key")
+	}
+
+	identities := make([]string, 0, len(cert.URIs)+len(cert.EmailAddresses))
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+
+	identities = append(identities, cert.EmailAddresses...)
+
+	return identities, nil
+}
+
+// keyFingerprint returns the SHA-256 fingerprint of the DER encoding of a PEM public key.
+func keyFingerprint(publicKeyPEM []byte) (string, error) {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil {
+		return "", errors.New("public key is not PEM-encoded")
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode public key: %w", err)
+	}
+
+	sum := sha256.Sum256(der)
+
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,101 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package signpolicy
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// certificate returns a base64-encoded DER self-signed certificate of the signer key.
+func (s *testSigner) certificate(t *testing.T, uri string, email string) string {
+	t.Helper()
+
+	spiffeID, err := url.Parse(uri)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(1),
+		Subject:        pkix.Name{CommonName: s.Name},
+		NotBefore:      time.Now().Add(-time.Hour),
+		NotAfter:       time.Now().Add(time.Hour),
+		URIs:           []*url.URL{spiffeID},
+		EmailAddresses: []string{email},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &s.key.PublicKey, s.key)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+// publish attaches the public key of the signer to the store.
+func (s *testSigner) publish(t *testing.T, store *referrerStore) {
+	t.Helper()
+
+	referrer, err := (&signv1.PublicKey{Key: s.PublicKey}).MarshalReferrer()
+	require.NoError(t, err)
+
+	require.NoError(t, store.PushReferrer(context.Background(), testCID, referrer))
+}
+
+func TestSignerIdentities(t *testing.T) {
+	ctx := context.Background()
+
+	alice := newTestSigner(t, "alice")
+	bob := newTestSigner(t, "bob")
+
+	t.Run("key fingerprint of the verifying public key", func(t *testing.T) {
+		store := &referrerStore{}
+		alice.publish(t, store)
+		bob.publish(t, store)
+
+		signature := alice.sign(t, store)
+
+		identities, err := SignerIdentities(ctx, store, testCID, &signv1.Signature{Signature: signature})
+		require.NoError(t, err)
+		require.Len(t, identities, 1)
+
+		fingerprint, err := keyFingerprint([]byte(alice.PublicKey))
+		require.NoError(t, err)
+		assert.Equal(t, fingerprint, identities[0])
+		assert.True(t, strings.HasPrefix(fingerprint, "sha256:"))
+	})
+
+	t.Run("certificate identities", func(t *testing.T) {
+		store := &referrerStore{}
+		signature := alice.sign(t, store)
+
+		identities, err := SignerIdentities(ctx, store, testCID, &signv1.Signature{
+			Signature:   signature,
+			Certificate: alice.certificate(t, "spiffe://acme/ci", "ci@acme.com"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ci@acme.com", "spiffe://acme/ci"}, identities)
+	})
+
+	t.Run("certificate of another key is ignored", func(t *testing.T) {
+		store := &referrerStore{}
+		signature := alice.sign(t, store)
+
+		identities, err := SignerIdentities(ctx, store, testCID, &signv1.Signature{
+			Signature:   signature,
+			Certificate: bob.certificate(t, "spiffe://acme/ci", "ci@acme.com"),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, identities)
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package signpolicy evaluates N-of-M signature policies requiring records
+// to be signed by a number of configured signers before they can be published.
+package signpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/agntcy/dir/server/publication/config"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/utils/cosign"
+	"github.com/agntcy/dir/utils/logging"
+)
+
+var logger = logging.Logger("signpolicy")
+
+// ErrPolicyNotSatisfied is returned when a record does not satisfy the signature policies.
+var ErrPolicyNotSatisfied = errors.New("signature policy not satisfied")
+
+// Evaluator checks records against the configured signature policies.
+type Evaluator struct {
+	store    types.StoreAPI
+	policies []config.SignaturePolicy
+}
+
+// New creates a new signature policy evaluator.
+func New(store types.StoreAPI, cfg config.Config) (*Evaluator, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid signature policy configuration: %w", err)
+	}
+
+	return &Evaluator{
+		store:    store,
+		policies: cfg.SignaturePolicies,
+	}, nil
+}
+
+// Enabled reports whether any signature policy is configured.
+func (e *Evaluator) Enabled() bool {
+	return e != nil && len(e.policies) > 0
+}
+
+// Evaluate returns the result of each signature policy for the record.
+// Revoked signatures are not taken into account.
+func (e *Evaluator) Evaluate(ctx context.Context, recordCID string) ([]*signv1.SignaturePolicyResult, error) {
+	if !e.Enabled() {
+		return nil, nil
+	}
+
+	refStore, ok := e.store.(types.ReferrerStoreAPI)
+	if !ok {
+		return nil, errors.New("referrer storage not supported by current store implementation")
+	}
+
+	signatures, err := validSignatures(ctx, refStore, recordCID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := signv1.CIDPayload(recordCID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate payload: %w", err)
+	}
+
+	results := make([]*signv1.SignaturePolicyResult, 0, len(e.policies))
+
+	for _, policy := range e.policies {
+		result := &signv1.SignaturePolicyResult{
+			Name:      policy.Name,
+			Threshold: uint32(policy.Threshold), //nolint:gosec // threshold is validated to be positive
+		}
+
+		for _, signer := range policy.Signers {
+			if hasSigned(signer, signatures, payload) {
+				result.Signers = append(result.Signers, signer.Name)
+			}
+		}
+
+		result.Satisfied = len(result.Signers) >= policy.Threshold
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// Check returns ErrPolicyNotSatisfied if the record does not satisfy all the signature policies.
+func (e *Evaluator) Check(ctx context.Context, recordCID string) error {
+	results, err := e.Evaluate(ctx, recordCID)
+	if err != nil {
+		return err
+	}
+
+	var unsatisfied []string
+
+	for _, result := range results {
+		if !result.GetSatisfied() {
+			unsatisfied = append(unsatisfied, fmt.Sprintf("%s (%d of %d signers)", result.GetName(), len(result.GetSigners()), result.GetThreshold()))
+		}
+	}
+
+	if len(unsatisfied) > 0 {
+		return fmt.Errorf("%w for record %s: %s", ErrPolicyNotSatisfied, recordCID, strings.Join(unsatisfied, ", "))
+	}
+
+	return nil
+}
+
+// hasSigned reports whether one of the signatures was created by the signer.
+func hasSigned(signer config.Signer, signatures []string, payload []byte) bool {
+	for _, signature := range signatures {
+		if err := cosign.VerifySignature([]byte(signer.PublicKey), signature, payload); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validSignatures returns the signatures attached to the record that have not been revoked.
+func validSignatures(ctx context.Context, refStore types.ReferrerStoreAPI, recordCID string) ([]string, error) {
+	revoked := make(map[string]bool)
+
+	err := refStore.WalkReferrers(ctx, recordCID, corev1.RevocationReferrerType, func(referrer *corev1.RecordReferrer) error {
+		revocation := &signv1.Revocation{}
+		if err := revocation.UnmarshalReferrer(referrer); err != nil {
+			logger.Warn("Failed to decode revocation referrer", "recordCID", recordCID, "error", err)
+
+			return nil
+		}
+
+		revoked[revocation.GetSignature()] = true
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk revocation referrers: %w", err)
+	}
+
+	var signatures []string
+
+	err = refStore.WalkReferrers(ctx, recordCID, corev1.SignatureReferrerType, func(referrer *corev1.RecordReferrer) error {
+		signature := &signv1.Signature{}
+		if err := signature.UnmarshalReferrer(referrer); err != nil {
+			logger.Warn("Failed to decode signature referrer", "recordCID", recordCID, "error", err)
+
+			return nil
+		}
+
+		if !revoked[signature.GetSignature()] {
+			signatures = append(signatures, signature.GetSignature())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk signature referrers: %w", err)
+	}
+
+	return signatures, nil
+}
@@ -0,0 +1,184 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package signpolicy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/agntcy/dir/server/publication/config"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCID = "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+
+// referrerStore is an in-memory store keeping the referrers of a single record.
+type referrerStore struct {
+	types.StoreAPI
+
+	referrers []*corev1.RecordReferrer
+}
+
+func (s *referrerStore) PushReferrer(_ context.Context, _ string, referrer *corev1.RecordReferrer) error {
+	s.referrers = append(s.referrers, referrer)
+
+	return nil
+}
+
+func (s *referrerStore) WalkReferrers(_ context.Context, _ string, referrerType string, walkFn func(*corev1.RecordReferrer) error) error {
+	for _, referrer := range s.referrers {
+		if referrerType != "" && referrer.GetType() != referrerType {
+			continue
+		}
+
+		if err := walkFn(referrer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type testSigner struct {
+	config.Signer
+
+	key *ecdsa.PrivateKey
+}
+
+func newTestSigner(t *testing.T, name string) *testSigner {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	return &testSigner{
+		Signer: config.Signer{
+			Name:      name,
+			PublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+		},
+		key: key,
+	}
+}
+
+// sign attaches a signature of the test record created by the signer to the store.
+func (s *testSigner) sign(t *testing.T, store *referrerStore) string {
+	t.Helper()
+
+	payload, err := signv1.CIDPayload(testCID)
+	require.NoError(t, err)
+
+	hash := sha256.Sum256(payload)
+
+	signatureBytes, err := ecdsa.SignASN1(rand.Reader, s.key, hash[:])
+	require.NoError(t, err)
+
+	signature := base64.StdEncoding.EncodeToString(signatureBytes)
+
+	referrer, err := (&signv1.Signature{Signature: signature}).MarshalReferrer()
+	require.NoError(t, err)
+
+	require.NoError(t, store.PushReferrer(context.Background(), testCID, referrer))
+
+	return signature
+}
+
+func TestEvaluator(t *testing.T) {
+	ctx := context.Background()
+
+	alice := newTestSigner(t, "alice")
+	bob := newTestSigner(t, "bob")
+	carol := newTestSigner(t, "carol")
+
+	cfg := config.Config{
+		SignaturePolicies: []config.SignaturePolicy{
+			{
+				Name:      "two-of-three",
+				Threshold: 2,
+				Signers:   []config.Signer{alice.Signer, bob.Signer, carol.Signer},
+			},
+		},
+	}
+
+	t.Run("not enough signers", func(t *testing.T) {
+		store := &referrerStore{}
+		alice.sign(t, store)
+
+		evaluator, err := New(store, cfg)
+		require.NoError(t, err)
+
+		results, err := evaluator.Evaluate(ctx, testCID)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, []string{"alice"}, results[0].GetSigners())
+		assert.False(t, results[0].GetSatisfied())
+
+		require.ErrorIs(t, evaluator.Check(ctx, testCID), ErrPolicyNotSatisfied)
+	})
+
+	t.Run("threshold reached", func(t *testing.T) {
+		store := &referrerStore{}
+		alice.sign(t, store)
+		carol.sign(t, store)
+
+		evaluator, err := New(store, cfg)
+		require.NoError(t, err)
+
+		results, err := evaluator.Evaluate(ctx, testCID)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, []string{"alice", "carol"}, results[0].GetSigners())
+		assert.True(t, results[0].GetSatisfied())
+
+		require.NoError(t, evaluator.Check(ctx, testCID))
+	})
+
+	t.Run("revoked signatures are ignored", func(t *testing.T) {
+		store := &referrerStore{}
+		alice.sign(t, store)
+		signature := bob.sign(t, store)
+
+		referrer, err := (&signv1.Revocation{Signature: signature}).MarshalReferrer()
+		require.NoError(t, err)
+		require.NoError(t, store.PushReferrer(ctx, testCID, referrer))
+
+		evaluator, err := New(store, cfg)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, evaluator.Check(ctx, testCID), ErrPolicyNotSatisfied)
+	})
+
+	t.Run("no policies", func(t *testing.T) {
+		evaluator, err := New(&referrerStore{}, config.Config{})
+		require.NoError(t, err)
+		assert.False(t, evaluator.Enabled())
+
+		results, err := evaluator.Evaluate(ctx, testCID)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+		require.NoError(t, evaluator.Check(ctx, testCID))
+	})
+
+	t.Run("invalid threshold", func(t *testing.T) {
+		_, err := New(&referrerStore{}, config.Config{
+			SignaturePolicies: []config.SignaturePolicy{
+				{Name: "invalid", Threshold: 2, Signers: []config.Signer{alice.Signer}},
+			},
+		})
+		require.Error(t, err)
+	})
+}
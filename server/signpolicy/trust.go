@@ -0,0 +1,40 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package signpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/agntcy/dir/server/publication/config"
+	"github.com/agntcy/dir/server/types"
+)
+
+// ErrUntrusted is returned when a record is not signed by any of the trusted keys.
+var ErrUntrusted = errors.New("record not signed by a trusted key")
+
+// CheckTrusted returns ErrUntrusted if none of the signatures attached to the record
+// verifies against one of the trusted PEM-encoded public keys.
+// Revoked signatures are not taken into account.
+func CheckTrusted(ctx context.Context, refStore types.ReferrerStoreAPI, recordCID string, trustedKeys []string) error {
+	signatures, err := validSignatures(ctx, refStore, recordCID)
+	if err != nil {
+		return err
+	}
+
+	payload, err := signv1.CIDPayload(recordCID)
+	if err != nil {
+		return fmt.Errorf("failed to generate payload: %w", err)
+	}
+
+	for _, key := range trustedKeys {
+		if hasSigned(config.Signer{PublicKey: key}, signatures, payload) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: none of %d signatures verified against %d trusted keys", ErrUntrusted, len(signatures), len(trustedKeys))
+}
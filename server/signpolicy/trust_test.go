@@ -0,0 +1,48 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package signpolicy
+
+import (
+	"context"
+	"testing"
+
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTrusted(t *testing.T) {
+	ctx := context.Background()
+
+	alice := newTestSigner(t, "alice")
+	bob := newTestSigner(t, "bob")
+
+	t.Run("signed by a trusted key", func(t *testing.T) {
+		store := &referrerStore{}
+		alice.sign(t, store)
+
+		require.NoError(t, CheckTrusted(ctx, store, testCID, []string{bob.PublicKey, alice.PublicKey}))
+	})
+
+	t.Run("signed by an untrusted key", func(t *testing.T) {
+		store := &referrerStore{}
+		bob.sign(t, store)
+
+		require.ErrorIs(t, CheckTrusted(ctx, store, testCID, []string{alice.PublicKey}), ErrUntrusted)
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		require.ErrorIs(t, CheckTrusted(ctx, &referrerStore{}, testCID, []string{alice.PublicKey}), ErrUntrusted)
+	})
+
+	t.Run("revoked signatures are ignored", func(t *testing.T) {
+		store := &referrerStore{}
+		signature := alice.sign(t, store)
+
+		referrer, err := (&signv1.Revocation{Signature: signature}).MarshalReferrer()
+		require.NoError(t, err)
+		require.NoError(t, store.PushReferrer(ctx, testCID, referrer))
+
+		require.ErrorIs(t, CheckTrusted(ctx, store, testCID, []string{alice.PublicKey}), ErrUntrusted)
+	})
+}
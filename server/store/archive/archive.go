@@ -0,0 +1,507 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package archive provides the archive storage class for StoreAPI.
+// Records that are not pushed or pulled for a configured duration are moved
+// together with their metadata and referrers from the source store to a
+// compressed cold datastore. Record metadata stays searchable, as the search
+// database is not affected. Archived records are transparently restored to the
+// source store when pulled.
+//
+//nolint:wrapcheck
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/utils/logging"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var logger = logging.Logger("store/archive")
+
+const (
+	// accessPrefix is the datastore key prefix holding the last access time of records.
+	accessPrefix = "/access/"
+
+	// archivePrefix is the datastore key prefix holding archived records.
+	archivePrefix = "/archive/"
+)
+
+var (
+	// zstdEncoder and zstdDecoder are safe for concurrent use with EncodeAll and DecodeAll.
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// archivedStore wraps a StoreAPI with the archive storage class.
+type archivedStore struct {
+	source types.StoreAPI
+	cold   types.Datastore
+	after  time.Duration
+
+	// mu serializes archiving and rehydration of records
+	mu sync.Mutex
+}
+
+// archivedRecord is the archived form of a record.
+type archivedRecord struct {
+	Record    []byte   `json:"record"`
+	Meta      []byte   `json:"meta"`
+	Referrers [][]byte `json:"referrers,omitempty"`
+}
+
+// Wrap creates a store that archives records not accessed for the given duration
+// into the cold datastore. Records are checked for archiving every scanInterval
+// until the context is canceled.
+//
+// Only records pushed or pulled through the returned store are tracked for archiving.
+func Wrap(ctx context.Context, source types.StoreAPI, cold types.Datastore, after, scanInterval time.Duration) types.StoreAPI {
+	s := &archivedStore{
+		source: source,
+		cold:   cold,
+		after:  after,
+	}
+
+	if scanInterval > 0 {
+		go s.run(ctx, scanInterval)
+	}
+
+	return s
+}
+
+// Push pushes a record to the source store and tracks its access time.
+// Pushing an archived record restores it together with its referrers.
+func (s *archivedStore) Push(ctx context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
+	cid := record.GetCid()
+
+	if _, err := s.rehydrate(ctx, cid); err == nil {
+		return &corev1.RecordRef{Cid: cid}, nil
+	} else if !errors.Is(err, datastore.ErrNotFound) {
+		return nil, err
+	}
+
+	ref, err := s.source.Push(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	s.touch(ctx, ref.GetCid())
+
+	return ref, nil
+}
+
+// Pull pulls a record from the source store, restoring it from the archive if needed.
+// Restored records are listed in the response trailer under storev1.RehydratedMetadataKey.
+func (s *archivedStore) Pull(ctx context.Context, ref *corev1.RecordRef) (*corev1.Record, error) {
+	cid := ref.GetCid()
+
+	record, err := s.source.Pull(ctx, ref)
+	if err == nil {
+		s.touch(ctx, cid)
+
+		return record, nil
+	}
+
+	record, rehydrateErr := s.rehydrate(ctx, cid)
+	if errors.Is(rehydrateErr, datastore.ErrNotFound) {
+		// Not archived, return the source error
+		return nil, err
+	}
+
+	if rehydrateErr != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore archived record %s: %v", cid, rehydrateErr)
+	}
+
+	// Surface the rehydration to gRPC clients, if called within a request
+	if err := grpc.SetTrailer(ctx, metadata.Pairs(storev1.RehydratedMetadataKey, cid)); err != nil {
+		logger.Debug("Failed to set rehydration trailer", "cid", cid, "error", err)
+	}
+
+	return record, nil
+}
+
+// Lookup looks up record metadata from the source store, or from the archive if archived.
+func (s *archivedStore) Lookup(ctx context.Context, ref *corev1.RecordRef) (*corev1.RecordMeta, error) {
+	meta, err := s.source.Lookup(ctx, ref)
+	if err == nil {
+		return meta, nil
+	}
+
+	entry, archiveErr := s.getArchived(ctx, ref.GetCid())
+	if archiveErr != nil {
+		return nil, err
+	}
+
+	var archivedMeta corev1.RecordMeta
+	if err := json.Unmarshal(entry.Meta, &archivedMeta); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal archived metadata: %v", err)
+	}
+
+	return &archivedMeta, nil
+}
+
+// Delete removes a record from the archive and the source store.
+func (s *archivedStore) Delete(ctx context.Context, ref *corev1.RecordRef) error {
+	cid := ref.GetCid()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	archived, err := s.cold.Has(ctx, datastore.NewKey(archivePrefix+cid))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check archive: %v", err)
+	}
+
+	if archived {
+		if err := s.cold.Delete(ctx, datastore.NewKey(archivePrefix+cid)); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete archived record: %v", err)
+		}
+	}
+
+	if err := s.cold.Delete(ctx, datastore.NewKey(accessPrefix+cid)); err != nil {
+		logger.Debug("Failed to delete record access time", "cid", cid, "error", err)
+	}
+
+	if err := s.source.Delete(ctx, ref); err != nil && !archived {
+		return err
+	}
+
+	return nil
+}
+
+// IsReady checks if the store is ready to serve traffic.
+func (s *archivedStore) IsReady(ctx context.Context) bool {
+	return s.source.IsReady(ctx)
+}
+
+// VerifyWithZot restores archived records before delegating to the source store.
+func (s *archivedStore) VerifyWithZot(ctx context.Context, recordCID string) (bool, error) {
+	zotStore, ok := s.source.(types.VerifierStore)
+	if !ok {
+		return false, nil
+	}
+
+	if _, err := s.rehydrate(ctx, recordCID); err != nil && !errors.Is(err, datastore.ErrNotFound) {
+		return false, err
+	}
+
+	return zotStore.VerifyWithZot(ctx, recordCID)
+}
+
+// PushReferrer restores archived records before delegating to the source store.
+func (s *archivedStore) PushReferrer(ctx context.Context, recordCID string, referrer *corev1.RecordReferrer) error {
+	referrerStore, ok := s.source.(types.ReferrerStoreAPI)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "source store does not support referrer operations")
+	}
+
+	if _, err := s.rehydrate(ctx, recordCID); err != nil && !errors.Is(err, datastore.ErrNotFound) {
+		return err
+	}
+
+	return referrerStore.PushReferrer(ctx, recordCID, referrer)
+}
+
+// WalkReferrers walks the referrers of archived records from the archive,
+// and delegates to the source store otherwise.
+func (s *archivedStore) WalkReferrers(ctx context.Context, recordCID string, referrerType string, walkFn func(*corev1.RecordReferrer) error) error {
+	referrerStore, ok := s.source.(types.ReferrerStoreAPI)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "source store does not support referrer operations")
+	}
+
+	entry, err := s.getArchived(ctx, recordCID)
+	if errors.Is(err, datastore.ErrNotFound) {
+		return referrerStore.WalkReferrers(ctx, recordCID, referrerType, walkFn)
+	}
+
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to read archived record: %v", err)
+	}
+
+	for _, data := range entry.Referrers {
+		var referrer corev1.RecordReferrer
+		if err := proto.Unmarshal(data, &referrer); err != nil {
+			return status.Errorf(codes.Internal, "failed to unmarshal archived referrer: %v", err)
+		}
+
+		if referrerType != "" && referrer.GetType() != referrerType {
+			continue
+		}
+
+		if err := walkFn(&referrer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// List lists the records of the source store followed by the archived records,
+// as archived records are removed from the source store.
+func (s *archivedStore) List(ctx context.Context, listFn func(*corev1.RecordRef) error) error {
+	listerStore, ok := s.source.(types.ListerStore)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "source store does not support listing records")
+	}
+
+	if err := listerStore.List(ctx, listFn); err != nil {
+		return err
+	}
+
+	results, err := s.cold.Query(ctx, query.Query{Prefix: archivePrefix, KeysOnly: true})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to query archived records: %v", err)
+	}
+	defer results.Close()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "failed to read archived record: %v", result.Error)
+		}
+
+		if err := listFn(&corev1.RecordRef{Cid: strings.TrimPrefix(result.Key, archivePrefix)}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GarbageCollect delegates to the source store if it supports garbage collection.
+func (s *archivedStore) GarbageCollect(ctx context.Context) (*types.GarbageCollectResult, error) {
+	gcStore, ok := s.source.(types.GarbageCollectorStore)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "source store does not support garbage collection")
+	}
+
+	return gcStore.GarbageCollect(ctx)
+}
+
+// run periodically archives records that were not accessed recently.
+func (s *archivedStore) run(ctx context.Context, scanInterval time.Duration) {
+	ticker := time.NewTicker(scanInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.archiveStale(ctx); err != nil {
+				logger.Warn("Failed to archive stale records", "error", err)
+			}
+		}
+	}
+}
+
+// archiveStale archives all records whose last access is older than the configured duration.
+func (s *archivedStore) archiveStale(ctx context.Context) error {
+	results, err := s.cold.Query(ctx, query.Query{Prefix: accessPrefix})
+	if err != nil {
+		return fmt.Errorf("failed to query record access times: %w", err)
+	}
+	defer results.Close()
+
+	var stale []string
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			return fmt.Errorf("failed to read record access time: %w", result.Error)
+		}
+
+		accessedAt, err := parseAccessTime(result.Value)
+		if err != nil {
+			logger.Debug("Skipping invalid record access time", "key", result.Key, "error", err)
+
+			continue
+		}
+
+		if time.Since(accessedAt) >= s.after {
+			stale = append(stale, strings.TrimPrefix(result.Key, accessPrefix))
+		}
+	}
+
+	for _, cid := range stale {
+		if err := s.archive(ctx, cid); err != nil {
+			logger.Warn("Failed to archive record", "cid", cid, "error", err)
+		}
+	}
+
+	return nil
+}
+
+// archive moves a record with its metadata and referrers from the source store to the archive.
+func (s *archivedStore) archive(ctx context.Context, cid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	archiveKey := datastore.NewKey(archivePrefix + cid)
+
+	if archived, err := s.cold.Has(ctx, archiveKey); err != nil || archived {
+		return err
+	}
+
+	ref := &corev1.RecordRef{Cid: cid}
+
+	record, err := s.source.Pull(ctx, ref)
+	if err != nil {
+		return fmt.Errorf("failed to pull record: %w", err)
+	}
+
+	meta, err := s.source.Lookup(ctx, ref)
+	if err != nil {
+		return fmt.Errorf("failed to lookup record: %w", err)
+	}
+
+	var entry archivedRecord
+
+	if entry.Record, err = proto.Marshal(record); err != nil {
+		return fmt.Errorf("failed to marshal record: %w", err)
+	}
+
+	if entry.Meta, err = json.Marshal(meta); err != nil {
+		return fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	if referrerStore, ok := s.source.(types.ReferrerStoreAPI); ok {
+		err := referrerStore.WalkReferrers(ctx, cid, "", func(referrer *corev1.RecordReferrer) error {
+			data, err := proto.Marshal(referrer)
+			if err != nil {
+				return fmt.Errorf("failed to marshal referrer: %w", err)
+			}
+
+			entry.Referrers = append(entry.Referrers, data)
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to walk referrers: %w", err)
+		}
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal archived record: %w", err)
+	}
+
+	if err := s.cold.Put(ctx, archiveKey, zstdEncoder.EncodeAll(data, nil)); err != nil {
+		return fmt.Errorf("failed to store archived record: %w", err)
+	}
+
+	// Remove the record from the source store only once it is safely archived
+	if err := s.source.Delete(ctx, ref); err != nil {
+		if err := s.cold.Delete(ctx, archiveKey); err != nil {
+			logger.Warn("Failed to roll back archived record", "cid", cid, "error", err)
+		}
+
+		return fmt.Errorf("failed to delete record from source store: %w", err)
+	}
+
+	logger.Info("Archived record", "cid", cid, "size", len(data), "referrers", len(entry.Referrers))
+
+	return nil
+}
+
+// rehydrate restores an archived record with its referrers to the source store.
+// Returns datastore.ErrNotFound if the record is not archived.
+func (s *archivedStore) rehydrate(ctx context.Context, cid string) (*corev1.Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, err := s.getArchived(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	startedAt := time.Now()
+
+	var record corev1.Record
+	if err := proto.Unmarshal(entry.Record, &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal archived record: %w", err)
+	}
+
+	if _, err := s.source.Push(ctx, &record); err != nil {
+		return nil, fmt.Errorf("failed to restore record: %w", err)
+	}
+
+	if referrerStore, ok := s.source.(types.ReferrerStoreAPI); ok {
+		for _, data := range entry.Referrers {
+			var referrer corev1.RecordReferrer
+			if err := proto.Unmarshal(data, &referrer); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal archived referrer: %w", err)
+			}
+
+			if err := referrerStore.PushReferrer(ctx, cid, &referrer); err != nil {
+				return nil, fmt.Errorf("failed to restore referrer: %w", err)
+			}
+		}
+	}
+
+	if err := s.cold.Delete(ctx, datastore.NewKey(archivePrefix+cid)); err != nil {
+		logger.Warn("Failed to remove restored record from archive", "cid", cid, "error", err)
+	}
+
+	s.touch(ctx, cid)
+
+	logger.Warn("Restored archived record, pull latency increased", "cid", cid, "duration", time.Since(startedAt))
+
+	return &record, nil
+}
+
+// getArchived reads an archived record.
+// Returns datastore.ErrNotFound if the record is not archived.
+func (s *archivedStore) getArchived(ctx context.Context, cid string) (*archivedRecord, error) {
+	compressed, err := s.cold.Get(ctx, datastore.NewKey(archivePrefix+cid))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := zstdDecoder.DecodeAll(compressed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress archived record: %w", err)
+	}
+
+	var entry archivedRecord
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal archived record: %w", err)
+	}
+
+	return &entry, nil
+}
+
+// touch records the current time as the last access time of a record.
+func (s *archivedStore) touch(ctx context.Context, cid string) {
+	value := []byte(strconv.FormatInt(time.Now().UnixNano(), 10))
+
+	if err := s.cold.Put(ctx, datastore.NewKey(accessPrefix+cid), value); err != nil {
+		logger.Debug("Failed to update record access time", "cid", cid, "error", err)
+	}
+}
+
+func parseAccessTime(value []byte) (time.Time, error) {
+	nanos, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid access time: %w", err)
+	}
+
+	return time.Unix(0, nanos), nil
+}
@@ -0,0 +1,267 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package archive
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	typesv1alpha0 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/agntcy/oasf/types/v1alpha0"
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// memoryStore is an in-memory store with referrer support for testing.
+type memoryStore struct {
+	mu        sync.Mutex
+	records   map[string]*corev1.Record
+	referrers map[string][]*corev1.RecordReferrer
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		records:   make(map[string]*corev1.Record),
+		referrers: make(map[string][]*corev1.RecordReferrer),
+	}
+}
+
+func (m *memoryStore) Push(_ context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.records[record.GetCid()] = record
+
+	return &corev1.RecordRef{Cid: record.GetCid()}, nil
+}
+
+func (m *memoryStore) Pull(_ context.Context, ref *corev1.RecordRef) (*corev1.Record, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	record, ok := m.records[ref.GetCid()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "record not found")
+	}
+
+	return record, nil
+}
+
+func (m *memoryStore) Lookup(_ context.Context, ref *corev1.RecordRef) (*corev1.RecordMeta, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.records[ref.GetCid()]; !ok {
+		return nil, status.Error(codes.NotFound, "record not found")
+	}
+
+	return &corev1.RecordMeta{Cid: ref.GetCid(), SchemaVersion: "v0.3.1"}, nil
+}
+
+func (m *memoryStore) Delete(_ context.Context, ref *corev1.RecordRef) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.records[ref.GetCid()]; !ok {
+		return status.Error(codes.NotFound, "record not found")
+	}
+
+	delete(m.records, ref.GetCid())
+	delete(m.referrers, ref.GetCid())
+
+	return nil
+}
+
+func (m *memoryStore) IsReady(_ context.Context) bool {
+	return true
+}
+
+func (m *memoryStore) PushReferrer(_ context.Context, recordCID string, referrer *corev1.RecordReferrer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.records[recordCID]; !ok {
+		return status.Error(codes.NotFound, "record not found")
+	}
+
+	m.referrers[recordCID] = append(m.referrers[recordCID], referrer)
+
+	return nil
+}
+
+func (m *memoryStore) WalkReferrers(_ context.Context, recordCID string, referrerType string, walkFn func(*corev1.RecordReferrer) error) error {
+	m.mu.Lock()
+	referrers := m.referrers[recordCID]
+	m.mu.Unlock()
+
+	for _, referrer := range referrers {
+		if referrerType != "" && referrer.GetType() != referrerType {
+			continue
+		}
+
+		if err := walkFn(referrer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryStore) List(_ context.Context, listFn func(*corev1.RecordRef) error) error {
+	m.mu.Lock()
+	cids := slices.Sorted(maps.Keys(m.records))
+	m.mu.Unlock()
+
+	for _, cid := range cids {
+		if err := listFn(&corev1.RecordRef{Cid: cid}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryStore) has(cid string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.records[cid]
+
+	return ok
+}
+
+func walkTypes(t *testing.T, store types.StoreAPI, cid string) []string {
+	t.Helper()
+
+	referrerStore, ok := store.(types.ReferrerStoreAPI)
+	require.True(t, ok)
+
+	var referrerTypes []string
+
+	err := referrerStore.WalkReferrers(t.Context(), cid, "", func(referrer *corev1.RecordReferrer) error {
+		referrerTypes = append(referrerTypes, referrer.GetType())
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	return referrerTypes
+}
+
+func TestArchiveAndRehydrate(t *testing.T) {
+	ctx := t.Context()
+	source := newMemoryStore()
+	cold := dssync.MutexWrap(datastore.NewMapDatastore())
+
+	store, ok := Wrap(ctx, source, cold, time.Hour, 0).(*archivedStore)
+	require.True(t, ok)
+
+	record := corev1.New(&typesv1alpha0.Record{
+		Name:          "archived-agent",
+		SchemaVersion: "v0.3.1",
+	})
+	cid := record.GetCid()
+
+	ref, err := store.Push(ctx, record)
+	require.NoError(t, err)
+	require.NoError(t, store.PushReferrer(ctx, cid, &corev1.RecordReferrer{Type: "test-type"}))
+
+	// Recently accessed records are not archived
+	require.NoError(t, store.archiveStale(ctx))
+	assert.True(t, source.has(cid))
+
+	// Stale records are archived
+	store.after = 0
+	require.NoError(t, store.archiveStale(ctx))
+	assert.False(t, source.has(cid))
+
+	// Metadata and referrers are served from the archive
+	meta, err := store.Lookup(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, cid, meta.GetCid())
+	assert.Equal(t, []string{"test-type"}, walkTypes(t, store, cid))
+
+	// Pull restores the record with its referrers
+	pulled, err := store.Pull(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, cid, pulled.GetCid())
+	assert.True(t, source.has(cid))
+	assert.Equal(t, []string{"test-type"}, walkTypes(t, source, cid))
+
+	archived, err := cold.Has(ctx, datastore.NewKey(archivePrefix+cid))
+	require.NoError(t, err)
+	assert.False(t, archived)
+}
+
+func TestDeleteArchived(t *testing.T) {
+	ctx := t.Context()
+	source := newMemoryStore()
+	cold := dssync.MutexWrap(datastore.NewMapDatastore())
+
+	store, ok := Wrap(ctx, source, cold, 0, 0).(*archivedStore)
+	require.True(t, ok)
+
+	record := corev1.New(&typesv1alpha0.Record{
+		Name:          "deleted-agent",
+		SchemaVersion: "v0.3.1",
+	})
+
+	ref, err := store.Push(ctx, record)
+	require.NoError(t, err)
+	require.NoError(t, store.archiveStale(ctx))
+
+	require.NoError(t, store.Delete(ctx, ref))
+
+	_, err = store.Pull(ctx, ref)
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = store.Lookup(ctx, ref)
+	require.Error(t, err)
+}
+
+func TestListArchived(t *testing.T) {
+	ctx := t.Context()
+	source := newMemoryStore()
+	cold := dssync.MutexWrap(datastore.NewMapDatastore())
+
+	store, ok := Wrap(ctx, source, cold, 0, 0).(*archivedStore)
+	require.True(t, ok)
+
+	archivedRef, err := store.Push(ctx, corev1.New(&typesv1alpha0.Record{
+		Name:          "archived-agent",
+		SchemaVersion: "v0.3.1",
+	}))
+	require.NoError(t, err)
+	require.NoError(t, store.archiveStale(ctx))
+
+	store.after = time.Hour
+
+	storedRef, err := store.Push(ctx, corev1.New(&typesv1alpha0.Record{
+		Name:          "stored-agent",
+		SchemaVersion: "v0.3.1",
+	}))
+	require.NoError(t, err)
+
+	var cids []string
+
+	err = store.List(ctx, func(ref *corev1.RecordRef) error {
+		cids = append(cids, ref.GetCid())
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Archived records are listed although they are no longer in the source store
+	assert.ElementsMatch(t, []string{archivedRef.GetCid(), storedRef.GetCid()}, cids)
+}
@@ -0,0 +1,32 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+const (
+	DefaultEnabled      = false
+	DefaultAfter        = 30 * 24 * time.Hour
+	DefaultScanInterval = time.Hour
+)
+
+// Config is the configuration for the archive storage class.
+// Records that are not pulled for a while are moved from the storage provider
+// to a compressed cold directory, while their metadata remains searchable.
+// Archived records are restored to the storage provider when pulled.
+type Config struct {
+	// Enabled turns on the archive storage class.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// Dir is the path to a local directory that will hold archived records.
+	// If empty, an in-memory archive is used.
+	Dir string `json:"dir,omitempty" mapstructure:"dir"`
+
+	// After is the duration since the last push or pull of a record
+	// after which it is moved to the archive.
+	After time.Duration `json:"after,omitempty" mapstructure:"after"`
+
+	// ScanInterval is the interval at which records are checked for archiving.
+	ScanInterval time.Duration `json:"scan_interval,omitempty" mapstructure:"scan_interval"`
+}
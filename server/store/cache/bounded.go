@@ -0,0 +1,175 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:wrapcheck
+package cache
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/agntcy/dir/server/types"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+)
+
+// boundedDatastore wraps a datastore and evicts the least recently used
+// entries once the total size of stored values exceeds the limit.
+//
+// Only Get, Put and Delete are tracked. Entries written through batches or
+// other datastore methods bypass the size accounting until the next restart.
+type boundedDatastore struct {
+	types.Datastore
+
+	maxSize int64
+
+	mu      sync.Mutex
+	size    int64
+	entries *list.List // front is the most recently used entry
+	index   map[datastore.Key]*list.Element
+}
+
+type boundedEntry struct {
+	key  datastore.Key
+	size int64
+}
+
+// NewBounded wraps the datastore so that its total size stays within maxSize bytes.
+// Entries already present in the datastore are accounted for on creation.
+// If maxSize is not positive, the datastore is returned unchanged.
+func NewBounded(ctx context.Context, ds types.Datastore, maxSize int64) (types.Datastore, error) {
+	if maxSize <= 0 {
+		return ds, nil
+	}
+
+	b := &boundedDatastore{
+		Datastore: ds,
+		maxSize:   maxSize,
+		entries:   list.New(),
+		index:     make(map[datastore.Key]*list.Element),
+	}
+
+	// Account for entries persisted by previous runs
+	results, err := ds.Query(ctx, query.Query{KeysOnly: true, ReturnsSizes: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query cache entries: %w", err)
+	}
+	defer results.Close()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, fmt.Errorf("failed to read cache entry: %w", result.Error)
+		}
+
+		b.track(datastore.NewKey(result.Key), int64(result.Size))
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.evict(ctx)
+
+	return b, nil
+}
+
+// Get returns the value and marks the entry as recently used.
+func (b *boundedDatastore) Get(ctx context.Context, key datastore.Key) ([]byte, error) {
+	value, err := b.Datastore.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if elem, ok := b.index[key]; ok {
+		b.entries.MoveToFront(elem)
+	}
+
+	return value, nil
+}
+
+// Put stores the value and evicts least recently used entries if needed.
+func (b *boundedDatastore) Put(ctx context.Context, key datastore.Key, value []byte) error {
+	if err := b.Datastore.Put(ctx, key, value); err != nil {
+		return err
+	}
+
+	b.track(key, int64(len(value)))
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.evict(ctx)
+
+	return nil
+}
+
+// Delete removes the value and stops tracking the entry.
+func (b *boundedDatastore) Delete(ctx context.Context, key datastore.Key) error {
+	if err := b.Datastore.Delete(ctx, key); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.untrack(key)
+
+	return nil
+}
+
+// Size returns the total size of tracked entries in bytes.
+func (b *boundedDatastore) Size() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.size
+}
+
+// track records an entry as the most recently used one.
+func (b *boundedDatastore) track(key datastore.Key, size int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if elem, ok := b.index[key]; ok {
+		entry := elem.Value.(*boundedEntry) //nolint:forcetypeassert
+		b.size += size - entry.size
+		entry.size = size
+		b.entries.MoveToFront(elem)
+
+		return
+	}
+
+	b.index[key] = b.entries.PushFront(&boundedEntry{key: key, size: size})
+	b.size += size
+}
+
+// untrack removes an entry from accounting. Must be called with the lock held.
+func (b *boundedDatastore) untrack(key datastore.Key) {
+	elem, ok := b.index[key]
+	if !ok {
+		return
+	}
+
+	b.size -= elem.Value.(*boundedEntry).size //nolint:forcetypeassert
+	b.entries.Remove(elem)
+	delete(b.index, key)
+}
+
+// evict removes least recently used entries until the size limit is met.
+// Must be called with the lock held.
+func (b *boundedDatastore) evict(ctx context.Context) {
+	for b.size > b.maxSize && b.entries.Len() > 0 {
+		entry := b.entries.Back().Value.(*boundedEntry) //nolint:forcetypeassert
+
+		if err := b.Datastore.Delete(ctx, entry.key); err != nil && !errors.Is(err, datastore.ErrNotFound) {
+			logger.Debug("Failed to evict cache entry", "key", entry.key, "error", err)
+		}
+
+		b.untrack(entry.key)
+	}
+}
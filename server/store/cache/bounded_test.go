@@ -0,0 +1,83 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoundedDatastore(t *testing.T) {
+	ctx := context.Background()
+	value := make([]byte, 10)
+
+	keyA := datastore.NewKey("/record/a")
+	keyB := datastore.NewKey("/record/b")
+	keyC := datastore.NewKey("/record/c")
+
+	t.Run("evicts least recently used entries", func(t *testing.T) {
+		ds, err := NewBounded(ctx, sync.MutexWrap(datastore.NewMapDatastore()), 25)
+		require.NoError(t, err)
+
+		require.NoError(t, ds.Put(ctx, keyA, value))
+		require.NoError(t, ds.Put(ctx, keyB, value))
+
+		// Touch a so that b becomes the least recently used entry
+		_, err = ds.Get(ctx, keyA)
+		require.NoError(t, err)
+
+		require.NoError(t, ds.Put(ctx, keyC, value))
+
+		_, err = ds.Get(ctx, keyB)
+		require.ErrorIs(t, err, datastore.ErrNotFound)
+
+		_, err = ds.Get(ctx, keyA)
+		require.NoError(t, err)
+
+		_, err = ds.Get(ctx, keyC)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(20), ds.(*boundedDatastore).Size()) //nolint:forcetypeassert
+	})
+
+	t.Run("delete releases space", func(t *testing.T) {
+		ds, err := NewBounded(ctx, sync.MutexWrap(datastore.NewMapDatastore()), 25)
+		require.NoError(t, err)
+
+		require.NoError(t, ds.Put(ctx, keyA, value))
+		require.NoError(t, ds.Put(ctx, keyB, value))
+		require.NoError(t, ds.Delete(ctx, keyA))
+		require.NoError(t, ds.Put(ctx, keyC, value))
+
+		_, err = ds.Get(ctx, keyB)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(20), ds.(*boundedDatastore).Size()) //nolint:forcetypeassert
+	})
+
+	t.Run("accounts for existing entries", func(t *testing.T) {
+		source := sync.MutexWrap(datastore.NewMapDatastore())
+		require.NoError(t, source.Put(ctx, keyA, value))
+		require.NoError(t, source.Put(ctx, keyB, value))
+		require.NoError(t, source.Put(ctx, keyC, value))
+
+		ds, err := NewBounded(ctx, source, 25)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(20), ds.(*boundedDatastore).Size()) //nolint:forcetypeassert
+	})
+
+	t.Run("no limit", func(t *testing.T) {
+		source := sync.MutexWrap(datastore.NewMapDatastore())
+
+		ds, err := NewBounded(ctx, source, 0)
+		require.NoError(t, err)
+		assert.Equal(t, source, ds)
+	})
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/agntcy/dir/server/types"
 	"github.com/agntcy/dir/utils/logging"
 	"github.com/ipfs/go-datastore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -124,6 +126,57 @@ func (s *cachedStore) IsReady(ctx context.Context) bool {
 	return s.source.IsReady(ctx)
 }
 
+// VerifyWithZot delegates to the source store if it supports Zot verification.
+func (s *cachedStore) VerifyWithZot(ctx context.Context, recordCID string) (bool, error) {
+	zotStore, ok := s.source.(types.VerifierStore)
+	if !ok {
+		return false, nil
+	}
+
+	return zotStore.VerifyWithZot(ctx, recordCID)
+}
+
+// PushReferrer delegates to the source store if it supports referrer operations.
+// Referrers are not cached as they can be attached to a record at any time.
+func (s *cachedStore) PushReferrer(ctx context.Context, recordCID string, referrer *corev1.RecordReferrer) error {
+	referrerStore, ok := s.source.(types.ReferrerStoreAPI)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "source store does not support referrer operations")
+	}
+
+	return referrerStore.PushReferrer(ctx, recordCID, referrer)
+}
+
+// WalkReferrers delegates to the source store if it supports referrer operations.
+func (s *cachedStore) WalkReferrers(ctx context.Context, recordCID string, referrerType string, walkFn func(*corev1.RecordReferrer) error) error {
+	referrerStore, ok := s.source.(types.ReferrerStoreAPI)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "source store does not support referrer operations")
+	}
+
+	return referrerStore.WalkReferrers(ctx, recordCID, referrerType, walkFn)
+}
+
+// List delegates to the source store if it supports listing records.
+func (s *cachedStore) List(ctx context.Context, listFn func(*corev1.RecordRef) error) error {
+	listerStore, ok := s.source.(types.ListerStore)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "source store does not support listing records")
+	}
+
+	return listerStore.List(ctx, listFn)
+}
+
+// GarbageCollect delegates to the source store if it supports garbage collection.
+func (s *cachedStore) GarbageCollect(ctx context.Context) (*types.GarbageCollectResult, error) {
+	gcStore, ok := s.source.(types.GarbageCollectorStore)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "source store does not support garbage collection")
+	}
+
+	return gcStore.GarbageCollect(ctx)
+}
+
 // cacheRecord stores a record in the cache.
 func (s *cachedStore) cacheRecord(ctx context.Context, record *corev1.Record) error {
 	cid := record.GetCid()
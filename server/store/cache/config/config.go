@@ -0,0 +1,50 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+const (
+	DefaultEnabled = false
+	DefaultMaxSize = 512 * 1024 * 1024 // 512 MiB
+
+	DefaultWarmEnabled  = false
+	DefaultWarmTopN     = 100
+	DefaultWarmInterval = 1 * time.Hour
+)
+
+// Config is the configuration for the local cache tier placed in front of
+// the storage provider. Records and metadata are written through to the
+// provider on push and read through from the provider on cache miss.
+type Config struct {
+	// Enabled turns on the local cache tier.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// Dir is the path to a local directory that will hold cached entries.
+	// If empty, an in-memory cache is used.
+	Dir string `json:"dir,omitempty" mapstructure:"dir"`
+
+	// MaxSize is the maximum size of cached entries in bytes.
+	// Least recently used entries are evicted when the limit is exceeded.
+	// Zero means no limit.
+	MaxSize int64 `json:"max_size,omitempty" mapstructure:"max_size"`
+
+	// Warm configures the warming of the cache with the most pulled records.
+	Warm WarmConfig `json:"warm,omitempty" mapstructure:"warm"`
+}
+
+// WarmConfig configures the periodic warming of the local cache tier with the
+// most pulled records, so that popular records are served locally even after
+// restarts or evictions. Each server warms its own cache, so replicas and edge
+// nodes can warm a different number of records.
+type WarmConfig struct {
+	// Enabled turns on cache warming. Requires the local cache tier to be enabled.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// TopN is the number of most pulled records kept warm.
+	TopN int `json:"top_n,omitempty" mapstructure:"top_n"`
+
+	// Interval at which the cache is warmed. The cache is also warmed on start.
+	Interval time.Duration `json:"interval,omitempty" mapstructure:"interval"`
+}
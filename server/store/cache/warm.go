@@ -0,0 +1,130 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/store/cache/config"
+	"github.com/agntcy/dir/server/types"
+)
+
+// warmingMetadata marks the events of the records pulled to warm the cache.
+var warmingMetadata = map[string]string{"reason": "cache_warming"}
+
+// Warmer periodically pulls the most pulled records through the store, so that
+// they are kept in its local cache tier even after restarts or evictions.
+type Warmer struct {
+	db     types.RecordStatsDatabaseAPI
+	store  types.StoreAPI
+	config config.WarmConfig
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewWarmer creates a cache warmer pulling records through the given store.
+func NewWarmer(db types.RecordStatsDatabaseAPI, store types.StoreAPI, cfg config.WarmConfig) *Warmer {
+	return &Warmer{
+		db:     db,
+		store:  store,
+		config: cfg,
+		stopCh: make(chan struct{}),
+	}
+}
+
+// Start begins the periodic warming of the cache.
+func (w *Warmer) Start(ctx context.Context) error {
+	logger.Info("Starting cache warmer", "top_n", w.config.TopN, "interval", w.config.Interval)
+
+	w.wg.Add(1)
+
+	go func() {
+		defer w.wg.Done()
+
+		w.run(ctx)
+	}()
+
+	return nil
+}
+
+// Stop gracefully shuts down the cache warmer.
+func (w *Warmer) Stop() error {
+	logger.Info("Stopping cache warmer")
+
+	close(w.stopCh)
+	w.wg.Wait()
+
+	logger.Info("Cache warmer stopped")
+
+	return nil
+}
+
+func (w *Warmer) run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	// Warm immediately on start, as the cache may be empty after a restart
+	w.Warm(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.stopCh:
+			return
+		case <-ticker.C:
+			w.Warm(ctx)
+		}
+	}
+}
+
+// Warm pulls the most pulled records and their metadata through the store.
+// Records already cached are marked as recently used, so that they are evicted last.
+// Returns the number of records warmed.
+func (w *Warmer) Warm(ctx context.Context) int {
+	cids, err := w.db.GetMostPulledRecordCIDs(w.config.TopN)
+	if err != nil {
+		logger.Error("Failed to get most pulled records", "error", err)
+
+		return 0
+	}
+
+	ctx = events.WithMetadata(ctx, warmingMetadata)
+	warmed := 0
+
+	for _, cid := range cids {
+		select {
+		case <-ctx.Done():
+			return warmed
+		case <-w.stopCh:
+			return warmed
+		default:
+		}
+
+		ref := &corev1.RecordRef{Cid: cid}
+
+		if _, err := w.store.Pull(ctx, ref); err != nil {
+			logger.Warn("Failed to warm record", "cid", cid, "error", err)
+
+			continue
+		}
+
+		if _, err := w.store.Lookup(ctx, ref); err != nil {
+			logger.Warn("Failed to warm record metadata", "cid", cid, "error", err)
+
+			continue
+		}
+
+		warmed++
+	}
+
+	logger.Info("Warmed cache with most pulled records", "warmed", warmed, "candidates", len(cids))
+
+	return warmed
+}
@@ -0,0 +1,82 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	typesv1alpha0 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/agntcy/oasf/types/v1alpha0"
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/store/cache/config"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockRecordStats serves fixed pull statistics.
+type mockRecordStats struct {
+	mostPulled []string
+	err        error
+}
+
+func (m *mockRecordStats) IncrementRecordPullCount(string) error { return nil }
+
+func (m *mockRecordStats) GetRecordPullCount(string) (uint64, error) { return 0, nil }
+
+func (m *mockRecordStats) GetMostPulledRecordCIDs(limit int) ([]string, error) {
+	return m.mostPulled[:min(limit, len(m.mostPulled))], m.err
+}
+
+func TestWarmer_Warm(t *testing.T) {
+	ctx := t.Context()
+
+	record := corev1.New(&typesv1alpha0.Record{
+		Name:          "popular-agent",
+		Version:       "1.0.0",
+		SchemaVersion: "v0.3.1",
+	})
+	ref := &corev1.RecordRef{Cid: record.GetCid()}
+	meta := &corev1.RecordMeta{Cid: record.GetCid()}
+
+	missingRef := &corev1.RecordRef{Cid: "missing"}
+
+	mockStore := &MockStoreAPI{}
+	mockStore.On("Pull", mock.Anything, ref).Return(record, nil).Once()
+	mockStore.On("Lookup", mock.Anything, ref).Return(meta, nil).Once()
+	mockStore.On("Pull", mock.Anything, missingRef).Return(nil, errors.New("not found")).Once()
+
+	cachedStore := Wrap(mockStore, sync.MutexWrap(datastore.NewMapDatastore()))
+
+	warmer := NewWarmer(
+		&mockRecordStats{mostPulled: []string{ref.GetCid(), missingRef.GetCid(), "not-in-top-n"}},
+		cachedStore,
+		config.WarmConfig{TopN: 2, Interval: time.Hour},
+	)
+
+	// Records failing to be pulled are skipped
+	assert.Equal(t, 1, warmer.Warm(ctx))
+
+	// Warmed records are served from the cache
+	pulled, err := cachedStore.Pull(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, record.GetCid(), pulled.GetCid())
+
+	_, err = cachedStore.Lookup(ctx, ref)
+	require.NoError(t, err)
+
+	mockStore.AssertExpectations(t)
+}
+
+func TestWarmer_Warm_StatsError(t *testing.T) {
+	mockStore := &MockStoreAPI{}
+
+	warmer := NewWarmer(&mockRecordStats{err: errors.New("database unavailable")}, mockStore, config.WarmConfig{TopN: 10})
+
+	assert.Equal(t, 0, warmer.Warm(t.Context()))
+	mockStore.AssertNotCalled(t, "Pull", mock.Anything, mock.Anything)
+}
@@ -4,7 +4,11 @@
 package config
 
 import (
+	archive "github.com/agntcy/dir/server/store/archive/config"
+	cache "github.com/agntcy/dir/server/store/cache/config"
 	oci "github.com/agntcy/dir/server/store/oci/config"
+	probe "github.com/agntcy/dir/server/store/probe/config"
+	s3 "github.com/agntcy/dir/server/store/s3/config"
 )
 
 const (
@@ -12,9 +16,21 @@ const (
 )
 
 type Config struct {
-	// Provider is the type of the storage provider.
+	// Provider is the type of the storage provider, either oci or s3.
 	Provider string `json:"c,omitempty" mapstructure:"provider"`
 
 	// Config for OCI database.
 	OCI oci.Config `json:"oci,omitempty" mapstructure:"oci"`
+
+	// Config for S3-compatible object store.
+	S3 s3.Config `json:"s3,omitempty" mapstructure:"s3"`
+
+	// Config for the local cache tier in front of the provider.
+	Cache cache.Config `json:"cache,omitempty" mapstructure:"cache"`
+
+	// Config for the archive storage class of rarely accessed records.
+	Archive archive.Config `json:"archive,omitempty" mapstructure:"archive"`
+
+	// Config for the probe of the provider at startup.
+	Probe probe.Config `json:"probe,omitempty" mapstructure:"probe"`
 }
@@ -23,6 +23,34 @@ type eventsStore struct {
 	eventBus *events.SafeEventBus
 }
 
+type withoutEventsContextKey struct{}
+
+// WithoutEvents returns a context under which store operations emit no events.
+// Callers that stage writes, such as transactions, use it to emit the events
+// themselves once the writes are committed.
+func WithoutEvents(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withoutEventsContextKey{}, true)
+}
+
+// eventsDisabled reports whether ctx was returned by WithoutEvents.
+func eventsDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(withoutEventsContextKey{}).(bool)
+
+	return disabled
+}
+
+// RecordLabels returns the labels of a record attached to its events.
+func RecordLabels(record *corev1.Record) []string {
+	labels := types.GetLabelsFromRecord(adapters.NewRecordAdapter(record))
+	labelStrings := make([]string, len(labels))
+
+	for i, label := range labels {
+		labelStrings[i] = label.String()
+	}
+
+	return labelStrings
+}
+
 // Wrap creates an event-emitting wrapper around a StoreAPI.
 // All successful operations will emit corresponding events.
 func Wrap(source types.StoreAPI, eventBus *events.SafeEventBus) types.StoreAPI {
@@ -41,15 +69,10 @@ func (s *eventsStore) Push(ctx context.Context, record *corev1.Record) (*corev1.
 	}
 
 	// Emit event after successful push
-	labels := types.GetLabelsFromRecord(adapters.NewRecordAdapter(record))
-	labelStrings := make([]string, len(labels))
-
-	for i, label := range labels {
-		labelStrings[i] = label.String()
+	if !eventsDisabled(ctx) {
+		s.eventBus.ForContext(ctx).RecordPushed(ref.GetCid(), RecordLabels(record))
 	}
 
-	s.eventBus.RecordPushed(ref.GetCid(), labelStrings)
-
 	return ref, nil
 }
 
@@ -62,15 +85,10 @@ func (s *eventsStore) Pull(ctx context.Context, ref *corev1.RecordRef) (*corev1.
 	}
 
 	// Emit event after successful pull
-	labels := types.GetLabelsFromRecord(adapters.NewRecordAdapter(record))
-	labelStrings := make([]string, len(labels))
-
-	for i, label := range labels {
-		labelStrings[i] = label.String()
+	if !eventsDisabled(ctx) {
+		s.eventBus.ForContext(ctx).RecordPulled(ref.GetCid(), RecordLabels(record))
 	}
 
-	s.eventBus.RecordPulled(ref.GetCid(), labelStrings)
-
 	return record, nil
 }
 
@@ -89,7 +107,9 @@ func (s *eventsStore) Delete(ctx context.Context, ref *corev1.RecordRef) error {
 	}
 
 	// Emit event after successful deletion
-	s.eventBus.RecordDeleted(ref.GetCid())
+	if !eventsDisabled(ctx) {
+		s.eventBus.ForContext(ctx).RecordDeleted(ref.GetCid())
+	}
 
 	return nil
 }
@@ -142,3 +162,27 @@ func (s *eventsStore) WalkReferrers(ctx context.Context, recordCID string, refer
 	//nolint:wrapcheck
 	return referrerStore.WalkReferrers(ctx, recordCID, referrerType, walkFn)
 }
+
+// List delegates to the source store if it supports listing records.
+// This is needed for checking the consistency of the store with the search database.
+func (s *eventsStore) List(ctx context.Context, listFn func(*corev1.RecordRef) error) error {
+	listerStore, ok := s.source.(types.ListerStore)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "source store does not support listing records")
+	}
+
+	// Delegate to source (no event emitted for listing)
+	//nolint:wrapcheck
+	return listerStore.List(ctx, listFn)
+}
+
+// GarbageCollect delegates to the source store if it supports garbage collection.
+func (s *eventsStore) GarbageCollect(ctx context.Context) (*types.GarbageCollectResult, error) {
+	gcStore, ok := s.source.(types.GarbageCollectorStore)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "source store does not support garbage collection")
+	}
+
+	//nolint:wrapcheck
+	return gcStore.GarbageCollect(ctx)
+}
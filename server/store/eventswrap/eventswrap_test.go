@@ -214,6 +214,42 @@ func TestEventsWrapLookup(t *testing.T) {
 	}
 }
 
+func TestEventsWrapWithoutEvents(t *testing.T) {
+	realBus := events.NewEventBus()
+	safeBus := events.NewSafeEventBus(realBus)
+	mockSrc := &mockStore{}
+
+	wrappedStore := Wrap(mockSrc, safeBus)
+	ctx := WithoutEvents(t.Context())
+
+	record := corev1.New(&typesv1alpha0.Record{Name: "test-agent", SchemaVersion: "v0.3.1"})
+
+	ref, err := wrappedStore.Push(ctx, record)
+	if err != nil {
+		t.Fatalf("Push failed: %v", err)
+	}
+
+	if _, err := wrappedStore.Pull(ctx, ref); err != nil {
+		t.Fatalf("Pull failed: %v", err)
+	}
+
+	if err := wrappedStore.Delete(ctx, ref); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	// Operations are still applied to the source store
+	if !mockSrc.pushCalled || !mockSrc.pullCalled || !mockSrc.deleteCalled {
+		t.Error("Source store operations were not called")
+	}
+
+	realBus.WaitForAsyncPublish()
+
+	metrics := realBus.GetMetrics()
+	if metrics.PublishedTotal != 0 {
+		t.Errorf("Expected 0 events for operations without events, got %d", metrics.PublishedTotal)
+	}
+}
+
 func TestEventsWrapWithNilBus(t *testing.T) {
 	// Should work even with nil bus (no-op)
 	mockSrc := &mockStore{}
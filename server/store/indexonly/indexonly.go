@@ -0,0 +1,95 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package indexonly implements the store of index-only discovery nodes.
+// Index-only nodes maintain the search index and participate in routing,
+// but store no record blobs locally: records are pulled from the proxy upstreams.
+package indexonly
+
+import (
+	"context"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/proxy"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/types/adapters"
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var logger = logging.Logger("store/indexonly")
+
+// Indexer adds records to the search index.
+type Indexer interface {
+	AddRecord(record types.Record) error
+}
+
+// store serves records from the proxy upstreams without storing them.
+type store struct {
+	upstreams *proxy.Proxy
+	index     Indexer
+}
+
+// New creates the store of an index-only node.
+// Records fetched from the upstreams are added to the index and their provenance is recorded,
+// so that later requests are sent to the upstream the record originates from first.
+func New(upstreams *proxy.Proxy, index Indexer) types.StoreAPI {
+	return &store{
+		upstreams: upstreams,
+		index:     index,
+	}
+}
+
+// Push rejects records, as index-only nodes do not store them.
+func (s *store) Push(_ context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
+	return nil, status.Errorf(codes.FailedPrecondition, "record %s cannot be pushed to an index-only node, push it to an upstream directory", record.GetCid())
+}
+
+// Pull fetches the record from the upstreams and indexes it.
+func (s *store) Pull(ctx context.Context, ref *corev1.RecordRef) (*corev1.Record, error) {
+	// Do not forward requests of other proxies, to avoid loops between directories
+	if proxy.IsProxyRequest(ctx) {
+		return nil, status.Errorf(codes.NotFound, "record %s is not stored on this index-only node", ref.GetCid())
+	}
+
+	record, provenance, err := s.upstreams.Fetch(ctx, ref.GetCid())
+	if err != nil {
+		return nil, err //nolint:wrapcheck // keep the gRPC status of the upstreams
+	}
+
+	// Index and record the provenance of the record, but don't fail the pull on errors
+	if err := s.index.AddRecord(adapters.NewRecordAdapter(record)); err != nil {
+		logger.Error("Failed to add record to search index", "error", err, "cid", ref.GetCid())
+	}
+
+	if err := s.upstreams.SaveProvenance(ctx, ref.GetCid(), provenance); err != nil {
+		logger.Error("Failed to save record provenance", "error", err, "cid", ref.GetCid())
+	}
+
+	return record, nil
+}
+
+// Lookup returns the metadata of the record from the upstreams.
+func (s *store) Lookup(ctx context.Context, ref *corev1.RecordRef) (*corev1.RecordMeta, error) {
+	if proxy.IsProxyRequest(ctx) {
+		return nil, status.Errorf(codes.NotFound, "record %s is not stored on this index-only node", ref.GetCid())
+	}
+
+	return s.upstreams.Lookup(ctx, ref.GetCid()) //nolint:wrapcheck // keep the gRPC status of the upstreams
+}
+
+// Delete forgets the provenance of the record.
+// No blob is stored locally, the record is removed from the index by the caller.
+func (s *store) Delete(ctx context.Context, ref *corev1.RecordRef) error {
+	if err := s.upstreams.DeleteProvenance(ctx, ref.GetCid()); err != nil {
+		logger.Warn("Failed to delete record provenance", "error", err, "cid", ref.GetCid())
+	}
+
+	return nil
+}
+
+// IsReady reports the store as ready, records are served by the upstreams.
+func (s *store) IsReady(_ context.Context) bool {
+	return true
+}
@@ -0,0 +1,151 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package indexonly
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	typesv1alpha0 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/agntcy/oasf/types/v1alpha0"
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/proxy"
+	"github.com/agntcy/dir/server/proxy/config"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeUpstream serves a fixed set of records over the Store API.
+type fakeUpstream struct {
+	storev1.UnimplementedStoreServiceServer
+
+	records map[string]*corev1.Record
+}
+
+func (u *fakeUpstream) Pull(stream storev1.StoreService_PullServer) error {
+	for {
+		ref, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		record, ok := u.records[ref.GetCid()]
+		if !ok {
+			return status.Errorf(codes.NotFound, "record not found: %s", ref.GetCid())
+		}
+
+		if err := stream.Send(record); err != nil {
+			return err
+		}
+	}
+}
+
+// fakeIndex records the indexed records.
+type fakeIndex struct {
+	cids []string
+}
+
+func (i *fakeIndex) AddRecord(record types.Record) error {
+	i.cids = append(i.cids, record.GetCid())
+
+	return nil
+}
+
+func newTestStore(t *testing.T, records ...*corev1.Record) (types.StoreAPI, *proxy.Proxy, *fakeIndex) {
+	t.Helper()
+
+	upstream := &fakeUpstream{records: map[string]*corev1.Record{}}
+	for _, record := range records {
+		upstream.records[record.GetCid()] = record
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	storev1.RegisterStoreServiceServer(server, upstream)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	p, err := proxy.New(config.Config{
+		Enabled:        true,
+		IndexOnly:      true,
+		Upstreams:      []string{lis.Addr().String()},
+		RequestTimeout: 5 * time.Second,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = p.Close() })
+
+	index := &fakeIndex{}
+
+	return New(p, index), p, index
+}
+
+func newTestRecord(name string) *corev1.Record {
+	return corev1.New(&typesv1alpha0.Record{
+		Name:          name,
+		Version:       "v1.0.0",
+		SchemaVersion: "v0.3.1",
+	})
+}
+
+func TestPull(t *testing.T) {
+	ctx := context.Background()
+	record := newTestRecord("edge-agent")
+
+	store, p, index := newTestStore(t, record)
+
+	pulled, err := store.Pull(ctx, &corev1.RecordRef{Cid: record.GetCid()})
+	require.NoError(t, err)
+	assert.Equal(t, record.GetCid(), pulled.GetCid())
+
+	// Pulled records are indexed and their origin is remembered
+	assert.Equal(t, []string{record.GetCid()}, index.cids)
+
+	provenance, err := p.GetProvenance(ctx, record.GetCid())
+	require.NoError(t, err)
+	require.NotNil(t, provenance)
+
+	// Missing records are not found
+	_, err = store.Pull(ctx, &corev1.RecordRef{Cid: newTestRecord("missing-agent").GetCid()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Requests of other proxies are not forwarded
+	proxyCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(proxy.RequestMetadataKey, "true"))
+
+	_, err = store.Pull(proxyCtx, &corev1.RecordRef{Cid: record.GetCid()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Deleting forgets the origin of the record
+	require.NoError(t, store.Delete(ctx, &corev1.RecordRef{Cid: record.GetCid()}))
+
+	provenance, err = p.GetProvenance(ctx, record.GetCid())
+	require.NoError(t, err)
+	assert.Nil(t, provenance)
+}
+
+func TestPush(t *testing.T) {
+	store, _, _ := newTestStore(t)
+
+	_, err := store.Push(context.Background(), newTestRecord("edge-agent"))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
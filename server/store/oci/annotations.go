@@ -218,6 +218,15 @@ func parseManifestAnnotations(annotations map[string]string) *corev1.RecordMeta
 	return recordMeta
 }
 
+// RecordMeta returns the metadata of a record, as looked up from the OCI store after a push.
+// It allows other store providers to serve the same record metadata.
+func RecordMeta(record *corev1.Record) *corev1.RecordMeta {
+	meta := parseManifestAnnotations(extractManifestAnnotations(record))
+	meta.Cid = record.GetCid()
+
+	return meta
+}
+
 // parseCommaSeparated splits comma-separated values and trims whitespace.
 func parseCommaSeparated(value string) []string {
 	if value == "" {
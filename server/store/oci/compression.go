@@ -0,0 +1,96 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"fmt"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	ociconfig "github.com/agntcy/dir/server/store/oci/config"
+	"github.com/klauspost/compress/zstd"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// MediaTypeRecord is the media type of uncompressed record blobs.
+	MediaTypeRecord = "application/json"
+
+	// MediaTypeRecordZstd is the media type of zstd-compressed record blobs.
+	MediaTypeRecordZstd = MediaTypeRecord + "+zstd"
+
+	// LayerKeyUncompressedDigest is the layer annotation holding the digest of the
+	// uncompressed record of a compressed blob. Record CIDs are always derived from
+	// the uncompressed record, so that they do not depend on the storage compression.
+	LayerKeyUncompressedDigest = manifestDirObjectKeyPrefix + "/uncompressed-digest"
+
+	// minCompressionSize is the record size below which blobs are stored uncompressed,
+	// as compression does not pay off for small records.
+	minCompressionSize = 1024
+
+	// maxDecompressedSize limits the size of decompressed records to protect
+	// against decompression bombs.
+	maxDecompressedSize = 64 << 20 // 64MiB
+)
+
+var (
+	// zstdEncoder and zstdDecoder are safe for concurrent use with EncodeAll and DecodeAll.
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxDecompressedSize))
+)
+
+// validateCompression checks that the configured compression is supported.
+func validateCompression(compression string) error {
+	switch compression {
+	case "", ociconfig.CompressionZstd, ociconfig.CompressionNone:
+		return nil
+	default:
+		return fmt.Errorf("unsupported compression: %s", compression)
+	}
+}
+
+// encodeRecordBlob returns the blob to store for the canonical record bytes and its media type.
+// Records are compressed with the configured compression if they are large enough
+// and compression reduces their size, otherwise they are stored as is.
+func (s *store) encodeRecordBlob(recordBytes []byte) (string, []byte) {
+	if s.config.Compression == ociconfig.CompressionNone || len(recordBytes) < minCompressionSize {
+		return MediaTypeRecord, recordBytes
+	}
+
+	compressed := zstdEncoder.EncodeAll(recordBytes, nil)
+	if len(compressed) >= len(recordBytes) {
+		return MediaTypeRecord, recordBytes
+	}
+
+	return MediaTypeRecordZstd, compressed
+}
+
+// decodeRecordBlob returns the canonical record bytes of a blob based on its media type.
+// Decompressed records are verified against the expected record CID.
+// Blobs with other media types are returned as is for backward compatibility.
+func decodeRecordBlob(blobDesc ocispec.Descriptor, blob []byte, cid string) ([]byte, error) {
+	if blobDesc.MediaType != MediaTypeRecordZstd {
+		return blob, nil
+	}
+
+	recordBytes, err := zstdDecoder.DecodeAll(blob, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress record: %w", err)
+	}
+
+	recordDigest, err := corev1.CalculateDigest(recordBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate decompressed record digest: %w", err)
+	}
+
+	actualCID, err := corev1.ConvertDigestToCID(recordDigest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert decompressed record digest to CID: %w", err)
+	}
+
+	if actualCID != cid {
+		return nil, fmt.Errorf("decompressed record CID %s does not match %s", actualCID, cid)
+	}
+
+	return recordBytes, nil
+}
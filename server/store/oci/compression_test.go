@@ -0,0 +1,142 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"strings"
+	"testing"
+
+	typesv1alpha0 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/agntcy/oasf/types/v1alpha0"
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	ociconfig "github.com/agntcy/dir/server/store/oci/config"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeRecordBlob(t *testing.T) {
+	largeRecord := corev1.New(&typesv1alpha0.Record{
+		Name:          "large-agent",
+		SchemaVersion: "v0.3.1",
+		Description:   strings.Repeat("a highly compressible description ", 100),
+	})
+	smallRecord := corev1.New(&typesv1alpha0.Record{
+		Name:          "small-agent",
+		SchemaVersion: "v0.3.1",
+	})
+
+	tests := []struct {
+		name              string
+		compression       string
+		record            *corev1.Record
+		expectedMediaType string
+	}{
+		{
+			name:              "large record is compressed",
+			compression:       ociconfig.CompressionZstd,
+			record:            largeRecord,
+			expectedMediaType: MediaTypeRecordZstd,
+		},
+		{
+			name:              "default compression is zstd",
+			compression:       "",
+			record:            largeRecord,
+			expectedMediaType: MediaTypeRecordZstd,
+		},
+		{
+			name:              "small record is not compressed",
+			compression:       ociconfig.CompressionZstd,
+			record:            smallRecord,
+			expectedMediaType: MediaTypeRecord,
+		},
+		{
+			name:              "compression disabled",
+			compression:       ociconfig.CompressionNone,
+			record:            largeRecord,
+			expectedMediaType: MediaTypeRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &store{config: ociconfig.Config{Compression: tt.compression}}
+
+			recordBytes, err := tt.record.Marshal()
+			require.NoError(t, err)
+
+			mediaType, blob := s.encodeRecordBlob(recordBytes)
+			assert.Equal(t, tt.expectedMediaType, mediaType)
+
+			if mediaType == MediaTypeRecordZstd {
+				assert.Less(t, len(blob), len(recordBytes))
+			}
+
+			decoded, err := decodeRecordBlob(ocispec.Descriptor{MediaType: mediaType}, blob, tt.record.GetCid())
+			require.NoError(t, err)
+			assert.Equal(t, recordBytes, decoded)
+		})
+	}
+}
+
+func TestDecodeRecordBlob_CIDMismatch(t *testing.T) {
+	record := corev1.New(&typesv1alpha0.Record{
+		Name:          "agent",
+		SchemaVersion: "v0.3.1",
+		Description:   strings.Repeat("description ", 200),
+	})
+	otherRecord := corev1.New(&typesv1alpha0.Record{
+		Name:          "other-agent",
+		SchemaVersion: "v0.3.1",
+	})
+
+	recordBytes, err := record.Marshal()
+	require.NoError(t, err)
+
+	s := &store{config: ociconfig.Config{Compression: ociconfig.CompressionZstd}}
+	mediaType, blob := s.encodeRecordBlob(recordBytes)
+	require.Equal(t, MediaTypeRecordZstd, mediaType)
+
+	_, err = decodeRecordBlob(ocispec.Descriptor{MediaType: mediaType}, blob, otherRecord.GetCid())
+	assert.ErrorContains(t, err, "does not match")
+}
+
+func TestDecodeRecordBlob_Invalid(t *testing.T) {
+	_, err := decodeRecordBlob(ocispec.Descriptor{MediaType: MediaTypeRecordZstd}, []byte("not zstd"), "cid")
+	assert.ErrorContains(t, err, "failed to decompress record")
+}
+
+func TestValidateCompression(t *testing.T) {
+	assert.NoError(t, validateCompression(""))
+	assert.NoError(t, validateCompression(ociconfig.CompressionZstd))
+	assert.NoError(t, validateCompression(ociconfig.CompressionNone))
+	assert.Error(t, validateCompression("gzip"))
+}
+
+func TestStorePushPullCompressed(t *testing.T) {
+	for _, compression := range []string{ociconfig.CompressionZstd, ociconfig.CompressionNone} {
+		t.Run(compression, func(t *testing.T) {
+			s, err := New(ociconfig.Config{LocalDir: t.TempDir(), Compression: compression})
+			require.NoError(t, err)
+
+			record := corev1.New(&typesv1alpha0.Record{
+				Name:          "compressed-agent",
+				SchemaVersion: "v0.3.1",
+				Description:   strings.Repeat("a highly compressible description ", 100),
+			})
+
+			ref, err := s.Push(testCtx, record)
+			require.NoError(t, err)
+			assert.Equal(t, record.GetCid(), ref.GetCid())
+
+			pulled, err := s.Pull(testCtx, ref)
+			require.NoError(t, err)
+			assert.Equal(t, record.GetCid(), pulled.GetCid())
+
+			require.NoError(t, s.Delete(testCtx, ref))
+
+			_, err = s.Pull(testCtx, ref)
+			assert.ErrorContains(t, err, "not found")
+		})
+	}
+}
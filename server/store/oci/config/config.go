@@ -7,6 +7,31 @@ const (
 	DefaultAuthConfigInsecure = true
 	DefaultRegistryAddress    = "127.0.0.1:5000"
 	DefaultRepositoryName     = "dir"
+	DefaultReferrersMode      = ReferrersModeAuto
+	DefaultCompression        = CompressionZstd
+)
+
+// Referrers modes control how referrer artifacts (signatures, public keys,
+// attestations, annotations) are attached to and discovered from records.
+const (
+	// ReferrersModeAuto detects whether the registry supports the OCI Referrers API
+	// and falls back to the referrers tag scheme if it does not.
+	ReferrersModeAuto = "auto"
+
+	// ReferrersModeAPI always uses the OCI Referrers API.
+	ReferrersModeAPI = "api"
+
+	// ReferrersModeTag always uses the referrers tag scheme (<alg>-<digest> index tags).
+	ReferrersModeTag = "tag"
+)
+
+// Compression algorithms for record blobs.
+const (
+	// CompressionZstd stores record blobs compressed with zstd.
+	CompressionZstd = "zstd"
+
+	// CompressionNone stores record blobs as uncompressed JSON.
+	CompressionNone = "none"
 )
 
 type Config struct {
@@ -24,6 +49,16 @@ type Config struct {
 	// Repository name to connect to
 	RepositoryName string `json:"repository_name,omitempty" mapstructure:"repository_name"`
 
+	// Referrers mode to use for remote registries.
+	// One of "auto", "api", or "tag". Defaults to "auto".
+	ReferrersMode string `json:"referrers_mode,omitempty" mapstructure:"referrers_mode"`
+
+	// Compression of record blobs pushed to the store.
+	// One of "zstd" or "none". Defaults to "zstd".
+	// Records are always readable regardless of this setting, as the compression
+	// of each blob is signaled by its media type.
+	Compression string `json:"compression,omitempty" mapstructure:"compression"`
+
 	// Authentication configuration
 	AuthConfig `json:"auth_config,omitempty" mapstructure:"auth_config"`
 }
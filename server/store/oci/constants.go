@@ -80,6 +80,13 @@ const (
 	// Custom annotations prefix.
 	ManifestKeyCustomPrefix = manifestDirObjectKeyPrefix + "/custom."
 
+	// Referrer manifest annotations.
+	// These are set on referrer artifacts so that OCI tooling (e.g. oras discover)
+	// can inspect them without fetching the referrer blob.
+	ReferrerKeyType             = "agntcy.dir.referrer.type"
+	ReferrerKeyCreatedAt        = "agntcy.dir.referrer.created_at"
+	ReferrerKeyAnnotationPrefix = "agntcy.dir.referrer.annotation."
+
 	// Fallback values for error recovery scenarios.
 	// Used when parsing corrupted storage, legacy records, or external modifications.
 	FallbackSchemaVersion = "v0.3.1"
@@ -0,0 +1,87 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/agntcy/dir/server/types"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"oras.land/oras-go/v2/content/oci"
+)
+
+// GarbageCollect removes the blobs of a local store that are not reachable from a record
+// or from the referrers of a stored record, e.g. the blobs left behind by interrupted pushes.
+// Remote registries collect their own garbage.
+func (s *store) GarbageCollect(ctx context.Context) (*types.GarbageCollectResult, error) {
+	ociStore, ok := s.repo.(*oci.Store)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "garbage collection is only supported by local stores, remote registries collect their own garbage") //nolint:wrapcheck
+	}
+
+	// Wait for the pushes in progress, whose blobs are not referenced yet
+	s.gc.Lock()
+	defer s.gc.Unlock()
+
+	before, err := blobSizes(s.config.LocalDir)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list blobs: %v", err)
+	}
+
+	if err := ociStore.GC(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to collect garbage: %v", err)
+	}
+
+	after, err := blobSizes(s.config.LocalDir)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list blobs: %v", err)
+	}
+
+	result := &types.GarbageCollectResult{}
+
+	for path, size := range before {
+		if _, ok := after[path]; !ok {
+			result.RemovedBlobs++
+			result.ReclaimedBytes += size
+		}
+	}
+
+	logger.Info("Collected garbage of local store", "removed_blobs", result.RemovedBlobs, "reclaimed_bytes", result.ReclaimedBytes)
+
+	return result, nil
+}
+
+// blobSizes returns the sizes of the blobs of a local OCI layout by path.
+func blobSizes(root string) (map[string]uint64, error) {
+	sizes := make(map[string]uint64)
+
+	err := filepath.WalkDir(filepath.Join(root, ocispec.ImageBlobsDir), func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("failed to stat blob: %w", err)
+		}
+
+		sizes[path] = uint64(info.Size()) //nolint:gosec // file sizes are not negative
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk blobs: %w", err)
+	}
+
+	return sizes, nil
+}
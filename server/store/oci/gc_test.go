@@ -0,0 +1,65 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"testing"
+
+	typesv1alpha0 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/agntcy/oasf/types/v1alpha0"
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2"
+)
+
+func TestStoreGarbageCollect(t *testing.T) {
+	recordStore := loadLocalStore(t)
+
+	gcStore, ok := recordStore.(types.GarbageCollectorStore)
+	require.True(t, ok, "local store should support garbage collection")
+
+	record := corev1.New(&typesv1alpha0.Record{
+		Name:          "test-gc-agent",
+		SchemaVersion: "v0.3.1",
+		Description:   "A test agent kept by garbage collection",
+	})
+
+	recordRef, err := recordStore.Push(testCtx, record)
+	require.NoError(t, err, "push failed")
+
+	err = recordStore.(types.ReferrerStoreAPI).PushReferrer(testCtx, recordRef.GetCid(), &corev1.RecordReferrer{ //nolint:forcetypeassert
+		Type:        corev1.AnnotationsReferrerType,
+		Annotations: map[string]string{"team": "platform"},
+	})
+	require.NoError(t, err, "push referrer failed")
+
+	// A blob left behind by an interrupted push is not referenced by any manifest
+	garbage := []byte("interrupted push")
+	_, err = oras.PushBytes(testCtx, recordStore.(*store).repo, "application/octet-stream", garbage) //nolint:forcetypeassert
+	require.NoError(t, err, "push garbage failed")
+
+	result, err := gcStore.GarbageCollect(testCtx)
+	require.NoError(t, err, "garbage collection failed")
+	assert.Equal(t, &types.GarbageCollectResult{RemovedBlobs: 1, ReclaimedBytes: uint64(len(garbage))}, result)
+
+	// The record and its referrers are kept
+	_, err = recordStore.Pull(testCtx, recordRef)
+	require.NoError(t, err, "pull after garbage collection failed")
+
+	var referrers int
+
+	err = recordStore.(types.ReferrerStoreAPI).WalkReferrers(testCtx, recordRef.GetCid(), "", func(*corev1.RecordReferrer) error { //nolint:forcetypeassert
+		referrers++
+
+		return nil
+	})
+	require.NoError(t, err, "walk referrers after garbage collection failed")
+	assert.Equal(t, 1, referrers)
+
+	// Nothing is left to collect
+	result, err = gcStore.GarbageCollect(testCtx)
+	require.NoError(t, err, "second garbage collection failed")
+	assert.Zero(t, result.RemovedBlobs)
+}
@@ -110,12 +110,22 @@ func (s *store) deleteFromOCIStore(ctx context.Context, ref *corev1.RecordRef) e
 	// Phase 1: Delete manifest (tags will be cleaned up by OCI GC)
 	internalLogger.Debug("Phase 1: Deleting manifest", "cid", cid)
 
+	// Layer descriptors are collected before the manifest is deleted, since compressed
+	// records are stored in blobs whose digest differs from the record CID.
+	var layers []ocispec.Descriptor
+
 	manifestDesc, err := s.repo.Resolve(ctx, cid)
 	if err != nil {
 		// Manifest might already be gone - this is not necessarily an error
 		internalLogger.Debug("Failed to resolve manifest during delete (may already be deleted)", "cid", cid, "error", err)
 		errors = append(errors, fmt.Sprintf("manifest resolve: %v", err))
 	} else {
+		if manifest, err := s.fetchAndParseManifestFromDescriptor(ctx, manifestDesc); err != nil {
+			internalLogger.Debug("Failed to fetch manifest layers during delete", "cid", cid, "error", err)
+		} else {
+			layers = manifest.Layers
+		}
+
 		if err := store.Delete(ctx, manifestDesc); err != nil {
 			internalLogger.Warn("Failed to delete manifest", "cid", cid, "error", err)
 			errors = append(errors, fmt.Sprintf("manifest delete: %v", err))
@@ -127,9 +137,22 @@ func (s *store) deleteFromOCIStore(ctx context.Context, ref *corev1.RecordRef) e
 	// Phase 2: Remove blob data (local store - we have full control)
 	internalLogger.Debug("Phase 2: Deleting blob data", "cid", cid)
 
-	if err := s.deleteBlobForLocalStore(ctx, cid, store); err != nil {
-		internalLogger.Warn("Failed to delete blob", "cid", cid, "error", err)
-		errors = append(errors, fmt.Sprintf("blob delete: %v", err))
+	if len(layers) == 0 {
+		if err := s.deleteBlobForLocalStore(ctx, cid, store); err != nil {
+			internalLogger.Warn("Failed to delete blob", "cid", cid, "error", err)
+			errors = append(errors, fmt.Sprintf("blob delete: %v", err))
+		}
+	}
+
+	for _, layer := range layers {
+		if err := store.Delete(ctx, layer); err != nil {
+			internalLogger.Warn("Failed to delete blob", "cid", cid, "digest", layer.Digest.String(), "error", err)
+			errors = append(errors, fmt.Sprintf("blob delete: %v", err))
+
+			continue
+		}
+
+		internalLogger.Debug("Blob deleted successfully", "cid", cid, "digest", layer.Digest.String())
 	}
 
 	// Log summary
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 	"time"
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
@@ -22,6 +23,7 @@ import (
 	"google.golang.org/grpc/status"
 	"oras.land/oras-go/v2"
 	"oras.land/oras-go/v2/content/oci"
+	"oras.land/oras-go/v2/registry"
 	"oras.land/oras-go/v2/registry/remote"
 )
 
@@ -39,19 +41,29 @@ const (
 type store struct {
 	repo   oras.GraphTarget
 	config ociconfig.Config
+
+	// gc is held by garbage collections, and shared by pushes so that the blobs
+	// they push are not collected before the manifests referencing them
+	gc sync.RWMutex
 }
 
 // Compile-time interface checks to ensure store implements all capability interfaces.
 var (
-	_ types.StoreAPI         = (*store)(nil)
-	_ types.ReferrerStoreAPI = (*store)(nil)
-	_ types.VerifierStore    = (*store)(nil)
-	_ types.FullStore        = (*store)(nil)
+	_ types.StoreAPI              = (*store)(nil)
+	_ types.ReferrerStoreAPI      = (*store)(nil)
+	_ types.VerifierStore         = (*store)(nil)
+	_ types.ListerStore           = (*store)(nil)
+	_ types.GarbageCollectorStore = (*store)(nil)
+	_ types.FullStore             = (*store)(nil)
 )
 
 func New(cfg ociconfig.Config) (types.StoreAPI, error) {
 	logger.Debug("Creating OCI store with config", "config", cfg)
 
+	if err := validateCompression(cfg.Compression); err != nil {
+		return nil, err
+	}
+
 	// if local dir used, return client for that local path.
 	// allows mounting of data via volumes
 	// allows S3 usage for backup store
@@ -191,6 +203,9 @@ func (s *store) tagWithRetry(ctx context.Context, manifestDigest, tag string) er
 func (s *store) Push(ctx context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
 	logger.Debug("Pushing record to OCI store", "record", record)
 
+	s.gc.RLock()
+	defer s.gc.RUnlock()
+
 	// Marshal the record using canonical JSON marshaling first
 	// This ensures consistent bytes for both CID calculation and storage
 	recordBytes, err := record.Marshal()
@@ -198,32 +213,51 @@ func (s *store) Push(ctx context.Context, record *corev1.Record) (*corev1.Record
 		return nil, status.Errorf(codes.Internal, "failed to marshal record: %v", err)
 	}
 
-	// Step 1: Use oras.PushBytes to push the record data and get Layer Descriptor
-	layerDesc, err := oras.PushBytes(ctx, s.repo, "application/json", recordBytes)
+	// Step 1: Calculate CID from the digest of the canonical record bytes.
+	// The CID does not depend on how the record blob is compressed in storage.
+	recordDigest, err := corev1.CalculateDigest(recordBytes)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to push record bytes: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to calculate record digest: %v", err)
 	}
 
-	// Step 2: Calculate CID from Layer Descriptor's digest using our new utility function
-	recordCID, err := corev1.ConvertDigestToCID(layerDesc.Digest)
+	recordCID, err := corev1.ConvertDigestToCID(recordDigest)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert digest to CID: %v", err)
 	}
 
-	// Validate consistency: CID from ORAS digest should match CID from record
+	// Validate consistency: CID from record bytes digest should match CID from record
 	expectedCID := record.GetCid()
 	if recordCID != expectedCID {
 		return nil, status.Errorf(codes.Internal,
-			"CID mismatch: OCI digest CID (%s) != Record CID (%s)",
+			"CID mismatch: record digest CID (%s) != Record CID (%s)",
 			recordCID, expectedCID)
 	}
 
 	logger.Debug("CID validation successful",
 		"cid", recordCID,
-		"digest", layerDesc.Digest.String(),
-		"validation", "ORAS digest CID matches Record CID")
+		"digest", recordDigest.String(),
+		"validation", "record digest CID matches Record CID")
 
-	logger.Debug("Calculated CID from ORAS digest", "cid", recordCID, "digest", layerDesc.Digest.String())
+	// Step 2: Use oras.PushBytes to push the (possibly compressed) record blob and get Layer Descriptor
+	mediaType, blob := s.encodeRecordBlob(recordBytes)
+
+	layerDesc, err := oras.PushBytes(ctx, s.repo, mediaType, blob)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to push record bytes: %v", err)
+	}
+
+	if mediaType == MediaTypeRecordZstd {
+		layerDesc.Annotations = map[string]string{
+			LayerKeyUncompressedDigest: recordDigest.String(),
+		}
+	}
+
+	logger.Debug("Pushed record blob",
+		"cid", recordCID,
+		"digest", layerDesc.Digest.String(),
+		"mediaType", mediaType,
+		"size", len(blob),
+		"uncompressedSize", len(recordBytes))
 
 	// Create record reference
 	recordRef := &corev1.RecordRef{Cid: recordCID}
@@ -336,10 +370,10 @@ func (s *store) Pull(ctx context.Context, ref *corev1.RecordRef) (*corev1.Record
 	blobDesc := manifest.Layers[0]
 
 	// Validate layer media type
-	if blobDesc.MediaType != "application/json" {
+	if blobDesc.MediaType != MediaTypeRecord && blobDesc.MediaType != MediaTypeRecordZstd {
 		logger.Warn("Unexpected blob media type",
 			"cid", ref.GetCid(),
-			"expected", "application/json",
+			"expected", []string{MediaTypeRecord, MediaTypeRecordZstd},
 			"actual", blobDesc.MediaType)
 	}
 
@@ -357,17 +391,23 @@ func (s *store) Pull(ctx context.Context, ref *corev1.RecordRef) (*corev1.Record
 	defer reader.Close()
 
 	// Read all data from the reader
-	recordData, err := io.ReadAll(reader)
+	blobData, err := io.ReadAll(reader)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to read record data for CID %s: %v", ref.GetCid(), err)
 	}
 
 	// Validate blob size matches descriptor
-	if blobDesc.Size > 0 && int64(len(recordData)) != blobDesc.Size {
+	if blobDesc.Size > 0 && int64(len(blobData)) != blobDesc.Size {
 		logger.Warn("Blob size mismatch",
 			"cid", ref.GetCid(),
 			"expected", blobDesc.Size,
-			"actual", len(recordData))
+			"actual", len(blobData))
+	}
+
+	// Decompress the blob if it was stored compressed
+	recordData, err := decodeRecordBlob(blobDesc, blobData, ref.GetCid())
+	if err != nil {
+		return nil, status.Errorf(codes.DataLoss, "failed to decode record blob for CID %s: %v", ref.GetCid(), err)
 	}
 
 	// Unmarshal canonical JSON data back to Record
@@ -378,7 +418,8 @@ func (s *store) Pull(ctx context.Context, ref *corev1.RecordRef) (*corev1.Record
 
 	logger.Debug("Record pulled successfully",
 		"cid", ref.GetCid(),
-		"blobSize", len(recordData),
+		"blobSize", len(blobData),
+		"recordSize", len(recordData),
 		"blobDigest", blobDesc.Digest.String(),
 		"manifestDigest", manifestDesc.Digest.String())
 
@@ -403,6 +444,39 @@ func (s *store) Delete(ctx context.Context, ref *corev1.RecordRef) error {
 	}
 }
 
+// List calls listFn with the reference of every record in the store.
+// Records are listed by their CID tags, other tags are skipped.
+func (s *store) List(ctx context.Context, listFn func(*corev1.RecordRef) error) error {
+	tagLister, ok := s.repo.(registry.TagLister)
+	if !ok {
+		return status.Errorf(codes.FailedPrecondition, "unsupported repo type: %T", s.repo)
+	}
+
+	err := tagLister.Tags(ctx, "", func(tags []string) error {
+		for _, tag := range tags {
+			if !corev1.IsValidCID(tag) {
+				continue
+			}
+
+			if err := listFn(&corev1.RecordRef{Cid: tag}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		// An empty registry has no repository until the first record is pushed
+		if isNotFoundError(err) {
+			return nil
+		}
+
+		return status.Errorf(codes.Internal, "failed to list record tags: %v", err)
+	}
+
+	return nil
+}
+
 // IsReady checks if the storage backend is ready to serve traffic.
 // For local stores, always returns true.
 // For remote OCI registries, checks Zot's /readyz endpoint to verify it's ready.
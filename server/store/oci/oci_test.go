@@ -91,6 +91,58 @@ func TestStorePushLookupPullDelete(t *testing.T) {
 	assert.ErrorContains(t, err, "not found")
 }
 
+func TestStorePushWalkReferrers(t *testing.T) {
+	store := loadLocalStore(t)
+
+	refStore, ok := store.(types.ReferrerStoreAPI)
+	require.True(t, ok, "local store should support referrers")
+
+	record := corev1.New(&typesv1alpha0.Record{
+		Name:          "test-referrers-agent",
+		SchemaVersion: "v0.3.1",
+		Description:   "A test agent with referrers",
+	})
+
+	recordRef, err := store.Push(testCtx, record)
+	require.NoError(t, err, "push failed")
+
+	// Push an attestation and a mutable annotations referrer
+	err = refStore.PushReferrer(testCtx, recordRef.GetCid(), &corev1.RecordReferrer{
+		Type:        corev1.AttestationReferrerType,
+		Annotations: map[string]string{"predicate": "provenance"},
+	})
+	require.NoError(t, err, "push attestation referrer failed")
+
+	err = refStore.PushReferrer(testCtx, recordRef.GetCid(), &corev1.RecordReferrer{
+		Type:        corev1.AnnotationsReferrerType,
+		Annotations: map[string]string{"team": "platform"},
+	})
+	require.NoError(t, err, "push annotations referrer failed")
+
+	// Walk all referrers
+	var allTypes []string
+
+	err = refStore.WalkReferrers(testCtx, recordRef.GetCid(), "", func(referrer *corev1.RecordReferrer) error {
+		allTypes = append(allTypes, referrer.GetType())
+
+		return nil
+	})
+	require.NoError(t, err, "walk all referrers failed")
+	assert.ElementsMatch(t, []string{corev1.AttestationReferrerType, corev1.AnnotationsReferrerType}, allTypes)
+
+	// Walk referrers filtered by type
+	var annotations []*corev1.RecordReferrer
+
+	err = refStore.WalkReferrers(testCtx, recordRef.GetCid(), corev1.AnnotationsReferrerType, func(referrer *corev1.RecordReferrer) error {
+		annotations = append(annotations, referrer)
+
+		return nil
+	})
+	require.NoError(t, err, "walk annotations referrers failed")
+	require.Len(t, annotations, 1)
+	assert.Equal(t, "platform", annotations[0].GetAnnotations()["team"])
+}
+
 func BenchmarkLocalStore(b *testing.B) {
 	if !runLocal {
 		b.Skip()
@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/registry"
 )
 
 var referrersLogger = logging.Logger("store/oci/referrers")
@@ -32,6 +33,9 @@ type ReferrersLister interface {
 func (s *store) PushReferrer(ctx context.Context, recordCID string, referrer *corev1.RecordReferrer) error {
 	referrersLogger.Debug("Pushing generic referrer to OCI store", "recordCID", recordCID, "type", referrer.GetType())
 
+	s.gc.RLock()
+	defer s.gc.RUnlock()
+
 	if referrer == nil {
 		return status.Error(codes.InvalidArgument, "referrer is required") //nolint:wrapcheck
 	}
@@ -85,18 +89,22 @@ func (s *store) PushReferrer(ctx context.Context, recordCID string, referrer *co
 
 	// Create annotations for the referrer manifest
 	annotations := make(map[string]string)
-	annotations["agntcy.dir.referrer.type"] = referrer.GetType()
+	annotations[ReferrerKeyType] = referrer.GetType()
 
 	if referrer.GetCreatedAt() != "" {
-		annotations["agntcy.dir.referrer.created_at"] = referrer.GetCreatedAt()
+		annotations[ReferrerKeyCreatedAt] = referrer.GetCreatedAt()
 	}
 	// Add custom annotations from the referrer
 	for key, value := range referrer.GetAnnotations() {
-		annotations["agntcy.dir.referrer.annotation."+key] = value
+		annotations[ReferrerKeyAnnotationPrefix+key] = value
 	}
 
-	// Create the referrer manifest with proper OCI subject field
-	manifestDesc, err := oras.PackManifest(ctx, s.repo, oras.PackManifestVersion1_1, ocispec.MediaTypeImageManifest,
+	// Create the referrer manifest with proper OCI subject field.
+	// The artifact type allows OCI tooling to filter referrers by type
+	// (e.g. oras discover --artifact-type). For registries without the
+	// Referrers API, the referrers index is maintained under the fallback
+	// tag scheme (<alg>-<digest>) when the manifest is pushed.
+	manifestDesc, err := oras.PackManifest(ctx, s.repo, oras.PackManifestVersion1_1, ociArtifactType,
 		oras.PackManifestOptions{
 			Subject:             &recordManifestDesc,
 			ManifestAnnotations: annotations,
@@ -143,15 +151,9 @@ func (s *store) WalkReferrers(ctx context.Context, recordCID string, referrerTyp
 		matcher = s.MediaTypeReferrerMatcher(ociArtifactType)
 	}
 
-	// Use the OCI referrers API to walk through referrers efficiently
-	referrersLister, ok := s.repo.(ReferrersLister)
-	if !ok {
-		return status.Errorf(codes.Unimplemented, "repository does not support OCI referrers API")
-	}
-
 	var walkErr error
 
-	err = referrersLister.Referrers(ctx, recordManifestDesc, "", func(referrers []ocispec.Descriptor) error {
+	err = s.listReferrers(ctx, recordManifestDesc, func(referrers []ocispec.Descriptor) error {
 		for _, referrerDesc := range referrers {
 			// Apply matcher if specified
 			if matcher != nil && !matcher(ctx, referrerDesc) {
@@ -192,6 +194,27 @@ func (s *store) WalkReferrers(ctx context.Context, recordCID string, referrerTyp
 	return nil
 }
 
+// listReferrers lists all referrers of the given manifest, passing them to fn in batches.
+// Repositories implementing the Referrers API are queried directly; remote repositories
+// transparently fall back to the referrers tag scheme when the registry does not support it.
+// Other stores, such as a local OCI layout, are scanned through their graph predecessors.
+func (s *store) listReferrers(ctx context.Context, desc ocispec.Descriptor, fn func(referrers []ocispec.Descriptor) error) error {
+	if referrersLister, ok := s.repo.(ReferrersLister); ok {
+		return referrersLister.Referrers(ctx, desc, "", fn) //nolint:wrapcheck
+	}
+
+	referrers, err := registry.Referrers(ctx, s.repo, desc, "")
+	if err != nil {
+		return fmt.Errorf("failed to list referrers from predecessors: %w", err)
+	}
+
+	if len(referrers) == 0 {
+		return nil
+	}
+
+	return fn(referrers)
+}
+
 // extractReferrerFromManifest extracts the referrer data from a referrer manifest.
 func (s *store) extractReferrerFromManifest(ctx context.Context, manifestDesc ocispec.Descriptor, recordCID string) (*corev1.RecordReferrer, error) {
 	manifest, err := s.fetchAndParseManifestFromDescriptor(ctx, manifestDesc)
@@ -242,6 +265,11 @@ func (s *store) extractReferrerFromManifest(ctx context.Context, manifestDesc oc
 // MediaTypeReferrerMatcher creates a ReferrerMatcher that checks for a specific media type.
 func (s *store) MediaTypeReferrerMatcher(expectedMediaType string) ReferrerMatcher {
 	return func(ctx context.Context, referrer ocispec.Descriptor) bool {
+		// Referrers pushed with an artifact type can be matched without fetching the manifest
+		if referrer.ArtifactType == expectedMediaType {
+			return true
+		}
+
 		manifest, err := s.fetchAndParseManifestFromDescriptor(ctx, referrer)
 		if err != nil {
 			referrersLogger.Debug("Failed to fetch and parse referrer manifest", "digest", referrer.Digest.String(), "error", err)
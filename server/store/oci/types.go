@@ -16,6 +16,18 @@ const (
 	// SignatureArtifactType defines the internal OCI media type for signature layers.
 	SignatureArtifactType = "application/vnd.dev.cosign.simplesigning.v1+json"
 
+	// RevocationArtifactMediaType defines the internal OCI media type for signature revocation blobs.
+	RevocationArtifactMediaType = "application/vnd.agntcy.dir.revocation.v1+json"
+
+	// VerificationSnapshotArtifactMediaType defines the internal OCI media type for verification snapshot blobs.
+	VerificationSnapshotArtifactMediaType = "application/vnd.agntcy.dir.verification-snapshot.v1+json"
+
+	// AttestationArtifactMediaType defines the internal OCI media type for attestation blobs.
+	AttestationArtifactMediaType = "application/vnd.agntcy.dir.attestation.v1+json"
+
+	// AnnotationsArtifactMediaType defines the internal OCI media type for mutable annotation blobs.
+	AnnotationsArtifactMediaType = "application/vnd.agntcy.dir.annotations.v1+json"
+
 	// DefaultReferrerArtifactMediaType defines the default internal OCI media type for referrer blobs.
 	DefaultReferrerArtifactMediaType = "application/vnd.agntcy.dir.referrer.v1+json"
 )
@@ -27,6 +39,14 @@ func apiToOCIType(apiType string) string {
 		return SignatureArtifactType
 	case corev1.PublicKeyReferrerType:
 		return PublicKeyArtifactMediaType
+	case corev1.RevocationReferrerType:
+		return RevocationArtifactMediaType
+	case corev1.VerificationSnapshotReferrerType:
+		return VerificationSnapshotArtifactMediaType
+	case corev1.AttestationReferrerType:
+		return AttestationArtifactMediaType
+	case corev1.AnnotationsReferrerType:
+		return AnnotationsArtifactMediaType
 	default:
 		return DefaultReferrerArtifactMediaType
 	}
@@ -39,6 +59,14 @@ func ociToAPIType(ociType string) string {
 		return corev1.SignatureReferrerType
 	case PublicKeyArtifactMediaType:
 		return corev1.PublicKeyReferrerType
+	case RevocationArtifactMediaType:
+		return corev1.RevocationReferrerType
+	case VerificationSnapshotArtifactMediaType:
+		return corev1.VerificationSnapshotReferrerType
+	case AttestationArtifactMediaType:
+		return corev1.AttestationReferrerType
+	case AnnotationsArtifactMediaType:
+		return corev1.AnnotationsReferrerType
 	default:
 		return ociType // Return the original OCI type if not found
 	}
@@ -43,5 +43,17 @@ func NewORASRepository(cfg ociconfig.Config) (*remote.Repository, error) {
 		),
 	}
 
+	// Configure referrers capability
+	switch cfg.ReferrersMode {
+	case ociconfig.ReferrersModeAPI:
+		_ = repo.SetReferrersCapability(true)
+	case ociconfig.ReferrersModeTag:
+		_ = repo.SetReferrersCapability(false)
+	case "", ociconfig.ReferrersModeAuto:
+		// Capability is detected on first use
+	default:
+		return nil, fmt.Errorf("unsupported referrers mode: %s", cfg.ReferrersMode)
+	}
+
 	return repo, nil
 }
@@ -0,0 +1,22 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+const (
+	DefaultEnabled = true
+	DefaultTimeout = 30 * time.Second
+)
+
+// Config is the configuration of the store provider probe run at startup.
+// The probe writes, reads and deletes probe records to measure the latency
+// and capabilities of the provider, and warns about configuration mismatches.
+type Config struct {
+	// Enabled turns on the probe of the store provider at startup.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// Timeout of the whole probe. Startup is delayed by the probe at most by this duration.
+	Timeout time.Duration `json:"timeout,omitempty" mapstructure:"timeout"`
+}
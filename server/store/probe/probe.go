@@ -0,0 +1,229 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package probe measures the latency and capabilities of a store provider
+// by writing, reading and deleting probe records.
+package probe
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var logger = logging.Logger("store/probe")
+
+const (
+	// probeRecordName is the name of the probe records.
+	probeRecordName = "agntcy.dir/store-probe"
+
+	// minProbeSize is the size of the first large probe record.
+	// Larger probe records double in size up to the maximum received message size.
+	minProbeSize = 1024 * 1024
+)
+
+// Result holds the latency and capabilities of a store provider.
+type Result struct {
+	Provider      string
+	WriteLatency  time.Duration
+	ReadLatency   time.Duration
+	DeleteLatency time.Duration
+
+	// Referrers is set if the store supports referrers.
+	Referrers bool
+
+	// MaxRecordSize is the size in bytes of the largest probe record accepted by the store.
+	MaxRecordSize int
+
+	// Err is set if the store could not be probed.
+	Err error
+
+	// Warnings are mismatches between the configuration and the store capabilities.
+	Warnings []string
+}
+
+// Healthy reports whether the store was probed without error or warnings.
+func (r *Result) Healthy() bool {
+	return r.Err == nil && len(r.Warnings) == 0
+}
+
+// ToProto converts the result to its API representation.
+func (r *Result) ToProto() *corev1.StoreProbe {
+	probe := &corev1.StoreProbe{
+		Provider:      r.Provider,
+		WriteLatency:  durationpb.New(r.WriteLatency),
+		ReadLatency:   durationpb.New(r.ReadLatency),
+		DeleteLatency: durationpb.New(r.DeleteLatency),
+		Referrers:     r.Referrers,
+		MaxRecordSize: uint64(max(r.MaxRecordSize, 0)), //nolint:gosec // non-negative
+		Warnings:      r.Warnings,
+	}
+
+	if r.Err != nil {
+		probe.Error = r.Err.Error()
+	}
+
+	return probe
+}
+
+// Log logs the result, with a warning for each configuration mismatch.
+func (r *Result) Log() {
+	if r.Err != nil {
+		logger.Error("Failed to probe store provider", "provider", r.Provider, "error", r.Err)
+
+		return
+	}
+
+	logger.Info("Probed store provider",
+		"provider", r.Provider,
+		"write_latency", r.WriteLatency,
+		"read_latency", r.ReadLatency,
+		"delete_latency", r.DeleteLatency,
+		"referrers", r.Referrers,
+		"max_record_size", r.MaxRecordSize)
+
+	for _, warning := range r.Warnings {
+		logger.Warn("Store provider does not match the configuration", "provider", r.Provider, "warning", warning)
+	}
+}
+
+// Run probes the store provider. It measures the latency of writing, reading and
+// deleting a small record, checks the support of referrers, and writes larger
+// records up to maxRecvMsgSize to find the largest record accepted by the store.
+func Run(ctx context.Context, provider string, store types.StoreAPI, maxRecvMsgSize int) *Result {
+	result := &Result{Provider: provider}
+	nonce := strconv.FormatInt(time.Now().UnixNano(), 10)
+
+	record, err := newProbeRecord(nonce, 0)
+	if err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	if result.MaxRecordSize, err = recordSize(record); err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	start := time.Now()
+
+	ref, err := store.Push(ctx, record)
+	if err != nil {
+		result.Err = fmt.Errorf("failed to write probe record: %w", err)
+
+		return result
+	}
+
+	result.WriteLatency = time.Since(start)
+
+	start = time.Now()
+
+	if _, err := store.Pull(ctx, ref); err != nil {
+		result.Err = fmt.Errorf("failed to read probe record: %w", err)
+
+		return result
+	}
+
+	result.ReadLatency = time.Since(start)
+
+	if referrerStore, ok := store.(types.ReferrerStoreAPI); ok {
+		err := referrerStore.WalkReferrers(ctx, ref.GetCid(), "", func(*corev1.RecordReferrer) error { return nil })
+		result.Referrers = err == nil
+	}
+
+	if !result.Referrers {
+		result.Warnings = append(result.Warnings, "store does not support referrers, records cannot be signed")
+	}
+
+	start = time.Now()
+
+	if err := store.Delete(ctx, ref); err != nil {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("failed to delete probe record: %v", err))
+	} else {
+		result.DeleteLatency = time.Since(start)
+	}
+
+	for probeSize := minProbeSize; ; probeSize *= 2 {
+		probeSize = min(probeSize, maxRecvMsgSize)
+
+		accepted, err := probeRecordSize(ctx, store, nonce, probeSize)
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf(
+				"max_recv_msg_size of %d bytes exceeds the largest record of %d bytes accepted by the store: %v",
+				maxRecvMsgSize, result.MaxRecordSize, err))
+
+			break
+		}
+
+		result.MaxRecordSize = accepted
+
+		if probeSize >= maxRecvMsgSize {
+			break
+		}
+	}
+
+	return result
+}
+
+// probeRecordSize writes and deletes a probe record of about the given size.
+// Returns the size of the written record.
+func probeRecordSize(ctx context.Context, store types.StoreAPI, nonce string, size int) (int, error) {
+	empty, err := newProbeRecord(nonce, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	overhead, err := recordSize(empty)
+	if err != nil {
+		return 0, err
+	}
+
+	record, err := newProbeRecord(nonce, max(size-overhead, 0))
+	if err != nil {
+		return 0, err
+	}
+
+	ref, err := store.Push(ctx, record)
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	if err := store.Delete(ctx, ref); err != nil {
+		logger.Debug("Failed to delete probe record", "cid", ref.GetCid(), "error", err)
+	}
+
+	return recordSize(record)
+}
+
+// newProbeRecord creates a probe record padded with the given number of bytes.
+func newProbeRecord(nonce string, padding int) (*corev1.Record, error) {
+	data, err := structpb.NewStruct(map[string]any{
+		"name":    probeRecordName,
+		"nonce":   nonce,
+		"padding": strings.Repeat("0", padding),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create probe record: %w", err)
+	}
+
+	return &corev1.Record{Data: data}, nil
+}
+
+func recordSize(record *corev1.Record) (int, error) {
+	data, err := record.Marshal()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal probe record: %w", err)
+	}
+
+	return len(data), nil
+}
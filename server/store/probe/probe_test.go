@@ -0,0 +1,112 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package probe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore is an in-memory store rejecting records larger than maxSize.
+type memoryStore struct {
+	types.StoreAPI
+
+	maxSize int
+	records map[string]*corev1.Record
+}
+
+func newMemoryStore(maxSize int) *memoryStore {
+	return &memoryStore{maxSize: maxSize, records: make(map[string]*corev1.Record)}
+}
+
+func (s *memoryStore) Push(_ context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
+	size, err := recordSize(record)
+	if err != nil {
+		return nil, err
+	}
+
+	if size > s.maxSize {
+		return nil, fmt.Errorf("blob of %d bytes exceeds the registry limit", size)
+	}
+
+	s.records[record.GetCid()] = record
+
+	return &corev1.RecordRef{Cid: record.GetCid()}, nil
+}
+
+func (s *memoryStore) Pull(_ context.Context, ref *corev1.RecordRef) (*corev1.Record, error) {
+	record, ok := s.records[ref.GetCid()]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+
+	return record, nil
+}
+
+func (s *memoryStore) Delete(_ context.Context, ref *corev1.RecordRef) error {
+	delete(s.records, ref.GetCid())
+
+	return nil
+}
+
+// referrerStore is an in-memory store supporting referrers.
+type referrerStore struct {
+	*memoryStore
+}
+
+func (s *referrerStore) PushReferrer(context.Context, string, *corev1.RecordReferrer) error {
+	return nil
+}
+
+func (s *referrerStore) WalkReferrers(context.Context, string, string, func(*corev1.RecordReferrer) error) error {
+	return nil
+}
+
+func TestRun(t *testing.T) {
+	const maxRecvMsgSize = 4 * 1024 * 1024
+
+	store := &referrerStore{newMemoryStore(maxRecvMsgSize)}
+
+	result := Run(t.Context(), "memory", store, maxRecvMsgSize)
+	require.NoError(t, result.Err)
+	assert.True(t, result.Healthy())
+	assert.True(t, result.Referrers)
+	assert.Equal(t, maxRecvMsgSize, result.MaxRecordSize)
+	assert.Empty(t, store.records, "probe records must be deleted")
+
+	probe := result.ToProto()
+	assert.Equal(t, "memory", probe.GetProvider())
+	assert.Equal(t, uint64(maxRecvMsgSize), probe.GetMaxRecordSize())
+	assert.Empty(t, probe.GetError())
+}
+
+func TestRunMismatches(t *testing.T) {
+	// The store rejects records larger than 3 MiB but the server accepts 8 MiB messages
+	store := newMemoryStore(3 * 1024 * 1024)
+
+	result := Run(t.Context(), "memory", store, 8*1024*1024)
+	require.NoError(t, result.Err)
+	assert.False(t, result.Healthy())
+	assert.False(t, result.Referrers)
+	assert.Equal(t, 2*1024*1024, result.MaxRecordSize)
+	require.Len(t, result.Warnings, 2)
+	assert.Contains(t, result.Warnings[0], "referrers")
+	assert.Contains(t, result.Warnings[1], "max_recv_msg_size of 8388608 bytes")
+}
+
+func TestRunUnavailable(t *testing.T) {
+	store := newMemoryStore(0)
+
+	result := Run(t.Context(), "memory", store, 4*1024*1024)
+	require.Error(t, result.Err)
+	assert.False(t, result.Healthy())
+	assert.NotEmpty(t, result.ToProto().GetError())
+}
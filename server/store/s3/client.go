@@ -0,0 +1,154 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"os"
+
+	s3config "github.com/agntcy/dir/server/store/s3/config"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// minioClient implements objectClient for a bucket of an S3-compatible object store.
+type minioClient struct {
+	client *minio.Client
+	bucket string
+}
+
+var _ objectClient = (*minioClient)(nil)
+
+func newMinioClient(cfg s3config.Config) (*minioClient, error) {
+	secure := !cfg.TLS.Insecure
+
+	transport, err := minio.DefaultTransport(secure)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transport: %w", err)
+	}
+
+	if secure {
+		transport.TLSClientConfig.InsecureSkipVerify = cfg.TLS.SkipVerify //nolint:gosec
+
+		if cfg.TLS.CAFile != "" {
+			caPEM, err := os.ReadFile(cfg.TLS.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read CA file: %w", err)
+			}
+
+			rootCAs, err := x509.SystemCertPool()
+			if err != nil {
+				rootCAs = x509.NewCertPool()
+			}
+
+			if !rootCAs.AppendCertsFromPEM(caPEM) {
+				return nil, fmt.Errorf("no certificates found in CA file %s", cfg.TLS.CAFile)
+			}
+
+			transport.TLSClientConfig.RootCAs = rootCAs
+		}
+	}
+
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:     newCredentials(cfg),
+		Secure:    secure,
+		Region:    cfg.Region,
+		Transport: transport,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &minioClient{
+		client: client,
+		bucket: cfg.Bucket,
+	}, nil
+}
+
+// newCredentials returns the static credentials of the configuration if set,
+// or else the credentials of the environment.
+func newCredentials(cfg s3config.Config) *credentials.Credentials {
+	if cfg.AccessKeyID != "" {
+		return credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, cfg.SessionToken)
+	}
+
+	return credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.EnvAWS{},
+		&credentials.EnvMinio{},
+		&credentials.FileAWSCredentials{},
+		&credentials.IAM{},
+	})
+}
+
+func (c *minioClient) PutObject(ctx context.Context, key string, data []byte) error {
+	_, err := c.client.PutObject(ctx, c.bucket, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: "application/json",
+	})
+
+	return err //nolint:wrapcheck
+}
+
+func (c *minioClient) GetObject(ctx context.Context, key string) ([]byte, error) {
+	object, err := c.client.GetObject(ctx, c.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, notFound(err)
+	}
+	defer object.Close()
+
+	// Errors of the request are only returned once the object is read
+	data, err := io.ReadAll(object)
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	return data, nil
+}
+
+func (c *minioClient) StatObject(ctx context.Context, key string) error {
+	_, err := c.client.StatObject(ctx, c.bucket, key, minio.StatObjectOptions{})
+
+	return notFound(err)
+}
+
+func (c *minioClient) RemoveObject(ctx context.Context, key string) error {
+	return c.client.RemoveObject(ctx, c.bucket, key, minio.RemoveObjectOptions{}) //nolint:wrapcheck
+}
+
+func (c *minioClient) ListObjects(ctx context.Context, prefix string, listFn func(key string) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // Stops listing if listFn fails
+
+	for object := range c.client.ListObjects(ctx, c.bucket, minio.ListObjectsOptions{Prefix: prefix}) {
+		if object.Err != nil {
+			return object.Err //nolint:wrapcheck
+		}
+
+		if err := listFn(object.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *minioClient) BucketExists(ctx context.Context) (bool, error) {
+	return c.client.BucketExists(ctx, c.bucket) //nolint:wrapcheck
+}
+
+// notFound translates the errors of missing objects to errObjectNotFound.
+func notFound(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return errObjectNotFound
+	}
+
+	return err
+}
@@ -0,0 +1,48 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+const (
+	DefaultEndpoint = "127.0.0.1:9000"
+	DefaultBucket   = "dir"
+	DefaultPrefix   = "records/"
+)
+
+// Config is the configuration of an S3-compatible object store, such as AWS S3 or MinIO.
+type Config struct {
+	// Endpoint is the host and optional port of the object store, without scheme.
+	Endpoint string `json:"endpoint,omitempty" mapstructure:"endpoint"`
+
+	// Region of the bucket. Leave empty for object stores without regions.
+	Region string `json:"region,omitempty" mapstructure:"region"`
+
+	// Bucket holding the records. It must exist.
+	Bucket string `json:"bucket,omitempty" mapstructure:"bucket"`
+
+	// Prefix of the object keys of the records, allowing a bucket to be shared.
+	Prefix string `json:"prefix,omitempty" mapstructure:"prefix"`
+
+	// Static credentials. If empty, credentials are read from the AWS environment
+	// variables, the shared credentials file or the instance metadata service.
+	AccessKeyID     string `json:"access_key_id,omitempty" mapstructure:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key,omitempty" mapstructure:"secret_access_key"`
+	SessionToken    string `json:"session_token,omitempty" mapstructure:"session_token"`
+
+	// TLS configuration of the connection to the object store.
+	TLS TLSConfig `json:"tls,omitempty" mapstructure:"tls"`
+}
+
+// TLSConfig configures the connection to the object store.
+type TLSConfig struct {
+	// Insecure connects to the object store over plain HTTP.
+	Insecure bool `json:"insecure,omitempty" mapstructure:"insecure"`
+
+	// CAFile is the path to a PEM file with the CA certificates trusted
+	// in addition to the system roots, e.g. for a self-hosted MinIO.
+	CAFile string `json:"ca_file,omitempty" mapstructure:"ca_file"`
+
+	// SkipVerify disables the verification of the certificate of the object store.
+	// Only use for testing.
+	SkipVerify bool `json:"skip_verify,omitempty" mapstructure:"skip_verify"`
+}
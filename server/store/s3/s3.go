@@ -0,0 +1,236 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package s3 provides a store provider keeping records in an S3-compatible
+// object store, such as AWS S3 or MinIO, so that deployments do not need to
+// run an OCI registry for record storage.
+//
+// Each record is stored as a single object holding its canonical JSON, under
+// the configured prefix and named by its CID. Referrers are not supported.
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/store/oci"
+	s3config "github.com/agntcy/dir/server/store/s3/config"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var logger = logging.Logger("store/s3")
+
+// errObjectNotFound is returned by object clients for missing objects.
+var errObjectNotFound = errors.New("object not found")
+
+// objectClient is the subset of object store operations used by the store.
+type objectClient interface {
+	// PutObject writes an object, replacing any existing object with the same key.
+	PutObject(ctx context.Context, key string, data []byte) error
+
+	// GetObject reads an object, or returns errObjectNotFound.
+	GetObject(ctx context.Context, key string) ([]byte, error)
+
+	// StatObject checks that an object exists, or returns errObjectNotFound.
+	StatObject(ctx context.Context, key string) error
+
+	// RemoveObject removes an object. Removing a missing object is not an error.
+	RemoveObject(ctx context.Context, key string) error
+
+	// ListObjects calls listFn with the key of every object with the prefix.
+	ListObjects(ctx context.Context, prefix string, listFn func(key string) error) error
+
+	// BucketExists reports whether the bucket exists and is accessible.
+	BucketExists(ctx context.Context) (bool, error)
+}
+
+type store struct {
+	client objectClient
+	prefix string
+}
+
+// Compile-time interface checks to ensure store implements the supported capability interfaces.
+var (
+	_ types.StoreAPI    = (*store)(nil)
+	_ types.ListerStore = (*store)(nil)
+)
+
+func New(cfg s3config.Config) (types.StoreAPI, error) {
+	logger.Debug("Creating S3 store", "endpoint", cfg.Endpoint, "bucket", cfg.Bucket, "prefix", cfg.Prefix)
+
+	if cfg.Bucket == "" {
+		return nil, errors.New("bucket is required")
+	}
+
+	client, err := newMinioClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	return &store{
+		client: client,
+		prefix: cfg.Prefix,
+	}, nil
+}
+
+// key returns the object key of a record.
+func (s *store) key(cid string) string {
+	return s.prefix + cid
+}
+
+// Push writes the canonical JSON of a record to the object named by its CID.
+// Pushing an existing record does not rewrite it.
+func (s *store) Push(ctx context.Context, record *corev1.Record) (*corev1.RecordRef, error) {
+	recordBytes, err := record.Marshal()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal record: %v", err)
+	}
+
+	recordDigest, err := corev1.CalculateDigest(recordBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to calculate record digest: %v", err)
+	}
+
+	recordCID, err := corev1.ConvertDigestToCID(recordDigest)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert digest to CID: %v", err)
+	}
+
+	// Validate consistency: CID from record bytes digest should match CID from record
+	if expectedCID := record.GetCid(); recordCID != expectedCID {
+		return nil, status.Errorf(codes.Internal,
+			"CID mismatch: record digest CID (%s) != Record CID (%s)",
+			recordCID, expectedCID)
+	}
+
+	recordRef := &corev1.RecordRef{Cid: recordCID}
+
+	// Records are immutable, so existing objects are left untouched
+	err = s.client.StatObject(ctx, s.key(recordCID))
+	if err == nil {
+		logger.Info("Record already exists in S3 store", "cid", recordCID)
+
+		return recordRef, nil
+	}
+
+	if !errors.Is(err, errObjectNotFound) {
+		return nil, status.Errorf(codes.Unavailable, "failed to check record %s: %v", recordCID, err)
+	}
+
+	if err := s.client.PutObject(ctx, s.key(recordCID), recordBytes); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write record %s: %v", recordCID, err)
+	}
+
+	logger.Info("Record pushed to S3 store successfully", "cid", recordCID, "size", len(recordBytes))
+
+	return recordRef, nil
+}
+
+// Pull reads a record from its object and checks that its content matches its CID.
+func (s *store) Pull(ctx context.Context, ref *corev1.RecordRef) (*corev1.Record, error) {
+	if err := validateRecordRef(ref); err != nil {
+		return nil, err
+	}
+
+	cid := ref.GetCid()
+
+	data, err := s.client.GetObject(ctx, s.key(cid))
+	if errors.Is(err, errObjectNotFound) {
+		return nil, status.Errorf(codes.NotFound, "record not found: %s", cid)
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to read record %s: %v", cid, err)
+	}
+
+	record, err := corev1.UnmarshalRecord(data)
+	if err != nil {
+		return nil, status.Errorf(codes.DataLoss, "failed to unmarshal record for CID %s: %v", cid, err)
+	}
+
+	if record.GetCid() != cid {
+		return nil, status.Errorf(codes.DataLoss, "record object %s holds a record with CID %s", cid, record.GetCid())
+	}
+
+	return record, nil
+}
+
+// Lookup returns the metadata of a record. Objects carry no metadata of their own,
+// so the record is read to derive the same metadata as the OCI store.
+func (s *store) Lookup(ctx context.Context, ref *corev1.RecordRef) (*corev1.RecordMeta, error) {
+	record, err := s.Pull(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return oci.RecordMeta(record), nil
+}
+
+// Delete removes the object of a record.
+func (s *store) Delete(ctx context.Context, ref *corev1.RecordRef) error {
+	if err := validateRecordRef(ref); err != nil {
+		return err
+	}
+
+	cid := ref.GetCid()
+
+	err := s.client.StatObject(ctx, s.key(cid))
+	if errors.Is(err, errObjectNotFound) {
+		return status.Errorf(codes.NotFound, "record not found: %s", cid)
+	}
+
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to check record %s: %v", cid, err)
+	}
+
+	if err := s.client.RemoveObject(ctx, s.key(cid)); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete record %s: %v", cid, err)
+	}
+
+	logger.Info("Record deleted from S3 store", "cid", cid)
+
+	return nil
+}
+
+// List calls listFn with the reference of every record in the store.
+// Objects under the prefix that are not named by a CID are skipped.
+func (s *store) List(ctx context.Context, listFn func(*corev1.RecordRef) error) error {
+	return s.client.ListObjects(ctx, s.prefix, func(key string) error { //nolint:wrapcheck
+		cid := strings.TrimPrefix(key, s.prefix)
+		if !corev1.IsValidCID(cid) {
+			return nil
+		}
+
+		return listFn(&corev1.RecordRef{Cid: cid})
+	})
+}
+
+// IsReady checks that the bucket of the store is accessible.
+func (s *store) IsReady(ctx context.Context) bool {
+	exists, err := s.client.BucketExists(ctx)
+	if err != nil {
+		logger.Debug("Store not ready: failed to check bucket", "error", err)
+
+		return false
+	}
+
+	return exists
+}
+
+func validateRecordRef(ref *corev1.RecordRef) error {
+	if ref == nil {
+		return status.Error(codes.InvalidArgument, "record reference cannot be nil") //nolint:wrapcheck
+	}
+
+	if ref.GetCid() == "" {
+		return status.Error(codes.InvalidArgument, "record CID cannot be empty") //nolint:wrapcheck
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	typesv1alpha0 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/agntcy/oasf/types/v1alpha0"
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// memoryClient is an in-memory objectClient.
+type memoryClient struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	puts    int
+	err     error
+}
+
+func newMemoryClient() *memoryClient {
+	return &memoryClient{objects: map[string][]byte{}}
+}
+
+func (c *memoryClient) PutObject(_ context.Context, key string, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.objects[key] = data
+	c.puts++
+
+	return c.err
+}
+
+func (c *memoryClient) GetObject(_ context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	data, ok := c.objects[key]
+	if !ok {
+		return nil, errObjectNotFound
+	}
+
+	return data, nil
+}
+
+func (c *memoryClient) StatObject(ctx context.Context, key string) error {
+	_, err := c.GetObject(ctx, key)
+
+	return err
+}
+
+func (c *memoryClient) RemoveObject(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.objects, key)
+
+	return c.err
+}
+
+func (c *memoryClient) ListObjects(_ context.Context, prefix string, listFn func(key string) error) error {
+	c.mu.Lock()
+
+	var keys []string
+
+	for key := range c.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	c.mu.Unlock()
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := listFn(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *memoryClient) BucketExists(context.Context) (bool, error) {
+	return c.err == nil, c.err
+}
+
+func newTestRecord(name string) *corev1.Record {
+	return corev1.New(&typesv1alpha0.Record{
+		Name:          name,
+		Version:       "v1.0.0",
+		SchemaVersion: "v0.3.1",
+		Description:   "A test agent",
+	})
+}
+
+func TestStore_PushLookupPullDelete(t *testing.T) {
+	client := newMemoryClient()
+	s := &store{client: client, prefix: "records/"}
+	record := newTestRecord("test-agent")
+
+	ref, err := s.Push(t.Context(), record)
+	require.NoError(t, err)
+	assert.Equal(t, record.GetCid(), ref.GetCid())
+	assert.Contains(t, client.objects, "records/"+record.GetCid())
+
+	// Pushing an existing record does not rewrite it
+	_, err = s.Push(t.Context(), record)
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.puts)
+
+	meta, err := s.Lookup(t.Context(), ref)
+	require.NoError(t, err)
+	assert.Equal(t, record.GetCid(), meta.GetCid())
+	assert.Equal(t, "v0.3.1", meta.GetSchemaVersion())
+	assert.Equal(t, "test-agent", meta.GetAnnotations()["name"])
+
+	pulled, err := s.Pull(t.Context(), ref)
+	require.NoError(t, err)
+	assert.Equal(t, record.GetCid(), pulled.GetCid())
+
+	require.NoError(t, s.Delete(t.Context(), ref))
+	assert.Empty(t, client.objects)
+
+	_, err = s.Pull(t.Context(), ref)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.Lookup(t.Context(), ref)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	assert.Equal(t, codes.NotFound, status.Code(s.Delete(t.Context(), ref)))
+}
+
+func TestStore_PullCorruptedRecord(t *testing.T) {
+	client := newMemoryClient()
+	s := &store{client: client}
+
+	record := newTestRecord("test-agent")
+	other := newTestRecord("other-agent")
+
+	otherBytes, err := other.Marshal()
+	require.NoError(t, err)
+
+	// The object of the record holds another record
+	client.objects[record.GetCid()] = otherBytes
+
+	_, err = s.Pull(t.Context(), &corev1.RecordRef{Cid: record.GetCid()})
+	assert.Equal(t, codes.DataLoss, status.Code(err))
+}
+
+func TestStore_InvalidRef(t *testing.T) {
+	s := &store{client: newMemoryClient()}
+
+	_, err := s.Pull(t.Context(), nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.Lookup(t.Context(), &corev1.RecordRef{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(s.Delete(t.Context(), &corev1.RecordRef{})))
+}
+
+func TestStore_List(t *testing.T) {
+	client := newMemoryClient()
+	s := &store{client: client, prefix: "records/"}
+
+	var cids []string
+
+	for _, name := range []string{"agent-a", "agent-b"} {
+		ref, err := s.Push(t.Context(), newTestRecord(name))
+		require.NoError(t, err)
+
+		cids = append(cids, ref.GetCid())
+	}
+
+	// Objects not named by a CID are skipped
+	client.objects["records/README"] = []byte("not a record")
+
+	var listed []string
+
+	require.NoError(t, s.List(t.Context(), func(ref *corev1.RecordRef) error {
+		listed = append(listed, ref.GetCid())
+
+		return nil
+	}))
+	assert.ElementsMatch(t, cids, listed)
+}
+
+func TestStore_Unavailable(t *testing.T) {
+	client := newMemoryClient()
+	s := &store{client: client}
+
+	record := newTestRecord("test-agent")
+	client.err = errors.New("connection refused")
+
+	assert.False(t, s.IsReady(t.Context()))
+
+	_, err := s.Push(t.Context(), record)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = s.Pull(t.Context(), &corev1.RecordRef{Cid: record.GetCid()})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
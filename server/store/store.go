@@ -4,10 +4,15 @@
 package store
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/agntcy/dir/server/datastore"
+	"github.com/agntcy/dir/server/store/archive"
+	"github.com/agntcy/dir/server/store/cache"
 	"github.com/agntcy/dir/server/store/eventswrap"
 	"github.com/agntcy/dir/server/store/oci"
+	"github.com/agntcy/dir/server/store/s3"
 	"github.com/agntcy/dir/server/types"
 )
 
@@ -15,23 +20,104 @@ type Provider string
 
 const (
 	OCI = Provider("oci")
+	S3  = Provider("s3")
 )
 
-// TODO: add options for adding cache.
+// New creates the configured store provider wrapped with the configured storage tiers.
 func New(opts types.APIOptions) (types.StoreAPI, error) {
+	store, err := NewProvider(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return Wrap(opts, store)
+}
+
+// NewProvider creates the configured store provider without any storage tier or event emitter.
+func NewProvider(opts types.APIOptions) (types.StoreAPI, error) {
 	switch provider := Provider(opts.Config().Store.Provider); provider {
 	case OCI:
-		store, err := oci.New(opts.Config().Store.OCI)
+		ociStore, err := oci.New(opts.Config().Store.OCI)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create OCI store: %w", err)
 		}
 
-		// Wrap with event emitter
-		store = eventswrap.Wrap(store, opts.EventBus())
+		return ociStore, nil
 
-		return store, nil
+	case S3:
+		s3Store, err := s3.New(opts.Config().Store.S3)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create S3 store: %w", err)
+		}
+
+		return s3Store, nil
 
 	default:
 		return nil, fmt.Errorf("unsupported provider=%s", provider)
 	}
 }
+
+// Wrap wraps the store provider with the configured storage tiers and the event emitter.
+func Wrap(opts types.APIOptions, store types.StoreAPI) (types.StoreAPI, error) {
+	// Wrap with local cache tier
+	store, err := wrapCache(opts, store)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrap with archive storage class
+	store, err = wrapArchive(opts, store)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrap with event emitter
+	store = eventswrap.Wrap(store, opts.EventBus())
+
+	return store, nil
+}
+
+// wrapCache places a size-bounded local cache in front of the store if enabled.
+func wrapCache(opts types.APIOptions, store types.StoreAPI) (types.StoreAPI, error) {
+	cfg := opts.Config().Store.Cache
+	if !cfg.Enabled {
+		return store, nil
+	}
+
+	var dsOpts []datastore.Option
+	if cfg.Dir != "" {
+		dsOpts = append(dsOpts, datastore.WithFsProvider(cfg.Dir))
+	}
+
+	cacheDS, err := datastore.New(dsOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cache datastore: %w", err)
+	}
+
+	boundedDS, err := cache.NewBounded(context.Background(), cacheDS, cfg.MaxSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bounded cache datastore: %w", err)
+	}
+
+	return cache.Wrap(store, boundedDS), nil
+}
+
+// wrapArchive moves records that were not accessed recently to a cold archive if enabled.
+func wrapArchive(opts types.APIOptions, store types.StoreAPI) (types.StoreAPI, error) {
+	cfg := opts.Config().Store.Archive
+	if !cfg.Enabled {
+		return store, nil
+	}
+
+	var dsOpts []datastore.Option
+	if cfg.Dir != "" {
+		dsOpts = append(dsOpts, datastore.WithFsProvider(cfg.Dir))
+	}
+
+	archiveDS, err := datastore.New(dsOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create archive datastore: %w", err)
+	}
+
+	return archive.Wrap(context.Background(), store, archiveDS, cfg.After, cfg.ScanInterval), nil
+}
@@ -13,6 +13,11 @@ const (
 	DefaultSyncSchedulerInterval = 30 * time.Second
 	DefaultSyncWorkerCount       = 1
 	DefaultSyncWorkerTimeout     = 10 * time.Minute
+	DefaultSyncWorkerParallelism = 4
+	DefaultSyncInvitationTTL     = 24 * time.Hour
+
+	DefaultSyncReconciliationInterval      = 5 * time.Minute
+	DefaultSyncReconciliationItemThreshold = 64
 )
 
 type Config struct {
@@ -27,11 +32,22 @@ type Config struct {
 	// Worker timeout.
 	WorkerTimeout time.Duration `json:"worker_timeout,omitempty" mapstructure:"worker_timeout"`
 
+	// Worker parallelism.
+	// The maximum number of records fetched concurrently per sync.
+	// Records are committed to the database in order regardless of parallelism.
+	WorkerParallelism int `json:"worker_parallelism,omitempty" mapstructure:"worker_parallelism"`
+
 	// Registry monitor configuration
 	RegistryMonitor monitor.Config `json:"registry_monitor,omitempty" mapstructure:"registry_monitor"`
 
 	// Authentication configuration
 	AuthConfig `json:"auth_config,omitempty" mapstructure:"auth_config"`
+
+	// Invitations configuration
+	Invitations InvitationsConfig `json:"invitations,omitempty" mapstructure:"invitations"`
+
+	// Reconciliation configuration
+	Reconciliation ReconciliationConfig `json:"reconciliation,omitempty" mapstructure:"reconciliation"`
 }
 
 // AuthConfig represents the configuration for authentication.
@@ -39,3 +55,33 @@ type AuthConfig struct {
 	Username string `json:"username,omitempty" mapstructure:"username"`
 	Password string `json:"password,omitempty" mapstructure:"password"`
 }
+
+// InvitationsConfig represents the configuration for issuing sync invitations.
+// Invitations are disabled if no secret is set.
+type InvitationsConfig struct {
+	// Secret used to sign and verify invitation tokens.
+	Secret string `json:"secret,omitempty" mapstructure:"secret"`
+
+	// Directory URL advertised in invitation tokens.
+	// This is the address remote Directory nodes use to reach this node.
+	DirectoryURL string `json:"directory_url,omitempty" mapstructure:"directory_url"`
+
+	// Default validity period of invitations.
+	TTL time.Duration `json:"ttl,omitempty" mapstructure:"ttl"`
+}
+
+// ReconciliationConfig represents the configuration of differential sync.
+// When enabled, the CIDs stored locally are reconciled with the CIDs stored on the remote node,
+// and only the missing CIDs are synchronized instead of the whole remote catalog.
+// Records deleted on the remote node are not deleted locally.
+type ReconciliationConfig struct {
+	// Enable differential sync.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// Interval at which active syncs are reconciled with their remote node.
+	Interval time.Duration `json:"interval,omitempty" mapstructure:"interval"`
+
+	// Maximum number of CIDs the remote node returns for a mismatching range
+	// before splitting it into smaller ranges.
+	ItemThreshold int `json:"item_threshold,omitempty" mapstructure:"item_threshold"`
+}
@@ -0,0 +1,116 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package invitation implements the tokens used to invite remote Directory nodes to sync.
+//
+// A token encodes the address of the inviting node and the records to synchronize,
+// and is signed with HMAC-SHA256 using a secret only known to the inviting node.
+// The invited node reads the token to create the sync, and presents it back to the
+// inviting node when requesting registry credentials, which verifies it.
+package invitation
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// tokenPrefix identifies invitation tokens and their format version.
+const tokenPrefix = "dirinv1"
+
+// Invitation is the content of an invitation token.
+type Invitation struct {
+	// DirectoryURL is the address of the inviting Directory node.
+	DirectoryURL string `json:"directory_url"`
+
+	// CIDs are the records to synchronize. All records are synchronized if empty.
+	CIDs []string `json:"cids,omitempty"`
+
+	// ExpiresAt is the expiration time of the invitation, in seconds since the epoch.
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+// Expired reports whether the invitation is expired at the given time.
+func (i *Invitation) Expired(now time.Time) bool {
+	return now.Unix() >= i.ExpiresAt
+}
+
+// Issue returns the token of an invitation, signed with the secret.
+func Issue(secret string, invitation *Invitation) (string, error) {
+	if secret == "" {
+		return "", errors.New("invitation secret is required")
+	}
+
+	payload, err := json.Marshal(invitation)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode invitation: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+
+	return tokenPrefix + "." + encoded + "." + sign(secret, encoded), nil
+}
+
+// Parse returns the invitation encoded in a token, without verifying its signature.
+// It is used by invited nodes, which do not know the secret of the inviting node.
+func Parse(token string) (*Invitation, error) {
+	invitation, _, _, err := parse(token)
+
+	return invitation, err
+}
+
+// Verify returns the invitation encoded in a token after checking that
+// it was signed with the secret and is not expired at the given time.
+func Verify(secret, token string, now time.Time) (*Invitation, error) {
+	invitation, encoded, signature, err := parse(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == "" || !hmac.Equal([]byte(signature), []byte(sign(secret, encoded))) {
+		return nil, errors.New("invalid invitation signature")
+	}
+
+	if invitation.Expired(now) {
+		return nil, errors.New("invitation expired")
+	}
+
+	return invitation, nil
+}
+
+// parse splits a token and decodes its invitation.
+func parse(token string) (*Invitation, string, string, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 || parts[0] != tokenPrefix { //nolint:mnd
+		return nil, "", "", errors.New("malformed invitation token")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, "", "", errors.New("malformed invitation token")
+	}
+
+	var invitation Invitation
+	if err := json.Unmarshal(payload, &invitation); err != nil {
+		return nil, "", "", fmt.Errorf("malformed invitation: %w", err)
+	}
+
+	if invitation.DirectoryURL == "" {
+		return nil, "", "", errors.New("invitation has no directory URL")
+	}
+
+	return &invitation, parts[1], parts[2], nil
+}
+
+// sign returns the signature of an encoded invitation.
+func sign(secret, encoded string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(tokenPrefix + "." + encoded))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,67 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package invitation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueAndVerify(t *testing.T) {
+	now := time.Now()
+	invitation := &Invitation{
+		DirectoryURL: "dir.example.com:8888",
+		CIDs:         []string{"bafy1", "bafy2"},
+		ExpiresAt:    now.Add(time.Hour).Unix(),
+	}
+
+	token, err := Issue("secret", invitation)
+	require.NoError(t, err)
+
+	// Invited nodes read the invitation without the secret
+	parsed, err := Parse(token)
+	require.NoError(t, err)
+	assert.Equal(t, invitation, parsed)
+
+	verified, err := Verify("secret", token, now)
+	require.NoError(t, err)
+	assert.Equal(t, invitation, verified)
+
+	_, err = Verify("other-secret", token, now)
+	require.ErrorContains(t, err, "invalid invitation signature")
+
+	_, err = Verify("secret", token, now.Add(2*time.Hour))
+	require.ErrorContains(t, err, "invitation expired")
+}
+
+func TestVerifyTampered(t *testing.T) {
+	token, err := Issue("secret", &Invitation{DirectoryURL: "dir.example.com:8888", ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+
+	// Replace the invitation while keeping the signature
+	other, err := Issue("other-secret", &Invitation{DirectoryURL: "evil.example.com:8888", ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+
+	parts := strings.Split(token, ".")
+	otherParts := strings.Split(other, ".")
+
+	_, err = Verify("secret", strings.Join([]string{parts[0], otherParts[1], parts[2]}, "."), time.Now())
+	require.ErrorContains(t, err, "invalid invitation signature")
+}
+
+func TestParseMalformed(t *testing.T) {
+	for _, token := range []string{"", "dirinv1", "other.e30.sig", "dirinv1.!!!.sig", "dirinv1.e30.sig"} {
+		_, err := Parse(token)
+		assert.Error(t, err, token)
+	}
+}
+
+func TestIssueRequiresSecret(t *testing.T) {
+	_, err := Issue("", &Invitation{DirectoryURL: "dir.example.com:8888"})
+	require.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"time"
 
 	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/signpolicy"
 	"github.com/agntcy/dir/server/store/oci"
 	ociconfig "github.com/agntcy/dir/server/store/oci/config"
 	"github.com/agntcy/dir/server/sync/monitor/config"
@@ -35,6 +37,7 @@ type MonitorService struct {
 	store         types.StoreAPI
 	ociConfig     ociconfig.Config
 	checkInterval time.Duration
+	parallelism   int
 
 	// Monitoring state
 	mu            sync.RWMutex
@@ -44,14 +47,18 @@ type MonitorService struct {
 	cancelMonitor context.CancelFunc
 
 	// Sync management
-	activeSyncs map[string]struct{} // Track active sync operations
+	activeSyncs map[string]struct{}                 // Track active sync operations
+	sources     map[string]*remote.Repository       // Remote registries of active syncs, used to mirror referrers
+	mappings    map[string][]types.NamespaceMapping // Namespace mappings of active syncs, used to rename mirrored records
+	policies    map[string]types.SyncTrustPolicy    // Trust policies of active syncs, used to quarantine mirrored records
 
 	// ORAS repository client
 	repo *remote.Repository
 }
 
 // NewMonitorService creates a new monitor service.
-func NewMonitorService(db types.DatabaseAPI, store types.StoreAPI, ociConfig ociconfig.Config, monitorConfig config.Config) (*MonitorService, error) {
+// Parallelism bounds the number of records fetched concurrently when indexing changes.
+func NewMonitorService(db types.DatabaseAPI, store types.StoreAPI, ociConfig ociconfig.Config, monitorConfig config.Config, parallelism int) (*MonitorService, error) {
 	// Create ORAS repository client
 	repo, err := oci.NewORASRepository(ociConfig)
 	if err != nil {
@@ -63,7 +70,11 @@ func NewMonitorService(db types.DatabaseAPI, store types.StoreAPI, ociConfig oci
 		store:         store,
 		ociConfig:     ociConfig,
 		checkInterval: monitorConfig.CheckInterval,
+		parallelism:   max(parallelism, 1),
 		activeSyncs:   make(map[string]struct{}),
+		sources:       make(map[string]*remote.Repository),
+		mappings:      make(map[string][]types.NamespaceMapping),
+		policies:      make(map[string]types.SyncTrustPolicy),
 		repo:          repo,
 	}, nil
 }
@@ -92,6 +103,9 @@ func (s *MonitorService) Stop() error {
 
 	// Clear active syncs
 	s.activeSyncs = make(map[string]struct{})
+	s.sources = make(map[string]*remote.Repository)
+	s.mappings = make(map[string][]types.NamespaceMapping)
+	s.policies = make(map[string]types.SyncTrustPolicy)
 
 	logger.Info("Monitor service stopped")
 
@@ -99,12 +113,21 @@ func (s *MonitorService) Stop() error {
 }
 
 // StartSyncMonitoring begins monitoring when a sync operation starts.
-func (s *MonitorService) StartSyncMonitoring(syncID string) error {
+// The referrers of records synced from the source registry are mirrored when the records are indexed.
+func (s *MonitorService) StartSyncMonitoring(syncID string, source SyncSource) error {
+	sourceRepo, err := newSourceRepository(source, s.ociConfig.ReferrersMode)
+	if err != nil {
+		return fmt.Errorf("failed to create sync source repository client: %w", err)
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	// Add sync to active list
 	s.activeSyncs[syncID] = struct{}{}
+	s.sources[syncID] = sourceRepo
+	s.mappings[syncID] = source.NamespaceMappings
+	s.policies[syncID] = source.TrustPolicy
 
 	// Start monitoring if this is the first active sync
 	if len(s.activeSyncs) == 1 && !s.isRunning {
@@ -127,6 +150,18 @@ func (s *MonitorService) StopSyncMonitoring(syncID string) error {
 	// Remove sync from active list
 	delete(s.activeSyncs, syncID)
 
+	// Keep the sync source for the final indexing scans, as zot may still be syncing records from it
+	time.AfterFunc(config.DefaultCheckInterval*2, func() { //nolint:mnd
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, active := s.activeSyncs[syncID]; !active {
+			delete(s.sources, syncID)
+			delete(s.mappings, syncID)
+			delete(s.policies, syncID)
+		}
+	})
+
 	// Stop monitoring if no more active syncs
 	if len(s.activeSyncs) == 0 && s.isRunning {
 		// Cancel monitoring
@@ -339,55 +374,226 @@ func (s *MonitorService) detectChanges(oldSnapshot, newSnapshot *RegistrySnapsho
 }
 
 // processChanges processes detected registry changes by indexing new records.
+// Records are fetched concurrently and then added to the database in tag order.
 func (s *MonitorService) processChanges(ctx context.Context, changes *RegistryChanges) {
-	for _, tag := range changes.NewTags {
-		// Index record
-		if err := s.indexRecord(ctx, tag); err != nil {
+	startedAt := time.Now()
+	parallelism := min(s.parallelism, len(changes.NewTags))
+
+	fetched := s.fetchRecords(ctx, changes.NewTags, parallelism)
+
+	indexed := 0
+
+	for _, result := range fetched {
+		if result.err != nil {
 			// Warn but continue processing other records even if one fails
-			logger.Error("Failed to index record", "tag", tag, "error", err)
-		} else {
-			logger.Debug("Successfully indexed record", "tag", tag)
+			logger.Error("Failed to index record", "tag", result.tag, "error", result.err)
+
+			continue
 		}
 
-		// Upload public key to OCI store
-		if err := s.uploadPublicKey(ctx, tag); err != nil {
-			logger.Error("Failed to upload public key", "tag", tag, "error", err)
+		// Keep records failing the trust policy of their sync out of the index
+		if result.quarantine != "" {
+			if err := s.db.QuarantineRecord(result.syncID, result.tag, result.quarantine); err != nil {
+				logger.Error("Failed to quarantine record", "tag", result.tag, "error", err)
+			} else {
+				logger.Warn("Quarantined record failing sync trust policy", "tag", result.tag, "sync_id", result.syncID, "reason", result.quarantine)
+			}
+
+			continue
+		}
+
+		// Index record
+		if err := s.indexRecord(result.tag, result.record, result.syncID); err != nil {
+			logger.Error("Failed to index record", "tag", result.tag, "error", err)
+
+			continue
 		}
+
+		indexed++
+
+		logger.Debug("Successfully indexed record", "tag", result.tag)
 	}
+
+	s.updateSyncProgress(parallelism, indexed, time.Since(startedAt))
 }
 
-// indexRecord indexes a single record from the registry into the database.
-func (s *MonitorService) indexRecord(ctx context.Context, tag string) error {
-	logger.Debug("Indexing record", "tag", tag)
+// fetchedRecord holds the result of fetching a single record from the local store.
+type fetchedRecord struct {
+	tag    string
+	record *corev1.Record
+	err    error
+
+	// syncID is the sync the record was synced from, empty if unknown.
+	syncID string
+
+	// quarantine is the reason the record failed the trust policy of its sync, empty if it did not.
+	quarantine string
+}
+
+// fetchRecords fetches the records of the given tags using a bounded pool of workers.
+// The results are returned in the order of the tags.
+func (s *MonitorService) fetchRecords(ctx context.Context, tags []string, parallelism int) []fetchedRecord {
+	results := make([]fetchedRecord, len(tags))
+	slots := make(chan struct{}, parallelism)
+
+	var wg sync.WaitGroup
+
+	for i, tag := range tags {
+		slots <- struct{}{}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			record, err := s.fetchRecord(ctx, tag)
+			results[i] = fetchedRecord{tag: tag, record: record, err: err}
+
+			// Mirror signatures, attestations and public keys before they are used below
+			syncID, err := s.mirrorReferrers(ctx, tag)
+			if err != nil {
+				logger.Error("Failed to mirror record referrers", "tag", tag, "error", err)
+			}
+
+			results[i].syncID = syncID
+
+			// Verify signatures on the mirrored record, as renamed records are not covered by them
+			if results[i].err == nil {
+				results[i].quarantine = s.verifyTrust(ctx, syncID, tag)
+			}
+
+			// Index renamed records in place of mirrored records matching a namespace mapping
+			// Quarantined records are renamed when they are released
+			if results[i].err == nil && results[i].quarantine == "" && len(s.mappings[syncID]) > 0 {
+				results[i].record, results[i].err = RenameRecord(ctx, s.store, record, s.mappings[syncID])
+			}
+
+			// Upload public key to OCI store
+			if err := s.uploadPublicKey(ctx, tag); err != nil {
+				logger.Error("Failed to upload public key", "tag", tag, "error", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// verifyTrust checks a mirrored record against the trust policy of the sync it was synced from.
+// Returns the reason the record must be quarantined, empty if it passes the policy or was released after review.
+func (s *MonitorService) verifyTrust(ctx context.Context, syncID string, tag string) string {
+	policy := s.policies[syncID]
+	if !policy.Enabled() {
+		return ""
+	}
+
+	quarantined, err := s.db.GetQuarantinedRecord(tag)
+	if err != nil {
+		return fmt.Sprintf("failed to get quarantine status: %v", err)
+	}
+
+	if quarantined != nil && quarantined.IsReleased() {
+		return ""
+	}
+
+	refStore, ok := s.store.(types.ReferrerStoreAPI)
+	if !ok {
+		return "referrer storage not supported by current store implementation"
+	}
+
+	if err := signpolicy.CheckTrusted(ctx, refStore, tag, policy.TrustedPublicKeys); err != nil {
+		return err.Error()
+	}
+
+	return ""
+}
+
+// fetchRecord pulls and validates a single record from the local store.
+func (s *MonitorService) fetchRecord(ctx context.Context, tag string) (*corev1.Record, error) {
+	logger.Debug("Fetching record", "tag", tag)
 
 	// Pull record from local store
 	recordRef := &corev1.RecordRef{Cid: tag}
 
 	record, err := s.store.Pull(ctx, recordRef)
 	if err != nil {
-		return fmt.Errorf("failed to pull record from local store: %w", err)
+		return nil, fmt.Errorf("failed to pull record from local store: %w", err)
 	}
 
 	isValid, validationErrors, err := record.Validate()
 	if err != nil {
-		return fmt.Errorf("failed to validate record: %w", err)
+		return nil, fmt.Errorf("failed to validate record: %w", err)
+	}
+
+	if !isValid {
+		return nil, fmt.Errorf("record validation failed: %v", validationErrors)
+	}
+
+	return record, nil
+}
+
+// RenameRecord renames a mirrored record with the first matching namespace mapping and stores the renamed record.
+// The renamed record is a new record linked to the mirrored record through its previous_record_cid field.
+// The mirrored record is kept in the store with its referrers, but is not indexed.
+// Records matching no mapping are returned unchanged.
+func RenameRecord(ctx context.Context, store types.StoreAPI, record *corev1.Record, mappings []types.NamespaceMapping) (*corev1.Record, error) {
+	name := record.GetData().GetFields()["name"].GetStringValue()
+
+	localName, ok := types.MapRecordName(mappings, name)
+	if !ok {
+		return record, nil
+	}
+
+	patch, err := json.Marshal([]map[string]string{{"op": "replace", "path": "/name", "value": localName}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rename patch: %w", err)
+	}
+
+	renamed, err := record.ApplyPatch(patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename record: %w", err)
+	}
+
+	isValid, validationErrors, err := renamed.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate renamed record: %w", err)
 	}
 
 	if !isValid {
-		return fmt.Errorf("record validation failed: %v", validationErrors)
+		return nil, fmt.Errorf("renamed record validation failed: %v", validationErrors)
+	}
+
+	if _, err := store.Push(ctx, renamed); err != nil {
+		return nil, fmt.Errorf("failed to push renamed record: %w", err)
 	}
 
+	logger.Info("Renamed mirrored record", "cid", record.GetCid(), "name", name, "renamed_cid", renamed.GetCid(), "renamed_name", localName)
+
+	return renamed, nil
+}
+
+// indexRecord indexes a single fetched record into the database, with the sync it was synced from.
+// Records already indexed, e.g. pushed to this Directory or synced by another sync, get the sync added to their provenance.
+func (s *MonitorService) indexRecord(tag string, record *corev1.Record, syncID string) error {
+	logger.Debug("Indexing record", "tag", tag)
+
 	// Add to database
 	recordAdapter := adapters.NewRecordAdapter(record)
 	if err := s.db.AddRecord(recordAdapter); err != nil {
 		// Check if this is a duplicate record error - if so, it's not really an error
-		if s.isDuplicateRecordError(err) {
-			logger.Debug("Record already indexed, skipping", "cid", tag)
-
-			return nil
+		if !s.isDuplicateRecordError(err) {
+			return fmt.Errorf("failed to add record to database: %w", err)
 		}
 
-		return fmt.Errorf("failed to add record to database: %w", err)
+		logger.Debug("Record already indexed, adding provenance only", "cid", tag)
+	}
+
+	if syncID != "" {
+		if err := s.db.AddRecordProvenance(record.GetCid(), syncID); err != nil {
+			logger.Warn("Failed to add record provenance", "cid", record.GetCid(), "sync_id", syncID, "error", err)
+		}
 	}
 
 	logger.Info("Successfully indexed local record", "cid", tag)
@@ -395,6 +601,16 @@ func (s *MonitorService) indexRecord(ctx context.Context, tag string) error {
 	return nil
 }
 
+// updateSyncProgress records the fetch statistics of a monitoring check on all active syncs.
+// Records synced by zot cannot be attributed to a single sync, so every active sync is credited.
+func (s *MonitorService) updateSyncProgress(parallelism int, records int, duration time.Duration) {
+	for syncID := range s.activeSyncs {
+		if err := s.db.UpdateSyncProgress(syncID, parallelism, records, duration); err != nil {
+			logger.Warn("Failed to update sync progress", "sync_id", syncID, "error", err)
+		}
+	}
+}
+
 // uploadPublicKey uploads a public key to the OCI store.
 func (s *MonitorService) uploadPublicKey(ctx context.Context, tag string) error {
 	logger.Debug("Uploading public key", "tag", tag)
@@ -0,0 +1,84 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/agntcy/dir/server/store/oci"
+	ociconfig "github.com/agntcy/dir/server/store/oci/config"
+	syncconfig "github.com/agntcy/dir/server/sync/config"
+	"github.com/agntcy/dir/server/types"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote"
+)
+
+// SyncSource is the remote registry a sync operation pulls records from.
+// The referrers of synced records are mirrored from it.
+type SyncSource struct {
+	// RegistryURL is the address of the remote registry, with an optional http:// or https:// scheme.
+	RegistryURL string
+
+	// Credentials negotiated with the remote Directory node.
+	Credentials syncconfig.AuthConfig
+
+	// NamespaceMappings rename the records synced from the source into local namespaces.
+	NamespaceMappings []types.NamespaceMapping
+
+	// TrustPolicy quarantines the records synced from the source without a valid signature from its trust roots.
+	TrustPolicy types.SyncTrustPolicy
+}
+
+// newSourceRepository creates an ORAS repository client for the remote registry of a sync.
+// Registries without a scheme are accessed over plain HTTP, as done by zot sync.
+func newSourceRepository(source SyncSource, referrersMode string) (*remote.Repository, error) {
+	address := source.RegistryURL
+	insecure := !strings.HasPrefix(address, "https://")
+
+	address = strings.TrimPrefix(strings.TrimPrefix(address, "https://"), "http://")
+
+	return oci.NewORASRepository(ociconfig.Config{ //nolint:wrapcheck
+		RegistryAddress: address,
+		RepositoryName:  ociconfig.DefaultRepositoryName,
+		ReferrersMode:   referrersMode,
+		AuthConfig: ociconfig.AuthConfig{
+			Insecure: insecure,
+			Username: source.Credentials.Username,
+			Password: source.Credentials.Password,
+		},
+	})
+}
+
+// mirrorReferrers copies the referrers of a synced record from the sync sources to the local registry.
+// Referrers include signatures, attestations and public keys, so that mirrored records remain
+// verifiable on this node without contacting the origin. Referrers already present are skipped.
+// Returns the ID of the sync the record was synced from, empty if it is not found on any source.
+func (s *MonitorService) mirrorReferrers(ctx context.Context, tag string) (string, error) {
+	for syncID, source := range s.sources {
+		desc, err := source.Resolve(ctx, tag)
+		if err != nil {
+			if errors.Is(err, errdef.ErrNotFound) {
+				// The record was synced from another source
+				continue
+			}
+
+			return "", fmt.Errorf("failed to resolve record on sync %s source: %w", syncID, err)
+		}
+
+		// Copy the record graph and the graphs of all its (transitive) referrers
+		if err := oras.ExtendedCopyGraph(ctx, source, s.repo, desc, oras.DefaultExtendedCopyGraphOptions); err != nil {
+			return syncID, fmt.Errorf("failed to mirror referrers from sync %s source: %w", syncID, err)
+		}
+
+		logger.Debug("Mirrored record referrers", "tag", tag, "sync_id", syncID)
+
+		return syncID, nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,211 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package reconcile implements range-based set reconciliation of the CIDs stored on two Directory nodes.
+//
+// The sorted set of CIDs is split into ranges summarized by their count and fingerprint,
+// the XOR of the SHA-256 hashes of their CIDs. The destination node sends the summaries of its ranges
+// to the source node, which skips matching ranges, answers small mismatching ranges with their CIDs
+// and splits large ones into subranges the destination node compares in the next round.
+// Steady-state reconciliation of mostly identical sets therefore only transfers the summaries
+// of the few ranges containing differences, instead of the whole catalog.
+package reconcile
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	storev1 "github.com/agntcy/dir/api/store/v1"
+)
+
+const (
+	// DefaultItemThreshold is the maximum number of CIDs returned for a mismatching range before it is split.
+	DefaultItemThreshold = 64
+
+	// MaxItemThreshold bounds the item threshold requested by destination nodes.
+	MaxItemThreshold = 1024
+
+	// DefaultBranching is the number of subranges a large mismatching range is split into.
+	DefaultBranching = 16
+
+	// DefaultMaxRounds bounds the number of rounds of a reconciliation.
+	DefaultMaxRounds = 32
+)
+
+// ErrTooManyRounds is returned when a reconciliation does not converge within the maximum number of rounds.
+var ErrTooManyRounds = errors.New("reconciliation did not converge")
+
+// Set is a sorted set of CIDs.
+type Set struct {
+	cids []string
+}
+
+// NewSet creates a set of the given CIDs.
+func NewSet(cids []string) *Set {
+	sorted := slices.Clone(cids)
+	slices.Sort(sorted)
+
+	return &Set{cids: slices.Compact(sorted)}
+}
+
+// Len returns the number of CIDs in the set.
+func (s *Set) Len() int {
+	return len(s.cids)
+}
+
+// Contains reports whether the set contains the CID.
+func (s *Set) Contains(cid string) bool {
+	_, found := slices.BinarySearch(s.cids, cid)
+
+	return found
+}
+
+// Summarize returns the summary of the CIDs of the set in the range [lower, upper).
+// Empty bounds are unbounded.
+func (s *Set) Summarize(lower, upper string) *storev1.CIDRange {
+	return summarize(s.within(lower, upper), lower, upper)
+}
+
+// within returns the CIDs of the set in the range [lower, upper).
+func (s *Set) within(lower, upper string) []string {
+	start := sort.SearchStrings(s.cids, lower)
+
+	end := len(s.cids)
+	if upper != "" {
+		end = sort.SearchStrings(s.cids, upper)
+	}
+
+	if start >= end {
+		return nil
+	}
+
+	return s.cids[start:end]
+}
+
+// Respond compares the ranges summarized by the destination node with the set of the source node.
+// Mismatching ranges with at most threshold CIDs are answered with their CIDs,
+// larger ones are split into branching subranges summarized with the set.
+func Respond(set *Set, ranges []*storev1.CIDRange, threshold, branching int) *storev1.ReconcileCIDsResponse {
+	threshold = max(threshold, 1)
+	branching = max(branching, 2) //nolint:mnd
+
+	response := &storev1.ReconcileCIDsResponse{}
+
+	for _, remote := range ranges {
+		cids := set.within(remote.GetLower(), remote.GetUpper())
+		if equal(summarize(cids, remote.GetLower(), remote.GetUpper()), remote) {
+			continue
+		}
+
+		if len(cids) <= threshold {
+			response.Cids = append(response.Cids, cids...)
+
+			continue
+		}
+
+		response.Ranges = append(response.Ranges, split(cids, remote.GetLower(), remote.GetUpper(), branching)...)
+	}
+
+	return response
+}
+
+// ReconcileFunc sends a reconciliation request to the source node.
+type ReconcileFunc func(context.Context, *storev1.ReconcileCIDsRequest) (*storev1.ReconcileCIDsResponse, error)
+
+// Missing returns the sorted CIDs stored on the source node that are missing from the local set.
+// CIDs missing from the source node are not reported, as reconciliation only pulls records.
+func Missing(ctx context.Context, local *Set, reconcile ReconcileFunc, threshold uint32, maxRounds int) ([]string, error) {
+	var missing []string
+
+	ranges := []*storev1.CIDRange{local.Summarize("", "")}
+
+	for round := 0; len(ranges) > 0; round++ {
+		if round >= maxRounds {
+			return nil, fmt.Errorf("%w after %d rounds", ErrTooManyRounds, maxRounds)
+		}
+
+		response, err := reconcile(ctx, &storev1.ReconcileCIDsRequest{
+			Ranges:        ranges,
+			ItemThreshold: threshold,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile CIDs: %w", err)
+		}
+
+		for _, cid := range response.GetCids() {
+			if !local.Contains(cid) {
+				missing = append(missing, cid)
+			}
+		}
+
+		ranges = nil
+
+		for _, remote := range response.GetRanges() {
+			summary := local.Summarize(remote.GetLower(), remote.GetUpper())
+			if !equal(summary, remote) {
+				ranges = append(ranges, summary)
+			}
+		}
+	}
+
+	slices.Sort(missing)
+
+	return slices.Compact(missing), nil
+}
+
+// split splits the sorted CIDs of the range [lower, upper) into subranges of similar sizes.
+func split(cids []string, lower, upper string, branching int) []*storev1.CIDRange {
+	ranges := make([]*storev1.CIDRange, 0, branching)
+	size := (len(cids) + branching - 1) / branching
+
+	for start := 0; start < len(cids); start += size {
+		end := min(start+size, len(cids))
+
+		// Subranges cover the whole range, so that CIDs only stored on the destination node are compared too
+		subLower, subUpper := cids[start], upper
+		if start == 0 {
+			subLower = lower
+		}
+
+		if end < len(cids) {
+			subUpper = cids[end]
+		}
+
+		ranges = append(ranges, summarize(cids[start:end], subLower, subUpper))
+	}
+
+	return ranges
+}
+
+// summarize returns the summary of the sorted CIDs of the range [lower, upper).
+func summarize(cids []string, lower, upper string) *storev1.CIDRange {
+	fingerprint := make([]byte, sha256.Size)
+
+	for _, cid := range cids {
+		hash := sha256.Sum256([]byte(cid))
+		for i := range fingerprint {
+			fingerprint[i] ^= hash[i]
+		}
+	}
+
+	return &storev1.CIDRange{
+		Lower:       lower,
+		Upper:       upper,
+		Count:       uint64(len(cids)),
+		Fingerprint: fingerprint,
+	}
+}
+
+// equal reports whether two summaries of the same range match.
+func equal(a, b *storev1.CIDRange) bool {
+	if a.GetCount() != b.GetCount() {
+		return false
+	}
+
+	return a.GetCount() == 0 || bytes.Equal(a.GetFingerprint(), b.GetFingerprint())
+}
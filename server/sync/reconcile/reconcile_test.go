@@ -0,0 +1,139 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCIDs(prefix string, n int) []string {
+	cids := make([]string, n)
+	for i := range cids {
+		cids[i] = fmt.Sprintf("%s%05d", prefix, i)
+	}
+
+	return cids
+}
+
+// source returns a ReconcileFunc answering with the given set, counting rounds and transferred CIDs.
+func source(set *Set, rounds, transferred *int) ReconcileFunc {
+	return func(_ context.Context, req *storev1.ReconcileCIDsRequest) (*storev1.ReconcileCIDsResponse, error) {
+		*rounds++
+
+		resp := Respond(set, req.GetRanges(), int(req.GetItemThreshold()), DefaultBranching)
+		*transferred += len(resp.GetCids())
+
+		return resp, nil
+	}
+}
+
+func TestSetSummarize(t *testing.T) {
+	set := NewSet([]string{"c", "a", "b", "a"})
+
+	assert.Equal(t, 3, set.Len())
+	assert.True(t, set.Contains("b"))
+	assert.False(t, set.Contains("d"))
+
+	assert.EqualValues(t, 3, set.Summarize("", "").GetCount())
+	assert.EqualValues(t, 2, set.Summarize("b", "").GetCount())
+	assert.EqualValues(t, 1, set.Summarize("", "b").GetCount())
+	assert.EqualValues(t, 0, set.Summarize("x", "").GetCount())
+
+	// Fingerprints do not depend on the order of insertion
+	assert.Equal(t, set.Summarize("", "").GetFingerprint(), NewSet([]string{"b", "c", "a"}).Summarize("", "").GetFingerprint())
+}
+
+func TestMissing(t *testing.T) {
+	ctx := context.Background()
+	remote := testCIDs("cid-", 5000)
+
+	tests := []struct {
+		name        string
+		local       []string
+		wantMissing []string
+	}{
+		{
+			name:        "identical sets",
+			local:       remote,
+			wantMissing: nil,
+		},
+		{
+			name:        "empty local set",
+			local:       nil,
+			wantMissing: remote,
+		},
+		{
+			name:        "few missing CIDs",
+			local:       append(append(append([]string{}, remote[:10]...), remote[11:2500]...), remote[2501:]...),
+			wantMissing: []string{remote[10], remote[2500]},
+		},
+		{
+			name:        "local-only CIDs are not reported",
+			local:       append(append([]string{}, remote[1:]...), "cid-local-only"),
+			wantMissing: []string{remote[0]},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rounds, transferred int
+
+			missing, err := Missing(ctx, NewSet(tt.local), source(NewSet(remote), &rounds, &transferred), DefaultItemThreshold, DefaultMaxRounds)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMissing, missing)
+		})
+	}
+}
+
+func TestMissingTransfersOnlyDifferences(t *testing.T) {
+	remote := testCIDs("cid-", 100000)
+	local := append(append([]string{}, remote[:50000]...), remote[50001:]...)
+
+	var rounds, transferred int
+
+	missing, err := Missing(context.Background(), NewSet(local), source(NewSet(remote), &rounds, &transferred), DefaultItemThreshold, DefaultMaxRounds)
+	require.NoError(t, err)
+	assert.Equal(t, []string{remote[50000]}, missing)
+
+	// A single mismatching range is narrowed down instead of transferring the whole catalog
+	assert.LessOrEqual(t, transferred, DefaultItemThreshold)
+	assert.Less(t, rounds, 6)
+
+	t.Run("identical sets take a single round", func(t *testing.T) {
+		rounds, transferred = 0, 0
+
+		missing, err := Missing(context.Background(), NewSet(remote), source(NewSet(remote), &rounds, &transferred), DefaultItemThreshold, DefaultMaxRounds)
+		require.NoError(t, err)
+		assert.Empty(t, missing)
+		assert.Equal(t, 1, rounds)
+		assert.Zero(t, transferred)
+	})
+}
+
+func TestMissingErrors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("source error", func(t *testing.T) {
+		errSource := errors.New("unavailable")
+
+		_, err := Missing(ctx, NewSet(nil), func(context.Context, *storev1.ReconcileCIDsRequest) (*storev1.ReconcileCIDsResponse, error) {
+			return nil, errSource
+		}, DefaultItemThreshold, DefaultMaxRounds)
+		require.ErrorIs(t, err, errSource)
+	})
+
+	t.Run("too many rounds", func(t *testing.T) {
+		var rounds, transferred int
+
+		_, err := Missing(ctx, NewSet(nil), source(NewSet(testCIDs("cid-", 10000)), &rounds, &transferred), DefaultItemThreshold, 1)
+		require.ErrorIs(t, err, ErrTooManyRounds)
+	})
+}
@@ -19,14 +19,21 @@ type Scheduler struct {
 	db        types.SyncDatabaseAPI
 	workQueue chan<- synctypes.WorkItem
 	interval  time.Duration
+
+	// Active syncs are reconciled with their remote node every reconcileInterval, if set
+	reconcileInterval time.Duration
+	reconciledAt      map[string]time.Time
 }
 
 // NewScheduler creates a new scheduler instance.
-func NewScheduler(db types.SyncDatabaseAPI, workQueue chan<- synctypes.WorkItem, interval time.Duration) *Scheduler {
+// Active syncs are periodically reconciled with their remote node if reconcileInterval is positive.
+func NewScheduler(db types.SyncDatabaseAPI, workQueue chan<- synctypes.WorkItem, interval, reconcileInterval time.Duration) *Scheduler {
 	return &Scheduler{
-		db:        db,
-		workQueue: workQueue,
-		interval:  interval,
+		db:                db,
+		workQueue:         workQueue,
+		interval:          interval,
+		reconcileInterval: reconcileInterval,
+		reconciledAt:      make(map[string]time.Time),
 	}
 }
 
@@ -69,6 +76,11 @@ func (s *Scheduler) processPendingSyncs(ctx context.Context) {
 	if err := s.processPendingSyncDeletions(ctx); err != nil {
 		logger.Error("Failed to process pending sync deletions", "error", err)
 	}
+
+	// Process reconciliations of active syncs
+	if err := s.processSyncReconciliations(ctx); err != nil {
+		logger.Error("Failed to process sync reconciliations", "error", err)
+	}
 }
 
 // processPendingSyncCreations handles syncs that need to be created.
@@ -92,6 +104,9 @@ func (s *Scheduler) processPendingSyncCreations(ctx context.Context) error {
 			SyncID:             sync.GetID(),
 			RemoteDirectoryURL: sync.GetRemoteDirectoryURL(),
 			CIDs:               sync.GetCIDs(),
+			InvitationToken:    sync.GetInvitationToken(),
+			NamespaceMappings:  sync.GetNamespaceMappings(),
+			TrustPolicy:        sync.GetTrustPolicy(),
 		}
 
 		if err := s.dispatchWorkItem(ctx, workItem); err != nil {
@@ -99,9 +114,57 @@ func (s *Scheduler) processPendingSyncCreations(ctx context.Context) error {
 			if err := s.db.UpdateSyncStatus(sync.GetID(), storev1.SyncStatus_SYNC_STATUS_PENDING); err != nil {
 				logger.Error("Failed to revert sync status to PENDING", "sync_id", sync.GetID(), "error", err)
 			}
+
+			continue
 		}
+
+		// Creating the sync reconciles it already
+		s.reconciledAt[sync.GetID()] = time.Now()
+	}
+
+	return nil
+}
+
+// processSyncReconciliations dispatches the reconciliation of active syncs not reconciled for reconcileInterval.
+func (s *Scheduler) processSyncReconciliations(ctx context.Context) error {
+	if s.reconcileInterval <= 0 {
+		return nil
 	}
 
+	syncs, err := s.db.GetSyncsByStatus(storev1.SyncStatus_SYNC_STATUS_IN_PROGRESS)
+	if err != nil {
+		return fmt.Errorf("failed to get active syncs from database: %w", err)
+	}
+
+	active := make(map[string]time.Time, len(syncs))
+
+	for _, sync := range syncs {
+		reconciledAt := s.reconciledAt[sync.GetID()]
+		active[sync.GetID()] = reconciledAt
+
+		if time.Since(reconciledAt) < s.reconcileInterval {
+			continue
+		}
+
+		workItem := synctypes.WorkItem{
+			Type:               synctypes.WorkItemTypeSyncReconcile,
+			SyncID:             sync.GetID(),
+			RemoteDirectoryURL: sync.GetRemoteDirectoryURL(),
+			CIDs:               sync.GetCIDs(),
+		}
+
+		if err := s.dispatchWorkItem(ctx, workItem); err != nil {
+			logger.Error("Failed to dispatch reconcile work item", "sync_id", sync.GetID(), "error", err)
+
+			continue
+		}
+
+		active[sync.GetID()] = time.Now()
+	}
+
+	// Forget syncs that are no longer active
+	s.reconciledAt = active
+
 	return nil
 }
 
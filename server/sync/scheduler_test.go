@@ -0,0 +1,75 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	synctypes "github.com/agntcy/dir/server/sync/types"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSync struct {
+	types.SyncObject
+
+	id string
+}
+
+func (s *testSync) GetID() string                 { return s.id }
+func (s *testSync) GetRemoteDirectoryURL() string { return testRemoteURL }
+func (s *testSync) GetCIDs() []string             { return nil }
+
+// activeSyncDatabase reports the given syncs as active.
+type activeSyncDatabase struct {
+	types.SyncDatabaseAPI
+
+	active []types.SyncObject
+}
+
+func (d *activeSyncDatabase) GetSyncsByStatus(status storev1.SyncStatus) ([]types.SyncObject, error) {
+	if status == storev1.SyncStatus_SYNC_STATUS_IN_PROGRESS {
+		return d.active, nil
+	}
+
+	return nil, nil
+}
+
+func TestSchedulerReconciliation(t *testing.T) {
+	ctx := context.Background()
+	db := &activeSyncDatabase{active: []types.SyncObject{&testSync{id: "sync-1"}, &testSync{id: "sync-2"}}}
+
+	t.Run("disabled", func(t *testing.T) {
+		workQueue := make(chan synctypes.WorkItem, 10)
+
+		require.NoError(t, NewScheduler(db, workQueue, time.Second, 0).processSyncReconciliations(ctx))
+		assert.Empty(t, workQueue)
+	})
+
+	t.Run("active syncs are reconciled once per interval", func(t *testing.T) {
+		workQueue := make(chan synctypes.WorkItem, 10)
+		scheduler := NewScheduler(db, workQueue, time.Second, time.Hour)
+
+		require.NoError(t, scheduler.processSyncReconciliations(ctx))
+		require.Len(t, workQueue, 2)
+
+		item := <-workQueue
+		assert.Equal(t, synctypes.WorkItemTypeSyncReconcile, item.Type)
+		assert.Equal(t, testRemoteURL, item.RemoteDirectoryURL)
+		<-workQueue
+
+		// Not reconciled again before the interval elapses
+		require.NoError(t, scheduler.processSyncReconciliations(ctx))
+		assert.Empty(t, workQueue)
+
+		// Syncs no longer active are forgotten
+		db.active = db.active[:1]
+		require.NoError(t, scheduler.processSyncReconciliations(ctx))
+		assert.Len(t, scheduler.reconciledAt, 1)
+	})
+}
@@ -7,12 +7,14 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/agntcy/dir/server/events"
 	"github.com/agntcy/dir/server/sync/config"
 	"github.com/agntcy/dir/server/sync/monitor"
 	synctypes "github.com/agntcy/dir/server/sync/types"
 	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/watchdog"
 	"github.com/agntcy/dir/utils/logging"
 )
 
@@ -22,9 +24,11 @@ var logger = logging.Logger("sync")
 type Service struct {
 	db             types.DatabaseAPI
 	store          types.StoreAPI
+	routing        types.RoutingAPI
 	config         config.Config
 	monitorService *monitor.MonitorService
 	eventBus       *events.SafeEventBus
+	watchdog       *watchdog.Watchdog
 
 	scheduler *Scheduler
 	workers   []*Worker
@@ -34,8 +38,8 @@ type Service struct {
 }
 
 // New creates a new sync service.
-func New(db types.DatabaseAPI, store types.StoreAPI, opts types.APIOptions) (*Service, error) {
-	monitorService, err := monitor.NewMonitorService(db, store, opts.Config().Store.OCI, opts.Config().Sync.RegistryMonitor)
+func New(db types.DatabaseAPI, store types.StoreAPI, routing types.RoutingAPI, opts types.APIOptions) (*Service, error) {
+	monitorService, err := monitor.NewMonitorService(db, store, opts.Config().Store.OCI, opts.Config().Sync.RegistryMonitor, opts.Config().Sync.WorkerParallelism)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create registry monitor service: %w", err)
 	}
@@ -43,10 +47,14 @@ func New(db types.DatabaseAPI, store types.StoreAPI, opts types.APIOptions) (*Se
 	return &Service{
 		db:             db,
 		store:          store,
+		routing:        routing,
 		config:         opts.Config().Sync,
 		monitorService: monitorService,
 		eventBus:       opts.EventBus(),
-		stopCh:         make(chan struct{}),
+		watchdog: watchdog.New(opts.Config().Watchdog, func(stall watchdog.Stall) {
+			opts.EventBus().WorkerStalled(stall.Kind, stall.Name, stall.WorkerID, stall.LastCheckpoint)
+		}),
+		stopCh: make(chan struct{}),
 	}, nil
 }
 
@@ -58,14 +66,22 @@ func (s *Service) Start(ctx context.Context) error {
 	workQueue := make(chan synctypes.WorkItem, 100) //nolint:mnd
 
 	// Create and start scheduler
-	s.scheduler = NewScheduler(s.db, workQueue, s.config.SchedulerInterval)
+	var reconcileInterval time.Duration
+	if s.config.Reconciliation.Enabled {
+		reconcileInterval = s.config.Reconciliation.Interval
+	}
+
+	s.scheduler = NewScheduler(s.db, workQueue, s.config.SchedulerInterval, reconcileInterval)
 
 	// Create and start workers
 	s.workers = make([]*Worker, s.config.WorkerCount)
 	for i := range s.config.WorkerCount {
-		s.workers[i] = NewWorker(i, s.db, s.store, workQueue, s.config.WorkerTimeout, s.monitorService, s.eventBus)
+		s.workers[i] = NewWorker(i, s.db, s.store, s.routing, workQueue, s.config.WorkerTimeout, s.config.Reconciliation, s.monitorService, s.eventBus, s.watchdog, workQueue)
 	}
 
+	// Start watchdog cancelling and retrying stalled work items
+	s.watchdog.Start(ctx)
+
 	// Start scheduler
 	s.wg.Add(1)
 
@@ -99,6 +115,9 @@ func (s *Service) Stop() error {
 	close(s.stopCh)
 	s.wg.Wait()
 
+	// Stop watchdog, dropping the pending retries
+	s.watchdog.Stop()
+
 	// Stop monitor service
 	if err := s.monitorService.Stop(); err != nil {
 		logger.Error("Failed to stop monitor service", "error", err)
@@ -3,12 +3,26 @@
 
 package types
 
+import "github.com/agntcy/dir/server/types"
+
 // WorkItem represents a sync task to be processed by workers.
 type WorkItem struct {
 	Type               WorkItemType
 	SyncID             string
 	RemoteDirectoryURL string
 	CIDs               []string
+
+	// InvitationToken is presented to the remote node when requesting registry credentials.
+	InvitationToken string
+
+	// NamespaceMappings rename mirrored records into local namespaces.
+	NamespaceMappings []types.NamespaceMapping
+
+	// TrustPolicy quarantines mirrored records without a valid signature from its trust roots.
+	TrustPolicy types.SyncTrustPolicy
+
+	// Attempt is the number of times the item stalled and was retried by the watchdog.
+	Attempt int
 }
 
 // WorkItemType represents the type of sync task.
@@ -17,4 +31,7 @@ type WorkItemType string
 const (
 	WorkItemTypeSyncCreate WorkItemType = "sync-create"
 	WorkItemTypeSyncDelete WorkItemType = "sync-delete"
+
+	// WorkItemTypeSyncReconcile reconciles an active sync with its remote node, see config.ReconciliationConfig.
+	WorkItemTypeSyncReconcile WorkItemType = "sync-reconcile"
 )
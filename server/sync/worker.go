@@ -5,16 +5,21 @@ package sync
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	storev1 "github.com/agntcy/dir/api/store/v1"
 	"github.com/agntcy/dir/server/events"
 	ociconfig "github.com/agntcy/dir/server/store/oci/config"
 	syncconfig "github.com/agntcy/dir/server/sync/config"
 	"github.com/agntcy/dir/server/sync/monitor"
+	"github.com/agntcy/dir/server/sync/reconcile"
 	synctypes "github.com/agntcy/dir/server/sync/types"
 	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/watchdog"
 	zotutils "github.com/agntcy/dir/utils/zot"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -26,22 +31,34 @@ type Worker struct {
 	id             int
 	db             types.DatabaseAPI
 	store          types.StoreAPI
+	routing        types.RoutingAPI
 	workQueue      <-chan synctypes.WorkItem
 	timeout        time.Duration
+	reconciliation syncconfig.ReconciliationConfig
 	monitorService *monitor.MonitorService
 	eventBus       *events.SafeEventBus
+
+	// Stalled work items are retried through the retry queue
+	watchdog   *watchdog.Watchdog
+	retryQueue chan<- synctypes.WorkItem
 }
 
 // NewWorker creates a new worker instance.
-func NewWorker(id int, db types.DatabaseAPI, store types.StoreAPI, workQueue <-chan synctypes.WorkItem, timeout time.Duration, monitorService *monitor.MonitorService, eventBus *events.SafeEventBus) *Worker {
+// Only the CIDs missing locally are synchronized if reconciliation is enabled.
+// Work items stalled according to the watchdog are sent back to the retry queue, if the watchdog is set.
+func NewWorker(id int, db types.DatabaseAPI, store types.StoreAPI, routing types.RoutingAPI, workQueue <-chan synctypes.WorkItem, timeout time.Duration, reconciliation syncconfig.ReconciliationConfig, monitorService *monitor.MonitorService, eventBus *events.SafeEventBus, wd *watchdog.Watchdog, retryQueue chan<- synctypes.WorkItem) *Worker {
 	return &Worker{
 		id:             id,
 		db:             db,
 		store:          store,
+		routing:        routing,
 		workQueue:      workQueue,
 		timeout:        timeout,
+		reconciliation: reconciliation,
 		monitorService: monitorService,
 		eventBus:       eventBus,
+		watchdog:       wd,
+		retryQueue:     retryQueue,
 	}
 }
 
@@ -78,12 +95,18 @@ func (w *Worker) processWorkItem(ctx context.Context, item synctypes.WorkItem) {
 
 	switch item.Type {
 	case synctypes.WorkItemTypeSyncCreate:
-		// Emit SYNC_CREATED event when sync operation begins
-		w.eventBus.SyncCreated(item.SyncID, item.RemoteDirectoryURL)
+		// Emit SYNC_CREATED event when sync operation begins, but not when it is retried
+		if item.Attempt == 0 {
+			w.eventBus.SyncCreated(item.SyncID, item.RemoteDirectoryURL)
+		}
 
 		finalStatus = storev1.SyncStatus_SYNC_STATUS_IN_PROGRESS
 
-		err := w.addSync(workCtx, item)
+		err := w.supervise(workCtx, item, w.addSync)
+		if errors.Is(err, watchdog.ErrStalled) && w.retry(ctx, item) {
+			return
+		}
+
 		if err != nil {
 			logger.Error("Sync failed", "worker_id", w.id, "sync_id", item.SyncID, "error", err)
 
@@ -98,10 +121,22 @@ func (w *Worker) processWorkItem(ctx context.Context, item synctypes.WorkItem) {
 			w.eventBus.SyncCompleted(item.SyncID, item.RemoteDirectoryURL, recordCount)
 		}
 
+	case synctypes.WorkItemTypeSyncReconcile:
+		// Reconciliation failures are retried on the next cycle, the sync remains active
+		if err := w.supervise(workCtx, item, w.reconcileSync); err != nil {
+			logger.Error("Sync reconciliation failed", "worker_id", w.id, "sync_id", item.SyncID, "error", err)
+		}
+
+		return
+
 	case synctypes.WorkItemTypeSyncDelete:
 		finalStatus = storev1.SyncStatus_SYNC_STATUS_DELETED
 
-		err := w.deleteSync(workCtx, item)
+		err := w.supervise(workCtx, item, w.deleteSync)
+		if errors.Is(err, watchdog.ErrStalled) && w.retry(ctx, item) {
+			return
+		}
+
 		if err != nil {
 			logger.Error("Sync delete failed", "worker_id", w.id, "sync_id", item.SyncID, "error", err)
 
@@ -118,6 +153,36 @@ func (w *Worker) processWorkItem(ctx context.Context, item synctypes.WorkItem) {
 	}
 }
 
+// supervise runs a sync operation under the watchdog, which cancels it if it makes no progress
+// for the worker timeout. Returns watchdog.ErrStalled if the operation stalled.
+func (w *Worker) supervise(ctx context.Context, item synctypes.WorkItem, operation func(context.Context, synctypes.WorkItem) error) error {
+	var err error
+
+	if stallErr := w.watchdog.Supervise(ctx, "sync", item.SyncID, w.id, item.Attempt, w.timeout, func(ctx context.Context) {
+		err = operation(ctx, item)
+	}); stallErr != nil {
+		return stallErr //nolint:wrapcheck
+	}
+
+	return err
+}
+
+// retry sends a stalled work item back to the work queue after a backoff.
+// The status of the sync is left unchanged meanwhile.
+// Returns false if the work item exceeded the maximum number of retries.
+func (w *Worker) retry(ctx context.Context, item synctypes.WorkItem) bool {
+	attempt := item.Attempt
+	item.Attempt++
+
+	if !watchdog.Retry(ctx, w.watchdog, w.retryQueue, item, attempt) {
+		return false
+	}
+
+	logger.Warn("Sync work item stalled, retrying", "worker_id", w.id, "sync_id", item.SyncID, "type", item.Type, "attempt", item.Attempt)
+
+	return true
+}
+
 func (w *Worker) deleteSync(_ context.Context, item synctypes.WorkItem) error {
 	logger.Debug("Starting sync delete operation", "worker_id", w.id, "sync_id", item.SyncID, "remote_url", item.RemoteDirectoryURL)
 
@@ -148,8 +213,17 @@ func (w *Worker) deleteSync(_ context.Context, item synctypes.WorkItem) error {
 func (w *Worker) addSync(ctx context.Context, item synctypes.WorkItem) error {
 	logger.Debug("Starting sync operation", "worker_id", w.id, "sync_id", item.SyncID, "remote_url", item.RemoteDirectoryURL)
 
+	// Avoid syncing from nodes known to be incompatible
+	watchdog.Checkpoint(ctx, "checking remote compatibility")
+
+	if err := w.checkRemoteCompatibility(ctx, item.RemoteDirectoryURL); err != nil {
+		return err
+	}
+
 	// Negotiate credentials with remote node using RequestRegistryCredentials RPC
-	remoteRegistryURL, credentials, err := w.negotiateCredentials(ctx, item.RemoteDirectoryURL)
+	watchdog.Checkpoint(ctx, "negotiating credentials")
+
+	remoteRegistryURL, credentials, err := w.negotiateCredentials(ctx, item.RemoteDirectoryURL, item.InvitationToken)
 	if err != nil {
 		return fmt.Errorf("failed to negotiate credentials: %w", err)
 	}
@@ -163,15 +237,39 @@ func (w *Worker) addSync(ctx context.Context, item synctypes.WorkItem) error {
 	}
 
 	// Update zot configuration with sync extension to trigger sync
-	if err := zotutils.AddRegistryToSyncConfig(zotutils.DefaultZotConfigPath, remoteRegistryURL, ociconfig.DefaultRepositoryName, zotsyncconfig.Credentials{
+	zotCredentials := zotsyncconfig.Credentials{
 		Username: credentials.Username,
 		Password: credentials.Password,
-	}, item.CIDs); err != nil {
+	}
+
+	watchdog.Checkpoint(ctx, "configuring registry sync")
+
+	if w.reconciliation.Enabled {
+		// Only sync the CIDs missing locally instead of the whole remote catalog
+		missing, err := w.missingCIDs(ctx, item)
+		if err != nil {
+			return err
+		}
+
+		if err := zotutils.SetRegistrySyncCIDs(zotutils.DefaultZotConfigPath, remoteRegistryURL, ociconfig.DefaultRepositoryName, zotCredentials, missing); err != nil {
+			return fmt.Errorf("failed to add registry to zot sync: %w", err)
+		}
+	} else if err := zotutils.AddRegistryToSyncConfig(zotutils.DefaultZotConfigPath, remoteRegistryURL, ociconfig.DefaultRepositoryName, zotCredentials, item.CIDs); err != nil {
 		return fmt.Errorf("failed to add registry to zot sync: %w", err)
 	}
 
 	// Start monitoring the local registry for changes after Zot sync is configured
-	if err := w.monitorService.StartSyncMonitoring(item.SyncID); err != nil { //nolint:contextcheck
+	// Referrers of synced records (signatures, attestations, public keys) are mirrored from the remote registry
+	// Mirrored records are renamed into local namespaces as configured for the sync
+	// Mirrored records failing the trust policy of the sync are quarantined instead of indexed
+	watchdog.Checkpoint(ctx, "starting registry monitoring")
+
+	if err := w.monitorService.StartSyncMonitoring(item.SyncID, monitor.SyncSource{ //nolint:contextcheck
+		RegistryURL:       remoteRegistryURL,
+		Credentials:       credentials,
+		NamespaceMappings: item.NamespaceMappings,
+		TrustPolicy:       item.TrustPolicy,
+	}); err != nil {
 		return fmt.Errorf("failed to start registry monitoring: %w", err)
 	}
 
@@ -180,8 +278,111 @@ func (w *Worker) addSync(ctx context.Context, item synctypes.WorkItem) error {
 	return nil
 }
 
+// reconcileSync restricts the CIDs synchronized by zot from the remote registry of an active sync
+// to the CIDs stored on the remote node that are still missing locally.
+func (w *Worker) reconcileSync(ctx context.Context, item synctypes.WorkItem) error {
+	logger.Debug("Starting sync reconciliation", "worker_id", w.id, "sync_id", item.SyncID, "remote_url", item.RemoteDirectoryURL)
+
+	remoteRegistryURL, err := w.db.GetSyncRemoteRegistry(item.SyncID)
+	if err != nil {
+		return fmt.Errorf("failed to get remote registry URL: %w", err)
+	}
+
+	missing, err := w.missingCIDs(ctx, item)
+	if err != nil {
+		return err
+	}
+
+	// Credentials were stored when the sync was created
+	if err := zotutils.SetRegistrySyncCIDs(zotutils.DefaultZotConfigPath, remoteRegistryURL, ociconfig.DefaultRepositoryName, zotsyncconfig.Credentials{}, missing); err != nil {
+		return fmt.Errorf("failed to update zot sync: %w", err)
+	}
+
+	logger.Info("Sync reconciled", "worker_id", w.id, "sync_id", item.SyncID, "missing", len(missing))
+
+	return nil
+}
+
+// missingCIDs reconciles the CIDs stored locally with the CIDs stored on the remote Directory node,
+// and returns the remote CIDs missing locally, restricted to the CIDs of the sync if any.
+func (w *Worker) missingCIDs(ctx context.Context, item synctypes.WorkItem) ([]string, error) {
+	lister, ok := w.store.(types.ListerStore)
+	if !ok {
+		return nil, errors.New("listing records is not supported by current store implementation")
+	}
+
+	var local []string
+
+	watchdog.Checkpoint(ctx, "listing local records")
+
+	if err := lister.List(ctx, func(ref *corev1.RecordRef) error {
+		local = append(local, ref.GetCid())
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list local records: %w", err)
+	}
+
+	conn, err := grpc.NewClient(
+		item.RemoteDirectoryURL,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC connection to remote node %s: %w", item.RemoteDirectoryURL, err)
+	}
+	defer conn.Close()
+
+	syncClient := storev1.NewSyncServiceClient(conn)
+
+	watchdog.Checkpoint(ctx, "reconciling with remote node")
+
+	missing, err := reconcile.Missing(ctx, reconcile.NewSet(local), func(ctx context.Context, req *storev1.ReconcileCIDsRequest) (*storev1.ReconcileCIDsResponse, error) {
+		return syncClient.ReconcileCIDs(ctx, req)
+	}, uint32(max(w.reconciliation.ItemThreshold, 0)), reconcile.DefaultMaxRounds) //nolint:gosec // threshold is not negative
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconcile with remote node %s: %w", item.RemoteDirectoryURL, err)
+	}
+
+	if len(item.CIDs) == 0 {
+		return missing, nil
+	}
+
+	return slices.DeleteFunc(missing, func(cid string) bool {
+		return !slices.Contains(item.CIDs, cid)
+	}), nil
+}
+
+// checkRemoteCompatibility checks the capabilities advertised by the remote Directory node, if known.
+// The remote node is matched against known peers by its Directory API address.
+func (w *Worker) checkRemoteCompatibility(ctx context.Context, remoteDirectoryURL string) error {
+	if w.routing == nil {
+		return nil
+	}
+
+	peers, err := w.routing.ListPeers(ctx)
+	if err != nil {
+		// Capabilities are advisory, do not block the sync
+		logger.Debug("Failed to list peers for compatibility check", "worker_id", w.id, "error", err)
+
+		return nil
+	}
+
+	for _, peer := range peers {
+		if !slices.Contains(peer.GetAddrs(), remoteDirectoryURL) {
+			continue
+		}
+
+		if err := types.CheckPeerCompatibility(peer.GetCapabilities()); err != nil {
+			return fmt.Errorf("remote node %s: %w", remoteDirectoryURL, err)
+		}
+	}
+
+	return nil
+}
+
 // negotiateCredentials negotiates registry credentials with the remote Directory node.
-func (w *Worker) negotiateCredentials(ctx context.Context, remoteDirectoryURL string) (string, syncconfig.AuthConfig, error) {
+// The invitation token the sync was accepted from, if any, is presented to the remote node.
+func (w *Worker) negotiateCredentials(ctx context.Context, remoteDirectoryURL, invitationToken string) (string, syncconfig.AuthConfig, error) {
 	logger.Debug("Starting credential negotiation", "worker_id", w.id, "remote_url", remoteDirectoryURL)
 
 	// Create gRPC connection to the remote Directory node
@@ -203,6 +404,7 @@ func (w *Worker) negotiateCredentials(ctx context.Context, remoteDirectoryURL st
 	// Make the credential negotiation request
 	resp, err := syncClient.RequestRegistryCredentials(ctx, &storev1.RequestRegistryCredentialsRequest{
 		RequestingNodeId: requestingNodeID,
+		InvitationToken:  invitationToken,
 	})
 	if err != nil {
 		return "", syncconfig.AuthConfig{}, fmt.Errorf("failed to request registry credentials from %s: %w", remoteDirectoryURL, err)
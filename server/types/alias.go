@@ -0,0 +1,29 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "errors"
+
+// ErrAliasConflict is returned when an alias does not point to the expected record.
+var ErrAliasConflict = errors.New("alias does not point to the expected record")
+
+// ErrAliasNotFound is returned when an alias does not exist.
+var ErrAliasNotFound = errors.New("alias not found")
+
+type AliasObject interface {
+	GetName() string
+	GetTag() string
+	GetCID() string
+	GetUpdatedTime() string
+	GetUpdatedBy() string
+}
+
+type AliasChangeObject interface {
+	GetName() string
+	GetTag() string
+	GetPreviousCID() string
+	GetCID() string
+	GetChangedTime() string
+	GetChangedBy() string
+}
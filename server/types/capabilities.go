@@ -0,0 +1,45 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+)
+
+// APIVersion is the Directory API version served by this node.
+const APIVersion = "v1"
+
+// SupportedSchemaVersions lists the record schema versions this node can decode.
+// It must be kept in sync with the versions handled by adapters.RecordAdapter.
+var SupportedSchemaVersions = []string{"0.3.1", "0.7.0", "0.8.0"}
+
+// ErrIncompatiblePeer is returned when the capabilities advertised by a peer
+// do not allow communicating with it.
+var ErrIncompatiblePeer = errors.New("incompatible peer")
+
+// CheckPeerCompatibility checks that the capabilities advertised by a peer are compatible
+// with this node, i.e. that the peer serves this node's API version and decodes at least
+// one of its schema versions. Peers that have not advertised capabilities are assumed compatible.
+func CheckPeerCompatibility(capabilities *routingv1.PeerCapabilities) error {
+	if capabilities == nil {
+		return nil
+	}
+
+	if apiVersions := capabilities.GetApiVersions(); len(apiVersions) > 0 && !slices.Contains(apiVersions, APIVersion) {
+		return fmt.Errorf("%w: peer serves API versions %v, need %s", ErrIncompatiblePeer, apiVersions, APIVersion)
+	}
+
+	schemaVersions := capabilities.GetSchemaVersions()
+	if len(schemaVersions) > 0 && !slices.ContainsFunc(schemaVersions, func(version string) bool {
+		return slices.Contains(SupportedSchemaVersions, version)
+	}) {
+		return fmt.Errorf("%w: peer supports schema versions %v, need one of %v", ErrIncompatiblePeer, schemaVersions, SupportedSchemaVersions)
+	}
+
+	return nil
+}
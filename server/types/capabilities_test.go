@@ -0,0 +1,62 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPeerCompatibility(t *testing.T) {
+	tests := []struct {
+		name         string
+		capabilities *routingv1.PeerCapabilities
+		compatible   bool
+	}{
+		{
+			name:       "no capabilities advertised",
+			compatible: true,
+		},
+		{
+			name:         "empty capabilities",
+			capabilities: &routingv1.PeerCapabilities{},
+			compatible:   true,
+		},
+		{
+			name: "matching versions",
+			capabilities: &routingv1.PeerCapabilities{
+				ApiVersions:    []string{"v1", "v2"},
+				SchemaVersions: []string{"0.8.0", "0.9.0"},
+			},
+			compatible: true,
+		},
+		{
+			name: "unsupported API version",
+			capabilities: &routingv1.PeerCapabilities{
+				ApiVersions: []string{"v2"},
+			},
+		},
+		{
+			name: "no common schema version",
+			capabilities: &routingv1.PeerCapabilities{
+				ApiVersions:    []string{"v1"},
+				SchemaVersions: []string{"0.9.0"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.CheckPeerCompatibility(tt.capabilities)
+			if tt.compatible {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrIncompatiblePeer)
+			}
+		})
+	}
+}
@@ -5,7 +5,9 @@ package types
 
 import (
 	"context"
+	"time"
 
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
 	storev1 "github.com/agntcy/dir/api/store/v1"
 )
@@ -14,12 +16,42 @@ type DatabaseAPI interface {
 	// SearchDatabaseAPI handles management of the search database.
 	SearchDatabaseAPI
 
+	// RecordStatsDatabaseAPI handles management of record usage statistics.
+	RecordStatsDatabaseAPI
+
+	// ReferenceDatabaseAPI handles queries of the record reference graph.
+	ReferenceDatabaseAPI
+
+	// ValidationDatabaseAPI handles management of stored record validation results.
+	ValidationDatabaseAPI
+
+	// EmbargoDatabaseAPI handles management of record embargoes.
+	EmbargoDatabaseAPI
+
+	// OwnerDatabaseAPI handles management of record owners.
+	OwnerDatabaseAPI
+
+	// ScanDatabaseAPI handles management of record content scan verdicts.
+	ScanDatabaseAPI
+
+	// SignerDatabaseAPI handles management of the signer identities of records.
+	SignerDatabaseAPI
+
+	// ProvenanceDatabaseAPI handles management of the provenance of records.
+	ProvenanceDatabaseAPI
+
+	// AliasDatabaseAPI handles management of record aliases.
+	AliasDatabaseAPI
+
 	// SyncDatabaseAPI handles management of the sync database.
 	SyncDatabaseAPI
 
 	// PublicationDatabaseAPI handles management of the publication database.
 	PublicationDatabaseAPI
 
+	// WebhookDatabaseAPI handles management of record webhooks.
+	WebhookDatabaseAPI
+
 	// IsReady checks if the database connection is ready to serve traffic.
 	IsReady(context.Context) bool
 }
@@ -39,9 +71,116 @@ type SearchDatabaseAPI interface {
 	RemoveRecord(cid string) error
 }
 
+type RecordStatsDatabaseAPI interface {
+	// IncrementRecordPullCount increments the pull counter of a record by CID.
+	IncrementRecordPullCount(cid string) error
+
+	// GetRecordPullCount retrieves the pull counter of a record by CID.
+	GetRecordPullCount(cid string) (uint64, error)
+
+	// GetMostPulledRecordCIDs retrieves the CIDs of the limit most pulled records, most pulled first.
+	GetMostPulledRecordCIDs(limit int) ([]string, error)
+}
+
+type ReferenceDatabaseAPI interface {
+	// GetRecordDependencies retrieves the CIDs of records referenced by a record.
+	GetRecordDependencies(cid string) ([]string, error)
+
+	// GetRecordDependents retrieves the CIDs of records referencing a record.
+	GetRecordDependents(cid string) ([]string, error)
+}
+
+type ValidationDatabaseAPI interface {
+	// SetRecordValidation stores the result of validating a record with the given rules version.
+	SetRecordValidation(cid, rulesVersion string, validationErrors []string) error
+
+	// GetRecordValidation retrieves the rules version and errors of the last validation of a record.
+	// Returns an empty rules version if the record was not validated since it was indexed.
+	GetRecordValidation(cid string) (string, []string, error)
+}
+
+type EmbargoDatabaseAPI interface {
+	// AddEmbargoedRecord adds a record to the search index, owned by the given identity, hidden
+	// from the searches of other identities and with its publication held until the given time.
+	// The embargo is stored together with the record, so that the record is never visible without it.
+	// Returns ErrRecordIndexed if the record is already indexed.
+	AddEmbargoedRecord(record Record, owner string, until time.Time) error
+
+	// GetRecordEmbargo retrieves the embargo time and owner of a record.
+	// Returns a zero time if the record is not under embargo.
+	GetRecordEmbargo(cid string) (time.Time, string, error)
+}
+
+type OwnerDatabaseAPI interface {
+	// SetRecordOwner sets the identity owning a record, unless the record already has an owner.
+	SetRecordOwner(cid, owner string) error
+
+	// GetRecordOwner retrieves the identity owning a record.
+	// Returns an empty owner if the record has no owner.
+	GetRecordOwner(cid string) (string, error)
+}
+
+type ScanDatabaseAPI interface {
+	// SetRecordScan stores the verdict and findings of the content scan of a record.
+	SetRecordScan(cid, verdict string, findings []string) error
+
+	// GetRecordScan retrieves the verdict and findings of the last content scan of a record.
+	// Returns an empty verdict if the record was not scanned.
+	GetRecordScan(cid string) (string, []string, error)
+}
+
+type SignerDatabaseAPI interface {
+	// AddRecordSigners stores the signer identities extracted from a verified signature of a record.
+	AddRecordSigners(cid, signature string, identities []string) error
+
+	// RemoveRecordSigners removes the signer identities of a signature of a record, e.g. once revoked.
+	RemoveRecordSigners(cid, signature string) error
+
+	// GetRecordSigners retrieves the sorted signer identities of a record.
+	GetRecordSigners(cid string) ([]string, error)
+}
+
+type ProvenanceDatabaseAPI interface {
+	// AddRecordProvenance records that a record was added by a sync, or pushed to this Directory
+	// if syncID is empty. Provenance already recorded for the record is ignored.
+	AddRecordProvenance(cid, syncID string) error
+
+	// GetRecordProvenance retrieves the distinct provenance of records by CID, ordered by sync ID
+	// with the records pushed to this Directory first. Records without provenance are omitted.
+	GetRecordProvenance(cids []string) (map[string][]RecordProvenance, error)
+}
+
+type AliasDatabaseAPI interface {
+	// SetAlias points the name:tag alias to a record and appends the change to the alias history.
+	// If expectedCID is not nil, the alias is only changed if it points to that record, or does not
+	// exist when it is empty, and ErrAliasConflict is returned otherwise.
+	// Returns the CID the alias pointed to before the change, empty if it was created.
+	SetAlias(name, tag, cid string, expectedCID *string, actor string) (string, error)
+
+	// DeleteAlias deletes the name:tag alias and appends the deletion to the alias history.
+	// Returns ErrAliasNotFound if the alias does not exist.
+	DeleteAlias(name, tag, actor string) error
+
+	// GetAlias retrieves the name:tag alias. Returns ErrAliasNotFound if it does not exist.
+	GetAlias(name, tag string) (AliasObject, error)
+
+	// GetAliases retrieves the aliases of a record name, or all aliases if name is empty.
+	GetAliases(name string) ([]AliasObject, error)
+
+	// GetAliasHistory retrieves the changes of the aliases of a record name, oldest first,
+	// optionally only those of the given tag.
+	GetAliasHistory(name, tag string) ([]AliasChangeObject, error)
+}
+
 type SyncDatabaseAPI interface {
 	// CreateSync creates a new sync object in the database.
-	CreateSync(remoteURL string, cids []string) (string, error)
+	// Mirrored records are renamed with the first matching namespace mapping,
+	// and quarantined if they fail the trust policy.
+	CreateSync(remoteURL string, cids []string, mappings []NamespaceMapping, trustPolicy SyncTrustPolicy) (string, error)
+
+	// CreateInvitedSync creates a new sync object accepted from an invitation.
+	// The invitation token is presented to the remote node when requesting registry credentials.
+	CreateInvitedSync(remoteURL string, cids []string, invitationToken string, mappings []NamespaceMapping, trustPolicy SyncTrustPolicy) (string, error)
 
 	// GetSyncByID retrieves a sync object by its ID.
 	GetSyncByID(syncID string) (SyncObject, error)
@@ -58,16 +197,40 @@ type SyncDatabaseAPI interface {
 	// UpdateSyncRemoteRegistry updates the remote registry of a sync object.
 	UpdateSyncRemoteRegistry(syncID string, remoteRegistry string) error
 
+	// UpdateSyncProgress accumulates fetched records and fetch duration of a sync object,
+	// and raises its achieved parallelism if higher.
+	UpdateSyncProgress(syncID string, parallelism int, records int, duration time.Duration) error
+
 	// GetSyncRemoteRegistry retrieves the remote registry of a sync object.
 	GetSyncRemoteRegistry(syncID string) (string, error)
 
 	// DeleteSync deletes a sync object by its ID.
 	DeleteSync(syncID string) error
+
+	// QuarantineRecord quarantines a record imported by a sync that failed its trust policy.
+	// Records already quarantined are left unchanged.
+	QuarantineRecord(syncID, cid, reason string) error
+
+	// GetQuarantinedRecords retrieves the quarantined records that are not released,
+	// optionally only those imported by a sync.
+	GetQuarantinedRecords(syncID string, offset, limit int) ([]QuarantinedRecordObject, error)
+
+	// GetQuarantinedRecord retrieves a quarantined record by its CID.
+	// Returns nil if the record was never quarantined.
+	GetQuarantinedRecord(cid string) (QuarantinedRecordObject, error)
+
+	// ReleaseQuarantinedRecord marks a quarantined record as released.
+	ReleaseQuarantinedRecord(cid string) error
 }
 
 type PublicationDatabaseAPI interface {
 	// CreatePublication creates a new publication object in the database.
-	CreatePublication(request *routingv1.PublishRequest) (string, error)
+	// The publication is not processed before settleUntil, unless it is confirmed. A zero time does not delay it.
+	// The namespace and labels are only used to aggregate the publications in summaries.
+	CreatePublication(request *routingv1.PublishRequest, settleUntil time.Time, namespace string, labels []string) (string, error)
+
+	// ConfirmPublication ends the settle delay of a publication object.
+	ConfirmPublication(publicationID string) error
 
 	// GetPublicationByID retrieves a publication object by its ID.
 	GetPublicationByID(publicationID string) (PublicationObject, error)
@@ -75,12 +238,37 @@ type PublicationDatabaseAPI interface {
 	// GetPublications retrieves all publication objects.
 	GetPublications(offset, limit int) ([]PublicationObject, error)
 
+	// SummarizePublications counts the publications that are not completed, in total,
+	// by namespace and by label. Pending publications whose settle delay has not elapsed at now are settling.
+	SummarizePublications(now time.Time) (*PublicationSummary, error)
+
 	// GetPublicationsByStatus retrieves all publication objects by their status.
 	GetPublicationsByStatus(status routingv1.PublicationStatus) ([]PublicationObject, error)
 
 	// UpdatePublicationStatus updates an existing publication object's status in the database.
 	UpdatePublicationStatus(publicationID string, status routingv1.PublicationStatus) error
 
+	// UpdatePublicationSchedule updates the announcement schedule and progress of a publication object.
+	UpdatePublicationSchedule(publicationID string, schedule *routingv1.PublicationSchedule) error
+
 	// DeletePublication deletes a publication object by its ID.
 	DeletePublication(publicationID string) error
 }
+
+type WebhookDatabaseAPI interface {
+	// CreateRecordWebhook creates a new record webhook object in the database.
+	CreateRecordWebhook(cid, url string, eventTypes []eventsv1.EventType, secret, owner string) (string, error)
+
+	// GetRecordWebhookByID retrieves a record webhook object by its ID.
+	GetRecordWebhookByID(webhookID string) (RecordWebhookObject, error)
+
+	// GetRecordWebhooksByOwner retrieves the record webhook objects of an owner,
+	// optionally only those of the record with the given CID.
+	GetRecordWebhooksByOwner(owner, cid string) ([]RecordWebhookObject, error)
+
+	// GetRecordWebhooksByCID retrieves all record webhook objects of a record.
+	GetRecordWebhooksByCID(cid string) ([]RecordWebhookObject, error)
+
+	// DeleteRecordWebhook deletes a record webhook object by its ID.
+	DeleteRecordWebhook(webhookID string) error
+}
@@ -0,0 +1,34 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "strings"
+
+const (
+	// LicenseModuleName is the name of the record module declaring the record license.
+	LicenseModuleName = "license"
+
+	// LicenseModuleKey is the license module data key holding the SPDX license identifier.
+	LicenseModuleKey = "license"
+)
+
+// GetRecordLicense returns the license declared by the license module of the record data,
+// e.g. Apache-2.0, or an empty string if the record does not declare a license.
+func GetRecordLicense(data RecordData) string {
+	if data == nil {
+		return ""
+	}
+
+	for _, module := range data.GetModules() {
+		if module.GetName() != LicenseModuleName {
+			continue
+		}
+
+		if license, ok := module.GetData()[LicenseModuleKey].(string); ok {
+			return strings.TrimSpace(license)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,79 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/types/adapters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRecordLicense(t *testing.T) {
+	tests := []struct {
+		name       string
+		recordJSON string
+		want       string
+	}{
+		{
+			name: "license module",
+			recordJSON: `{
+				"name": "licensed-agent",
+				"version": "1.0.0",
+				"schema_version": "0.7.0",
+				"authors": ["test"],
+				"created_at": "2023-01-01T00:00:00Z",
+				"modules": [
+					{"name": "integration/mcp", "data": {"license": "MIT"}},
+					{"name": "license", "data": {"header": "Copyright", "license": " Apache-2.0 "}}
+				]
+			}`,
+			want: "Apache-2.0",
+		},
+		{
+			name: "license extension of older schema versions",
+			recordJSON: `{
+				"name": "licensed-agent",
+				"version": "1.0.0",
+				"schema_version": "v0.3.1",
+				"authors": ["test"],
+				"created_at": "2023-01-01T00:00:00Z",
+				"extensions": [
+					{"name": "license", "version": "v1.0.0", "data": {"license": "BSD-3-Clause"}}
+				]
+			}`,
+			want: "BSD-3-Clause",
+		},
+		{
+			name: "no license module",
+			recordJSON: `{
+				"name": "unlicensed-agent",
+				"version": "1.0.0",
+				"schema_version": "0.7.0",
+				"authors": ["test"],
+				"created_at": "2023-01-01T00:00:00Z"
+			}`,
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, err := corev1.UnmarshalRecord([]byte(tt.recordJSON))
+			require.NoError(t, err)
+
+			recordData, err := adapters.NewRecordAdapter(record).GetRecordData()
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, types.GetRecordLicense(recordData))
+		})
+	}
+
+	t.Run("nil data", func(t *testing.T) {
+		assert.Empty(t, types.GetRecordLicense(nil))
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "strings"
+
+// LocatorRegionAnnotation is the locator annotation holding the region hint of the locator,
+// i.e. the region the artifact is served from, e.g. eu-west-1.
+const LocatorRegionAnnotation = "region"
+
+// GetLocatorRegion returns the region hint of the locator, or an empty string if it has none.
+func GetLocatorRegion(locator Locator) string {
+	if locator == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(locator.GetAnnotations()[LocatorRegionAnnotation])
+}
+
+// ResolveLocator selects the locator of the given type nearest to the preferred regions, most preferred first.
+// Locators in the most preferred region are selected first, then locators without region hint,
+// and finally locators in other regions. Ties are broken by the order of the locators.
+// All locator types are considered if locatorType is empty.
+// It returns nil if there is no locator of the type, and whether the locator is in a preferred region.
+func ResolveLocator(locators []Locator, locatorType string, preferredRegions []string) (Locator, bool) {
+	var (
+		best     Locator
+		bestRank int
+	)
+
+	for _, locator := range locators {
+		if locatorType != "" && !strings.EqualFold(locator.GetType(), locatorType) {
+			continue
+		}
+
+		rank := locatorRank(locator, preferredRegions)
+		if best == nil || rank < bestRank {
+			best, bestRank = locator, rank
+		}
+	}
+
+	return best, best != nil && bestRank < len(preferredRegions)
+}
+
+// locatorRank ranks a locator by the index of its region in the preferred regions.
+// Locators without region hint rank after all preferred regions, and locators in other regions last.
+func locatorRank(locator Locator, preferredRegions []string) int {
+	region := GetLocatorRegion(locator)
+	if region == "" {
+		return len(preferredRegions)
+	}
+
+	for i, preferred := range preferredRegions {
+		if strings.EqualFold(region, strings.TrimSpace(preferred)) {
+			return i
+		}
+	}
+
+	return len(preferredRegions) + 1
+}
@@ -0,0 +1,74 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/types/adapters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveLocator(t *testing.T) {
+	recordJSON := `{
+		"name": "multi-region-agent",
+		"version": "1.0.0",
+		"schema_version": "0.7.0",
+		"authors": ["test"],
+		"created_at": "2023-01-01T00:00:00Z",
+		"locators": [
+			{"type": "docker_image", "url": "https://us.example.com/agent", "annotations": {"region": "us-east-1"}},
+			{"type": "docker_image", "url": "https://example.com/agent"},
+			{"type": "docker_image", "url": "https://eu.example.com/agent", "annotations": {"region": "EU-West-1"}},
+			{"type": "source_code", "url": "https://github.com/example/agent", "annotations": {"region": "eu-west-1"}}
+		]
+	}`
+
+	record, err := corev1.UnmarshalRecord([]byte(recordJSON))
+	require.NoError(t, err)
+
+	recordData, err := adapters.NewRecordAdapter(record).GetRecordData()
+	require.NoError(t, err)
+
+	locators := recordData.GetLocators()
+
+	tests := []struct {
+		name          string
+		locatorType   string
+		regions       []string
+		wantURL       string
+		wantPreferred bool
+	}{
+		{"most preferred region", "docker_image", []string{"eu-west-1", "us-east-1"}, "https://eu.example.com/agent", true},
+		{"next preferred region", "docker_image", []string{"ap-south-1", "us-east-1"}, "https://us.example.com/agent", true},
+		{"locators without region before other regions", "docker_image", []string{"ap-south-1"}, "https://example.com/agent", false},
+		{"first locator without preference", "", nil, "https://example.com/agent", false},
+		{"all types", "", []string{"eu-west-1"}, "https://eu.example.com/agent", true},
+		{"type filter", "source_code", []string{"us-east-1"}, "https://github.com/example/agent", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locator, preferred := types.ResolveLocator(locators, tt.locatorType, tt.regions)
+			require.NotNil(t, locator)
+			assert.Equal(t, tt.wantURL, locator.GetURL())
+			assert.Equal(t, tt.wantPreferred, preferred)
+		})
+	}
+
+	t.Run("no locator of the type", func(t *testing.T) {
+		locator, preferred := types.ResolveLocator(locators, "helm_chart", []string{"eu-west-1"})
+		assert.Nil(t, locator)
+		assert.False(t, preferred)
+	})
+
+	t.Run("region hint", func(t *testing.T) {
+		assert.Equal(t, "us-east-1", types.GetLocatorRegion(locators[0]))
+		assert.Empty(t, types.GetLocatorRegion(locators[1]))
+		assert.Empty(t, types.GetLocatorRegion(nil))
+	})
+}
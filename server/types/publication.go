@@ -4,6 +4,9 @@
 package types
 
 import (
+	"sort"
+	"time"
+
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
 )
 
@@ -13,4 +16,111 @@ type PublicationObject interface {
 	GetStatus() routingv1.PublicationStatus
 	GetCreatedTime() string
 	GetLastUpdateTime() string
+	GetSchedule() *routingv1.PublicationSchedule
+	GetSettleUntil() time.Time
+	GetNamespace() string
+	GetLabels() []string
+}
+
+// PublicationCounts counts publications by their processing state.
+type PublicationCounts struct {
+	Queued     uint32
+	Settling   uint32
+	InProgress uint32
+	Failed     uint32
+}
+
+// ToProto converts the counts to their API representation.
+func (c *PublicationCounts) ToProto() *routingv1.PublicationCounts {
+	return &routingv1.PublicationCounts{
+		Queued:     c.Queued,
+		Settling:   c.Settling,
+		InProgress: c.InProgress,
+		Failed:     c.Failed,
+	}
+}
+
+// add counts a publication with the given status.
+// Pending publications are settling until their settle delay has elapsed.
+func (c *PublicationCounts) add(status routingv1.PublicationStatus, settling bool) {
+	switch status {
+	case routingv1.PublicationStatus_PUBLICATION_STATUS_PENDING:
+		if settling {
+			c.Settling++
+		} else {
+			c.Queued++
+		}
+	case routingv1.PublicationStatus_PUBLICATION_STATUS_IN_PROGRESS:
+		c.InProgress++
+	case routingv1.PublicationStatus_PUBLICATION_STATUS_FAILED:
+		c.Failed++
+	case routingv1.PublicationStatus_PUBLICATION_STATUS_UNSPECIFIED, routingv1.PublicationStatus_PUBLICATION_STATUS_COMPLETED:
+	}
+}
+
+// PublicationSummary aggregates the publication counts in total, by namespace and by label.
+type PublicationSummary struct {
+	Total      PublicationCounts
+	Namespaces map[string]*PublicationCounts
+	Labels     map[string]*PublicationCounts
+}
+
+// NewPublicationSummary creates an empty publication summary.
+func NewPublicationSummary() *PublicationSummary {
+	return &PublicationSummary{
+		Namespaces: make(map[string]*PublicationCounts),
+		Labels:     make(map[string]*PublicationCounts),
+	}
+}
+
+// Add counts a publication in the total, under its namespace and under each of its labels.
+// Completed publications are not counted.
+func (s *PublicationSummary) Add(namespace string, labels []string, status routingv1.PublicationStatus, settleUntil, now time.Time) {
+	if status == routingv1.PublicationStatus_PUBLICATION_STATUS_COMPLETED || status == routingv1.PublicationStatus_PUBLICATION_STATUS_UNSPECIFIED {
+		return
+	}
+
+	settling := settleUntil.After(now)
+
+	s.Total.add(status, settling)
+	summaryGroup(s.Namespaces, namespace).add(status, settling)
+
+	for _, label := range labels {
+		summaryGroup(s.Labels, label).add(status, settling)
+	}
+}
+
+// ToProto converts the summary to its API representation, with groups sorted by name.
+func (s *PublicationSummary) ToProto() *routingv1.GetPublicationSummaryResponse {
+	return &routingv1.GetPublicationSummaryResponse{
+		Total:      s.Total.ToProto(),
+		Namespaces: summaryGroupsToProto(s.Namespaces),
+		Labels:     summaryGroupsToProto(s.Labels),
+	}
+}
+
+func summaryGroup(groups map[string]*PublicationCounts, name string) *PublicationCounts {
+	counts, ok := groups[name]
+	if !ok {
+		counts = &PublicationCounts{}
+		groups[name] = counts
+	}
+
+	return counts
+}
+
+func summaryGroupsToProto(groups map[string]*PublicationCounts) []*routingv1.PublicationSummaryGroup {
+	result := make([]*routingv1.PublicationSummaryGroup, 0, len(groups))
+	for name, counts := range groups {
+		result = append(result, &routingv1.PublicationSummaryGroup{
+			Name:   name,
+			Counts: counts.ToProto(),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetName() < result[j].GetName()
+	})
+
+	return result
 }
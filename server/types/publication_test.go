@@ -0,0 +1,50 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicationSummary(t *testing.T) {
+	now := time.Now()
+	summary := types.NewPublicationSummary()
+
+	summary.Add("example.org", []string{"/skills/AI", "/domains/research"}, routingv1.PublicationStatus_PUBLICATION_STATUS_PENDING, time.Time{}, now)
+	summary.Add("example.org", []string{"/skills/AI"}, routingv1.PublicationStatus_PUBLICATION_STATUS_PENDING, now.Add(time.Hour), now)
+	summary.Add("other.org", []string{"/skills/AI"}, routingv1.PublicationStatus_PUBLICATION_STATUS_IN_PROGRESS, time.Time{}, now)
+	summary.Add("", nil, routingv1.PublicationStatus_PUBLICATION_STATUS_FAILED, time.Time{}, now)
+
+	// Completed publications are not part of the backlog
+	summary.Add("example.org", []string{"/skills/AI"}, routingv1.PublicationStatus_PUBLICATION_STATUS_COMPLETED, time.Time{}, now)
+
+	assert.Equal(t, types.PublicationCounts{Queued: 1, Settling: 1, InProgress: 1, Failed: 1}, summary.Total)
+
+	resp := summary.ToProto()
+
+	namespaces := resp.GetNamespaces()
+	require.Len(t, namespaces, 3)
+	assert.Empty(t, namespaces[0].GetName())
+	assert.Equal(t, uint32(1), namespaces[0].GetCounts().GetFailed())
+	assert.Equal(t, "example.org", namespaces[1].GetName())
+	assert.Equal(t, uint32(1), namespaces[1].GetCounts().GetQueued())
+	assert.Equal(t, uint32(1), namespaces[1].GetCounts().GetSettling())
+	assert.Equal(t, "other.org", namespaces[2].GetName())
+	assert.Equal(t, uint32(1), namespaces[2].GetCounts().GetInProgress())
+
+	labels := resp.GetLabels()
+	require.Len(t, labels, 2)
+	assert.Equal(t, "/domains/research", labels[0].GetName())
+	assert.Equal(t, uint32(1), labels[0].GetCounts().GetQueued())
+	assert.Equal(t, "/skills/AI", labels[1].GetName())
+	assert.Equal(t, uint32(1), labels[1].GetCounts().GetQueued())
+	assert.Equal(t, uint32(1), labels[1].GetCounts().GetSettling())
+	assert.Equal(t, uint32(1), labels[1].GetCounts().GetInProgress())
+}
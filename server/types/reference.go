@@ -0,0 +1,152 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// Record reference types and operations for the reference graph.
+// Records reference other records they depend on or are composed of,
+// which is indexed at push time and queried as a directed graph.
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// DependenciesAnnotation is the record annotation listing CIDs of records
+	// the record depends on, separated by commas.
+	DependenciesAnnotation = "dependencies"
+
+	// ModuleRecordCIDKey is the module data key holding the CID of a record
+	// the module is composed of.
+	ModuleRecordCIDKey = "record_cid"
+
+	// ModuleRecordCIDsKey is the module data key holding a list of CIDs of
+	// records the module is composed of.
+	ModuleRecordCIDsKey = "record_cids"
+
+	// MaxReferenceGraphNodes limits the number of records visited by a single graph walk.
+	MaxReferenceGraphNodes = 1000
+)
+
+// ErrReferenceGraphTooLarge is returned when a graph walk exceeds MaxReferenceGraphNodes.
+var ErrReferenceGraphTooLarge = errors.New("reference graph too large")
+
+// ReferenceEdge is a directed edge of the record reference graph.
+type ReferenceEdge struct {
+	From string
+	To   string
+}
+
+// GetRecordReferences extracts the CIDs of records referenced by the record data.
+// References are taken from the dependencies annotation and from module data.
+// The result is deduplicated, keeps the order of appearance and never contains the record itself.
+func GetRecordReferences(recordCID string, data RecordData) []string {
+	if data == nil {
+		return nil
+	}
+
+	var references []string
+
+	add := func(cid string) {
+		cid = strings.TrimSpace(cid)
+		if cid == "" || cid == recordCID || slices.Contains(references, cid) {
+			return
+		}
+
+		references = append(references, cid)
+	}
+
+	// Dependencies declared via annotation
+	if dependencies, ok := data.GetAnnotations()[DependenciesAnnotation]; ok {
+		for _, cid := range strings.Split(dependencies, ",") {
+			add(cid)
+		}
+	}
+
+	// Compositions declared via module data
+	for _, module := range data.GetModules() {
+		moduleData := module.GetData()
+
+		if cid, ok := moduleData[ModuleRecordCIDKey].(string); ok {
+			add(cid)
+		}
+
+		if cids, ok := moduleData[ModuleRecordCIDsKey].([]any); ok {
+			for _, value := range cids {
+				if cid, ok := value.(string); ok {
+					add(cid)
+				}
+			}
+		}
+	}
+
+	return references
+}
+
+// WalkReferenceGraph walks the reference graph starting at root using next to
+// resolve adjacent records. If recursive is false, only the edges of root are returned.
+// It returns the traversed edges in depth-first order and the cycles found, each
+// given as the list of records on the cycle starting at the record closing it.
+func WalkReferenceGraph(root string, recursive bool, next func(cid string) ([]string, error)) ([]ReferenceEdge, [][]string, error) {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+
+	var (
+		edges  []ReferenceEdge
+		cycles [][]string
+		state  = map[string]int{}
+		path   []string
+	)
+
+	var walk func(cid string) error
+
+	walk = func(cid string) error {
+		if len(state) >= MaxReferenceGraphNodes {
+			return fmt.Errorf("%w: more than %d records", ErrReferenceGraphTooLarge, MaxReferenceGraphNodes)
+		}
+
+		state[cid] = inProgress
+		path = append(path, cid)
+
+		adjacent, err := next(cid)
+		if err != nil {
+			return err
+		}
+
+		for _, adj := range adjacent {
+			edges = append(edges, ReferenceEdge{From: cid, To: adj})
+
+			switch state[adj] {
+			case inProgress:
+				// Back edge, the records on the path since adj form a cycle
+				start := slices.Index(path, adj)
+				cycles = append(cycles, slices.Clone(path[start:]))
+			case unvisited:
+				if !recursive {
+					continue
+				}
+
+				if err := walk(adj); err != nil {
+					return err
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[cid] = done
+
+		return nil
+	}
+
+	if err := walk(root); err != nil {
+		return nil, nil, err
+	}
+
+	return edges, cycles, nil
+}
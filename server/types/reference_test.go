@@ -0,0 +1,108 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/types/adapters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRecordReferences(t *testing.T) {
+	recordJSON := `{
+		"name": "test-composite-agent",
+		"version": "1.0.0",
+		"schema_version": "0.7.0",
+		"authors": ["test"],
+		"created_at": "2023-01-01T00:00:00Z",
+		"annotations": {
+			"dependencies": "cid-a, cid-b,,cid-a"
+		},
+		"modules": [
+			{
+				"name": "integration/agent-composition",
+				"data": {
+					"record_cid": "cid-c",
+					"record_cids": ["cid-b", "cid-d", 42]
+				}
+			}
+		]
+	}`
+
+	record, err := corev1.UnmarshalRecord([]byte(recordJSON))
+	require.NoError(t, err)
+
+	recordData, err := adapters.NewRecordAdapter(record).GetRecordData()
+	require.NoError(t, err)
+
+	t.Run("annotations and modules", func(t *testing.T) {
+		references := types.GetRecordReferences("cid-self", recordData)
+		assert.Equal(t, []string{"cid-a", "cid-b", "cid-c", "cid-d"}, references)
+	})
+
+	t.Run("self references are ignored", func(t *testing.T) {
+		references := types.GetRecordReferences("cid-c", recordData)
+		assert.Equal(t, []string{"cid-a", "cid-b", "cid-d"}, references)
+	})
+
+	t.Run("nil data", func(t *testing.T) {
+		assert.Empty(t, types.GetRecordReferences("cid-self", nil))
+	})
+}
+
+func TestWalkReferenceGraph(t *testing.T) {
+	graph := map[string][]string{
+		"a": {"b", "c"},
+		"b": {"d"},
+		"c": {"d", "a"},
+		"d": {},
+	}
+
+	next := func(cid string) ([]string, error) {
+		return graph[cid], nil
+	}
+
+	t.Run("direct references", func(t *testing.T) {
+		edges, cycles, err := types.WalkReferenceGraph("a", false, next)
+		require.NoError(t, err)
+
+		assert.Equal(t, []types.ReferenceEdge{{From: "a", To: "b"}, {From: "a", To: "c"}}, edges)
+		assert.Empty(t, cycles)
+	})
+
+	t.Run("recursive with cycle", func(t *testing.T) {
+		edges, cycles, err := types.WalkReferenceGraph("a", true, next)
+		require.NoError(t, err)
+
+		assert.Equal(t, []types.ReferenceEdge{
+			{From: "a", To: "b"},
+			{From: "b", To: "d"},
+			{From: "a", To: "c"},
+			{From: "c", To: "d"},
+			{From: "c", To: "a"},
+		}, edges)
+		assert.Equal(t, [][]string{{"a", "c"}}, cycles)
+	})
+
+	t.Run("resolve error", func(t *testing.T) {
+		_, _, err := types.WalkReferenceGraph("a", true, func(string) ([]string, error) {
+			return nil, errors.New("boom")
+		})
+		require.ErrorContains(t, err, "boom")
+	})
+
+	t.Run("graph too large", func(t *testing.T) {
+		// Every record references a new record, forming an endless chain
+		_, _, err := types.WalkReferenceGraph("0", true, func(cid string) ([]string, error) {
+			return []string{fmt.Sprintf("%s0", cid)}, nil
+		})
+		require.ErrorIs(t, err, types.ErrReferenceGraphTooLarge)
+	})
+}
@@ -6,6 +6,7 @@ package types
 import (
 	"context"
 
+	corev1 "github.com/agntcy/dir/api/core/v1"
 	routingv1 "github.com/agntcy/dir/api/routing/v1"
 	"github.com/libp2p/go-libp2p/core/peer"
 )
@@ -21,9 +22,17 @@ type RoutingAPI interface {
 	// List all records that this peer is currently providing (local-only operation)
 	List(context.Context, *routingv1.ListRequest) (<-chan *routingv1.ListResponse, error)
 
+	// Lookup returns the labels this peer is currently announcing for a record (local-only operation).
+	// Returns a NotFound error if the record is not published by this peer.
+	Lookup(context.Context, *corev1.RecordRef) (*routingv1.ListResponse, error)
+
 	// Search for records across the network using cached remote announcements
 	Search(context.Context, *routingv1.SearchRequest) (<-chan *routingv1.SearchResponse, error)
 
+	// ListPeers returns the peers known to this node along with the capabilities they
+	// advertised (local-only operation). Peers without advertised capabilities have none set.
+	ListPeers(context.Context) ([]*routingv1.Peer, error)
+
 	// Unpublish record from the network
 	// The caller must wrap concrete record types (e.g. *corev1.Record) with adapters.NewRecordAdapter()
 	Unpublish(context.Context, Record) error
@@ -0,0 +1,16 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// Verdicts of the content scanning of a record.
+const (
+	// ScanVerdictClean is the verdict of records without findings.
+	ScanVerdictClean = "clean"
+
+	// ScanVerdictFlagged is the verdict of records with findings that are still published.
+	ScanVerdictFlagged = "flagged"
+
+	// ScanVerdictBlocked is the verdict of records with findings whose publication is blocked.
+	ScanVerdictBlocked = "blocked"
+)
@@ -3,9 +3,34 @@
 
 package types
 
+import (
+	"errors"
+	"time"
+)
+
+// ErrAnnotationNotIndexed is returned when filtering by an annotation key
+// that is not declared as indexed in the database configuration.
+var ErrAnnotationNotIndexed = errors.New("annotation is not indexed")
+
+// ErrRecordIndexed is returned when adding a record under embargo that is already indexed.
+var ErrRecordIndexed = errors.New("record is already indexed")
+
+// Record fields that can be selected with WithFields.
+const (
+	RecordFieldName        = "name"
+	RecordFieldVersion     = "version"
+	RecordFieldCreatedAt   = "created_at"
+	RecordFieldSkills      = "skills"
+	RecordFieldLocators    = "locators"
+	RecordFieldModules     = "modules"
+	RecordFieldDomains     = "domains"
+	RecordFieldAnnotations = "annotations"
+)
+
 type RecordFilters struct {
 	Limit        int
 	Offset       int
+	CIDs         []string
 	Name         string
 	Version      string
 	SkillIDs     []uint64
@@ -15,6 +40,31 @@ type RecordFilters struct {
 	ModuleNames  []string
 	DomainIDs    []uint64
 	DomainNames  []string
+	Annotations  map[string][]string
+	Licenses     []string
+	Signers      []string
+
+	// PreferredRegions ranks records with a locator in an earlier region first.
+	PreferredRegions []string
+
+	// CreatedBefore excludes records added to the database after this time, if set.
+	CreatedBefore time.Time
+
+	// UpdatedSince excludes records not added or updated after this time, if set.
+	UpdatedSince time.Time
+
+	// EmbargoedAt excludes records under embargo at this time, if set,
+	// unless they were pushed by EmbargoViewer.
+	EmbargoedAt   time.Time
+	EmbargoViewer string
+
+	// Fields restricts the record fields loaded by GetRecords, if set.
+	// The CID is always loaded.
+	Fields []string
+
+	// OrderByCID orders records by CID, and excludes records up to AfterCID if set.
+	OrderByCID bool
+	AfterCID   string
 }
 
 type FilterOption func(*RecordFilters)
@@ -33,6 +83,13 @@ func WithOffset(offset int) FilterOption {
 	}
 }
 
+// WithCIDs RecordFilters records by exact CIDs.
+func WithCIDs(cids ...string) FilterOption {
+	return func(sc *RecordFilters) {
+		sc.CIDs = cids
+	}
+}
+
 // WithName RecordFilters records by name (partial match).
 func WithName(name string) FilterOption {
 	return func(sc *RecordFilters) {
@@ -95,3 +152,84 @@ func WithDomainNames(names ...string) FilterOption {
 		sc.DomainNames = names
 	}
 }
+
+// WithLicenses filters records by the SPDX identifier of their license.
+// Licenses given by several options are matched with OR semantics.
+func WithLicenses(licenses ...string) FilterOption {
+	return func(sc *RecordFilters) {
+		sc.Licenses = append(sc.Licenses, licenses...)
+	}
+}
+
+// WithSigners filters records by the identity of their signers.
+// Signers given by several options are matched with OR semantics.
+func WithSigners(signers ...string) FilterOption {
+	return func(sc *RecordFilters) {
+		sc.Signers = append(sc.Signers, signers...)
+	}
+}
+
+// WithEmbargoes hides the records under embargo at the given time from a viewer,
+// unless the viewer pushed them. An empty viewer never sees embargoed records.
+func WithEmbargoes(now time.Time, viewer string) FilterOption {
+	return func(sc *RecordFilters) {
+		sc.EmbargoedAt = now
+		sc.EmbargoViewer = viewer
+	}
+}
+
+// WithPreferredRegions ranks records by the region hints of their locators,
+// returning records with a locator in an earlier preferred region first.
+func WithPreferredRegions(regions ...string) FilterOption {
+	return func(sc *RecordFilters) {
+		sc.PreferredRegions = regions
+	}
+}
+
+// WithAnnotation filters records by annotation value.
+// Values of the same annotation key are matched with OR semantics.
+func WithAnnotation(key string, values ...string) FilterOption {
+	return func(sc *RecordFilters) {
+		if sc.Annotations == nil {
+			sc.Annotations = make(map[string][]string)
+		}
+
+		sc.Annotations[key] = append(sc.Annotations[key], values...)
+	}
+}
+
+// WithCreatedBefore excludes records added after the given time.
+// It is used to read consistent pages of results at a snapshot time.
+func WithCreatedBefore(t time.Time) FilterOption {
+	return func(sc *RecordFilters) {
+		sc.CreatedBefore = t
+	}
+}
+
+// WithUpdatedSince excludes records that were not added or updated after the given time.
+// It is used to poll for changed records incrementally.
+func WithUpdatedSince(t time.Time) FilterOption {
+	return func(sc *RecordFilters) {
+		sc.UpdatedSince = t
+	}
+}
+
+// WithCursor orders records by CID and excludes the records up to and including afterCID,
+// so that pages of results can be read with a limit without scanning the previous pages.
+// afterCID is the last CID of the previous page, or empty for the first page.
+// It should not be combined with WithPreferredRegions, which ranks records first.
+func WithCursor(afterCID string) FilterOption {
+	return func(sc *RecordFilters) {
+		sc.OrderByCID = true
+		sc.AfterCID = afterCID
+	}
+}
+
+// WithFields only loads the given record fields, see the RecordField constants,
+// so that unneeded columns and related records are not read. The CID is always loaded.
+// It does not filter records and only applies to GetRecords.
+func WithFields(fields ...string) FilterOption {
+	return func(sc *RecordFilters) {
+		sc.Fields = append(sc.Fields, fields...)
+	}
+}
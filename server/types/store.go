@@ -55,10 +55,36 @@ type VerifierStore interface {
 	VerifyWithZot(ctx context.Context, recordCID string) (bool, error)
 }
 
+// ListerStore enumerates the records held by the store.
+//
+// Implementations: oci.Store
+// Used by: consistency.Checker.
+type ListerStore interface {
+	// List calls listFn with the reference of every record in the store.
+	List(ctx context.Context, listFn func(*corev1.RecordRef) error) error
+}
+
+// GarbageCollectorStore removes the content of the store no longer referenced by any record.
+//
+// Implementations: oci.Store (local directory only)
+// Used by: store.Controller.
+type GarbageCollectorStore interface {
+	// GarbageCollect removes the unreferenced content of the store and reports what was removed.
+	GarbageCollect(ctx context.Context) (*GarbageCollectResult, error)
+}
+
+// GarbageCollectResult is the content removed by a garbage collection.
+type GarbageCollectResult struct {
+	RemovedBlobs   uint64
+	ReclaimedBytes uint64
+}
+
 // FullStore is the complete store interface with all optional capabilities.
 // This is what the OCI store implementation provides.
 type FullStore interface {
 	StoreAPI
 	ReferrerStoreAPI
 	VerifierStore
+	ListerStore
+	GarbageCollectorStore
 }
@@ -3,11 +3,183 @@
 
 package types
 
-import storev1 "github.com/agntcy/dir/api/store/v1"
+import (
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	storev1 "github.com/agntcy/dir/api/store/v1"
+)
 
 type SyncObject interface {
 	GetID() string
 	GetRemoteDirectoryURL() string
 	GetCIDs() []string
 	GetStatus() storev1.SyncStatus
+
+	// GetInvitationToken returns the invitation token the sync was accepted from, if any.
+	GetInvitationToken() string
+
+	// GetParallelism returns the highest number of records fetched concurrently.
+	GetParallelism() int
+
+	// GetSyncedRecords returns the number of records fetched and indexed.
+	GetSyncedRecords() int
+
+	// GetThroughput returns the average number of records fetched per second.
+	GetThroughput() float64
+
+	// GetNamespaceMappings returns the mappings of remote record names into local namespaces.
+	GetNamespaceMappings() []NamespaceMapping
+
+	// GetTrustPolicy returns the trust policy verifying the signatures of the imported records.
+	GetTrustPolicy() SyncTrustPolicy
+}
+
+// QuarantinedRecordObject is a record imported by a sync that failed its trust policy.
+// Quarantined records are stored but not indexed until released.
+type QuarantinedRecordObject interface {
+	GetCID() string
+	GetSyncID() string
+	GetReason() string
+	GetQuarantinedAt() time.Time
+
+	// IsReleased reports whether the record was released after review.
+	// Released records are indexed without verifying their signatures again.
+	IsReleased() bool
+}
+
+// NamespaceMapping maps remote record names into a local namespace.
+// Patterns ending with "*" match name prefixes, other patterns match exact names.
+type NamespaceMapping struct {
+	Remote string `json:"remote"`
+	Local  string `json:"local"`
+}
+
+// Validate checks that the patterns of the mapping are consistent.
+func (m NamespaceMapping) Validate() error {
+	if m.Remote == "" || m.Local == "" {
+		return errors.New("remote and local patterns are required")
+	}
+
+	if strings.HasSuffix(m.Remote, "*") != strings.HasSuffix(m.Local, "*") {
+		return fmt.Errorf("mapping %q to %q: both patterns must end with '*' or neither", m.Remote, m.Local)
+	}
+
+	if strings.Count(m.Remote, "*") > 1 || strings.Count(m.Local, "*") > 1 {
+		return fmt.Errorf("mapping %q to %q: '*' is only allowed at the end of patterns", m.Remote, m.Local)
+	}
+
+	return nil
+}
+
+// Map returns the local name of a remote record name, if the mapping matches it.
+func (m NamespaceMapping) Map(name string) (string, bool) {
+	remotePrefix, isPrefix := strings.CutSuffix(m.Remote, "*")
+	if !isPrefix {
+		if name != m.Remote {
+			return "", false
+		}
+
+		return m.Local, true
+	}
+
+	suffix, ok := strings.CutPrefix(name, remotePrefix)
+	if !ok {
+		return "", false
+	}
+
+	return strings.TrimSuffix(m.Local, "*") + suffix, true
+}
+
+// MapRecordName returns the local name of a remote record name using the first matching mapping.
+// Names matching no mapping are returned unchanged.
+func MapRecordName(mappings []NamespaceMapping, name string) (string, bool) {
+	for _, mapping := range mappings {
+		if local, ok := mapping.Map(name); ok {
+			return local, true
+		}
+	}
+
+	return name, false
+}
+
+// NamespaceMappingsFromProto converts and validates the namespace mappings of a sync request.
+func NamespaceMappingsFromProto(mappings []*storev1.NamespaceMapping) ([]NamespaceMapping, error) {
+	result := make([]NamespaceMapping, 0, len(mappings))
+
+	for _, mapping := range mappings {
+		m := NamespaceMapping{Remote: mapping.GetRemote(), Local: mapping.GetLocal()}
+		if err := m.Validate(); err != nil {
+			return nil, err
+		}
+
+		result = append(result, m)
+	}
+
+	return result, nil
+}
+
+// NamespaceMappingsToProto converts namespace mappings to their API representation.
+func NamespaceMappingsToProto(mappings []NamespaceMapping) []*storev1.NamespaceMapping {
+	result := make([]*storev1.NamespaceMapping, 0, len(mappings))
+	for _, mapping := range mappings {
+		result = append(result, &storev1.NamespaceMapping{Remote: mapping.Remote, Local: mapping.Local})
+	}
+
+	return result
+}
+
+// SyncTrustPolicy requires records imported by a sync to be signed by one of the trusted public keys.
+// Records failing the policy are quarantined instead of indexed.
+type SyncTrustPolicy struct {
+	TrustedPublicKeys []string `json:"trusted_public_keys,omitempty"`
+}
+
+// Enabled reports whether the signatures of the imported records are verified.
+func (p SyncTrustPolicy) Enabled() bool {
+	return len(p.TrustedPublicKeys) > 0
+}
+
+// Validate checks that the trusted public keys are PEM-encoded.
+func (p SyncTrustPolicy) Validate() error {
+	for i, key := range p.TrustedPublicKeys {
+		if block, _ := pem.Decode([]byte(key)); block == nil {
+			return fmt.Errorf("trusted public key %d is not PEM-encoded", i)
+		}
+	}
+
+	return nil
+}
+
+// SyncTrustPolicyFromProto converts and validates the trust policy of a sync request.
+func SyncTrustPolicyFromProto(policy *storev1.SyncTrustPolicy) (SyncTrustPolicy, error) {
+	result := SyncTrustPolicy{TrustedPublicKeys: policy.GetTrustedPublicKeys()}
+	if err := result.Validate(); err != nil {
+		return SyncTrustPolicy{}, err
+	}
+
+	return result, nil
+}
+
+// SyncTrustPolicyToProto converts a trust policy to its API representation.
+// Returns nil if the policy is not enabled.
+func SyncTrustPolicyToProto(policy SyncTrustPolicy) *storev1.SyncTrustPolicy {
+	if !policy.Enabled() {
+		return nil
+	}
+
+	return &storev1.SyncTrustPolicy{TrustedPublicKeys: policy.TrustedPublicKeys}
+}
+
+// RecordProvenance is a source a record was added to this Directory from.
+type RecordProvenance struct {
+	// SyncID is the sync that imported the record, empty if the record was pushed.
+	SyncID string
+
+	// RemoteDirectoryURL is the remote Directory of the sync,
+	// empty if the record was pushed or the sync was deleted.
+	RemoteDirectoryURL string
 }
@@ -0,0 +1,61 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapRecordName(t *testing.T) {
+	mappings := []types.NamespaceMapping{
+		{Remote: "acme/special", Local: "mirrors/special"},
+		{Remote: "acme/*", Local: "mirrors/acme/*"},
+		{Remote: "*", Local: "mirrors/other/*"},
+	}
+
+	tests := []struct {
+		name   string
+		local  string
+		mapped bool
+	}{
+		{"acme/special", "mirrors/special", true},
+		{"acme/agent", "mirrors/acme/agent", true},
+		{"acme/team/agent", "mirrors/acme/team/agent", true},
+		{"acmeagent", "mirrors/other/acmeagent", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			local, mapped := types.MapRecordName(mappings, tt.name)
+			assert.Equal(t, tt.local, local)
+			assert.Equal(t, tt.mapped, mapped)
+		})
+	}
+
+	// Names matching no mapping are unchanged
+	local, mapped := types.MapRecordName(mappings[:2], "other/agent")
+	assert.Equal(t, "other/agent", local)
+	assert.False(t, mapped)
+}
+
+func TestNamespaceMappingsFromProto(t *testing.T) {
+	mappings, err := types.NamespaceMappingsFromProto([]*storev1.NamespaceMapping{{Remote: "acme/*", Local: "mirrors/acme/*"}})
+	require.NoError(t, err)
+	assert.Equal(t, []types.NamespaceMapping{{Remote: "acme/*", Local: "mirrors/acme/*"}}, mappings)
+
+	for _, invalid := range []*storev1.NamespaceMapping{
+		{Remote: "acme/*"},
+		{Remote: "acme/*", Local: "mirrors/acme"},
+		{Remote: "acme/agent", Local: "mirrors/*"},
+		{Remote: "*/agent/*", Local: "mirrors/*"},
+	} {
+		_, err := types.NamespaceMappingsFromProto([]*storev1.NamespaceMapping{invalid})
+		assert.Error(t, err, invalid.String())
+	}
+}
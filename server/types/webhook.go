@@ -0,0 +1,18 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+)
+
+type RecordWebhookObject interface {
+	GetID() string
+	GetCID() string
+	GetURL() string
+	GetEventTypes() []eventsv1.EventType
+	GetSecret() string
+	GetOwner() string
+	GetCreatedTime() string
+}
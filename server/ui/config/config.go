@@ -0,0 +1,21 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+const (
+	DefaultEnabled       = false
+	DefaultListenAddress = "0.0.0.0:8080"
+)
+
+// Config is the configuration of the embedded web UI.
+type Config struct {
+	// Enabled serves the web UI under /ui on its own HTTP address.
+	// The UI browses the directory through a read-only JSON gateway to the gRPC APIs.
+	// When authentication is enabled, requests must carry a bearer token, e.g. an API token,
+	// and are authorized as the API calls they are translated to.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// ListenAddress is the address the web UI is served on.
+	ListenAddress string `json:"listen_address,omitempty" mapstructure:"listen_address"`
+}
@@ -0,0 +1,284 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	routingv1 "github.com/agntcy/dir/api/routing/v1"
+	searchv1 "github.com/agntcy/dir/api/search/v1"
+	signv1 "github.com/agntcy/dir/api/sign/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+var logger = logging.Logger("ui")
+
+const (
+	// maxRequestSize bounds the JSON request of API calls.
+	maxRequestSize = 1 << 20
+
+	jsonContentType   = "application/json"
+	ndjsonContentType = "application/x-ndjson"
+)
+
+// Methods are the read-only API methods callable from the UI.
+var Methods = []string{
+	corev1.InfoService_GetServerInfo_FullMethodName,
+	searchv1.SearchService_Search_FullMethodName,
+	storev1.StoreService_Pull_FullMethodName,
+	storev1.StoreService_Lookup_FullMethodName,
+	storev1.StoreService_RecordInfo_FullMethodName,
+	storev1.StoreService_PullReferrer_FullMethodName,
+	signv1.SignService_Verify_FullMethodName,
+	storev1.SyncService_ListSyncs_FullMethodName,
+	storev1.SyncService_GetSync_FullMethodName,
+	routingv1.RoutingService_List_FullMethodName,
+	eventsv1.EventService_Listen_FullMethodName,
+}
+
+// httpStatus maps gRPC status codes to HTTP status codes.
+var httpStatus = map[codes.Code]int{
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.Canceled:           499, //nolint:mnd // client closed request
+}
+
+// gateway translates JSON requests to calls of the read-only API methods.
+// Each call sends the request message once. Responses of streaming methods are
+// written as newline-delimited JSON, followed by an error object if the call fails.
+type gateway struct {
+	conn          grpc.ClientConnInterface
+	authenticated bool
+}
+
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fullMethod := "/" + r.PathValue("method")
+	if !slices.Contains(Methods, fullMethod) {
+		writeError(w, status.Errorf(codes.NotFound, "method %s is not available from the UI", fullMethod))
+
+		return
+	}
+
+	method, err := methodDescriptor(fullMethod)
+	if err != nil {
+		writeError(w, status.Error(codes.Internal, err.Error()))
+
+		return
+	}
+
+	authorization := r.Header.Get("Authorization")
+	if g.authenticated && authorization == "" {
+		writeError(w, status.Error(codes.Unauthenticated, "a bearer token is required"))
+
+		return
+	}
+
+	req, err := newMessage(method.Input())
+	if err != nil {
+		writeError(w, status.Error(codes.Internal, err.Error()))
+
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		writeError(w, status.Errorf(codes.InvalidArgument, "failed to read request: %v", err))
+
+		return
+	}
+
+	if len(strings.TrimSpace(string(body))) > 0 {
+		if err := protojson.Unmarshal(body, req); err != nil {
+			writeError(w, status.Errorf(codes.InvalidArgument, "invalid request: %v", err))
+
+			return
+		}
+	}
+
+	ctx := r.Context()
+	if authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+
+	// Forward the instance ID of the browser, so that each browser is rate limited on its own
+	// rather than with all the browsers calling the APIs through the UI
+	if instanceID := r.Header.Get(corev1.ClientInstanceIDMetadataKey); corev1.ValidateClientInstanceID(instanceID) == nil {
+		ctx = metadata.AppendToOutgoingContext(ctx, corev1.ClientInstanceIDMetadataKey, instanceID)
+	}
+
+	stream, err := g.conn.NewStream(ctx, &grpc.StreamDesc{
+		ServerStreams: method.IsStreamingServer(),
+		ClientStreams: method.IsStreamingClient(),
+	}, fullMethod)
+	if err == nil {
+		err = stream.SendMsg(req)
+	}
+
+	if err == nil {
+		err = stream.CloseSend()
+	}
+
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	if !method.IsStreamingServer() {
+		resp, err := g.recv(stream, method.Output())
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", jsonContentType)
+		_, _ = w.Write(resp)
+
+		return
+	}
+
+	g.stream(w, stream, method.Output())
+}
+
+// stream writes the responses of a streaming method as newline-delimited JSON.
+func (g *gateway) stream(w http.ResponseWriter, stream grpc.ClientStream, output protoreflect.MessageDescriptor) {
+	controller := http.NewResponseController(w)
+
+	// Wait for the first response so that failed calls are reported with their HTTP status
+	resp, err := g.recv(stream, output)
+	if errors.Is(err, io.EOF) {
+		w.Header().Set("Content-Type", ndjsonContentType)
+
+		return
+	}
+
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", ndjsonContentType)
+
+	for {
+		if _, err := w.Write(append(resp, '\n')); err != nil {
+			return
+		}
+
+		_ = controller.Flush()
+
+		resp, err = g.recv(stream, output)
+		if errors.Is(err, io.EOF) {
+			return
+		}
+
+		if err != nil {
+			line, _ := json.Marshal(map[string]any{"error": errorBody(err)})
+			_, _ = w.Write(append(line, '\n'))
+
+			return
+		}
+	}
+}
+
+// recv receives a response and marshals it to JSON.
+func (g *gateway) recv(stream grpc.ClientStream, output protoreflect.MessageDescriptor) ([]byte, error) {
+	resp, err := newMessage(output)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := stream.RecvMsg(resp); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	data, err := protojson.Marshal(resp)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal response: %v", err)
+	}
+
+	return data, nil
+}
+
+// methodDescriptor returns the descriptor of a method from its full gRPC name, e.g. "/pkg.Service/Method".
+func methodDescriptor(fullMethod string) (protoreflect.MethodDescriptor, error) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, errors.New("not a method: " + string(name))
+	}
+
+	return method, nil
+}
+
+// newMessage returns a new message of the registered type of a descriptor.
+func newMessage(desc protoreflect.MessageDescriptor) (proto.Message, error) {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return messageType.New().Interface(), nil
+}
+
+// writeError writes the error of a call as JSON, with the HTTP status of its gRPC code.
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	if mapped, ok := httpStatus[status.Code(err)]; ok {
+		code = mapped
+	}
+
+	if code == http.StatusInternalServerError {
+		logger.Warn("UI API call failed", "error", err)
+	}
+
+	data, _ := json.Marshal(map[string]any{"error": errorBody(err)})
+
+	w.Header().Set("Content-Type", jsonContentType)
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+}
+
+// errorBody returns the JSON representation of the error of a call.
+func errorBody(err error) map[string]string {
+	st := status.Convert(err)
+
+	return map[string]string{
+		"code":    st.Code().String(),
+		"message": st.Message(),
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package ui
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	storev1 "github.com/agntcy/dir/api/store/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testInfoService struct {
+	corev1.UnimplementedInfoServiceServer
+
+	instanceIDs []string
+}
+
+func (s *testInfoService) GetServerInfo(ctx context.Context, _ *corev1.GetServerInfoRequest) (*corev1.GetServerInfoResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.instanceIDs = append(s.instanceIDs, md.Get(corev1.ClientInstanceIDMetadataKey)...)
+
+	if auth := md.Get("authorization"); len(auth) > 0 && auth[0] != "Bearer valid" {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	return &corev1.GetServerInfoResponse{AcceptedSchemaVersions: []string{"0.8.0"}}, nil
+}
+
+type testSyncService struct {
+	storev1.UnimplementedSyncServiceServer
+}
+
+func (testSyncService) ListSyncs(_ *storev1.ListSyncsRequest, stream storev1.SyncService_ListSyncsServer) error {
+	for _, id := range []string{"sync-1", "sync-2"} {
+		if err := stream.Send(&storev1.ListSyncsItem{SyncId: id}); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	return status.Error(codes.Internal, "listing failed")
+}
+
+func newTestHandler(t *testing.T, authenticated bool) http.Handler {
+	t.Helper()
+
+	return newTestGateway(t, authenticated, &testInfoService{})
+}
+
+func newTestGateway(t *testing.T, authenticated bool, info *testInfoService) http.Handler {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	corev1.RegisterInfoServiceServer(server, info)
+	storev1.RegisterSyncServiceServer(server, testSyncService{})
+
+	go func() { _ = server.Serve(listener) }()
+
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///ui",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return Handler(conn, authenticated)
+}
+
+func call(handler http.Handler, method, body, authorization string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, apiPath+method, strings.NewReader(body))
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestHandlerStatic(t *testing.T) {
+	handler := newTestHandler(t, false)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+	assert.Equal(t, Path+"/", rec.Header().Get("Location"))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path+"/", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "<title>Directory</title>")
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path+"/app.js", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestGatewayUnary(t *testing.T) {
+	handler := newTestHandler(t, false)
+
+	rec := call(handler, "agntcy.dir.core.v1.InfoService/GetServerInfo", "", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, jsonContentType, rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"acceptedSchemaVersions":["0.8.0"]}`, rec.Body.String())
+
+	// Errors of calls are reported with the HTTP status of their code
+	rec = call(handler, "agntcy.dir.core.v1.InfoService/GetServerInfo", "{}", "Bearer invalid")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.JSONEq(t, `{"error":{"code":"Unauthenticated","message":"invalid token"}}`, rec.Body.String())
+
+	rec = call(handler, "agntcy.dir.core.v1.InfoService/GetServerInfo", `{"unknown":true}`, "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGatewayClientInstanceID(t *testing.T) {
+	info := &testInfoService{}
+	handler := newTestGateway(t, false, info)
+
+	for _, instanceID := range []string{"browser-1", "invalid id", ""} {
+		req := httptest.NewRequest(http.MethodPost, apiPath+"agntcy.dir.core.v1.InfoService/GetServerInfo", nil)
+		req.Header.Set(corev1.ClientInstanceIDMetadataKey, instanceID)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	// Only valid instance IDs are forwarded
+	assert.Equal(t, []string{"browser-1"}, info.instanceIDs)
+}
+
+func TestGatewayStreaming(t *testing.T) {
+	handler := newTestHandler(t, false)
+
+	rec := call(handler, "agntcy.dir.store.v1.SyncService/ListSyncs", "{}", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, ndjsonContentType, rec.Header().Get("Content-Type"))
+
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.JSONEq(t, `{"syncId":"sync-1"}`, lines[0])
+	assert.JSONEq(t, `{"syncId":"sync-2"}`, lines[1])
+	assert.JSONEq(t, `{"error":{"code":"Internal","message":"listing failed"}}`, lines[2])
+}
+
+func TestGatewayRejectedCalls(t *testing.T) {
+	handler := newTestHandler(t, true)
+
+	// Only read-only methods are available
+	rec := call(handler, "agntcy.dir.store.v1.StoreService/Delete", "{}", "Bearer valid")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// Calls require a bearer token when authentication is enabled
+	rec = call(handler, "agntcy.dir.core.v1.InfoService/GetServerInfo", "{}", "")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = call(handler, "agntcy.dir.core.v1.InfoService/GetServerInfo", "{}", "Bearer valid")
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,109 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/agntcy/dir/server/ui/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+// Server serves the UI on its own HTTP address. The API calls of the UI are sent to the
+// gRPC server over a client connection, so that they go through the same authentication,
+// authorization and rate limiting as the calls of any other client.
+type Server struct {
+	config        config.Config
+	authenticated bool
+	conn          *grpc.ClientConn
+	server        *http.Server
+	wg            sync.WaitGroup
+}
+
+// New creates a UI server. If authenticated is true, API calls require a bearer token.
+func New(cfg config.Config, authenticated bool) *Server {
+	return &Server{
+		config:        cfg,
+		authenticated: authenticated,
+	}
+}
+
+// Start connects to the gRPC server listening on addr with the given transport credentials,
+// and starts serving the UI on the configured address.
+func (s *Server) Start(ctx context.Context, addr net.Addr, creds credentials.TransportCredentials) error {
+	conn, err := grpc.NewClient("passthrough:///"+addr.String(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, addr.Network(), addr.String())
+		}),
+		grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", s.config.ListenAddress)
+	if err != nil {
+		_ = conn.Close()
+
+		return fmt.Errorf("failed to listen on %s: %w", s.config.ListenAddress, err)
+	}
+
+	s.conn = conn
+	s.server = &http.Server{
+		Handler:           Handler(conn, s.authenticated),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("Web UI server failed", "error", err)
+		}
+	}()
+
+	logger.Info("Web UI server started", "address", listener.Addr().String(), "path", Path+"/")
+
+	return nil
+}
+
+// Stop stops serving the UI, and closes the connection to the gRPC server once the requests are done.
+func (s *Server) Stop() error {
+	if s.server == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	err := s.server.Shutdown(ctx)
+	s.wg.Wait()
+
+	// Closing the connection also ends the streams of requests still running, e.g. event listeners
+	if closeErr := s.conn.Close(); closeErr != nil {
+		logger.Error("Failed to close API client", "error", closeErr)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to stop web UI server: %w", err)
+	}
+
+	logger.Info("Web UI server stopped")
+
+	return nil
+}
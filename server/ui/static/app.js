@@ -0,0 +1,303 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+'use strict';
+
+const API = 'api/';
+const TOKEN_KEY = 'dir-ui-token';
+const INSTANCE_KEY = 'dir-ui-instance-id';
+
+const $ = (id) => document.getElementById(id);
+
+// call sends a request to a unary API method and returns its JSON response.
+async function call(method, request) {
+  const response = await send(method, request);
+
+  return response.json();
+}
+
+// stream sends a request to a streaming API method and calls onMessage for each response.
+// Calls can be stopped with the signal of an AbortController.
+async function stream(method, request, onMessage, signal) {
+  const response = await send(method, request, signal);
+  const reader = response.body.pipeThrough(new TextDecoderStream()).getReader();
+  let buffer = '';
+
+  for (;;) {
+    const { value, done } = await reader.read();
+    if (done) {
+      return;
+    }
+
+    buffer += value;
+    const lines = buffer.split('\n');
+    buffer = lines.pop();
+
+    for (const line of lines.filter((line) => line.trim() !== '')) {
+      const message = JSON.parse(line);
+      if (message.error) {
+        throw new Error(`${message.error.code}: ${message.error.message}`);
+      }
+
+      onMessage(message);
+    }
+  }
+}
+
+// instanceID returns the ID of this browser tab, sent with API calls to be rate limited on its own.
+function instanceID() {
+  let id = sessionStorage.getItem(INSTANCE_KEY);
+  if (!id) {
+    id = crypto.randomUUID();
+    sessionStorage.setItem(INSTANCE_KEY, id);
+  }
+
+  return id;
+}
+
+async function send(method, request, signal) {
+  const headers = {
+    'Content-Type': 'application/json',
+    'X-Dir-Client-Instance-Id': instanceID(),
+  };
+  const token = sessionStorage.getItem(TOKEN_KEY);
+  if (token) {
+    headers.Authorization = `Bearer ${token}`;
+  }
+
+  const response = await fetch(API + method, {
+    method: 'POST',
+    headers,
+    body: JSON.stringify(request || {}),
+    signal,
+  });
+
+  if (!response.ok) {
+    const body = await response.json().catch(() => ({}));
+    const error = body.error || { code: response.status, message: response.statusText };
+    throw new Error(`${error.code}: ${error.message}`);
+  }
+
+  return response;
+}
+
+function showError(error) {
+  $('error').textContent = error ? error.message : '';
+}
+
+function cell(row, text, className) {
+  const td = row.insertCell();
+  td.textContent = text == null ? '' : String(text);
+  if (className) {
+    td.className = className;
+  }
+
+  return td;
+}
+
+function definitions(dl, entries) {
+  dl.replaceChildren();
+
+  for (const [term, description] of entries) {
+    const dt = document.createElement('dt');
+    dt.textContent = term;
+    const dd = document.createElement('dd');
+    dd.textContent = description == null || description === '' ? 'none' : String(description);
+    dl.append(dt, dd);
+  }
+}
+
+function enumName(value, prefix) {
+  return String(value || `${prefix}UNSPECIFIED`).replace(prefix, '').toLowerCase();
+}
+
+async function loadServerInfo() {
+  try {
+    const info = await call('agntcy.dir.core.v1.InfoService/GetServerInfo');
+    const build = info.buildInfo || {};
+    $('server-info').textContent = `${build.version || 'unknown version'} · schemas ${(info.acceptedSchemaVersions || []).join(', ')}`;
+  } catch (error) {
+    $('server-info').textContent = '';
+  }
+}
+
+async function search(event) {
+  event.preventDefault();
+  showError();
+
+  const results = $('search-results');
+  results.replaceChildren();
+
+  const value = $('search-value').value.trim();
+  const queries = value ? [{ type: $('search-type').value, value }] : [];
+
+  try {
+    const request = { queries, limit: 100, readMask: 'recordCid,name,version,skills' };
+
+    await stream('agntcy.dir.search.v1.SearchService/Search', request, (result) => {
+      const row = results.insertRow();
+      cell(row, result.name);
+      cell(row, result.version);
+      cell(row, (result.skills || []).join(', '));
+
+      const link = document.createElement('a');
+      link.href = `#/records/${encodeURIComponent(result.recordCid)}`;
+      link.textContent = result.recordCid;
+      cell(row, '', 'cid').append(link);
+    });
+  } catch (error) {
+    showError(error);
+  }
+}
+
+async function showRecord(cid) {
+  showError();
+  $('record-title').textContent = cid;
+  $('record-data').textContent = '';
+  definitions($('record-info'), []);
+  definitions($('record-signatures'), []);
+
+  const ref = { recordRef: { cid } };
+
+  try {
+    const info = await call('agntcy.dir.store.v1.StoreService/RecordInfo', ref);
+    const meta = info.meta || {};
+    const signature = info.signature || {};
+
+    definitions($('record-info'), [
+      ['Schema version', meta.schemaVersion],
+      ['Created at', meta.createdAt],
+      ['Annotations', Object.entries(meta.annotations || {}).map(([key, value]) => `${key}=${value}`).join(', ')],
+      ['Stored', Boolean(info.stored)],
+      ['Indexed', Boolean(info.indexed)],
+      ['Published', Boolean(info.published)],
+      ['Labels', (info.labels || []).join(', ')],
+      ['Pull count', info.pullCount || 0],
+      ['Sync origin', (info.syncOrigins || []).map((origin) => `${origin.remoteDirectoryUrl} (${enumName(origin.status, 'SYNC_STATUS_')})`).join(', ')],
+    ]);
+
+    const entries = [
+      ['Signatures', signature.signatureCount || 0],
+      ['Revoked', signature.revokedCount || 0],
+      ['Verified by server', Boolean(signature.verified)],
+      ['Server verification error', signature.verificationError],
+    ];
+
+    if (signature.signatureCount) {
+      const verification = await call('agntcy.dir.sign.v1.SignService/Verify', ref);
+      entries.push(['Verified now', Boolean(verification.success)]);
+      entries.push(['Verification error', verification.errorMessage]);
+    }
+
+    definitions($('record-signatures'), entries);
+
+    await stream('agntcy.dir.store.v1.StoreService/Pull', { cid }, (record) => {
+      $('record-data').textContent = JSON.stringify(record.data, null, 2);
+    });
+  } catch (error) {
+    showError(error);
+  }
+}
+
+async function showSyncs() {
+  showError();
+
+  const results = $('sync-results');
+  results.replaceChildren();
+
+  try {
+    await stream('agntcy.dir.store.v1.SyncService/ListSyncs', {}, (sync) => {
+      const row = results.insertRow();
+      cell(row, sync.syncId, 'cid');
+      cell(row, sync.remoteDirectoryUrl);
+      cell(row, enumName(sync.status, 'SYNC_STATUS_'));
+    });
+  } catch (error) {
+    showError(error);
+  }
+}
+
+let listening = null;
+
+function stopEvents() {
+  if (listening) {
+    listening.abort();
+    listening = null;
+  }
+}
+
+async function listenEvents(event) {
+  if (event) {
+    event.preventDefault();
+  }
+
+  stopEvents();
+  showError();
+
+  listening = new AbortController();
+  const results = $('event-results');
+  const request = { filterExpression: $('events-filter').value.trim() };
+
+  try {
+    await stream('agntcy.dir.events.v1.EventService/Listen', request, (response) => {
+      const e = response.event || {};
+      const row = results.insertRow(0);
+      cell(row, e.timestamp);
+      cell(row, enumName(e.type, 'EVENT_TYPE_'));
+      cell(row, e.resourceId, 'cid');
+      cell(row, e.actor);
+      cell(row, (e.labels || []).join(', '));
+    }, listening.signal);
+  } catch (error) {
+    if (error.name !== 'AbortError') {
+      showError(error);
+    }
+  }
+}
+
+function route() {
+  const [, view, ...rest] = (location.hash || '#/search').split('/');
+
+  if (view !== 'events') {
+    stopEvents();
+  }
+
+  const active = view === 'records' ? 'record' : view;
+  for (const section of document.querySelectorAll('.view')) {
+    section.classList.toggle('active', section.id === `view-${active}`);
+  }
+
+  for (const link of document.querySelectorAll('nav a')) {
+    link.classList.toggle('active', link.getAttribute('href') === `#/${view}`);
+  }
+
+  switch (view) {
+    case 'records':
+      showRecord(decodeURIComponent(rest.join('/')));
+      break;
+    case 'syncs':
+      showSyncs();
+      break;
+    case 'events':
+      listenEvents();
+      break;
+    default:
+      break;
+  }
+}
+
+$('token').value = sessionStorage.getItem(TOKEN_KEY) || '';
+$('token-form').addEventListener('submit', (event) => {
+  event.preventDefault();
+  sessionStorage.setItem(TOKEN_KEY, $('token').value.trim());
+  loadServerInfo();
+  route();
+});
+
+$('search-form').addEventListener('submit', search);
+$('events-form').addEventListener('submit', listenEvents);
+$('events-stop').addEventListener('click', stopEvents);
+window.addEventListener('hashchange', route);
+
+loadServerInfo();
+route();
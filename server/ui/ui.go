@@ -0,0 +1,48 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ui serves an embedded single-page web UI for browsing the directory:
+// searching records, viewing their details, signatures and sync status, and following live events.
+// The UI calls the gRPC APIs of the server through a read-only JSON gateway served next to it.
+package ui
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// Path is the HTTP path the UI is served under:
+	//   - /ui/ serves the single-page application
+	//   - /ui/api/<service>/<method> calls a read-only API method, see Methods
+	Path = "/ui"
+
+	apiPath = Path + "/api/"
+)
+
+//go:embed static
+var static embed.FS
+
+// Handler returns the HTTP handler serving the UI under Path.
+// API calls are sent over conn, forwarding the bearer token of the request if any.
+// If authenticated is true, API calls without a bearer token are rejected before they are sent.
+func Handler(conn grpc.ClientConnInterface, authenticated bool) http.Handler {
+	assets, err := fs.Sub(static, "static")
+	if err != nil {
+		panic(err) // the embedded directory always exists
+	}
+
+	mux := http.NewServeMux()
+
+	mux.Handle("GET "+Path+"/", http.StripPrefix(Path+"/", http.FileServerFS(assets)))
+	mux.Handle("GET "+Path, http.RedirectHandler(Path+"/", http.StatusMovedPermanently))
+	mux.Handle("POST "+apiPath+"{method...}", &gateway{
+		conn:          conn,
+		authenticated: authenticated,
+	})
+
+	return mux
+}
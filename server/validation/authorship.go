@@ -0,0 +1,152 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/validation/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// AuthorshipMismatchAnnotation lists the authorship mismatches of records accepted in annotate mode.
+	AuthorshipMismatchAnnotation = "org.agntcy.dir/authorship-mismatch"
+
+	// PushedByAnnotation is the identity that pushed a record with authorship mismatches.
+	PushedByAnnotation = "org.agntcy.dir/pushed-by"
+
+	// PushedAtAnnotation is the time a record with authorship mismatches was pushed, in RFC 3339 format.
+	PushedAtAnnotation = "org.agntcy.dir/pushed-at"
+)
+
+// AuthorshipPolicy checks the authorship fields of records on push: that their creation time
+// is not in the future beyond the allowed clock skew, and that their authors include a display
+// name registered for the identity pushing them.
+type AuthorshipPolicy struct {
+	mode         string
+	maxClockSkew time.Duration
+	authors      map[string][]string
+
+	now func() time.Time
+}
+
+// NewAuthorshipPolicy creates an authorship policy from the configuration.
+// It returns nil, accepting all records, if no mode is configured.
+func NewAuthorshipPolicy(cfg config.AuthorshipConfig) (*AuthorshipPolicy, error) {
+	switch cfg.Mode {
+	case "":
+		return nil, nil //nolint:nilnil // a nil policy accepts all records
+	case config.AuthorshipModeReject, config.AuthorshipModeAnnotate:
+	default:
+		return nil, fmt.Errorf("invalid authorship mode %q: must be %q or %q", cfg.Mode, config.AuthorshipModeReject, config.AuthorshipModeAnnotate)
+	}
+
+	if cfg.MaxClockSkew < 0 {
+		return nil, errors.New("authorship max clock skew cannot be negative")
+	}
+
+	authors := make(map[string][]string, len(cfg.Identities))
+
+	for _, identity := range cfg.Identities {
+		if identity.ID == "" {
+			return nil, errors.New("authorship identity cannot be empty")
+		}
+
+		if len(identity.Authors) == 0 {
+			return nil, fmt.Errorf("authorship identity %s: at least one author is required", identity.ID)
+		}
+
+		authors[identity.ID] = append(authors[identity.ID], identity.Authors...)
+	}
+
+	return &AuthorshipPolicy{
+		mode:         cfg.Mode,
+		maxClockSkew: cfg.MaxClockSkew,
+		authors:      authors,
+		now:          time.Now,
+	}, nil
+}
+
+// Annotates reports whether records with authorship mismatches are accepted and annotated.
+func (p *AuthorshipPolicy) Annotates() bool {
+	return p != nil && p.mode == config.AuthorshipModeAnnotate
+}
+
+// Check returns an error listing the authorship mismatches of a record pushed by the given identity.
+// Records are only rejected in reject mode.
+func (p *AuthorshipPolicy) Check(data types.RecordData, identity string) error {
+	if p == nil || p.mode != config.AuthorshipModeReject {
+		return nil
+	}
+
+	if mismatches := p.Mismatches(data, identity); len(mismatches) > 0 {
+		return status.Errorf(codes.FailedPrecondition, "record authorship does not match the server policy: %s", strings.Join(mismatches, "; "))
+	}
+
+	return nil
+}
+
+// Mismatches returns the authorship fields of a record pushed by the given identity
+// that do not match the policy. Identities without registered display names are not
+// checked against the authors of the record.
+func (p *AuthorshipPolicy) Mismatches(data types.RecordData, identity string) []string {
+	if p == nil {
+		return nil
+	}
+
+	var mismatches []string
+
+	if p.maxClockSkew > 0 {
+		createdAt := data.GetCreatedAt()
+
+		switch created, err := time.Parse(time.RFC3339, createdAt); {
+		case createdAt == "":
+			mismatches = append(mismatches, "created_at is not set")
+		case err != nil:
+			mismatches = append(mismatches, fmt.Sprintf("created_at %q is not an RFC 3339 time", createdAt))
+		case created.After(p.now().Add(p.maxClockSkew)):
+			mismatches = append(mismatches, fmt.Sprintf("created_at %s is more than %s in the future", createdAt, p.maxClockSkew))
+		}
+	}
+
+	if names, registered := p.authors[identity]; registered && !slices.ContainsFunc(data.GetAuthors(), func(author string) bool {
+		return matchesDisplayName(author, names)
+	}) {
+		mismatches = append(mismatches, fmt.Sprintf("authors do not include a display name of %s (%s)", identity, strings.Join(names, ", ")))
+	}
+
+	return mismatches
+}
+
+// Annotations returns the annotations recording the mismatches of a record accepted in annotate mode.
+func (p *AuthorshipPolicy) Annotations(mismatches []string, identity string) map[string]string {
+	annotations := map[string]string{
+		AuthorshipMismatchAnnotation: strings.Join(mismatches, "; "),
+		PushedAtAnnotation:           p.now().UTC().Format(time.RFC3339),
+	}
+
+	if identity != "" {
+		annotations[PushedByAnnotation] = identity
+	}
+
+	return annotations
+}
+
+// matchesDisplayName reports whether an author is one of the display names, ignoring case.
+// Authors may carry an email address after the name, as in "Team A <team-a@example.org>".
+func matchesDisplayName(author string, names []string) bool {
+	name, _, _ := strings.Cut(author, "<")
+	name = strings.TrimSpace(name)
+
+	return slices.ContainsFunc(names, func(displayName string) bool {
+		return strings.EqualFold(strings.TrimSpace(displayName), name)
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/types/adapters"
+	"github.com/agntcy/dir/server/validation/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const teamIdentity = "spiffe://example.org/team-a"
+
+// authoredRecordData returns the data of a record with the given authors and creation time.
+func authoredRecordData(t *testing.T, createdAt string, authors ...string) types.RecordData {
+	t.Helper()
+
+	authorsJSON, err := json.Marshal(append([]string{}, authors...))
+	require.NoError(t, err)
+
+	record, err := corev1.UnmarshalRecord(fmt.Appendf(nil, `{
+		"name": "authored-agent",
+		"version": "1.0.0",
+		"schema_version": "0.7.0",
+		"authors": %s,
+		"created_at": %q
+	}`, authorsJSON, createdAt))
+	require.NoError(t, err)
+
+	recordData, err := adapters.NewRecordAdapter(record).GetRecordData()
+	require.NoError(t, err)
+
+	return recordData
+}
+
+func newTestAuthorshipPolicy(t *testing.T, mode string) *AuthorshipPolicy {
+	t.Helper()
+
+	policy, err := NewAuthorshipPolicy(config.AuthorshipConfig{
+		Mode:         mode,
+		MaxClockSkew: 5 * time.Minute,
+		Identities: []config.AuthorshipIdentity{
+			{ID: teamIdentity, Authors: []string{"Team A"}},
+		},
+	})
+	require.NoError(t, err)
+
+	policy.now = func() time.Time { return time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC) }
+
+	return policy
+}
+
+func TestNewAuthorshipPolicy(t *testing.T) {
+	policy, err := NewAuthorshipPolicy(config.AuthorshipConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+	assert.False(t, policy.Annotates())
+	require.NoError(t, policy.Check(authoredRecordData(t, ""), teamIdentity))
+
+	for _, cfg := range []config.AuthorshipConfig{
+		{Mode: "warn"},
+		{Mode: config.AuthorshipModeReject, MaxClockSkew: -time.Minute},
+		{Mode: config.AuthorshipModeReject, Identities: []config.AuthorshipIdentity{{Authors: []string{"Team A"}}}},
+		{Mode: config.AuthorshipModeReject, Identities: []config.AuthorshipIdentity{{ID: teamIdentity}}},
+	} {
+		_, err := NewAuthorshipPolicy(cfg)
+		assert.Error(t, err, "%+v", cfg)
+	}
+}
+
+func TestAuthorshipPolicy_Mismatches(t *testing.T) {
+	policy := newTestAuthorshipPolicy(t, config.AuthorshipModeReject)
+
+	tests := []struct {
+		name       string
+		data       types.RecordData
+		identity   string
+		mismatches int
+	}{
+		{
+			name:     "matching author and creation time",
+			data:     authoredRecordData(t, "2026-01-01T12:04:00Z", "Someone Else", "team a <team-a@example.org>"),
+			identity: teamIdentity,
+		},
+		{
+			name:     "authors of unregistered identities are not checked",
+			data:     authoredRecordData(t, "2025-06-01T00:00:00Z", "Anyone"),
+			identity: "spiffe://example.org/other",
+		},
+		{
+			name:       "creation time beyond the clock skew",
+			data:       authoredRecordData(t, "2026-01-01T12:10:00Z", "Team A"),
+			identity:   teamIdentity,
+			mismatches: 1,
+		},
+		{
+			name:       "invalid creation time",
+			data:       authoredRecordData(t, "yesterday", "Team A"),
+			identity:   teamIdentity,
+			mismatches: 1,
+		},
+		{
+			name:       "author not registered for the identity",
+			data:       authoredRecordData(t, "2026-01-01T00:00:00Z", "Team B"),
+			identity:   teamIdentity,
+			mismatches: 1,
+		},
+		{
+			name:       "missing creation time and author",
+			data:       authoredRecordData(t, ""),
+			identity:   teamIdentity,
+			mismatches: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mismatches := policy.Mismatches(tt.data, tt.identity)
+			assert.Len(t, mismatches, tt.mismatches, mismatches)
+
+			err := policy.Check(tt.data, tt.identity)
+			if tt.mismatches == 0 {
+				require.NoError(t, err)
+			} else {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestAuthorshipPolicy_Annotate(t *testing.T) {
+	policy := newTestAuthorshipPolicy(t, config.AuthorshipModeAnnotate)
+	assert.True(t, policy.Annotates())
+
+	// Mismatching records are accepted in annotate mode
+	data := authoredRecordData(t, "2026-01-01T00:00:00Z", "Team B")
+	require.NoError(t, policy.Check(data, teamIdentity))
+
+	mismatches := policy.Mismatches(data, teamIdentity)
+	require.Len(t, mismatches, 1)
+
+	assert.Equal(t, map[string]string{
+		AuthorshipMismatchAnnotation: mismatches[0],
+		PushedByAnnotation:           teamIdentity,
+		PushedAtAnnotation:           "2026-01-01T12:00:00Z",
+	}, policy.Annotations(mismatches, teamIdentity))
+}
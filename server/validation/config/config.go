@@ -0,0 +1,105 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+const (
+	DefaultValidationEnabled  = true
+	DefaultValidationInterval = 1 * time.Hour
+)
+
+const (
+	// AuthorshipModeReject rejects records whose authorship does not match the policy.
+	AuthorshipModeReject = "reject"
+
+	// AuthorshipModeAnnotate accepts records whose authorship does not match the policy,
+	// and annotates them with the mismatches.
+	AuthorshipModeAnnotate = "annotate"
+)
+
+const (
+	// TaxonomyModeReject rejects records declaring skills or domains not in the OASF taxonomy.
+	TaxonomyModeReject = "reject"
+
+	// TaxonomyModeWarn accepts records declaring skills or domains not in the OASF taxonomy,
+	// and returns a warning with suggestions to the caller.
+	TaxonomyModeWarn = "warn"
+)
+
+type Config struct {
+	// Enabled turns on the background re-validation of stored records.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// Interval at which stored records are checked for re-validation.
+	// Only records not yet validated with the current validation rules are re-validated.
+	Interval time.Duration `json:"interval,omitempty" mapstructure:"interval"`
+
+	// SchemaVersions restricts the record schema versions accepted on push.
+	SchemaVersions SchemaVersionsConfig `json:"schema_versions,omitempty" mapstructure:"schema_versions"`
+
+	// Licenses restricts the record licenses accepted on push.
+	Licenses LicensesConfig `json:"licenses,omitempty" mapstructure:"licenses"`
+
+	// Authorship checks the creation time and authors of records on push.
+	Authorship AuthorshipConfig `json:"authorship,omitempty" mapstructure:"authorship"`
+
+	// Taxonomy checks the skills and domains of records on push against the OASF taxonomy.
+	Taxonomy TaxonomyConfig `json:"taxonomy,omitempty" mapstructure:"taxonomy"`
+}
+
+// SchemaVersionsConfig restricts the record schema versions accepted on push.
+// Only schema versions supported by the server can be accepted.
+type SchemaVersionsConfig struct {
+	// Min is the lowest accepted schema version, e.g. 0.7.0. Unbounded if empty.
+	Min string `json:"min,omitempty" mapstructure:"min"`
+
+	// Max is the highest accepted schema version, e.g. 0.8.0. Unbounded if empty.
+	Max string `json:"max,omitempty" mapstructure:"max"`
+
+	// Deny lists schema versions that are not accepted.
+	Deny []string `json:"deny,omitempty" mapstructure:"deny"`
+}
+
+// LicensesConfig restricts the record licenses accepted on push.
+// The license of a record is declared by its license module.
+type LicensesConfig struct {
+	// Allowed lists the accepted SPDX license identifiers, e.g. Apache-2.0.
+	// Records without an allowed license are rejected. All records are accepted if empty.
+	Allowed []string `json:"allowed,omitempty" mapstructure:"allowed"`
+}
+
+// AuthorshipConfig checks the creation time and authors of records on push,
+// so that the provenance metadata of stored records can be trusted.
+type AuthorshipConfig struct {
+	// Mode is the handling of records not matching the policy, "reject" or "annotate".
+	// The policy is disabled if empty.
+	Mode string `json:"mode,omitempty" mapstructure:"mode"`
+
+	// MaxClockSkew is how far in the future the creation time of records may be.
+	// Records must then have a creation time. The creation time is not checked if zero.
+	MaxClockSkew time.Duration `json:"max_clock_skew,omitempty" mapstructure:"max_clock_skew"`
+
+	// Identities registers the display names of authenticated identities.
+	// Records pushed by a registered identity must list one of its display names as author.
+	// Records pushed by other identities are not checked.
+	Identities []AuthorshipIdentity `json:"identities,omitempty" mapstructure:"identities"`
+}
+
+// AuthorshipIdentity registers the display names of an authenticated identity.
+type AuthorshipIdentity struct {
+	// ID is the identity of the client, e.g. spiffe://example.org/team-a.
+	ID string `json:"id" mapstructure:"id"`
+
+	// Authors lists the display names the identity publishes records as, e.g. "Team A".
+	Authors []string `json:"authors" mapstructure:"authors"`
+}
+
+// TaxonomyConfig checks that the skills and domains declared by records on push exist
+// in the OASF taxonomy of their schema version, beyond the structural validation of records.
+type TaxonomyConfig struct {
+	// Mode is the handling of records declaring unknown skills or domains, "reject" or "warn".
+	// The check is disabled if empty.
+	Mode string `json:"mode,omitempty" mapstructure:"mode"`
+}
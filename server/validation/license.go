@@ -0,0 +1,67 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/validation/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LicensePolicy decides which record licenses are accepted on push.
+type LicensePolicy struct {
+	allowed []string
+}
+
+// NewLicensePolicy creates a license policy accepting the licenses allowed by the configuration.
+// It returns nil, accepting all records, if no license is allowed explicitly.
+func NewLicensePolicy(cfg config.LicensesConfig) *LicensePolicy {
+	var allowed []string
+
+	for _, license := range cfg.Allowed {
+		if license = strings.TrimSpace(license); license != "" {
+			allowed = append(allowed, license)
+		}
+	}
+
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	return &LicensePolicy{allowed: allowed}
+}
+
+// AllowedLicenses returns the accepted licenses, or nil if all records are accepted.
+func (p *LicensePolicy) AllowedLicenses() []string {
+	if p == nil {
+		return nil
+	}
+
+	return slices.Clone(p.allowed)
+}
+
+// Check returns an error listing the allowed licenses if the record does not declare one of them.
+// License identifiers are matched case-insensitively, as SPDX identifiers are.
+func (p *LicensePolicy) Check(data types.RecordData) error {
+	if p == nil {
+		return nil
+	}
+
+	license := types.GetRecordLicense(data)
+	if license == "" {
+		return status.Errorf(codes.FailedPrecondition, "record does not declare a license, allowed licenses: %s", strings.Join(p.allowed, ", "))
+	}
+
+	if !slices.ContainsFunc(p.allowed, func(allowed string) bool {
+		return strings.EqualFold(allowed, license)
+	}) {
+		return status.Errorf(codes.FailedPrecondition, "record license %q is not allowed, allowed licenses: %s", license, strings.Join(p.allowed, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/types/adapters"
+	"github.com/agntcy/dir/server/validation/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// licensedRecordData returns the data of a record declaring the license, if not empty.
+func licensedRecordData(t *testing.T, license string) types.RecordData {
+	t.Helper()
+
+	modules := `[]`
+	if license != "" {
+		modules = fmt.Sprintf(`[{"name": "license", "data": {"license": %q}}]`, license)
+	}
+
+	record, err := corev1.UnmarshalRecord(fmt.Appendf(nil, `{
+		"name": "licensed-agent",
+		"version": "1.0.0",
+		"schema_version": "0.7.0",
+		"authors": ["test"],
+		"created_at": "2023-01-01T00:00:00Z",
+		"modules": %s
+	}`, modules))
+	require.NoError(t, err)
+
+	recordData, err := adapters.NewRecordAdapter(record).GetRecordData()
+	require.NoError(t, err)
+
+	return recordData
+}
+
+func TestLicensePolicy(t *testing.T) {
+	t.Run("accepts all records by default", func(t *testing.T) {
+		policy := NewLicensePolicy(config.LicensesConfig{Allowed: []string{" ", ""}})
+		assert.Nil(t, policy)
+		assert.Nil(t, policy.AllowedLicenses())
+		require.NoError(t, policy.Check(licensedRecordData(t, "")))
+		require.NoError(t, policy.Check(licensedRecordData(t, "GPL-3.0-only")))
+	})
+
+	policy := NewLicensePolicy(config.LicensesConfig{Allowed: []string{"Apache-2.0", " MIT "}})
+	assert.Equal(t, []string{"Apache-2.0", "MIT"}, policy.AllowedLicenses())
+
+	t.Run("allowed licenses", func(t *testing.T) {
+		require.NoError(t, policy.Check(licensedRecordData(t, "Apache-2.0")))
+		require.NoError(t, policy.Check(licensedRecordData(t, "mit")))
+	})
+
+	t.Run("other licenses are rejected", func(t *testing.T) {
+		err := policy.Check(licensedRecordData(t, "GPL-3.0-only"))
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), `record license "GPL-3.0-only" is not allowed, allowed licenses: Apache-2.0, MIT`)
+	})
+
+	t.Run("records without license are rejected", func(t *testing.T) {
+		err := policy.Check(licensedRecordData(t, ""))
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "record does not declare a license")
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/validation/config"
+	"golang.org/x/mod/semver"
+)
+
+// SchemaVersionPolicy decides which record schema versions are accepted on push.
+type SchemaVersionPolicy struct {
+	accepted []string
+}
+
+// NewSchemaVersionPolicy creates a schema version policy accepting the supported
+// schema versions allowed by the configuration.
+func NewSchemaVersionPolicy(cfg config.SchemaVersionsConfig) (*SchemaVersionPolicy, error) {
+	for _, version := range append([]string{cfg.Min, cfg.Max}, cfg.Deny...) {
+		if version != "" && !semver.IsValid(canonicalSchemaVersion(version)) {
+			return nil, fmt.Errorf("invalid schema version %q", version)
+		}
+	}
+
+	if cfg.Min != "" && cfg.Max != "" && semver.Compare(canonicalSchemaVersion(cfg.Min), canonicalSchemaVersion(cfg.Max)) > 0 {
+		return nil, fmt.Errorf("min schema version %s is greater than max schema version %s", cfg.Min, cfg.Max)
+	}
+
+	var accepted []string
+
+	for _, version := range types.SupportedSchemaVersions {
+		if schemaVersionAllowed(cfg, version) {
+			accepted = append(accepted, version)
+		}
+	}
+
+	if len(accepted) == 0 {
+		return nil, errors.New("schema version configuration does not accept any supported schema version")
+	}
+
+	return &SchemaVersionPolicy{accepted: accepted}, nil
+}
+
+// AcceptedVersions returns the accepted record schema versions.
+// All supported schema versions are accepted by a nil policy.
+func (p *SchemaVersionPolicy) AcceptedVersions() []string {
+	if p == nil {
+		return slices.Clone(types.SupportedSchemaVersions)
+	}
+
+	return slices.Clone(p.accepted)
+}
+
+// Check returns an error listing the accepted schema versions if the schema version is not accepted.
+func (p *SchemaVersionPolicy) Check(schemaVersion string) error {
+	accepted := p.AcceptedVersions()
+
+	if slices.ContainsFunc(accepted, func(version string) bool {
+		return canonicalSchemaVersion(version) == canonicalSchemaVersion(schemaVersion)
+	}) {
+		return nil
+	}
+
+	return corev1.NewUnsupportedSchemaVersionError(schemaVersion, accepted)
+}
+
+// schemaVersionAllowed reports whether the configuration allows the schema version.
+func schemaVersionAllowed(cfg config.SchemaVersionsConfig, version string) bool {
+	canonical := canonicalSchemaVersion(version)
+
+	if cfg.Min != "" && semver.Compare(canonical, canonicalSchemaVersion(cfg.Min)) < 0 {
+		return false
+	}
+
+	if cfg.Max != "" && semver.Compare(canonical, canonicalSchemaVersion(cfg.Max)) > 0 {
+		return false
+	}
+
+	return !slices.ContainsFunc(cfg.Deny, func(denied string) bool {
+		return canonicalSchemaVersion(denied) == canonical
+	})
+}
+
+// canonicalSchemaVersion converts schema versions such as 0.7.0 and v0.7.0 to the same semver form.
+func canonicalSchemaVersion(version string) string {
+	return "v" + strings.TrimPrefix(strings.TrimSpace(version), "v")
+}
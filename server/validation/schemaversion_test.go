@@ -0,0 +1,55 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/validation/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSchemaVersionPolicy(t *testing.T) {
+	t.Run("accepts all supported versions by default", func(t *testing.T) {
+		policy, err := NewSchemaVersionPolicy(config.SchemaVersionsConfig{})
+		require.NoError(t, err)
+		assert.Equal(t, types.SupportedSchemaVersions, policy.AcceptedVersions())
+		require.NoError(t, policy.Check("v0.3.1"))
+	})
+
+	t.Run("min, max and deny list", func(t *testing.T) {
+		policy, err := NewSchemaVersionPolicy(config.SchemaVersionsConfig{Min: "0.7.0", Deny: []string{"v0.8.0"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"0.7.0"}, policy.AcceptedVersions())
+		require.NoError(t, policy.Check("0.7.0"))
+
+		err = policy.Check("0.8.0")
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		unsupported, ok := corev1.GetUnsupportedSchemaVersion(err)
+		require.True(t, ok)
+		assert.Equal(t, "0.8.0", unsupported.GetSchemaVersion())
+		assert.Equal(t, []string{"0.7.0"}, unsupported.GetAcceptedSchemaVersions())
+
+		policy, err = NewSchemaVersionPolicy(config.SchemaVersionsConfig{Max: "0.7.0"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"0.3.1", "0.7.0"}, policy.AcceptedVersions())
+	})
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		_, err := NewSchemaVersionPolicy(config.SchemaVersionsConfig{Min: "latest"})
+		require.Error(t, err)
+
+		_, err = NewSchemaVersionPolicy(config.SchemaVersionsConfig{Min: "0.8.0", Max: "0.7.0"})
+		require.Error(t, err)
+
+		_, err = NewSchemaVersionPolicy(config.SchemaVersionsConfig{Min: "1.0.0"})
+		require.Error(t, err)
+	})
+}
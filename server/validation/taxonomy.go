@@ -0,0 +1,279 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/validation/config"
+	"github.com/agntcy/oasf-sdk/pkg/validator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSuggestions is the maximum number of taxonomy names suggested for an unknown name.
+const maxSuggestions = 3
+
+// TaxonomyPolicy checks that the skills and domains declared by records on push
+// exist in the OASF taxonomy of their schema version. Unknown names are reported
+// with suggestions of close taxonomy names.
+type TaxonomyPolicy struct {
+	mode string
+
+	mu         sync.Mutex
+	taxonomies map[string]*schemaTaxonomy // By schema version, nil if the schema has no taxonomy
+
+	// load returns the skill or domain definitions of a schema version, as in the OASF schema
+	load func(version, defsKey string) ([]byte, error)
+}
+
+// NewTaxonomyPolicy creates a taxonomy policy from the configuration.
+// It returns nil, accepting all records, if no mode is configured.
+func NewTaxonomyPolicy(cfg config.TaxonomyConfig) (*TaxonomyPolicy, error) {
+	switch cfg.Mode {
+	case "":
+		return nil, nil //nolint:nilnil // a nil policy accepts all records
+	case config.TaxonomyModeReject, config.TaxonomyModeWarn:
+	default:
+		return nil, fmt.Errorf("invalid taxonomy mode %q: must be %q or %q", cfg.Mode, config.TaxonomyModeReject, config.TaxonomyModeWarn)
+	}
+
+	return &TaxonomyPolicy{
+		mode:       cfg.Mode,
+		taxonomies: make(map[string]*schemaTaxonomy),
+		load:       validator.GetSchemaKey,
+	}, nil
+}
+
+// Warns reports whether records declaring unknown skills or domains are accepted with a warning.
+func (p *TaxonomyPolicy) Warns() bool {
+	return p != nil && p.mode == config.TaxonomyModeWarn
+}
+
+// Check returns an error listing the unknown skills and domains of a record with suggestions.
+// Records are only rejected in reject mode.
+func (p *TaxonomyPolicy) Check(data types.RecordData) error {
+	if p == nil || p.mode != config.TaxonomyModeReject {
+		return nil
+	}
+
+	if mismatches := p.Mismatches(data); len(mismatches) > 0 {
+		return status.Errorf(codes.InvalidArgument, "record does not match the OASF taxonomy: %s", strings.Join(mismatches, "; "))
+	}
+
+	return nil
+}
+
+// Mismatches returns the skills and domains of a record that are not in the OASF taxonomy
+// of its schema version. Records of schema versions without taxonomy are not checked.
+func (p *TaxonomyPolicy) Mismatches(data types.RecordData) []string {
+	if p == nil {
+		return nil
+	}
+
+	taxonomy := p.schemaTaxonomy(data.GetSchemaVersion())
+	if taxonomy == nil {
+		return nil
+	}
+
+	var mismatches []string
+
+	for _, skill := range data.GetSkills() {
+		if mismatch := taxonomy.skills.mismatch("skill", skill.GetName(), skill.GetID()); mismatch != "" {
+			mismatches = append(mismatches, mismatch)
+		}
+	}
+
+	for _, domain := range data.GetDomains() {
+		if mismatch := taxonomy.domains.mismatch("domain", domain.GetName(), domain.GetID()); mismatch != "" {
+			mismatches = append(mismatches, mismatch)
+		}
+	}
+
+	return mismatches
+}
+
+// schemaTaxonomy returns the taxonomy of a schema version, loaded once from the embedded OASF schemas.
+func (p *TaxonomyPolicy) schemaTaxonomy(schemaVersion string) *schemaTaxonomy {
+	version := strings.TrimPrefix(canonicalSchemaVersion(schemaVersion), "v")
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if taxonomy, loaded := p.taxonomies[version]; loaded {
+		return taxonomy
+	}
+
+	skills, skillsErr := p.loadClasses(version, "skills")
+	domains, domainsErr := p.loadClasses(version, "domains")
+
+	var taxonomy *schemaTaxonomy
+
+	switch {
+	case skillsErr != nil && domainsErr != nil:
+		logger.Debug("No OASF taxonomy for schema version, skills and domains are not checked", "schema_version", version, "error", skillsErr)
+	case len(skills.names) == 0 && len(domains.names) == 0:
+		logger.Debug("OASF schema version does not enumerate skill and domain names, skills and domains are not checked", "schema_version", version)
+	default:
+		taxonomy = &schemaTaxonomy{skills: skills, domains: domains}
+	}
+
+	p.taxonomies[version] = taxonomy
+
+	return taxonomy
+}
+
+// loadClasses loads the skill or domain classes of a schema version.
+func (p *TaxonomyPolicy) loadClasses(version, defsKey string) (*taxonomyClasses, error) {
+	classes := &taxonomyClasses{
+		names: make(map[string]bool),
+		ids:   make(map[uint64]bool),
+	}
+
+	data, err := p.load(version, defsKey)
+	if err != nil {
+		return classes, fmt.Errorf("failed to load %s: %w", defsKey, err)
+	}
+
+	var defs map[string]struct {
+		Properties struct {
+			Name struct {
+				Const string `json:"const"`
+			} `json:"name"`
+			ID struct {
+				Const uint64 `json:"const"`
+			} `json:"id"`
+		} `json:"properties"`
+	}
+
+	if err := json.Unmarshal(data, &defs); err != nil {
+		return classes, fmt.Errorf("failed to parse %s: %w", defsKey, err)
+	}
+
+	for _, def := range defs {
+		if name := def.Properties.Name.Const; name != "" {
+			classes.names[name] = true
+			classes.leaves = append(classes.leaves, name)
+
+			// Categories are valid names, e.g. "category" for "category/class"
+			for category := path.Dir(name); category != "."; category = path.Dir(category) {
+				classes.names[category] = true
+			}
+		}
+
+		if id := def.Properties.ID.Const; id != 0 {
+			classes.ids[id] = true
+		}
+	}
+
+	slices.Sort(classes.leaves)
+
+	return classes, nil
+}
+
+// schemaTaxonomy is the OASF taxonomy of a schema version.
+type schemaTaxonomy struct {
+	skills  *taxonomyClasses
+	domains *taxonomyClasses
+}
+
+// taxonomyClasses are the skill or domain classes of a taxonomy.
+type taxonomyClasses struct {
+	names  map[string]bool // Names of classes and their categories
+	ids    map[uint64]bool // IDs of classes
+	leaves []string        // Names of classes, sorted
+}
+
+// mismatch describes a skill or domain not in the taxonomy, with suggestions of close names.
+// Classes are checked by name, or by ID if they have no name. It returns an empty string
+// if the class is in the taxonomy.
+func (c *taxonomyClasses) mismatch(kind, name string, id uint64) string {
+	switch {
+	case name == "" && (id == 0 || len(c.ids) == 0 || c.ids[id]):
+		return ""
+	case name == "":
+		return fmt.Sprintf("unknown %s id %d", kind, id)
+	case len(c.names) == 0 || c.names[name]:
+		return ""
+	}
+
+	mismatch := fmt.Sprintf("unknown %s %q", kind, name)
+
+	if suggestions := c.suggest(name); len(suggestions) > 0 {
+		mismatch += fmt.Sprintf(" (did you mean %s?)", strings.Join(suggestions, ", "))
+	}
+
+	return mismatch
+}
+
+// suggest returns the closest names of classes to an unknown name, by edit distance.
+// Classes with the same last path segment are suggested too, for names declared
+// without or with a wrong category.
+func (c *taxonomyClasses) suggest(name string) []string {
+	type candidate struct {
+		name     string
+		distance int
+	}
+
+	leaf := path.Base(name)
+	maxDistance := max(2, len(leaf)/3) //nolint:mnd
+
+	var candidates []candidate
+
+	for _, class := range c.leaves {
+		distance := editDistance(name, class)
+		if path.Base(class) == leaf {
+			distance = min(distance, 1)
+		}
+
+		if distance <= maxDistance {
+			candidates = append(candidates, candidate{name: class, distance: distance})
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return cmp.Compare(a.distance, b.distance)
+	})
+
+	suggestions := make([]string, 0, min(len(candidates), maxSuggestions))
+	for _, candidate := range candidates[:min(len(candidates), maxSuggestions)] {
+		suggestions = append(suggestions, fmt.Sprintf("%q", candidate.name))
+	}
+
+	return suggestions
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			substitution := previous[j-1]
+			if a[i-1] != b[j-1] {
+				substitution++
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, substitution)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,129 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/types/adapters"
+	"github.com/agntcy/dir/server/validation/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// classifiedRecordData returns the data of a record of the schema version with the given skills and domains.
+func classifiedRecordData(t *testing.T, schemaVersion string, skills, domains []string) types.RecordData {
+	t.Helper()
+
+	classes := func(names []string) []byte {
+		var classes []map[string]string
+		for _, name := range names {
+			classes = append(classes, map[string]string{"name": name})
+		}
+
+		data, err := json.Marshal(classes)
+		require.NoError(t, err)
+
+		return data
+	}
+
+	record, err := corev1.UnmarshalRecord(fmt.Appendf(nil, `{
+		"name": "classified-agent",
+		"version": "1.0.0",
+		"schema_version": %q,
+		"skills": %s,
+		"domains": %s
+	}`, schemaVersion, classes(skills), classes(domains)))
+	require.NoError(t, err)
+
+	recordData, err := adapters.NewRecordAdapter(record).GetRecordData()
+	require.NoError(t, err)
+
+	return recordData
+}
+
+func TestNewTaxonomyPolicy(t *testing.T) {
+	policy, err := NewTaxonomyPolicy(config.TaxonomyConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+	assert.False(t, policy.Warns())
+	require.NoError(t, policy.Check(classifiedRecordData(t, "0.7.0", []string{"unknown"}, nil)))
+
+	_, err = NewTaxonomyPolicy(config.TaxonomyConfig{Mode: "annotate"})
+	assert.Error(t, err)
+}
+
+func TestTaxonomyPolicy_Mismatches(t *testing.T) {
+	policy, err := NewTaxonomyPolicy(config.TaxonomyConfig{Mode: config.TaxonomyModeReject})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		data       types.RecordData
+		mismatches []string
+	}{
+		{
+			name: "skills, categories and domains in the taxonomy",
+			data: classifiedRecordData(t, "0.7.0",
+				[]string{"analytical_skills/coding_skills/text_to_code", "analytical_skills"},
+				[]string{"education/e_learning"}),
+		},
+		{
+			name: "misspelled skill",
+			data: classifiedRecordData(t, "0.7.0", []string{"analytical_skills/coding_skills/text_to_cod"}, nil),
+			mismatches: []string{
+				`unknown skill "analytical_skills/coding_skills/text_to_cod" (did you mean "analytical_skills/coding_skills/text_to_code"?)`,
+			},
+		},
+		{
+			name: "skill without category",
+			data: classifiedRecordData(t, "0.7.0", []string{"text_to_code"}, nil),
+			mismatches: []string{
+				`unknown skill "text_to_code" (did you mean "analytical_skills/coding_skills/text_to_code"?)`,
+			},
+		},
+		{
+			name:       "unknown domain without close names",
+			data:       classifiedRecordData(t, "0.7.0", nil, []string{"astrology"}),
+			mismatches: []string{`unknown domain "astrology"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.mismatches, policy.Mismatches(tt.data))
+
+			err := policy.Check(tt.data)
+			if len(tt.mismatches) == 0 {
+				require.NoError(t, err)
+			} else {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestTaxonomyPolicy_Warn(t *testing.T) {
+	policy, err := NewTaxonomyPolicy(config.TaxonomyConfig{Mode: config.TaxonomyModeWarn})
+	require.NoError(t, err)
+	assert.True(t, policy.Warns())
+
+	// Records with unknown skills are accepted in warn mode
+	data := classifiedRecordData(t, "0.7.0", []string{"unknown"}, nil)
+	require.NoError(t, policy.Check(data))
+	assert.Len(t, policy.Mismatches(data), 1)
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("skill", "skill"))
+	assert.Equal(t, 1, editDistance("skill", "skil"))
+	assert.Equal(t, 2, editDistance("skill", "skull!"))
+	assert.Equal(t, 5, editDistance("", "skill"))
+}
@@ -0,0 +1,250 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package validation re-validates stored records against the current OASF
+// schemas and validation rules to detect records that no longer validate.
+package validation
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	corev1 "github.com/agntcy/dir/api/core/v1"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/validation/config"
+	"github.com/agntcy/dir/utils/logging"
+)
+
+// rulesModule is the module providing OASF schemas and validation rules.
+const rulesModule = "github.com/agntcy/oasf-sdk/pkg"
+
+var logger = logging.Logger("validation")
+
+// RulesVersion returns the version of the validation rules used by this build.
+// It changes whenever new OASF schema versions or validation rules are added.
+func RulesVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path == rulesModule {
+			if dep.Replace != nil {
+				return dep.Replace.Version
+			}
+
+			return dep.Version
+		}
+	}
+
+	return "unknown"
+}
+
+// Result is the outcome of re-validating a stored record.
+type Result struct {
+	CID           string
+	Valid         bool
+	Errors        []string
+	SchemaVersion string
+	RulesVersion  string
+
+	// Drift is set when the record was valid before and no longer validates.
+	Drift bool
+}
+
+// Validator re-validates stored records and records the results.
+type Validator struct {
+	store        types.StoreAPI
+	db           types.DatabaseAPI
+	eventBus     *events.SafeEventBus
+	rulesVersion string
+}
+
+// NewValidator creates a new stored record validator.
+func NewValidator(store types.StoreAPI, db types.DatabaseAPI, eventBus *events.SafeEventBus) *Validator {
+	return &Validator{
+		store:        store,
+		db:           db,
+		eventBus:     eventBus,
+		rulesVersion: RulesVersion(),
+	}
+}
+
+// RulesVersion returns the version of the validation rules used by the validator.
+func (v *Validator) RulesVersion() string {
+	return v.rulesVersion
+}
+
+// Validate re-validates the stored record with the current validation rules.
+// Records are accepted as valid when stored, so a record is flagged as drifted
+// when it no longer validates and did validate at its last validation.
+// A RECORD_VALIDATION_DRIFT event is emitted for drifted records.
+func (v *Validator) Validate(ctx context.Context, cid string) (*Result, error) {
+	record, err := v.store.Pull(ctx, &corev1.RecordRef{Cid: cid})
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull record: %w", err)
+	}
+
+	valid, validationErrors, err := record.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate record: %w", err)
+	}
+
+	_, previousErrors, err := v.db.GetRecordValidation(cid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get previous validation: %w", err)
+	}
+
+	result := &Result{
+		CID:           cid,
+		Valid:         valid,
+		Errors:        validationErrors,
+		SchemaVersion: record.GetSchemaVersion(),
+		RulesVersion:  v.rulesVersion,
+		Drift:         !valid && len(previousErrors) == 0,
+	}
+
+	if valid {
+		result.Errors = nil
+	}
+
+	if err := v.db.SetRecordValidation(cid, v.rulesVersion, result.Errors); err != nil {
+		return nil, fmt.Errorf("failed to store validation result: %w", err)
+	}
+
+	if result.Drift {
+		logger.Warn("Stored record no longer validates", "cid", cid, "rules_version", v.rulesVersion, "errors", result.Errors)
+
+		v.eventBus.RecordValidationDrift(cid, result.SchemaVersion, v.rulesVersion, result.Errors)
+	}
+
+	return result, nil
+}
+
+// Service periodically re-validates stored records that were not yet
+// validated with the current validation rules.
+type Service struct {
+	validator *Validator
+	db        types.DatabaseAPI
+	config    config.Config
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New creates a new validation service.
+func New(db types.DatabaseAPI, store types.StoreAPI, opts types.APIOptions) (*Service, error) {
+	return &Service{
+		validator: NewValidator(store, db, opts.EventBus()),
+		db:        db,
+		config:    opts.Config().Validation,
+		stopCh:    make(chan struct{}),
+	}, nil
+}
+
+// Start begins the periodic re-validation of stored records.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.config.Enabled {
+		logger.Info("Stored record validation disabled")
+
+		return nil
+	}
+
+	logger.Info("Starting validation service", "interval", s.config.Interval, "rules_version", s.validator.RulesVersion())
+
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		s.run(ctx)
+	}()
+
+	return nil
+}
+
+// Stop gracefully shuts down the validation service.
+func (s *Service) Stop() error {
+	logger.Info("Stopping validation service")
+
+	close(s.stopCh)
+	s.wg.Wait()
+
+	logger.Info("Validation service stopped")
+
+	return nil
+}
+
+func (s *Service) run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	// Process immediately on start to pick up new validation rules
+	s.validatePending(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.validatePending(ctx)
+		}
+	}
+}
+
+// validatePending re-validates all stored records not yet validated with the current rules.
+func (s *Service) validatePending(ctx context.Context) {
+	cids, err := s.db.GetRecordCIDs()
+	if err != nil {
+		logger.Error("Failed to get stored records", "error", err)
+
+		return
+	}
+
+	var validated, drifted int
+
+	for _, cid := range cids {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		default:
+		}
+
+		rulesVersion, _, err := s.db.GetRecordValidation(cid)
+		if err != nil {
+			logger.Error("Failed to get record validation", "cid", cid, "error", err)
+
+			continue
+		}
+
+		if rulesVersion == s.validator.RulesVersion() {
+			continue
+		}
+
+		result, err := s.validator.Validate(ctx, cid)
+		if err != nil {
+			logger.Error("Failed to re-validate stored record", "cid", cid, "error", err)
+
+			continue
+		}
+
+		validated++
+
+		if result.Drift {
+			drifted++
+		}
+	}
+
+	if validated > 0 {
+		logger.Info("Re-validated stored records", "validated", validated, "drifted", drifted, "rules_version", s.validator.RulesVersion())
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+const (
+	DefaultEnabled       = true
+	DefaultCheckInterval = 30 * time.Second
+	DefaultMaxRetries    = 3
+	DefaultRetryBackoff  = 1 * time.Minute
+)
+
+// Config is the configuration of the watchdog of the sync and publication workers.
+type Config struct {
+	// Enabled turns on the watchdog.
+	// Tasks making no progress for their worker timeout are cancelled and retried,
+	// so that a call ignoring cancellation does not hold its worker forever.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// CheckInterval is the interval at which running tasks are checked.
+	CheckInterval time.Duration `json:"check_interval,omitempty" mapstructure:"check_interval"`
+
+	// MaxRetries is the number of times a stalled task is retried before it fails.
+	MaxRetries int `json:"max_retries,omitempty" mapstructure:"max_retries"`
+
+	// RetryBackoff is the delay before the first retry of a stalled task.
+	// The delay doubles with each retry.
+	RetryBackoff time.Duration `json:"retry_backoff,omitempty" mapstructure:"retry_backoff"`
+}
+
+// Backoff returns the delay before retrying a task that stalled attempt times before.
+func (c Config) Backoff(attempt int) time.Duration {
+	return c.RetryBackoff << min(max(attempt, 0), 16) //nolint:mnd
+}
@@ -0,0 +1,321 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package watchdog supervises the tasks of background workers, such as syncs and publications.
+// Tasks making no progress for their timeout, e.g. blocked on a remote call that ignores
+// cancellation, are force-cancelled and abandoned, so that they do not hold their worker forever.
+package watchdog
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/agntcy/dir/server/watchdog/config"
+	"github.com/agntcy/dir/utils/logging"
+)
+
+var logger = logging.Logger("watchdog")
+
+// ErrStalled is the cause of the cancellation of stalled tasks.
+var ErrStalled = errors.New("task stalled")
+
+const (
+	// maxStackSize bounds the stack snapshot recorded for stalled tasks.
+	maxStackSize = 64 << 10
+
+	// maxStalls bounds the number of stalled task diagnostics kept.
+	maxStalls = 32
+)
+
+// Stall describes a task force-cancelled by the watchdog.
+type Stall struct {
+	// Kind of the task, e.g. "sync" or "publication".
+	Kind string
+
+	// Name of the task, e.g. the ID of the sync or publication.
+	Name string
+
+	// WorkerID is the ID of the worker that ran the task.
+	WorkerID int
+
+	// Attempt is the number of times the task stalled before.
+	Attempt int
+
+	StartedAt      time.Time
+	StalledAt      time.Time
+	LastProgressAt time.Time
+
+	// LastCheckpoint is the last checkpoint reported by the task, empty if none.
+	LastCheckpoint string
+
+	// Stack is a snapshot of the stacks of all goroutines when the task stalled.
+	Stack string
+}
+
+// Task is a task supervised by the watchdog.
+type Task struct {
+	kind     string
+	name     string
+	workerID int
+	attempt  int
+	timeout  time.Duration
+
+	startedAt time.Time
+	cancel    context.CancelCauseFunc
+	stalled   chan struct{}
+
+	mu         sync.Mutex
+	progressAt time.Time
+	checkpoint string
+}
+
+type taskKey struct{}
+
+// Checkpoint reports the progress of the task supervised with the context, e.g. "negotiating credentials".
+// Tasks only stall when they report no progress for their timeout.
+// No-op if the context is not supervised.
+func Checkpoint(ctx context.Context, name string) {
+	task, ok := ctx.Value(taskKey{}).(*Task)
+	if !ok {
+		return
+	}
+
+	task.mu.Lock()
+	defer task.mu.Unlock()
+
+	task.progressAt = time.Now()
+	task.checkpoint = name
+}
+
+// Watchdog cancels the supervised tasks making no progress for their timeout.
+type Watchdog struct {
+	config  config.Config
+	onStall func(Stall)
+
+	mu     sync.Mutex
+	tasks  map[*Task]struct{}
+	stalls []Stall
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New creates a watchdog calling onStall for each stalled task, if set.
+// Returns nil if the watchdog is disabled, in which case tasks run unsupervised.
+func New(cfg config.Config, onStall func(Stall)) *Watchdog {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	return &Watchdog{
+		config:  cfg,
+		onStall: onStall,
+		tasks:   make(map[*Task]struct{}),
+		stopCh:  make(chan struct{}),
+	}
+}
+
+// Start starts checking the supervised tasks. No-op if the watchdog is nil.
+func (w *Watchdog) Start(ctx context.Context) {
+	if w == nil {
+		return
+	}
+
+	w.wg.Add(1)
+
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(w.config.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-w.stopCh:
+				return
+			case now := <-ticker.C:
+				w.check(now)
+			}
+		}
+	}()
+}
+
+// Stop stops checking the supervised tasks and drops the pending retries. No-op if the watchdog is nil.
+func (w *Watchdog) Stop() {
+	if w == nil {
+		return
+	}
+
+	close(w.stopCh)
+	w.wg.Wait()
+}
+
+// Supervise runs fn as a task of a worker, and returns once it completes or stalls.
+// The task stalls if it reports no progress for its timeout, in which case its context
+// is cancelled with ErrStalled, the task is abandoned in the background and ErrStalled is returned.
+// Attempt is the number of times the task stalled before. fn runs unsupervised if the watchdog is nil.
+func (w *Watchdog) Supervise(ctx context.Context, kind, name string, workerID, attempt int, timeout time.Duration, fn func(ctx context.Context)) error {
+	if w == nil {
+		fn(ctx)
+
+		return nil
+	}
+
+	taskCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	now := time.Now()
+	task := &Task{
+		kind:       kind,
+		name:       name,
+		workerID:   workerID,
+		attempt:    attempt,
+		timeout:    timeout,
+		startedAt:  now,
+		cancel:     cancel,
+		stalled:    make(chan struct{}),
+		progressAt: now,
+	}
+
+	w.mu.Lock()
+	w.tasks[task] = struct{}{}
+	w.mu.Unlock()
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		fn(context.WithValue(taskCtx, taskKey{}, task))
+	}()
+
+	select {
+	case <-done:
+		w.mu.Lock()
+		delete(w.tasks, task)
+		w.mu.Unlock()
+
+		return nil
+	case <-task.stalled:
+		return ErrStalled
+	}
+}
+
+// Retry sends an item back to a work queue once the backoff of its attempt elapsed, unless stopped first.
+// Attempt is the number of times the item stalled before. Returns false without retrying
+// if the item exceeded the maximum number of retries, or if the watchdog is nil.
+func Retry[T any](ctx context.Context, w *Watchdog, queue chan<- T, item T, attempt int) bool {
+	if w == nil || attempt >= w.config.MaxRetries {
+		return false
+	}
+
+	w.wg.Add(1)
+
+	go func() {
+		defer w.wg.Done()
+
+		timer := time.NewTimer(w.config.Backoff(attempt))
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.stopCh:
+			return
+		case <-timer.C:
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-w.stopCh:
+		case queue <- item:
+		}
+	}()
+
+	return true
+}
+
+// Stalls returns the diagnostics of the most recently stalled tasks, oldest first.
+// Returns nil if the watchdog is nil.
+func (w *Watchdog) Stalls() []Stall {
+	if w == nil {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	stalls := make([]Stall, len(w.stalls))
+	copy(stalls, w.stalls)
+
+	return stalls
+}
+
+// check cancels the tasks that reported no progress for their timeout.
+func (w *Watchdog) check(now time.Time) {
+	var stalled []*Task
+
+	w.mu.Lock()
+
+	for task := range w.tasks {
+		task.mu.Lock()
+		idle := now.Sub(task.progressAt)
+		task.mu.Unlock()
+
+		if idle > task.timeout {
+			stalled = append(stalled, task)
+			delete(w.tasks, task)
+		}
+	}
+
+	w.mu.Unlock()
+
+	for _, task := range stalled {
+		w.stall(task, now)
+	}
+}
+
+// stall records the diagnostics of a stalled task, then cancels and abandons it.
+func (w *Watchdog) stall(task *Task, now time.Time) {
+	stack := make([]byte, maxStackSize)
+	stack = stack[:runtime.Stack(stack, true)]
+
+	task.mu.Lock()
+	stall := Stall{
+		Kind:           task.kind,
+		Name:           task.name,
+		WorkerID:       task.workerID,
+		Attempt:        task.attempt,
+		StartedAt:      task.startedAt,
+		StalledAt:      now,
+		LastProgressAt: task.progressAt,
+		LastCheckpoint: task.checkpoint,
+		Stack:          string(stack),
+	}
+	task.mu.Unlock()
+
+	task.cancel(ErrStalled)
+	close(task.stalled)
+
+	logger.Error("Worker task stalled, cancelling it", "kind", stall.Kind, "name", stall.Name, "worker_id", stall.WorkerID,
+		"attempt", stall.Attempt, "running_for", now.Sub(stall.StartedAt), "last_checkpoint", stall.LastCheckpoint,
+		"last_progress_at", stall.LastProgressAt)
+	logger.Debug("Stalled worker task stack", "kind", stall.Kind, "name", stall.Name, "stack", stall.Stack)
+
+	w.mu.Lock()
+	w.stalls = append(w.stalls, stall)
+
+	if len(w.stalls) > maxStalls {
+		w.stalls = w.stalls[len(w.stalls)-maxStalls:]
+	}
+	w.mu.Unlock()
+
+	if w.onStall != nil {
+		w.onStall(stall)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package watchdog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agntcy/dir/server/watchdog/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() config.Config {
+	return config.Config{
+		Enabled:       true,
+		CheckInterval: time.Hour, // checks are triggered manually
+		MaxRetries:    2,
+		RetryBackoff:  time.Millisecond,
+	}
+}
+
+func TestSupervise(t *testing.T) {
+	t.Run("completed task", func(t *testing.T) {
+		w := New(testConfig(), nil)
+
+		err := w.Supervise(t.Context(), "sync", "completed", 0, 0, time.Minute, func(context.Context) {})
+		require.NoError(t, err)
+		assert.Empty(t, w.tasks)
+	})
+
+	t.Run("stalled task", func(t *testing.T) {
+		var stalls []Stall
+
+		w := New(testConfig(), func(stall Stall) { stalls = append(stalls, stall) })
+
+		cancelled := make(chan error, 1)
+		errCh := make(chan error, 1)
+
+		go func() {
+			errCh <- w.Supervise(t.Context(), "sync", "stalled", 1, 0, time.Second, func(ctx context.Context) {
+				Checkpoint(ctx, "blocked")
+				<-ctx.Done()
+				cancelled <- context.Cause(ctx)
+			})
+		}()
+
+		waitForTasks(t, w, 1)
+		w.check(time.Now().Add(time.Minute))
+
+		require.ErrorIs(t, <-errCh, ErrStalled)
+		require.ErrorIs(t, <-cancelled, ErrStalled)
+
+		require.Len(t, stalls, 1)
+		assert.Equal(t, "sync", stalls[0].Kind)
+		assert.Equal(t, "stalled", stalls[0].Name)
+		assert.Equal(t, 1, stalls[0].WorkerID)
+		assert.Equal(t, "blocked", stalls[0].LastCheckpoint)
+		assert.NotEmpty(t, stalls[0].Stack)
+		assert.Equal(t, stalls, w.Stalls())
+	})
+
+	t.Run("task making progress", func(t *testing.T) {
+		w := New(testConfig(), nil)
+
+		progressed := make(chan struct{})
+		release := make(chan struct{})
+		errCh := make(chan error, 1)
+
+		go func() {
+			errCh <- w.Supervise(t.Context(), "publication", "progressing", 0, 0, time.Second, func(ctx context.Context) {
+				<-progressed
+				Checkpoint(ctx, "announcing")
+				<-release
+			})
+		}()
+
+		waitForTasks(t, w, 1)
+		time.Sleep(10 * time.Millisecond)
+		progressed <- struct{}{}
+
+		// Idle since the checkpoint for less than the timeout
+		time.Sleep(10 * time.Millisecond)
+		w.check(time.Now().Add(time.Second - 5*time.Millisecond))
+		close(release)
+
+		require.NoError(t, <-errCh)
+		assert.Empty(t, w.Stalls())
+	})
+
+	t.Run("disabled watchdog", func(t *testing.T) {
+		var w *Watchdog
+
+		ran := false
+		err := w.Supervise(t.Context(), "sync", "unsupervised", 0, 0, time.Nanosecond, func(context.Context) { ran = true })
+		require.NoError(t, err)
+		assert.True(t, ran)
+		assert.Nil(t, w.Stalls())
+
+		w.Start(t.Context())
+		w.Stop()
+	})
+}
+
+func TestRetry(t *testing.T) {
+	w := New(testConfig(), nil)
+	queue := make(chan int, 1)
+
+	require.True(t, Retry(t.Context(), w, queue, 42, 1))
+
+	select {
+	case item := <-queue:
+		assert.Equal(t, 42, item)
+	case <-time.After(time.Second):
+		t.Fatal("item not retried")
+	}
+
+	// Items exceeding the maximum number of retries are not retried
+	assert.False(t, Retry(t.Context(), w, queue, 42, 2))
+	assert.False(t, Retry[int](t.Context(), nil, queue, 42, 0))
+
+	// Pending retries are dropped on stop
+	w.config.RetryBackoff = time.Hour
+	require.True(t, Retry(t.Context(), w, queue, 42, 0))
+	w.Stop()
+	assert.Empty(t, queue)
+}
+
+func TestBackoff(t *testing.T) {
+	cfg := config.Config{RetryBackoff: time.Minute}
+
+	assert.Equal(t, time.Minute, cfg.Backoff(0))
+	assert.Equal(t, 4*time.Minute, cfg.Backoff(2))
+}
+
+// waitForTasks waits until the watchdog supervises the given number of tasks.
+func waitForTasks(t *testing.T, w *Watchdog, count int) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		return len(w.tasks) == count
+	}, time.Second, time.Millisecond)
+}
@@ -0,0 +1,27 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+const (
+	DefaultWebhooksEnabled      = false
+	DefaultWebhooksTimeout      = 5 * time.Second
+	DefaultWebhooksMaxPerRecord = 10
+	DefaultWebhooksWorkers      = 4
+)
+
+type Config struct {
+	// Enabled allows registering record webhooks and dispatches events to them.
+	Enabled bool `json:"enabled,omitempty" mapstructure:"enabled"`
+
+	// Timeout of the HTTP requests sent to webhook URLs.
+	Timeout time.Duration `json:"timeout,omitempty" mapstructure:"timeout"`
+
+	// MaxPerRecord is the maximum number of webhooks registered for a record.
+	MaxPerRecord int `json:"max_per_record,omitempty" mapstructure:"max_per_record"`
+
+	// Workers is the number of webhook requests sent concurrently.
+	Workers int `json:"workers,omitempty" mapstructure:"workers"`
+}
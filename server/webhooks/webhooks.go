@@ -0,0 +1,399 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package webhooks notifies URLs registered for a record of the events
+// affecting the record.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/webhooks/config"
+	"github.com/agntcy/dir/utils/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gorm.io/gorm"
+)
+
+var logger = logging.Logger("webhooks")
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the request body
+	// for webhooks registered with a secret.
+	SignatureHeader = "X-Dir-Signature"
+
+	// EventTypeHeader carries the type of the delivered event.
+	EventTypeHeader = "X-Dir-Event"
+
+	// deliveryQueueSize is the maximum number of deliveries waiting for a worker.
+	// Deliveries are dropped once the queue is full.
+	deliveryQueueSize = 256
+)
+
+// recordEventTypes are the event types whose resource is a record CID.
+var recordEventTypes = []eventsv1.EventType{
+	eventsv1.EventType_EVENT_TYPE_RECORD_PUSHED,
+	eventsv1.EventType_EVENT_TYPE_RECORD_PULLED,
+	eventsv1.EventType_EVENT_TYPE_RECORD_DELETED,
+	eventsv1.EventType_EVENT_TYPE_RECORD_PUBLISHED,
+	eventsv1.EventType_EVENT_TYPE_RECORD_UNPUBLISHED,
+	eventsv1.EventType_EVENT_TYPE_RECORD_SIGNED,
+	eventsv1.EventType_EVENT_TYPE_RECORD_VALIDATION_DRIFT,
+	eventsv1.EventType_EVENT_TYPE_RECORD_FLAGGED,
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the body with the secret,
+// as sent in the SignatureHeader.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// delivery is a record event to send to a webhook.
+type delivery struct {
+	webhook   types.RecordWebhookObject
+	cid       string
+	eventType eventsv1.EventType
+	body      []byte
+}
+
+// Service manages record webhooks and dispatches record events to them.
+type Service struct {
+	db       types.DatabaseAPI
+	eventBus *events.SafeEventBus
+	config   config.Config
+	client   *http.Client
+
+	// allowPrivateTargets allows webhook URLs of loopback and private network addresses, for tests
+	allowPrivateTargets bool
+
+	deliveries chan delivery
+	stopCh     chan struct{}
+	wg         sync.WaitGroup
+}
+
+// New creates a new webhook service.
+func New(db types.DatabaseAPI, opts types.APIOptions) (*Service, error) {
+	cfg := opts.Config().Webhooks
+	if cfg.Enabled && cfg.Timeout <= 0 {
+		return nil, fmt.Errorf("webhook timeout must be positive, got %s", cfg.Timeout)
+	}
+
+	if cfg.Enabled && cfg.Workers <= 0 {
+		return nil, fmt.Errorf("webhook workers must be positive, got %d", cfg.Workers)
+	}
+
+	s := &Service{
+		db:         db,
+		eventBus:   opts.EventBus(),
+		config:     cfg,
+		deliveries: make(chan delivery, deliveryQueueSize),
+		stopCh:     make(chan struct{}),
+	}
+
+	// Addresses are checked when connecting, so that hosts cannot resolve to private addresses once registered.
+	// Requests are not sent through proxies, whose address would be checked instead.
+	dialer := &net.Dialer{Control: s.checkDialAddress}
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+	transport.DialContext = dialer.DialContext
+	transport.Proxy = nil
+	s.client = &http.Client{Transport: transport}
+
+	return s, nil
+}
+
+// Register registers a webhook notified of the events affecting a stored record.
+// Only the owner of the record, the identity that first pushed it, can register webhooks for it.
+// If no event types are given, the webhook is notified of all record events.
+func (s *Service) Register(cid, webhookURL string, eventTypes []eventsv1.EventType, secret, owner string) (types.RecordWebhookObject, error) {
+	if !s.config.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "record webhooks are disabled on this server")
+	}
+
+	if cid == "" {
+		return nil, status.Error(codes.InvalidArgument, "cid is required")
+	}
+
+	parsed, err := url.Parse(webhookURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook url %q: must be an absolute http or https URL", webhookURL)
+	}
+
+	if !s.allowPrivateTargets && isPrivateHost(parsed.Hostname()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook url %q: loopback and private network addresses are not allowed", webhookURL)
+	}
+
+	for _, eventType := range eventTypes {
+		if !slices.Contains(recordEventTypes, eventType) {
+			return nil, status.Errorf(codes.InvalidArgument, "event type %s is not a record event", eventType)
+		}
+	}
+
+	cids, err := s.db.GetRecordCIDs(types.WithCIDs(cid), types.WithLimit(1))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to look up record: %v", err)
+	}
+
+	if len(cids) == 0 {
+		return nil, status.Errorf(codes.NotFound, "record %s not found", cid)
+	}
+
+	recordOwner, err := s.db.GetRecordOwner(cid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get record owner: %v", err)
+	}
+
+	if recordOwner != owner {
+		return nil, status.Errorf(codes.PermissionDenied, "only the owner of record %s can register webhooks for it", cid)
+	}
+
+	existing, err := s.db.GetRecordWebhooksByCID(cid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get record webhooks: %v", err)
+	}
+
+	if s.config.MaxPerRecord > 0 && len(existing) >= s.config.MaxPerRecord {
+		return nil, status.Errorf(codes.ResourceExhausted, "record %s already has the maximum of %d webhooks", cid, s.config.MaxPerRecord)
+	}
+
+	webhookID, err := s.db.CreateRecordWebhook(cid, webhookURL, eventTypes, secret, owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create record webhook: %v", err)
+	}
+
+	webhook, err := s.db.GetRecordWebhookByID(webhookID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get record webhook: %v", err)
+	}
+
+	logger.Info("Registered record webhook", "webhook_id", webhookID, "cid", cid, "owner", owner)
+
+	return webhook, nil
+}
+
+// List returns the webhooks of the owner, optionally only those of a record.
+func (s *Service) List(owner, cid string) ([]types.RecordWebhookObject, error) {
+	webhooks, err := s.db.GetRecordWebhooksByOwner(owner, cid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list record webhooks: %v", err)
+	}
+
+	return webhooks, nil
+}
+
+// Delete deletes a webhook of the owner.
+// Webhooks of other owners are reported as not found, so that their IDs are not disclosed.
+func (s *Service) Delete(webhookID, owner string) error {
+	if webhookID == "" {
+		return status.Error(codes.InvalidArgument, "webhook_id is required")
+	}
+
+	webhook, err := s.db.GetRecordWebhookByID(webhookID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && webhook.GetOwner() != owner) {
+		return status.Errorf(codes.NotFound, "webhook %s not found", webhookID)
+	}
+
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get record webhook: %v", err)
+	}
+
+	if err := s.db.DeleteRecordWebhook(webhookID); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete record webhook: %v", err)
+	}
+
+	logger.Info("Deleted record webhook", "webhook_id", webhookID, "owner", owner)
+
+	return nil
+}
+
+// Start begins dispatching record events to the registered webhooks.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.config.Enabled {
+		logger.Info("Record webhooks disabled")
+
+		return nil
+	}
+
+	subID, eventCh := s.eventBus.Subscribe(&eventsv1.ListenRequest{EventTypes: recordEventTypes})
+	if eventCh == nil {
+		logger.Info("Event bus disabled, record webhooks are not notified")
+
+		return nil
+	}
+
+	logger.Info("Starting webhook service", "timeout", s.config.Timeout, "max_per_record", s.config.MaxPerRecord, "workers", s.config.Workers)
+
+	for range s.config.Workers {
+		s.wg.Add(1)
+
+		go s.deliver(ctx)
+	}
+
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+		defer s.eventBus.Unsubscribe(subID)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stopCh:
+				return
+			case event, ok := <-eventCh:
+				if !ok {
+					return
+				}
+
+				s.dispatch(event)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop gracefully shuts down the webhook service.
+func (s *Service) Stop() error {
+	logger.Info("Stopping webhook service")
+
+	close(s.stopCh)
+	s.wg.Wait()
+
+	logger.Info("Webhook service stopped")
+
+	return nil
+}
+
+// dispatch queues the event for the webhooks of its record that are notified of its type.
+// Deliveries are dropped if the queue is full, and are not retried.
+func (s *Service) dispatch(event *events.Event) {
+	webhooks, err := s.db.GetRecordWebhooksByCID(event.ResourceID)
+	if err != nil {
+		logger.Error("Failed to get record webhooks", "cid", event.ResourceID, "error", err)
+
+		return
+	}
+
+	if len(webhooks) == 0 {
+		return
+	}
+
+	body, err := protojson.Marshal(event.ToProto())
+	if err != nil {
+		logger.Error("Failed to marshal event", "event_id", event.ID, "error", err)
+
+		return
+	}
+
+	for _, webhook := range webhooks {
+		if eventTypes := webhook.GetEventTypes(); len(eventTypes) > 0 && !slices.Contains(eventTypes, event.Type) {
+			continue
+		}
+
+		select {
+		case s.deliveries <- delivery{webhook: webhook, cid: event.ResourceID, eventType: event.Type, body: body}:
+		default:
+			logger.Warn("Webhook delivery queue is full, dropping delivery", "webhook_id", webhook.GetID(), "cid", event.ResourceID, "event_type", event.Type)
+		}
+	}
+}
+
+// deliver sends the queued deliveries until the service stops.
+func (s *Service) deliver(ctx context.Context) {
+	defer s.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case d := <-s.deliveries:
+			if err := s.send(ctx, d.webhook, d.eventType, d.body); err != nil {
+				logger.Warn("Failed to notify record webhook", "webhook_id", d.webhook.GetID(), "cid", d.cid, "event_type", d.eventType, "error", err)
+			}
+		}
+	}
+}
+
+// send posts the event body to the webhook URL, giving up after the configured timeout.
+func (s *Service) send(ctx context.Context, webhook types.RecordWebhookObject, eventType eventsv1.EventType, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.GetURL(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, eventType.String())
+
+	if secret := webhook.GetSecret(); secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// checkDialAddress rejects connections to loopback and private network addresses,
+// including those of hosts resolving to such addresses.
+func (s *Service) checkDialAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid address %q: %w", address, err)
+	}
+
+	if !s.allowPrivateTargets && isPrivateHost(host) {
+		return fmt.Errorf("address %s is a loopback or private network address", host)
+	}
+
+	return nil
+}
+
+// isPrivateHost reports whether a host is localhost or a loopback, private,
+// link-local or unspecified IP address. Other host names are not resolved.
+func isPrivateHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified()
+}
@@ -0,0 +1,340 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	eventsv1 "github.com/agntcy/dir/api/events/v1"
+	serverconfig "github.com/agntcy/dir/server/config"
+	"github.com/agntcy/dir/server/events"
+	"github.com/agntcy/dir/server/types"
+	"github.com/agntcy/dir/server/webhooks/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	testCID   = "baeareihdr6t7s6sr2q4zo456sza66eewqc7huzatyfgvoupaqyjw23ilvi"
+	testOwner = "spiffe://example.org/owner"
+)
+
+type fakeWebhook struct {
+	id, cid, url, secret, owner string
+	eventTypes                  []eventsv1.EventType
+}
+
+func (w *fakeWebhook) GetID() string                       { return w.id }
+func (w *fakeWebhook) GetCID() string                      { return w.cid }
+func (w *fakeWebhook) GetURL() string                      { return w.url }
+func (w *fakeWebhook) GetEventTypes() []eventsv1.EventType { return w.eventTypes }
+func (w *fakeWebhook) GetSecret() string                   { return w.secret }
+func (w *fakeWebhook) GetOwner() string                    { return w.owner }
+func (w *fakeWebhook) GetCreatedTime() string              { return "" }
+
+type fakeDatabase struct {
+	types.DatabaseAPI
+
+	owner    string
+	webhooks []*fakeWebhook
+}
+
+func (d *fakeDatabase) GetRecordCIDs(opts ...types.FilterOption) ([]string, error) {
+	cfg := &types.RecordFilters{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	for _, cid := range cfg.CIDs {
+		if cid == testCID {
+			return []string{testCID}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (d *fakeDatabase) GetRecordOwner(string) (string, error) {
+	return d.owner, nil
+}
+
+func (d *fakeDatabase) CreateRecordWebhook(cid, url string, eventTypes []eventsv1.EventType, secret, owner string) (string, error) {
+	id := strconv.Itoa(len(d.webhooks) + 1)
+	d.webhooks = append(d.webhooks, &fakeWebhook{id: id, cid: cid, url: url, secret: secret, owner: owner, eventTypes: eventTypes})
+
+	return id, nil
+}
+
+func (d *fakeDatabase) GetRecordWebhookByID(webhookID string) (types.RecordWebhookObject, error) {
+	for _, webhook := range d.webhooks {
+		if webhook.id == webhookID {
+			return webhook, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *fakeDatabase) GetRecordWebhooksByOwner(owner, cid string) ([]types.RecordWebhookObject, error) {
+	var webhooks []types.RecordWebhookObject
+
+	for _, webhook := range d.webhooks {
+		if webhook.owner == owner && (cid == "" || webhook.cid == cid) {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+
+	return webhooks, nil
+}
+
+func (d *fakeDatabase) GetRecordWebhooksByCID(cid string) ([]types.RecordWebhookObject, error) {
+	var webhooks []types.RecordWebhookObject
+
+	for _, webhook := range d.webhooks {
+		if webhook.cid == cid {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+
+	return webhooks, nil
+}
+
+func (d *fakeDatabase) DeleteRecordWebhook(webhookID string) error {
+	for i, webhook := range d.webhooks {
+		if webhook.id == webhookID {
+			d.webhooks = append(d.webhooks[:i], d.webhooks[i+1:]...)
+		}
+	}
+
+	return nil
+}
+
+func newTestService(t *testing.T, cfg config.Config) (*Service, *fakeDatabase, *events.EventBus) {
+	t.Helper()
+
+	db := &fakeDatabase{owner: testOwner}
+	bus := events.NewEventBus()
+	opts := types.NewOptions(&serverconfig.Config{Webhooks: cfg}).WithEventBus(events.NewSafeEventBus(bus))
+
+	service, err := New(db, opts)
+	require.NoError(t, err)
+
+	return service, db, bus
+}
+
+func TestRegister(t *testing.T) {
+	service, _, _ := newTestService(t, config.Config{Enabled: true, Timeout: time.Second, MaxPerRecord: 1, Workers: 1})
+
+	tests := []struct {
+		name       string
+		cid        string
+		url        string
+		eventTypes []eventsv1.EventType
+		code       codes.Code
+	}{
+		{"missing cid", "", "https://example.org/hook", nil, codes.InvalidArgument},
+		{"relative url", testCID, "/hook", nil, codes.InvalidArgument},
+		{"unsupported scheme", testCID, "ftp://example.org/hook", nil, codes.InvalidArgument},
+		{"localhost", testCID, "http://localhost:8080/hook", nil, codes.InvalidArgument},
+		{"loopback address", testCID, "http://127.0.0.1/hook", nil, codes.InvalidArgument},
+		{"loopback IPv6 address", testCID, "http://[::1]/hook", nil, codes.InvalidArgument},
+		{"private address", testCID, "https://10.0.0.1/hook", nil, codes.InvalidArgument},
+		{"link-local address", testCID, "http://169.254.169.254/latest", nil, codes.InvalidArgument},
+		{"not a record event", testCID, "https://example.org/hook", []eventsv1.EventType{eventsv1.EventType_EVENT_TYPE_SYNC_CREATED}, codes.InvalidArgument},
+		{"unknown record", "bafyunknown", "https://example.org/hook", nil, codes.NotFound},
+		{"valid", testCID, "https://example.org/hook", []eventsv1.EventType{eventsv1.EventType_EVENT_TYPE_RECORD_PULLED}, codes.OK},
+		{"too many webhooks", testCID, "https://example.org/other", nil, codes.ResourceExhausted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhook, err := service.Register(tt.cid, tt.url, tt.eventTypes, "", testOwner)
+			require.Equal(t, tt.code, status.Code(err), err)
+
+			if tt.code == codes.OK {
+				assert.Equal(t, tt.cid, webhook.GetCID())
+				assert.Equal(t, testOwner, webhook.GetOwner())
+			}
+		})
+	}
+}
+
+func TestRegisterNotOwner(t *testing.T) {
+	service, _, _ := newTestService(t, config.Config{Enabled: true, Timeout: time.Second, Workers: 1})
+
+	_, err := service.Register(testCID, "https://example.org/hook", nil, "", "spiffe://example.org/other")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Callers without identity cannot register webhooks for owned records
+	_, err = service.Register(testCID, "https://example.org/hook", nil, "", "")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestRegisterDisabled(t *testing.T) {
+	service, _, _ := newTestService(t, config.Config{})
+
+	_, err := service.Register(testCID, "https://example.org/hook", nil, "", "")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestListAndDeleteOwnWebhooks(t *testing.T) {
+	service, db, _ := newTestService(t, config.Config{Enabled: true, Timeout: time.Second, Workers: 1})
+
+	owned, err := service.Register(testCID, "https://example.org/hook", nil, "", testOwner)
+	require.NoError(t, err)
+
+	// Registered by the previous owner of the record
+	otherID, err := db.CreateRecordWebhook(testCID, "https://example.org/other", nil, "", "spiffe://example.org/other")
+	require.NoError(t, err)
+
+	webhooks, err := service.List(testOwner, "")
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, owned.GetID(), webhooks[0].GetID())
+
+	// Webhooks of other owners cannot be deleted
+	err = service.Delete(otherID, testOwner)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	require.NoError(t, service.Delete(owned.GetID(), testOwner))
+
+	webhooks, err = service.List(testOwner, "")
+	require.NoError(t, err)
+	assert.Empty(t, webhooks)
+}
+
+func TestDispatch(t *testing.T) {
+	type delivery struct {
+		eventType string
+		signature string
+		body      []byte
+	}
+
+	deliveries := make(chan delivery, 10)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		deliveries <- delivery{
+			eventType: r.Header.Get(EventTypeHeader),
+			signature: r.Header.Get(SignatureHeader),
+			body:      body,
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	service, _, bus := newTestService(t, config.Config{Enabled: true, Timeout: time.Second, Workers: 1})
+	service.allowPrivateTargets = true
+
+	_, err := service.Register(testCID, server.URL, []eventsv1.EventType{eventsv1.EventType_EVENT_TYPE_RECORD_PULLED}, "secret", testOwner)
+	require.NoError(t, err)
+
+	require.NoError(t, service.Start(t.Context()))
+	defer service.Stop() //nolint:errcheck
+
+	// Only pulls are notified
+	bus.RecordPushed(testCID, nil)
+	bus.RecordPulled(testCID, nil)
+
+	select {
+	case d := <-deliveries:
+		assert.Equal(t, eventsv1.EventType_EVENT_TYPE_RECORD_PULLED.String(), d.eventType)
+		assert.Equal(t, Sign("secret", d.body), d.signature)
+		assert.Contains(t, string(d.body), testCID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not notified")
+	}
+
+	select {
+	case d := <-deliveries:
+		t.Fatalf("unexpected delivery of %s", d.eventType)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestDispatchSlowWebhook(t *testing.T) {
+	release := make(chan struct{})
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer slow.Close()
+
+	delivered := make(chan struct{}, 1)
+
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		delivered <- struct{}{}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer fast.Close()
+
+	service, _, bus := newTestService(t, config.Config{Enabled: true, Timeout: time.Minute, Workers: 2})
+	service.allowPrivateTargets = true
+
+	_, err := service.Register(testCID, slow.URL, nil, "", testOwner)
+	require.NoError(t, err)
+
+	_, err = service.Register(testCID, fast.URL, nil, "", testOwner)
+	require.NoError(t, err)
+
+	require.NoError(t, service.Start(t.Context()))
+	defer service.Stop() //nolint:errcheck
+	defer close(release)
+
+	// A hanging webhook does not hold the deliveries of the other webhooks
+	bus.RecordPulled(testCID, nil)
+
+	select {
+	case <-delivered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not notified")
+	}
+}
+
+func TestSendTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	service, _, _ := newTestService(t, config.Config{Enabled: true, Timeout: 50 * time.Millisecond, Workers: 1})
+	service.allowPrivateTargets = true
+
+	err := service.send(t.Context(), &fakeWebhook{url: server.URL}, eventsv1.EventType_EVENT_TYPE_RECORD_PULLED, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSendPrivateAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	service, _, _ := newTestService(t, config.Config{Enabled: true, Timeout: time.Second, Workers: 1})
+
+	// Hosts resolving to private addresses are rejected when connecting
+	err := service.send(t.Context(), &fakeWebhook{url: server.URL}, eventsv1.EventType_EVENT_TYPE_RECORD_PULLED, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "private network address")
+}
@@ -60,6 +60,8 @@ type SignBlobOIDCOptions struct {
 type SignBlobOIDCResult struct {
 	Signature string
 	PublicKey string
+	// Certificate is the base64-encoded DER signing certificate issued by Fulcio.
+	Certificate string
 }
 
 // SignBlobWithOIDC signs a blob using OIDC authentication.
@@ -183,9 +185,18 @@ func SignBlobWithOIDC(_ context.Context, opts *SignBlobOIDCOptions) (*SignBlobOI
 		return nil, fmt.Errorf("failed to get public key: %w", err)
 	}
 
+	// Keep the signing certificate, as it carries the identity of the signer.
+	certificate := sigBundle.GetVerificationMaterial().GetCertificate().GetRawBytes()
+	if certificate == nil {
+		if chain := sigBundle.GetVerificationMaterial().GetX509CertificateChain().GetCertificates(); len(chain) > 0 {
+			certificate = chain[0].GetRawBytes()
+		}
+	}
+
 	return &SignBlobOIDCResult{
-		Signature: base64.StdEncoding.EncodeToString(sigBundle.GetMessageSignature().GetSignature()),
-		PublicKey: publicKeyPEM,
+		Signature:   base64.StdEncoding.EncodeToString(sigBundle.GetMessageSignature().GetSignature()),
+		PublicKey:   publicKeyPEM,
+		Certificate: base64.StdEncoding.EncodeToString(certificate),
 	}, nil
 }
 
@@ -0,0 +1,35 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package cosign
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/base64"
+	"fmt"
+
+	sigs "github.com/sigstore/cosign/v2/pkg/signature"
+)
+
+// VerifySignature verifies the signature of the payload using the PEM-encoded public key.
+// The signature is expected to be base64-encoded, raw signatures are also accepted.
+func VerifySignature(publicKey []byte, signature string, payload []byte) error {
+	verifier, err := sigs.LoadPublicKeyRaw(publicKey, crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("failed to load public key: %w", err)
+	}
+
+	// Decode base64 signature if needed
+	signatureBytes, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		// If decoding fails, assume it's already raw bytes
+		signatureBytes = []byte(signature)
+	}
+
+	if err := verifier.VerifySignature(bytes.NewReader(signatureBytes), bytes.NewReader(payload)); err != nil {
+		return fmt.Errorf("failed to verify signature: %w", err)
+	}
+
+	return nil
+}
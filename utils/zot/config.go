@@ -49,6 +49,46 @@ func writeConfigFile(filePath string, zotConfig *zotconfig.Config) error {
 
 // addRegistryToSyncConfig adds a registry to the zot sync configuration.
 func AddRegistryToSyncConfig(filePath string, remoteRegistryURL string, remoteRepositoryName string, credentials zotsyncconfig.Credentials, cids []string) error {
+	var syncContent []zotsyncconfig.Content
+
+	if len(cids) > 0 {
+		syncContent = tagsSyncContent(remoteRepositoryName, cids)
+	} else {
+		syncContent = []zotsyncconfig.Content{
+			{
+				Prefix: remoteRepositoryName,
+			},
+		}
+	}
+
+	return setRegistrySyncConfig(filePath, remoteRegistryURL, credentials, syncContent, false)
+}
+
+// SetRegistrySyncCIDs sets the CIDs synchronized from a registry, adding the registry to the zot sync
+// configuration if needed. Unlike AddRegistryToSyncConfig, no CID is synchronized if cids is empty.
+func SetRegistrySyncCIDs(filePath string, remoteRegistryURL string, remoteRepositoryName string, credentials zotsyncconfig.Credentials, cids []string) error {
+	return setRegistrySyncConfig(filePath, remoteRegistryURL, credentials, tagsSyncContent(remoteRepositoryName, cids), true)
+}
+
+// tagsSyncContent returns the sync content matching the CIDs tagged in the repository.
+// Tags are never empty, so that no tag matches if cids is empty.
+func tagsSyncContent(remoteRepositoryName string, cids []string) []zotsyncconfig.Content {
+	// Create a regex to match the CIDs
+	regex := fmt.Sprintf("^(%s)$", strings.Join(cids, "|"))
+
+	return []zotsyncconfig.Content{
+		{
+			Prefix: remoteRepositoryName,
+			Tags: &zotsyncconfig.Tags{
+				Regex: &regex,
+			},
+		},
+	}
+}
+
+// setRegistrySyncConfig adds a registry with the given content to the zot sync configuration.
+// The content of a registry already present is replaced if replace is set, and kept otherwise.
+func setRegistrySyncConfig(filePath string, remoteRegistryURL string, credentials zotsyncconfig.Credentials, syncContent []zotsyncconfig.Content, replace bool) error {
 	logger.Debug("Adding registry to zot sync", "remote_url", remoteRegistryURL)
 
 	// Validate input
@@ -100,37 +140,21 @@ func AddRegistryToSyncConfig(filePath string, remoteRegistryURL string, remoteRe
 	}
 
 	// Check if registry already exists
-	for _, existingRegistry := range syncConfig.Registries {
+	for i, existingRegistry := range syncConfig.Registries {
 		for _, existingURL := range existingRegistry.URLs {
-			if existingURL == registryURL {
-				logger.Debug("Registry already exists in zot config", "registry_url", registryURL)
+			if existingURL != registryURL {
+				continue
+			}
+
+			logger.Debug("Registry already exists in zot config", "registry_url", registryURL)
 
+			if !replace {
 				return nil
 			}
-		}
-	}
 
-	var syncContent []zotsyncconfig.Content
-
-	if len(cids) > 0 {
-		// Create a regex to match the CIDs
-		cidsRegex := strings.Join(cids, "|")
-		regex := fmt.Sprintf("^(%s)$", cidsRegex)
+			syncConfig.Registries[i].Content = syncContent
 
-		// Add the regex to the sync content
-		syncContent = []zotsyncconfig.Content{
-			{
-				Prefix: remoteRepositoryName,
-				Tags: &zotsyncconfig.Tags{
-					Regex: &regex,
-				},
-			},
-		}
-	} else {
-		syncContent = []zotsyncconfig.Content{
-			{
-				Prefix: remoteRepositoryName,
-			},
+			return writeConfigFile(filePath, zotConfig)
 		}
 	}
 
@@ -7,6 +7,8 @@ package zot
 import (
 	"encoding/json"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -518,6 +520,70 @@ func TestAddRegistryToZotSync(t *testing.T) {
 	})
 }
 
+func TestSetRegistrySyncCIDs(t *testing.T) {
+	createBasicConfig := func() string {
+		configPath := filepath.Join(t.TempDir(), "zot-config.json")
+		if err := os.WriteFile(configPath, []byte(`{"http": {"address": "0.0.0.0", "port": "5000"}}`), 0o600); err != nil {
+			t.Fatalf("Failed to write basic config: %v", err)
+		}
+
+		return configPath
+	}
+
+	readRegex := func(t *testing.T, configPath string) string {
+		t.Helper()
+
+		config, err := readConfigFile(configPath)
+		if err != nil {
+			t.Fatalf("Failed to read updated config: %v", err)
+		}
+
+		if len(config.Extensions.Sync.Registries) != 1 {
+			t.Fatalf("Expected 1 registry, got %d", len(config.Extensions.Sync.Registries))
+		}
+
+		content := config.Extensions.Sync.Registries[0].Content
+		if len(content) != 1 || content[0].Tags == nil || content[0].Tags.Regex == nil {
+			t.Fatalf("Tags regex not set: %v", content)
+		}
+
+		return *content[0].Tags.Regex
+	}
+
+	t.Run("add and replace CIDs", func(t *testing.T) {
+		configPath := createBasicConfig()
+
+		if err := SetRegistrySyncCIDs(configPath, "registry.example.com", "test/repo", zotsyncconfig.Credentials{}, []string{"cid1", "cid2"}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if regex := readRegex(t, configPath); regex != "^(cid1|cid2)$" {
+			t.Errorf("Expected regex %q, got %q", "^(cid1|cid2)$", regex)
+		}
+
+		if err := SetRegistrySyncCIDs(configPath, "registry.example.com", "test/repo", zotsyncconfig.Credentials{}, []string{"cid3"}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if regex := readRegex(t, configPath); regex != "^(cid3)$" {
+			t.Errorf("Expected regex %q, got %q", "^(cid3)$", regex)
+		}
+	})
+
+	t.Run("no CIDs syncs nothing", func(t *testing.T) {
+		configPath := createBasicConfig()
+
+		if err := SetRegistrySyncCIDs(configPath, "registry.example.com", "test/repo", zotsyncconfig.Credentials{}, nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		regex := regexp.MustCompile(readRegex(t, configPath))
+		if regex.MatchString("cid1") {
+			t.Errorf("Expected regex %q to match no tag", regex)
+		}
+	})
+}
+
 func TestRemoveRegistryFromZotSync(t *testing.T) {
 	// Helper function to create config with sync registries
 	createConfigWithRegistries := func() string {